@@ -0,0 +1,231 @@
+// Package bsdate implements the Bikram Sambat (BS) calendar used by Nepali
+// government offices, together with Nepali fiscal year helpers.
+//
+// Conversion between BS and AD (Gregorian) dates is table driven; see
+// monthDays for the supported range.
+package bsdate
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Errors returned by the package
+var (
+	ErrOutOfRange  = errors.New("bsdate: date outside supported range")
+	ErrInvalidDate = errors.New("bsdate: invalid date")
+)
+
+// Nepal is the Nepal Standard Time zone (UTC+05:45). BS dates are always
+// derived from the wall clock in Nepal, regardless of the server time zone.
+var Nepal = time.FixedZone("NPT", 5*60*60+45*60)
+
+// Month is a Bikram Sambat month (Baisakh = 1 ... Chaitra = 12)
+type Month int
+
+// BS months
+const (
+	Baisakh Month = 1 + iota
+	Jestha
+	Ashadh
+	Shrawan
+	Bhadra
+	Ashwin
+	Kartik
+	Mangsir
+	Poush
+	Magh
+	Falgun
+	Chaitra
+)
+
+var monthNames = [...]string{
+	"Baisakh", "Jestha", "Ashadh", "Shrawan", "Bhadra", "Ashwin",
+	"Kartik", "Mangsir", "Poush", "Magh", "Falgun", "Chaitra",
+}
+
+var monthNamesNepali = [...]string{
+	"बैशाख", "जेठ", "असार", "साउन", "भदौ", "असोज",
+	"कात्तिक", "मंसिर", "पुस", "माघ", "फागुन", "चैत",
+}
+
+// String returns the romanized month name
+func (m Month) String() string {
+	if m < Baisakh || m > Chaitra {
+		return fmt.Sprintf("Month(%d)", int(m))
+	}
+	return monthNames[m-1]
+}
+
+// Nepali returns the month name in Devanagari
+func (m Month) Nepali() string {
+	if m < Baisakh || m > Chaitra {
+		return fmt.Sprintf("Month(%d)", int(m))
+	}
+	return monthNamesNepali[m-1]
+}
+
+// Date is a calendar date in Bikram Sambat
+type Date struct {
+	Year  int
+	Month Month
+	Day   int
+}
+
+// New returns the BS date for the given year, month and day after
+// validating it against the calendar table.
+func New(year int, month Month, day int) (Date, error) {
+	n, err := DaysInMonth(year, month)
+	if err != nil {
+		return Date{}, err
+	}
+	if day < 1 || day > n {
+		return Date{}, fmt.Errorf("%w: %d-%02d-%02d", ErrInvalidDate, year, int(month), day)
+	}
+	return Date{Year: year, Month: month, Day: day}, nil
+}
+
+// DaysInMonth returns the number of days in the given BS month
+func DaysInMonth(year int, month Month) (int, error) {
+	if year < MinYear || year > MaxYear {
+		return 0, fmt.Errorf("%w: year %d", ErrOutOfRange, year)
+	}
+	if month < Baisakh || month > Chaitra {
+		return 0, fmt.Errorf("%w: month %d", ErrInvalidDate, int(month))
+	}
+	return monthDays[year-MinYear][month-1], nil
+}
+
+// DaysInYear returns the number of days in the given BS year
+func DaysInYear(year int) (int, error) {
+	if year < MinYear || year > MaxYear {
+		return 0, fmt.Errorf("%w: year %d", ErrOutOfRange, year)
+	}
+	i := year - MinYear
+	return yearOffset[i+1] - yearOffset[i], nil
+}
+
+// FromTime converts an instant to its BS date, using the calendar day in Nepal
+func FromTime(t time.Time) (Date, error) {
+	y, m, d := t.In(Nepal).Date()
+	civil := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	days := int(civil.Sub(epoch).Hours() / 24)
+	if days < 0 || days >= yearOffset[len(yearOffset)-1] {
+		return Date{}, fmt.Errorf("%w: %s", ErrOutOfRange, civil.Format(time.DateOnly))
+	}
+
+	i := 0
+	for yearOffset[i+1] <= days {
+		i++
+	}
+	days -= yearOffset[i]
+
+	month := 0
+	for days >= monthDays[i][month] {
+		days -= monthDays[i][month]
+		month++
+	}
+
+	return Date{Year: MinYear + i, Month: Month(month + 1), Day: days + 1}, nil
+}
+
+// MustFromTime is like FromTime but panics if t is outside the supported range
+func MustFromTime(t time.Time) Date {
+	d, err := FromTime(t)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// Today returns the current BS date in Nepal
+func Today() (Date, error) {
+	return FromTime(time.Now())
+}
+
+// Time returns midnight at the start of d in Nepal time
+func (d Date) Time() (time.Time, error) {
+	days, err := d.dayNumber()
+	if err != nil {
+		return time.Time{}, err
+	}
+	y, m, dd := epoch.AddDate(0, 0, days).Date()
+	return time.Date(y, m, dd, 0, 0, 0, 0, Nepal), nil
+}
+
+// dayNumber returns the number of days between epoch and d
+func (d Date) dayNumber() (int, error) {
+	if _, err := New(d.Year, d.Month, d.Day); err != nil {
+		return 0, err
+	}
+	i := d.Year - MinYear
+	days := yearOffset[i]
+	for m := 0; m < int(d.Month)-1; m++ {
+		days += monthDays[i][m]
+	}
+	return days + d.Day - 1, nil
+}
+
+// IsValid reports whether d exists in the calendar table
+func (d Date) IsValid() bool {
+	_, err := New(d.Year, d.Month, d.Day)
+	return err == nil
+}
+
+// IsZero reports whether d is the zero Date
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// Before reports whether d is before other
+func (d Date) Before(other Date) bool {
+	return d.Compare(other) < 0
+}
+
+// After reports whether d is after other
+func (d Date) After(other Date) bool {
+	return d.Compare(other) > 0
+}
+
+// Compare returns -1, 0 or +1 depending on whether d is before, equal to or
+// after other
+func (d Date) Compare(other Date) int {
+	switch {
+	case d.Year != other.Year:
+		return sign(d.Year - other.Year)
+	case d.Month != other.Month:
+		return sign(int(d.Month - other.Month))
+	default:
+		return sign(d.Day - other.Day)
+	}
+}
+
+// AddDays returns d shifted by n days
+func (d Date) AddDays(n int) (Date, error) {
+	t, err := d.Time()
+	if err != nil {
+		return Date{}, err
+	}
+	return FromTime(t.AddDate(0, 0, n))
+}
+
+// EndOfMonth returns the last day of d's month
+func (d Date) EndOfMonth() (Date, error) {
+	n, err := DaysInMonth(d.Year, d.Month)
+	if err != nil {
+		return Date{}, err
+	}
+	return Date{Year: d.Year, Month: d.Month, Day: n}, nil
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,237 @@
+package bsdate
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func ad(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, Nepal)
+}
+
+func TestConversionRoundTrip(t *testing.T) {
+	lastDay, err := DaysInMonth(MaxYear, Chaitra)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		bs   Date
+		ad   time.Time
+	}{
+		{"first supported day", Date{MinYear, Baisakh, 1}, ad(1943, time.April, 14)},
+		{"new year 2080", Date{2080, Baisakh, 1}, ad(2023, time.April, 14)},
+		{"FY 2080-81 start", Date{2080, Shrawan, 1}, ad(2023, time.July, 17)},
+		{"new year 2081", Date{2081, Baisakh, 1}, ad(2024, time.April, 13)},
+		{"FY 2080-81 end", Date{2081, Ashadh, 31}, ad(2024, time.July, 15)},
+		{"FY 2081-82 start", Date{2081, Shrawan, 1}, ad(2024, time.July, 16)},
+		{"new year 2082", Date{2082, Baisakh, 1}, ad(2025, time.April, 14)},
+		{"FY 2082-83 start", Date{2082, Shrawan, 1}, ad(2025, time.July, 17)},
+		{"last supported day", Date{MaxYear, Chaitra, lastDay}, ad(2034, time.April, 13)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.bs.Time()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(tt.ad) {
+				t.Errorf("%s to AD = %s, want %s", tt.bs, got.Format(time.DateOnly), tt.ad.Format(time.DateOnly))
+			}
+
+			back, err := FromTime(tt.ad)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if back != tt.bs {
+				t.Errorf("%s to BS = %s, want %s", tt.ad.Format(time.DateOnly), back, tt.bs)
+			}
+		})
+	}
+}
+
+func TestFromTimeUsesNepalDay(t *testing.T) {
+	// 18:15 UTC on 15 July is already 16 July, Shrawan 1, in Nepal
+	got, err := FromTime(time.Date(2024, time.July, 15, 18, 15, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Date{2081, Shrawan, 1}); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestOutOfRange(t *testing.T) {
+	last, err := Date{MaxYear, Chaitra, 1}.EndOfMonth()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lastTime, err := last.Time()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tm := range []time.Time{
+		ad(1943, time.April, 13),
+		lastTime.AddDate(0, 0, 1),
+	} {
+		if d, err := FromTime(tm); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("FromTime(%s) = %s, %v; want ErrOutOfRange", tm.Format(time.DateOnly), d, err)
+		}
+	}
+	for _, d := range []Date{{MinYear - 1, Chaitra, 30}, {MaxYear + 1, Baisakh, 1}} {
+		if _, err := d.Time(); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("%s.Time() error = %v, want ErrOutOfRange", d, err)
+		}
+	}
+}
+
+func TestFiscalYearBoundaries(t *testing.T) {
+	tests := []struct {
+		date Date
+		want FiscalYear
+	}{
+		{Date{2081, Baisakh, 1}, FiscalYear{2080}},
+		{Date{2081, Ashadh, 31}, FiscalYear{2080}},
+		{Date{2081, Shrawan, 1}, FiscalYear{2081}},
+		{Date{2081, Chaitra, 30}, FiscalYear{2081}},
+		{Date{2082, Ashadh, 32}, FiscalYear{2081}},
+		{Date{2082, Shrawan, 1}, FiscalYear{2082}},
+	}
+	for _, tt := range tests {
+		if got := FiscalYearOf(tt.date); got != tt.want {
+			t.Errorf("FiscalYearOf(%s) = %s, want %s", tt.date, got, tt.want)
+		}
+	}
+
+	fy := FiscalYear{2081}
+	if got, want := fy.Start(), (Date{2081, Shrawan, 1}); got != want {
+		t.Errorf("Start = %s, want %s", got, want)
+	}
+	end, err := fy.End()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Date{2082, Ashadh, 32}); end != want {
+		t.Errorf("End = %s, want %s", end, want)
+	}
+	if next, _ := end.AddDays(1); next != fy.Next().Start() {
+		t.Errorf("day after End = %s, want %s", next, fy.Next().Start())
+	}
+
+	start, stop, err := fy.Bounds()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !start.Equal(ad(2024, time.July, 16)) || !stop.Equal(ad(2025, time.July, 17)) {
+		t.Errorf("Bounds = [%s, %s), want [2024-07-16, 2025-07-17)", start, stop)
+	}
+	got, err := FiscalYearForTime(stop.Add(-time.Nanosecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != fy {
+		t.Errorf("last instant of %s falls in %s", fy, got)
+	}
+}
+
+func TestParseFiscalYear(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    FiscalYear
+		wantErr bool
+	}{
+		{in: "2081-82", want: FiscalYear{2081}},
+		{in: "2081/82", want: FiscalYear{2081}},
+		{in: "2081-2082", want: FiscalYear{2081}},
+		{in: "2081/2082", want: FiscalYear{2081}},
+		{in: " 2081 ", want: FiscalYear{2081}},
+		{in: "२०८१-८२", want: FiscalYear{2081}},
+		{in: "२०८१/२०८२", want: FiscalYear{2081}},
+		{in: "2099-00", wantErr: true},
+		{in: "2089-90", want: FiscalYear{2089}},
+		{in: "2081-83", wantErr: true},
+		{in: "2081-2083", wantErr: true},
+		{in: "2081-820", wantErr: true},
+		{in: "2081-82-83", wantErr: true},
+		{in: "1999", wantErr: true},
+		{in: "", wantErr: true},
+		{in: "FY2081", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseFiscalYear(tt.in)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidDate) {
+				t.Errorf("ParseFiscalYear(%q) = %v, %v; want ErrInvalidDate", tt.in, got, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseFiscalYear(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	d := Date{2081, Shrawan, 1}
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"String", d.String(), "2081-04-01"},
+		{"Nepali", d.Nepali(), "२०८१-०४-०१"},
+		{"Long", d.Long(), "1 Shrawan 2081"},
+		{"LongNepali", d.LongNepali(), "२०८१ साउन १"},
+		{"Code", FiscalYear{2081}.Code(), "2081-82"},
+		{"Code across a century", FiscalYear{2099}.Code(), "2099-00"},
+		{"Name", FiscalYear{2081}.Name(), "2081/2082"},
+		{"NepaliCode", FiscalYear{2081}.NepaliCode(), "२०८१-८२"},
+		{"FormatNumber", FormatNumber(1234567890), "१२३४५६७८९०"},
+		{"ToNepaliDigits keeps other runes", ToNepaliDigits("D-12/a"), "D-१२/a"},
+		{"FromNepaliDigits keeps other runes", FromNepaliDigits("द-१२/a"), "द-12/a"},
+		{"invalid month", Month(13).String(), "Month(13)"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Date
+		wantErr error
+	}{
+		{in: "2081-04-01", want: Date{2081, Shrawan, 1}},
+		{in: "2081/4/1", want: Date{2081, Shrawan, 1}},
+		{in: "2081.04.01", want: Date{2081, Shrawan, 1}},
+		{in: "२०८१-०४-०१", want: Date{2081, Shrawan, 1}},
+		{in: " २०८२/३/३२ ", want: Date{2082, Ashadh, 32}},
+		{in: "2081-03-32", wantErr: ErrInvalidDate},
+		{in: "2081-13-01", wantErr: ErrInvalidDate},
+		{in: "2081-04", wantErr: ErrInvalidDate},
+		{in: "2081-0a-01", wantErr: ErrInvalidDate},
+		{in: "1999-12-30", wantErr: ErrOutOfRange},
+		{in: "2091-01-01", wantErr: ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse(%q) = %v, %v; want %v", tt.in, got, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("Parse(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+		if round, err := Parse(got.Nepali()); err != nil || round != got {
+			t.Errorf("Parse(%q) = %v, %v; want %v", got.Nepali(), round, err, got)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package bsdate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FiscalYear is a Nepali fiscal year, running from Shrawan 1 of StartYear to
+// the last day of Ashadh of StartYear+1.
+type FiscalYear struct {
+	StartYear int
+}
+
+// FiscalYearOf returns the fiscal year that contains d
+func FiscalYearOf(d Date) FiscalYear {
+	if d.Month >= Shrawan {
+		return FiscalYear{StartYear: d.Year}
+	}
+	return FiscalYear{StartYear: d.Year - 1}
+}
+
+// FiscalYearForTime returns the fiscal year that contains t (in Nepal time)
+func FiscalYearForTime(t time.Time) (FiscalYear, error) {
+	d, err := FromTime(t)
+	if err != nil {
+		return FiscalYear{}, err
+	}
+	return FiscalYearOf(d), nil
+}
+
+// CurrentFiscalYear returns the fiscal year in progress in Nepal
+func CurrentFiscalYear() (FiscalYear, error) {
+	return FiscalYearForTime(time.Now())
+}
+
+// EndYear returns the BS year in which the fiscal year ends
+func (fy FiscalYear) EndYear() int {
+	return fy.StartYear + 1
+}
+
+// Code returns the short code used in register numbers, e.g. "2081-82"
+func (fy FiscalYear) Code() string {
+	return fmt.Sprintf("%d-%02d", fy.StartYear, fy.EndYear()%100)
+}
+
+// Name returns the long form of the fiscal year, e.g. "2081/2082"
+func (fy FiscalYear) Name() string {
+	return fmt.Sprintf("%d/%d", fy.StartYear, fy.EndYear())
+}
+
+// NepaliCode returns Code in Devanagari digits, e.g. "२०८१-८२"
+func (fy FiscalYear) NepaliCode() string {
+	return ToNepaliDigits(fy.Code())
+}
+
+// String implements fmt.Stringer and returns Code
+func (fy FiscalYear) String() string {
+	return fy.Code()
+}
+
+// Start returns Shrawan 1 of the fiscal year
+func (fy FiscalYear) Start() Date {
+	return Date{Year: fy.StartYear, Month: Shrawan, Day: 1}
+}
+
+// End returns the last day of Ashadh that closes the fiscal year
+func (fy FiscalYear) End() (Date, error) {
+	return Date{Year: fy.EndYear(), Month: Ashadh, Day: 1}.EndOfMonth()
+}
+
+// Bounds returns the fiscal year as a half-open [start, end) interval of
+// instants in Nepal time, suitable for range queries on timestamps.
+func (fy FiscalYear) Bounds() (start, end time.Time, err error) {
+	start, err = fy.Start().Time()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	end, err = fy.Next().Start().Time()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start, end, nil
+}
+
+// Contains reports whether d falls within the fiscal year
+func (fy FiscalYear) Contains(d Date) bool {
+	return FiscalYearOf(d) == fy
+}
+
+// Next returns the following fiscal year
+func (fy FiscalYear) Next() FiscalYear {
+	return FiscalYear{StartYear: fy.StartYear + 1}
+}
+
+// Prev returns the preceding fiscal year
+func (fy FiscalYear) Prev() FiscalYear {
+	return FiscalYear{StartYear: fy.StartYear - 1}
+}
+
+// ParseFiscalYear parses a fiscal year written as "2081-82", "2081/82",
+// "2081-2082", "2081/2082" or just "2081", in ASCII or Devanagari digits.
+func ParseFiscalYear(s string) (FiscalYear, error) {
+	raw := strings.TrimSpace(FromNepaliDigits(s))
+	parts := strings.Split(strings.ReplaceAll(raw, "/", "-"), "-")
+	if len(parts) > 2 {
+		return FiscalYear{}, fmt.Errorf("%w: fiscal year %q", ErrInvalidDate, s)
+	}
+
+	start, err := strconv.Atoi(parts[0])
+	if err != nil || start < MinYear || start > MaxYear {
+		return FiscalYear{}, fmt.Errorf("%w: fiscal year %q", ErrInvalidDate, s)
+	}
+	fy := FiscalYear{StartYear: start}
+
+	if len(parts) == 2 {
+		end, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return FiscalYear{}, fmt.Errorf("%w: fiscal year %q", ErrInvalidDate, s)
+		}
+		switch len(parts[1]) {
+		case 2:
+			if end != fy.EndYear()%100 {
+				return FiscalYear{}, fmt.Errorf("%w: fiscal year %q", ErrInvalidDate, s)
+			}
+		case 4:
+			if end != fy.EndYear() {
+				return FiscalYear{}, fmt.Errorf("%w: fiscal year %q", ErrInvalidDate, s)
+			}
+		default:
+			return FiscalYear{}, fmt.Errorf("%w: fiscal year %q", ErrInvalidDate, s)
+		}
+	}
+
+	return fy, nil
+}
@@ -0,0 +1,66 @@
+package bsdate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var nepaliDigits = [...]rune{'०', '१', '२', '३', '४', '५', '६', '७', '८', '९'}
+
+// String formats d as YYYY-MM-DD using ASCII digits, e.g. "2081-04-01"
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, int(d.Month), d.Day)
+}
+
+// Nepali formats d as YYYY-MM-DD using Devanagari digits, e.g. "२०८१-०४-०१"
+func (d Date) Nepali() string {
+	return ToNepaliDigits(d.String())
+}
+
+// Long formats d with the romanized month name, e.g. "1 Shrawan 2081"
+func (d Date) Long() string {
+	return fmt.Sprintf("%d %s %d", d.Day, d.Month, d.Year)
+}
+
+// LongNepali formats d in Devanagari with the month name, e.g. "२०८१ साउन १"
+func (d Date) LongNepali() string {
+	return fmt.Sprintf("%s %s %s",
+		ToNepaliDigits(strconv.Itoa(d.Year)),
+		d.Month.Nepali(),
+		ToNepaliDigits(strconv.Itoa(d.Day)),
+	)
+}
+
+// ToNepaliDigits replaces ASCII digits in s with Devanagari digits
+func ToNepaliDigits(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) * 3)
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(nepaliDigits[r-'0'])
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// FromNepaliDigits replaces Devanagari digits in s with ASCII digits
+func FromNepaliDigits(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if r >= '०' && r <= '९' {
+			b.WriteRune('0' + (r - '०'))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// FormatNumber formats n with Devanagari digits
+func FormatNumber(n int) string {
+	return ToNepaliDigits(strconv.Itoa(n))
+}
@@ -0,0 +1,42 @@
+package bsdate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parse parses a BS date written as year, month and day separated by '-',
+// '/' or '.', in either ASCII or Devanagari digits. Month and day may omit
+// the leading zero. Examples: "2081-04-01", "2081/4/1", "२०८१-०४-०१".
+func Parse(s string) (Date, error) {
+	parts := splitDate(FromNepaliDigits(strings.TrimSpace(s)))
+	if len(parts) != 3 {
+		return Date{}, fmt.Errorf("%w: %q", ErrInvalidDate, s)
+	}
+
+	nums := make([]int, 3)
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return Date{}, fmt.Errorf("%w: %q", ErrInvalidDate, s)
+		}
+		nums[i] = n
+	}
+
+	return New(nums[0], Month(nums[1]), nums[2])
+}
+
+// MustParse is like Parse but panics on error
+func MustParse(s string) Date {
+	d, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func splitDate(s string) []string {
+	s = strings.NewReplacer("/", "-", ".", "-").Replace(s)
+	return strings.Split(s, "-")
+}
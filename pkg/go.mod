@@ -0,0 +1,3 @@
+module git.ninjainfosys.com/ePalika/pkg
+
+go 1.25.1
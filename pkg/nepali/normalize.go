@@ -0,0 +1,146 @@
+// Package nepali prepares Nepali text for search: it normalizes the
+// spelling variants of Devanagari text, romanizes it, and folds romanized
+// text to a phonetic key so that "नगरपालिका", "nagarpalika" and
+// "nagarpaalika" can all find each other.
+package nepali
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Devanagari code points handled specially
+const (
+	chandrabindu = 'ँ'
+	anusvara     = 'ं'
+	visarga      = 'ः'
+	nukta        = '़'
+	virama       = '्'
+	avagraha     = 'ऽ'
+)
+
+// nuktaForms maps precomposed nukta letters to their base letter. Nepali
+// spelling does not distinguish them.
+var nuktaForms = map[rune]rune{
+	'\u0929': 'न', '\u0931': 'र', '\u0934': 'ळ',
+	'\u0958': 'क', '\u0959': 'ख', '\u095A': 'ग', '\u095B': 'ज',
+	'\u095C': 'ड', '\u095D': 'ढ', '\u095E': 'फ', '\u095F': 'य',
+}
+
+// longVowels maps long (dirgha) vowels to the short (hrasva) ones they are
+// commonly interchanged with
+var longVowels = map[rune]rune{
+	'ई': 'इ', 'ऊ': 'उ', 'ी': 'ि', 'ू': 'ु',
+}
+
+// nasals are the nasal consonants that, joined by a virama to a following
+// consonant, are also written as an anusvara: पञ्चायत and पंचायत
+var nasals = map[rune]bool{'ङ': true, 'ञ': true, 'ण': true, 'न': true, 'म': true}
+
+// Normalize returns the canonical form of s used for matching:
+//
+//   - Devanagari digits become ASCII digits
+//   - nukta, zero-width joiners and avagraha are removed
+//   - chandrabindu and half nasals before a consonant become anusvara
+//   - long vowels become short vowels
+//   - Latin letters are lower-cased
+//   - punctuation separates words and runs of spaces collapse to one
+func Normalize(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	b.Grow(len(s))
+	space := true
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r >= '०' && r <= '९':
+			r = '0' + (r - '०')
+		case r == nukta || r == avagraha || r == '\u200C' || r == '\u200D' || r == '\u00AD':
+			continue
+		case r == chandrabindu:
+			r = anusvara
+		case nasals[r] && i+2 < len(runes) && runes[i+1] == virama && isConsonant(runes[i+2]):
+			r = anusvara
+			i++
+		}
+		if base, ok := nuktaForms[r]; ok {
+			r = base
+		}
+		if short, ok := longVowels[r]; ok {
+			r = short
+		}
+
+		if !isWordRune(r) {
+			if !space {
+				b.WriteByte(' ')
+				space = true
+			}
+			continue
+		}
+		b.WriteRune(unicode.ToLower(r))
+		space = false
+	}
+	return strings.TrimSuffix(b.String(), " ")
+}
+
+// Words splits s into normalized words
+func Words(s string) []string {
+	return strings.Fields(Normalize(s))
+}
+
+// IsDevanagari reports whether s contains any Devanagari letter
+func IsDevanagari(s string) bool {
+	for _, r := range s {
+		if isDevanagari(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func isDevanagari(r rune) bool {
+	return r >= 0x0900 && r <= 0x097F
+}
+
+func isConsonant(r rune) bool {
+	_, ok := consonants[r]
+	return ok
+}
+
+// isWordRune reports whether r belongs to a word rather than separating
+// words. Devanagari signs such as matras and the virama are part of words.
+func isWordRune(r rune) bool {
+	if isDevanagari(r) {
+		return r != '।' && r != '॥' && r != '॰'
+	}
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Span is the position of a word in a string, in runes
+type Span struct {
+	Start, End int
+}
+
+// WordSpans locates the words of s that Words returns, so that matches can
+// be highlighted in the original text
+func WordSpans(s string) []Span {
+	var spans []Span
+	start := -1
+	i := 0
+	for _, r := range s {
+		word := isWordRune(r) || r == '\u200C' || r == '\u200D' || r == '\u00AD'
+		switch {
+		case word && start < 0:
+			start = i
+		case !word && start >= 0:
+			spans = append(spans, Span{start, i})
+			start = -1
+		}
+		i++
+	}
+	if start >= 0 {
+		spans = append(spans, Span{start, i})
+	}
+	return spans
+}
@@ -0,0 +1,243 @@
+package nepali
+
+import (
+	"strings"
+	"unicode"
+)
+
+// consonants maps Devanagari consonants to their romanization without the
+// inherent vowel
+var consonants = map[rune]string{
+	'क': "k", 'ख': "kh", 'ग': "g", 'घ': "gh", 'ङ': "ng",
+	'च': "ch", 'छ': "chh", 'ज': "j", 'झ': "jh", 'ञ': "n",
+	'ट': "t", 'ठ': "th", 'ड': "d", 'ढ': "dh", 'ण': "n",
+	'त': "t", 'थ': "th", 'द': "d", 'ध': "dh", 'न': "n",
+	'प': "p", 'फ': "ph", 'ब': "b", 'भ': "bh", 'म': "m",
+	'य': "y", 'र': "r", 'ल': "l", 'व': "w", 'ळ': "l",
+	'श': "sh", 'ष': "sh", 'स': "s", 'ह': "h",
+}
+
+// vowels maps independent vowels
+var vowels = map[rune]string{
+	'अ': "a", 'आ': "aa", 'इ': "i", 'ई': "ii", 'उ': "u", 'ऊ': "uu",
+	'ऋ': "ri", 'ए': "e", 'ऐ': "ai", 'ओ': "o", 'औ': "au",
+}
+
+// matras maps dependent vowel signs
+var matras = map[rune]string{
+	'ा': "aa", 'ि': "i", 'ी': "ii", 'ु': "u", 'ू': "uu",
+	'ृ': "ri", 'े': "e", 'ै': "ai", 'ो': "o", 'ौ': "au",
+}
+
+// syllable is one romanized akshara: a consonant cluster or an independent
+// vowel with its vowel and final signs
+type syllable struct {
+	onset    string // consonants, empty for an independent vowel
+	vowel    string // "a" when the inherent vowel applies
+	coda     string // anusvara or visarga
+	inherent bool   // vowel is the inherent "a"
+	cluster  bool   // onset joins consonants with a virama
+	dropped  bool   // the inherent vowel is silent
+}
+
+// Romanize transliterates the Devanagari in s to lower-case ASCII, in the
+// informal spelling Nepali speakers type: "नगरपालिका" becomes
+// "nagarpaalikaa". Silent inherent vowels are dropped inside and at the end
+// of words. Other characters are kept, lower-cased.
+func Romanize(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			b.WriteString(romanizeWord(word, true))
+			word = word[:0]
+		}
+	}
+	for _, r := range s {
+		if isDevanagari(r) && isWordRune(r) {
+			word = append(word, r)
+			continue
+		}
+		flush()
+		b.WriteRune(unicode.ToLower(r))
+	}
+	flush()
+	return b.String()
+}
+
+// romanizeWord romanizes one Devanagari word. Final silent vowels are
+// always dropped; medial ones only when medial is set, because Nepali
+// speakers romanize them both ways ("suchanaa" and "suchnaa").
+func romanizeWord(word []rune, medial bool) string {
+	var syllables []syllable
+	var cur *syllable
+
+	for i := 0; i < len(word); i++ {
+		r := word[i]
+		switch {
+		case r == 'ज' && i+2 < len(word) && word[i+1] == virama && word[i+2] == 'ञ':
+			// ज्ञ is pronounced "gya" in Nepali
+			syllables = append(syllables, syllable{onset: "gy", vowel: "a", inherent: true})
+			cur = &syllables[len(syllables)-1]
+			i += 2
+		case consonants[r] != "":
+			if cur != nil && cur.vowel == "" {
+				// joined to the previous consonant by a virama
+				cur.onset += consonants[r]
+				cur.vowel, cur.inherent, cur.cluster = "a", true, true
+				continue
+			}
+			syllables = append(syllables, syllable{onset: consonants[r], vowel: "a", inherent: true})
+			cur = &syllables[len(syllables)-1]
+		case r == virama:
+			if cur != nil && cur.inherent {
+				cur.vowel, cur.inherent = "", false
+			}
+		case matras[r] != "":
+			if cur == nil || !cur.inherent {
+				syllables = append(syllables, syllable{})
+				cur = &syllables[len(syllables)-1]
+			}
+			cur.vowel, cur.inherent = matras[r], false
+		case vowels[r] != "":
+			syllables = append(syllables, syllable{vowel: vowels[r]})
+			cur = &syllables[len(syllables)-1]
+		case r == anusvara || r == chandrabindu:
+			if cur != nil {
+				cur.coda += "n"
+			}
+		case r == visarga:
+			if cur != nil {
+				cur.coda += "h"
+			}
+		case r >= '०' && r <= '९':
+			syllables = append(syllables, syllable{onset: string('0' + (r - '०'))})
+			cur = nil
+		}
+	}
+
+	deleteSchwas(syllables, medial)
+
+	var b strings.Builder
+	for _, s := range syllables {
+		b.WriteString(s.onset)
+		if !s.dropped {
+			b.WriteString(s.vowel)
+		}
+		b.WriteString(s.coda)
+	}
+	return b.String()
+}
+
+// deleteSchwas marks the inherent vowels that are not pronounced. The
+// vowel of a final consonant is silent unless it ends a cluster or is a
+// final y. Working back from the end of the word, a medial vowel between a
+// voiced syllable and an open syllable is silent too: न-ग-र-पा-लि-का is
+// "nagarpaalikaa" and ना-ग-रि-क-ता is "naagariktaa".
+func deleteSchwas(syllables []syllable, medial bool) {
+	n := len(syllables)
+	if n < 2 {
+		return
+	}
+	last := &syllables[n-1]
+	if last.inherent && last.coda == "" && !last.cluster && last.onset != "" && last.onset != "y" {
+		last.dropped = true
+	}
+	if !medial {
+		return
+	}
+	for i := n - 2; i > 0; i-- {
+		s := &syllables[i]
+		if !s.inherent || s.coda != "" {
+			continue
+		}
+		prev, next := syllables[i-1], syllables[i+1]
+		if prev.dropped || prev.vowel == "" || next.dropped || next.onset == "" || next.vowel == "" {
+			continue
+		}
+		// the next syllable must be open and not a final vowel kept above
+		if (i+2 < n && syllables[i+2].dropped) || (i+1 == n-1 && next.inherent) {
+			continue
+		}
+		s.dropped = true
+	}
+}
+
+// Fold reduces text to a phonetic key for fuzzy matching of romanized
+// Nepali. Devanagari is romanized first, so spellings of a word in either
+// script fold to the same key where they differ only in vowel length,
+// aspiration, sibilants or b/v/w: "Kathmandu", "kaathmaandu" and
+// "काठमान्डु" all fold to "katmandu".
+func Fold(s string) string {
+	return foldLatin(Romanize(Normalize(s)))
+}
+
+// Keys returns the distinct folded keys of the words in s, for indexing.
+// Devanagari words yield a key with and without their medial silent
+// vowels, so that either romanization a reader types will match.
+func Keys(s string) []string {
+	var keys []string
+	seen := map[string]bool{}
+	add := func(k string) {
+		if k != "" && !seen[k] {
+			seen[k] = true
+			keys = append(keys, k)
+		}
+	}
+	for _, w := range Words(s) {
+		if !IsDevanagari(w) {
+			add(foldLatin(w))
+			continue
+		}
+		runes := []rune(w)
+		add(foldLatin(romanizeWord(runes, true)))
+		add(foldLatin(romanizeWord(runes, false)))
+	}
+	return keys
+}
+
+func foldLatin(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	var prev byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case 'w', 'v':
+			c = 'b'
+		case 'f':
+			c = 'p'
+		case 'z':
+			c = 'j'
+		case 'q':
+			c = 'k'
+		case 'x':
+			b.WriteString("ks")
+			prev = 's'
+			continue
+		case 'e':
+			if i+1 < len(s) && s[i+1] == 'e' {
+				c = 'i'
+				i++
+			}
+		case 'o':
+			if i+1 < len(s) && s[i+1] == 'o' {
+				c = 'u'
+				i++
+			}
+		case 'h':
+			// aspiration and the h of ch and sh are not distinctive
+			if prev != 0 && prev != ' ' && !strings.ContainsRune("aeiou", rune(prev)) {
+				continue
+			}
+		}
+		if c == prev && c != ' ' {
+			continue
+		}
+		b.WriteByte(c)
+		prev = c
+	}
+	return b.String()
+}
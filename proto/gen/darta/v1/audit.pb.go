@@ -0,0 +1,959 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
+// source: darta/v1/audit.proto
+
+package dartav1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AuditChainProblem is a place where a tenant's audit chain is not as it
+// was written
+type AuditChainProblem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// HASH_MISMATCH, BROKEN_LINK, MISSING_ENTRIES, ANCHOR_MISMATCH or
+	// UNCHAINED_ENTRIES
+	Kind          string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	ChainSeq      int64  `protobuf:"varint,2,opt,name=chain_seq,json=chainSeq,proto3" json:"chain_seq,omitempty"`
+	EntryId       string `protobuf:"bytes,3,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Detail        string `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditChainProblem) Reset() {
+	*x = AuditChainProblem{}
+	mi := &file_darta_v1_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditChainProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditChainProblem) ProtoMessage() {}
+
+func (x *AuditChainProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditChainProblem.ProtoReflect.Descriptor instead.
+func (*AuditChainProblem) Descriptor() ([]byte, []int) {
+	return file_darta_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditChainProblem) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AuditChainProblem) GetChainSeq() int64 {
+	if x != nil {
+		return x.ChainSeq
+	}
+	return 0
+}
+
+func (x *AuditChainProblem) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *AuditChainProblem) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// AuditAnchor records the head of a tenant's chain at a point in time.
+// statement is the canonical JSON of the anchor, the text to notarize.
+type AuditAnchor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChainSeq      int64                  `protobuf:"varint,2,opt,name=chain_seq,json=chainSeq,proto3" json:"chain_seq,omitempty"`
+	EntryHash     string                 `protobuf:"bytes,3,opt,name=entry_hash,json=entryHash,proto3" json:"entry_hash,omitempty"` // Hex SHA-256
+	AnchoredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=anchored_at,json=anchoredAt,proto3" json:"anchored_at,omitempty"`
+	Statement     string                 `protobuf:"bytes,5,opt,name=statement,proto3" json:"statement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditAnchor) Reset() {
+	*x = AuditAnchor{}
+	mi := &file_darta_v1_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditAnchor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditAnchor) ProtoMessage() {}
+
+func (x *AuditAnchor) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditAnchor.ProtoReflect.Descriptor instead.
+func (*AuditAnchor) Descriptor() ([]byte, []int) {
+	return file_darta_v1_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuditAnchor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditAnchor) GetChainSeq() int64 {
+	if x != nil {
+		return x.ChainSeq
+	}
+	return 0
+}
+
+func (x *AuditAnchor) GetEntryHash() string {
+	if x != nil {
+		return x.EntryHash
+	}
+	return ""
+}
+
+func (x *AuditAnchor) GetAnchoredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AnchoredAt
+	}
+	return nil
+}
+
+func (x *AuditAnchor) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+type VerifyAuditChainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAuditChainRequest) Reset() {
+	*x = VerifyAuditChainRequest{}
+	mi := &file_darta_v1_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditChainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditChainRequest) ProtoMessage() {}
+
+func (x *VerifyAuditChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditChainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAuditChainRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_audit_proto_rawDescGZIP(), []int{2}
+}
+
+type VerifyAuditChainResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Intact            bool                   `protobuf:"varint,1,opt,name=intact,proto3" json:"intact,omitempty"`
+	EntriesVerified   int64                  `protobuf:"varint,2,opt,name=entries_verified,json=entriesVerified,proto3" json:"entries_verified,omitempty"`
+	HeadSeq           int64                  `protobuf:"varint,3,opt,name=head_seq,json=headSeq,proto3" json:"head_seq,omitempty"`
+	HeadHash          string                 `protobuf:"bytes,4,opt,name=head_hash,json=headHash,proto3" json:"head_hash,omitempty"`
+	AnchorsVerified   int32                  `protobuf:"varint,5,opt,name=anchors_verified,json=anchorsVerified,proto3" json:"anchors_verified,omitempty"`
+	Problems          []*AuditChainProblem   `protobuf:"bytes,6,rep,name=problems,proto3" json:"problems,omitempty"`
+	ProblemsTruncated bool                   `protobuf:"varint,7,opt,name=problems_truncated,json=problemsTruncated,proto3" json:"problems_truncated,omitempty"` // More problems were found than listed
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *VerifyAuditChainResponse) Reset() {
+	*x = VerifyAuditChainResponse{}
+	mi := &file_darta_v1_audit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditChainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditChainResponse) ProtoMessage() {}
+
+func (x *VerifyAuditChainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_audit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditChainResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAuditChainResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_audit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VerifyAuditChainResponse) GetIntact() bool {
+	if x != nil {
+		return x.Intact
+	}
+	return false
+}
+
+func (x *VerifyAuditChainResponse) GetEntriesVerified() int64 {
+	if x != nil {
+		return x.EntriesVerified
+	}
+	return 0
+}
+
+func (x *VerifyAuditChainResponse) GetHeadSeq() int64 {
+	if x != nil {
+		return x.HeadSeq
+	}
+	return 0
+}
+
+func (x *VerifyAuditChainResponse) GetHeadHash() string {
+	if x != nil {
+		return x.HeadHash
+	}
+	return ""
+}
+
+func (x *VerifyAuditChainResponse) GetAnchorsVerified() int32 {
+	if x != nil {
+		return x.AnchorsVerified
+	}
+	return 0
+}
+
+func (x *VerifyAuditChainResponse) GetProblems() []*AuditChainProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *VerifyAuditChainResponse) GetProblemsTruncated() bool {
+	if x != nil {
+		return x.ProblemsTruncated
+	}
+	return false
+}
+
+type ListAuditAnchorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditAnchorsRequest) Reset() {
+	*x = ListAuditAnchorsRequest{}
+	mi := &file_darta_v1_audit_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditAnchorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditAnchorsRequest) ProtoMessage() {}
+
+func (x *ListAuditAnchorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_audit_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditAnchorsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditAnchorsRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_audit_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAuditAnchorsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAuditAnchorsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ListAuditAnchorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anchors       []*AuditAnchor         `protobuf:"bytes,1,rep,name=anchors,proto3" json:"anchors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditAnchorsResponse) Reset() {
+	*x = ListAuditAnchorsResponse{}
+	mi := &file_darta_v1_audit_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditAnchorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditAnchorsResponse) ProtoMessage() {}
+
+func (x *ListAuditAnchorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_audit_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditAnchorsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditAnchorsResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_audit_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAuditAnchorsResponse) GetAnchors() []*AuditAnchor {
+	if x != nil {
+		return x.Anchors
+	}
+	return nil
+}
+
+// AuditEntryFilter narrows audit entries. Unset fields match every entry.
+type AuditEntryFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"` // "DARTA", "CHALANI", "SLA_POLICY", ...
+	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	PerformedBy   string                 `protobuf:"bytes,3,opt,name=performed_by,json=performedBy,proto3" json:"performed_by,omitempty"` // User ID
+	Actions       []string               `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`                            // Any of these actions
+	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`                          // "ACTIVITY" or "SOD_VIOLATION"
+	From          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`                                  // Performed at or after
+	To            *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`                                      // Performed before
+	TenantId      string                 `protobuf:"bytes,8,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`          // Must be the caller's tenant when set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntryFilter) Reset() {
+	*x = AuditEntryFilter{}
+	mi := &file_darta_v1_audit_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntryFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntryFilter) ProtoMessage() {}
+
+func (x *AuditEntryFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_audit_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntryFilter.ProtoReflect.Descriptor instead.
+func (*AuditEntryFilter) Descriptor() ([]byte, []int) {
+	return file_darta_v1_audit_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AuditEntryFilter) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *AuditEntryFilter) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *AuditEntryFilter) GetPerformedBy() string {
+	if x != nil {
+		return x.PerformedBy
+	}
+	return ""
+}
+
+func (x *AuditEntryFilter) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *AuditEntryFilter) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *AuditEntryFilter) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AuditEntryFilter) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *AuditEntryFilter) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type ListAuditEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *AuditEntryFilter      `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Pagination    *PaginationInput       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"` // Sorted by performed_at, newest first unless sort_desc is false
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEntriesRequest) Reset() {
+	*x = ListAuditEntriesRequest{}
+	mi := &file_darta_v1_audit_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEntriesRequest) ProtoMessage() {}
+
+func (x *ListAuditEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_audit_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_audit_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListAuditEntriesRequest) GetFilter() *AuditEntryFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListAuditEntriesRequest) GetPagination() *PaginationInput {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type AuditEntryEdge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Node          *AuditEntry            `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntryEdge) Reset() {
+	*x = AuditEntryEdge{}
+	mi := &file_darta_v1_audit_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntryEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntryEdge) ProtoMessage() {}
+
+func (x *AuditEntryEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_audit_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntryEdge.ProtoReflect.Descriptor instead.
+func (*AuditEntryEdge) Descriptor() ([]byte, []int) {
+	return file_darta_v1_audit_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AuditEntryEdge) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AuditEntryEdge) GetNode() *AuditEntry {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type AuditEntryConnection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Edges         []*AuditEntryEdge      `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	PageInfo      *PageInfo              `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntryConnection) Reset() {
+	*x = AuditEntryConnection{}
+	mi := &file_darta_v1_audit_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntryConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntryConnection) ProtoMessage() {}
+
+func (x *AuditEntryConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_audit_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntryConnection.ProtoReflect.Descriptor instead.
+func (*AuditEntryConnection) Descriptor() ([]byte, []int) {
+	return file_darta_v1_audit_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AuditEntryConnection) GetEdges() []*AuditEntryEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *AuditEntryConnection) GetPageInfo() *PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+type ListAuditEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connection    *AuditEntryConnection  `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEntriesResponse) Reset() {
+	*x = ListAuditEntriesResponse{}
+	mi := &file_darta_v1_audit_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEntriesResponse) ProtoMessage() {}
+
+func (x *ListAuditEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_audit_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_audit_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListAuditEntriesResponse) GetConnection() *AuditEntryConnection {
+	if x != nil {
+		return x.Connection
+	}
+	return nil
+}
+
+type GetEntityTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"` // "DARTA" or "CHALANI"
+	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntityTimelineRequest) Reset() {
+	*x = GetEntityTimelineRequest{}
+	mi := &file_darta_v1_audit_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntityTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntityTimelineRequest) ProtoMessage() {}
+
+func (x *GetEntityTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_audit_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntityTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetEntityTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_audit_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetEntityTimelineRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *GetEntityTimelineRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+type GetEntityTimelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`      // Activity on the entity, oldest first
+	Truncated     bool                   `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"` // Older entries were left out
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntityTimelineResponse) Reset() {
+	*x = GetEntityTimelineResponse{}
+	mi := &file_darta_v1_audit_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntityTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntityTimelineResponse) ProtoMessage() {}
+
+func (x *GetEntityTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_audit_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntityTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetEntityTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_audit_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetEntityTimelineResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetEntityTimelineResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+var File_darta_v1_audit_proto protoreflect.FileDescriptor
+
+const file_darta_v1_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x14darta/v1/audit.proto\x12\bdarta.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15darta/v1/common.proto\"w\n" +
+	"\x11AuditChainProblem\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tchain_seq\x18\x02 \x01(\x03R\bchainSeq\x12\x19\n" +
+	"\bentry_id\x18\x03 \x01(\tR\aentryId\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\"\xb4\x01\n" +
+	"\vAuditAnchor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tchain_seq\x18\x02 \x01(\x03R\bchainSeq\x12\x1d\n" +
+	"\n" +
+	"entry_hash\x18\x03 \x01(\tR\tentryHash\x12;\n" +
+	"\vanchored_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"anchoredAt\x12\x1c\n" +
+	"\tstatement\x18\x05 \x01(\tR\tstatement\"\x19\n" +
+	"\x17VerifyAuditChainRequest\"\xa8\x02\n" +
+	"\x18VerifyAuditChainResponse\x12\x16\n" +
+	"\x06intact\x18\x01 \x01(\bR\x06intact\x12)\n" +
+	"\x10entries_verified\x18\x02 \x01(\x03R\x0fentriesVerified\x12\x19\n" +
+	"\bhead_seq\x18\x03 \x01(\x03R\aheadSeq\x12\x1b\n" +
+	"\thead_hash\x18\x04 \x01(\tR\bheadHash\x12)\n" +
+	"\x10anchors_verified\x18\x05 \x01(\x05R\x0fanchorsVerified\x127\n" +
+	"\bproblems\x18\x06 \x03(\v2\x1b.darta.v1.AuditChainProblemR\bproblems\x12-\n" +
+	"\x12problems_truncated\x18\a \x01(\bR\x11problemsTruncated\"u\n" +
+	"\x17ListAuditAnchorsRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"K\n" +
+	"\x18ListAuditAnchorsResponse\x12/\n" +
+	"\aanchors\x18\x01 \x03(\v2\x15.darta.v1.AuditAnchorR\aanchors\"\xa2\x02\n" +
+	"\x10AuditEntryFilter\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\x12!\n" +
+	"\fperformed_by\x18\x03 \x01(\tR\vperformedBy\x12\x18\n" +
+	"\aactions\x18\x04 \x03(\tR\aactions\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12.\n" +
+	"\x04from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1b\n" +
+	"\ttenant_id\x18\b \x01(\tR\btenantId\"\x88\x01\n" +
+	"\x17ListAuditEntriesRequest\x122\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1a.darta.v1.AuditEntryFilterR\x06filter\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.darta.v1.PaginationInputR\n" +
+	"pagination\"R\n" +
+	"\x0eAuditEntryEdge\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12(\n" +
+	"\x04node\x18\x02 \x01(\v2\x14.darta.v1.AuditEntryR\x04node\"w\n" +
+	"\x14AuditEntryConnection\x12.\n" +
+	"\x05edges\x18\x01 \x03(\v2\x18.darta.v1.AuditEntryEdgeR\x05edges\x12/\n" +
+	"\tpage_info\x18\x02 \x01(\v2\x12.darta.v1.PageInfoR\bpageInfo\"Z\n" +
+	"\x18ListAuditEntriesResponse\x12>\n" +
+	"\n" +
+	"connection\x18\x01 \x01(\v2\x1e.darta.v1.AuditEntryConnectionR\n" +
+	"connection\"X\n" +
+	"\x18GetEntityTimelineRequest\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\"i\n" +
+	"\x19GetEntityTimelineResponse\x12.\n" +
+	"\aentries\x18\x01 \x03(\v2\x14.darta.v1.AuditEntryR\aentries\x12\x1c\n" +
+	"\ttruncated\x18\x02 \x01(\bR\ttruncated2\xfd\x02\n" +
+	"\fAuditService\x12Y\n" +
+	"\x10ListAuditEntries\x12!.darta.v1.ListAuditEntriesRequest\x1a\".darta.v1.ListAuditEntriesResponse\x12\\\n" +
+	"\x11GetEntityTimeline\x12\".darta.v1.GetEntityTimelineRequest\x1a#.darta.v1.GetEntityTimelineResponse\x12Y\n" +
+	"\x10VerifyAuditChain\x12!.darta.v1.VerifyAuditChainRequest\x1a\".darta.v1.VerifyAuditChainResponse\x12Y\n" +
+	"\x10ListAuditAnchors\x12!.darta.v1.ListAuditAnchorsRequest\x1a\".darta.v1.ListAuditAnchorsResponseB9Z7git.ninjainfosys.com/ePalika/proto/gen/darta/v1;dartav1b\x06proto3"
+
+var (
+	file_darta_v1_audit_proto_rawDescOnce sync.Once
+	file_darta_v1_audit_proto_rawDescData []byte
+)
+
+func file_darta_v1_audit_proto_rawDescGZIP() []byte {
+	file_darta_v1_audit_proto_rawDescOnce.Do(func() {
+		file_darta_v1_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_darta_v1_audit_proto_rawDesc), len(file_darta_v1_audit_proto_rawDesc)))
+	})
+	return file_darta_v1_audit_proto_rawDescData
+}
+
+var file_darta_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_darta_v1_audit_proto_goTypes = []any{
+	(*AuditChainProblem)(nil),         // 0: darta.v1.AuditChainProblem
+	(*AuditAnchor)(nil),               // 1: darta.v1.AuditAnchor
+	(*VerifyAuditChainRequest)(nil),   // 2: darta.v1.VerifyAuditChainRequest
+	(*VerifyAuditChainResponse)(nil),  // 3: darta.v1.VerifyAuditChainResponse
+	(*ListAuditAnchorsRequest)(nil),   // 4: darta.v1.ListAuditAnchorsRequest
+	(*ListAuditAnchorsResponse)(nil),  // 5: darta.v1.ListAuditAnchorsResponse
+	(*AuditEntryFilter)(nil),          // 6: darta.v1.AuditEntryFilter
+	(*ListAuditEntriesRequest)(nil),   // 7: darta.v1.ListAuditEntriesRequest
+	(*AuditEntryEdge)(nil),            // 8: darta.v1.AuditEntryEdge
+	(*AuditEntryConnection)(nil),      // 9: darta.v1.AuditEntryConnection
+	(*ListAuditEntriesResponse)(nil),  // 10: darta.v1.ListAuditEntriesResponse
+	(*GetEntityTimelineRequest)(nil),  // 11: darta.v1.GetEntityTimelineRequest
+	(*GetEntityTimelineResponse)(nil), // 12: darta.v1.GetEntityTimelineResponse
+	(*timestamppb.Timestamp)(nil),     // 13: google.protobuf.Timestamp
+	(*PaginationInput)(nil),           // 14: darta.v1.PaginationInput
+	(*AuditEntry)(nil),                // 15: darta.v1.AuditEntry
+	(*PageInfo)(nil),                  // 16: darta.v1.PageInfo
+}
+var file_darta_v1_audit_proto_depIdxs = []int32{
+	13, // 0: darta.v1.AuditAnchor.anchored_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: darta.v1.VerifyAuditChainResponse.problems:type_name -> darta.v1.AuditChainProblem
+	13, // 2: darta.v1.ListAuditAnchorsRequest.from:type_name -> google.protobuf.Timestamp
+	13, // 3: darta.v1.ListAuditAnchorsRequest.to:type_name -> google.protobuf.Timestamp
+	1,  // 4: darta.v1.ListAuditAnchorsResponse.anchors:type_name -> darta.v1.AuditAnchor
+	13, // 5: darta.v1.AuditEntryFilter.from:type_name -> google.protobuf.Timestamp
+	13, // 6: darta.v1.AuditEntryFilter.to:type_name -> google.protobuf.Timestamp
+	6,  // 7: darta.v1.ListAuditEntriesRequest.filter:type_name -> darta.v1.AuditEntryFilter
+	14, // 8: darta.v1.ListAuditEntriesRequest.pagination:type_name -> darta.v1.PaginationInput
+	15, // 9: darta.v1.AuditEntryEdge.node:type_name -> darta.v1.AuditEntry
+	8,  // 10: darta.v1.AuditEntryConnection.edges:type_name -> darta.v1.AuditEntryEdge
+	16, // 11: darta.v1.AuditEntryConnection.page_info:type_name -> darta.v1.PageInfo
+	9,  // 12: darta.v1.ListAuditEntriesResponse.connection:type_name -> darta.v1.AuditEntryConnection
+	15, // 13: darta.v1.GetEntityTimelineResponse.entries:type_name -> darta.v1.AuditEntry
+	7,  // 14: darta.v1.AuditService.ListAuditEntries:input_type -> darta.v1.ListAuditEntriesRequest
+	11, // 15: darta.v1.AuditService.GetEntityTimeline:input_type -> darta.v1.GetEntityTimelineRequest
+	2,  // 16: darta.v1.AuditService.VerifyAuditChain:input_type -> darta.v1.VerifyAuditChainRequest
+	4,  // 17: darta.v1.AuditService.ListAuditAnchors:input_type -> darta.v1.ListAuditAnchorsRequest
+	10, // 18: darta.v1.AuditService.ListAuditEntries:output_type -> darta.v1.ListAuditEntriesResponse
+	12, // 19: darta.v1.AuditService.GetEntityTimeline:output_type -> darta.v1.GetEntityTimelineResponse
+	3,  // 20: darta.v1.AuditService.VerifyAuditChain:output_type -> darta.v1.VerifyAuditChainResponse
+	5,  // 21: darta.v1.AuditService.ListAuditAnchors:output_type -> darta.v1.ListAuditAnchorsResponse
+	18, // [18:22] is the sub-list for method output_type
+	14, // [14:18] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_darta_v1_audit_proto_init() }
+func file_darta_v1_audit_proto_init() {
+	if File_darta_v1_audit_proto != nil {
+		return
+	}
+	file_darta_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_darta_v1_audit_proto_rawDesc), len(file_darta_v1_audit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_darta_v1_audit_proto_goTypes,
+		DependencyIndexes: file_darta_v1_audit_proto_depIdxs,
+		MessageInfos:      file_darta_v1_audit_proto_msgTypes,
+	}.Build()
+	File_darta_v1_audit_proto = out.File
+	file_darta_v1_audit_proto_goTypes = nil
+	file_darta_v1_audit_proto_depIdxs = nil
+}
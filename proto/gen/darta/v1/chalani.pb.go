@@ -258,6 +258,7 @@ type Chalani struct {
 	SupersededById         string                 `protobuf:"bytes,32,opt,name=superseded_by_id,json=supersededById,proto3" json:"superseded_by_id,omitempty"`
 	SupersedesId           string                 `protobuf:"bytes,33,opt,name=supersedes_id,json=supersedesId,proto3" json:"supersedes_id,omitempty"`
 	TenantId               string                 `protobuf:"bytes,34,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Version                int64                  `protobuf:"varint,35,opt,name=version,proto3" json:"version,omitempty"` // Bumped by every change
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -530,6 +531,13 @@ func (x *Chalani) GetTenantId() string {
 	return ""
 }
 
+func (x *Chalani) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // Signatory represents a required signatory for approval
 type Signatory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1697,6 +1705,7 @@ type ApproveChalaniInput struct {
 	Decision          ApprovalDecision       `protobuf:"varint,2,opt,name=decision,proto3,enum=darta.v1.ApprovalDecision" json:"decision,omitempty"`
 	Notes             string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
 	DelegatedToUserId string                 `protobuf:"bytes,4,opt,name=delegated_to_user_id,json=delegatedToUserId,proto3" json:"delegated_to_user_id,omitempty"` // If delegating
+	ExpectedVersion   int64                  `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`          // 0 applies to whatever version is current
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -1759,13 +1768,21 @@ func (x *ApproveChalaniInput) GetDelegatedToUserId() string {
 	return ""
 }
 
+func (x *ApproveChalaniInput) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 // ReserveChalaniNumberInput
 type ReserveChalaniNumberInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChalaniId     string                 `protobuf:"bytes,1,opt,name=chalani_id,json=chalaniId,proto3" json:"chalani_id,omitempty"`
-	AllocationId  string                 `protobuf:"bytes,2,opt,name=allocation_id,json=allocationId,proto3" json:"allocation_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChalaniId       string                 `protobuf:"bytes,1,opt,name=chalani_id,json=chalaniId,proto3" json:"chalani_id,omitempty"`
+	AllocationId    string                 `protobuf:"bytes,2,opt,name=allocation_id,json=allocationId,proto3" json:"allocation_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ReserveChalaniNumberInput) Reset() {
@@ -1812,6 +1829,13 @@ func (x *ReserveChalaniNumberInput) GetAllocationId() string {
 	return ""
 }
 
+func (x *ReserveChalaniNumberInput) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 // FinalizeChalaniRegistrationInput
 type FinalizeChalaniRegistrationInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2024,6 +2048,7 @@ type DispatchChalaniInput struct {
 	TrackingId      string                 `protobuf:"bytes,3,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
 	CourierName     string                 `protobuf:"bytes,4,opt,name=courier_name,json=courierName,proto3" json:"courier_name,omitempty"`
 	Notes           string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -2093,6 +2118,13 @@ func (x *DispatchChalaniInput) GetNotes() string {
 	return ""
 }
 
+func (x *DispatchChalaniInput) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 // MarkInTransitInput
 type MarkInTransitInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2221,6 +2253,7 @@ type MarkDeliveredInput struct {
 	ChalaniId        string                 `protobuf:"bytes,1,opt,name=chalani_id,json=chalaniId,proto3" json:"chalani_id,omitempty"`
 	DeliveredProofId string                 `protobuf:"bytes,2,opt,name=delivered_proof_id,json=deliveredProofId,proto3" json:"delivered_proof_id,omitempty"`
 	Notes            string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	ExpectedVersion  int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -2276,6 +2309,13 @@ func (x *MarkDeliveredInput) GetNotes() string {
 	return ""
 }
 
+func (x *MarkDeliveredInput) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 // MarkReturnedUndeliveredInput
 type MarkReturnedUndeliveredInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2400,11 +2440,12 @@ func (x *ResendChalaniInput) GetNotes() string {
 
 // VoidChalaniInput
 type VoidChalaniInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChalaniId     string                 `protobuf:"bytes,1,opt,name=chalani_id,json=chalaniId,proto3" json:"chalani_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChalaniId       string                 `protobuf:"bytes,1,opt,name=chalani_id,json=chalaniId,proto3" json:"chalani_id,omitempty"`
+	Reason          string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *VoidChalaniInput) Reset() {
@@ -2451,6 +2492,13 @@ func (x *VoidChalaniInput) GetReason() string {
 	return ""
 }
 
+func (x *VoidChalaniInput) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 // SupersedeChalaniInput
 type SupersedeChalaniInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3315,10 +3363,11 @@ func (x *CreateChalaniResponse) GetChalani() *Chalani {
 }
 
 type SubmitChalaniRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChalaniId     string                 `protobuf:"bytes,1,opt,name=chalani_id,json=chalaniId,proto3" json:"chalani_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChalaniId       string                 `protobuf:"bytes,1,opt,name=chalani_id,json=chalaniId,proto3" json:"chalani_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *SubmitChalaniRequest) Reset() {
@@ -3358,6 +3407,13 @@ func (x *SubmitChalaniRequest) GetChalaniId() string {
 	return ""
 }
 
+func (x *SubmitChalaniRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type SubmitChalaniResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Chalani       *Chalani               `protobuf:"bytes,1,opt,name=chalani,proto3" json:"chalani,omitempty"`
@@ -4810,11 +4866,149 @@ func (x *CloseChalaniResponse) GetChalani() *Chalani {
 	return nil
 }
 
+type WatchChalanisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChalaniId     string                 `protobuf:"bytes,1,opt,name=chalani_id,json=chalaniId,proto3" json:"chalani_id,omitempty"`           // Only events for this chalani
+	DispatchOnly  bool                   `protobuf:"varint,2,opt,name=dispatch_only,json=dispatchOnly,proto3" json:"dispatch_only,omitempty"` // Only events for chalanis in the dispatch and delivery stages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchChalanisRequest) Reset() {
+	*x = WatchChalanisRequest{}
+	mi := &file_darta_v1_chalani_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchChalanisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchChalanisRequest) ProtoMessage() {}
+
+func (x *WatchChalanisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_chalani_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchChalanisRequest.ProtoReflect.Descriptor instead.
+func (*WatchChalanisRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_chalani_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *WatchChalanisRequest) GetChalaniId() string {
+	if x != nil {
+		return x.ChalaniId
+	}
+	return ""
+}
+
+func (x *WatchChalanisRequest) GetDispatchOnly() bool {
+	if x != nil {
+		return x.DispatchOnly
+	}
+	return false
+}
+
+// ChalaniEvent is emitted after every successful chalani mutation, and when
+// the SLA evaluator marks a breach or escalates one
+type ChalaniEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`   // RPC that produced the event, e.g. "DispatchChalani", or SLA_BREACHED / SLA_ESCALATED
+	Chalani       *Chalani               `protobuf:"bytes,2,opt,name=chalani,proto3" json:"chalani,omitempty"` // State after the mutation
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	SlaStage      string                 `protobuf:"bytes,5,opt,name=sla_stage,json=slaStage,proto3" json:"sla_stage,omitempty"`                  // Stage of an SLA_BREACHED or SLA_ESCALATED event
+	EscalatedToId string                 `protobuf:"bytes,6,opt,name=escalated_to_id,json=escalatedToId,proto3" json:"escalated_to_id,omitempty"` // Unit head an SLA_ESCALATED event went to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChalaniEvent) Reset() {
+	*x = ChalaniEvent{}
+	mi := &file_darta_v1_chalani_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChalaniEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChalaniEvent) ProtoMessage() {}
+
+func (x *ChalaniEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_chalani_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChalaniEvent.ProtoReflect.Descriptor instead.
+func (*ChalaniEvent) Descriptor() ([]byte, []int) {
+	return file_darta_v1_chalani_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ChalaniEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ChalaniEvent) GetChalani() *Chalani {
+	if x != nil {
+		return x.Chalani
+	}
+	return nil
+}
+
+func (x *ChalaniEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ChalaniEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *ChalaniEvent) GetSlaStage() string {
+	if x != nil {
+		return x.SlaStage
+	}
+	return ""
+}
+
+func (x *ChalaniEvent) GetEscalatedToId() string {
+	if x != nil {
+		return x.EscalatedToId
+	}
+	return ""
+}
+
 var File_darta_v1_chalani_proto protoreflect.FileDescriptor
 
 const file_darta_v1_chalani_proto_rawDesc = "" +
 	"\n" +
-	"\x16darta/v1/chalani.proto\x12\bdarta.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x15darta/v1/common.proto\"\xdd\f\n" +
+	"\x16darta/v1/chalani.proto\x12\bdarta.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x15darta/v1/common.proto\"\xf7\f\n" +
 	"\aChalani\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
 	"\x0echalani_number\x18\x02 \x01(\x05R\rchalaniNumber\x128\n" +
@@ -4857,7 +5051,8 @@ const file_darta_v1_chalani_proto_rawDesc = "" +
 	"auditTrail\x12(\n" +
 	"\x10superseded_by_id\x18  \x01(\tR\x0esupersededById\x12#\n" +
 	"\rsupersedes_id\x18! \x01(\tR\fsupersedesId\x12\x1b\n" +
-	"\ttenant_id\x18\" \x01(\tR\btenantId\"\x9a\x01\n" +
+	"\ttenant_id\x18\" \x01(\tR\btenantId\x12\x18\n" +
+	"\aversion\x18# \x01(\x03R\aversion\"\x9a\x01\n" +
 	"\tSignatory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
 	"\x04user\x18\x02 \x01(\v2\x0e.darta.v1.UserR\x04user\x12\"\n" +
@@ -4958,17 +5153,19 @@ const file_darta_v1_chalani_proto_rawDesc = "" +
 	"\n" +
 	"chalani_id\x18\x01 \x01(\tR\tchalaniId\x12\x14\n" +
 	"\x05notes\x18\x02 \x01(\tR\x05notes\x12\x1a\n" +
-	"\bapproved\x18\x03 \x01(\bR\bapproved\"\xb3\x01\n" +
+	"\bapproved\x18\x03 \x01(\bR\bapproved\"\xde\x01\n" +
 	"\x13ApproveChalaniInput\x12\x1d\n" +
 	"\n" +
 	"chalani_id\x18\x01 \x01(\tR\tchalaniId\x126\n" +
 	"\bdecision\x18\x02 \x01(\x0e2\x1a.darta.v1.ApprovalDecisionR\bdecision\x12\x14\n" +
 	"\x05notes\x18\x03 \x01(\tR\x05notes\x12/\n" +
-	"\x14delegated_to_user_id\x18\x04 \x01(\tR\x11delegatedToUserId\"_\n" +
+	"\x14delegated_to_user_id\x18\x04 \x01(\tR\x11delegatedToUserId\x12)\n" +
+	"\x10expected_version\x18\x05 \x01(\x03R\x0fexpectedVersion\"\x8a\x01\n" +
 	"\x19ReserveChalaniNumberInput\x12\x1d\n" +
 	"\n" +
 	"chalani_id\x18\x01 \x01(\tR\tchalaniId\x12#\n" +
-	"\rallocation_id\x18\x02 \x01(\tR\fallocationId\"f\n" +
+	"\rallocation_id\x18\x02 \x01(\tR\fallocationId\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"f\n" +
 	" FinalizeChalaniRegistrationInput\x12\x1d\n" +
 	"\n" +
 	"chalani_id\x18\x01 \x01(\tR\tchalaniId\x12#\n" +
@@ -4983,7 +5180,7 @@ const file_darta_v1_chalani_proto_rawDesc = "" +
 	"\x10SealChalaniInput\x12\x1d\n" +
 	"\n" +
 	"chalani_id\x18\x01 \x01(\tR\tchalaniId\x12,\n" +
-	"\x12seal_attachment_id\x18\x02 \x01(\tR\x10sealAttachmentId\"\xd5\x01\n" +
+	"\x12seal_attachment_id\x18\x02 \x01(\tR\x10sealAttachmentId\"\x80\x02\n" +
 	"\x14DispatchChalaniInput\x12\x1d\n" +
 	"\n" +
 	"chalani_id\x18\x01 \x01(\tR\tchalaniId\x12D\n" +
@@ -4991,7 +5188,8 @@ const file_darta_v1_chalani_proto_rawDesc = "" +
 	"\vtracking_id\x18\x03 \x01(\tR\n" +
 	"trackingId\x12!\n" +
 	"\fcourier_name\x18\x04 \x01(\tR\vcourierName\x12\x14\n" +
-	"\x05notes\x18\x05 \x01(\tR\x05notes\"e\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\x12)\n" +
+	"\x10expected_version\x18\x06 \x01(\x03R\x0fexpectedVersion\"e\n" +
 	"\x12MarkInTransitInput\x12\x1d\n" +
 	"\n" +
 	"chalani_id\x18\x01 \x01(\tR\tchalaniId\x12\x1a\n" +
@@ -5001,12 +5199,13 @@ const file_darta_v1_chalani_proto_rawDesc = "" +
 	"\n" +
 	"chalani_id\x18\x01 \x01(\tR\tchalaniId\x12'\n" +
 	"\x0facknowledged_by\x18\x02 \x01(\tR\x0eacknowledgedBy\x128\n" +
-	"\x18acknowledgement_proof_id\x18\x03 \x01(\tR\x16acknowledgementProofId\"w\n" +
+	"\x18acknowledgement_proof_id\x18\x03 \x01(\tR\x16acknowledgementProofId\"\xa2\x01\n" +
 	"\x12MarkDeliveredInput\x12\x1d\n" +
 	"\n" +
 	"chalani_id\x18\x01 \x01(\tR\tchalaniId\x12,\n" +
 	"\x12delivered_proof_id\x18\x02 \x01(\tR\x10deliveredProofId\x12\x14\n" +
-	"\x05notes\x18\x03 \x01(\tR\x05notes\"U\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\"U\n" +
 	"\x1cMarkReturnedUndeliveredInput\x12\x1d\n" +
 	"\n" +
 	"chalani_id\x18\x01 \x01(\tR\tchalaniId\x12\x16\n" +
@@ -5016,11 +5215,12 @@ const file_darta_v1_chalani_proto_rawDesc = "" +
 	"chalani_id\x18\x01 \x01(\tR\tchalaniId\x12K\n" +
 	"\x14new_dispatch_channel\x18\x02 \x01(\x0e2\x19.darta.v1.DispatchChannelR\x12newDispatchChannel\x12=\n" +
 	"\rnew_recipient\x18\x03 \x01(\v2\x18.darta.v1.RecipientInputR\fnewRecipient\x12\x14\n" +
-	"\x05notes\x18\x04 \x01(\tR\x05notes\"I\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\"t\n" +
 	"\x10VoidChalaniInput\x12\x1d\n" +
 	"\n" +
 	"chalani_id\x18\x01 \x01(\tR\tchalaniId\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x8d\x01\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"\x8d\x01\n" +
 	"\x15SupersedeChalaniInput\x12\x1d\n" +
 	"\n" +
 	"chalani_id\x18\x01 \x01(\tR\tchalaniId\x12\x16\n" +
@@ -5081,10 +5281,11 @@ const file_darta_v1_chalani_proto_rawDesc = "" +
 	"\x14CreateChalaniRequest\x122\n" +
 	"\x05input\x18\x01 \x01(\v2\x1c.darta.v1.CreateChalaniInputR\x05input\"D\n" +
 	"\x15CreateChalaniResponse\x12+\n" +
-	"\achalani\x18\x01 \x01(\v2\x11.darta.v1.ChalaniR\achalani\"5\n" +
+	"\achalani\x18\x01 \x01(\v2\x11.darta.v1.ChalaniR\achalani\"`\n" +
 	"\x14SubmitChalaniRequest\x12\x1d\n" +
 	"\n" +
-	"chalani_id\x18\x01 \x01(\tR\tchalaniId\"D\n" +
+	"chalani_id\x18\x01 \x01(\tR\tchalaniId\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"D\n" +
 	"\x15SubmitChalaniResponse\x12+\n" +
 	"\achalani\x18\x01 \x01(\v2\x11.darta.v1.ChalaniR\achalani\"J\n" +
 	"\x14ReviewChalaniRequest\x122\n" +
@@ -5151,7 +5352,19 @@ const file_darta_v1_chalani_proto_rawDesc = "" +
 	"\n" +
 	"chalani_id\x18\x01 \x01(\tR\tchalaniId\"C\n" +
 	"\x14CloseChalaniResponse\x12+\n" +
-	"\achalani\x18\x01 \x01(\v2\x11.darta.v1.ChalaniR\achalani*\xa2\x04\n" +
+	"\achalani\x18\x01 \x01(\v2\x11.darta.v1.ChalaniR\achalani\"Z\n" +
+	"\x14WatchChalanisRequest\x12\x1d\n" +
+	"\n" +
+	"chalani_id\x18\x01 \x01(\tR\tchalaniId\x12#\n" +
+	"\rdispatch_only\x18\x02 \x01(\bR\fdispatchOnly\"\xf0\x01\n" +
+	"\fChalaniEvent\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12+\n" +
+	"\achalani\x18\x02 \x01(\v2\x11.darta.v1.ChalaniR\achalani\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x1b\n" +
+	"\tsla_stage\x18\x05 \x01(\tR\bslaStage\x12&\n" +
+	"\x0fescalated_to_id\x18\x06 \x01(\tR\rescalatedToId*\xa2\x04\n" +
 	"\rChalaniStatus\x12\x1e\n" +
 	"\x1aCHALANI_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14CHALANI_STATUS_DRAFT\x10\x01\x12!\n" +
@@ -5181,7 +5394,7 @@ const file_darta_v1_chalani_proto_rawDesc = "" +
 	"\x1dAPPROVAL_DECISION_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aAPPROVAL_DECISION_APPROVED\x10\x01\x12\x1e\n" +
 	"\x1aAPPROVAL_DECISION_REJECTED\x10\x02\x12\x1f\n" +
-	"\x1bAPPROVAL_DECISION_DELEGATED\x10\x032\xc9\x12\n" +
+	"\x1bAPPROVAL_DECISION_DELEGATED\x10\x032\x94\x13\n" +
 	"\x0eChalaniService\x12G\n" +
 	"\n" +
 	"GetChalani\x12\x1b.darta.v1.GetChalaniRequest\x1a\x1c.darta.v1.GetChalaniResponse\x12_\n" +
@@ -5208,7 +5421,8 @@ const file_darta_v1_chalani_proto_rawDesc = "" +
 	"\rResendChalani\x12\x1e.darta.v1.ResendChalaniRequest\x1a\x1f.darta.v1.ResendChalaniResponse\x12J\n" +
 	"\vVoidChalani\x12\x1c.darta.v1.VoidChalaniRequest\x1a\x1d.darta.v1.VoidChalaniResponse\x12Y\n" +
 	"\x10SupersedeChalani\x12!.darta.v1.SupersedeChalaniRequest\x1a\".darta.v1.SupersedeChalaniResponse\x12M\n" +
-	"\fCloseChalani\x12\x1d.darta.v1.CloseChalaniRequest\x1a\x1e.darta.v1.CloseChalaniResponse\x12J\n" +
+	"\fCloseChalani\x12\x1d.darta.v1.CloseChalaniRequest\x1a\x1e.darta.v1.CloseChalaniResponse\x12I\n" +
+	"\rWatchChalanis\x12\x1e.darta.v1.WatchChalanisRequest\x1a\x16.darta.v1.ChalaniEvent0\x01\x12J\n" +
 	"\vHealthCheck\x12\x1c.darta.v1.HealthCheckRequest\x1a\x1d.darta.v1.HealthCheckResponseB9Z7git.ninjainfosys.com/ePalika/proto/gen/darta/v1;dartav1b\x06proto3"
 
 var (
@@ -5224,7 +5438,7 @@ func file_darta_v1_chalani_proto_rawDescGZIP() []byte {
 }
 
 var file_darta_v1_chalani_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_darta_v1_chalani_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
+var file_darta_v1_chalani_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
 var file_darta_v1_chalani_proto_goTypes = []any{
 	(ChalaniStatus)(0),                             // 0: darta.v1.ChalaniStatus
 	(RecipientType)(0),                             // 1: darta.v1.RecipientType
@@ -5309,83 +5523,85 @@ var file_darta_v1_chalani_proto_goTypes = []any{
 	(*SupersedeChalaniResponse)(nil),               // 80: darta.v1.SupersedeChalaniResponse
 	(*CloseChalaniRequest)(nil),                    // 81: darta.v1.CloseChalaniRequest
 	(*CloseChalaniResponse)(nil),                   // 82: darta.v1.CloseChalaniResponse
-	(*FiscalYear)(nil),                             // 83: darta.v1.FiscalYear
-	(Scope)(0),                                     // 84: darta.v1.Scope
-	(*Ward)(nil),                                   // 85: darta.v1.Ward
-	(*Attachment)(nil),                             // 86: darta.v1.Attachment
-	(DispatchChannel)(0),                           // 87: darta.v1.DispatchChannel
-	(*timestamppb.Timestamp)(nil),                  // 88: google.protobuf.Timestamp
-	(*User)(nil),                                   // 89: darta.v1.User
-	(*AuditEntry)(nil),                             // 90: darta.v1.AuditEntry
-	(*Role)(nil),                                   // 91: darta.v1.Role
-	(*PageInfo)(nil),                               // 92: darta.v1.PageInfo
-	(*PaginationInput)(nil),                        // 93: darta.v1.PaginationInput
-	(*HealthCheckRequest)(nil),                     // 94: darta.v1.HealthCheckRequest
-	(*HealthCheckResponse)(nil),                    // 95: darta.v1.HealthCheckResponse
+	(*WatchChalanisRequest)(nil),                   // 83: darta.v1.WatchChalanisRequest
+	(*ChalaniEvent)(nil),                           // 84: darta.v1.ChalaniEvent
+	(*FiscalYear)(nil),                             // 85: darta.v1.FiscalYear
+	(Scope)(0),                                     // 86: darta.v1.Scope
+	(*Ward)(nil),                                   // 87: darta.v1.Ward
+	(*Attachment)(nil),                             // 88: darta.v1.Attachment
+	(DispatchChannel)(0),                           // 89: darta.v1.DispatchChannel
+	(*timestamppb.Timestamp)(nil),                  // 90: google.protobuf.Timestamp
+	(*User)(nil),                                   // 91: darta.v1.User
+	(*AuditEntry)(nil),                             // 92: darta.v1.AuditEntry
+	(*Role)(nil),                                   // 93: darta.v1.Role
+	(*PageInfo)(nil),                               // 94: darta.v1.PageInfo
+	(*PaginationInput)(nil),                        // 95: darta.v1.PaginationInput
+	(*HealthCheckRequest)(nil),                     // 96: darta.v1.HealthCheckRequest
+	(*HealthCheckResponse)(nil),                    // 97: darta.v1.HealthCheckResponse
 }
 var file_darta_v1_chalani_proto_depIdxs = []int32{
-	83,  // 0: darta.v1.Chalani.fiscal_year:type_name -> darta.v1.FiscalYear
-	84,  // 1: darta.v1.Chalani.scope:type_name -> darta.v1.Scope
-	85,  // 2: darta.v1.Chalani.ward:type_name -> darta.v1.Ward
-	86,  // 3: darta.v1.Chalani.attachments:type_name -> darta.v1.Attachment
+	85,  // 0: darta.v1.Chalani.fiscal_year:type_name -> darta.v1.FiscalYear
+	86,  // 1: darta.v1.Chalani.scope:type_name -> darta.v1.Scope
+	87,  // 2: darta.v1.Chalani.ward:type_name -> darta.v1.Ward
+	88,  // 3: darta.v1.Chalani.attachments:type_name -> darta.v1.Attachment
 	0,   // 4: darta.v1.Chalani.status:type_name -> darta.v1.ChalaniStatus
 	4,   // 5: darta.v1.Chalani.required_signatories:type_name -> darta.v1.Signatory
 	5,   // 6: darta.v1.Chalani.approvals:type_name -> darta.v1.Approval
-	87,  // 7: darta.v1.Chalani.dispatch_channel:type_name -> darta.v1.DispatchChannel
+	89,  // 7: darta.v1.Chalani.dispatch_channel:type_name -> darta.v1.DispatchChannel
 	6,   // 8: darta.v1.Chalani.recipient:type_name -> darta.v1.Recipient
-	88,  // 9: darta.v1.Chalani.dispatched_at:type_name -> google.protobuf.Timestamp
-	89,  // 10: darta.v1.Chalani.dispatched_by:type_name -> darta.v1.User
-	88,  // 11: darta.v1.Chalani.acknowledged_at:type_name -> google.protobuf.Timestamp
-	86,  // 12: darta.v1.Chalani.acknowledgement_proof:type_name -> darta.v1.Attachment
-	88,  // 13: darta.v1.Chalani.delivered_at:type_name -> google.protobuf.Timestamp
-	86,  // 14: darta.v1.Chalani.delivered_proof:type_name -> darta.v1.Attachment
-	89,  // 15: darta.v1.Chalani.created_by:type_name -> darta.v1.User
-	88,  // 16: darta.v1.Chalani.created_at:type_name -> google.protobuf.Timestamp
-	88,  // 17: darta.v1.Chalani.updated_at:type_name -> google.protobuf.Timestamp
-	90,  // 18: darta.v1.Chalani.audit_trail:type_name -> darta.v1.AuditEntry
-	89,  // 19: darta.v1.Signatory.user:type_name -> darta.v1.User
-	91,  // 20: darta.v1.Signatory.role:type_name -> darta.v1.Role
+	90,  // 9: darta.v1.Chalani.dispatched_at:type_name -> google.protobuf.Timestamp
+	91,  // 10: darta.v1.Chalani.dispatched_by:type_name -> darta.v1.User
+	90,  // 11: darta.v1.Chalani.acknowledged_at:type_name -> google.protobuf.Timestamp
+	88,  // 12: darta.v1.Chalani.acknowledgement_proof:type_name -> darta.v1.Attachment
+	90,  // 13: darta.v1.Chalani.delivered_at:type_name -> google.protobuf.Timestamp
+	88,  // 14: darta.v1.Chalani.delivered_proof:type_name -> darta.v1.Attachment
+	91,  // 15: darta.v1.Chalani.created_by:type_name -> darta.v1.User
+	90,  // 16: darta.v1.Chalani.created_at:type_name -> google.protobuf.Timestamp
+	90,  // 17: darta.v1.Chalani.updated_at:type_name -> google.protobuf.Timestamp
+	92,  // 18: darta.v1.Chalani.audit_trail:type_name -> darta.v1.AuditEntry
+	91,  // 19: darta.v1.Signatory.user:type_name -> darta.v1.User
+	93,  // 20: darta.v1.Signatory.role:type_name -> darta.v1.Role
 	4,   // 21: darta.v1.Approval.signatory:type_name -> darta.v1.Signatory
 	2,   // 22: darta.v1.Approval.decision:type_name -> darta.v1.ApprovalDecision
-	88,  // 23: darta.v1.Approval.approved_at:type_name -> google.protobuf.Timestamp
+	90,  // 23: darta.v1.Approval.approved_at:type_name -> google.protobuf.Timestamp
 	1,   // 24: darta.v1.Recipient.type:type_name -> darta.v1.RecipientType
 	8,   // 25: darta.v1.ChalaniConnection.edges:type_name -> darta.v1.ChalaniEdge
-	92,  // 26: darta.v1.ChalaniConnection.page_info:type_name -> darta.v1.PageInfo
+	94,  // 26: darta.v1.ChalaniConnection.page_info:type_name -> darta.v1.PageInfo
 	3,   // 27: darta.v1.ChalaniEdge.node:type_name -> darta.v1.Chalani
 	10,  // 28: darta.v1.ChalaniStats.by_status:type_name -> darta.v1.ChalaniStatusCount
 	11,  // 29: darta.v1.ChalaniStats.by_channel:type_name -> darta.v1.DispatchChannelCount
 	0,   // 30: darta.v1.ChalaniStatusCount.status:type_name -> darta.v1.ChalaniStatus
-	87,  // 31: darta.v1.DispatchChannelCount.channel:type_name -> darta.v1.DispatchChannel
+	89,  // 31: darta.v1.DispatchChannelCount.channel:type_name -> darta.v1.DispatchChannel
 	3,   // 32: darta.v1.SupersedeChalaniResult.old:type_name -> darta.v1.Chalani
 	3,   // 33: darta.v1.SupersedeChalaniResult.new:type_name -> darta.v1.Chalani
-	88,  // 34: darta.v1.ChalaniTemplate.created_at:type_name -> google.protobuf.Timestamp
-	88,  // 35: darta.v1.ChalaniTemplate.updated_at:type_name -> google.protobuf.Timestamp
-	84,  // 36: darta.v1.ChalaniFilterInput.scope:type_name -> darta.v1.Scope
+	90,  // 34: darta.v1.ChalaniTemplate.created_at:type_name -> google.protobuf.Timestamp
+	90,  // 35: darta.v1.ChalaniTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	86,  // 36: darta.v1.ChalaniFilterInput.scope:type_name -> darta.v1.Scope
 	0,   // 37: darta.v1.ChalaniFilterInput.status:type_name -> darta.v1.ChalaniStatus
-	87,  // 38: darta.v1.ChalaniFilterInput.dispatch_channel:type_name -> darta.v1.DispatchChannel
-	88,  // 39: darta.v1.ChalaniFilterInput.from_date:type_name -> google.protobuf.Timestamp
-	88,  // 40: darta.v1.ChalaniFilterInput.to_date:type_name -> google.protobuf.Timestamp
+	89,  // 38: darta.v1.ChalaniFilterInput.dispatch_channel:type_name -> darta.v1.DispatchChannel
+	90,  // 39: darta.v1.ChalaniFilterInput.from_date:type_name -> google.protobuf.Timestamp
+	90,  // 40: darta.v1.ChalaniFilterInput.to_date:type_name -> google.protobuf.Timestamp
 	1,   // 41: darta.v1.RecipientInput.type:type_name -> darta.v1.RecipientType
-	84,  // 42: darta.v1.CreateChalaniInput.scope:type_name -> darta.v1.Scope
+	86,  // 42: darta.v1.CreateChalaniInput.scope:type_name -> darta.v1.Scope
 	15,  // 43: darta.v1.CreateChalaniInput.recipient:type_name -> darta.v1.RecipientInput
 	16,  // 44: darta.v1.CreateChalaniInput.signatories:type_name -> darta.v1.SignatoryInput
 	2,   // 45: darta.v1.ApproveChalaniInput.decision:type_name -> darta.v1.ApprovalDecision
-	87,  // 46: darta.v1.DispatchChalaniInput.dispatch_channel:type_name -> darta.v1.DispatchChannel
-	87,  // 47: darta.v1.ResendChalaniInput.new_dispatch_channel:type_name -> darta.v1.DispatchChannel
+	89,  // 46: darta.v1.DispatchChalaniInput.dispatch_channel:type_name -> darta.v1.DispatchChannel
+	89,  // 47: darta.v1.ResendChalaniInput.new_dispatch_channel:type_name -> darta.v1.DispatchChannel
 	15,  // 48: darta.v1.ResendChalaniInput.new_recipient:type_name -> darta.v1.RecipientInput
 	17,  // 49: darta.v1.SupersedeChalaniInput.new_chalani:type_name -> darta.v1.CreateChalaniInput
 	3,   // 50: darta.v1.GetChalaniResponse.chalani:type_name -> darta.v1.Chalani
-	84,  // 51: darta.v1.GetChalaniByNumberRequest.scope:type_name -> darta.v1.Scope
+	86,  // 51: darta.v1.GetChalaniByNumberRequest.scope:type_name -> darta.v1.Scope
 	3,   // 52: darta.v1.GetChalaniByNumberResponse.chalani:type_name -> darta.v1.Chalani
 	14,  // 53: darta.v1.ListChalanisRequest.filter:type_name -> darta.v1.ChalaniFilterInput
-	93,  // 54: darta.v1.ListChalanisRequest.pagination:type_name -> darta.v1.PaginationInput
+	95,  // 54: darta.v1.ListChalanisRequest.pagination:type_name -> darta.v1.PaginationInput
 	7,   // 55: darta.v1.ListChalanisResponse.connection:type_name -> darta.v1.ChalaniConnection
 	0,   // 56: darta.v1.GetMyChalaniRequest.status:type_name -> darta.v1.ChalaniStatus
-	93,  // 57: darta.v1.GetMyChalaniRequest.pagination:type_name -> darta.v1.PaginationInput
+	95,  // 57: darta.v1.GetMyChalaniRequest.pagination:type_name -> darta.v1.PaginationInput
 	7,   // 58: darta.v1.GetMyChalaniResponse.connection:type_name -> darta.v1.ChalaniConnection
-	84,  // 59: darta.v1.GetChalaniStatsRequest.scope:type_name -> darta.v1.Scope
+	86,  // 59: darta.v1.GetChalaniStatsRequest.scope:type_name -> darta.v1.Scope
 	9,   // 60: darta.v1.GetChalaniStatsResponse.stats:type_name -> darta.v1.ChalaniStats
-	93,  // 61: darta.v1.ListChalaniTemplatesRequest.pagination:type_name -> darta.v1.PaginationInput
+	95,  // 61: darta.v1.ListChalaniTemplatesRequest.pagination:type_name -> darta.v1.PaginationInput
 	13,  // 62: darta.v1.ListChalaniTemplatesResponse.templates:type_name -> darta.v1.ChalaniTemplate
 	13,  // 63: darta.v1.GetChalaniTemplateResponse.template:type_name -> darta.v1.ChalaniTemplate
 	17,  // 64: darta.v1.CreateChalaniRequest.input:type_name -> darta.v1.CreateChalaniInput
@@ -5422,63 +5638,67 @@ var file_darta_v1_chalani_proto_depIdxs = []int32{
 	32,  // 95: darta.v1.SupersedeChalaniRequest.input:type_name -> darta.v1.SupersedeChalaniInput
 	12,  // 96: darta.v1.SupersedeChalaniResponse.result:type_name -> darta.v1.SupersedeChalaniResult
 	3,   // 97: darta.v1.CloseChalaniResponse.chalani:type_name -> darta.v1.Chalani
-	33,  // 98: darta.v1.ChalaniService.GetChalani:input_type -> darta.v1.GetChalaniRequest
-	35,  // 99: darta.v1.ChalaniService.GetChalaniByNumber:input_type -> darta.v1.GetChalaniByNumberRequest
-	37,  // 100: darta.v1.ChalaniService.ListChalanis:input_type -> darta.v1.ListChalanisRequest
-	39,  // 101: darta.v1.ChalaniService.GetMyChalani:input_type -> darta.v1.GetMyChalaniRequest
-	41,  // 102: darta.v1.ChalaniService.GetChalaniStats:input_type -> darta.v1.GetChalaniStatsRequest
-	43,  // 103: darta.v1.ChalaniService.ListChalaniTemplates:input_type -> darta.v1.ListChalaniTemplatesRequest
-	45,  // 104: darta.v1.ChalaniService.GetChalaniTemplate:input_type -> darta.v1.GetChalaniTemplateRequest
-	47,  // 105: darta.v1.ChalaniService.CreateChalani:input_type -> darta.v1.CreateChalaniRequest
-	49,  // 106: darta.v1.ChalaniService.SubmitChalani:input_type -> darta.v1.SubmitChalaniRequest
-	51,  // 107: darta.v1.ChalaniService.ReviewChalani:input_type -> darta.v1.ReviewChalaniRequest
-	53,  // 108: darta.v1.ChalaniService.ApproveChalani:input_type -> darta.v1.ApproveChalaniRequest
-	55,  // 109: darta.v1.ChalaniService.ReserveChalaniNumber:input_type -> darta.v1.ReserveChalaniNumberRequest
-	57,  // 110: darta.v1.ChalaniService.FinalizeChalaniRegistration:input_type -> darta.v1.FinalizeChalaniRegistrationRequest
-	59,  // 111: darta.v1.ChalaniService.DirectRegisterChalani:input_type -> darta.v1.DirectRegisterChalaniRequest
-	61,  // 112: darta.v1.ChalaniService.SignChalani:input_type -> darta.v1.SignChalaniRequest
-	63,  // 113: darta.v1.ChalaniService.SealChalani:input_type -> darta.v1.SealChalaniRequest
-	65,  // 114: darta.v1.ChalaniService.DispatchChalani:input_type -> darta.v1.DispatchChalaniRequest
-	67,  // 115: darta.v1.ChalaniService.MarkChalaniInTransit:input_type -> darta.v1.MarkChalaniInTransitRequest
-	69,  // 116: darta.v1.ChalaniService.AcknowledgeChalani:input_type -> darta.v1.AcknowledgeChalaniRequest
-	71,  // 117: darta.v1.ChalaniService.MarkChalaniDelivered:input_type -> darta.v1.MarkChalaniDeliveredRequest
-	73,  // 118: darta.v1.ChalaniService.MarkChalaniReturnedUndelivered:input_type -> darta.v1.MarkChalaniReturnedUndeliveredRequest
-	75,  // 119: darta.v1.ChalaniService.ResendChalani:input_type -> darta.v1.ResendChalaniRequest
-	77,  // 120: darta.v1.ChalaniService.VoidChalani:input_type -> darta.v1.VoidChalaniRequest
-	79,  // 121: darta.v1.ChalaniService.SupersedeChalani:input_type -> darta.v1.SupersedeChalaniRequest
-	81,  // 122: darta.v1.ChalaniService.CloseChalani:input_type -> darta.v1.CloseChalaniRequest
-	94,  // 123: darta.v1.ChalaniService.HealthCheck:input_type -> darta.v1.HealthCheckRequest
-	34,  // 124: darta.v1.ChalaniService.GetChalani:output_type -> darta.v1.GetChalaniResponse
-	36,  // 125: darta.v1.ChalaniService.GetChalaniByNumber:output_type -> darta.v1.GetChalaniByNumberResponse
-	38,  // 126: darta.v1.ChalaniService.ListChalanis:output_type -> darta.v1.ListChalanisResponse
-	40,  // 127: darta.v1.ChalaniService.GetMyChalani:output_type -> darta.v1.GetMyChalaniResponse
-	42,  // 128: darta.v1.ChalaniService.GetChalaniStats:output_type -> darta.v1.GetChalaniStatsResponse
-	44,  // 129: darta.v1.ChalaniService.ListChalaniTemplates:output_type -> darta.v1.ListChalaniTemplatesResponse
-	46,  // 130: darta.v1.ChalaniService.GetChalaniTemplate:output_type -> darta.v1.GetChalaniTemplateResponse
-	48,  // 131: darta.v1.ChalaniService.CreateChalani:output_type -> darta.v1.CreateChalaniResponse
-	50,  // 132: darta.v1.ChalaniService.SubmitChalani:output_type -> darta.v1.SubmitChalaniResponse
-	52,  // 133: darta.v1.ChalaniService.ReviewChalani:output_type -> darta.v1.ReviewChalaniResponse
-	54,  // 134: darta.v1.ChalaniService.ApproveChalani:output_type -> darta.v1.ApproveChalaniResponse
-	56,  // 135: darta.v1.ChalaniService.ReserveChalaniNumber:output_type -> darta.v1.ReserveChalaniNumberResponse
-	58,  // 136: darta.v1.ChalaniService.FinalizeChalaniRegistration:output_type -> darta.v1.FinalizeChalaniRegistrationResponse
-	60,  // 137: darta.v1.ChalaniService.DirectRegisterChalani:output_type -> darta.v1.DirectRegisterChalaniResponse
-	62,  // 138: darta.v1.ChalaniService.SignChalani:output_type -> darta.v1.SignChalaniResponse
-	64,  // 139: darta.v1.ChalaniService.SealChalani:output_type -> darta.v1.SealChalaniResponse
-	66,  // 140: darta.v1.ChalaniService.DispatchChalani:output_type -> darta.v1.DispatchChalaniResponse
-	68,  // 141: darta.v1.ChalaniService.MarkChalaniInTransit:output_type -> darta.v1.MarkChalaniInTransitResponse
-	70,  // 142: darta.v1.ChalaniService.AcknowledgeChalani:output_type -> darta.v1.AcknowledgeChalaniResponse
-	72,  // 143: darta.v1.ChalaniService.MarkChalaniDelivered:output_type -> darta.v1.MarkChalaniDeliveredResponse
-	74,  // 144: darta.v1.ChalaniService.MarkChalaniReturnedUndelivered:output_type -> darta.v1.MarkChalaniReturnedUndeliveredResponse
-	76,  // 145: darta.v1.ChalaniService.ResendChalani:output_type -> darta.v1.ResendChalaniResponse
-	78,  // 146: darta.v1.ChalaniService.VoidChalani:output_type -> darta.v1.VoidChalaniResponse
-	80,  // 147: darta.v1.ChalaniService.SupersedeChalani:output_type -> darta.v1.SupersedeChalaniResponse
-	82,  // 148: darta.v1.ChalaniService.CloseChalani:output_type -> darta.v1.CloseChalaniResponse
-	95,  // 149: darta.v1.ChalaniService.HealthCheck:output_type -> darta.v1.HealthCheckResponse
-	124, // [124:150] is the sub-list for method output_type
-	98,  // [98:124] is the sub-list for method input_type
-	98,  // [98:98] is the sub-list for extension type_name
-	98,  // [98:98] is the sub-list for extension extendee
-	0,   // [0:98] is the sub-list for field type_name
+	3,   // 98: darta.v1.ChalaniEvent.chalani:type_name -> darta.v1.Chalani
+	90,  // 99: darta.v1.ChalaniEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	33,  // 100: darta.v1.ChalaniService.GetChalani:input_type -> darta.v1.GetChalaniRequest
+	35,  // 101: darta.v1.ChalaniService.GetChalaniByNumber:input_type -> darta.v1.GetChalaniByNumberRequest
+	37,  // 102: darta.v1.ChalaniService.ListChalanis:input_type -> darta.v1.ListChalanisRequest
+	39,  // 103: darta.v1.ChalaniService.GetMyChalani:input_type -> darta.v1.GetMyChalaniRequest
+	41,  // 104: darta.v1.ChalaniService.GetChalaniStats:input_type -> darta.v1.GetChalaniStatsRequest
+	43,  // 105: darta.v1.ChalaniService.ListChalaniTemplates:input_type -> darta.v1.ListChalaniTemplatesRequest
+	45,  // 106: darta.v1.ChalaniService.GetChalaniTemplate:input_type -> darta.v1.GetChalaniTemplateRequest
+	47,  // 107: darta.v1.ChalaniService.CreateChalani:input_type -> darta.v1.CreateChalaniRequest
+	49,  // 108: darta.v1.ChalaniService.SubmitChalani:input_type -> darta.v1.SubmitChalaniRequest
+	51,  // 109: darta.v1.ChalaniService.ReviewChalani:input_type -> darta.v1.ReviewChalaniRequest
+	53,  // 110: darta.v1.ChalaniService.ApproveChalani:input_type -> darta.v1.ApproveChalaniRequest
+	55,  // 111: darta.v1.ChalaniService.ReserveChalaniNumber:input_type -> darta.v1.ReserveChalaniNumberRequest
+	57,  // 112: darta.v1.ChalaniService.FinalizeChalaniRegistration:input_type -> darta.v1.FinalizeChalaniRegistrationRequest
+	59,  // 113: darta.v1.ChalaniService.DirectRegisterChalani:input_type -> darta.v1.DirectRegisterChalaniRequest
+	61,  // 114: darta.v1.ChalaniService.SignChalani:input_type -> darta.v1.SignChalaniRequest
+	63,  // 115: darta.v1.ChalaniService.SealChalani:input_type -> darta.v1.SealChalaniRequest
+	65,  // 116: darta.v1.ChalaniService.DispatchChalani:input_type -> darta.v1.DispatchChalaniRequest
+	67,  // 117: darta.v1.ChalaniService.MarkChalaniInTransit:input_type -> darta.v1.MarkChalaniInTransitRequest
+	69,  // 118: darta.v1.ChalaniService.AcknowledgeChalani:input_type -> darta.v1.AcknowledgeChalaniRequest
+	71,  // 119: darta.v1.ChalaniService.MarkChalaniDelivered:input_type -> darta.v1.MarkChalaniDeliveredRequest
+	73,  // 120: darta.v1.ChalaniService.MarkChalaniReturnedUndelivered:input_type -> darta.v1.MarkChalaniReturnedUndeliveredRequest
+	75,  // 121: darta.v1.ChalaniService.ResendChalani:input_type -> darta.v1.ResendChalaniRequest
+	77,  // 122: darta.v1.ChalaniService.VoidChalani:input_type -> darta.v1.VoidChalaniRequest
+	79,  // 123: darta.v1.ChalaniService.SupersedeChalani:input_type -> darta.v1.SupersedeChalaniRequest
+	81,  // 124: darta.v1.ChalaniService.CloseChalani:input_type -> darta.v1.CloseChalaniRequest
+	83,  // 125: darta.v1.ChalaniService.WatchChalanis:input_type -> darta.v1.WatchChalanisRequest
+	96,  // 126: darta.v1.ChalaniService.HealthCheck:input_type -> darta.v1.HealthCheckRequest
+	34,  // 127: darta.v1.ChalaniService.GetChalani:output_type -> darta.v1.GetChalaniResponse
+	36,  // 128: darta.v1.ChalaniService.GetChalaniByNumber:output_type -> darta.v1.GetChalaniByNumberResponse
+	38,  // 129: darta.v1.ChalaniService.ListChalanis:output_type -> darta.v1.ListChalanisResponse
+	40,  // 130: darta.v1.ChalaniService.GetMyChalani:output_type -> darta.v1.GetMyChalaniResponse
+	42,  // 131: darta.v1.ChalaniService.GetChalaniStats:output_type -> darta.v1.GetChalaniStatsResponse
+	44,  // 132: darta.v1.ChalaniService.ListChalaniTemplates:output_type -> darta.v1.ListChalaniTemplatesResponse
+	46,  // 133: darta.v1.ChalaniService.GetChalaniTemplate:output_type -> darta.v1.GetChalaniTemplateResponse
+	48,  // 134: darta.v1.ChalaniService.CreateChalani:output_type -> darta.v1.CreateChalaniResponse
+	50,  // 135: darta.v1.ChalaniService.SubmitChalani:output_type -> darta.v1.SubmitChalaniResponse
+	52,  // 136: darta.v1.ChalaniService.ReviewChalani:output_type -> darta.v1.ReviewChalaniResponse
+	54,  // 137: darta.v1.ChalaniService.ApproveChalani:output_type -> darta.v1.ApproveChalaniResponse
+	56,  // 138: darta.v1.ChalaniService.ReserveChalaniNumber:output_type -> darta.v1.ReserveChalaniNumberResponse
+	58,  // 139: darta.v1.ChalaniService.FinalizeChalaniRegistration:output_type -> darta.v1.FinalizeChalaniRegistrationResponse
+	60,  // 140: darta.v1.ChalaniService.DirectRegisterChalani:output_type -> darta.v1.DirectRegisterChalaniResponse
+	62,  // 141: darta.v1.ChalaniService.SignChalani:output_type -> darta.v1.SignChalaniResponse
+	64,  // 142: darta.v1.ChalaniService.SealChalani:output_type -> darta.v1.SealChalaniResponse
+	66,  // 143: darta.v1.ChalaniService.DispatchChalani:output_type -> darta.v1.DispatchChalaniResponse
+	68,  // 144: darta.v1.ChalaniService.MarkChalaniInTransit:output_type -> darta.v1.MarkChalaniInTransitResponse
+	70,  // 145: darta.v1.ChalaniService.AcknowledgeChalani:output_type -> darta.v1.AcknowledgeChalaniResponse
+	72,  // 146: darta.v1.ChalaniService.MarkChalaniDelivered:output_type -> darta.v1.MarkChalaniDeliveredResponse
+	74,  // 147: darta.v1.ChalaniService.MarkChalaniReturnedUndelivered:output_type -> darta.v1.MarkChalaniReturnedUndeliveredResponse
+	76,  // 148: darta.v1.ChalaniService.ResendChalani:output_type -> darta.v1.ResendChalaniResponse
+	78,  // 149: darta.v1.ChalaniService.VoidChalani:output_type -> darta.v1.VoidChalaniResponse
+	80,  // 150: darta.v1.ChalaniService.SupersedeChalani:output_type -> darta.v1.SupersedeChalaniResponse
+	82,  // 151: darta.v1.ChalaniService.CloseChalani:output_type -> darta.v1.CloseChalaniResponse
+	84,  // 152: darta.v1.ChalaniService.WatchChalanis:output_type -> darta.v1.ChalaniEvent
+	97,  // 153: darta.v1.ChalaniService.HealthCheck:output_type -> darta.v1.HealthCheckResponse
+	127, // [127:154] is the sub-list for method output_type
+	100, // [100:127] is the sub-list for method input_type
+	100, // [100:100] is the sub-list for extension type_name
+	100, // [100:100] is the sub-list for extension extendee
+	0,   // [0:100] is the sub-list for field type_name
 }
 
 func init() { file_darta_v1_chalani_proto_init() }
@@ -5493,7 +5713,7 @@ func file_darta_v1_chalani_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_darta_v1_chalani_proto_rawDesc), len(file_darta_v1_chalani_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   80,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -44,12 +44,19 @@ const (
 	ChalaniService_VoidChalani_FullMethodName                    = "/darta.v1.ChalaniService/VoidChalani"
 	ChalaniService_SupersedeChalani_FullMethodName               = "/darta.v1.ChalaniService/SupersedeChalani"
 	ChalaniService_CloseChalani_FullMethodName                   = "/darta.v1.ChalaniService/CloseChalani"
+	ChalaniService_WatchChalanis_FullMethodName                  = "/darta.v1.ChalaniService/WatchChalanis"
 	ChalaniService_HealthCheck_FullMethodName                    = "/darta.v1.ChalaniService/HealthCheck"
 )
 
 // ChalaniServiceClient is the client API for ChalaniService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Mutations that are implemented take an expected_version for optimistic
+// concurrency. The ones still returning UNIMPLEMENTED (review, finalize,
+// direct register, sign, seal, in transit, acknowledge, returned undelivered,
+// resend, supersede and close) have no expected_version yet; it is added to
+// their inputs when they are implemented.
 type ChalaniServiceClient interface {
 	// Query operations
 	GetChalani(ctx context.Context, in *GetChalaniRequest, opts ...grpc.CallOption) (*GetChalaniResponse, error)
@@ -82,6 +89,8 @@ type ChalaniServiceClient interface {
 	VoidChalani(ctx context.Context, in *VoidChalaniRequest, opts ...grpc.CallOption) (*VoidChalaniResponse, error)
 	SupersedeChalani(ctx context.Context, in *SupersedeChalaniRequest, opts ...grpc.CallOption) (*SupersedeChalaniResponse, error)
 	CloseChalani(ctx context.Context, in *CloseChalaniRequest, opts ...grpc.CallOption) (*CloseChalaniResponse, error)
+	// Streaming operations
+	WatchChalanis(ctx context.Context, in *WatchChalanisRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChalaniEvent], error)
 	// Health check
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
@@ -344,6 +353,25 @@ func (c *chalaniServiceClient) CloseChalani(ctx context.Context, in *CloseChalan
 	return out, nil
 }
 
+func (c *chalaniServiceClient) WatchChalanis(ctx context.Context, in *WatchChalanisRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChalaniEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ChalaniService_ServiceDesc.Streams[0], ChalaniService_WatchChalanis_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchChalanisRequest, ChalaniEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChalaniService_WatchChalanisClient = grpc.ServerStreamingClient[ChalaniEvent]
+
 func (c *chalaniServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthCheckResponse)
@@ -357,6 +385,12 @@ func (c *chalaniServiceClient) HealthCheck(ctx context.Context, in *HealthCheckR
 // ChalaniServiceServer is the server API for ChalaniService service.
 // All implementations must embed UnimplementedChalaniServiceServer
 // for forward compatibility.
+//
+// Mutations that are implemented take an expected_version for optimistic
+// concurrency. The ones still returning UNIMPLEMENTED (review, finalize,
+// direct register, sign, seal, in transit, acknowledge, returned undelivered,
+// resend, supersede and close) have no expected_version yet; it is added to
+// their inputs when they are implemented.
 type ChalaniServiceServer interface {
 	// Query operations
 	GetChalani(context.Context, *GetChalaniRequest) (*GetChalaniResponse, error)
@@ -389,6 +423,8 @@ type ChalaniServiceServer interface {
 	VoidChalani(context.Context, *VoidChalaniRequest) (*VoidChalaniResponse, error)
 	SupersedeChalani(context.Context, *SupersedeChalaniRequest) (*SupersedeChalaniResponse, error)
 	CloseChalani(context.Context, *CloseChalaniRequest) (*CloseChalaniResponse, error)
+	// Streaming operations
+	WatchChalanis(*WatchChalanisRequest, grpc.ServerStreamingServer[ChalaniEvent]) error
 	// Health check
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
 	mustEmbedUnimplementedChalaniServiceServer()
@@ -476,6 +512,9 @@ func (UnimplementedChalaniServiceServer) SupersedeChalani(context.Context, *Supe
 func (UnimplementedChalaniServiceServer) CloseChalani(context.Context, *CloseChalaniRequest) (*CloseChalaniResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseChalani not implemented")
 }
+func (UnimplementedChalaniServiceServer) WatchChalanis(*WatchChalanisRequest, grpc.ServerStreamingServer[ChalaniEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchChalanis not implemented")
+}
 func (UnimplementedChalaniServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -950,6 +989,17 @@ func _ChalaniService_CloseChalani_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChalaniService_WatchChalanis_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchChalanisRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChalaniServiceServer).WatchChalanis(m, &grpc.GenericServerStream[WatchChalanisRequest, ChalaniEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChalaniService_WatchChalanisServer = grpc.ServerStreamingServer[ChalaniEvent]
+
 func _ChalaniService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HealthCheckRequest)
 	if err := dec(in); err != nil {
@@ -1080,6 +1130,12 @@ var ChalaniService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ChalaniService_HealthCheck_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchChalanis",
+			Handler:       _ChalaniService_WatchChalanis_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "darta/v1/chalani.proto",
 }
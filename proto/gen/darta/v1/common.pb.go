@@ -831,6 +831,11 @@ type AuditEntry struct {
 	IpAddress       string                 `protobuf:"bytes,9,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
 	UserAgent       string                 `protobuf:"bytes,10,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
 	Notes           string                 `protobuf:"bytes,11,opt,name=notes,proto3" json:"notes,omitempty"`
+	Diff            []*AuditFieldChange    `protobuf:"bytes,12,rep,name=diff,proto3" json:"diff,omitempty"` // Changes as before/after values
+	RequestId       string                 `protobuf:"bytes,13,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	TraceId         string                 `protobuf:"bytes,14,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	ActingRole      string                 `protobuf:"bytes,15,opt,name=acting_role,json=actingRole,proto3" json:"acting_role,omitempty"` // Role the action was authorized under
+	DecisionId      string                 `protobuf:"bytes,16,opt,name=decision_id,json=decisionId,proto3" json:"decision_id,omitempty"` // PDP authorization decision
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -942,6 +947,103 @@ func (x *AuditEntry) GetNotes() string {
 	return ""
 }
 
+func (x *AuditEntry) GetDiff() []*AuditFieldChange {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActingRole() string {
+	if x != nil {
+		return x.ActingRole
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetDecisionId() string {
+	if x != nil {
+		return x.DecisionId
+	}
+	return ""
+}
+
+// AuditFieldChange is one field of an audit entry's changes. before is
+// unset for values recorded without their previous state.
+type AuditFieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before        *structpb.Value        `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         *structpb.Value        `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditFieldChange) Reset() {
+	*x = AuditFieldChange{}
+	mi := &file_darta_v1_common_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditFieldChange) ProtoMessage() {}
+
+func (x *AuditFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_common_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditFieldChange.ProtoReflect.Descriptor instead.
+func (*AuditFieldChange) Descriptor() ([]byte, []int) {
+	return file_darta_v1_common_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AuditFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AuditFieldChange) GetBefore() *structpb.Value {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *AuditFieldChange) GetAfter() *structpb.Value {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
 // PageInfo for cursor-based pagination
 type PageInfo struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -956,7 +1058,7 @@ type PageInfo struct {
 
 func (x *PageInfo) Reset() {
 	*x = PageInfo{}
-	mi := &file_darta_v1_common_proto_msgTypes[7]
+	mi := &file_darta_v1_common_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -968,7 +1070,7 @@ func (x *PageInfo) String() string {
 func (*PageInfo) ProtoMessage() {}
 
 func (x *PageInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_common_proto_msgTypes[7]
+	mi := &file_darta_v1_common_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,7 +1083,7 @@ func (x *PageInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageInfo.ProtoReflect.Descriptor instead.
 func (*PageInfo) Descriptor() ([]byte, []int) {
-	return file_darta_v1_common_proto_rawDescGZIP(), []int{7}
+	return file_darta_v1_common_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PageInfo) GetHasNextPage() bool {
@@ -1023,18 +1125,18 @@ func (x *PageInfo) GetTotalCount() int64 {
 type PaginationInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`                       // Max items per page
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`                     // Offset for offset-based pagination
-	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`                        // Cursor for cursor-based pagination
-	Before        string                 `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`                      // Cursor for backward pagination
-	SortBy        string                 `protobuf:"bytes,5,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`        // Field to sort by
-	SortDesc      bool                   `protobuf:"varint,6,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"` // Sort descending
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`                     // Deprecated: rejected, pages are addressed by cursor
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`                        // Opaque cursor; returns the page after it
+	Before        string                 `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`                      // Opaque cursor; returns the page before it
+	SortBy        string                 `protobuf:"bytes,5,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`        // Sort key, e.g. created_at; empty sorts newest first
+	SortDesc      bool                   `protobuf:"varint,6,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"` // Sort descending; applies when sort_by is set
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PaginationInput) Reset() {
 	*x = PaginationInput{}
-	mi := &file_darta_v1_common_proto_msgTypes[8]
+	mi := &file_darta_v1_common_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1046,7 +1148,7 @@ func (x *PaginationInput) String() string {
 func (*PaginationInput) ProtoMessage() {}
 
 func (x *PaginationInput) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_common_proto_msgTypes[8]
+	mi := &file_darta_v1_common_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1059,7 +1161,7 @@ func (x *PaginationInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaginationInput.ProtoReflect.Descriptor instead.
 func (*PaginationInput) Descriptor() ([]byte, []int) {
-	return file_darta_v1_common_proto_rawDescGZIP(), []int{8}
+	return file_darta_v1_common_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PaginationInput) GetLimit() int32 {
@@ -1115,7 +1217,7 @@ type DateTimeRange struct {
 
 func (x *DateTimeRange) Reset() {
 	*x = DateTimeRange{}
-	mi := &file_darta_v1_common_proto_msgTypes[9]
+	mi := &file_darta_v1_common_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1127,7 +1229,7 @@ func (x *DateTimeRange) String() string {
 func (*DateTimeRange) ProtoMessage() {}
 
 func (x *DateTimeRange) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_common_proto_msgTypes[9]
+	mi := &file_darta_v1_common_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,7 +1242,7 @@ func (x *DateTimeRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DateTimeRange.ProtoReflect.Descriptor instead.
 func (*DateTimeRange) Descriptor() ([]byte, []int) {
-	return file_darta_v1_common_proto_rawDescGZIP(), []int{9}
+	return file_darta_v1_common_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DateTimeRange) GetFrom() *timestamppb.Timestamp {
@@ -1170,7 +1272,7 @@ type ErrorDetail struct {
 
 func (x *ErrorDetail) Reset() {
 	*x = ErrorDetail{}
-	mi := &file_darta_v1_common_proto_msgTypes[10]
+	mi := &file_darta_v1_common_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1182,7 +1284,7 @@ func (x *ErrorDetail) String() string {
 func (*ErrorDetail) ProtoMessage() {}
 
 func (x *ErrorDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_common_proto_msgTypes[10]
+	mi := &file_darta_v1_common_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1195,7 +1297,7 @@ func (x *ErrorDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrorDetail.ProtoReflect.Descriptor instead.
 func (*ErrorDetail) Descriptor() ([]byte, []int) {
-	return file_darta_v1_common_proto_rawDescGZIP(), []int{10}
+	return file_darta_v1_common_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ErrorDetail) GetCode() string {
@@ -1242,7 +1344,7 @@ type OperationMetadata struct {
 
 func (x *OperationMetadata) Reset() {
 	*x = OperationMetadata{}
-	mi := &file_darta_v1_common_proto_msgTypes[11]
+	mi := &file_darta_v1_common_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1254,7 +1356,7 @@ func (x *OperationMetadata) String() string {
 func (*OperationMetadata) ProtoMessage() {}
 
 func (x *OperationMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_common_proto_msgTypes[11]
+	mi := &file_darta_v1_common_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1267,7 +1369,7 @@ func (x *OperationMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationMetadata.ProtoReflect.Descriptor instead.
 func (*OperationMetadata) Descriptor() ([]byte, []int) {
-	return file_darta_v1_common_proto_rawDescGZIP(), []int{11}
+	return file_darta_v1_common_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *OperationMetadata) GetRequestId() string {
@@ -1328,7 +1430,7 @@ type HealthCheckRequest struct {
 
 func (x *HealthCheckRequest) Reset() {
 	*x = HealthCheckRequest{}
-	mi := &file_darta_v1_common_proto_msgTypes[12]
+	mi := &file_darta_v1_common_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1340,7 +1442,7 @@ func (x *HealthCheckRequest) String() string {
 func (*HealthCheckRequest) ProtoMessage() {}
 
 func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_common_proto_msgTypes[12]
+	mi := &file_darta_v1_common_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1353,7 +1455,7 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
 func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_common_proto_rawDescGZIP(), []int{12}
+	return file_darta_v1_common_proto_rawDescGZIP(), []int{13}
 }
 
 // HealthCheckResponse for health check response
@@ -1368,7 +1470,7 @@ type HealthCheckResponse struct {
 
 func (x *HealthCheckResponse) Reset() {
 	*x = HealthCheckResponse{}
-	mi := &file_darta_v1_common_proto_msgTypes[13]
+	mi := &file_darta_v1_common_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1380,7 +1482,7 @@ func (x *HealthCheckResponse) String() string {
 func (*HealthCheckResponse) ProtoMessage() {}
 
 func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_common_proto_msgTypes[13]
+	mi := &file_darta_v1_common_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1393,7 +1495,7 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
 func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_common_proto_rawDescGZIP(), []int{13}
+	return file_darta_v1_common_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *HealthCheckResponse) GetStatus() string {
@@ -1483,7 +1585,7 @@ const file_darta_v1_common_proto_rawDesc = "" +
 	"\vuploaded_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"uploadedAt\x123\n" +
 	"\bmetadata\x18\n" +
-	" \x01(\v2\x17.google.protobuf.StructR\bmetadata\"\x97\x03\n" +
+	" \x01(\v2\x17.google.protobuf.StructR\bmetadata\"\xc3\x04\n" +
 	"\n" +
 	"AuditEntry\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
@@ -1500,7 +1602,19 @@ const file_darta_v1_common_proto_rawDesc = "" +
 	"\n" +
 	"user_agent\x18\n" +
 	" \x01(\tR\tuserAgent\x12\x14\n" +
-	"\x05notes\x18\v \x01(\tR\x05notes\"\xbd\x01\n" +
+	"\x05notes\x18\v \x01(\tR\x05notes\x12.\n" +
+	"\x04diff\x18\f \x03(\v2\x1a.darta.v1.AuditFieldChangeR\x04diff\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\r \x01(\tR\trequestId\x12\x19\n" +
+	"\btrace_id\x18\x0e \x01(\tR\atraceId\x12\x1f\n" +
+	"\vacting_role\x18\x0f \x01(\tR\n" +
+	"actingRole\x12\x1f\n" +
+	"\vdecision_id\x18\x10 \x01(\tR\n" +
+	"decisionId\"\x86\x01\n" +
+	"\x10AuditFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12.\n" +
+	"\x06before\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x06before\x12,\n" +
+	"\x05after\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x05after\"\xbd\x01\n" +
 	"\bPageInfo\x12\"\n" +
 	"\rhas_next_page\x18\x01 \x01(\bR\vhasNextPage\x12*\n" +
 	"\x11has_previous_page\x18\x02 \x01(\bR\x0fhasPreviousPage\x12!\n" +
@@ -1578,7 +1692,7 @@ func file_darta_v1_common_proto_rawDescGZIP() []byte {
 }
 
 var file_darta_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_darta_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_darta_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_darta_v1_common_proto_goTypes = []any{
 	(Scope)(0),                    // 0: darta.v1.Scope
 	(Priority)(0),                 // 1: darta.v1.Priority
@@ -1591,33 +1705,38 @@ var file_darta_v1_common_proto_goTypes = []any{
 	(*OrganizationalUnit)(nil),    // 8: darta.v1.OrganizationalUnit
 	(*Attachment)(nil),            // 9: darta.v1.Attachment
 	(*AuditEntry)(nil),            // 10: darta.v1.AuditEntry
-	(*PageInfo)(nil),              // 11: darta.v1.PageInfo
-	(*PaginationInput)(nil),       // 12: darta.v1.PaginationInput
-	(*DateTimeRange)(nil),         // 13: darta.v1.DateTimeRange
-	(*ErrorDetail)(nil),           // 14: darta.v1.ErrorDetail
-	(*OperationMetadata)(nil),     // 15: darta.v1.OperationMetadata
-	(*HealthCheckRequest)(nil),    // 16: darta.v1.HealthCheckRequest
-	(*HealthCheckResponse)(nil),   // 17: darta.v1.HealthCheckResponse
-	(*timestamppb.Timestamp)(nil), // 18: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),       // 19: google.protobuf.Struct
+	(*AuditFieldChange)(nil),      // 11: darta.v1.AuditFieldChange
+	(*PageInfo)(nil),              // 12: darta.v1.PageInfo
+	(*PaginationInput)(nil),       // 13: darta.v1.PaginationInput
+	(*DateTimeRange)(nil),         // 14: darta.v1.DateTimeRange
+	(*ErrorDetail)(nil),           // 15: darta.v1.ErrorDetail
+	(*OperationMetadata)(nil),     // 16: darta.v1.OperationMetadata
+	(*HealthCheckRequest)(nil),    // 17: darta.v1.HealthCheckRequest
+	(*HealthCheckResponse)(nil),   // 18: darta.v1.HealthCheckResponse
+	(*timestamppb.Timestamp)(nil), // 19: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 20: google.protobuf.Struct
+	(*structpb.Value)(nil),        // 21: google.protobuf.Value
 }
 var file_darta_v1_common_proto_depIdxs = []int32{
-	18, // 0: darta.v1.FiscalYear.start_date:type_name -> google.protobuf.Timestamp
-	18, // 1: darta.v1.FiscalYear.end_date:type_name -> google.protobuf.Timestamp
-	18, // 2: darta.v1.Attachment.uploaded_at:type_name -> google.protobuf.Timestamp
-	19, // 3: darta.v1.Attachment.metadata:type_name -> google.protobuf.Struct
+	19, // 0: darta.v1.FiscalYear.start_date:type_name -> google.protobuf.Timestamp
+	19, // 1: darta.v1.FiscalYear.end_date:type_name -> google.protobuf.Timestamp
+	19, // 2: darta.v1.Attachment.uploaded_at:type_name -> google.protobuf.Timestamp
+	20, // 3: darta.v1.Attachment.metadata:type_name -> google.protobuf.Struct
 	6,  // 4: darta.v1.AuditEntry.performed_by_user:type_name -> darta.v1.User
-	18, // 5: darta.v1.AuditEntry.performed_at:type_name -> google.protobuf.Timestamp
-	19, // 6: darta.v1.AuditEntry.changes:type_name -> google.protobuf.Struct
-	18, // 7: darta.v1.DateTimeRange.from:type_name -> google.protobuf.Timestamp
-	18, // 8: darta.v1.DateTimeRange.to:type_name -> google.protobuf.Timestamp
-	19, // 9: darta.v1.ErrorDetail.metadata:type_name -> google.protobuf.Struct
-	18, // 10: darta.v1.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	19, // 5: darta.v1.AuditEntry.performed_at:type_name -> google.protobuf.Timestamp
+	20, // 6: darta.v1.AuditEntry.changes:type_name -> google.protobuf.Struct
+	11, // 7: darta.v1.AuditEntry.diff:type_name -> darta.v1.AuditFieldChange
+	21, // 8: darta.v1.AuditFieldChange.before:type_name -> google.protobuf.Value
+	21, // 9: darta.v1.AuditFieldChange.after:type_name -> google.protobuf.Value
+	19, // 10: darta.v1.DateTimeRange.from:type_name -> google.protobuf.Timestamp
+	19, // 11: darta.v1.DateTimeRange.to:type_name -> google.protobuf.Timestamp
+	20, // 12: darta.v1.ErrorDetail.metadata:type_name -> google.protobuf.Struct
+	19, // 13: darta.v1.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_darta_v1_common_proto_init() }
@@ -1631,7 +1750,7 @@ func file_darta_v1_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_darta_v1_common_proto_rawDesc), len(file_darta_v1_common_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   14,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -227,41 +227,99 @@ func (DartaReviewDecision) EnumDescriptor() ([]byte, []int) {
 	return file_darta_v1_darta_proto_rawDescGZIP(), []int{2}
 }
 
+type StatsInterval int32
+
+const (
+	StatsInterval_STATS_INTERVAL_UNSPECIFIED StatsInterval = 0 // Daily
+	StatsInterval_STATS_INTERVAL_DAY         StatsInterval = 1
+	StatsInterval_STATS_INTERVAL_WEEK        StatsInterval = 2 // Sunday to Saturday
+	StatsInterval_STATS_INTERVAL_MONTH       StatsInterval = 3 // BS month
+)
+
+// Enum value maps for StatsInterval.
+var (
+	StatsInterval_name = map[int32]string{
+		0: "STATS_INTERVAL_UNSPECIFIED",
+		1: "STATS_INTERVAL_DAY",
+		2: "STATS_INTERVAL_WEEK",
+		3: "STATS_INTERVAL_MONTH",
+	}
+	StatsInterval_value = map[string]int32{
+		"STATS_INTERVAL_UNSPECIFIED": 0,
+		"STATS_INTERVAL_DAY":         1,
+		"STATS_INTERVAL_WEEK":        2,
+		"STATS_INTERVAL_MONTH":       3,
+	}
+)
+
+func (x StatsInterval) Enum() *StatsInterval {
+	p := new(StatsInterval)
+	*p = x
+	return p
+}
+
+func (x StatsInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_darta_v1_darta_proto_enumTypes[3].Descriptor()
+}
+
+func (StatsInterval) Type() protoreflect.EnumType {
+	return &file_darta_v1_darta_proto_enumTypes[3]
+}
+
+func (x StatsInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsInterval.Descriptor instead.
+func (StatsInterval) EnumDescriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{3}
+}
+
 // Darta represents an incoming correspondence record
 type Darta struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DartaNumber          int32                  `protobuf:"varint,2,opt,name=darta_number,json=dartaNumber,proto3" json:"darta_number,omitempty"`
-	FormattedDartaNumber string                 `protobuf:"bytes,3,opt,name=formatted_darta_number,json=formattedDartaNumber,proto3" json:"formatted_darta_number,omitempty"`
-	FiscalYear           *FiscalYear            `protobuf:"bytes,4,opt,name=fiscal_year,json=fiscalYear,proto3" json:"fiscal_year,omitempty"`
-	Scope                Scope                  `protobuf:"varint,5,opt,name=scope,proto3,enum=darta.v1.Scope" json:"scope,omitempty"`
-	Ward                 *Ward                  `protobuf:"bytes,6,opt,name=ward,proto3" json:"ward,omitempty"`
-	Subject              string                 `protobuf:"bytes,7,opt,name=subject,proto3" json:"subject,omitempty"`
-	Applicant            *Applicant             `protobuf:"bytes,8,opt,name=applicant,proto3" json:"applicant,omitempty"`
-	IntakeChannel        IntakeChannel          `protobuf:"varint,9,opt,name=intake_channel,json=intakeChannel,proto3,enum=darta.v1.IntakeChannel" json:"intake_channel,omitempty"`
-	ReceivedDate         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=received_date,json=receivedDate,proto3" json:"received_date,omitempty"`
-	EntryDate            *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=entry_date,json=entryDate,proto3" json:"entry_date,omitempty"`
-	IsBackdated          bool                   `protobuf:"varint,12,opt,name=is_backdated,json=isBackdated,proto3" json:"is_backdated,omitempty"`
-	BackdateReason       string                 `protobuf:"bytes,13,opt,name=backdate_reason,json=backdateReason,proto3" json:"backdate_reason,omitempty"`
-	BackdateApprover     *User                  `protobuf:"bytes,14,opt,name=backdate_approver,json=backdateApprover,proto3" json:"backdate_approver,omitempty"`
-	PrimaryDocument      *Attachment            `protobuf:"bytes,15,opt,name=primary_document,json=primaryDocument,proto3" json:"primary_document,omitempty"`
-	Annexes              []*Attachment          `protobuf:"bytes,16,rep,name=annexes,proto3" json:"annexes,omitempty"`
-	Status               DartaStatus            `protobuf:"varint,17,opt,name=status,proto3,enum=darta.v1.DartaStatus" json:"status,omitempty"`
-	Priority             Priority               `protobuf:"varint,18,opt,name=priority,proto3,enum=darta.v1.Priority" json:"priority,omitempty"`
-	ClassificationCode   string                 `protobuf:"bytes,19,opt,name=classification_code,json=classificationCode,proto3" json:"classification_code,omitempty"`
-	AssignedTo           *OrganizationalUnit    `protobuf:"bytes,20,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
-	CurrentAssignee      *User                  `protobuf:"bytes,21,opt,name=current_assignee,json=currentAssignee,proto3" json:"current_assignee,omitempty"`
-	SlaDeadline          *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=sla_deadline,json=slaDeadline,proto3" json:"sla_deadline,omitempty"`
-	IsOverdue            bool                   `protobuf:"varint,23,opt,name=is_overdue,json=isOverdue,proto3" json:"is_overdue,omitempty"`
-	CreatedBy            *User                  `protobuf:"bytes,24,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	AuditTrail           []*AuditEntry          `protobuf:"bytes,27,rep,name=audit_trail,json=auditTrail,proto3" json:"audit_trail,omitempty"`
-	ChalaniResponseIds   []string               `protobuf:"bytes,28,rep,name=chalani_response_ids,json=chalaniResponseIds,proto3" json:"chalani_response_ids,omitempty"` // Reference to chalani IDs
-	RelatedDartaIds      []string               `protobuf:"bytes,29,rep,name=related_darta_ids,json=relatedDartaIds,proto3" json:"related_darta_ids,omitempty"`          // Reference to related darta IDs
-	TenantId             string                 `protobuf:"bytes,30,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DartaNumber           int32                  `protobuf:"varint,2,opt,name=darta_number,json=dartaNumber,proto3" json:"darta_number,omitempty"`
+	FormattedDartaNumber  string                 `protobuf:"bytes,3,opt,name=formatted_darta_number,json=formattedDartaNumber,proto3" json:"formatted_darta_number,omitempty"`
+	FiscalYear            *FiscalYear            `protobuf:"bytes,4,opt,name=fiscal_year,json=fiscalYear,proto3" json:"fiscal_year,omitempty"`
+	Scope                 Scope                  `protobuf:"varint,5,opt,name=scope,proto3,enum=darta.v1.Scope" json:"scope,omitempty"`
+	Ward                  *Ward                  `protobuf:"bytes,6,opt,name=ward,proto3" json:"ward,omitempty"`
+	Subject               string                 `protobuf:"bytes,7,opt,name=subject,proto3" json:"subject,omitempty"`
+	Applicant             *Applicant             `protobuf:"bytes,8,opt,name=applicant,proto3" json:"applicant,omitempty"`
+	IntakeChannel         IntakeChannel          `protobuf:"varint,9,opt,name=intake_channel,json=intakeChannel,proto3,enum=darta.v1.IntakeChannel" json:"intake_channel,omitempty"`
+	ReceivedDate          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=received_date,json=receivedDate,proto3" json:"received_date,omitempty"`
+	EntryDate             *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=entry_date,json=entryDate,proto3" json:"entry_date,omitempty"`
+	IsBackdated           bool                   `protobuf:"varint,12,opt,name=is_backdated,json=isBackdated,proto3" json:"is_backdated,omitempty"`
+	BackdateReason        string                 `protobuf:"bytes,13,opt,name=backdate_reason,json=backdateReason,proto3" json:"backdate_reason,omitempty"`
+	BackdateApprover      *User                  `protobuf:"bytes,14,opt,name=backdate_approver,json=backdateApprover,proto3" json:"backdate_approver,omitempty"`
+	PrimaryDocument       *Attachment            `protobuf:"bytes,15,opt,name=primary_document,json=primaryDocument,proto3" json:"primary_document,omitempty"`
+	Annexes               []*Attachment          `protobuf:"bytes,16,rep,name=annexes,proto3" json:"annexes,omitempty"`
+	Status                DartaStatus            `protobuf:"varint,17,opt,name=status,proto3,enum=darta.v1.DartaStatus" json:"status,omitempty"`
+	Priority              Priority               `protobuf:"varint,18,opt,name=priority,proto3,enum=darta.v1.Priority" json:"priority,omitempty"`
+	ClassificationCode    string                 `protobuf:"bytes,19,opt,name=classification_code,json=classificationCode,proto3" json:"classification_code,omitempty"`
+	AssignedTo            *OrganizationalUnit    `protobuf:"bytes,20,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
+	CurrentAssignee       *User                  `protobuf:"bytes,21,opt,name=current_assignee,json=currentAssignee,proto3" json:"current_assignee,omitempty"`
+	SlaDeadline           *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=sla_deadline,json=slaDeadline,proto3" json:"sla_deadline,omitempty"`
+	IsOverdue             bool                   `protobuf:"varint,23,opt,name=is_overdue,json=isOverdue,proto3" json:"is_overdue,omitempty"`
+	CreatedBy             *User                  `protobuf:"bytes,24,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AuditTrail            []*AuditEntry          `protobuf:"bytes,27,rep,name=audit_trail,json=auditTrail,proto3" json:"audit_trail,omitempty"`
+	ChalaniResponseIds    []string               `protobuf:"bytes,28,rep,name=chalani_response_ids,json=chalaniResponseIds,proto3" json:"chalani_response_ids,omitempty"` // Reference to chalani IDs
+	RelatedDartaIds       []string               `protobuf:"bytes,29,rep,name=related_darta_ids,json=relatedDartaIds,proto3" json:"related_darta_ids,omitempty"`          // Reference to related darta IDs
+	TenantId              string                 `protobuf:"bytes,30,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ReceivedDateBs        string                 `protobuf:"bytes,31,opt,name=received_date_bs,json=receivedDateBs,proto3" json:"received_date_bs,omitempty"`                      // received_date in Bikram Sambat, e.g. "2081-04-01"
+	SupersededById        string                 `protobuf:"bytes,32,opt,name=superseded_by_id,json=supersededById,proto3" json:"superseded_by_id,omitempty"`                      // Darta that replaced this one
+	SupersedesId          string                 `protobuf:"bytes,33,opt,name=supersedes_id,json=supersedesId,proto3" json:"supersedes_id,omitempty"`                              // Darta this one replaced
+	SupersedesDartaNumber string                 `protobuf:"bytes,34,opt,name=supersedes_darta_number,json=supersedesDartaNumber,proto3" json:"supersedes_darta_number,omitempty"` // Formatted number of the darta this one replaced
+	Version               int64                  `protobuf:"varint,35,opt,name=version,proto3" json:"version,omitempty"`                                                           // Bumped by every change
+	Metadata              *structpb.Struct       `protobuf:"bytes,36,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *Darta) Reset() {
@@ -504,6 +562,48 @@ func (x *Darta) GetTenantId() string {
 	return ""
 }
 
+func (x *Darta) GetReceivedDateBs() string {
+	if x != nil {
+		return x.ReceivedDateBs
+	}
+	return ""
+}
+
+func (x *Darta) GetSupersededById() string {
+	if x != nil {
+		return x.SupersededById
+	}
+	return ""
+}
+
+func (x *Darta) GetSupersedesId() string {
+	if x != nil {
+		return x.SupersedesId
+	}
+	return ""
+}
+
+func (x *Darta) GetSupersedesDartaNumber() string {
+	if x != nil {
+		return x.SupersedesDartaNumber
+	}
+	return ""
+}
+
+func (x *Darta) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Darta) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 // Applicant represents the person/organization submitting the darta
 type Applicant struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
@@ -710,14 +810,22 @@ func (x *DartaEdge) GetNode() *Darta {
 	return nil
 }
 
-// DartaStats for analytics
+// DartaStats for analytics. Times in state are elapsed hours read from the
+// audit trail.
 type DartaStats struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
 	Total                  int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
 	ByStatus               []*DartaStatusCount    `protobuf:"bytes,2,rep,name=by_status,json=byStatus,proto3" json:"by_status,omitempty"`
 	ByChannel              []*ChannelCount        `protobuf:"bytes,3,rep,name=by_channel,json=byChannel,proto3" json:"by_channel,omitempty"`
 	OverdueCount           int32                  `protobuf:"varint,4,opt,name=overdue_count,json=overdueCount,proto3" json:"overdue_count,omitempty"`
-	AvgProcessingTimeHours float64                `protobuf:"fixed64,5,opt,name=avg_processing_time_hours,json=avgProcessingTimeHours,proto3" json:"avg_processing_time_hours,omitempty"`
+	AvgProcessingTimeHours float64                `protobuf:"fixed64,5,opt,name=avg_processing_time_hours,json=avgProcessingTimeHours,proto3" json:"avg_processing_time_hours,omitempty"` // Received to closed
+	ReceivedToRegistered   *DurationStats         `protobuf:"bytes,6,opt,name=received_to_registered,json=receivedToRegistered,proto3" json:"received_to_registered,omitempty"`
+	RegisteredToClosed     *DurationStats         `protobuf:"bytes,7,opt,name=registered_to_closed,json=registeredToClosed,proto3" json:"registered_to_closed,omitempty"`
+	ByWard                 []*DartaStatsBreakdown `protobuf:"bytes,8,rep,name=by_ward,json=byWard,proto3" json:"by_ward,omitempty"`
+	BySection              []*DartaStatsBreakdown `protobuf:"bytes,9,rep,name=by_section,json=bySection,proto3" json:"by_section,omitempty"` // By the section a darta is with now
+	ByIntakeChannel        []*DartaStatsBreakdown `protobuf:"bytes,10,rep,name=by_intake_channel,json=byIntakeChannel,proto3" json:"by_intake_channel,omitempty"`
+	ByPriority             []*DartaStatsBreakdown `protobuf:"bytes,11,rep,name=by_priority,json=byPriority,proto3" json:"by_priority,omitempty"`
+	Series                 []*DartaStatsBucket    `protobuf:"bytes,12,rep,name=series,proto3" json:"series,omitempty"`
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -787,81 +895,80 @@ func (x *DartaStats) GetAvgProcessingTimeHours() float64 {
 	return 0
 }
 
-type DartaStatusCount struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        DartaStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=darta.v1.DartaStatus" json:"status,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DartaStats) GetReceivedToRegistered() *DurationStats {
+	if x != nil {
+		return x.ReceivedToRegistered
+	}
+	return nil
 }
 
-func (x *DartaStatusCount) Reset() {
-	*x = DartaStatusCount{}
-	mi := &file_darta_v1_darta_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DartaStats) GetRegisteredToClosed() *DurationStats {
+	if x != nil {
+		return x.RegisteredToClosed
+	}
+	return nil
 }
 
-func (x *DartaStatusCount) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DartaStats) GetByWard() []*DartaStatsBreakdown {
+	if x != nil {
+		return x.ByWard
+	}
+	return nil
 }
 
-func (*DartaStatusCount) ProtoMessage() {}
-
-func (x *DartaStatusCount) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[5]
+func (x *DartaStats) GetBySection() []*DartaStatsBreakdown {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.BySection
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DartaStatusCount.ProtoReflect.Descriptor instead.
-func (*DartaStatusCount) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{5}
+func (x *DartaStats) GetByIntakeChannel() []*DartaStatsBreakdown {
+	if x != nil {
+		return x.ByIntakeChannel
+	}
+	return nil
 }
 
-func (x *DartaStatusCount) GetStatus() DartaStatus {
+func (x *DartaStats) GetByPriority() []*DartaStatsBreakdown {
 	if x != nil {
-		return x.Status
+		return x.ByPriority
 	}
-	return DartaStatus_DARTA_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *DartaStatusCount) GetCount() int32 {
+func (x *DartaStats) GetSeries() []*DartaStatsBucket {
 	if x != nil {
-		return x.Count
+		return x.Series
 	}
-	return 0
+	return nil
 }
 
-type ChannelCount struct {
+// DurationStats summarises how long a step took, in hours
+type DurationStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channel       IntakeChannel          `protobuf:"varint,1,opt,name=channel,proto3,enum=darta.v1.IntakeChannel" json:"channel,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"` // Dartas that completed the step
+	AvgHours      float64                `protobuf:"fixed64,2,opt,name=avg_hours,json=avgHours,proto3" json:"avg_hours,omitempty"`
+	P90Hours      float64                `protobuf:"fixed64,3,opt,name=p90_hours,json=p90Hours,proto3" json:"p90_hours,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChannelCount) Reset() {
-	*x = ChannelCount{}
-	mi := &file_darta_v1_darta_proto_msgTypes[6]
+func (x *DurationStats) Reset() {
+	*x = DurationStats{}
+	mi := &file_darta_v1_darta_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChannelCount) String() string {
+func (x *DurationStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelCount) ProtoMessage() {}
+func (*DurationStats) ProtoMessage() {}
 
-func (x *ChannelCount) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[6]
+func (x *DurationStats) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,60 +979,61 @@ func (x *ChannelCount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelCount.ProtoReflect.Descriptor instead.
-func (*ChannelCount) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use DurationStats.ProtoReflect.Descriptor instead.
+func (*DurationStats) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ChannelCount) GetChannel() IntakeChannel {
+func (x *DurationStats) GetCount() int32 {
 	if x != nil {
-		return x.Channel
+		return x.Count
 	}
-	return IntakeChannel_INTAKE_CHANNEL_UNSPECIFIED
+	return 0
 }
 
-func (x *ChannelCount) GetCount() int32 {
+func (x *DurationStats) GetAvgHours() float64 {
 	if x != nil {
-		return x.Count
+		return x.AvgHours
 	}
 	return 0
 }
 
-// DartaFilterInput for filtering darta list
-type DartaFilterInput struct {
+func (x *DurationStats) GetP90Hours() float64 {
+	if x != nil {
+		return x.P90Hours
+	}
+	return 0
+}
+
+// DartaStatsBreakdown is DartaStats for the dartas sharing one ward, section,
+// channel or priority
+type DartaStatsBreakdown struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
-	FiscalYearId         string                 `protobuf:"bytes,1,opt,name=fiscal_year_id,json=fiscalYearId,proto3" json:"fiscal_year_id,omitempty"`
-	Scope                Scope                  `protobuf:"varint,2,opt,name=scope,proto3,enum=darta.v1.Scope" json:"scope,omitempty"`
-	WardId               string                 `protobuf:"bytes,3,opt,name=ward_id,json=wardId,proto3" json:"ward_id,omitempty"`
-	Status               DartaStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=darta.v1.DartaStatus" json:"status,omitempty"`
-	Priority             Priority               `protobuf:"varint,5,opt,name=priority,proto3,enum=darta.v1.Priority" json:"priority,omitempty"`
-	OrganizationalUnitId string                 `protobuf:"bytes,6,opt,name=organizational_unit_id,json=organizationalUnitId,proto3" json:"organizational_unit_id,omitempty"`
-	AssigneeId           string                 `protobuf:"bytes,7,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
-	IntakeChannel        IntakeChannel          `protobuf:"varint,8,opt,name=intake_channel,json=intakeChannel,proto3,enum=darta.v1.IntakeChannel" json:"intake_channel,omitempty"`
-	FromDate             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
-	ToDate               *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
-	Search               string                 `protobuf:"bytes,11,opt,name=search,proto3" json:"search,omitempty"` // Search in subject, applicant name, etc.
-	IsOverdue            bool                   `protobuf:"varint,12,opt,name=is_overdue,json=isOverdue,proto3" json:"is_overdue,omitempty"`
-	TenantId             string                 `protobuf:"bytes,13,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Key                  string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // Ward or unit ID, or the channel or priority name; empty when unset
+	Total                int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	OverdueCount         int32                  `protobuf:"varint,3,opt,name=overdue_count,json=overdueCount,proto3" json:"overdue_count,omitempty"`
+	ReceivedToRegistered *DurationStats         `protobuf:"bytes,4,opt,name=received_to_registered,json=receivedToRegistered,proto3" json:"received_to_registered,omitempty"`
+	RegisteredToClosed   *DurationStats         `protobuf:"bytes,5,opt,name=registered_to_closed,json=registeredToClosed,proto3" json:"registered_to_closed,omitempty"`
+	SectionTurnaround    *DurationStats         `protobuf:"bytes,6,opt,name=section_turnaround,json=sectionTurnaround,proto3" json:"section_turnaround,omitempty"` // by_section only: assignment to the section until it is handed on, answered or closed
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
 
-func (x *DartaFilterInput) Reset() {
-	*x = DartaFilterInput{}
-	mi := &file_darta_v1_darta_proto_msgTypes[7]
+func (x *DartaStatsBreakdown) Reset() {
+	*x = DartaStatsBreakdown{}
+	mi := &file_darta_v1_darta_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DartaFilterInput) String() string {
+func (x *DartaStatsBreakdown) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DartaFilterInput) ProtoMessage() {}
+func (*DartaStatsBreakdown) ProtoMessage() {}
 
-func (x *DartaFilterInput) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[7]
+func (x *DartaStatsBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -936,130 +1044,152 @@ func (x *DartaFilterInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DartaFilterInput.ProtoReflect.Descriptor instead.
-func (*DartaFilterInput) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DartaStatsBreakdown.ProtoReflect.Descriptor instead.
+func (*DartaStatsBreakdown) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DartaFilterInput) GetFiscalYearId() string {
+func (x *DartaStatsBreakdown) GetKey() string {
 	if x != nil {
-		return x.FiscalYearId
+		return x.Key
 	}
 	return ""
 }
 
-func (x *DartaFilterInput) GetScope() Scope {
+func (x *DartaStatsBreakdown) GetTotal() int32 {
 	if x != nil {
-		return x.Scope
+		return x.Total
 	}
-	return Scope_SCOPE_UNSPECIFIED
+	return 0
 }
 
-func (x *DartaFilterInput) GetWardId() string {
+func (x *DartaStatsBreakdown) GetOverdueCount() int32 {
 	if x != nil {
-		return x.WardId
+		return x.OverdueCount
 	}
-	return ""
+	return 0
 }
 
-func (x *DartaFilterInput) GetStatus() DartaStatus {
+func (x *DartaStatsBreakdown) GetReceivedToRegistered() *DurationStats {
 	if x != nil {
-		return x.Status
+		return x.ReceivedToRegistered
 	}
-	return DartaStatus_DARTA_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *DartaFilterInput) GetPriority() Priority {
+func (x *DartaStatsBreakdown) GetRegisteredToClosed() *DurationStats {
 	if x != nil {
-		return x.Priority
+		return x.RegisteredToClosed
 	}
-	return Priority_PRIORITY_UNSPECIFIED
+	return nil
 }
 
-func (x *DartaFilterInput) GetOrganizationalUnitId() string {
+func (x *DartaStatsBreakdown) GetSectionTurnaround() *DurationStats {
 	if x != nil {
-		return x.OrganizationalUnitId
+		return x.SectionTurnaround
 	}
-	return ""
+	return nil
 }
 
-func (x *DartaFilterInput) GetAssigneeId() string {
-	if x != nil {
-		return x.AssigneeId
-	}
-	return ""
+// DartaStatsBucket counts what happened in one interval of the series
+type DartaStatsBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`                    // Midnight in Nepal
+	StartBs       string                 `protobuf:"bytes,2,opt,name=start_bs,json=startBs,proto3" json:"start_bs,omitempty"` // BS date, "YYYY-MM-DD"
+	Received      int32                  `protobuf:"varint,3,opt,name=received,proto3" json:"received,omitempty"`
+	Registered    int32                  `protobuf:"varint,4,opt,name=registered,proto3" json:"registered,omitempty"`
+	Closed        int32                  `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DartaFilterInput) GetIntakeChannel() IntakeChannel {
+func (x *DartaStatsBucket) Reset() {
+	*x = DartaStatsBucket{}
+	mi := &file_darta_v1_darta_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DartaStatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DartaStatsBucket) ProtoMessage() {}
+
+func (x *DartaStatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[7]
 	if x != nil {
-		return x.IntakeChannel
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return IntakeChannel_INTAKE_CHANNEL_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *DartaFilterInput) GetFromDate() *timestamppb.Timestamp {
+// Deprecated: Use DartaStatsBucket.ProtoReflect.Descriptor instead.
+func (*DartaStatsBucket) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DartaStatsBucket) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FromDate
+		return x.Start
 	}
 	return nil
 }
 
-func (x *DartaFilterInput) GetToDate() *timestamppb.Timestamp {
+func (x *DartaStatsBucket) GetStartBs() string {
 	if x != nil {
-		return x.ToDate
+		return x.StartBs
 	}
-	return nil
+	return ""
 }
 
-func (x *DartaFilterInput) GetSearch() string {
+func (x *DartaStatsBucket) GetReceived() int32 {
 	if x != nil {
-		return x.Search
+		return x.Received
 	}
-	return ""
+	return 0
 }
 
-func (x *DartaFilterInput) GetIsOverdue() bool {
+func (x *DartaStatsBucket) GetRegistered() int32 {
 	if x != nil {
-		return x.IsOverdue
+		return x.Registered
 	}
-	return false
+	return 0
 }
 
-func (x *DartaFilterInput) GetTenantId() string {
+func (x *DartaStatsBucket) GetClosed() int32 {
 	if x != nil {
-		return x.TenantId
+		return x.Closed
 	}
-	return ""
+	return 0
 }
 
-// ApplicantInput for creating/updating applicant
-type ApplicantInput struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Type                 ApplicantType          `protobuf:"varint,1,opt,name=type,proto3,enum=darta.v1.ApplicantType" json:"type,omitempty"`
-	FullName             string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Organization         string                 `protobuf:"bytes,3,opt,name=organization,proto3" json:"organization,omitempty"`
-	Email                string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Phone                string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
-	Address              string                 `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
-	IdentificationNumber string                 `protobuf:"bytes,7,opt,name=identification_number,json=identificationNumber,proto3" json:"identification_number,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type DartaStatusCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        DartaStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=darta.v1.DartaStatus" json:"status,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplicantInput) Reset() {
-	*x = ApplicantInput{}
-	mi := &file_darta_v1_darta_proto_msgTypes[8]
+func (x *DartaStatusCount) Reset() {
+	*x = DartaStatusCount{}
+	mi := &file_darta_v1_darta_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplicantInput) String() string {
+func (x *DartaStatusCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplicantInput) ProtoMessage() {}
+func (*DartaStatusCount) ProtoMessage() {}
 
-func (x *ApplicantInput) ProtoReflect() protoreflect.Message {
+func (x *DartaStatusCount) ProtoReflect() protoreflect.Message {
 	mi := &file_darta_v1_darta_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1071,93 +1201,112 @@ func (x *ApplicantInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplicantInput.ProtoReflect.Descriptor instead.
-func (*ApplicantInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use DartaStatusCount.ProtoReflect.Descriptor instead.
+func (*DartaStatusCount) Descriptor() ([]byte, []int) {
 	return file_darta_v1_darta_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ApplicantInput) GetType() ApplicantType {
+func (x *DartaStatusCount) GetStatus() DartaStatus {
 	if x != nil {
-		return x.Type
+		return x.Status
 	}
-	return ApplicantType_APPLICANT_TYPE_UNSPECIFIED
+	return DartaStatus_DARTA_STATUS_UNSPECIFIED
 }
 
-func (x *ApplicantInput) GetFullName() string {
+func (x *DartaStatusCount) GetCount() int32 {
 	if x != nil {
-		return x.FullName
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *ApplicantInput) GetOrganization() string {
-	if x != nil {
-		return x.Organization
-	}
-	return ""
+type ChannelCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       IntakeChannel          `protobuf:"varint,1,opt,name=channel,proto3,enum=darta.v1.IntakeChannel" json:"channel,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplicantInput) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+func (x *ChannelCount) Reset() {
+	*x = ChannelCount{}
+	mi := &file_darta_v1_darta_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplicantInput) GetPhone() string {
+func (x *ChannelCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelCount) ProtoMessage() {}
+
+func (x *ChannelCount) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[9]
 	if x != nil {
-		return x.Phone
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ApplicantInput) GetAddress() string {
+// Deprecated: Use ChannelCount.ProtoReflect.Descriptor instead.
+func (*ChannelCount) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChannelCount) GetChannel() IntakeChannel {
 	if x != nil {
-		return x.Address
+		return x.Channel
 	}
-	return ""
+	return IntakeChannel_INTAKE_CHANNEL_UNSPECIFIED
 }
 
-func (x *ApplicantInput) GetIdentificationNumber() string {
+func (x *ChannelCount) GetCount() int32 {
 	if x != nil {
-		return x.IdentificationNumber
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-// CreateDartaInput for creating a new darta
-type CreateDartaInput struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Scope             Scope                  `protobuf:"varint,1,opt,name=scope,proto3,enum=darta.v1.Scope" json:"scope,omitempty"`
-	WardId            string                 `protobuf:"bytes,2,opt,name=ward_id,json=wardId,proto3" json:"ward_id,omitempty"`
-	Subject           string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
-	Applicant         *ApplicantInput        `protobuf:"bytes,4,opt,name=applicant,proto3" json:"applicant,omitempty"`
-	IntakeChannel     IntakeChannel          `protobuf:"varint,5,opt,name=intake_channel,json=intakeChannel,proto3,enum=darta.v1.IntakeChannel" json:"intake_channel,omitempty"`
-	ReceivedDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=received_date,json=receivedDate,proto3" json:"received_date,omitempty"`
-	PrimaryDocumentId string                 `protobuf:"bytes,7,opt,name=primary_document_id,json=primaryDocumentId,proto3" json:"primary_document_id,omitempty"`
-	AnnexIds          []string               `protobuf:"bytes,8,rep,name=annex_ids,json=annexIds,proto3" json:"annex_ids,omitempty"`
-	Priority          Priority               `protobuf:"varint,9,opt,name=priority,proto3,enum=darta.v1.Priority" json:"priority,omitempty"`
-	IdempotencyKey    string                 `protobuf:"bytes,10,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
-	TenantId          string                 `protobuf:"bytes,11,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// DartaFilterInput for filtering darta list
+type DartaFilterInput struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	FiscalYearId         string                 `protobuf:"bytes,1,opt,name=fiscal_year_id,json=fiscalYearId,proto3" json:"fiscal_year_id,omitempty"`
+	Scope                Scope                  `protobuf:"varint,2,opt,name=scope,proto3,enum=darta.v1.Scope" json:"scope,omitempty"`
+	WardId               string                 `protobuf:"bytes,3,opt,name=ward_id,json=wardId,proto3" json:"ward_id,omitempty"`
+	Status               DartaStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=darta.v1.DartaStatus" json:"status,omitempty"`
+	Priority             Priority               `protobuf:"varint,5,opt,name=priority,proto3,enum=darta.v1.Priority" json:"priority,omitempty"`
+	OrganizationalUnitId string                 `protobuf:"bytes,6,opt,name=organizational_unit_id,json=organizationalUnitId,proto3" json:"organizational_unit_id,omitempty"`
+	AssigneeId           string                 `protobuf:"bytes,7,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	IntakeChannel        IntakeChannel          `protobuf:"varint,8,opt,name=intake_channel,json=intakeChannel,proto3,enum=darta.v1.IntakeChannel" json:"intake_channel,omitempty"`
+	FromDate             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate               *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	Search               string                 `protobuf:"bytes,11,opt,name=search,proto3" json:"search,omitempty"` // Search in subject, applicant name, etc.
+	IsOverdue            bool                   `protobuf:"varint,12,opt,name=is_overdue,json=isOverdue,proto3" json:"is_overdue,omitempty"`
+	TenantId             string                 `protobuf:"bytes,13,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *CreateDartaInput) Reset() {
-	*x = CreateDartaInput{}
-	mi := &file_darta_v1_darta_proto_msgTypes[9]
+func (x *DartaFilterInput) Reset() {
+	*x = DartaFilterInput{}
+	mi := &file_darta_v1_darta_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDartaInput) String() string {
+func (x *DartaFilterInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDartaInput) ProtoMessage() {}
+func (*DartaFilterInput) ProtoMessage() {}
 
-func (x *CreateDartaInput) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[9]
+func (x *DartaFilterInput) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1168,116 +1317,131 @@ func (x *CreateDartaInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDartaInput.ProtoReflect.Descriptor instead.
-func (*CreateDartaInput) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DartaFilterInput.ProtoReflect.Descriptor instead.
+func (*DartaFilterInput) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CreateDartaInput) GetScope() Scope {
+func (x *DartaFilterInput) GetFiscalYearId() string {
+	if x != nil {
+		return x.FiscalYearId
+	}
+	return ""
+}
+
+func (x *DartaFilterInput) GetScope() Scope {
 	if x != nil {
 		return x.Scope
 	}
 	return Scope_SCOPE_UNSPECIFIED
 }
 
-func (x *CreateDartaInput) GetWardId() string {
+func (x *DartaFilterInput) GetWardId() string {
 	if x != nil {
 		return x.WardId
 	}
 	return ""
 }
 
-func (x *CreateDartaInput) GetSubject() string {
+func (x *DartaFilterInput) GetStatus() DartaStatus {
 	if x != nil {
-		return x.Subject
+		return x.Status
 	}
-	return ""
+	return DartaStatus_DARTA_STATUS_UNSPECIFIED
 }
 
-func (x *CreateDartaInput) GetApplicant() *ApplicantInput {
+func (x *DartaFilterInput) GetPriority() Priority {
 	if x != nil {
-		return x.Applicant
+		return x.Priority
 	}
-	return nil
+	return Priority_PRIORITY_UNSPECIFIED
 }
 
-func (x *CreateDartaInput) GetIntakeChannel() IntakeChannel {
+func (x *DartaFilterInput) GetOrganizationalUnitId() string {
 	if x != nil {
-		return x.IntakeChannel
+		return x.OrganizationalUnitId
 	}
-	return IntakeChannel_INTAKE_CHANNEL_UNSPECIFIED
+	return ""
 }
 
-func (x *CreateDartaInput) GetReceivedDate() *timestamppb.Timestamp {
+func (x *DartaFilterInput) GetAssigneeId() string {
 	if x != nil {
-		return x.ReceivedDate
+		return x.AssigneeId
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateDartaInput) GetPrimaryDocumentId() string {
+func (x *DartaFilterInput) GetIntakeChannel() IntakeChannel {
 	if x != nil {
-		return x.PrimaryDocumentId
+		return x.IntakeChannel
 	}
-	return ""
+	return IntakeChannel_INTAKE_CHANNEL_UNSPECIFIED
 }
 
-func (x *CreateDartaInput) GetAnnexIds() []string {
+func (x *DartaFilterInput) GetFromDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AnnexIds
+		return x.FromDate
 	}
 	return nil
 }
 
-func (x *CreateDartaInput) GetPriority() Priority {
+func (x *DartaFilterInput) GetToDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Priority
+		return x.ToDate
 	}
-	return Priority_PRIORITY_UNSPECIFIED
+	return nil
 }
 
-func (x *CreateDartaInput) GetIdempotencyKey() string {
+func (x *DartaFilterInput) GetSearch() string {
 	if x != nil {
-		return x.IdempotencyKey
+		return x.Search
 	}
 	return ""
 }
 
-func (x *CreateDartaInput) GetTenantId() string {
+func (x *DartaFilterInput) GetIsOverdue() bool {
+	if x != nil {
+		return x.IsOverdue
+	}
+	return false
+}
+
+func (x *DartaFilterInput) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-// RouteDartaInput for routing darta to a unit/user
-type RouteDartaInput struct {
+// ApplicantInput for creating/updating applicant
+type ApplicantInput struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
-	DartaId              string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
-	OrganizationalUnitId string                 `protobuf:"bytes,2,opt,name=organizational_unit_id,json=organizationalUnitId,proto3" json:"organizational_unit_id,omitempty"`
-	AssigneeId           string                 `protobuf:"bytes,3,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
-	Priority             Priority               `protobuf:"varint,4,opt,name=priority,proto3,enum=darta.v1.Priority" json:"priority,omitempty"`
-	SlaHours             int32                  `protobuf:"varint,5,opt,name=sla_hours,json=slaHours,proto3" json:"sla_hours,omitempty"`
-	Notes                string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	Type                 ApplicantType          `protobuf:"varint,1,opt,name=type,proto3,enum=darta.v1.ApplicantType" json:"type,omitempty"`
+	FullName             string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Organization         string                 `protobuf:"bytes,3,opt,name=organization,proto3" json:"organization,omitempty"`
+	Email                string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Phone                string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Address              string                 `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+	IdentificationNumber string                 `protobuf:"bytes,7,opt,name=identification_number,json=identificationNumber,proto3" json:"identification_number,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
 
-func (x *RouteDartaInput) Reset() {
-	*x = RouteDartaInput{}
-	mi := &file_darta_v1_darta_proto_msgTypes[10]
+func (x *ApplicantInput) Reset() {
+	*x = ApplicantInput{}
+	mi := &file_darta_v1_darta_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RouteDartaInput) String() string {
+func (x *ApplicantInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteDartaInput) ProtoMessage() {}
+func (*ApplicantInput) ProtoMessage() {}
 
-func (x *RouteDartaInput) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[10]
+func (x *ApplicantInput) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,67 +1452,309 @@ func (x *RouteDartaInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteDartaInput.ProtoReflect.Descriptor instead.
-func (*RouteDartaInput) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ApplicantInput.ProtoReflect.Descriptor instead.
+func (*ApplicantInput) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *RouteDartaInput) GetDartaId() string {
+func (x *ApplicantInput) GetType() ApplicantType {
 	if x != nil {
-		return x.DartaId
+		return x.Type
 	}
-	return ""
+	return ApplicantType_APPLICANT_TYPE_UNSPECIFIED
 }
 
-func (x *RouteDartaInput) GetOrganizationalUnitId() string {
+func (x *ApplicantInput) GetFullName() string {
 	if x != nil {
-		return x.OrganizationalUnitId
+		return x.FullName
 	}
 	return ""
 }
 
-func (x *RouteDartaInput) GetAssigneeId() string {
+func (x *ApplicantInput) GetOrganization() string {
 	if x != nil {
-		return x.AssigneeId
+		return x.Organization
 	}
 	return ""
 }
 
-func (x *RouteDartaInput) GetPriority() Priority {
+func (x *ApplicantInput) GetEmail() string {
 	if x != nil {
-		return x.Priority
+		return x.Email
 	}
-	return Priority_PRIORITY_UNSPECIFIED
+	return ""
 }
 
-func (x *RouteDartaInput) GetSlaHours() int32 {
+func (x *ApplicantInput) GetPhone() string {
 	if x != nil {
-		return x.SlaHours
+		return x.Phone
 	}
-	return 0
+	return ""
 }
 
-func (x *RouteDartaInput) GetNotes() string {
+func (x *ApplicantInput) GetAddress() string {
 	if x != nil {
-		return x.Notes
+		return x.Address
 	}
 	return ""
 }
 
-// ReviewDartaInput for reviewing darta
-type ReviewDartaInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
-	Notes         string                 `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
-	Decision      DartaReviewDecision    `protobuf:"varint,3,opt,name=decision,proto3,enum=darta.v1.DartaReviewDecision" json:"decision,omitempty"`
-	RequestedInfo string                 `protobuf:"bytes,4,opt,name=requested_info,json=requestedInfo,proto3" json:"requested_info,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ApplicantInput) GetIdentificationNumber() string {
+	if x != nil {
+		return x.IdentificationNumber
+	}
+	return ""
+}
+
+// CreateDartaInput for creating a new darta
+type CreateDartaInput struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Scope                 Scope                  `protobuf:"varint,1,opt,name=scope,proto3,enum=darta.v1.Scope" json:"scope,omitempty"`
+	WardId                string                 `protobuf:"bytes,2,opt,name=ward_id,json=wardId,proto3" json:"ward_id,omitempty"`
+	Subject               string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Applicant             *ApplicantInput        `protobuf:"bytes,4,opt,name=applicant,proto3" json:"applicant,omitempty"`
+	IntakeChannel         IntakeChannel          `protobuf:"varint,5,opt,name=intake_channel,json=intakeChannel,proto3,enum=darta.v1.IntakeChannel" json:"intake_channel,omitempty"`
+	ReceivedDate          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=received_date,json=receivedDate,proto3" json:"received_date,omitempty"`
+	PrimaryDocumentId     string                 `protobuf:"bytes,7,opt,name=primary_document_id,json=primaryDocumentId,proto3" json:"primary_document_id,omitempty"`
+	AnnexIds              []string               `protobuf:"bytes,8,rep,name=annex_ids,json=annexIds,proto3" json:"annex_ids,omitempty"`
+	Priority              Priority               `protobuf:"varint,9,opt,name=priority,proto3,enum=darta.v1.Priority" json:"priority,omitempty"`
+	IdempotencyKey        string                 `protobuf:"bytes,10,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	TenantId              string                 `protobuf:"bytes,11,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	AcknowledgeDuplicates bool                   `protobuf:"varint,12,opt,name=acknowledge_duplicates,json=acknowledgeDuplicates,proto3" json:"acknowledge_duplicates,omitempty"` // Create even if blocked as a suspected duplicate
+	BackdateReason        string                 `protobuf:"bytes,13,opt,name=backdate_reason,json=backdateReason,proto3" json:"backdate_reason,omitempty"`                       // Required when received before the day of entry
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateDartaInput) Reset() {
+	*x = CreateDartaInput{}
+	mi := &file_darta_v1_darta_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDartaInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDartaInput) ProtoMessage() {}
+
+func (x *CreateDartaInput) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDartaInput.ProtoReflect.Descriptor instead.
+func (*CreateDartaInput) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateDartaInput) GetScope() Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return Scope_SCOPE_UNSPECIFIED
+}
+
+func (x *CreateDartaInput) GetWardId() string {
+	if x != nil {
+		return x.WardId
+	}
+	return ""
+}
+
+func (x *CreateDartaInput) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *CreateDartaInput) GetApplicant() *ApplicantInput {
+	if x != nil {
+		return x.Applicant
+	}
+	return nil
+}
+
+func (x *CreateDartaInput) GetIntakeChannel() IntakeChannel {
+	if x != nil {
+		return x.IntakeChannel
+	}
+	return IntakeChannel_INTAKE_CHANNEL_UNSPECIFIED
+}
+
+func (x *CreateDartaInput) GetReceivedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedDate
+	}
+	return nil
+}
+
+func (x *CreateDartaInput) GetPrimaryDocumentId() string {
+	if x != nil {
+		return x.PrimaryDocumentId
+	}
+	return ""
+}
+
+func (x *CreateDartaInput) GetAnnexIds() []string {
+	if x != nil {
+		return x.AnnexIds
+	}
+	return nil
+}
+
+func (x *CreateDartaInput) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *CreateDartaInput) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateDartaInput) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateDartaInput) GetAcknowledgeDuplicates() bool {
+	if x != nil {
+		return x.AcknowledgeDuplicates
+	}
+	return false
+}
+
+func (x *CreateDartaInput) GetBackdateReason() string {
+	if x != nil {
+		return x.BackdateReason
+	}
+	return ""
+}
+
+// RouteDartaInput for routing darta to a unit/user
+type RouteDartaInput struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	DartaId              string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	OrganizationalUnitId string                 `protobuf:"bytes,2,opt,name=organizational_unit_id,json=organizationalUnitId,proto3" json:"organizational_unit_id,omitempty"`
+	AssigneeId           string                 `protobuf:"bytes,3,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	Priority             Priority               `protobuf:"varint,4,opt,name=priority,proto3,enum=darta.v1.Priority" json:"priority,omitempty"`
+	SlaHours             int32                  `protobuf:"varint,5,opt,name=sla_hours,json=slaHours,proto3" json:"sla_hours,omitempty"` // Response target in business hours; 0 uses the tenant SLA policy
+	Notes                string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	ExpectedVersion      int64                  `protobuf:"varint,7,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RouteDartaInput) Reset() {
+	*x = RouteDartaInput{}
+	mi := &file_darta_v1_darta_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteDartaInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteDartaInput) ProtoMessage() {}
+
+func (x *RouteDartaInput) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteDartaInput.ProtoReflect.Descriptor instead.
+func (*RouteDartaInput) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RouteDartaInput) GetDartaId() string {
+	if x != nil {
+		return x.DartaId
+	}
+	return ""
+}
+
+func (x *RouteDartaInput) GetOrganizationalUnitId() string {
+	if x != nil {
+		return x.OrganizationalUnitId
+	}
+	return ""
+}
+
+func (x *RouteDartaInput) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *RouteDartaInput) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *RouteDartaInput) GetSlaHours() int32 {
+	if x != nil {
+		return x.SlaHours
+	}
+	return 0
+}
+
+func (x *RouteDartaInput) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *RouteDartaInput) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// ReviewDartaInput for reviewing darta
+type ReviewDartaInput struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DartaId         string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	Notes           string                 `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
+	Decision        DartaReviewDecision    `protobuf:"varint,3,opt,name=decision,proto3,enum=darta.v1.DartaReviewDecision" json:"decision,omitempty"`
+	RequestedInfo   string                 `protobuf:"bytes,4,opt,name=requested_info,json=requestedInfo,proto3" json:"requested_info,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ReviewDartaInput) Reset() {
 	*x = ReviewDartaInput{}
-	mi := &file_darta_v1_darta_proto_msgTypes[11]
+	mi := &file_darta_v1_darta_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1360,7 +1766,7 @@ func (x *ReviewDartaInput) String() string {
 func (*ReviewDartaInput) ProtoMessage() {}
 
 func (x *ReviewDartaInput) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[11]
+	mi := &file_darta_v1_darta_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1373,7 +1779,7 @@ func (x *ReviewDartaInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReviewDartaInput.ProtoReflect.Descriptor instead.
 func (*ReviewDartaInput) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{11}
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReviewDartaInput) GetDartaId() string {
@@ -1383,50 +1789,1621 @@ func (x *ReviewDartaInput) GetDartaId() string {
 	return ""
 }
 
-func (x *ReviewDartaInput) GetNotes() string {
+func (x *ReviewDartaInput) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *ReviewDartaInput) GetDecision() DartaReviewDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return DartaReviewDecision_DARTA_REVIEW_DECISION_UNSPECIFIED
+}
+
+func (x *ReviewDartaInput) GetRequestedInfo() string {
+	if x != nil {
+		return x.RequestedInfo
+	}
+	return ""
+}
+
+func (x *ReviewDartaInput) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// Query requests/responses
+type GetDartaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDartaRequest) Reset() {
+	*x = GetDartaRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDartaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDartaRequest) ProtoMessage() {}
+
+func (x *GetDartaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDartaRequest.ProtoReflect.Descriptor instead.
+func (*GetDartaRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetDartaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDartaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDartaResponse) Reset() {
+	*x = GetDartaResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDartaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDartaResponse) ProtoMessage() {}
+
+func (x *GetDartaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDartaResponse.ProtoReflect.Descriptor instead.
+func (*GetDartaResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetDartaResponse) GetDarta() *Darta {
+	if x != nil {
+		return x.Darta
+	}
+	return nil
+}
+
+type GetDartaByNumberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DartaNumber   int32                  `protobuf:"varint,1,opt,name=darta_number,json=dartaNumber,proto3" json:"darta_number,omitempty"`
+	FiscalYearId  string                 `protobuf:"bytes,2,opt,name=fiscal_year_id,json=fiscalYearId,proto3" json:"fiscal_year_id,omitempty"`
+	Scope         Scope                  `protobuf:"varint,3,opt,name=scope,proto3,enum=darta.v1.Scope" json:"scope,omitempty"`
+	WardId        string                 `protobuf:"bytes,4,opt,name=ward_id,json=wardId,proto3" json:"ward_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDartaByNumberRequest) Reset() {
+	*x = GetDartaByNumberRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDartaByNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDartaByNumberRequest) ProtoMessage() {}
+
+func (x *GetDartaByNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDartaByNumberRequest.ProtoReflect.Descriptor instead.
+func (*GetDartaByNumberRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetDartaByNumberRequest) GetDartaNumber() int32 {
+	if x != nil {
+		return x.DartaNumber
+	}
+	return 0
+}
+
+func (x *GetDartaByNumberRequest) GetFiscalYearId() string {
+	if x != nil {
+		return x.FiscalYearId
+	}
+	return ""
+}
+
+func (x *GetDartaByNumberRequest) GetScope() Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return Scope_SCOPE_UNSPECIFIED
+}
+
+func (x *GetDartaByNumberRequest) GetWardId() string {
+	if x != nil {
+		return x.WardId
+	}
+	return ""
+}
+
+type GetDartaByNumberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDartaByNumberResponse) Reset() {
+	*x = GetDartaByNumberResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDartaByNumberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDartaByNumberResponse) ProtoMessage() {}
+
+func (x *GetDartaByNumberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDartaByNumberResponse.ProtoReflect.Descriptor instead.
+func (*GetDartaByNumberResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetDartaByNumberResponse) GetDarta() *Darta {
+	if x != nil {
+		return x.Darta
+	}
+	return nil
+}
+
+type ListDartasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *DartaFilterInput      `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Pagination    *PaginationInput       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDartasRequest) Reset() {
+	*x = ListDartasRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDartasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDartasRequest) ProtoMessage() {}
+
+func (x *ListDartasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDartasRequest.ProtoReflect.Descriptor instead.
+func (*ListDartasRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListDartasRequest) GetFilter() *DartaFilterInput {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListDartasRequest) GetPagination() *PaginationInput {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListDartasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connection    *DartaConnection       `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDartasResponse) Reset() {
+	*x = ListDartasResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDartasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDartasResponse) ProtoMessage() {}
+
+func (x *ListDartasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDartasResponse.ProtoReflect.Descriptor instead.
+func (*ListDartasResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListDartasResponse) GetConnection() *DartaConnection {
+	if x != nil {
+		return x.Connection
+	}
+	return nil
+}
+
+type GetMyDartasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        DartaStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=darta.v1.DartaStatus" json:"status,omitempty"`
+	Pagination    *PaginationInput       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From context
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyDartasRequest) Reset() {
+	*x = GetMyDartasRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyDartasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyDartasRequest) ProtoMessage() {}
+
+func (x *GetMyDartasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyDartasRequest.ProtoReflect.Descriptor instead.
+func (*GetMyDartasRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetMyDartasRequest) GetStatus() DartaStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DartaStatus_DARTA_STATUS_UNSPECIFIED
+}
+
+func (x *GetMyDartasRequest) GetPagination() *PaginationInput {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetMyDartasRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetMyDartasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connection    *DartaConnection       `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyDartasResponse) Reset() {
+	*x = GetMyDartasResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyDartasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyDartasResponse) ProtoMessage() {}
+
+func (x *GetMyDartasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyDartasResponse.ProtoReflect.Descriptor instead.
+func (*GetMyDartasResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetMyDartasResponse) GetConnection() *DartaConnection {
+	if x != nil {
+		return x.Connection
+	}
+	return nil
+}
+
+type GetDartaStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scope         Scope                  `protobuf:"varint,1,opt,name=scope,proto3,enum=darta.v1.Scope" json:"scope,omitempty"`
+	FiscalYearId  string                 `protobuf:"bytes,2,opt,name=fiscal_year_id,json=fiscalYearId,proto3" json:"fiscal_year_id,omitempty"`
+	WardId        string                 `protobuf:"bytes,3,opt,name=ward_id,json=wardId,proto3" json:"ward_id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	FromDate      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // Received on or after; also starts the series
+	ToDate        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`       // Received before; also ends the series
+	Interval      StatsInterval          `protobuf:"varint,7,opt,name=interval,proto3,enum=darta.v1.StatsInterval" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDartaStatsRequest) Reset() {
+	*x = GetDartaStatsRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDartaStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDartaStatsRequest) ProtoMessage() {}
+
+func (x *GetDartaStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDartaStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetDartaStatsRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetDartaStatsRequest) GetScope() Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return Scope_SCOPE_UNSPECIFIED
+}
+
+func (x *GetDartaStatsRequest) GetFiscalYearId() string {
+	if x != nil {
+		return x.FiscalYearId
+	}
+	return ""
+}
+
+func (x *GetDartaStatsRequest) GetWardId() string {
+	if x != nil {
+		return x.WardId
+	}
+	return ""
+}
+
+func (x *GetDartaStatsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetDartaStatsRequest) GetFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromDate
+	}
+	return nil
+}
+
+func (x *GetDartaStatsRequest) GetToDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToDate
+	}
+	return nil
+}
+
+func (x *GetDartaStatsRequest) GetInterval() StatsInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return StatsInterval_STATS_INTERVAL_UNSPECIFIED
+}
+
+type GetDartaStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         *DartaStats            `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDartaStatsResponse) Reset() {
+	*x = GetDartaStatsResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDartaStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDartaStatsResponse) ProtoMessage() {}
+
+func (x *GetDartaStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDartaStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetDartaStatsResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetDartaStatsResponse) GetStats() *DartaStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// Mutation requests/responses
+type CreateDartaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *CreateDartaInput      `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDartaRequest) Reset() {
+	*x = CreateDartaRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDartaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDartaRequest) ProtoMessage() {}
+
+func (x *CreateDartaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDartaRequest.ProtoReflect.Descriptor instead.
+func (*CreateDartaRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateDartaRequest) GetInput() *CreateDartaInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type CreateDartaResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Darta               *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	SuspectedDuplicates []*DuplicateCandidate  `protobuf:"bytes,2,rep,name=suspected_duplicates,json=suspectedDuplicates,proto3" json:"suspected_duplicates,omitempty"` // Best match first
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateDartaResponse) Reset() {
+	*x = CreateDartaResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDartaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDartaResponse) ProtoMessage() {}
+
+func (x *CreateDartaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDartaResponse.ProtoReflect.Descriptor instead.
+func (*CreateDartaResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateDartaResponse) GetDarta() *Darta {
+	if x != nil {
+		return x.Darta
+	}
+	return nil
+}
+
+func (x *CreateDartaResponse) GetSuspectedDuplicates() []*DuplicateCandidate {
+	if x != nil {
+		return x.SuspectedDuplicates
+	}
+	return nil
+}
+
+type SubmitDartaForReviewRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DartaId         string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SubmitDartaForReviewRequest) Reset() {
+	*x = SubmitDartaForReviewRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDartaForReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDartaForReviewRequest) ProtoMessage() {}
+
+func (x *SubmitDartaForReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDartaForReviewRequest.ProtoReflect.Descriptor instead.
+func (*SubmitDartaForReviewRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SubmitDartaForReviewRequest) GetDartaId() string {
+	if x != nil {
+		return x.DartaId
+	}
+	return ""
+}
+
+func (x *SubmitDartaForReviewRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type SubmitDartaForReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitDartaForReviewResponse) Reset() {
+	*x = SubmitDartaForReviewResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDartaForReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDartaForReviewResponse) ProtoMessage() {}
+
+func (x *SubmitDartaForReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDartaForReviewResponse.ProtoReflect.Descriptor instead.
+func (*SubmitDartaForReviewResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SubmitDartaForReviewResponse) GetDarta() *Darta {
+	if x != nil {
+		return x.Darta
+	}
+	return nil
+}
+
+type ReviewDartaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *ReviewDartaInput      `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewDartaRequest) Reset() {
+	*x = ReviewDartaRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewDartaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewDartaRequest) ProtoMessage() {}
+
+func (x *ReviewDartaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewDartaRequest.ProtoReflect.Descriptor instead.
+func (*ReviewDartaRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReviewDartaRequest) GetInput() *ReviewDartaInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type ReviewDartaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewDartaResponse) Reset() {
+	*x = ReviewDartaResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewDartaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewDartaResponse) ProtoMessage() {}
+
+func (x *ReviewDartaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewDartaResponse.ProtoReflect.Descriptor instead.
+func (*ReviewDartaResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReviewDartaResponse) GetDarta() *Darta {
+	if x != nil {
+		return x.Darta
+	}
+	return nil
+}
+
+type ClassifyDartaRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	DartaId            string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	ClassificationCode string                 `protobuf:"bytes,2,opt,name=classification_code,json=classificationCode,proto3" json:"classification_code,omitempty"`
+	ExpectedVersion    int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ClassifyDartaRequest) Reset() {
+	*x = ClassifyDartaRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassifyDartaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassifyDartaRequest) ProtoMessage() {}
+
+func (x *ClassifyDartaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassifyDartaRequest.ProtoReflect.Descriptor instead.
+func (*ClassifyDartaRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ClassifyDartaRequest) GetDartaId() string {
+	if x != nil {
+		return x.DartaId
+	}
+	return ""
+}
+
+func (x *ClassifyDartaRequest) GetClassificationCode() string {
+	if x != nil {
+		return x.ClassificationCode
+	}
+	return ""
+}
+
+func (x *ClassifyDartaRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type ClassifyDartaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassifyDartaResponse) Reset() {
+	*x = ClassifyDartaResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassifyDartaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassifyDartaResponse) ProtoMessage() {}
+
+func (x *ClassifyDartaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassifyDartaResponse.ProtoReflect.Descriptor instead.
+func (*ClassifyDartaResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ClassifyDartaResponse) GetDarta() *Darta {
+	if x != nil {
+		return x.Darta
+	}
+	return nil
+}
+
+type ReserveDartaNumberRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	DartaId               string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	AllocationId          string                 `protobuf:"bytes,2,opt,name=allocation_id,json=allocationId,proto3" json:"allocation_id,omitempty"`
+	AcknowledgeDuplicates bool                   `protobuf:"varint,3,opt,name=acknowledge_duplicates,json=acknowledgeDuplicates,proto3" json:"acknowledge_duplicates,omitempty"` // Reserve even if blocked as a suspected duplicate
+	ExpectedVersion       int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`                   // 0 applies to whatever version is current
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ReserveDartaNumberRequest) Reset() {
+	*x = ReserveDartaNumberRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveDartaNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveDartaNumberRequest) ProtoMessage() {}
+
+func (x *ReserveDartaNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveDartaNumberRequest.ProtoReflect.Descriptor instead.
+func (*ReserveDartaNumberRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReserveDartaNumberRequest) GetDartaId() string {
+	if x != nil {
+		return x.DartaId
+	}
+	return ""
+}
+
+func (x *ReserveDartaNumberRequest) GetAllocationId() string {
+	if x != nil {
+		return x.AllocationId
+	}
+	return ""
+}
+
+func (x *ReserveDartaNumberRequest) GetAcknowledgeDuplicates() bool {
+	if x != nil {
+		return x.AcknowledgeDuplicates
+	}
+	return false
+}
+
+func (x *ReserveDartaNumberRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type ReserveDartaNumberResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Darta               *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	SuspectedDuplicates []*DuplicateCandidate  `protobuf:"bytes,2,rep,name=suspected_duplicates,json=suspectedDuplicates,proto3" json:"suspected_duplicates,omitempty"` // Best match first
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReserveDartaNumberResponse) Reset() {
+	*x = ReserveDartaNumberResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveDartaNumberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveDartaNumberResponse) ProtoMessage() {}
+
+func (x *ReserveDartaNumberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveDartaNumberResponse.ProtoReflect.Descriptor instead.
+func (*ReserveDartaNumberResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReserveDartaNumberResponse) GetDarta() *Darta {
+	if x != nil {
+		return x.Darta
+	}
+	return nil
+}
+
+func (x *ReserveDartaNumberResponse) GetSuspectedDuplicates() []*DuplicateCandidate {
+	if x != nil {
+		return x.SuspectedDuplicates
+	}
+	return nil
+}
+
+type FinalizeDartaRegistrationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DartaId         string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	AllocationId    string                 `protobuf:"bytes,2,opt,name=allocation_id,json=allocationId,proto3" json:"allocation_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FinalizeDartaRegistrationRequest) Reset() {
+	*x = FinalizeDartaRegistrationRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalizeDartaRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeDartaRegistrationRequest) ProtoMessage() {}
+
+func (x *FinalizeDartaRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeDartaRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*FinalizeDartaRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FinalizeDartaRegistrationRequest) GetDartaId() string {
+	if x != nil {
+		return x.DartaId
+	}
+	return ""
+}
+
+func (x *FinalizeDartaRegistrationRequest) GetAllocationId() string {
+	if x != nil {
+		return x.AllocationId
+	}
+	return ""
+}
+
+func (x *FinalizeDartaRegistrationRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type FinalizeDartaRegistrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinalizeDartaRegistrationResponse) Reset() {
+	*x = FinalizeDartaRegistrationResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalizeDartaRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeDartaRegistrationResponse) ProtoMessage() {}
+
+func (x *FinalizeDartaRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeDartaRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*FinalizeDartaRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *FinalizeDartaRegistrationResponse) GetDarta() *Darta {
+	if x != nil {
+		return x.Darta
+	}
+	return nil
+}
+
+type DirectRegisterDartaRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	DartaId               string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	AcknowledgeDuplicates bool                   `protobuf:"varint,2,opt,name=acknowledge_duplicates,json=acknowledgeDuplicates,proto3" json:"acknowledge_duplicates,omitempty"` // Register even if blocked as a suspected duplicate
+	ExpectedVersion       int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`                   // 0 applies to whatever version is current
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *DirectRegisterDartaRequest) Reset() {
+	*x = DirectRegisterDartaRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DirectRegisterDartaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectRegisterDartaRequest) ProtoMessage() {}
+
+func (x *DirectRegisterDartaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectRegisterDartaRequest.ProtoReflect.Descriptor instead.
+func (*DirectRegisterDartaRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DirectRegisterDartaRequest) GetDartaId() string {
+	if x != nil {
+		return x.DartaId
+	}
+	return ""
+}
+
+func (x *DirectRegisterDartaRequest) GetAcknowledgeDuplicates() bool {
+	if x != nil {
+		return x.AcknowledgeDuplicates
+	}
+	return false
+}
+
+func (x *DirectRegisterDartaRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type DirectRegisterDartaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DirectRegisterDartaResponse) Reset() {
+	*x = DirectRegisterDartaResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DirectRegisterDartaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectRegisterDartaResponse) ProtoMessage() {}
+
+func (x *DirectRegisterDartaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectRegisterDartaResponse.ProtoReflect.Descriptor instead.
+func (*DirectRegisterDartaResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DirectRegisterDartaResponse) GetDarta() *Darta {
+	if x != nil {
+		return x.Darta
+	}
+	return nil
+}
+
+type VoidDartaRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DartaId         string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	Reason          string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VoidDartaRequest) Reset() {
+	*x = VoidDartaRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidDartaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidDartaRequest) ProtoMessage() {}
+
+func (x *VoidDartaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidDartaRequest.ProtoReflect.Descriptor instead.
+func (*VoidDartaRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VoidDartaRequest) GetDartaId() string {
+	if x != nil {
+		return x.DartaId
+	}
+	return ""
+}
+
+func (x *VoidDartaRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *VoidDartaRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type VoidDartaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidDartaResponse) Reset() {
+	*x = VoidDartaResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidDartaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidDartaResponse) ProtoMessage() {}
+
+func (x *VoidDartaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidDartaResponse.ProtoReflect.Descriptor instead.
+func (*VoidDartaResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VoidDartaResponse) GetDarta() *Darta {
+	if x != nil {
+		return x.Darta
+	}
+	return nil
+}
+
+type ScanDartaRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DartaId          string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	ScanAttachmentId string                 `protobuf:"bytes,2,opt,name=scan_attachment_id,json=scanAttachmentId,proto3" json:"scan_attachment_id,omitempty"`
+	ExpectedVersion  int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ScanDartaRequest) Reset() {
+	*x = ScanDartaRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanDartaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanDartaRequest) ProtoMessage() {}
+
+func (x *ScanDartaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanDartaRequest.ProtoReflect.Descriptor instead.
+func (*ScanDartaRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ScanDartaRequest) GetDartaId() string {
+	if x != nil {
+		return x.DartaId
+	}
+	return ""
+}
+
+func (x *ScanDartaRequest) GetScanAttachmentId() string {
+	if x != nil {
+		return x.ScanAttachmentId
+	}
+	return ""
+}
+
+func (x *ScanDartaRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type ScanDartaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanDartaResponse) Reset() {
+	*x = ScanDartaResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanDartaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanDartaResponse) ProtoMessage() {}
+
+func (x *ScanDartaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanDartaResponse.ProtoReflect.Descriptor instead.
+func (*ScanDartaResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ScanDartaResponse) GetDarta() *Darta {
+	if x != nil {
+		return x.Darta
+	}
+	return nil
+}
+
+// EnrichDartaMetadataRequest merges metadata into the darta's as an RFC 7396
+// merge patch: null removes a key and objects merge recursively
+type EnrichDartaMetadataRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DartaId         string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	Metadata        *structpb.Struct       `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EnrichDartaMetadataRequest) Reset() {
+	*x = EnrichDartaMetadataRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrichDartaMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrichDartaMetadataRequest) ProtoMessage() {}
+
+func (x *EnrichDartaMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrichDartaMetadataRequest.ProtoReflect.Descriptor instead.
+func (*EnrichDartaMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *EnrichDartaMetadataRequest) GetDartaId() string {
+	if x != nil {
+		return x.DartaId
+	}
+	return ""
+}
+
+func (x *EnrichDartaMetadataRequest) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *EnrichDartaMetadataRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type EnrichDartaMetadataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrichDartaMetadataResponse) Reset() {
+	*x = EnrichDartaMetadataResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrichDartaMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrichDartaMetadataResponse) ProtoMessage() {}
+
+func (x *EnrichDartaMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[44]
 	if x != nil {
-		return x.Notes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ReviewDartaInput) GetDecision() DartaReviewDecision {
-	if x != nil {
-		return x.Decision
-	}
-	return DartaReviewDecision_DARTA_REVIEW_DECISION_UNSPECIFIED
+// Deprecated: Use EnrichDartaMetadataResponse.ProtoReflect.Descriptor instead.
+func (*EnrichDartaMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ReviewDartaInput) GetRequestedInfo() string {
+func (x *EnrichDartaMetadataResponse) GetDarta() *Darta {
 	if x != nil {
-		return x.RequestedInfo
+		return x.Darta
 	}
-	return ""
+	return nil
 }
 
-// Query requests/responses
-type GetDartaRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type FinalizeDartaArchiveRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DartaId         string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetDartaRequest) Reset() {
-	*x = GetDartaRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[12]
+func (x *FinalizeDartaArchiveRequest) Reset() {
+	*x = FinalizeDartaArchiveRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDartaRequest) String() string {
+func (x *FinalizeDartaArchiveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDartaRequest) ProtoMessage() {}
+func (*FinalizeDartaArchiveRequest) ProtoMessage() {}
 
-func (x *GetDartaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[12]
+func (x *FinalizeDartaArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1437,40 +3414,47 @@ func (x *GetDartaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDartaRequest.ProtoReflect.Descriptor instead.
-func (*GetDartaRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use FinalizeDartaArchiveRequest.ProtoReflect.Descriptor instead.
+func (*FinalizeDartaArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetDartaRequest) GetId() string {
+func (x *FinalizeDartaArchiveRequest) GetDartaId() string {
 	if x != nil {
-		return x.Id
+		return x.DartaId
 	}
 	return ""
 }
 
-type GetDartaResponse struct {
+func (x *FinalizeDartaArchiveRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type FinalizeDartaArchiveResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDartaResponse) Reset() {
-	*x = GetDartaResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[13]
+func (x *FinalizeDartaArchiveResponse) Reset() {
+	*x = FinalizeDartaArchiveResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDartaResponse) String() string {
+func (x *FinalizeDartaArchiveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDartaResponse) ProtoMessage() {}
+func (*FinalizeDartaArchiveResponse) ProtoMessage() {}
 
-func (x *GetDartaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[13]
+func (x *FinalizeDartaArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1481,43 +3465,40 @@ func (x *GetDartaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDartaResponse.ProtoReflect.Descriptor instead.
-func (*GetDartaResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use FinalizeDartaArchiveResponse.ProtoReflect.Descriptor instead.
+func (*FinalizeDartaArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetDartaResponse) GetDarta() *Darta {
+func (x *FinalizeDartaArchiveResponse) GetDarta() *Darta {
 	if x != nil {
 		return x.Darta
 	}
 	return nil
 }
 
-type GetDartaByNumberRequest struct {
+type RouteDartaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DartaNumber   int32                  `protobuf:"varint,1,opt,name=darta_number,json=dartaNumber,proto3" json:"darta_number,omitempty"`
-	FiscalYearId  string                 `protobuf:"bytes,2,opt,name=fiscal_year_id,json=fiscalYearId,proto3" json:"fiscal_year_id,omitempty"`
-	Scope         Scope                  `protobuf:"varint,3,opt,name=scope,proto3,enum=darta.v1.Scope" json:"scope,omitempty"`
-	WardId        string                 `protobuf:"bytes,4,opt,name=ward_id,json=wardId,proto3" json:"ward_id,omitempty"`
+	Input         *RouteDartaInput       `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDartaByNumberRequest) Reset() {
-	*x = GetDartaByNumberRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[14]
+func (x *RouteDartaRequest) Reset() {
+	*x = RouteDartaRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDartaByNumberRequest) String() string {
+func (x *RouteDartaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDartaByNumberRequest) ProtoMessage() {}
+func (*RouteDartaRequest) ProtoMessage() {}
 
-func (x *GetDartaByNumberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[14]
+func (x *RouteDartaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1528,61 +3509,40 @@ func (x *GetDartaByNumberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDartaByNumberRequest.ProtoReflect.Descriptor instead.
-func (*GetDartaByNumberRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *GetDartaByNumberRequest) GetDartaNumber() int32 {
-	if x != nil {
-		return x.DartaNumber
-	}
-	return 0
-}
-
-func (x *GetDartaByNumberRequest) GetFiscalYearId() string {
-	if x != nil {
-		return x.FiscalYearId
-	}
-	return ""
-}
-
-func (x *GetDartaByNumberRequest) GetScope() Scope {
-	if x != nil {
-		return x.Scope
-	}
-	return Scope_SCOPE_UNSPECIFIED
+// Deprecated: Use RouteDartaRequest.ProtoReflect.Descriptor instead.
+func (*RouteDartaRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetDartaByNumberRequest) GetWardId() string {
+func (x *RouteDartaRequest) GetInput() *RouteDartaInput {
 	if x != nil {
-		return x.WardId
+		return x.Input
 	}
-	return ""
+	return nil
 }
 
-type GetDartaByNumberResponse struct {
+type RouteDartaResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDartaByNumberResponse) Reset() {
-	*x = GetDartaByNumberResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[15]
+func (x *RouteDartaResponse) Reset() {
+	*x = RouteDartaResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDartaByNumberResponse) String() string {
+func (x *RouteDartaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDartaByNumberResponse) ProtoMessage() {}
+func (*RouteDartaResponse) ProtoMessage() {}
 
-func (x *GetDartaByNumberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[15]
+func (x *RouteDartaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1593,41 +3553,41 @@ func (x *GetDartaByNumberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDartaByNumberResponse.ProtoReflect.Descriptor instead.
-func (*GetDartaByNumberResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RouteDartaResponse.ProtoReflect.Descriptor instead.
+func (*RouteDartaResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetDartaByNumberResponse) GetDarta() *Darta {
+func (x *RouteDartaResponse) GetDarta() *Darta {
 	if x != nil {
 		return x.Darta
 	}
 	return nil
 }
 
-type ListDartasRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filter        *DartaFilterInput      `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	Pagination    *PaginationInput       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SectionReviewDartaRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DartaId         string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListDartasRequest) Reset() {
-	*x = ListDartasRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[16]
+func (x *SectionReviewDartaRequest) Reset() {
+	*x = SectionReviewDartaRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDartasRequest) String() string {
+func (x *SectionReviewDartaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDartasRequest) ProtoMessage() {}
+func (*SectionReviewDartaRequest) ProtoMessage() {}
 
-func (x *ListDartasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[16]
+func (x *SectionReviewDartaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1638,47 +3598,47 @@ func (x *ListDartasRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDartasRequest.ProtoReflect.Descriptor instead.
-func (*ListDartasRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SectionReviewDartaRequest.ProtoReflect.Descriptor instead.
+func (*SectionReviewDartaRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ListDartasRequest) GetFilter() *DartaFilterInput {
+func (x *SectionReviewDartaRequest) GetDartaId() string {
 	if x != nil {
-		return x.Filter
+		return x.DartaId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListDartasRequest) GetPagination() *PaginationInput {
+func (x *SectionReviewDartaRequest) GetExpectedVersion() int64 {
 	if x != nil {
-		return x.Pagination
+		return x.ExpectedVersion
 	}
-	return nil
+	return 0
 }
 
-type ListDartasResponse struct {
+type SectionReviewDartaResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Connection    *DartaConnection       `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDartasResponse) Reset() {
-	*x = ListDartasResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[17]
+func (x *SectionReviewDartaResponse) Reset() {
+	*x = SectionReviewDartaResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDartasResponse) String() string {
+func (x *SectionReviewDartaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDartasResponse) ProtoMessage() {}
+func (*SectionReviewDartaResponse) ProtoMessage() {}
 
-func (x *ListDartasResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[17]
+func (x *SectionReviewDartaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1689,42 +3649,42 @@ func (x *ListDartasResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDartasResponse.ProtoReflect.Descriptor instead.
-func (*ListDartasResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SectionReviewDartaResponse.ProtoReflect.Descriptor instead.
+func (*SectionReviewDartaResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ListDartasResponse) GetConnection() *DartaConnection {
+func (x *SectionReviewDartaResponse) GetDarta() *Darta {
 	if x != nil {
-		return x.Connection
+		return x.Darta
 	}
 	return nil
 }
 
-type GetMyDartasRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        DartaStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=darta.v1.DartaStatus" json:"status,omitempty"`
-	Pagination    *PaginationInput       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From context
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RequestDartaClarificationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DartaId         string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	Note            string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetMyDartasRequest) Reset() {
-	*x = GetMyDartasRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[18]
+func (x *RequestDartaClarificationRequest) Reset() {
+	*x = RequestDartaClarificationRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyDartasRequest) String() string {
+func (x *RequestDartaClarificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyDartasRequest) ProtoMessage() {}
+func (*RequestDartaClarificationRequest) ProtoMessage() {}
 
-func (x *GetMyDartasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[18]
+func (x *RequestDartaClarificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1735,54 +3695,54 @@ func (x *GetMyDartasRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyDartasRequest.ProtoReflect.Descriptor instead.
-func (*GetMyDartasRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use RequestDartaClarificationRequest.ProtoReflect.Descriptor instead.
+func (*RequestDartaClarificationRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetMyDartasRequest) GetStatus() DartaStatus {
+func (x *RequestDartaClarificationRequest) GetDartaId() string {
 	if x != nil {
-		return x.Status
+		return x.DartaId
 	}
-	return DartaStatus_DARTA_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *GetMyDartasRequest) GetPagination() *PaginationInput {
+func (x *RequestDartaClarificationRequest) GetNote() string {
 	if x != nil {
-		return x.Pagination
+		return x.Note
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMyDartasRequest) GetUserId() string {
+func (x *RequestDartaClarificationRequest) GetExpectedVersion() int64 {
 	if x != nil {
-		return x.UserId
+		return x.ExpectedVersion
 	}
-	return ""
+	return 0
 }
 
-type GetMyDartasResponse struct {
+type RequestDartaClarificationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Connection    *DartaConnection       `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyDartasResponse) Reset() {
-	*x = GetMyDartasResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[19]
+func (x *RequestDartaClarificationResponse) Reset() {
+	*x = RequestDartaClarificationResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyDartasResponse) String() string {
+func (x *RequestDartaClarificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyDartasResponse) ProtoMessage() {}
+func (*RequestDartaClarificationResponse) ProtoMessage() {}
 
-func (x *GetMyDartasResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[19]
+func (x *RequestDartaClarificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1793,43 +3753,42 @@ func (x *GetMyDartasResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyDartasResponse.ProtoReflect.Descriptor instead.
-func (*GetMyDartasResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RequestDartaClarificationResponse.ProtoReflect.Descriptor instead.
+func (*RequestDartaClarificationResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetMyDartasResponse) GetConnection() *DartaConnection {
+func (x *RequestDartaClarificationResponse) GetDarta() *Darta {
 	if x != nil {
-		return x.Connection
+		return x.Darta
 	}
 	return nil
 }
 
-type GetDartaStatsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Scope         Scope                  `protobuf:"varint,1,opt,name=scope,proto3,enum=darta.v1.Scope" json:"scope,omitempty"`
-	FiscalYearId  string                 `protobuf:"bytes,2,opt,name=fiscal_year_id,json=fiscalYearId,proto3" json:"fiscal_year_id,omitempty"`
-	WardId        string                 `protobuf:"bytes,3,opt,name=ward_id,json=wardId,proto3" json:"ward_id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ProvideDartaClarificationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DartaId         string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	Note            string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetDartaStatsRequest) Reset() {
-	*x = GetDartaStatsRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[20]
+func (x *ProvideDartaClarificationRequest) Reset() {
+	*x = ProvideDartaClarificationRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDartaStatsRequest) String() string {
+func (x *ProvideDartaClarificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDartaStatsRequest) ProtoMessage() {}
+func (*ProvideDartaClarificationRequest) ProtoMessage() {}
 
-func (x *GetDartaStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[20]
+func (x *ProvideDartaClarificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1840,61 +3799,54 @@ func (x *GetDartaStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDartaStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetDartaStatsRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *GetDartaStatsRequest) GetScope() Scope {
-	if x != nil {
-		return x.Scope
-	}
-	return Scope_SCOPE_UNSPECIFIED
+// Deprecated: Use ProvideDartaClarificationRequest.ProtoReflect.Descriptor instead.
+func (*ProvideDartaClarificationRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetDartaStatsRequest) GetFiscalYearId() string {
+func (x *ProvideDartaClarificationRequest) GetDartaId() string {
 	if x != nil {
-		return x.FiscalYearId
+		return x.DartaId
 	}
 	return ""
 }
 
-func (x *GetDartaStatsRequest) GetWardId() string {
+func (x *ProvideDartaClarificationRequest) GetNote() string {
 	if x != nil {
-		return x.WardId
+		return x.Note
 	}
 	return ""
 }
 
-func (x *GetDartaStatsRequest) GetTenantId() string {
+func (x *ProvideDartaClarificationRequest) GetExpectedVersion() int64 {
 	if x != nil {
-		return x.TenantId
+		return x.ExpectedVersion
 	}
-	return ""
+	return 0
 }
 
-type GetDartaStatsResponse struct {
+type ProvideDartaClarificationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stats         *DartaStats            `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDartaStatsResponse) Reset() {
-	*x = GetDartaStatsResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[21]
+func (x *ProvideDartaClarificationResponse) Reset() {
+	*x = ProvideDartaClarificationResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDartaStatsResponse) String() string {
+func (x *ProvideDartaClarificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDartaStatsResponse) ProtoMessage() {}
+func (*ProvideDartaClarificationResponse) ProtoMessage() {}
 
-func (x *GetDartaStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[21]
+func (x *ProvideDartaClarificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1905,41 +3857,41 @@ func (x *GetDartaStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDartaStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetDartaStatsResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ProvideDartaClarificationResponse.ProtoReflect.Descriptor instead.
+func (*ProvideDartaClarificationResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetDartaStatsResponse) GetStats() *DartaStats {
+func (x *ProvideDartaClarificationResponse) GetDarta() *Darta {
 	if x != nil {
-		return x.Stats
+		return x.Darta
 	}
 	return nil
 }
 
-// Mutation requests/responses
-type CreateDartaRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         *CreateDartaInput      `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AcceptDartaRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DartaId         string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateDartaRequest) Reset() {
-	*x = CreateDartaRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[22]
+func (x *AcceptDartaRequest) Reset() {
+	*x = AcceptDartaRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDartaRequest) String() string {
+func (x *AcceptDartaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDartaRequest) ProtoMessage() {}
+func (*AcceptDartaRequest) ProtoMessage() {}
 
-func (x *CreateDartaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[22]
+func (x *AcceptDartaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1950,40 +3902,47 @@ func (x *CreateDartaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDartaRequest.ProtoReflect.Descriptor instead.
-func (*CreateDartaRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use AcceptDartaRequest.ProtoReflect.Descriptor instead.
+func (*AcceptDartaRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *CreateDartaRequest) GetInput() *CreateDartaInput {
+func (x *AcceptDartaRequest) GetDartaId() string {
 	if x != nil {
-		return x.Input
+		return x.DartaId
 	}
-	return nil
+	return ""
 }
 
-type CreateDartaResponse struct {
+func (x *AcceptDartaRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type AcceptDartaResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDartaResponse) Reset() {
-	*x = CreateDartaResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[23]
+func (x *AcceptDartaResponse) Reset() {
+	*x = AcceptDartaResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDartaResponse) String() string {
+func (x *AcceptDartaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDartaResponse) ProtoMessage() {}
+func (*AcceptDartaResponse) ProtoMessage() {}
 
-func (x *CreateDartaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[23]
+func (x *AcceptDartaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1994,40 +3953,42 @@ func (x *CreateDartaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDartaResponse.ProtoReflect.Descriptor instead.
-func (*CreateDartaResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use AcceptDartaResponse.ProtoReflect.Descriptor instead.
+func (*AcceptDartaResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CreateDartaResponse) GetDarta() *Darta {
+func (x *AcceptDartaResponse) GetDarta() *Darta {
 	if x != nil {
 		return x.Darta
 	}
 	return nil
 }
 
-type SubmitDartaForReviewRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type MarkDartaActionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DartaId         string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	ActionNote      string                 `protobuf:"bytes,2,opt,name=action_note,json=actionNote,proto3" json:"action_note,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SubmitDartaForReviewRequest) Reset() {
-	*x = SubmitDartaForReviewRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[24]
+func (x *MarkDartaActionRequest) Reset() {
+	*x = MarkDartaActionRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitDartaForReviewRequest) String() string {
+func (x *MarkDartaActionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitDartaForReviewRequest) ProtoMessage() {}
+func (*MarkDartaActionRequest) ProtoMessage() {}
 
-func (x *SubmitDartaForReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[24]
+func (x *MarkDartaActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2038,40 +3999,54 @@ func (x *SubmitDartaForReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitDartaForReviewRequest.ProtoReflect.Descriptor instead.
-func (*SubmitDartaForReviewRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use MarkDartaActionRequest.ProtoReflect.Descriptor instead.
+func (*MarkDartaActionRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *SubmitDartaForReviewRequest) GetDartaId() string {
+func (x *MarkDartaActionRequest) GetDartaId() string {
 	if x != nil {
 		return x.DartaId
 	}
 	return ""
 }
 
-type SubmitDartaForReviewResponse struct {
+func (x *MarkDartaActionRequest) GetActionNote() string {
+	if x != nil {
+		return x.ActionNote
+	}
+	return ""
+}
+
+func (x *MarkDartaActionRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type MarkDartaActionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitDartaForReviewResponse) Reset() {
-	*x = SubmitDartaForReviewResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[25]
+func (x *MarkDartaActionResponse) Reset() {
+	*x = MarkDartaActionResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitDartaForReviewResponse) String() string {
+func (x *MarkDartaActionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitDartaForReviewResponse) ProtoMessage() {}
+func (*MarkDartaActionResponse) ProtoMessage() {}
 
-func (x *SubmitDartaForReviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[25]
+func (x *MarkDartaActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2082,40 +4057,43 @@ func (x *SubmitDartaForReviewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitDartaForReviewResponse.ProtoReflect.Descriptor instead.
-func (*SubmitDartaForReviewResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use MarkDartaActionResponse.ProtoReflect.Descriptor instead.
+func (*MarkDartaActionResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *SubmitDartaForReviewResponse) GetDarta() *Darta {
+func (x *MarkDartaActionResponse) GetDarta() *Darta {
 	if x != nil {
 		return x.Darta
 	}
 	return nil
 }
 
-type ReviewDartaRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         *ReviewDartaInput      `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type IssueDartaResponseRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DartaId           string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	ResponseChalaniId string                 `protobuf:"bytes,2,opt,name=response_chalani_id,json=responseChalaniId,proto3" json:"response_chalani_id,omitempty"`
+	DocAttachmentId   string                 `protobuf:"bytes,3,opt,name=doc_attachment_id,json=docAttachmentId,proto3" json:"doc_attachment_id,omitempty"`
+	ExpectedVersion   int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ReviewDartaRequest) Reset() {
-	*x = ReviewDartaRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[26]
+func (x *IssueDartaResponseRequest) Reset() {
+	*x = IssueDartaResponseRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReviewDartaRequest) String() string {
+func (x *IssueDartaResponseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReviewDartaRequest) ProtoMessage() {}
+func (*IssueDartaResponseRequest) ProtoMessage() {}
 
-func (x *ReviewDartaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[26]
+func (x *IssueDartaResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2126,40 +4104,61 @@ func (x *ReviewDartaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReviewDartaRequest.ProtoReflect.Descriptor instead.
-func (*ReviewDartaRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use IssueDartaResponseRequest.ProtoReflect.Descriptor instead.
+func (*IssueDartaResponseRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ReviewDartaRequest) GetInput() *ReviewDartaInput {
+func (x *IssueDartaResponseRequest) GetDartaId() string {
 	if x != nil {
-		return x.Input
+		return x.DartaId
 	}
-	return nil
+	return ""
 }
 
-type ReviewDartaResponse struct {
+func (x *IssueDartaResponseRequest) GetResponseChalaniId() string {
+	if x != nil {
+		return x.ResponseChalaniId
+	}
+	return ""
+}
+
+func (x *IssueDartaResponseRequest) GetDocAttachmentId() string {
+	if x != nil {
+		return x.DocAttachmentId
+	}
+	return ""
+}
+
+func (x *IssueDartaResponseRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type IssueDartaResponseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReviewDartaResponse) Reset() {
-	*x = ReviewDartaResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[27]
+func (x *IssueDartaResponseResponse) Reset() {
+	*x = IssueDartaResponseResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReviewDartaResponse) String() string {
+func (x *IssueDartaResponseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReviewDartaResponse) ProtoMessage() {}
+func (*IssueDartaResponseResponse) ProtoMessage() {}
 
-func (x *ReviewDartaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[27]
+func (x *IssueDartaResponseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2170,41 +4169,41 @@ func (x *ReviewDartaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReviewDartaResponse.ProtoReflect.Descriptor instead.
-func (*ReviewDartaResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use IssueDartaResponseResponse.ProtoReflect.Descriptor instead.
+func (*IssueDartaResponseResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ReviewDartaResponse) GetDarta() *Darta {
+func (x *IssueDartaResponseResponse) GetDarta() *Darta {
 	if x != nil {
 		return x.Darta
 	}
 	return nil
 }
 
-type ClassifyDartaRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	DartaId            string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
-	ClassificationCode string                 `protobuf:"bytes,2,opt,name=classification_code,json=classificationCode,proto3" json:"classification_code,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type RequestDartaAckRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DartaId         string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ClassifyDartaRequest) Reset() {
-	*x = ClassifyDartaRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[28]
+func (x *RequestDartaAckRequest) Reset() {
+	*x = RequestDartaAckRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClassifyDartaRequest) String() string {
+func (x *RequestDartaAckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClassifyDartaRequest) ProtoMessage() {}
+func (*RequestDartaAckRequest) ProtoMessage() {}
 
-func (x *ClassifyDartaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[28]
+func (x *RequestDartaAckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2215,47 +4214,47 @@ func (x *ClassifyDartaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClassifyDartaRequest.ProtoReflect.Descriptor instead.
-func (*ClassifyDartaRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use RequestDartaAckRequest.ProtoReflect.Descriptor instead.
+func (*RequestDartaAckRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ClassifyDartaRequest) GetDartaId() string {
+func (x *RequestDartaAckRequest) GetDartaId() string {
 	if x != nil {
 		return x.DartaId
 	}
 	return ""
 }
 
-func (x *ClassifyDartaRequest) GetClassificationCode() string {
+func (x *RequestDartaAckRequest) GetExpectedVersion() int64 {
 	if x != nil {
-		return x.ClassificationCode
+		return x.ExpectedVersion
 	}
-	return ""
+	return 0
 }
 
-type ClassifyDartaResponse struct {
+type RequestDartaAckResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClassifyDartaResponse) Reset() {
-	*x = ClassifyDartaResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[29]
+func (x *RequestDartaAckResponse) Reset() {
+	*x = RequestDartaAckResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClassifyDartaResponse) String() string {
+func (x *RequestDartaAckResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClassifyDartaResponse) ProtoMessage() {}
+func (*RequestDartaAckResponse) ProtoMessage() {}
 
-func (x *ClassifyDartaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[29]
+func (x *RequestDartaAckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2266,41 +4265,41 @@ func (x *ClassifyDartaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClassifyDartaResponse.ProtoReflect.Descriptor instead.
-func (*ClassifyDartaResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use RequestDartaAckResponse.ProtoReflect.Descriptor instead.
+func (*RequestDartaAckResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ClassifyDartaResponse) GetDarta() *Darta {
+func (x *RequestDartaAckResponse) GetDarta() *Darta {
 	if x != nil {
 		return x.Darta
 	}
 	return nil
 }
 
-type ReserveDartaNumberRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
-	AllocationId  string                 `protobuf:"bytes,2,opt,name=allocation_id,json=allocationId,proto3" json:"allocation_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ReceiveDartaAckRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DartaId         string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ReserveDartaNumberRequest) Reset() {
-	*x = ReserveDartaNumberRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[30]
+func (x *ReceiveDartaAckRequest) Reset() {
+	*x = ReceiveDartaAckRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReserveDartaNumberRequest) String() string {
+func (x *ReceiveDartaAckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveDartaNumberRequest) ProtoMessage() {}
+func (*ReceiveDartaAckRequest) ProtoMessage() {}
 
-func (x *ReserveDartaNumberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[30]
+func (x *ReceiveDartaAckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2311,47 +4310,47 @@ func (x *ReserveDartaNumberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReserveDartaNumberRequest.ProtoReflect.Descriptor instead.
-func (*ReserveDartaNumberRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ReceiveDartaAckRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveDartaAckRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ReserveDartaNumberRequest) GetDartaId() string {
+func (x *ReceiveDartaAckRequest) GetDartaId() string {
 	if x != nil {
 		return x.DartaId
 	}
 	return ""
 }
 
-func (x *ReserveDartaNumberRequest) GetAllocationId() string {
+func (x *ReceiveDartaAckRequest) GetExpectedVersion() int64 {
 	if x != nil {
-		return x.AllocationId
+		return x.ExpectedVersion
 	}
-	return ""
+	return 0
 }
 
-type ReserveDartaNumberResponse struct {
+type ReceiveDartaAckResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReserveDartaNumberResponse) Reset() {
-	*x = ReserveDartaNumberResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[31]
+func (x *ReceiveDartaAckResponse) Reset() {
+	*x = ReceiveDartaAckResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReserveDartaNumberResponse) String() string {
+func (x *ReceiveDartaAckResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveDartaNumberResponse) ProtoMessage() {}
+func (*ReceiveDartaAckResponse) ProtoMessage() {}
 
-func (x *ReserveDartaNumberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[31]
+func (x *ReceiveDartaAckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2362,41 +4361,43 @@ func (x *ReserveDartaNumberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReserveDartaNumberResponse.ProtoReflect.Descriptor instead.
-func (*ReserveDartaNumberResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ReceiveDartaAckResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveDartaAckResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ReserveDartaNumberResponse) GetDarta() *Darta {
+func (x *ReceiveDartaAckResponse) GetDarta() *Darta {
 	if x != nil {
 		return x.Darta
 	}
 	return nil
 }
 
-type FinalizeDartaRegistrationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
-	AllocationId  string                 `protobuf:"bytes,2,opt,name=allocation_id,json=allocationId,proto3" json:"allocation_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SupersedeDartaRecordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DartaId         string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	Reason          string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	NewDartaId      string                 `protobuf:"bytes,3,opt,name=new_darta_id,json=newDartaId,proto3" json:"new_darta_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *FinalizeDartaRegistrationRequest) Reset() {
-	*x = FinalizeDartaRegistrationRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[32]
+func (x *SupersedeDartaRecordRequest) Reset() {
+	*x = SupersedeDartaRecordRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FinalizeDartaRegistrationRequest) String() string {
+func (x *SupersedeDartaRecordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FinalizeDartaRegistrationRequest) ProtoMessage() {}
+func (*SupersedeDartaRecordRequest) ProtoMessage() {}
 
-func (x *FinalizeDartaRegistrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[32]
+func (x *SupersedeDartaRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2407,47 +4408,61 @@ func (x *FinalizeDartaRegistrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FinalizeDartaRegistrationRequest.ProtoReflect.Descriptor instead.
-func (*FinalizeDartaRegistrationRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use SupersedeDartaRecordRequest.ProtoReflect.Descriptor instead.
+func (*SupersedeDartaRecordRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *FinalizeDartaRegistrationRequest) GetDartaId() string {
+func (x *SupersedeDartaRecordRequest) GetDartaId() string {
 	if x != nil {
 		return x.DartaId
 	}
 	return ""
 }
 
-func (x *FinalizeDartaRegistrationRequest) GetAllocationId() string {
+func (x *SupersedeDartaRecordRequest) GetReason() string {
 	if x != nil {
-		return x.AllocationId
+		return x.Reason
 	}
 	return ""
 }
 
-type FinalizeDartaRegistrationResponse struct {
+func (x *SupersedeDartaRecordRequest) GetNewDartaId() string {
+	if x != nil {
+		return x.NewDartaId
+	}
+	return ""
+}
+
+func (x *SupersedeDartaRecordRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type SupersedeDartaRecordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FinalizeDartaRegistrationResponse) Reset() {
-	*x = FinalizeDartaRegistrationResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[33]
+func (x *SupersedeDartaRecordResponse) Reset() {
+	*x = SupersedeDartaRecordResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FinalizeDartaRegistrationResponse) String() string {
+func (x *SupersedeDartaRecordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FinalizeDartaRegistrationResponse) ProtoMessage() {}
+func (*SupersedeDartaRecordResponse) ProtoMessage() {}
 
-func (x *FinalizeDartaRegistrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[33]
+func (x *SupersedeDartaRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2458,40 +4473,40 @@ func (x *FinalizeDartaRegistrationResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FinalizeDartaRegistrationResponse.ProtoReflect.Descriptor instead.
-func (*FinalizeDartaRegistrationResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use SupersedeDartaRecordResponse.ProtoReflect.Descriptor instead.
+func (*SupersedeDartaRecordResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *FinalizeDartaRegistrationResponse) GetDarta() *Darta {
+func (x *SupersedeDartaRecordResponse) GetDarta() *Darta {
 	if x != nil {
 		return x.Darta
 	}
 	return nil
 }
 
-type DirectRegisterDartaRequest struct {
+type GetDartaSupersessionChainRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
-
-func (x *DirectRegisterDartaRequest) Reset() {
-	*x = DirectRegisterDartaRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[34]
+
+func (x *GetDartaSupersessionChainRequest) Reset() {
+	*x = GetDartaSupersessionChainRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DirectRegisterDartaRequest) String() string {
+func (x *GetDartaSupersessionChainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DirectRegisterDartaRequest) ProtoMessage() {}
+func (*GetDartaSupersessionChainRequest) ProtoMessage() {}
 
-func (x *DirectRegisterDartaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[34]
+func (x *GetDartaSupersessionChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2502,40 +4517,41 @@ func (x *DirectRegisterDartaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DirectRegisterDartaRequest.ProtoReflect.Descriptor instead.
-func (*DirectRegisterDartaRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use GetDartaSupersessionChainRequest.ProtoReflect.Descriptor instead.
+func (*GetDartaSupersessionChainRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *DirectRegisterDartaRequest) GetDartaId() string {
+func (x *GetDartaSupersessionChainRequest) GetDartaId() string {
 	if x != nil {
 		return x.DartaId
 	}
 	return ""
 }
 
-type DirectRegisterDartaResponse struct {
+// Dartas of the chain through darta_id, the one first superseded first
+type GetDartaSupersessionChainResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	Dartas        []*Darta               `protobuf:"bytes,1,rep,name=dartas,proto3" json:"dartas,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DirectRegisterDartaResponse) Reset() {
-	*x = DirectRegisterDartaResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[35]
+func (x *GetDartaSupersessionChainResponse) Reset() {
+	*x = GetDartaSupersessionChainResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DirectRegisterDartaResponse) String() string {
+func (x *GetDartaSupersessionChainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DirectRegisterDartaResponse) ProtoMessage() {}
+func (*GetDartaSupersessionChainResponse) ProtoMessage() {}
 
-func (x *DirectRegisterDartaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[35]
+func (x *GetDartaSupersessionChainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2546,41 +4562,41 @@ func (x *DirectRegisterDartaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DirectRegisterDartaResponse.ProtoReflect.Descriptor instead.
-func (*DirectRegisterDartaResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use GetDartaSupersessionChainResponse.ProtoReflect.Descriptor instead.
+func (*GetDartaSupersessionChainResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *DirectRegisterDartaResponse) GetDarta() *Darta {
+func (x *GetDartaSupersessionChainResponse) GetDartas() []*Darta {
 	if x != nil {
-		return x.Darta
+		return x.Dartas
 	}
 	return nil
 }
 
-type VoidDartaRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CloseDartaRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DartaId         string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *VoidDartaRequest) Reset() {
-	*x = VoidDartaRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[36]
+func (x *CloseDartaRequest) Reset() {
+	*x = CloseDartaRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VoidDartaRequest) String() string {
+func (x *CloseDartaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoidDartaRequest) ProtoMessage() {}
+func (*CloseDartaRequest) ProtoMessage() {}
 
-func (x *VoidDartaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[36]
+func (x *CloseDartaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2591,47 +4607,47 @@ func (x *VoidDartaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoidDartaRequest.ProtoReflect.Descriptor instead.
-func (*VoidDartaRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use CloseDartaRequest.ProtoReflect.Descriptor instead.
+func (*CloseDartaRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *VoidDartaRequest) GetDartaId() string {
+func (x *CloseDartaRequest) GetDartaId() string {
 	if x != nil {
 		return x.DartaId
 	}
 	return ""
 }
 
-func (x *VoidDartaRequest) GetReason() string {
+func (x *CloseDartaRequest) GetExpectedVersion() int64 {
 	if x != nil {
-		return x.Reason
+		return x.ExpectedVersion
 	}
-	return ""
+	return 0
 }
 
-type VoidDartaResponse struct {
+type CloseDartaResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VoidDartaResponse) Reset() {
-	*x = VoidDartaResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[37]
+func (x *CloseDartaResponse) Reset() {
+	*x = CloseDartaResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VoidDartaResponse) String() string {
+func (x *CloseDartaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoidDartaResponse) ProtoMessage() {}
+func (*CloseDartaResponse) ProtoMessage() {}
 
-func (x *VoidDartaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[37]
+func (x *CloseDartaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2642,41 +4658,41 @@ func (x *VoidDartaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoidDartaResponse.ProtoReflect.Descriptor instead.
-func (*VoidDartaResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use CloseDartaResponse.ProtoReflect.Descriptor instead.
+func (*CloseDartaResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *VoidDartaResponse) GetDarta() *Darta {
+func (x *CloseDartaResponse) GetDarta() *Darta {
 	if x != nil {
 		return x.Darta
 	}
 	return nil
 }
 
-type ScanDartaRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	DartaId          string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
-	ScanAttachmentId string                 `protobuf:"bytes,2,opt,name=scan_attachment_id,json=scanAttachmentId,proto3" json:"scan_attachment_id,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type WatchDartasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`                   // Only events for this darta
+	AssignedToMe  bool                   `protobuf:"varint,2,opt,name=assigned_to_me,json=assignedToMe,proto3" json:"assigned_to_me,omitempty"` // Only events entering or leaving the caller's queue, or escalated to the caller
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScanDartaRequest) Reset() {
-	*x = ScanDartaRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[38]
+func (x *WatchDartasRequest) Reset() {
+	*x = WatchDartasRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScanDartaRequest) String() string {
+func (x *WatchDartasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScanDartaRequest) ProtoMessage() {}
+func (*WatchDartasRequest) ProtoMessage() {}
 
-func (x *ScanDartaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[38]
+func (x *WatchDartasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2687,47 +4703,55 @@ func (x *ScanDartaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScanDartaRequest.ProtoReflect.Descriptor instead.
-func (*ScanDartaRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use WatchDartasRequest.ProtoReflect.Descriptor instead.
+func (*WatchDartasRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ScanDartaRequest) GetDartaId() string {
+func (x *WatchDartasRequest) GetDartaId() string {
 	if x != nil {
 		return x.DartaId
 	}
 	return ""
 }
 
-func (x *ScanDartaRequest) GetScanAttachmentId() string {
+func (x *WatchDartasRequest) GetAssignedToMe() bool {
 	if x != nil {
-		return x.ScanAttachmentId
+		return x.AssignedToMe
 	}
-	return ""
+	return false
 }
 
-type ScanDartaResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// DartaEvent is emitted after every successful darta mutation, and when the
+// SLA evaluator marks a breach or escalates one
+type DartaEvent struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Action             string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"` // RPC that produced the event, e.g. "RouteDarta", or SLA_BREACHED / SLA_ESCALATED
+	Darta              *Darta                 `protobuf:"bytes,2,opt,name=darta,proto3" json:"darta,omitempty"`   // State after the mutation
+	ActorId            string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	PreviousAssigneeId string                 `protobuf:"bytes,4,opt,name=previous_assignee_id,json=previousAssigneeId,proto3" json:"previous_assignee_id,omitempty"` // Set when the mutation changed the assignee
+	OccurredAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	SlaStage           string                 `protobuf:"bytes,6,opt,name=sla_stage,json=slaStage,proto3" json:"sla_stage,omitempty"`                  // Stage of an SLA_BREACHED or SLA_ESCALATED event
+	EscalatedToId      string                 `protobuf:"bytes,7,opt,name=escalated_to_id,json=escalatedToId,proto3" json:"escalated_to_id,omitempty"` // Unit head an SLA_ESCALATED event went to
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ScanDartaResponse) Reset() {
-	*x = ScanDartaResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[39]
+func (x *DartaEvent) Reset() {
+	*x = DartaEvent{}
+	mi := &file_darta_v1_darta_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScanDartaResponse) String() string {
+func (x *DartaEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScanDartaResponse) ProtoMessage() {}
+func (*DartaEvent) ProtoMessage() {}
 
-func (x *ScanDartaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[39]
+func (x *DartaEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2738,41 +4762,82 @@ func (x *ScanDartaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScanDartaResponse.ProtoReflect.Descriptor instead.
-func (*ScanDartaResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use DartaEvent.ProtoReflect.Descriptor instead.
+func (*DartaEvent) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ScanDartaResponse) GetDarta() *Darta {
+func (x *DartaEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DartaEvent) GetDarta() *Darta {
 	if x != nil {
 		return x.Darta
 	}
 	return nil
 }
 
-type EnrichDartaMetadataRequest struct {
+func (x *DartaEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *DartaEvent) GetPreviousAssigneeId() string {
+	if x != nil {
+		return x.PreviousAssigneeId
+	}
+	return ""
+}
+
+func (x *DartaEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *DartaEvent) GetSlaStage() string {
+	if x != nil {
+		return x.SlaStage
+	}
+	return ""
+}
+
+func (x *DartaEvent) GetEscalatedToId() string {
+	if x != nil {
+		return x.EscalatedToId
+	}
+	return ""
+}
+
+type BatchGetDartasRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
-	Metadata      *structpb.Struct       `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EnrichDartaMetadataRequest) Reset() {
-	*x = EnrichDartaMetadataRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[40]
+func (x *BatchGetDartasRequest) Reset() {
+	*x = BatchGetDartasRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EnrichDartaMetadataRequest) String() string {
+func (x *BatchGetDartasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnrichDartaMetadataRequest) ProtoMessage() {}
+func (*BatchGetDartasRequest) ProtoMessage() {}
 
-func (x *EnrichDartaMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[40]
+func (x *BatchGetDartasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2783,47 +4848,40 @@ func (x *EnrichDartaMetadataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnrichDartaMetadataRequest.ProtoReflect.Descriptor instead.
-func (*EnrichDartaMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *EnrichDartaMetadataRequest) GetDartaId() string {
-	if x != nil {
-		return x.DartaId
-	}
-	return ""
+// Deprecated: Use BatchGetDartasRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetDartasRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *EnrichDartaMetadataRequest) GetMetadata() *structpb.Struct {
+func (x *BatchGetDartasRequest) GetIds() []string {
 	if x != nil {
-		return x.Metadata
+		return x.Ids
 	}
 	return nil
 }
 
-type EnrichDartaMetadataResponse struct {
+type BatchGetDartasResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	Dartas        []*Darta               `protobuf:"bytes,1,rep,name=dartas,proto3" json:"dartas,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EnrichDartaMetadataResponse) Reset() {
-	*x = EnrichDartaMetadataResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[41]
+func (x *BatchGetDartasResponse) Reset() {
+	*x = BatchGetDartasResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EnrichDartaMetadataResponse) String() string {
+func (x *BatchGetDartasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnrichDartaMetadataResponse) ProtoMessage() {}
+func (*BatchGetDartasResponse) ProtoMessage() {}
 
-func (x *EnrichDartaMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[41]
+func (x *BatchGetDartasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2834,40 +4892,40 @@ func (x *EnrichDartaMetadataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnrichDartaMetadataResponse.ProtoReflect.Descriptor instead.
-func (*EnrichDartaMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use BatchGetDartasResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetDartasResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *EnrichDartaMetadataResponse) GetDarta() *Darta {
+func (x *BatchGetDartasResponse) GetDartas() []*Darta {
 	if x != nil {
-		return x.Darta
+		return x.Dartas
 	}
 	return nil
 }
 
-type FinalizeDartaArchiveRequest struct {
+type BatchGetApplicantsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FinalizeDartaArchiveRequest) Reset() {
-	*x = FinalizeDartaArchiveRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[42]
+func (x *BatchGetApplicantsRequest) Reset() {
+	*x = BatchGetApplicantsRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FinalizeDartaArchiveRequest) String() string {
+func (x *BatchGetApplicantsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FinalizeDartaArchiveRequest) ProtoMessage() {}
+func (*BatchGetApplicantsRequest) ProtoMessage() {}
 
-func (x *FinalizeDartaArchiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[42]
+func (x *BatchGetApplicantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2878,40 +4936,40 @@ func (x *FinalizeDartaArchiveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FinalizeDartaArchiveRequest.ProtoReflect.Descriptor instead.
-func (*FinalizeDartaArchiveRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use BatchGetApplicantsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetApplicantsRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *FinalizeDartaArchiveRequest) GetDartaId() string {
+func (x *BatchGetApplicantsRequest) GetIds() []string {
 	if x != nil {
-		return x.DartaId
+		return x.Ids
 	}
-	return ""
+	return nil
 }
 
-type FinalizeDartaArchiveResponse struct {
+type BatchGetApplicantsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	Applicants    []*Applicant           `protobuf:"bytes,1,rep,name=applicants,proto3" json:"applicants,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FinalizeDartaArchiveResponse) Reset() {
-	*x = FinalizeDartaArchiveResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[43]
+func (x *BatchGetApplicantsResponse) Reset() {
+	*x = BatchGetApplicantsResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FinalizeDartaArchiveResponse) String() string {
+func (x *BatchGetApplicantsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FinalizeDartaArchiveResponse) ProtoMessage() {}
+func (*BatchGetApplicantsResponse) ProtoMessage() {}
 
-func (x *FinalizeDartaArchiveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[43]
+func (x *BatchGetApplicantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2922,40 +4980,40 @@ func (x *FinalizeDartaArchiveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FinalizeDartaArchiveResponse.ProtoReflect.Descriptor instead.
-func (*FinalizeDartaArchiveResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use BatchGetApplicantsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetApplicantsResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *FinalizeDartaArchiveResponse) GetDarta() *Darta {
+func (x *BatchGetApplicantsResponse) GetApplicants() []*Applicant {
 	if x != nil {
-		return x.Darta
+		return x.Applicants
 	}
 	return nil
 }
 
-type RouteDartaRequest struct {
+type BatchGetAttachmentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         *RouteDartaInput       `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RouteDartaRequest) Reset() {
-	*x = RouteDartaRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[44]
+func (x *BatchGetAttachmentsRequest) Reset() {
+	*x = BatchGetAttachmentsRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RouteDartaRequest) String() string {
+func (x *BatchGetAttachmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteDartaRequest) ProtoMessage() {}
+func (*BatchGetAttachmentsRequest) ProtoMessage() {}
 
-func (x *RouteDartaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[44]
+func (x *BatchGetAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2966,40 +5024,40 @@ func (x *RouteDartaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteDartaRequest.ProtoReflect.Descriptor instead.
-func (*RouteDartaRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use BatchGetAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *RouteDartaRequest) GetInput() *RouteDartaInput {
+func (x *BatchGetAttachmentsRequest) GetIds() []string {
 	if x != nil {
-		return x.Input
+		return x.Ids
 	}
 	return nil
 }
 
-type RouteDartaResponse struct {
+type BatchGetAttachmentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RouteDartaResponse) Reset() {
-	*x = RouteDartaResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[45]
+func (x *BatchGetAttachmentsResponse) Reset() {
+	*x = BatchGetAttachmentsResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RouteDartaResponse) String() string {
+func (x *BatchGetAttachmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteDartaResponse) ProtoMessage() {}
+func (*BatchGetAttachmentsResponse) ProtoMessage() {}
 
-func (x *RouteDartaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[45]
+func (x *BatchGetAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3010,40 +5068,40 @@ func (x *RouteDartaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteDartaResponse.ProtoReflect.Descriptor instead.
-func (*RouteDartaResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use BatchGetAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *RouteDartaResponse) GetDarta() *Darta {
+func (x *BatchGetAttachmentsResponse) GetAttachments() []*Attachment {
 	if x != nil {
-		return x.Darta
+		return x.Attachments
 	}
 	return nil
 }
 
-type SectionReviewDartaRequest struct {
+type BatchGetDartaLinksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	DartaIds      []string               `protobuf:"bytes,1,rep,name=darta_ids,json=dartaIds,proto3" json:"darta_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SectionReviewDartaRequest) Reset() {
-	*x = SectionReviewDartaRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[46]
+func (x *BatchGetDartaLinksRequest) Reset() {
+	*x = BatchGetDartaLinksRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SectionReviewDartaRequest) String() string {
+func (x *BatchGetDartaLinksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SectionReviewDartaRequest) ProtoMessage() {}
+func (*BatchGetDartaLinksRequest) ProtoMessage() {}
 
-func (x *SectionReviewDartaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[46]
+func (x *BatchGetDartaLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3054,40 +5112,40 @@ func (x *SectionReviewDartaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SectionReviewDartaRequest.ProtoReflect.Descriptor instead.
-func (*SectionReviewDartaRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use BatchGetDartaLinksRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetDartaLinksRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *SectionReviewDartaRequest) GetDartaId() string {
+func (x *BatchGetDartaLinksRequest) GetDartaIds() []string {
 	if x != nil {
-		return x.DartaId
+		return x.DartaIds
 	}
-	return ""
+	return nil
 }
 
-type SectionReviewDartaResponse struct {
+type BatchGetDartaLinksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	Links         []*DartaLinks          `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SectionReviewDartaResponse) Reset() {
-	*x = SectionReviewDartaResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[47]
+func (x *BatchGetDartaLinksResponse) Reset() {
+	*x = BatchGetDartaLinksResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SectionReviewDartaResponse) String() string {
+func (x *BatchGetDartaLinksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SectionReviewDartaResponse) ProtoMessage() {}
+func (*BatchGetDartaLinksResponse) ProtoMessage() {}
 
-func (x *SectionReviewDartaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[47]
+func (x *BatchGetDartaLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3098,41 +5156,43 @@ func (x *SectionReviewDartaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SectionReviewDartaResponse.ProtoReflect.Descriptor instead.
-func (*SectionReviewDartaResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use BatchGetDartaLinksResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetDartaLinksResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *SectionReviewDartaResponse) GetDarta() *Darta {
+func (x *BatchGetDartaLinksResponse) GetLinks() []*DartaLinks {
 	if x != nil {
-		return x.Darta
+		return x.Links
 	}
 	return nil
 }
 
-type RequestDartaClarificationRequest struct {
+// DartaLinks are the records a darta points at besides its primary document
+type DartaLinks struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
-	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	AnnexIds      []string               `protobuf:"bytes,2,rep,name=annex_ids,json=annexIds,proto3" json:"annex_ids,omitempty"`
+	Relations     []*DartaRelation       `protobuf:"bytes,3,rep,name=relations,proto3" json:"relations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RequestDartaClarificationRequest) Reset() {
-	*x = RequestDartaClarificationRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[48]
+func (x *DartaLinks) Reset() {
+	*x = DartaLinks{}
+	mi := &file_darta_v1_darta_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestDartaClarificationRequest) String() string {
+func (x *DartaLinks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestDartaClarificationRequest) ProtoMessage() {}
+func (*DartaLinks) ProtoMessage() {}
 
-func (x *RequestDartaClarificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[48]
+func (x *DartaLinks) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3143,47 +5203,55 @@ func (x *RequestDartaClarificationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestDartaClarificationRequest.ProtoReflect.Descriptor instead.
-func (*RequestDartaClarificationRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use DartaLinks.ProtoReflect.Descriptor instead.
+func (*DartaLinks) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *RequestDartaClarificationRequest) GetDartaId() string {
+func (x *DartaLinks) GetDartaId() string {
 	if x != nil {
 		return x.DartaId
 	}
 	return ""
 }
 
-func (x *RequestDartaClarificationRequest) GetNote() string {
+func (x *DartaLinks) GetAnnexIds() []string {
 	if x != nil {
-		return x.Note
+		return x.AnnexIds
 	}
-	return ""
+	return nil
 }
 
-type RequestDartaClarificationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DartaLinks) GetRelations() []*DartaRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
 }
 
-func (x *RequestDartaClarificationResponse) Reset() {
-	*x = RequestDartaClarificationResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[49]
+type DartaRelation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RelatedDartaId   string                 `protobuf:"bytes,1,opt,name=related_darta_id,json=relatedDartaId,proto3" json:"related_darta_id,omitempty"`
+	RelationshipType string                 `protobuf:"bytes,2,opt,name=relationship_type,json=relationshipType,proto3" json:"relationship_type,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DartaRelation) Reset() {
+	*x = DartaRelation{}
+	mi := &file_darta_v1_darta_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestDartaClarificationResponse) String() string {
+func (x *DartaRelation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestDartaClarificationResponse) ProtoMessage() {}
+func (*DartaRelation) ProtoMessage() {}
 
-func (x *RequestDartaClarificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[49]
+func (x *DartaRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3194,41 +5262,49 @@ func (x *RequestDartaClarificationResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestDartaClarificationResponse.ProtoReflect.Descriptor instead.
-func (*RequestDartaClarificationResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use DartaRelation.ProtoReflect.Descriptor instead.
+func (*DartaRelation) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *RequestDartaClarificationResponse) GetDarta() *Darta {
+func (x *DartaRelation) GetRelatedDartaId() string {
 	if x != nil {
-		return x.Darta
+		return x.RelatedDartaId
 	}
-	return nil
+	return ""
 }
 
-type ProvideDartaClarificationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
-	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DartaRelation) GetRelationshipType() string {
+	if x != nil {
+		return x.RelationshipType
+	}
+	return ""
 }
 
-func (x *ProvideDartaClarificationRequest) Reset() {
-	*x = ProvideDartaClarificationRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[50]
+type BatchGetAuditTrailsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EntityType     string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"` // "DARTA" or "CHALANI"
+	EntityIds      []string               `protobuf:"bytes,2,rep,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
+	LimitPerEntity int32                  `protobuf:"varint,3,opt,name=limit_per_entity,json=limitPerEntity,proto3" json:"limit_per_entity,omitempty"` // Most recent entries per entity; defaults to 20
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BatchGetAuditTrailsRequest) Reset() {
+	*x = BatchGetAuditTrailsRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProvideDartaClarificationRequest) String() string {
+func (x *BatchGetAuditTrailsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProvideDartaClarificationRequest) ProtoMessage() {}
+func (*BatchGetAuditTrailsRequest) ProtoMessage() {}
 
-func (x *ProvideDartaClarificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[50]
+func (x *BatchGetAuditTrailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3239,47 +5315,54 @@ func (x *ProvideDartaClarificationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProvideDartaClarificationRequest.ProtoReflect.Descriptor instead.
-func (*ProvideDartaClarificationRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use BatchGetAuditTrailsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetAuditTrailsRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *ProvideDartaClarificationRequest) GetDartaId() string {
+func (x *BatchGetAuditTrailsRequest) GetEntityType() string {
 	if x != nil {
-		return x.DartaId
+		return x.EntityType
 	}
 	return ""
 }
 
-func (x *ProvideDartaClarificationRequest) GetNote() string {
+func (x *BatchGetAuditTrailsRequest) GetEntityIds() []string {
 	if x != nil {
-		return x.Note
+		return x.EntityIds
 	}
-	return ""
+	return nil
 }
 
-type ProvideDartaClarificationResponse struct {
+func (x *BatchGetAuditTrailsRequest) GetLimitPerEntity() int32 {
+	if x != nil {
+		return x.LimitPerEntity
+	}
+	return 0
+}
+
+type BatchGetAuditTrailsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // Grouped by entity_id, newest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProvideDartaClarificationResponse) Reset() {
-	*x = ProvideDartaClarificationResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[51]
+func (x *BatchGetAuditTrailsResponse) Reset() {
+	*x = BatchGetAuditTrailsResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProvideDartaClarificationResponse) String() string {
+func (x *BatchGetAuditTrailsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProvideDartaClarificationResponse) ProtoMessage() {}
+func (*BatchGetAuditTrailsResponse) ProtoMessage() {}
 
-func (x *ProvideDartaClarificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[51]
+func (x *BatchGetAuditTrailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3290,40 +5373,47 @@ func (x *ProvideDartaClarificationResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProvideDartaClarificationResponse.ProtoReflect.Descriptor instead.
-func (*ProvideDartaClarificationResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use BatchGetAuditTrailsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetAuditTrailsResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *ProvideDartaClarificationResponse) GetDarta() *Darta {
+func (x *BatchGetAuditTrailsResponse) GetEntries() []*AuditEntry {
 	if x != nil {
-		return x.Darta
+		return x.Entries
 	}
 	return nil
 }
 
-type AcceptDartaRequest struct {
+// SearchRecordsRequest searches the subject, body, applicant or recipient,
+// number, classification code and OCR text of dartas and chalanis. Queries
+// may be in Devanagari or romanized Nepali.
+type SearchRecordsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	EntityTypes   []string               `protobuf:"bytes,2,rep,name=entity_types,json=entityTypes,proto3" json:"entity_types,omitempty"` // DARTA, CHALANI; empty searches both
+	Filter        *SearchFilter          `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"` // Default 20, at most 100
+	After         string                 `protobuf:"bytes,5,opt,name=after,proto3" json:"after,omitempty"`  // end_cursor of the previous page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AcceptDartaRequest) Reset() {
-	*x = AcceptDartaRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[52]
+func (x *SearchRecordsRequest) Reset() {
+	*x = SearchRecordsRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcceptDartaRequest) String() string {
+func (x *SearchRecordsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptDartaRequest) ProtoMessage() {}
+func (*SearchRecordsRequest) ProtoMessage() {}
 
-func (x *AcceptDartaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[52]
+func (x *SearchRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3334,40 +5424,73 @@ func (x *AcceptDartaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptDartaRequest.ProtoReflect.Descriptor instead.
-func (*AcceptDartaRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use SearchRecordsRequest.ProtoReflect.Descriptor instead.
+func (*SearchRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *AcceptDartaRequest) GetDartaId() string {
+func (x *SearchRecordsRequest) GetQuery() string {
 	if x != nil {
-		return x.DartaId
+		return x.Query
 	}
 	return ""
 }
 
-type AcceptDartaResponse struct {
+func (x *SearchRecordsRequest) GetEntityTypes() []string {
+	if x != nil {
+		return x.EntityTypes
+	}
+	return nil
+}
+
+func (x *SearchRecordsRequest) GetFilter() *SearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchRecordsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchRecordsRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// SearchFilter narrows results by facet values. Each list matches any of
+// its values; an empty list applies no filter.
+type SearchFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	Statuses      []string               `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"` // e.g. REGISTERED, DISPATCHED
+	FiscalYearIds []string               `protobuf:"bytes,2,rep,name=fiscal_year_ids,json=fiscalYearIds,proto3" json:"fiscal_year_ids,omitempty"`
+	WardIds       []string               `protobuf:"bytes,3,rep,name=ward_ids,json=wardIds,proto3" json:"ward_ids,omitempty"`
+	Channels      []string               `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"` // Intake channel of dartas, dispatch channel of chalanis
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AcceptDartaResponse) Reset() {
-	*x = AcceptDartaResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[53]
+func (x *SearchFilter) Reset() {
+	*x = SearchFilter{}
+	mi := &file_darta_v1_darta_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcceptDartaResponse) String() string {
+func (x *SearchFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptDartaResponse) ProtoMessage() {}
+func (*SearchFilter) ProtoMessage() {}
 
-func (x *AcceptDartaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[53]
+func (x *SearchFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3378,41 +5501,65 @@ func (x *AcceptDartaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptDartaResponse.ProtoReflect.Descriptor instead.
-func (*AcceptDartaResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use SearchFilter.ProtoReflect.Descriptor instead.
+func (*SearchFilter) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *AcceptDartaResponse) GetDarta() *Darta {
+func (x *SearchFilter) GetStatuses() []string {
 	if x != nil {
-		return x.Darta
+		return x.Statuses
 	}
 	return nil
 }
 
-type MarkDartaActionRequest struct {
+func (x *SearchFilter) GetFiscalYearIds() []string {
+	if x != nil {
+		return x.FiscalYearIds
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetWardIds() []string {
+	if x != nil {
+		return x.WardIds
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type SearchRecordsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
-	ActionNote    string                 `protobuf:"bytes,2,opt,name=action_note,json=actionNote,proto3" json:"action_note,omitempty"`
+	Hits          []*SearchHit           `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`     // Best match first
+	Facets        []*SearchFacet         `protobuf:"bytes,2,rep,name=facets,proto3" json:"facets,omitempty"` // Counts over all matches, before filtering
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasNextPage   bool                   `protobuf:"varint,4,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	EndCursor     string                 `protobuf:"bytes,5,opt,name=end_cursor,json=endCursor,proto3" json:"end_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarkDartaActionRequest) Reset() {
-	*x = MarkDartaActionRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[54]
+func (x *SearchRecordsResponse) Reset() {
+	*x = SearchRecordsResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkDartaActionRequest) String() string {
+func (x *SearchRecordsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkDartaActionRequest) ProtoMessage() {}
+func (*SearchRecordsResponse) ProtoMessage() {}
 
-func (x *MarkDartaActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[54]
+func (x *SearchRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3423,47 +5570,80 @@ func (x *MarkDartaActionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkDartaActionRequest.ProtoReflect.Descriptor instead.
-func (*MarkDartaActionRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use SearchRecordsResponse.ProtoReflect.Descriptor instead.
+func (*SearchRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *MarkDartaActionRequest) GetDartaId() string {
+func (x *SearchRecordsResponse) GetHits() []*SearchHit {
 	if x != nil {
-		return x.DartaId
+		return x.Hits
 	}
-	return ""
+	return nil
 }
 
-func (x *MarkDartaActionRequest) GetActionNote() string {
+func (x *SearchRecordsResponse) GetFacets() []*SearchFacet {
 	if x != nil {
-		return x.ActionNote
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *SearchRecordsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchRecordsResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *SearchRecordsResponse) GetEndCursor() string {
+	if x != nil {
+		return x.EndCursor
 	}
 	return ""
 }
 
-type MarkDartaActionResponse struct {
+type SearchHit struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`   // Subject
+	Number        string                 `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"` // Formatted darta or chalani number, if assigned
+	Party         string                 `protobuf:"bytes,5,opt,name=party,proto3" json:"party,omitempty"`   // Applicant or recipient
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	FiscalYearId  string                 `protobuf:"bytes,7,opt,name=fiscal_year_id,json=fiscalYearId,proto3" json:"fiscal_year_id,omitempty"`
+	WardId        string                 `protobuf:"bytes,8,opt,name=ward_id,json=wardId,proto3" json:"ward_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,9,opt,name=channel,proto3" json:"channel,omitempty"`
+	Score         float64                `protobuf:"fixed64,10,opt,name=score,proto3" json:"score,omitempty"`
+	Snippet       string                 `protobuf:"bytes,11,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	SnippetField  string                 `protobuf:"bytes,12,opt,name=snippet_field,json=snippetField,proto3" json:"snippet_field,omitempty"` // content, title, party or number
+	Highlights    []*TextRange           `protobuf:"bytes,13,rep,name=highlights,proto3" json:"highlights,omitempty"`                         // Matches within snippet
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarkDartaActionResponse) Reset() {
-	*x = MarkDartaActionResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[55]
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_darta_v1_darta_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkDartaActionResponse) String() string {
+func (x *SearchHit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkDartaActionResponse) ProtoMessage() {}
+func (*SearchHit) ProtoMessage() {}
 
-func (x *MarkDartaActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[55]
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3474,100 +5654,126 @@ func (x *MarkDartaActionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkDartaActionResponse.ProtoReflect.Descriptor instead.
-func (*MarkDartaActionResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *MarkDartaActionResponse) GetDarta() *Darta {
+func (x *SearchHit) GetEntityType() string {
 	if x != nil {
-		return x.Darta
+		return x.EntityType
 	}
-	return nil
+	return ""
 }
 
-type IssueDartaResponseRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	DartaId           string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
-	ResponseChalaniId string                 `protobuf:"bytes,2,opt,name=response_chalani_id,json=responseChalaniId,proto3" json:"response_chalani_id,omitempty"`
-	DocAttachmentId   string                 `protobuf:"bytes,3,opt,name=doc_attachment_id,json=docAttachmentId,proto3" json:"doc_attachment_id,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *SearchHit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *IssueDartaResponseRequest) Reset() {
-	*x = IssueDartaResponseRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[56]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SearchHit) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (x *IssueDartaResponseRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SearchHit) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *SearchHit) GetParty() string {
+	if x != nil {
+		return x.Party
+	}
+	return ""
+}
+
+func (x *SearchHit) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SearchHit) GetFiscalYearId() string {
+	if x != nil {
+		return x.FiscalYearId
+	}
+	return ""
 }
 
-func (*IssueDartaResponseRequest) ProtoMessage() {}
+func (x *SearchHit) GetWardId() string {
+	if x != nil {
+		return x.WardId
+	}
+	return ""
+}
 
-func (x *IssueDartaResponseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[56]
+func (x *SearchHit) GetChannel() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Channel
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use IssueDartaResponseRequest.ProtoReflect.Descriptor instead.
-func (*IssueDartaResponseRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{56}
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
 }
 
-func (x *IssueDartaResponseRequest) GetDartaId() string {
+func (x *SearchHit) GetSnippet() string {
 	if x != nil {
-		return x.DartaId
+		return x.Snippet
 	}
 	return ""
 }
 
-func (x *IssueDartaResponseRequest) GetResponseChalaniId() string {
+func (x *SearchHit) GetSnippetField() string {
 	if x != nil {
-		return x.ResponseChalaniId
+		return x.SnippetField
 	}
 	return ""
 }
 
-func (x *IssueDartaResponseRequest) GetDocAttachmentId() string {
+func (x *SearchHit) GetHighlights() []*TextRange {
 	if x != nil {
-		return x.DocAttachmentId
+		return x.Highlights
 	}
-	return ""
+	return nil
 }
 
-type IssueDartaResponseResponse struct {
+// TextRange is a span of text in characters (Unicode code points)
+type TextRange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IssueDartaResponseResponse) Reset() {
-	*x = IssueDartaResponseResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[57]
+func (x *TextRange) Reset() {
+	*x = TextRange{}
+	mi := &file_darta_v1_darta_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueDartaResponseResponse) String() string {
+func (x *TextRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueDartaResponseResponse) ProtoMessage() {}
+func (*TextRange) ProtoMessage() {}
 
-func (x *IssueDartaResponseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[57]
+func (x *TextRange) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3578,40 +5784,48 @@ func (x *IssueDartaResponseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueDartaResponseResponse.ProtoReflect.Descriptor instead.
-func (*IssueDartaResponseResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use TextRange.ProtoReflect.Descriptor instead.
+func (*TextRange) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *IssueDartaResponseResponse) GetDarta() *Darta {
+func (x *TextRange) GetStart() int32 {
 	if x != nil {
-		return x.Darta
+		return x.Start
 	}
-	return nil
+	return 0
 }
 
-type RequestDartaAckRequest struct {
+func (x *TextRange) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type SearchFacet struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // status, fiscal_year, ward or channel
+	Values        []*SearchFacetValue    `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RequestDartaAckRequest) Reset() {
-	*x = RequestDartaAckRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[58]
+func (x *SearchFacet) Reset() {
+	*x = SearchFacet{}
+	mi := &file_darta_v1_darta_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestDartaAckRequest) String() string {
+func (x *SearchFacet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestDartaAckRequest) ProtoMessage() {}
+func (*SearchFacet) ProtoMessage() {}
 
-func (x *RequestDartaAckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[58]
+func (x *SearchFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3622,40 +5836,48 @@ func (x *RequestDartaAckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestDartaAckRequest.ProtoReflect.Descriptor instead.
-func (*RequestDartaAckRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use SearchFacet.ProtoReflect.Descriptor instead.
+func (*SearchFacet) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *RequestDartaAckRequest) GetDartaId() string {
+func (x *SearchFacet) GetField() string {
 	if x != nil {
-		return x.DartaId
+		return x.Field
 	}
 	return ""
 }
 
-type RequestDartaAckResponse struct {
+func (x *SearchFacet) GetValues() []*SearchFacetValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type SearchFacetValue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RequestDartaAckResponse) Reset() {
-	*x = RequestDartaAckResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[59]
+func (x *SearchFacetValue) Reset() {
+	*x = SearchFacetValue{}
+	mi := &file_darta_v1_darta_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestDartaAckResponse) String() string {
+func (x *SearchFacetValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestDartaAckResponse) ProtoMessage() {}
+func (*SearchFacetValue) ProtoMessage() {}
 
-func (x *RequestDartaAckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[59]
+func (x *SearchFacetValue) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3666,40 +5888,61 @@ func (x *RequestDartaAckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestDartaAckResponse.ProtoReflect.Descriptor instead.
-func (*RequestDartaAckResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use SearchFacetValue.ProtoReflect.Descriptor instead.
+func (*SearchFacetValue) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *RequestDartaAckResponse) GetDarta() *Darta {
+func (x *SearchFacetValue) GetValue() string {
 	if x != nil {
-		return x.Darta
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-type ReceiveDartaAckRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+func (x *SearchFacetValue) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// DuplicateCandidate is an existing darta suspected to duplicate another.
+// Candidates are found by applicant identifiers, primary document checksum
+// and subject similarity among dartas received near the same date, in any
+// ward. Blocking above a score threshold is configured per deployment.
+type DuplicateCandidate struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	DartaId              string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	FormattedDartaNumber string                 `protobuf:"bytes,2,opt,name=formatted_darta_number,json=formattedDartaNumber,proto3" json:"formatted_darta_number,omitempty"`
+	Subject              string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Status               DartaStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=darta.v1.DartaStatus" json:"status,omitempty"`
+	WardId               string                 `protobuf:"bytes,5,opt,name=ward_id,json=wardId,proto3" json:"ward_id,omitempty"`
+	ReceivedDate         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=received_date,json=receivedDate,proto3" json:"received_date,omitempty"`
+	ApplicantName        string                 `protobuf:"bytes,7,opt,name=applicant_name,json=applicantName,proto3" json:"applicant_name,omitempty"`
+	Score                float64                `protobuf:"fixed64,8,opt,name=score,proto3" json:"score,omitempty"` // 0 to 1
+	// SAME_APPLICANT, SAME_IDENTIFICATION_NUMBER, SAME_PHONE, SAME_EMAIL,
+	// SAME_PRIMARY_DOCUMENT, SIMILAR_SUBJECT, RECEIVED_SAME_DAY
+	Reasons       []string `protobuf:"bytes,9,rep,name=reasons,proto3" json:"reasons,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReceiveDartaAckRequest) Reset() {
-	*x = ReceiveDartaAckRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[60]
+func (x *DuplicateCandidate) Reset() {
+	*x = DuplicateCandidate{}
+	mi := &file_darta_v1_darta_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReceiveDartaAckRequest) String() string {
+func (x *DuplicateCandidate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiveDartaAckRequest) ProtoMessage() {}
+func (*DuplicateCandidate) ProtoMessage() {}
 
-func (x *ReceiveDartaAckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[60]
+func (x *DuplicateCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3710,86 +5953,96 @@ func (x *ReceiveDartaAckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiveDartaAckRequest.ProtoReflect.Descriptor instead.
-func (*ReceiveDartaAckRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use DuplicateCandidate.ProtoReflect.Descriptor instead.
+func (*DuplicateCandidate) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *ReceiveDartaAckRequest) GetDartaId() string {
+func (x *DuplicateCandidate) GetDartaId() string {
 	if x != nil {
 		return x.DartaId
 	}
 	return ""
 }
 
-type ReceiveDartaAckResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DuplicateCandidate) GetFormattedDartaNumber() string {
+	if x != nil {
+		return x.FormattedDartaNumber
+	}
+	return ""
 }
 
-func (x *ReceiveDartaAckResponse) Reset() {
-	*x = ReceiveDartaAckResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[61]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DuplicateCandidate) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
 }
 
-func (x *ReceiveDartaAckResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DuplicateCandidate) GetStatus() DartaStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DartaStatus_DARTA_STATUS_UNSPECIFIED
 }
 
-func (*ReceiveDartaAckResponse) ProtoMessage() {}
+func (x *DuplicateCandidate) GetWardId() string {
+	if x != nil {
+		return x.WardId
+	}
+	return ""
+}
 
-func (x *ReceiveDartaAckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[61]
+func (x *DuplicateCandidate) GetReceivedDate() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ReceivedDate
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ReceiveDartaAckResponse.ProtoReflect.Descriptor instead.
-func (*ReceiveDartaAckResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{61}
+func (x *DuplicateCandidate) GetApplicantName() string {
+	if x != nil {
+		return x.ApplicantName
+	}
+	return ""
 }
 
-func (x *ReceiveDartaAckResponse) GetDarta() *Darta {
+func (x *DuplicateCandidate) GetScore() float64 {
 	if x != nil {
-		return x.Darta
+		return x.Score
+	}
+	return 0
+}
+
+func (x *DuplicateCandidate) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
 	}
 	return nil
 }
 
-type SupersedeDartaRecordRequest struct {
+type ListDuplicateCandidatesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	NewDartaId    string                 `protobuf:"bytes,3,opt,name=new_darta_id,json=newDartaId,proto3" json:"new_darta_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SupersedeDartaRecordRequest) Reset() {
-	*x = SupersedeDartaRecordRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[62]
+func (x *ListDuplicateCandidatesRequest) Reset() {
+	*x = ListDuplicateCandidatesRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SupersedeDartaRecordRequest) String() string {
+func (x *ListDuplicateCandidatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SupersedeDartaRecordRequest) ProtoMessage() {}
+func (*ListDuplicateCandidatesRequest) ProtoMessage() {}
 
-func (x *SupersedeDartaRecordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[62]
+func (x *ListDuplicateCandidatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3800,54 +6053,40 @@ func (x *SupersedeDartaRecordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SupersedeDartaRecordRequest.ProtoReflect.Descriptor instead.
-func (*SupersedeDartaRecordRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use ListDuplicateCandidatesRequest.ProtoReflect.Descriptor instead.
+func (*ListDuplicateCandidatesRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *SupersedeDartaRecordRequest) GetDartaId() string {
+func (x *ListDuplicateCandidatesRequest) GetDartaId() string {
 	if x != nil {
 		return x.DartaId
 	}
 	return ""
 }
 
-func (x *SupersedeDartaRecordRequest) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-func (x *SupersedeDartaRecordRequest) GetNewDartaId() string {
-	if x != nil {
-		return x.NewDartaId
-	}
-	return ""
-}
-
-type SupersedeDartaRecordResponse struct {
+type ListDuplicateCandidatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
+	Candidates    []*DuplicateCandidate  `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"` // Best match first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SupersedeDartaRecordResponse) Reset() {
-	*x = SupersedeDartaRecordResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[63]
+func (x *ListDuplicateCandidatesResponse) Reset() {
+	*x = ListDuplicateCandidatesResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SupersedeDartaRecordResponse) String() string {
+func (x *ListDuplicateCandidatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SupersedeDartaRecordResponse) ProtoMessage() {}
+func (*ListDuplicateCandidatesResponse) ProtoMessage() {}
 
-func (x *SupersedeDartaRecordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[63]
+func (x *ListDuplicateCandidatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3858,40 +6097,45 @@ func (x *SupersedeDartaRecordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SupersedeDartaRecordResponse.ProtoReflect.Descriptor instead.
-func (*SupersedeDartaRecordResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use ListDuplicateCandidatesResponse.ProtoReflect.Descriptor instead.
+func (*ListDuplicateCandidatesResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *SupersedeDartaRecordResponse) GetDarta() *Darta {
+func (x *ListDuplicateCandidatesResponse) GetCandidates() []*DuplicateCandidate {
 	if x != nil {
-		return x.Darta
+		return x.Candidates
 	}
 	return nil
 }
 
-type CloseDartaRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DartaId       string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// LinkDuplicateDartaRequest records that a darta duplicates an earlier one,
+// as a DUPLICATE_OF darta relationship
+type LinkDuplicateDartaRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DartaId         string                 `protobuf:"bytes,1,opt,name=darta_id,json=dartaId,proto3" json:"darta_id,omitempty"`
+	DuplicateOfId   string                 `protobuf:"bytes,2,opt,name=duplicate_of_id,json=duplicateOfId,proto3" json:"duplicate_of_id,omitempty"`
+	Notes           string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 applies to whatever version is current
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CloseDartaRequest) Reset() {
-	*x = CloseDartaRequest{}
-	mi := &file_darta_v1_darta_proto_msgTypes[64]
+func (x *LinkDuplicateDartaRequest) Reset() {
+	*x = LinkDuplicateDartaRequest{}
+	mi := &file_darta_v1_darta_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloseDartaRequest) String() string {
+func (x *LinkDuplicateDartaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseDartaRequest) ProtoMessage() {}
+func (*LinkDuplicateDartaRequest) ProtoMessage() {}
 
-func (x *CloseDartaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[64]
+func (x *LinkDuplicateDartaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3902,40 +6146,61 @@ func (x *CloseDartaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseDartaRequest.ProtoReflect.Descriptor instead.
-func (*CloseDartaRequest) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use LinkDuplicateDartaRequest.ProtoReflect.Descriptor instead.
+func (*LinkDuplicateDartaRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *CloseDartaRequest) GetDartaId() string {
+func (x *LinkDuplicateDartaRequest) GetDartaId() string {
 	if x != nil {
 		return x.DartaId
 	}
 	return ""
 }
 
-type CloseDartaResponse struct {
+func (x *LinkDuplicateDartaRequest) GetDuplicateOfId() string {
+	if x != nil {
+		return x.DuplicateOfId
+	}
+	return ""
+}
+
+func (x *LinkDuplicateDartaRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *LinkDuplicateDartaRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type LinkDuplicateDartaResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Darta         *Darta                 `protobuf:"bytes,1,opt,name=darta,proto3" json:"darta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CloseDartaResponse) Reset() {
-	*x = CloseDartaResponse{}
-	mi := &file_darta_v1_darta_proto_msgTypes[65]
+func (x *LinkDuplicateDartaResponse) Reset() {
+	*x = LinkDuplicateDartaResponse{}
+	mi := &file_darta_v1_darta_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloseDartaResponse) String() string {
+func (x *LinkDuplicateDartaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseDartaResponse) ProtoMessage() {}
+func (*LinkDuplicateDartaResponse) ProtoMessage() {}
 
-func (x *CloseDartaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_darta_v1_darta_proto_msgTypes[65]
+func (x *LinkDuplicateDartaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_darta_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3946,12 +6211,12 @@ func (x *CloseDartaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseDartaResponse.ProtoReflect.Descriptor instead.
-func (*CloseDartaResponse) Descriptor() ([]byte, []int) {
-	return file_darta_v1_darta_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use LinkDuplicateDartaResponse.ProtoReflect.Descriptor instead.
+func (*LinkDuplicateDartaResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_darta_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *CloseDartaResponse) GetDarta() *Darta {
+func (x *LinkDuplicateDartaResponse) GetDarta() *Darta {
 	if x != nil {
 		return x.Darta
 	}
@@ -3962,7 +6227,7 @@ var File_darta_v1_darta_proto protoreflect.FileDescriptor
 
 const file_darta_v1_darta_proto_rawDesc = "" +
 	"\n" +
-	"\x14darta/v1/darta.proto\x12\bdarta.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x15darta/v1/common.proto\"\xb4\v\n" +
+	"\x14darta/v1/darta.proto\x12\bdarta.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x15darta/v1/common.proto\"\xb4\r\n" +
 	"\x05Darta\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\fdarta_number\x18\x02 \x01(\x05R\vdartaNumber\x124\n" +
@@ -4002,7 +6267,13 @@ const file_darta_v1_darta_proto_rawDesc = "" +
 	"auditTrail\x120\n" +
 	"\x14chalani_response_ids\x18\x1c \x03(\tR\x12chalaniResponseIds\x12*\n" +
 	"\x11related_darta_ids\x18\x1d \x03(\tR\x0frelatedDartaIds\x12\x1b\n" +
-	"\ttenant_id\x18\x1e \x01(\tR\btenantId\"\x84\x02\n" +
+	"\ttenant_id\x18\x1e \x01(\tR\btenantId\x12(\n" +
+	"\x10received_date_bs\x18\x1f \x01(\tR\x0ereceivedDateBs\x12(\n" +
+	"\x10superseded_by_id\x18  \x01(\tR\x0esupersededById\x12#\n" +
+	"\rsupersedes_id\x18! \x01(\tR\fsupersedesId\x126\n" +
+	"\x17supersedes_darta_number\x18\" \x01(\tR\x15supersedesDartaNumber\x12\x18\n" +
+	"\aversion\x18# \x01(\x03R\aversion\x123\n" +
+	"\bmetadata\x18$ \x01(\v2\x17.google.protobuf.StructR\bmetadata\"\x84\x02\n" +
 	"\tApplicant\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x17.darta.v1.ApplicantTypeR\x04type\x12\x1b\n" +
@@ -4017,7 +6288,7 @@ const file_darta_v1_darta_proto_rawDesc = "" +
 	"\tpage_info\x18\x02 \x01(\v2\x12.darta.v1.PageInfoR\bpageInfo\"H\n" +
 	"\tDartaEdge\x12\x16\n" +
 	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12#\n" +
-	"\x04node\x18\x02 \x01(\v2\x0f.darta.v1.DartaR\x04node\"\xf2\x01\n" +
+	"\x04node\x18\x02 \x01(\v2\x0f.darta.v1.DartaR\x04node\"\xc1\x05\n" +
 	"\n" +
 	"DartaStats\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x127\n" +
@@ -4025,7 +6296,36 @@ const file_darta_v1_darta_proto_rawDesc = "" +
 	"\n" +
 	"by_channel\x18\x03 \x03(\v2\x16.darta.v1.ChannelCountR\tbyChannel\x12#\n" +
 	"\roverdue_count\x18\x04 \x01(\x05R\foverdueCount\x129\n" +
-	"\x19avg_processing_time_hours\x18\x05 \x01(\x01R\x16avgProcessingTimeHours\"W\n" +
+	"\x19avg_processing_time_hours\x18\x05 \x01(\x01R\x16avgProcessingTimeHours\x12M\n" +
+	"\x16received_to_registered\x18\x06 \x01(\v2\x17.darta.v1.DurationStatsR\x14receivedToRegistered\x12I\n" +
+	"\x14registered_to_closed\x18\a \x01(\v2\x17.darta.v1.DurationStatsR\x12registeredToClosed\x126\n" +
+	"\aby_ward\x18\b \x03(\v2\x1d.darta.v1.DartaStatsBreakdownR\x06byWard\x12<\n" +
+	"\n" +
+	"by_section\x18\t \x03(\v2\x1d.darta.v1.DartaStatsBreakdownR\tbySection\x12I\n" +
+	"\x11by_intake_channel\x18\n" +
+	" \x03(\v2\x1d.darta.v1.DartaStatsBreakdownR\x0fbyIntakeChannel\x12>\n" +
+	"\vby_priority\x18\v \x03(\v2\x1d.darta.v1.DartaStatsBreakdownR\n" +
+	"byPriority\x122\n" +
+	"\x06series\x18\f \x03(\v2\x1a.darta.v1.DartaStatsBucketR\x06series\"_\n" +
+	"\rDurationStats\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12\x1b\n" +
+	"\tavg_hours\x18\x02 \x01(\x01R\bavgHours\x12\x1b\n" +
+	"\tp90_hours\x18\x03 \x01(\x01R\bp90Hours\"\xc4\x02\n" +
+	"\x13DartaStatsBreakdown\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12#\n" +
+	"\roverdue_count\x18\x03 \x01(\x05R\foverdueCount\x12M\n" +
+	"\x16received_to_registered\x18\x04 \x01(\v2\x17.darta.v1.DurationStatsR\x14receivedToRegistered\x12I\n" +
+	"\x14registered_to_closed\x18\x05 \x01(\v2\x17.darta.v1.DurationStatsR\x12registeredToClosed\x12F\n" +
+	"\x12section_turnaround\x18\x06 \x01(\v2\x17.darta.v1.DurationStatsR\x11sectionTurnaround\"\xb3\x01\n" +
+	"\x10DartaStatsBucket\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x19\n" +
+	"\bstart_bs\x18\x02 \x01(\tR\astartBs\x12\x1a\n" +
+	"\breceived\x18\x03 \x01(\x05R\breceived\x12\x1e\n" +
+	"\n" +
+	"registered\x18\x04 \x01(\x05R\n" +
+	"registered\x12\x16\n" +
+	"\x06closed\x18\x05 \x01(\x05R\x06closed\"W\n" +
 	"\x10DartaStatusCount\x12-\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x15.darta.v1.DartaStatusR\x06status\x12\x14\n" +
 	"\x05count\x18\x02 \x01(\x05R\x05count\"W\n" +
@@ -4056,7 +6356,7 @@ const file_darta_v1_darta_proto_rawDesc = "" +
 	"\x05email\x18\x04 \x01(\tR\x05email\x12\x14\n" +
 	"\x05phone\x18\x05 \x01(\tR\x05phone\x12\x18\n" +
 	"\aaddress\x18\x06 \x01(\tR\aaddress\x123\n" +
-	"\x15identification_number\x18\a \x01(\tR\x14identificationNumber\"\xe8\x03\n" +
+	"\x15identification_number\x18\a \x01(\tR\x14identificationNumber\"\xc8\x04\n" +
 	"\x10CreateDartaInput\x12%\n" +
 	"\x05scope\x18\x01 \x01(\x0e2\x0f.darta.v1.ScopeR\x05scope\x12\x17\n" +
 	"\award_id\x18\x02 \x01(\tR\x06wardId\x12\x18\n" +
@@ -4069,7 +6369,9 @@ const file_darta_v1_darta_proto_rawDesc = "" +
 	"\bpriority\x18\t \x01(\x0e2\x12.darta.v1.PriorityR\bpriority\x12'\n" +
 	"\x0fidempotency_key\x18\n" +
 	" \x01(\tR\x0eidempotencyKey\x12\x1b\n" +
-	"\ttenant_id\x18\v \x01(\tR\btenantId\"\xe6\x01\n" +
+	"\ttenant_id\x18\v \x01(\tR\btenantId\x125\n" +
+	"\x16acknowledge_duplicates\x18\f \x01(\bR\x15acknowledgeDuplicates\x12'\n" +
+	"\x0fbackdate_reason\x18\r \x01(\tR\x0ebackdateReason\"\x91\x02\n" +
 	"\x0fRouteDartaInput\x12\x19\n" +
 	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x124\n" +
 	"\x16organizational_unit_id\x18\x02 \x01(\tR\x14organizationalUnitId\x12\x1f\n" +
@@ -4077,12 +6379,14 @@ const file_darta_v1_darta_proto_rawDesc = "" +
 	"assigneeId\x12.\n" +
 	"\bpriority\x18\x04 \x01(\x0e2\x12.darta.v1.PriorityR\bpriority\x12\x1b\n" +
 	"\tsla_hours\x18\x05 \x01(\x05R\bslaHours\x12\x14\n" +
-	"\x05notes\x18\x06 \x01(\tR\x05notes\"\xa5\x01\n" +
+	"\x05notes\x18\x06 \x01(\tR\x05notes\x12)\n" +
+	"\x10expected_version\x18\a \x01(\x03R\x0fexpectedVersion\"\xd0\x01\n" +
 	"\x10ReviewDartaInput\x12\x19\n" +
 	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12\x14\n" +
 	"\x05notes\x18\x02 \x01(\tR\x05notes\x129\n" +
 	"\bdecision\x18\x03 \x01(\x0e2\x1d.darta.v1.DartaReviewDecisionR\bdecision\x12%\n" +
-	"\x0erequested_info\x18\x04 \x01(\tR\rrequestedInfo\"!\n" +
+	"\x0erequested_info\x18\x04 \x01(\tR\rrequestedInfo\x12)\n" +
+	"\x10expected_version\x18\x05 \x01(\x03R\x0fexpectedVersion\"!\n" +
 	"\x0fGetDartaRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
 	"\x10GetDartaResponse\x12%\n" +
@@ -4112,116 +6416,262 @@ const file_darta_v1_darta_proto_rawDesc = "" +
 	"\x13GetMyDartasResponse\x129\n" +
 	"\n" +
 	"connection\x18\x01 \x01(\v2\x19.darta.v1.DartaConnectionR\n" +
-	"connection\"\x99\x01\n" +
+	"connection\"\xbc\x02\n" +
 	"\x14GetDartaStatsRequest\x12%\n" +
 	"\x05scope\x18\x01 \x01(\x0e2\x0f.darta.v1.ScopeR\x05scope\x12$\n" +
 	"\x0efiscal_year_id\x18\x02 \x01(\tR\ffiscalYearId\x12\x17\n" +
 	"\award_id\x18\x03 \x01(\tR\x06wardId\x12\x1b\n" +
-	"\ttenant_id\x18\x04 \x01(\tR\btenantId\"C\n" +
+	"\ttenant_id\x18\x04 \x01(\tR\btenantId\x127\n" +
+	"\tfrom_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bfromDate\x123\n" +
+	"\ato_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06toDate\x123\n" +
+	"\binterval\x18\a \x01(\x0e2\x17.darta.v1.StatsIntervalR\binterval\"C\n" +
 	"\x15GetDartaStatsResponse\x12*\n" +
 	"\x05stats\x18\x01 \x01(\v2\x14.darta.v1.DartaStatsR\x05stats\"F\n" +
 	"\x12CreateDartaRequest\x120\n" +
-	"\x05input\x18\x01 \x01(\v2\x1a.darta.v1.CreateDartaInputR\x05input\"<\n" +
+	"\x05input\x18\x01 \x01(\v2\x1a.darta.v1.CreateDartaInputR\x05input\"\x8d\x01\n" +
 	"\x13CreateDartaResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"8\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\x12O\n" +
+	"\x14suspected_duplicates\x18\x02 \x03(\v2\x1c.darta.v1.DuplicateCandidateR\x13suspectedDuplicates\"c\n" +
 	"\x1bSubmitDartaForReviewRequest\x12\x19\n" +
-	"\bdarta_id\x18\x01 \x01(\tR\adartaId\"E\n" +
+	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"E\n" +
 	"\x1cSubmitDartaForReviewResponse\x12%\n" +
 	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"F\n" +
 	"\x12ReviewDartaRequest\x120\n" +
 	"\x05input\x18\x01 \x01(\v2\x1a.darta.v1.ReviewDartaInputR\x05input\"<\n" +
 	"\x13ReviewDartaResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"b\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"\x8d\x01\n" +
 	"\x14ClassifyDartaRequest\x12\x19\n" +
 	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12/\n" +
-	"\x13classification_code\x18\x02 \x01(\tR\x12classificationCode\">\n" +
+	"\x13classification_code\x18\x02 \x01(\tR\x12classificationCode\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\">\n" +
 	"\x15ClassifyDartaResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"[\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"\xbd\x01\n" +
 	"\x19ReserveDartaNumberRequest\x12\x19\n" +
 	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12#\n" +
-	"\rallocation_id\x18\x02 \x01(\tR\fallocationId\"C\n" +
+	"\rallocation_id\x18\x02 \x01(\tR\fallocationId\x125\n" +
+	"\x16acknowledge_duplicates\x18\x03 \x01(\bR\x15acknowledgeDuplicates\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\"\x94\x01\n" +
 	"\x1aReserveDartaNumberResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"b\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\x12O\n" +
+	"\x14suspected_duplicates\x18\x02 \x03(\v2\x1c.darta.v1.DuplicateCandidateR\x13suspectedDuplicates\"\x8d\x01\n" +
 	" FinalizeDartaRegistrationRequest\x12\x19\n" +
 	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12#\n" +
-	"\rallocation_id\x18\x02 \x01(\tR\fallocationId\"J\n" +
+	"\rallocation_id\x18\x02 \x01(\tR\fallocationId\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"J\n" +
 	"!FinalizeDartaRegistrationResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"7\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"\x99\x01\n" +
 	"\x1aDirectRegisterDartaRequest\x12\x19\n" +
-	"\bdarta_id\x18\x01 \x01(\tR\adartaId\"D\n" +
+	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x125\n" +
+	"\x16acknowledge_duplicates\x18\x02 \x01(\bR\x15acknowledgeDuplicates\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"D\n" +
 	"\x1bDirectRegisterDartaResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"E\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"p\n" +
 	"\x10VoidDartaRequest\x12\x19\n" +
 	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\":\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\":\n" +
 	"\x11VoidDartaResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"[\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"\x86\x01\n" +
 	"\x10ScanDartaRequest\x12\x19\n" +
 	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12,\n" +
-	"\x12scan_attachment_id\x18\x02 \x01(\tR\x10scanAttachmentId\":\n" +
+	"\x12scan_attachment_id\x18\x02 \x01(\tR\x10scanAttachmentId\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\":\n" +
 	"\x11ScanDartaResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"l\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"\x97\x01\n" +
 	"\x1aEnrichDartaMetadataRequest\x12\x19\n" +
 	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x123\n" +
-	"\bmetadata\x18\x02 \x01(\v2\x17.google.protobuf.StructR\bmetadata\"D\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"D\n" +
 	"\x1bEnrichDartaMetadataResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"8\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"c\n" +
 	"\x1bFinalizeDartaArchiveRequest\x12\x19\n" +
-	"\bdarta_id\x18\x01 \x01(\tR\adartaId\"E\n" +
+	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"E\n" +
 	"\x1cFinalizeDartaArchiveResponse\x12%\n" +
 	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"D\n" +
 	"\x11RouteDartaRequest\x12/\n" +
 	"\x05input\x18\x01 \x01(\v2\x19.darta.v1.RouteDartaInputR\x05input\";\n" +
 	"\x12RouteDartaResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"6\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"a\n" +
 	"\x19SectionReviewDartaRequest\x12\x19\n" +
-	"\bdarta_id\x18\x01 \x01(\tR\adartaId\"C\n" +
+	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"C\n" +
 	"\x1aSectionReviewDartaResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"Q\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"|\n" +
 	" RequestDartaClarificationRequest\x12\x19\n" +
 	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12\x12\n" +
-	"\x04note\x18\x02 \x01(\tR\x04note\"J\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"J\n" +
 	"!RequestDartaClarificationResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"Q\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"|\n" +
 	" ProvideDartaClarificationRequest\x12\x19\n" +
 	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12\x12\n" +
-	"\x04note\x18\x02 \x01(\tR\x04note\"J\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"J\n" +
 	"!ProvideDartaClarificationResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"/\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"Z\n" +
 	"\x12AcceptDartaRequest\x12\x19\n" +
-	"\bdarta_id\x18\x01 \x01(\tR\adartaId\"<\n" +
+	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"<\n" +
 	"\x13AcceptDartaResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"T\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"\x7f\n" +
 	"\x16MarkDartaActionRequest\x12\x19\n" +
 	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12\x1f\n" +
 	"\vaction_note\x18\x02 \x01(\tR\n" +
-	"actionNote\"@\n" +
+	"actionNote\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"@\n" +
 	"\x17MarkDartaActionResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"\x92\x01\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"\xbd\x01\n" +
 	"\x19IssueDartaResponseRequest\x12\x19\n" +
 	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12.\n" +
 	"\x13response_chalani_id\x18\x02 \x01(\tR\x11responseChalaniId\x12*\n" +
-	"\x11doc_attachment_id\x18\x03 \x01(\tR\x0fdocAttachmentId\"C\n" +
+	"\x11doc_attachment_id\x18\x03 \x01(\tR\x0fdocAttachmentId\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\"C\n" +
 	"\x1aIssueDartaResponseResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"3\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"^\n" +
 	"\x16RequestDartaAckRequest\x12\x19\n" +
-	"\bdarta_id\x18\x01 \x01(\tR\adartaId\"@\n" +
+	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"@\n" +
 	"\x17RequestDartaAckResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"3\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"^\n" +
 	"\x16ReceiveDartaAckRequest\x12\x19\n" +
-	"\bdarta_id\x18\x01 \x01(\tR\adartaId\"@\n" +
+	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"@\n" +
 	"\x17ReceiveDartaAckResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"r\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"\x9d\x01\n" +
 	"\x1bSupersedeDartaRecordRequest\x12\x19\n" +
 	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\x12 \n" +
 	"\fnew_darta_id\x18\x03 \x01(\tR\n" +
-	"newDartaId\"E\n" +
+	"newDartaId\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\"E\n" +
 	"\x1cSupersedeDartaRecordResponse\x12%\n" +
-	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\".\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"=\n" +
+	" GetDartaSupersessionChainRequest\x12\x19\n" +
+	"\bdarta_id\x18\x01 \x01(\tR\adartaId\"L\n" +
+	"!GetDartaSupersessionChainResponse\x12'\n" +
+	"\x06dartas\x18\x01 \x03(\v2\x0f.darta.v1.DartaR\x06dartas\"Y\n" +
 	"\x11CloseDartaRequest\x12\x19\n" +
-	"\bdarta_id\x18\x01 \x01(\tR\adartaId\";\n" +
+	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\";\n" +
 	"\x12CloseDartaResponse\x12%\n" +
+	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta\"U\n" +
+	"\x12WatchDartasRequest\x12\x19\n" +
+	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12$\n" +
+	"\x0eassigned_to_me\x18\x02 \x01(\bR\fassignedToMe\"\x9a\x02\n" +
+	"\n" +
+	"DartaEvent\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12%\n" +
+	"\x05darta\x18\x02 \x01(\v2\x0f.darta.v1.DartaR\x05darta\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x120\n" +
+	"\x14previous_assignee_id\x18\x04 \x01(\tR\x12previousAssigneeId\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x1b\n" +
+	"\tsla_stage\x18\x06 \x01(\tR\bslaStage\x12&\n" +
+	"\x0fescalated_to_id\x18\a \x01(\tR\rescalatedToId\")\n" +
+	"\x15BatchGetDartasRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"A\n" +
+	"\x16BatchGetDartasResponse\x12'\n" +
+	"\x06dartas\x18\x01 \x03(\v2\x0f.darta.v1.DartaR\x06dartas\"-\n" +
+	"\x19BatchGetApplicantsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"Q\n" +
+	"\x1aBatchGetApplicantsResponse\x123\n" +
+	"\n" +
+	"applicants\x18\x01 \x03(\v2\x13.darta.v1.ApplicantR\n" +
+	"applicants\".\n" +
+	"\x1aBatchGetAttachmentsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"U\n" +
+	"\x1bBatchGetAttachmentsResponse\x126\n" +
+	"\vattachments\x18\x01 \x03(\v2\x14.darta.v1.AttachmentR\vattachments\"8\n" +
+	"\x19BatchGetDartaLinksRequest\x12\x1b\n" +
+	"\tdarta_ids\x18\x01 \x03(\tR\bdartaIds\"H\n" +
+	"\x1aBatchGetDartaLinksResponse\x12*\n" +
+	"\x05links\x18\x01 \x03(\v2\x14.darta.v1.DartaLinksR\x05links\"{\n" +
+	"\n" +
+	"DartaLinks\x12\x19\n" +
+	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12\x1b\n" +
+	"\tannex_ids\x18\x02 \x03(\tR\bannexIds\x125\n" +
+	"\trelations\x18\x03 \x03(\v2\x17.darta.v1.DartaRelationR\trelations\"f\n" +
+	"\rDartaRelation\x12(\n" +
+	"\x10related_darta_id\x18\x01 \x01(\tR\x0erelatedDartaId\x12+\n" +
+	"\x11relationship_type\x18\x02 \x01(\tR\x10relationshipType\"\x86\x01\n" +
+	"\x1aBatchGetAuditTrailsRequest\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x1d\n" +
+	"\n" +
+	"entity_ids\x18\x02 \x03(\tR\tentityIds\x12(\n" +
+	"\x10limit_per_entity\x18\x03 \x01(\x05R\x0elimitPerEntity\"M\n" +
+	"\x1bBatchGetAuditTrailsResponse\x12.\n" +
+	"\aentries\x18\x01 \x03(\v2\x14.darta.v1.AuditEntryR\aentries\"\xab\x01\n" +
+	"\x14SearchRecordsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12!\n" +
+	"\fentity_types\x18\x02 \x03(\tR\ventityTypes\x12.\n" +
+	"\x06filter\x18\x03 \x01(\v2\x16.darta.v1.SearchFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05after\x18\x05 \x01(\tR\x05after\"\x89\x01\n" +
+	"\fSearchFilter\x12\x1a\n" +
+	"\bstatuses\x18\x01 \x03(\tR\bstatuses\x12&\n" +
+	"\x0ffiscal_year_ids\x18\x02 \x03(\tR\rfiscalYearIds\x12\x19\n" +
+	"\bward_ids\x18\x03 \x03(\tR\awardIds\x12\x1a\n" +
+	"\bchannels\x18\x04 \x03(\tR\bchannels\"\xd3\x01\n" +
+	"\x15SearchRecordsResponse\x12'\n" +
+	"\x04hits\x18\x01 \x03(\v2\x13.darta.v1.SearchHitR\x04hits\x12-\n" +
+	"\x06facets\x18\x02 \x03(\v2\x15.darta.v1.SearchFacetR\x06facets\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\x12\"\n" +
+	"\rhas_next_page\x18\x04 \x01(\bR\vhasNextPage\x12\x1d\n" +
+	"\n" +
+	"end_cursor\x18\x05 \x01(\tR\tendCursor\"\xfb\x02\n" +
+	"\tSearchHit\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x16\n" +
+	"\x06number\x18\x04 \x01(\tR\x06number\x12\x14\n" +
+	"\x05party\x18\x05 \x01(\tR\x05party\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12$\n" +
+	"\x0efiscal_year_id\x18\a \x01(\tR\ffiscalYearId\x12\x17\n" +
+	"\award_id\x18\b \x01(\tR\x06wardId\x12\x18\n" +
+	"\achannel\x18\t \x01(\tR\achannel\x12\x14\n" +
+	"\x05score\x18\n" +
+	" \x01(\x01R\x05score\x12\x18\n" +
+	"\asnippet\x18\v \x01(\tR\asnippet\x12#\n" +
+	"\rsnippet_field\x18\f \x01(\tR\fsnippetField\x123\n" +
+	"\n" +
+	"highlights\x18\r \x03(\v2\x13.darta.v1.TextRangeR\n" +
+	"highlights\"3\n" +
+	"\tTextRange\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x05R\x03end\"W\n" +
+	"\vSearchFacet\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x122\n" +
+	"\x06values\x18\x02 \x03(\v2\x1a.darta.v1.SearchFacetValueR\x06values\">\n" +
+	"\x10SearchFacetValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\xdf\x02\n" +
+	"\x12DuplicateCandidate\x12\x19\n" +
+	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x124\n" +
+	"\x16formatted_darta_number\x18\x02 \x01(\tR\x14formattedDartaNumber\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12-\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x15.darta.v1.DartaStatusR\x06status\x12\x17\n" +
+	"\award_id\x18\x05 \x01(\tR\x06wardId\x12?\n" +
+	"\rreceived_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\freceivedDate\x12%\n" +
+	"\x0eapplicant_name\x18\a \x01(\tR\rapplicantName\x12\x14\n" +
+	"\x05score\x18\b \x01(\x01R\x05score\x12\x18\n" +
+	"\areasons\x18\t \x03(\tR\areasons\";\n" +
+	"\x1eListDuplicateCandidatesRequest\x12\x19\n" +
+	"\bdarta_id\x18\x01 \x01(\tR\adartaId\"_\n" +
+	"\x1fListDuplicateCandidatesResponse\x12<\n" +
+	"\n" +
+	"candidates\x18\x01 \x03(\v2\x1c.darta.v1.DuplicateCandidateR\n" +
+	"candidates\"\x9f\x01\n" +
+	"\x19LinkDuplicateDartaRequest\x12\x19\n" +
+	"\bdarta_id\x18\x01 \x01(\tR\adartaId\x12&\n" +
+	"\x0fduplicate_of_id\x18\x02 \x01(\tR\rduplicateOfId\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\"C\n" +
+	"\x1aLinkDuplicateDartaResponse\x12%\n" +
 	"\x05darta\x18\x01 \x01(\v2\x0f.darta.v1.DartaR\x05darta*\xf9\x04\n" +
 	"\vDartaStatus\x12\x1c\n" +
 	"\x18DARTA_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
@@ -4254,7 +6704,12 @@ const file_darta_v1_darta_proto_rawDesc = "" +
 	"\x13DartaReviewDecision\x12%\n" +
 	"!DARTA_REVIEW_DECISION_UNSPECIFIED\x10\x00\x12(\n" +
 	"$DARTA_REVIEW_DECISION_APPROVE_REVIEW\x10\x01\x12'\n" +
-	"#DARTA_REVIEW_DECISION_EDIT_REQUIRED\x10\x022\xbd\x13\n" +
+	"#DARTA_REVIEW_DECISION_EDIT_REQUIRED\x10\x02*z\n" +
+	"\rStatsInterval\x12\x1e\n" +
+	"\x1aSTATS_INTERVAL_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12STATS_INTERVAL_DAY\x10\x01\x12\x17\n" +
+	"\x13STATS_INTERVAL_WEEK\x10\x02\x12\x18\n" +
+	"\x14STATS_INTERVAL_MONTH\x10\x032\xfa\x1a\n" +
 	"\fDartaService\x12A\n" +
 	"\bGetDarta\x12\x19.darta.v1.GetDartaRequest\x1a\x1a.darta.v1.GetDartaResponse\x12Y\n" +
 	"\x10GetDartaByNumber\x12!.darta.v1.GetDartaByNumberRequest\x1a\".darta.v1.GetDartaByNumberResponse\x12G\n" +
@@ -4283,9 +6738,19 @@ const file_darta_v1_darta_proto_rawDesc = "" +
 	"\x12IssueDartaResponse\x12#.darta.v1.IssueDartaResponseRequest\x1a$.darta.v1.IssueDartaResponseResponse\x12V\n" +
 	"\x0fRequestDartaAck\x12 .darta.v1.RequestDartaAckRequest\x1a!.darta.v1.RequestDartaAckResponse\x12V\n" +
 	"\x0fReceiveDartaAck\x12 .darta.v1.ReceiveDartaAckRequest\x1a!.darta.v1.ReceiveDartaAckResponse\x12e\n" +
-	"\x14SupersedeDartaRecord\x12%.darta.v1.SupersedeDartaRecordRequest\x1a&.darta.v1.SupersedeDartaRecordResponse\x12G\n" +
+	"\x14SupersedeDartaRecord\x12%.darta.v1.SupersedeDartaRecordRequest\x1a&.darta.v1.SupersedeDartaRecordResponse\x12t\n" +
+	"\x19GetDartaSupersessionChain\x12*.darta.v1.GetDartaSupersessionChainRequest\x1a+.darta.v1.GetDartaSupersessionChainResponse\x12G\n" +
 	"\n" +
-	"CloseDarta\x12\x1b.darta.v1.CloseDartaRequest\x1a\x1c.darta.v1.CloseDartaResponse\x12J\n" +
+	"CloseDarta\x12\x1b.darta.v1.CloseDartaRequest\x1a\x1c.darta.v1.CloseDartaResponse\x12n\n" +
+	"\x17ListDuplicateCandidates\x12(.darta.v1.ListDuplicateCandidatesRequest\x1a).darta.v1.ListDuplicateCandidatesResponse\x12_\n" +
+	"\x12LinkDuplicateDarta\x12#.darta.v1.LinkDuplicateDartaRequest\x1a$.darta.v1.LinkDuplicateDartaResponse\x12S\n" +
+	"\x0eBatchGetDartas\x12\x1f.darta.v1.BatchGetDartasRequest\x1a .darta.v1.BatchGetDartasResponse\x12_\n" +
+	"\x12BatchGetApplicants\x12#.darta.v1.BatchGetApplicantsRequest\x1a$.darta.v1.BatchGetApplicantsResponse\x12b\n" +
+	"\x13BatchGetAttachments\x12$.darta.v1.BatchGetAttachmentsRequest\x1a%.darta.v1.BatchGetAttachmentsResponse\x12_\n" +
+	"\x12BatchGetDartaLinks\x12#.darta.v1.BatchGetDartaLinksRequest\x1a$.darta.v1.BatchGetDartaLinksResponse\x12b\n" +
+	"\x13BatchGetAuditTrails\x12$.darta.v1.BatchGetAuditTrailsRequest\x1a%.darta.v1.BatchGetAuditTrailsResponse\x12P\n" +
+	"\rSearchRecords\x12\x1e.darta.v1.SearchRecordsRequest\x1a\x1f.darta.v1.SearchRecordsResponse\x12C\n" +
+	"\vWatchDartas\x12\x1c.darta.v1.WatchDartasRequest\x1a\x14.darta.v1.DartaEvent0\x01\x12J\n" +
 	"\vHealthCheck\x12\x1c.darta.v1.HealthCheckRequest\x1a\x1d.darta.v1.HealthCheckResponseB9Z7git.ninjainfosys.com/ePalika/proto/gen/darta/v1;dartav1b\x06proto3"
 
 var (
@@ -4300,234 +6765,321 @@ func file_darta_v1_darta_proto_rawDescGZIP() []byte {
 	return file_darta_v1_darta_proto_rawDescData
 }
 
-var file_darta_v1_darta_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_darta_v1_darta_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
+var file_darta_v1_darta_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_darta_v1_darta_proto_msgTypes = make([]protoimpl.MessageInfo, 97)
 var file_darta_v1_darta_proto_goTypes = []any{
 	(DartaStatus)(0),                          // 0: darta.v1.DartaStatus
 	(ApplicantType)(0),                        // 1: darta.v1.ApplicantType
 	(DartaReviewDecision)(0),                  // 2: darta.v1.DartaReviewDecision
-	(*Darta)(nil),                             // 3: darta.v1.Darta
-	(*Applicant)(nil),                         // 4: darta.v1.Applicant
-	(*DartaConnection)(nil),                   // 5: darta.v1.DartaConnection
-	(*DartaEdge)(nil),                         // 6: darta.v1.DartaEdge
-	(*DartaStats)(nil),                        // 7: darta.v1.DartaStats
-	(*DartaStatusCount)(nil),                  // 8: darta.v1.DartaStatusCount
-	(*ChannelCount)(nil),                      // 9: darta.v1.ChannelCount
-	(*DartaFilterInput)(nil),                  // 10: darta.v1.DartaFilterInput
-	(*ApplicantInput)(nil),                    // 11: darta.v1.ApplicantInput
-	(*CreateDartaInput)(nil),                  // 12: darta.v1.CreateDartaInput
-	(*RouteDartaInput)(nil),                   // 13: darta.v1.RouteDartaInput
-	(*ReviewDartaInput)(nil),                  // 14: darta.v1.ReviewDartaInput
-	(*GetDartaRequest)(nil),                   // 15: darta.v1.GetDartaRequest
-	(*GetDartaResponse)(nil),                  // 16: darta.v1.GetDartaResponse
-	(*GetDartaByNumberRequest)(nil),           // 17: darta.v1.GetDartaByNumberRequest
-	(*GetDartaByNumberResponse)(nil),          // 18: darta.v1.GetDartaByNumberResponse
-	(*ListDartasRequest)(nil),                 // 19: darta.v1.ListDartasRequest
-	(*ListDartasResponse)(nil),                // 20: darta.v1.ListDartasResponse
-	(*GetMyDartasRequest)(nil),                // 21: darta.v1.GetMyDartasRequest
-	(*GetMyDartasResponse)(nil),               // 22: darta.v1.GetMyDartasResponse
-	(*GetDartaStatsRequest)(nil),              // 23: darta.v1.GetDartaStatsRequest
-	(*GetDartaStatsResponse)(nil),             // 24: darta.v1.GetDartaStatsResponse
-	(*CreateDartaRequest)(nil),                // 25: darta.v1.CreateDartaRequest
-	(*CreateDartaResponse)(nil),               // 26: darta.v1.CreateDartaResponse
-	(*SubmitDartaForReviewRequest)(nil),       // 27: darta.v1.SubmitDartaForReviewRequest
-	(*SubmitDartaForReviewResponse)(nil),      // 28: darta.v1.SubmitDartaForReviewResponse
-	(*ReviewDartaRequest)(nil),                // 29: darta.v1.ReviewDartaRequest
-	(*ReviewDartaResponse)(nil),               // 30: darta.v1.ReviewDartaResponse
-	(*ClassifyDartaRequest)(nil),              // 31: darta.v1.ClassifyDartaRequest
-	(*ClassifyDartaResponse)(nil),             // 32: darta.v1.ClassifyDartaResponse
-	(*ReserveDartaNumberRequest)(nil),         // 33: darta.v1.ReserveDartaNumberRequest
-	(*ReserveDartaNumberResponse)(nil),        // 34: darta.v1.ReserveDartaNumberResponse
-	(*FinalizeDartaRegistrationRequest)(nil),  // 35: darta.v1.FinalizeDartaRegistrationRequest
-	(*FinalizeDartaRegistrationResponse)(nil), // 36: darta.v1.FinalizeDartaRegistrationResponse
-	(*DirectRegisterDartaRequest)(nil),        // 37: darta.v1.DirectRegisterDartaRequest
-	(*DirectRegisterDartaResponse)(nil),       // 38: darta.v1.DirectRegisterDartaResponse
-	(*VoidDartaRequest)(nil),                  // 39: darta.v1.VoidDartaRequest
-	(*VoidDartaResponse)(nil),                 // 40: darta.v1.VoidDartaResponse
-	(*ScanDartaRequest)(nil),                  // 41: darta.v1.ScanDartaRequest
-	(*ScanDartaResponse)(nil),                 // 42: darta.v1.ScanDartaResponse
-	(*EnrichDartaMetadataRequest)(nil),        // 43: darta.v1.EnrichDartaMetadataRequest
-	(*EnrichDartaMetadataResponse)(nil),       // 44: darta.v1.EnrichDartaMetadataResponse
-	(*FinalizeDartaArchiveRequest)(nil),       // 45: darta.v1.FinalizeDartaArchiveRequest
-	(*FinalizeDartaArchiveResponse)(nil),      // 46: darta.v1.FinalizeDartaArchiveResponse
-	(*RouteDartaRequest)(nil),                 // 47: darta.v1.RouteDartaRequest
-	(*RouteDartaResponse)(nil),                // 48: darta.v1.RouteDartaResponse
-	(*SectionReviewDartaRequest)(nil),         // 49: darta.v1.SectionReviewDartaRequest
-	(*SectionReviewDartaResponse)(nil),        // 50: darta.v1.SectionReviewDartaResponse
-	(*RequestDartaClarificationRequest)(nil),  // 51: darta.v1.RequestDartaClarificationRequest
-	(*RequestDartaClarificationResponse)(nil), // 52: darta.v1.RequestDartaClarificationResponse
-	(*ProvideDartaClarificationRequest)(nil),  // 53: darta.v1.ProvideDartaClarificationRequest
-	(*ProvideDartaClarificationResponse)(nil), // 54: darta.v1.ProvideDartaClarificationResponse
-	(*AcceptDartaRequest)(nil),                // 55: darta.v1.AcceptDartaRequest
-	(*AcceptDartaResponse)(nil),               // 56: darta.v1.AcceptDartaResponse
-	(*MarkDartaActionRequest)(nil),            // 57: darta.v1.MarkDartaActionRequest
-	(*MarkDartaActionResponse)(nil),           // 58: darta.v1.MarkDartaActionResponse
-	(*IssueDartaResponseRequest)(nil),         // 59: darta.v1.IssueDartaResponseRequest
-	(*IssueDartaResponseResponse)(nil),        // 60: darta.v1.IssueDartaResponseResponse
-	(*RequestDartaAckRequest)(nil),            // 61: darta.v1.RequestDartaAckRequest
-	(*RequestDartaAckResponse)(nil),           // 62: darta.v1.RequestDartaAckResponse
-	(*ReceiveDartaAckRequest)(nil),            // 63: darta.v1.ReceiveDartaAckRequest
-	(*ReceiveDartaAckResponse)(nil),           // 64: darta.v1.ReceiveDartaAckResponse
-	(*SupersedeDartaRecordRequest)(nil),       // 65: darta.v1.SupersedeDartaRecordRequest
-	(*SupersedeDartaRecordResponse)(nil),      // 66: darta.v1.SupersedeDartaRecordResponse
-	(*CloseDartaRequest)(nil),                 // 67: darta.v1.CloseDartaRequest
-	(*CloseDartaResponse)(nil),                // 68: darta.v1.CloseDartaResponse
-	(*FiscalYear)(nil),                        // 69: darta.v1.FiscalYear
-	(Scope)(0),                                // 70: darta.v1.Scope
-	(*Ward)(nil),                              // 71: darta.v1.Ward
-	(IntakeChannel)(0),                        // 72: darta.v1.IntakeChannel
-	(*timestamppb.Timestamp)(nil),             // 73: google.protobuf.Timestamp
-	(*User)(nil),                              // 74: darta.v1.User
-	(*Attachment)(nil),                        // 75: darta.v1.Attachment
-	(Priority)(0),                             // 76: darta.v1.Priority
-	(*OrganizationalUnit)(nil),                // 77: darta.v1.OrganizationalUnit
-	(*AuditEntry)(nil),                        // 78: darta.v1.AuditEntry
-	(*PageInfo)(nil),                          // 79: darta.v1.PageInfo
-	(*PaginationInput)(nil),                   // 80: darta.v1.PaginationInput
-	(*structpb.Struct)(nil),                   // 81: google.protobuf.Struct
-	(*HealthCheckRequest)(nil),                // 82: darta.v1.HealthCheckRequest
-	(*HealthCheckResponse)(nil),               // 83: darta.v1.HealthCheckResponse
+	(StatsInterval)(0),                        // 3: darta.v1.StatsInterval
+	(*Darta)(nil),                             // 4: darta.v1.Darta
+	(*Applicant)(nil),                         // 5: darta.v1.Applicant
+	(*DartaConnection)(nil),                   // 6: darta.v1.DartaConnection
+	(*DartaEdge)(nil),                         // 7: darta.v1.DartaEdge
+	(*DartaStats)(nil),                        // 8: darta.v1.DartaStats
+	(*DurationStats)(nil),                     // 9: darta.v1.DurationStats
+	(*DartaStatsBreakdown)(nil),               // 10: darta.v1.DartaStatsBreakdown
+	(*DartaStatsBucket)(nil),                  // 11: darta.v1.DartaStatsBucket
+	(*DartaStatusCount)(nil),                  // 12: darta.v1.DartaStatusCount
+	(*ChannelCount)(nil),                      // 13: darta.v1.ChannelCount
+	(*DartaFilterInput)(nil),                  // 14: darta.v1.DartaFilterInput
+	(*ApplicantInput)(nil),                    // 15: darta.v1.ApplicantInput
+	(*CreateDartaInput)(nil),                  // 16: darta.v1.CreateDartaInput
+	(*RouteDartaInput)(nil),                   // 17: darta.v1.RouteDartaInput
+	(*ReviewDartaInput)(nil),                  // 18: darta.v1.ReviewDartaInput
+	(*GetDartaRequest)(nil),                   // 19: darta.v1.GetDartaRequest
+	(*GetDartaResponse)(nil),                  // 20: darta.v1.GetDartaResponse
+	(*GetDartaByNumberRequest)(nil),           // 21: darta.v1.GetDartaByNumberRequest
+	(*GetDartaByNumberResponse)(nil),          // 22: darta.v1.GetDartaByNumberResponse
+	(*ListDartasRequest)(nil),                 // 23: darta.v1.ListDartasRequest
+	(*ListDartasResponse)(nil),                // 24: darta.v1.ListDartasResponse
+	(*GetMyDartasRequest)(nil),                // 25: darta.v1.GetMyDartasRequest
+	(*GetMyDartasResponse)(nil),               // 26: darta.v1.GetMyDartasResponse
+	(*GetDartaStatsRequest)(nil),              // 27: darta.v1.GetDartaStatsRequest
+	(*GetDartaStatsResponse)(nil),             // 28: darta.v1.GetDartaStatsResponse
+	(*CreateDartaRequest)(nil),                // 29: darta.v1.CreateDartaRequest
+	(*CreateDartaResponse)(nil),               // 30: darta.v1.CreateDartaResponse
+	(*SubmitDartaForReviewRequest)(nil),       // 31: darta.v1.SubmitDartaForReviewRequest
+	(*SubmitDartaForReviewResponse)(nil),      // 32: darta.v1.SubmitDartaForReviewResponse
+	(*ReviewDartaRequest)(nil),                // 33: darta.v1.ReviewDartaRequest
+	(*ReviewDartaResponse)(nil),               // 34: darta.v1.ReviewDartaResponse
+	(*ClassifyDartaRequest)(nil),              // 35: darta.v1.ClassifyDartaRequest
+	(*ClassifyDartaResponse)(nil),             // 36: darta.v1.ClassifyDartaResponse
+	(*ReserveDartaNumberRequest)(nil),         // 37: darta.v1.ReserveDartaNumberRequest
+	(*ReserveDartaNumberResponse)(nil),        // 38: darta.v1.ReserveDartaNumberResponse
+	(*FinalizeDartaRegistrationRequest)(nil),  // 39: darta.v1.FinalizeDartaRegistrationRequest
+	(*FinalizeDartaRegistrationResponse)(nil), // 40: darta.v1.FinalizeDartaRegistrationResponse
+	(*DirectRegisterDartaRequest)(nil),        // 41: darta.v1.DirectRegisterDartaRequest
+	(*DirectRegisterDartaResponse)(nil),       // 42: darta.v1.DirectRegisterDartaResponse
+	(*VoidDartaRequest)(nil),                  // 43: darta.v1.VoidDartaRequest
+	(*VoidDartaResponse)(nil),                 // 44: darta.v1.VoidDartaResponse
+	(*ScanDartaRequest)(nil),                  // 45: darta.v1.ScanDartaRequest
+	(*ScanDartaResponse)(nil),                 // 46: darta.v1.ScanDartaResponse
+	(*EnrichDartaMetadataRequest)(nil),        // 47: darta.v1.EnrichDartaMetadataRequest
+	(*EnrichDartaMetadataResponse)(nil),       // 48: darta.v1.EnrichDartaMetadataResponse
+	(*FinalizeDartaArchiveRequest)(nil),       // 49: darta.v1.FinalizeDartaArchiveRequest
+	(*FinalizeDartaArchiveResponse)(nil),      // 50: darta.v1.FinalizeDartaArchiveResponse
+	(*RouteDartaRequest)(nil),                 // 51: darta.v1.RouteDartaRequest
+	(*RouteDartaResponse)(nil),                // 52: darta.v1.RouteDartaResponse
+	(*SectionReviewDartaRequest)(nil),         // 53: darta.v1.SectionReviewDartaRequest
+	(*SectionReviewDartaResponse)(nil),        // 54: darta.v1.SectionReviewDartaResponse
+	(*RequestDartaClarificationRequest)(nil),  // 55: darta.v1.RequestDartaClarificationRequest
+	(*RequestDartaClarificationResponse)(nil), // 56: darta.v1.RequestDartaClarificationResponse
+	(*ProvideDartaClarificationRequest)(nil),  // 57: darta.v1.ProvideDartaClarificationRequest
+	(*ProvideDartaClarificationResponse)(nil), // 58: darta.v1.ProvideDartaClarificationResponse
+	(*AcceptDartaRequest)(nil),                // 59: darta.v1.AcceptDartaRequest
+	(*AcceptDartaResponse)(nil),               // 60: darta.v1.AcceptDartaResponse
+	(*MarkDartaActionRequest)(nil),            // 61: darta.v1.MarkDartaActionRequest
+	(*MarkDartaActionResponse)(nil),           // 62: darta.v1.MarkDartaActionResponse
+	(*IssueDartaResponseRequest)(nil),         // 63: darta.v1.IssueDartaResponseRequest
+	(*IssueDartaResponseResponse)(nil),        // 64: darta.v1.IssueDartaResponseResponse
+	(*RequestDartaAckRequest)(nil),            // 65: darta.v1.RequestDartaAckRequest
+	(*RequestDartaAckResponse)(nil),           // 66: darta.v1.RequestDartaAckResponse
+	(*ReceiveDartaAckRequest)(nil),            // 67: darta.v1.ReceiveDartaAckRequest
+	(*ReceiveDartaAckResponse)(nil),           // 68: darta.v1.ReceiveDartaAckResponse
+	(*SupersedeDartaRecordRequest)(nil),       // 69: darta.v1.SupersedeDartaRecordRequest
+	(*SupersedeDartaRecordResponse)(nil),      // 70: darta.v1.SupersedeDartaRecordResponse
+	(*GetDartaSupersessionChainRequest)(nil),  // 71: darta.v1.GetDartaSupersessionChainRequest
+	(*GetDartaSupersessionChainResponse)(nil), // 72: darta.v1.GetDartaSupersessionChainResponse
+	(*CloseDartaRequest)(nil),                 // 73: darta.v1.CloseDartaRequest
+	(*CloseDartaResponse)(nil),                // 74: darta.v1.CloseDartaResponse
+	(*WatchDartasRequest)(nil),                // 75: darta.v1.WatchDartasRequest
+	(*DartaEvent)(nil),                        // 76: darta.v1.DartaEvent
+	(*BatchGetDartasRequest)(nil),             // 77: darta.v1.BatchGetDartasRequest
+	(*BatchGetDartasResponse)(nil),            // 78: darta.v1.BatchGetDartasResponse
+	(*BatchGetApplicantsRequest)(nil),         // 79: darta.v1.BatchGetApplicantsRequest
+	(*BatchGetApplicantsResponse)(nil),        // 80: darta.v1.BatchGetApplicantsResponse
+	(*BatchGetAttachmentsRequest)(nil),        // 81: darta.v1.BatchGetAttachmentsRequest
+	(*BatchGetAttachmentsResponse)(nil),       // 82: darta.v1.BatchGetAttachmentsResponse
+	(*BatchGetDartaLinksRequest)(nil),         // 83: darta.v1.BatchGetDartaLinksRequest
+	(*BatchGetDartaLinksResponse)(nil),        // 84: darta.v1.BatchGetDartaLinksResponse
+	(*DartaLinks)(nil),                        // 85: darta.v1.DartaLinks
+	(*DartaRelation)(nil),                     // 86: darta.v1.DartaRelation
+	(*BatchGetAuditTrailsRequest)(nil),        // 87: darta.v1.BatchGetAuditTrailsRequest
+	(*BatchGetAuditTrailsResponse)(nil),       // 88: darta.v1.BatchGetAuditTrailsResponse
+	(*SearchRecordsRequest)(nil),              // 89: darta.v1.SearchRecordsRequest
+	(*SearchFilter)(nil),                      // 90: darta.v1.SearchFilter
+	(*SearchRecordsResponse)(nil),             // 91: darta.v1.SearchRecordsResponse
+	(*SearchHit)(nil),                         // 92: darta.v1.SearchHit
+	(*TextRange)(nil),                         // 93: darta.v1.TextRange
+	(*SearchFacet)(nil),                       // 94: darta.v1.SearchFacet
+	(*SearchFacetValue)(nil),                  // 95: darta.v1.SearchFacetValue
+	(*DuplicateCandidate)(nil),                // 96: darta.v1.DuplicateCandidate
+	(*ListDuplicateCandidatesRequest)(nil),    // 97: darta.v1.ListDuplicateCandidatesRequest
+	(*ListDuplicateCandidatesResponse)(nil),   // 98: darta.v1.ListDuplicateCandidatesResponse
+	(*LinkDuplicateDartaRequest)(nil),         // 99: darta.v1.LinkDuplicateDartaRequest
+	(*LinkDuplicateDartaResponse)(nil),        // 100: darta.v1.LinkDuplicateDartaResponse
+	(*FiscalYear)(nil),                        // 101: darta.v1.FiscalYear
+	(Scope)(0),                                // 102: darta.v1.Scope
+	(*Ward)(nil),                              // 103: darta.v1.Ward
+	(IntakeChannel)(0),                        // 104: darta.v1.IntakeChannel
+	(*timestamppb.Timestamp)(nil),             // 105: google.protobuf.Timestamp
+	(*User)(nil),                              // 106: darta.v1.User
+	(*Attachment)(nil),                        // 107: darta.v1.Attachment
+	(Priority)(0),                             // 108: darta.v1.Priority
+	(*OrganizationalUnit)(nil),                // 109: darta.v1.OrganizationalUnit
+	(*AuditEntry)(nil),                        // 110: darta.v1.AuditEntry
+	(*structpb.Struct)(nil),                   // 111: google.protobuf.Struct
+	(*PageInfo)(nil),                          // 112: darta.v1.PageInfo
+	(*PaginationInput)(nil),                   // 113: darta.v1.PaginationInput
+	(*HealthCheckRequest)(nil),                // 114: darta.v1.HealthCheckRequest
+	(*HealthCheckResponse)(nil),               // 115: darta.v1.HealthCheckResponse
 }
 var file_darta_v1_darta_proto_depIdxs = []int32{
-	69,  // 0: darta.v1.Darta.fiscal_year:type_name -> darta.v1.FiscalYear
-	70,  // 1: darta.v1.Darta.scope:type_name -> darta.v1.Scope
-	71,  // 2: darta.v1.Darta.ward:type_name -> darta.v1.Ward
-	4,   // 3: darta.v1.Darta.applicant:type_name -> darta.v1.Applicant
-	72,  // 4: darta.v1.Darta.intake_channel:type_name -> darta.v1.IntakeChannel
-	73,  // 5: darta.v1.Darta.received_date:type_name -> google.protobuf.Timestamp
-	73,  // 6: darta.v1.Darta.entry_date:type_name -> google.protobuf.Timestamp
-	74,  // 7: darta.v1.Darta.backdate_approver:type_name -> darta.v1.User
-	75,  // 8: darta.v1.Darta.primary_document:type_name -> darta.v1.Attachment
-	75,  // 9: darta.v1.Darta.annexes:type_name -> darta.v1.Attachment
+	101, // 0: darta.v1.Darta.fiscal_year:type_name -> darta.v1.FiscalYear
+	102, // 1: darta.v1.Darta.scope:type_name -> darta.v1.Scope
+	103, // 2: darta.v1.Darta.ward:type_name -> darta.v1.Ward
+	5,   // 3: darta.v1.Darta.applicant:type_name -> darta.v1.Applicant
+	104, // 4: darta.v1.Darta.intake_channel:type_name -> darta.v1.IntakeChannel
+	105, // 5: darta.v1.Darta.received_date:type_name -> google.protobuf.Timestamp
+	105, // 6: darta.v1.Darta.entry_date:type_name -> google.protobuf.Timestamp
+	106, // 7: darta.v1.Darta.backdate_approver:type_name -> darta.v1.User
+	107, // 8: darta.v1.Darta.primary_document:type_name -> darta.v1.Attachment
+	107, // 9: darta.v1.Darta.annexes:type_name -> darta.v1.Attachment
 	0,   // 10: darta.v1.Darta.status:type_name -> darta.v1.DartaStatus
-	76,  // 11: darta.v1.Darta.priority:type_name -> darta.v1.Priority
-	77,  // 12: darta.v1.Darta.assigned_to:type_name -> darta.v1.OrganizationalUnit
-	74,  // 13: darta.v1.Darta.current_assignee:type_name -> darta.v1.User
-	73,  // 14: darta.v1.Darta.sla_deadline:type_name -> google.protobuf.Timestamp
-	74,  // 15: darta.v1.Darta.created_by:type_name -> darta.v1.User
-	73,  // 16: darta.v1.Darta.created_at:type_name -> google.protobuf.Timestamp
-	73,  // 17: darta.v1.Darta.updated_at:type_name -> google.protobuf.Timestamp
-	78,  // 18: darta.v1.Darta.audit_trail:type_name -> darta.v1.AuditEntry
-	1,   // 19: darta.v1.Applicant.type:type_name -> darta.v1.ApplicantType
-	6,   // 20: darta.v1.DartaConnection.edges:type_name -> darta.v1.DartaEdge
-	79,  // 21: darta.v1.DartaConnection.page_info:type_name -> darta.v1.PageInfo
-	3,   // 22: darta.v1.DartaEdge.node:type_name -> darta.v1.Darta
-	8,   // 23: darta.v1.DartaStats.by_status:type_name -> darta.v1.DartaStatusCount
-	9,   // 24: darta.v1.DartaStats.by_channel:type_name -> darta.v1.ChannelCount
-	0,   // 25: darta.v1.DartaStatusCount.status:type_name -> darta.v1.DartaStatus
-	72,  // 26: darta.v1.ChannelCount.channel:type_name -> darta.v1.IntakeChannel
-	70,  // 27: darta.v1.DartaFilterInput.scope:type_name -> darta.v1.Scope
-	0,   // 28: darta.v1.DartaFilterInput.status:type_name -> darta.v1.DartaStatus
-	76,  // 29: darta.v1.DartaFilterInput.priority:type_name -> darta.v1.Priority
-	72,  // 30: darta.v1.DartaFilterInput.intake_channel:type_name -> darta.v1.IntakeChannel
-	73,  // 31: darta.v1.DartaFilterInput.from_date:type_name -> google.protobuf.Timestamp
-	73,  // 32: darta.v1.DartaFilterInput.to_date:type_name -> google.protobuf.Timestamp
-	1,   // 33: darta.v1.ApplicantInput.type:type_name -> darta.v1.ApplicantType
-	70,  // 34: darta.v1.CreateDartaInput.scope:type_name -> darta.v1.Scope
-	11,  // 35: darta.v1.CreateDartaInput.applicant:type_name -> darta.v1.ApplicantInput
-	72,  // 36: darta.v1.CreateDartaInput.intake_channel:type_name -> darta.v1.IntakeChannel
-	73,  // 37: darta.v1.CreateDartaInput.received_date:type_name -> google.protobuf.Timestamp
-	76,  // 38: darta.v1.CreateDartaInput.priority:type_name -> darta.v1.Priority
-	76,  // 39: darta.v1.RouteDartaInput.priority:type_name -> darta.v1.Priority
-	2,   // 40: darta.v1.ReviewDartaInput.decision:type_name -> darta.v1.DartaReviewDecision
-	3,   // 41: darta.v1.GetDartaResponse.darta:type_name -> darta.v1.Darta
-	70,  // 42: darta.v1.GetDartaByNumberRequest.scope:type_name -> darta.v1.Scope
-	3,   // 43: darta.v1.GetDartaByNumberResponse.darta:type_name -> darta.v1.Darta
-	10,  // 44: darta.v1.ListDartasRequest.filter:type_name -> darta.v1.DartaFilterInput
-	80,  // 45: darta.v1.ListDartasRequest.pagination:type_name -> darta.v1.PaginationInput
-	5,   // 46: darta.v1.ListDartasResponse.connection:type_name -> darta.v1.DartaConnection
-	0,   // 47: darta.v1.GetMyDartasRequest.status:type_name -> darta.v1.DartaStatus
-	80,  // 48: darta.v1.GetMyDartasRequest.pagination:type_name -> darta.v1.PaginationInput
-	5,   // 49: darta.v1.GetMyDartasResponse.connection:type_name -> darta.v1.DartaConnection
-	70,  // 50: darta.v1.GetDartaStatsRequest.scope:type_name -> darta.v1.Scope
-	7,   // 51: darta.v1.GetDartaStatsResponse.stats:type_name -> darta.v1.DartaStats
-	12,  // 52: darta.v1.CreateDartaRequest.input:type_name -> darta.v1.CreateDartaInput
-	3,   // 53: darta.v1.CreateDartaResponse.darta:type_name -> darta.v1.Darta
-	3,   // 54: darta.v1.SubmitDartaForReviewResponse.darta:type_name -> darta.v1.Darta
-	14,  // 55: darta.v1.ReviewDartaRequest.input:type_name -> darta.v1.ReviewDartaInput
-	3,   // 56: darta.v1.ReviewDartaResponse.darta:type_name -> darta.v1.Darta
-	3,   // 57: darta.v1.ClassifyDartaResponse.darta:type_name -> darta.v1.Darta
-	3,   // 58: darta.v1.ReserveDartaNumberResponse.darta:type_name -> darta.v1.Darta
-	3,   // 59: darta.v1.FinalizeDartaRegistrationResponse.darta:type_name -> darta.v1.Darta
-	3,   // 60: darta.v1.DirectRegisterDartaResponse.darta:type_name -> darta.v1.Darta
-	3,   // 61: darta.v1.VoidDartaResponse.darta:type_name -> darta.v1.Darta
-	3,   // 62: darta.v1.ScanDartaResponse.darta:type_name -> darta.v1.Darta
-	81,  // 63: darta.v1.EnrichDartaMetadataRequest.metadata:type_name -> google.protobuf.Struct
-	3,   // 64: darta.v1.EnrichDartaMetadataResponse.darta:type_name -> darta.v1.Darta
-	3,   // 65: darta.v1.FinalizeDartaArchiveResponse.darta:type_name -> darta.v1.Darta
-	13,  // 66: darta.v1.RouteDartaRequest.input:type_name -> darta.v1.RouteDartaInput
-	3,   // 67: darta.v1.RouteDartaResponse.darta:type_name -> darta.v1.Darta
-	3,   // 68: darta.v1.SectionReviewDartaResponse.darta:type_name -> darta.v1.Darta
-	3,   // 69: darta.v1.RequestDartaClarificationResponse.darta:type_name -> darta.v1.Darta
-	3,   // 70: darta.v1.ProvideDartaClarificationResponse.darta:type_name -> darta.v1.Darta
-	3,   // 71: darta.v1.AcceptDartaResponse.darta:type_name -> darta.v1.Darta
-	3,   // 72: darta.v1.MarkDartaActionResponse.darta:type_name -> darta.v1.Darta
-	3,   // 73: darta.v1.IssueDartaResponseResponse.darta:type_name -> darta.v1.Darta
-	3,   // 74: darta.v1.RequestDartaAckResponse.darta:type_name -> darta.v1.Darta
-	3,   // 75: darta.v1.ReceiveDartaAckResponse.darta:type_name -> darta.v1.Darta
-	3,   // 76: darta.v1.SupersedeDartaRecordResponse.darta:type_name -> darta.v1.Darta
-	3,   // 77: darta.v1.CloseDartaResponse.darta:type_name -> darta.v1.Darta
-	15,  // 78: darta.v1.DartaService.GetDarta:input_type -> darta.v1.GetDartaRequest
-	17,  // 79: darta.v1.DartaService.GetDartaByNumber:input_type -> darta.v1.GetDartaByNumberRequest
-	19,  // 80: darta.v1.DartaService.ListDartas:input_type -> darta.v1.ListDartasRequest
-	21,  // 81: darta.v1.DartaService.GetMyDartas:input_type -> darta.v1.GetMyDartasRequest
-	23,  // 82: darta.v1.DartaService.GetDartaStats:input_type -> darta.v1.GetDartaStatsRequest
-	25,  // 83: darta.v1.DartaService.CreateDarta:input_type -> darta.v1.CreateDartaRequest
-	27,  // 84: darta.v1.DartaService.SubmitDartaForReview:input_type -> darta.v1.SubmitDartaForReviewRequest
-	29,  // 85: darta.v1.DartaService.ReviewDarta:input_type -> darta.v1.ReviewDartaRequest
-	31,  // 86: darta.v1.DartaService.ClassifyDarta:input_type -> darta.v1.ClassifyDartaRequest
-	33,  // 87: darta.v1.DartaService.ReserveDartaNumber:input_type -> darta.v1.ReserveDartaNumberRequest
-	35,  // 88: darta.v1.DartaService.FinalizeDartaRegistration:input_type -> darta.v1.FinalizeDartaRegistrationRequest
-	37,  // 89: darta.v1.DartaService.DirectRegisterDarta:input_type -> darta.v1.DirectRegisterDartaRequest
-	39,  // 90: darta.v1.DartaService.VoidDarta:input_type -> darta.v1.VoidDartaRequest
-	41,  // 91: darta.v1.DartaService.ScanDarta:input_type -> darta.v1.ScanDartaRequest
-	43,  // 92: darta.v1.DartaService.EnrichDartaMetadata:input_type -> darta.v1.EnrichDartaMetadataRequest
-	45,  // 93: darta.v1.DartaService.FinalizeDartaArchive:input_type -> darta.v1.FinalizeDartaArchiveRequest
-	47,  // 94: darta.v1.DartaService.RouteDarta:input_type -> darta.v1.RouteDartaRequest
-	49,  // 95: darta.v1.DartaService.SectionReviewDarta:input_type -> darta.v1.SectionReviewDartaRequest
-	51,  // 96: darta.v1.DartaService.RequestDartaClarification:input_type -> darta.v1.RequestDartaClarificationRequest
-	53,  // 97: darta.v1.DartaService.ProvideDartaClarification:input_type -> darta.v1.ProvideDartaClarificationRequest
-	55,  // 98: darta.v1.DartaService.AcceptDarta:input_type -> darta.v1.AcceptDartaRequest
-	57,  // 99: darta.v1.DartaService.MarkDartaAction:input_type -> darta.v1.MarkDartaActionRequest
-	59,  // 100: darta.v1.DartaService.IssueDartaResponse:input_type -> darta.v1.IssueDartaResponseRequest
-	61,  // 101: darta.v1.DartaService.RequestDartaAck:input_type -> darta.v1.RequestDartaAckRequest
-	63,  // 102: darta.v1.DartaService.ReceiveDartaAck:input_type -> darta.v1.ReceiveDartaAckRequest
-	65,  // 103: darta.v1.DartaService.SupersedeDartaRecord:input_type -> darta.v1.SupersedeDartaRecordRequest
-	67,  // 104: darta.v1.DartaService.CloseDarta:input_type -> darta.v1.CloseDartaRequest
-	82,  // 105: darta.v1.DartaService.HealthCheck:input_type -> darta.v1.HealthCheckRequest
-	16,  // 106: darta.v1.DartaService.GetDarta:output_type -> darta.v1.GetDartaResponse
-	18,  // 107: darta.v1.DartaService.GetDartaByNumber:output_type -> darta.v1.GetDartaByNumberResponse
-	20,  // 108: darta.v1.DartaService.ListDartas:output_type -> darta.v1.ListDartasResponse
-	22,  // 109: darta.v1.DartaService.GetMyDartas:output_type -> darta.v1.GetMyDartasResponse
-	24,  // 110: darta.v1.DartaService.GetDartaStats:output_type -> darta.v1.GetDartaStatsResponse
-	26,  // 111: darta.v1.DartaService.CreateDarta:output_type -> darta.v1.CreateDartaResponse
-	28,  // 112: darta.v1.DartaService.SubmitDartaForReview:output_type -> darta.v1.SubmitDartaForReviewResponse
-	30,  // 113: darta.v1.DartaService.ReviewDarta:output_type -> darta.v1.ReviewDartaResponse
-	32,  // 114: darta.v1.DartaService.ClassifyDarta:output_type -> darta.v1.ClassifyDartaResponse
-	34,  // 115: darta.v1.DartaService.ReserveDartaNumber:output_type -> darta.v1.ReserveDartaNumberResponse
-	36,  // 116: darta.v1.DartaService.FinalizeDartaRegistration:output_type -> darta.v1.FinalizeDartaRegistrationResponse
-	38,  // 117: darta.v1.DartaService.DirectRegisterDarta:output_type -> darta.v1.DirectRegisterDartaResponse
-	40,  // 118: darta.v1.DartaService.VoidDarta:output_type -> darta.v1.VoidDartaResponse
-	42,  // 119: darta.v1.DartaService.ScanDarta:output_type -> darta.v1.ScanDartaResponse
-	44,  // 120: darta.v1.DartaService.EnrichDartaMetadata:output_type -> darta.v1.EnrichDartaMetadataResponse
-	46,  // 121: darta.v1.DartaService.FinalizeDartaArchive:output_type -> darta.v1.FinalizeDartaArchiveResponse
-	48,  // 122: darta.v1.DartaService.RouteDarta:output_type -> darta.v1.RouteDartaResponse
-	50,  // 123: darta.v1.DartaService.SectionReviewDarta:output_type -> darta.v1.SectionReviewDartaResponse
-	52,  // 124: darta.v1.DartaService.RequestDartaClarification:output_type -> darta.v1.RequestDartaClarificationResponse
-	54,  // 125: darta.v1.DartaService.ProvideDartaClarification:output_type -> darta.v1.ProvideDartaClarificationResponse
-	56,  // 126: darta.v1.DartaService.AcceptDarta:output_type -> darta.v1.AcceptDartaResponse
-	58,  // 127: darta.v1.DartaService.MarkDartaAction:output_type -> darta.v1.MarkDartaActionResponse
-	60,  // 128: darta.v1.DartaService.IssueDartaResponse:output_type -> darta.v1.IssueDartaResponseResponse
-	62,  // 129: darta.v1.DartaService.RequestDartaAck:output_type -> darta.v1.RequestDartaAckResponse
-	64,  // 130: darta.v1.DartaService.ReceiveDartaAck:output_type -> darta.v1.ReceiveDartaAckResponse
-	66,  // 131: darta.v1.DartaService.SupersedeDartaRecord:output_type -> darta.v1.SupersedeDartaRecordResponse
-	68,  // 132: darta.v1.DartaService.CloseDarta:output_type -> darta.v1.CloseDartaResponse
-	83,  // 133: darta.v1.DartaService.HealthCheck:output_type -> darta.v1.HealthCheckResponse
-	106, // [106:134] is the sub-list for method output_type
-	78,  // [78:106] is the sub-list for method input_type
-	78,  // [78:78] is the sub-list for extension type_name
-	78,  // [78:78] is the sub-list for extension extendee
-	0,   // [0:78] is the sub-list for field type_name
+	108, // 11: darta.v1.Darta.priority:type_name -> darta.v1.Priority
+	109, // 12: darta.v1.Darta.assigned_to:type_name -> darta.v1.OrganizationalUnit
+	106, // 13: darta.v1.Darta.current_assignee:type_name -> darta.v1.User
+	105, // 14: darta.v1.Darta.sla_deadline:type_name -> google.protobuf.Timestamp
+	106, // 15: darta.v1.Darta.created_by:type_name -> darta.v1.User
+	105, // 16: darta.v1.Darta.created_at:type_name -> google.protobuf.Timestamp
+	105, // 17: darta.v1.Darta.updated_at:type_name -> google.protobuf.Timestamp
+	110, // 18: darta.v1.Darta.audit_trail:type_name -> darta.v1.AuditEntry
+	111, // 19: darta.v1.Darta.metadata:type_name -> google.protobuf.Struct
+	1,   // 20: darta.v1.Applicant.type:type_name -> darta.v1.ApplicantType
+	7,   // 21: darta.v1.DartaConnection.edges:type_name -> darta.v1.DartaEdge
+	112, // 22: darta.v1.DartaConnection.page_info:type_name -> darta.v1.PageInfo
+	4,   // 23: darta.v1.DartaEdge.node:type_name -> darta.v1.Darta
+	12,  // 24: darta.v1.DartaStats.by_status:type_name -> darta.v1.DartaStatusCount
+	13,  // 25: darta.v1.DartaStats.by_channel:type_name -> darta.v1.ChannelCount
+	9,   // 26: darta.v1.DartaStats.received_to_registered:type_name -> darta.v1.DurationStats
+	9,   // 27: darta.v1.DartaStats.registered_to_closed:type_name -> darta.v1.DurationStats
+	10,  // 28: darta.v1.DartaStats.by_ward:type_name -> darta.v1.DartaStatsBreakdown
+	10,  // 29: darta.v1.DartaStats.by_section:type_name -> darta.v1.DartaStatsBreakdown
+	10,  // 30: darta.v1.DartaStats.by_intake_channel:type_name -> darta.v1.DartaStatsBreakdown
+	10,  // 31: darta.v1.DartaStats.by_priority:type_name -> darta.v1.DartaStatsBreakdown
+	11,  // 32: darta.v1.DartaStats.series:type_name -> darta.v1.DartaStatsBucket
+	9,   // 33: darta.v1.DartaStatsBreakdown.received_to_registered:type_name -> darta.v1.DurationStats
+	9,   // 34: darta.v1.DartaStatsBreakdown.registered_to_closed:type_name -> darta.v1.DurationStats
+	9,   // 35: darta.v1.DartaStatsBreakdown.section_turnaround:type_name -> darta.v1.DurationStats
+	105, // 36: darta.v1.DartaStatsBucket.start:type_name -> google.protobuf.Timestamp
+	0,   // 37: darta.v1.DartaStatusCount.status:type_name -> darta.v1.DartaStatus
+	104, // 38: darta.v1.ChannelCount.channel:type_name -> darta.v1.IntakeChannel
+	102, // 39: darta.v1.DartaFilterInput.scope:type_name -> darta.v1.Scope
+	0,   // 40: darta.v1.DartaFilterInput.status:type_name -> darta.v1.DartaStatus
+	108, // 41: darta.v1.DartaFilterInput.priority:type_name -> darta.v1.Priority
+	104, // 42: darta.v1.DartaFilterInput.intake_channel:type_name -> darta.v1.IntakeChannel
+	105, // 43: darta.v1.DartaFilterInput.from_date:type_name -> google.protobuf.Timestamp
+	105, // 44: darta.v1.DartaFilterInput.to_date:type_name -> google.protobuf.Timestamp
+	1,   // 45: darta.v1.ApplicantInput.type:type_name -> darta.v1.ApplicantType
+	102, // 46: darta.v1.CreateDartaInput.scope:type_name -> darta.v1.Scope
+	15,  // 47: darta.v1.CreateDartaInput.applicant:type_name -> darta.v1.ApplicantInput
+	104, // 48: darta.v1.CreateDartaInput.intake_channel:type_name -> darta.v1.IntakeChannel
+	105, // 49: darta.v1.CreateDartaInput.received_date:type_name -> google.protobuf.Timestamp
+	108, // 50: darta.v1.CreateDartaInput.priority:type_name -> darta.v1.Priority
+	108, // 51: darta.v1.RouteDartaInput.priority:type_name -> darta.v1.Priority
+	2,   // 52: darta.v1.ReviewDartaInput.decision:type_name -> darta.v1.DartaReviewDecision
+	4,   // 53: darta.v1.GetDartaResponse.darta:type_name -> darta.v1.Darta
+	102, // 54: darta.v1.GetDartaByNumberRequest.scope:type_name -> darta.v1.Scope
+	4,   // 55: darta.v1.GetDartaByNumberResponse.darta:type_name -> darta.v1.Darta
+	14,  // 56: darta.v1.ListDartasRequest.filter:type_name -> darta.v1.DartaFilterInput
+	113, // 57: darta.v1.ListDartasRequest.pagination:type_name -> darta.v1.PaginationInput
+	6,   // 58: darta.v1.ListDartasResponse.connection:type_name -> darta.v1.DartaConnection
+	0,   // 59: darta.v1.GetMyDartasRequest.status:type_name -> darta.v1.DartaStatus
+	113, // 60: darta.v1.GetMyDartasRequest.pagination:type_name -> darta.v1.PaginationInput
+	6,   // 61: darta.v1.GetMyDartasResponse.connection:type_name -> darta.v1.DartaConnection
+	102, // 62: darta.v1.GetDartaStatsRequest.scope:type_name -> darta.v1.Scope
+	105, // 63: darta.v1.GetDartaStatsRequest.from_date:type_name -> google.protobuf.Timestamp
+	105, // 64: darta.v1.GetDartaStatsRequest.to_date:type_name -> google.protobuf.Timestamp
+	3,   // 65: darta.v1.GetDartaStatsRequest.interval:type_name -> darta.v1.StatsInterval
+	8,   // 66: darta.v1.GetDartaStatsResponse.stats:type_name -> darta.v1.DartaStats
+	16,  // 67: darta.v1.CreateDartaRequest.input:type_name -> darta.v1.CreateDartaInput
+	4,   // 68: darta.v1.CreateDartaResponse.darta:type_name -> darta.v1.Darta
+	96,  // 69: darta.v1.CreateDartaResponse.suspected_duplicates:type_name -> darta.v1.DuplicateCandidate
+	4,   // 70: darta.v1.SubmitDartaForReviewResponse.darta:type_name -> darta.v1.Darta
+	18,  // 71: darta.v1.ReviewDartaRequest.input:type_name -> darta.v1.ReviewDartaInput
+	4,   // 72: darta.v1.ReviewDartaResponse.darta:type_name -> darta.v1.Darta
+	4,   // 73: darta.v1.ClassifyDartaResponse.darta:type_name -> darta.v1.Darta
+	4,   // 74: darta.v1.ReserveDartaNumberResponse.darta:type_name -> darta.v1.Darta
+	96,  // 75: darta.v1.ReserveDartaNumberResponse.suspected_duplicates:type_name -> darta.v1.DuplicateCandidate
+	4,   // 76: darta.v1.FinalizeDartaRegistrationResponse.darta:type_name -> darta.v1.Darta
+	4,   // 77: darta.v1.DirectRegisterDartaResponse.darta:type_name -> darta.v1.Darta
+	4,   // 78: darta.v1.VoidDartaResponse.darta:type_name -> darta.v1.Darta
+	4,   // 79: darta.v1.ScanDartaResponse.darta:type_name -> darta.v1.Darta
+	111, // 80: darta.v1.EnrichDartaMetadataRequest.metadata:type_name -> google.protobuf.Struct
+	4,   // 81: darta.v1.EnrichDartaMetadataResponse.darta:type_name -> darta.v1.Darta
+	4,   // 82: darta.v1.FinalizeDartaArchiveResponse.darta:type_name -> darta.v1.Darta
+	17,  // 83: darta.v1.RouteDartaRequest.input:type_name -> darta.v1.RouteDartaInput
+	4,   // 84: darta.v1.RouteDartaResponse.darta:type_name -> darta.v1.Darta
+	4,   // 85: darta.v1.SectionReviewDartaResponse.darta:type_name -> darta.v1.Darta
+	4,   // 86: darta.v1.RequestDartaClarificationResponse.darta:type_name -> darta.v1.Darta
+	4,   // 87: darta.v1.ProvideDartaClarificationResponse.darta:type_name -> darta.v1.Darta
+	4,   // 88: darta.v1.AcceptDartaResponse.darta:type_name -> darta.v1.Darta
+	4,   // 89: darta.v1.MarkDartaActionResponse.darta:type_name -> darta.v1.Darta
+	4,   // 90: darta.v1.IssueDartaResponseResponse.darta:type_name -> darta.v1.Darta
+	4,   // 91: darta.v1.RequestDartaAckResponse.darta:type_name -> darta.v1.Darta
+	4,   // 92: darta.v1.ReceiveDartaAckResponse.darta:type_name -> darta.v1.Darta
+	4,   // 93: darta.v1.SupersedeDartaRecordResponse.darta:type_name -> darta.v1.Darta
+	4,   // 94: darta.v1.GetDartaSupersessionChainResponse.dartas:type_name -> darta.v1.Darta
+	4,   // 95: darta.v1.CloseDartaResponse.darta:type_name -> darta.v1.Darta
+	4,   // 96: darta.v1.DartaEvent.darta:type_name -> darta.v1.Darta
+	105, // 97: darta.v1.DartaEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	4,   // 98: darta.v1.BatchGetDartasResponse.dartas:type_name -> darta.v1.Darta
+	5,   // 99: darta.v1.BatchGetApplicantsResponse.applicants:type_name -> darta.v1.Applicant
+	107, // 100: darta.v1.BatchGetAttachmentsResponse.attachments:type_name -> darta.v1.Attachment
+	85,  // 101: darta.v1.BatchGetDartaLinksResponse.links:type_name -> darta.v1.DartaLinks
+	86,  // 102: darta.v1.DartaLinks.relations:type_name -> darta.v1.DartaRelation
+	110, // 103: darta.v1.BatchGetAuditTrailsResponse.entries:type_name -> darta.v1.AuditEntry
+	90,  // 104: darta.v1.SearchRecordsRequest.filter:type_name -> darta.v1.SearchFilter
+	92,  // 105: darta.v1.SearchRecordsResponse.hits:type_name -> darta.v1.SearchHit
+	94,  // 106: darta.v1.SearchRecordsResponse.facets:type_name -> darta.v1.SearchFacet
+	93,  // 107: darta.v1.SearchHit.highlights:type_name -> darta.v1.TextRange
+	95,  // 108: darta.v1.SearchFacet.values:type_name -> darta.v1.SearchFacetValue
+	0,   // 109: darta.v1.DuplicateCandidate.status:type_name -> darta.v1.DartaStatus
+	105, // 110: darta.v1.DuplicateCandidate.received_date:type_name -> google.protobuf.Timestamp
+	96,  // 111: darta.v1.ListDuplicateCandidatesResponse.candidates:type_name -> darta.v1.DuplicateCandidate
+	4,   // 112: darta.v1.LinkDuplicateDartaResponse.darta:type_name -> darta.v1.Darta
+	19,  // 113: darta.v1.DartaService.GetDarta:input_type -> darta.v1.GetDartaRequest
+	21,  // 114: darta.v1.DartaService.GetDartaByNumber:input_type -> darta.v1.GetDartaByNumberRequest
+	23,  // 115: darta.v1.DartaService.ListDartas:input_type -> darta.v1.ListDartasRequest
+	25,  // 116: darta.v1.DartaService.GetMyDartas:input_type -> darta.v1.GetMyDartasRequest
+	27,  // 117: darta.v1.DartaService.GetDartaStats:input_type -> darta.v1.GetDartaStatsRequest
+	29,  // 118: darta.v1.DartaService.CreateDarta:input_type -> darta.v1.CreateDartaRequest
+	31,  // 119: darta.v1.DartaService.SubmitDartaForReview:input_type -> darta.v1.SubmitDartaForReviewRequest
+	33,  // 120: darta.v1.DartaService.ReviewDarta:input_type -> darta.v1.ReviewDartaRequest
+	35,  // 121: darta.v1.DartaService.ClassifyDarta:input_type -> darta.v1.ClassifyDartaRequest
+	37,  // 122: darta.v1.DartaService.ReserveDartaNumber:input_type -> darta.v1.ReserveDartaNumberRequest
+	39,  // 123: darta.v1.DartaService.FinalizeDartaRegistration:input_type -> darta.v1.FinalizeDartaRegistrationRequest
+	41,  // 124: darta.v1.DartaService.DirectRegisterDarta:input_type -> darta.v1.DirectRegisterDartaRequest
+	43,  // 125: darta.v1.DartaService.VoidDarta:input_type -> darta.v1.VoidDartaRequest
+	45,  // 126: darta.v1.DartaService.ScanDarta:input_type -> darta.v1.ScanDartaRequest
+	47,  // 127: darta.v1.DartaService.EnrichDartaMetadata:input_type -> darta.v1.EnrichDartaMetadataRequest
+	49,  // 128: darta.v1.DartaService.FinalizeDartaArchive:input_type -> darta.v1.FinalizeDartaArchiveRequest
+	51,  // 129: darta.v1.DartaService.RouteDarta:input_type -> darta.v1.RouteDartaRequest
+	53,  // 130: darta.v1.DartaService.SectionReviewDarta:input_type -> darta.v1.SectionReviewDartaRequest
+	55,  // 131: darta.v1.DartaService.RequestDartaClarification:input_type -> darta.v1.RequestDartaClarificationRequest
+	57,  // 132: darta.v1.DartaService.ProvideDartaClarification:input_type -> darta.v1.ProvideDartaClarificationRequest
+	59,  // 133: darta.v1.DartaService.AcceptDarta:input_type -> darta.v1.AcceptDartaRequest
+	61,  // 134: darta.v1.DartaService.MarkDartaAction:input_type -> darta.v1.MarkDartaActionRequest
+	63,  // 135: darta.v1.DartaService.IssueDartaResponse:input_type -> darta.v1.IssueDartaResponseRequest
+	65,  // 136: darta.v1.DartaService.RequestDartaAck:input_type -> darta.v1.RequestDartaAckRequest
+	67,  // 137: darta.v1.DartaService.ReceiveDartaAck:input_type -> darta.v1.ReceiveDartaAckRequest
+	69,  // 138: darta.v1.DartaService.SupersedeDartaRecord:input_type -> darta.v1.SupersedeDartaRecordRequest
+	71,  // 139: darta.v1.DartaService.GetDartaSupersessionChain:input_type -> darta.v1.GetDartaSupersessionChainRequest
+	73,  // 140: darta.v1.DartaService.CloseDarta:input_type -> darta.v1.CloseDartaRequest
+	97,  // 141: darta.v1.DartaService.ListDuplicateCandidates:input_type -> darta.v1.ListDuplicateCandidatesRequest
+	99,  // 142: darta.v1.DartaService.LinkDuplicateDarta:input_type -> darta.v1.LinkDuplicateDartaRequest
+	77,  // 143: darta.v1.DartaService.BatchGetDartas:input_type -> darta.v1.BatchGetDartasRequest
+	79,  // 144: darta.v1.DartaService.BatchGetApplicants:input_type -> darta.v1.BatchGetApplicantsRequest
+	81,  // 145: darta.v1.DartaService.BatchGetAttachments:input_type -> darta.v1.BatchGetAttachmentsRequest
+	83,  // 146: darta.v1.DartaService.BatchGetDartaLinks:input_type -> darta.v1.BatchGetDartaLinksRequest
+	87,  // 147: darta.v1.DartaService.BatchGetAuditTrails:input_type -> darta.v1.BatchGetAuditTrailsRequest
+	89,  // 148: darta.v1.DartaService.SearchRecords:input_type -> darta.v1.SearchRecordsRequest
+	75,  // 149: darta.v1.DartaService.WatchDartas:input_type -> darta.v1.WatchDartasRequest
+	114, // 150: darta.v1.DartaService.HealthCheck:input_type -> darta.v1.HealthCheckRequest
+	20,  // 151: darta.v1.DartaService.GetDarta:output_type -> darta.v1.GetDartaResponse
+	22,  // 152: darta.v1.DartaService.GetDartaByNumber:output_type -> darta.v1.GetDartaByNumberResponse
+	24,  // 153: darta.v1.DartaService.ListDartas:output_type -> darta.v1.ListDartasResponse
+	26,  // 154: darta.v1.DartaService.GetMyDartas:output_type -> darta.v1.GetMyDartasResponse
+	28,  // 155: darta.v1.DartaService.GetDartaStats:output_type -> darta.v1.GetDartaStatsResponse
+	30,  // 156: darta.v1.DartaService.CreateDarta:output_type -> darta.v1.CreateDartaResponse
+	32,  // 157: darta.v1.DartaService.SubmitDartaForReview:output_type -> darta.v1.SubmitDartaForReviewResponse
+	34,  // 158: darta.v1.DartaService.ReviewDarta:output_type -> darta.v1.ReviewDartaResponse
+	36,  // 159: darta.v1.DartaService.ClassifyDarta:output_type -> darta.v1.ClassifyDartaResponse
+	38,  // 160: darta.v1.DartaService.ReserveDartaNumber:output_type -> darta.v1.ReserveDartaNumberResponse
+	40,  // 161: darta.v1.DartaService.FinalizeDartaRegistration:output_type -> darta.v1.FinalizeDartaRegistrationResponse
+	42,  // 162: darta.v1.DartaService.DirectRegisterDarta:output_type -> darta.v1.DirectRegisterDartaResponse
+	44,  // 163: darta.v1.DartaService.VoidDarta:output_type -> darta.v1.VoidDartaResponse
+	46,  // 164: darta.v1.DartaService.ScanDarta:output_type -> darta.v1.ScanDartaResponse
+	48,  // 165: darta.v1.DartaService.EnrichDartaMetadata:output_type -> darta.v1.EnrichDartaMetadataResponse
+	50,  // 166: darta.v1.DartaService.FinalizeDartaArchive:output_type -> darta.v1.FinalizeDartaArchiveResponse
+	52,  // 167: darta.v1.DartaService.RouteDarta:output_type -> darta.v1.RouteDartaResponse
+	54,  // 168: darta.v1.DartaService.SectionReviewDarta:output_type -> darta.v1.SectionReviewDartaResponse
+	56,  // 169: darta.v1.DartaService.RequestDartaClarification:output_type -> darta.v1.RequestDartaClarificationResponse
+	58,  // 170: darta.v1.DartaService.ProvideDartaClarification:output_type -> darta.v1.ProvideDartaClarificationResponse
+	60,  // 171: darta.v1.DartaService.AcceptDarta:output_type -> darta.v1.AcceptDartaResponse
+	62,  // 172: darta.v1.DartaService.MarkDartaAction:output_type -> darta.v1.MarkDartaActionResponse
+	64,  // 173: darta.v1.DartaService.IssueDartaResponse:output_type -> darta.v1.IssueDartaResponseResponse
+	66,  // 174: darta.v1.DartaService.RequestDartaAck:output_type -> darta.v1.RequestDartaAckResponse
+	68,  // 175: darta.v1.DartaService.ReceiveDartaAck:output_type -> darta.v1.ReceiveDartaAckResponse
+	70,  // 176: darta.v1.DartaService.SupersedeDartaRecord:output_type -> darta.v1.SupersedeDartaRecordResponse
+	72,  // 177: darta.v1.DartaService.GetDartaSupersessionChain:output_type -> darta.v1.GetDartaSupersessionChainResponse
+	74,  // 178: darta.v1.DartaService.CloseDarta:output_type -> darta.v1.CloseDartaResponse
+	98,  // 179: darta.v1.DartaService.ListDuplicateCandidates:output_type -> darta.v1.ListDuplicateCandidatesResponse
+	100, // 180: darta.v1.DartaService.LinkDuplicateDarta:output_type -> darta.v1.LinkDuplicateDartaResponse
+	78,  // 181: darta.v1.DartaService.BatchGetDartas:output_type -> darta.v1.BatchGetDartasResponse
+	80,  // 182: darta.v1.DartaService.BatchGetApplicants:output_type -> darta.v1.BatchGetApplicantsResponse
+	82,  // 183: darta.v1.DartaService.BatchGetAttachments:output_type -> darta.v1.BatchGetAttachmentsResponse
+	84,  // 184: darta.v1.DartaService.BatchGetDartaLinks:output_type -> darta.v1.BatchGetDartaLinksResponse
+	88,  // 185: darta.v1.DartaService.BatchGetAuditTrails:output_type -> darta.v1.BatchGetAuditTrailsResponse
+	91,  // 186: darta.v1.DartaService.SearchRecords:output_type -> darta.v1.SearchRecordsResponse
+	76,  // 187: darta.v1.DartaService.WatchDartas:output_type -> darta.v1.DartaEvent
+	115, // 188: darta.v1.DartaService.HealthCheck:output_type -> darta.v1.HealthCheckResponse
+	151, // [151:189] is the sub-list for method output_type
+	113, // [113:151] is the sub-list for method input_type
+	113, // [113:113] is the sub-list for extension type_name
+	113, // [113:113] is the sub-list for extension extendee
+	0,   // [0:113] is the sub-list for field type_name
 }
 
 func init() { file_darta_v1_darta_proto_init() }
@@ -4541,8 +7093,8 @@ func file_darta_v1_darta_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_darta_v1_darta_proto_rawDesc), len(file_darta_v1_darta_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   66,
+			NumEnums:      4,
+			NumMessages:   97,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
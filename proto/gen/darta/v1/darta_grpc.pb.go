@@ -45,7 +45,17 @@ const (
 	DartaService_RequestDartaAck_FullMethodName           = "/darta.v1.DartaService/RequestDartaAck"
 	DartaService_ReceiveDartaAck_FullMethodName           = "/darta.v1.DartaService/ReceiveDartaAck"
 	DartaService_SupersedeDartaRecord_FullMethodName      = "/darta.v1.DartaService/SupersedeDartaRecord"
+	DartaService_GetDartaSupersessionChain_FullMethodName = "/darta.v1.DartaService/GetDartaSupersessionChain"
 	DartaService_CloseDarta_FullMethodName                = "/darta.v1.DartaService/CloseDarta"
+	DartaService_ListDuplicateCandidates_FullMethodName   = "/darta.v1.DartaService/ListDuplicateCandidates"
+	DartaService_LinkDuplicateDarta_FullMethodName        = "/darta.v1.DartaService/LinkDuplicateDarta"
+	DartaService_BatchGetDartas_FullMethodName            = "/darta.v1.DartaService/BatchGetDartas"
+	DartaService_BatchGetApplicants_FullMethodName        = "/darta.v1.DartaService/BatchGetApplicants"
+	DartaService_BatchGetAttachments_FullMethodName       = "/darta.v1.DartaService/BatchGetAttachments"
+	DartaService_BatchGetDartaLinks_FullMethodName        = "/darta.v1.DartaService/BatchGetDartaLinks"
+	DartaService_BatchGetAuditTrails_FullMethodName       = "/darta.v1.DartaService/BatchGetAuditTrails"
+	DartaService_SearchRecords_FullMethodName             = "/darta.v1.DartaService/SearchRecords"
+	DartaService_WatchDartas_FullMethodName               = "/darta.v1.DartaService/WatchDartas"
 	DartaService_HealthCheck_FullMethodName               = "/darta.v1.DartaService/HealthCheck"
 )
 
@@ -84,7 +94,22 @@ type DartaServiceClient interface {
 	RequestDartaAck(ctx context.Context, in *RequestDartaAckRequest, opts ...grpc.CallOption) (*RequestDartaAckResponse, error)
 	ReceiveDartaAck(ctx context.Context, in *ReceiveDartaAckRequest, opts ...grpc.CallOption) (*ReceiveDartaAckResponse, error)
 	SupersedeDartaRecord(ctx context.Context, in *SupersedeDartaRecordRequest, opts ...grpc.CallOption) (*SupersedeDartaRecordResponse, error)
+	GetDartaSupersessionChain(ctx context.Context, in *GetDartaSupersessionChainRequest, opts ...grpc.CallOption) (*GetDartaSupersessionChainResponse, error)
 	CloseDarta(ctx context.Context, in *CloseDartaRequest, opts ...grpc.CallOption) (*CloseDartaResponse, error)
+	// Duplicate detection
+	ListDuplicateCandidates(ctx context.Context, in *ListDuplicateCandidatesRequest, opts ...grpc.CallOption) (*ListDuplicateCandidatesResponse, error)
+	LinkDuplicateDarta(ctx context.Context, in *LinkDuplicateDartaRequest, opts ...grpc.CallOption) (*LinkDuplicateDartaResponse, error)
+	// Batch operations - used by the gateway's DataLoaders. Unknown or
+	// other-tenant IDs are omitted from the response.
+	BatchGetDartas(ctx context.Context, in *BatchGetDartasRequest, opts ...grpc.CallOption) (*BatchGetDartasResponse, error)
+	BatchGetApplicants(ctx context.Context, in *BatchGetApplicantsRequest, opts ...grpc.CallOption) (*BatchGetApplicantsResponse, error)
+	BatchGetAttachments(ctx context.Context, in *BatchGetAttachmentsRequest, opts ...grpc.CallOption) (*BatchGetAttachmentsResponse, error)
+	BatchGetDartaLinks(ctx context.Context, in *BatchGetDartaLinksRequest, opts ...grpc.CallOption) (*BatchGetDartaLinksResponse, error)
+	BatchGetAuditTrails(ctx context.Context, in *BatchGetAuditTrailsRequest, opts ...grpc.CallOption) (*BatchGetAuditTrailsResponse, error)
+	// Search across dartas and chalanis in the caller's tenant
+	SearchRecords(ctx context.Context, in *SearchRecordsRequest, opts ...grpc.CallOption) (*SearchRecordsResponse, error)
+	// Streaming operations
+	WatchDartas(ctx context.Context, in *WatchDartasRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DartaEvent], error)
 	// Health check
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
@@ -357,6 +382,16 @@ func (c *dartaServiceClient) SupersedeDartaRecord(ctx context.Context, in *Super
 	return out, nil
 }
 
+func (c *dartaServiceClient) GetDartaSupersessionChain(ctx context.Context, in *GetDartaSupersessionChainRequest, opts ...grpc.CallOption) (*GetDartaSupersessionChainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDartaSupersessionChainResponse)
+	err := c.cc.Invoke(ctx, DartaService_GetDartaSupersessionChain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dartaServiceClient) CloseDarta(ctx context.Context, in *CloseDartaRequest, opts ...grpc.CallOption) (*CloseDartaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CloseDartaResponse)
@@ -367,6 +402,105 @@ func (c *dartaServiceClient) CloseDarta(ctx context.Context, in *CloseDartaReque
 	return out, nil
 }
 
+func (c *dartaServiceClient) ListDuplicateCandidates(ctx context.Context, in *ListDuplicateCandidatesRequest, opts ...grpc.CallOption) (*ListDuplicateCandidatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDuplicateCandidatesResponse)
+	err := c.cc.Invoke(ctx, DartaService_ListDuplicateCandidates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dartaServiceClient) LinkDuplicateDarta(ctx context.Context, in *LinkDuplicateDartaRequest, opts ...grpc.CallOption) (*LinkDuplicateDartaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkDuplicateDartaResponse)
+	err := c.cc.Invoke(ctx, DartaService_LinkDuplicateDarta_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dartaServiceClient) BatchGetDartas(ctx context.Context, in *BatchGetDartasRequest, opts ...grpc.CallOption) (*BatchGetDartasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetDartasResponse)
+	err := c.cc.Invoke(ctx, DartaService_BatchGetDartas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dartaServiceClient) BatchGetApplicants(ctx context.Context, in *BatchGetApplicantsRequest, opts ...grpc.CallOption) (*BatchGetApplicantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetApplicantsResponse)
+	err := c.cc.Invoke(ctx, DartaService_BatchGetApplicants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dartaServiceClient) BatchGetAttachments(ctx context.Context, in *BatchGetAttachmentsRequest, opts ...grpc.CallOption) (*BatchGetAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetAttachmentsResponse)
+	err := c.cc.Invoke(ctx, DartaService_BatchGetAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dartaServiceClient) BatchGetDartaLinks(ctx context.Context, in *BatchGetDartaLinksRequest, opts ...grpc.CallOption) (*BatchGetDartaLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetDartaLinksResponse)
+	err := c.cc.Invoke(ctx, DartaService_BatchGetDartaLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dartaServiceClient) BatchGetAuditTrails(ctx context.Context, in *BatchGetAuditTrailsRequest, opts ...grpc.CallOption) (*BatchGetAuditTrailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetAuditTrailsResponse)
+	err := c.cc.Invoke(ctx, DartaService_BatchGetAuditTrails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dartaServiceClient) SearchRecords(ctx context.Context, in *SearchRecordsRequest, opts ...grpc.CallOption) (*SearchRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchRecordsResponse)
+	err := c.cc.Invoke(ctx, DartaService_SearchRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dartaServiceClient) WatchDartas(ctx context.Context, in *WatchDartasRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DartaEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DartaService_ServiceDesc.Streams[0], DartaService_WatchDartas_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchDartasRequest, DartaEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DartaService_WatchDartasClient = grpc.ServerStreamingClient[DartaEvent]
+
 func (c *dartaServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthCheckResponse)
@@ -412,7 +546,22 @@ type DartaServiceServer interface {
 	RequestDartaAck(context.Context, *RequestDartaAckRequest) (*RequestDartaAckResponse, error)
 	ReceiveDartaAck(context.Context, *ReceiveDartaAckRequest) (*ReceiveDartaAckResponse, error)
 	SupersedeDartaRecord(context.Context, *SupersedeDartaRecordRequest) (*SupersedeDartaRecordResponse, error)
+	GetDartaSupersessionChain(context.Context, *GetDartaSupersessionChainRequest) (*GetDartaSupersessionChainResponse, error)
 	CloseDarta(context.Context, *CloseDartaRequest) (*CloseDartaResponse, error)
+	// Duplicate detection
+	ListDuplicateCandidates(context.Context, *ListDuplicateCandidatesRequest) (*ListDuplicateCandidatesResponse, error)
+	LinkDuplicateDarta(context.Context, *LinkDuplicateDartaRequest) (*LinkDuplicateDartaResponse, error)
+	// Batch operations - used by the gateway's DataLoaders. Unknown or
+	// other-tenant IDs are omitted from the response.
+	BatchGetDartas(context.Context, *BatchGetDartasRequest) (*BatchGetDartasResponse, error)
+	BatchGetApplicants(context.Context, *BatchGetApplicantsRequest) (*BatchGetApplicantsResponse, error)
+	BatchGetAttachments(context.Context, *BatchGetAttachmentsRequest) (*BatchGetAttachmentsResponse, error)
+	BatchGetDartaLinks(context.Context, *BatchGetDartaLinksRequest) (*BatchGetDartaLinksResponse, error)
+	BatchGetAuditTrails(context.Context, *BatchGetAuditTrailsRequest) (*BatchGetAuditTrailsResponse, error)
+	// Search across dartas and chalanis in the caller's tenant
+	SearchRecords(context.Context, *SearchRecordsRequest) (*SearchRecordsResponse, error)
+	// Streaming operations
+	WatchDartas(*WatchDartasRequest, grpc.ServerStreamingServer[DartaEvent]) error
 	// Health check
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
 	mustEmbedUnimplementedDartaServiceServer()
@@ -503,9 +652,39 @@ func (UnimplementedDartaServiceServer) ReceiveDartaAck(context.Context, *Receive
 func (UnimplementedDartaServiceServer) SupersedeDartaRecord(context.Context, *SupersedeDartaRecordRequest) (*SupersedeDartaRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SupersedeDartaRecord not implemented")
 }
+func (UnimplementedDartaServiceServer) GetDartaSupersessionChain(context.Context, *GetDartaSupersessionChainRequest) (*GetDartaSupersessionChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDartaSupersessionChain not implemented")
+}
 func (UnimplementedDartaServiceServer) CloseDarta(context.Context, *CloseDartaRequest) (*CloseDartaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseDarta not implemented")
 }
+func (UnimplementedDartaServiceServer) ListDuplicateCandidates(context.Context, *ListDuplicateCandidatesRequest) (*ListDuplicateCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDuplicateCandidates not implemented")
+}
+func (UnimplementedDartaServiceServer) LinkDuplicateDarta(context.Context, *LinkDuplicateDartaRequest) (*LinkDuplicateDartaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkDuplicateDarta not implemented")
+}
+func (UnimplementedDartaServiceServer) BatchGetDartas(context.Context, *BatchGetDartasRequest) (*BatchGetDartasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetDartas not implemented")
+}
+func (UnimplementedDartaServiceServer) BatchGetApplicants(context.Context, *BatchGetApplicantsRequest) (*BatchGetApplicantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetApplicants not implemented")
+}
+func (UnimplementedDartaServiceServer) BatchGetAttachments(context.Context, *BatchGetAttachmentsRequest) (*BatchGetAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetAttachments not implemented")
+}
+func (UnimplementedDartaServiceServer) BatchGetDartaLinks(context.Context, *BatchGetDartaLinksRequest) (*BatchGetDartaLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetDartaLinks not implemented")
+}
+func (UnimplementedDartaServiceServer) BatchGetAuditTrails(context.Context, *BatchGetAuditTrailsRequest) (*BatchGetAuditTrailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetAuditTrails not implemented")
+}
+func (UnimplementedDartaServiceServer) SearchRecords(context.Context, *SearchRecordsRequest) (*SearchRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchRecords not implemented")
+}
+func (UnimplementedDartaServiceServer) WatchDartas(*WatchDartasRequest, grpc.ServerStreamingServer[DartaEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDartas not implemented")
+}
 func (UnimplementedDartaServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -998,6 +1177,24 @@ func _DartaService_SupersedeDartaRecord_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DartaService_GetDartaSupersessionChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDartaSupersessionChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DartaServiceServer).GetDartaSupersessionChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DartaService_GetDartaSupersessionChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DartaServiceServer).GetDartaSupersessionChain(ctx, req.(*GetDartaSupersessionChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DartaService_CloseDarta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CloseDartaRequest)
 	if err := dec(in); err != nil {
@@ -1016,6 +1213,161 @@ func _DartaService_CloseDarta_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DartaService_ListDuplicateCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDuplicateCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DartaServiceServer).ListDuplicateCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DartaService_ListDuplicateCandidates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DartaServiceServer).ListDuplicateCandidates(ctx, req.(*ListDuplicateCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DartaService_LinkDuplicateDarta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkDuplicateDartaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DartaServiceServer).LinkDuplicateDarta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DartaService_LinkDuplicateDarta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DartaServiceServer).LinkDuplicateDarta(ctx, req.(*LinkDuplicateDartaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DartaService_BatchGetDartas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetDartasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DartaServiceServer).BatchGetDartas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DartaService_BatchGetDartas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DartaServiceServer).BatchGetDartas(ctx, req.(*BatchGetDartasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DartaService_BatchGetApplicants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetApplicantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DartaServiceServer).BatchGetApplicants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DartaService_BatchGetApplicants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DartaServiceServer).BatchGetApplicants(ctx, req.(*BatchGetApplicantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DartaService_BatchGetAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DartaServiceServer).BatchGetAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DartaService_BatchGetAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DartaServiceServer).BatchGetAttachments(ctx, req.(*BatchGetAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DartaService_BatchGetDartaLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetDartaLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DartaServiceServer).BatchGetDartaLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DartaService_BatchGetDartaLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DartaServiceServer).BatchGetDartaLinks(ctx, req.(*BatchGetDartaLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DartaService_BatchGetAuditTrails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetAuditTrailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DartaServiceServer).BatchGetAuditTrails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DartaService_BatchGetAuditTrails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DartaServiceServer).BatchGetAuditTrails(ctx, req.(*BatchGetAuditTrailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DartaService_SearchRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DartaServiceServer).SearchRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DartaService_SearchRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DartaServiceServer).SearchRecords(ctx, req.(*SearchRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DartaService_WatchDartas_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDartasRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DartaServiceServer).WatchDartas(m, &grpc.GenericServerStream[WatchDartasRequest, DartaEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DartaService_WatchDartasServer = grpc.ServerStreamingServer[DartaEvent]
+
 func _DartaService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HealthCheckRequest)
 	if err := dec(in); err != nil {
@@ -1145,15 +1497,57 @@ var DartaService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SupersedeDartaRecord",
 			Handler:    _DartaService_SupersedeDartaRecord_Handler,
 		},
+		{
+			MethodName: "GetDartaSupersessionChain",
+			Handler:    _DartaService_GetDartaSupersessionChain_Handler,
+		},
 		{
 			MethodName: "CloseDarta",
 			Handler:    _DartaService_CloseDarta_Handler,
 		},
+		{
+			MethodName: "ListDuplicateCandidates",
+			Handler:    _DartaService_ListDuplicateCandidates_Handler,
+		},
+		{
+			MethodName: "LinkDuplicateDarta",
+			Handler:    _DartaService_LinkDuplicateDarta_Handler,
+		},
+		{
+			MethodName: "BatchGetDartas",
+			Handler:    _DartaService_BatchGetDartas_Handler,
+		},
+		{
+			MethodName: "BatchGetApplicants",
+			Handler:    _DartaService_BatchGetApplicants_Handler,
+		},
+		{
+			MethodName: "BatchGetAttachments",
+			Handler:    _DartaService_BatchGetAttachments_Handler,
+		},
+		{
+			MethodName: "BatchGetDartaLinks",
+			Handler:    _DartaService_BatchGetDartaLinks_Handler,
+		},
+		{
+			MethodName: "BatchGetAuditTrails",
+			Handler:    _DartaService_BatchGetAuditTrails_Handler,
+		},
+		{
+			MethodName: "SearchRecords",
+			Handler:    _DartaService_SearchRecords_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _DartaService_HealthCheck_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDartas",
+			Handler:       _DartaService_WatchDartas_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "darta/v1/darta.proto",
 }
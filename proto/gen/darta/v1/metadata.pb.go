@@ -0,0 +1,446 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
+// source: darta/v1/metadata.proto
+
+package dartav1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MetadataSchema is the JSON Schema the metadata of a tenant's dartas of a
+// classification code must satisfy. An empty classification code is the
+// tenant's default, applying to dartas no other schema covers.
+type MetadataSchema struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ClassificationCode string                 `protobuf:"bytes,1,opt,name=classification_code,json=classificationCode,proto3" json:"classification_code,omitempty"`
+	Schema             *structpb.Struct       `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"` // Self-contained JSON Schema document
+	UpdatedBy          string                 `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MetadataSchema) Reset() {
+	*x = MetadataSchema{}
+	mi := &file_darta_v1_metadata_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetadataSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataSchema) ProtoMessage() {}
+
+func (x *MetadataSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_metadata_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataSchema.ProtoReflect.Descriptor instead.
+func (*MetadataSchema) Descriptor() ([]byte, []int) {
+	return file_darta_v1_metadata_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MetadataSchema) GetClassificationCode() string {
+	if x != nil {
+		return x.ClassificationCode
+	}
+	return ""
+}
+
+func (x *MetadataSchema) GetSchema() *structpb.Struct {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+func (x *MetadataSchema) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *MetadataSchema) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListMetadataSchemasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMetadataSchemasRequest) Reset() {
+	*x = ListMetadataSchemasRequest{}
+	mi := &file_darta_v1_metadata_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMetadataSchemasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetadataSchemasRequest) ProtoMessage() {}
+
+func (x *ListMetadataSchemasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_metadata_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetadataSchemasRequest.ProtoReflect.Descriptor instead.
+func (*ListMetadataSchemasRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_metadata_proto_rawDescGZIP(), []int{1}
+}
+
+type ListMetadataSchemasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schemas       []*MetadataSchema      `protobuf:"bytes,1,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMetadataSchemasResponse) Reset() {
+	*x = ListMetadataSchemasResponse{}
+	mi := &file_darta_v1_metadata_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMetadataSchemasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetadataSchemasResponse) ProtoMessage() {}
+
+func (x *ListMetadataSchemasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_metadata_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetadataSchemasResponse.ProtoReflect.Descriptor instead.
+func (*ListMetadataSchemasResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_metadata_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListMetadataSchemasResponse) GetSchemas() []*MetadataSchema {
+	if x != nil {
+		return x.Schemas
+	}
+	return nil
+}
+
+type SetMetadataSchemaRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ClassificationCode string                 `protobuf:"bytes,1,opt,name=classification_code,json=classificationCode,proto3" json:"classification_code,omitempty"`
+	Schema             *structpb.Struct       `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SetMetadataSchemaRequest) Reset() {
+	*x = SetMetadataSchemaRequest{}
+	mi := &file_darta_v1_metadata_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMetadataSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMetadataSchemaRequest) ProtoMessage() {}
+
+func (x *SetMetadataSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_metadata_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMetadataSchemaRequest.ProtoReflect.Descriptor instead.
+func (*SetMetadataSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_metadata_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetMetadataSchemaRequest) GetClassificationCode() string {
+	if x != nil {
+		return x.ClassificationCode
+	}
+	return ""
+}
+
+func (x *SetMetadataSchemaRequest) GetSchema() *structpb.Struct {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type SetMetadataSchemaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schema        *MetadataSchema        `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMetadataSchemaResponse) Reset() {
+	*x = SetMetadataSchemaResponse{}
+	mi := &file_darta_v1_metadata_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMetadataSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMetadataSchemaResponse) ProtoMessage() {}
+
+func (x *SetMetadataSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_metadata_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMetadataSchemaResponse.ProtoReflect.Descriptor instead.
+func (*SetMetadataSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_metadata_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetMetadataSchemaResponse) GetSchema() *MetadataSchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type DeleteMetadataSchemaRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ClassificationCode string                 `protobuf:"bytes,1,opt,name=classification_code,json=classificationCode,proto3" json:"classification_code,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DeleteMetadataSchemaRequest) Reset() {
+	*x = DeleteMetadataSchemaRequest{}
+	mi := &file_darta_v1_metadata_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMetadataSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMetadataSchemaRequest) ProtoMessage() {}
+
+func (x *DeleteMetadataSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_metadata_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMetadataSchemaRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMetadataSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_metadata_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteMetadataSchemaRequest) GetClassificationCode() string {
+	if x != nil {
+		return x.ClassificationCode
+	}
+	return ""
+}
+
+type DeleteMetadataSchemaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMetadataSchemaResponse) Reset() {
+	*x = DeleteMetadataSchemaResponse{}
+	mi := &file_darta_v1_metadata_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMetadataSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMetadataSchemaResponse) ProtoMessage() {}
+
+func (x *DeleteMetadataSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_metadata_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMetadataSchemaResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMetadataSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_metadata_proto_rawDescGZIP(), []int{6}
+}
+
+var File_darta_v1_metadata_proto protoreflect.FileDescriptor
+
+const file_darta_v1_metadata_proto_rawDesc = "" +
+	"\n" +
+	"\x17darta/v1/metadata.proto\x12\bdarta.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcc\x01\n" +
+	"\x0eMetadataSchema\x12/\n" +
+	"\x13classification_code\x18\x01 \x01(\tR\x12classificationCode\x12/\n" +
+	"\x06schema\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x06schema\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x03 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x1c\n" +
+	"\x1aListMetadataSchemasRequest\"Q\n" +
+	"\x1bListMetadataSchemasResponse\x122\n" +
+	"\aschemas\x18\x01 \x03(\v2\x18.darta.v1.MetadataSchemaR\aschemas\"|\n" +
+	"\x18SetMetadataSchemaRequest\x12/\n" +
+	"\x13classification_code\x18\x01 \x01(\tR\x12classificationCode\x12/\n" +
+	"\x06schema\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x06schema\"M\n" +
+	"\x19SetMetadataSchemaResponse\x120\n" +
+	"\x06schema\x18\x01 \x01(\v2\x18.darta.v1.MetadataSchemaR\x06schema\"N\n" +
+	"\x1bDeleteMetadataSchemaRequest\x12/\n" +
+	"\x13classification_code\x18\x01 \x01(\tR\x12classificationCode\"\x1e\n" +
+	"\x1cDeleteMetadataSchemaResponse2\xc0\x02\n" +
+	"\x15MetadataSchemaService\x12b\n" +
+	"\x13ListMetadataSchemas\x12$.darta.v1.ListMetadataSchemasRequest\x1a%.darta.v1.ListMetadataSchemasResponse\x12\\\n" +
+	"\x11SetMetadataSchema\x12\".darta.v1.SetMetadataSchemaRequest\x1a#.darta.v1.SetMetadataSchemaResponse\x12e\n" +
+	"\x14DeleteMetadataSchema\x12%.darta.v1.DeleteMetadataSchemaRequest\x1a&.darta.v1.DeleteMetadataSchemaResponseB9Z7git.ninjainfosys.com/ePalika/proto/gen/darta/v1;dartav1b\x06proto3"
+
+var (
+	file_darta_v1_metadata_proto_rawDescOnce sync.Once
+	file_darta_v1_metadata_proto_rawDescData []byte
+)
+
+func file_darta_v1_metadata_proto_rawDescGZIP() []byte {
+	file_darta_v1_metadata_proto_rawDescOnce.Do(func() {
+		file_darta_v1_metadata_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_darta_v1_metadata_proto_rawDesc), len(file_darta_v1_metadata_proto_rawDesc)))
+	})
+	return file_darta_v1_metadata_proto_rawDescData
+}
+
+var file_darta_v1_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_darta_v1_metadata_proto_goTypes = []any{
+	(*MetadataSchema)(nil),               // 0: darta.v1.MetadataSchema
+	(*ListMetadataSchemasRequest)(nil),   // 1: darta.v1.ListMetadataSchemasRequest
+	(*ListMetadataSchemasResponse)(nil),  // 2: darta.v1.ListMetadataSchemasResponse
+	(*SetMetadataSchemaRequest)(nil),     // 3: darta.v1.SetMetadataSchemaRequest
+	(*SetMetadataSchemaResponse)(nil),    // 4: darta.v1.SetMetadataSchemaResponse
+	(*DeleteMetadataSchemaRequest)(nil),  // 5: darta.v1.DeleteMetadataSchemaRequest
+	(*DeleteMetadataSchemaResponse)(nil), // 6: darta.v1.DeleteMetadataSchemaResponse
+	(*structpb.Struct)(nil),              // 7: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),        // 8: google.protobuf.Timestamp
+}
+var file_darta_v1_metadata_proto_depIdxs = []int32{
+	7, // 0: darta.v1.MetadataSchema.schema:type_name -> google.protobuf.Struct
+	8, // 1: darta.v1.MetadataSchema.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 2: darta.v1.ListMetadataSchemasResponse.schemas:type_name -> darta.v1.MetadataSchema
+	7, // 3: darta.v1.SetMetadataSchemaRequest.schema:type_name -> google.protobuf.Struct
+	0, // 4: darta.v1.SetMetadataSchemaResponse.schema:type_name -> darta.v1.MetadataSchema
+	1, // 5: darta.v1.MetadataSchemaService.ListMetadataSchemas:input_type -> darta.v1.ListMetadataSchemasRequest
+	3, // 6: darta.v1.MetadataSchemaService.SetMetadataSchema:input_type -> darta.v1.SetMetadataSchemaRequest
+	5, // 7: darta.v1.MetadataSchemaService.DeleteMetadataSchema:input_type -> darta.v1.DeleteMetadataSchemaRequest
+	2, // 8: darta.v1.MetadataSchemaService.ListMetadataSchemas:output_type -> darta.v1.ListMetadataSchemasResponse
+	4, // 9: darta.v1.MetadataSchemaService.SetMetadataSchema:output_type -> darta.v1.SetMetadataSchemaResponse
+	6, // 10: darta.v1.MetadataSchemaService.DeleteMetadataSchema:output_type -> darta.v1.DeleteMetadataSchemaResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_darta_v1_metadata_proto_init() }
+func file_darta_v1_metadata_proto_init() {
+	if File_darta_v1_metadata_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_darta_v1_metadata_proto_rawDesc), len(file_darta_v1_metadata_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_darta_v1_metadata_proto_goTypes,
+		DependencyIndexes: file_darta_v1_metadata_proto_depIdxs,
+		MessageInfos:      file_darta_v1_metadata_proto_msgTypes,
+	}.Build()
+	File_darta_v1_metadata_proto = out.File
+	file_darta_v1_metadata_proto_goTypes = nil
+	file_darta_v1_metadata_proto_depIdxs = nil
+}
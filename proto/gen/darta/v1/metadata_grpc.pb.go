@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
+// source: darta/v1/metadata.proto
+
+package dartav1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MetadataSchemaService_ListMetadataSchemas_FullMethodName  = "/darta.v1.MetadataSchemaService/ListMetadataSchemas"
+	MetadataSchemaService_SetMetadataSchema_FullMethodName    = "/darta.v1.MetadataSchemaService/SetMetadataSchema"
+	MetadataSchemaService_DeleteMetadataSchema_FullMethodName = "/darta.v1.MetadataSchemaService/DeleteMetadataSchema"
+)
+
+// MetadataSchemaServiceClient is the client API for MetadataSchemaService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MetadataSchemaService configures the schemas darta metadata is validated
+// against. Changes require the admin role.
+type MetadataSchemaServiceClient interface {
+	ListMetadataSchemas(ctx context.Context, in *ListMetadataSchemasRequest, opts ...grpc.CallOption) (*ListMetadataSchemasResponse, error)
+	SetMetadataSchema(ctx context.Context, in *SetMetadataSchemaRequest, opts ...grpc.CallOption) (*SetMetadataSchemaResponse, error)
+	DeleteMetadataSchema(ctx context.Context, in *DeleteMetadataSchemaRequest, opts ...grpc.CallOption) (*DeleteMetadataSchemaResponse, error)
+}
+
+type metadataSchemaServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMetadataSchemaServiceClient(cc grpc.ClientConnInterface) MetadataSchemaServiceClient {
+	return &metadataSchemaServiceClient{cc}
+}
+
+func (c *metadataSchemaServiceClient) ListMetadataSchemas(ctx context.Context, in *ListMetadataSchemasRequest, opts ...grpc.CallOption) (*ListMetadataSchemasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMetadataSchemasResponse)
+	err := c.cc.Invoke(ctx, MetadataSchemaService_ListMetadataSchemas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataSchemaServiceClient) SetMetadataSchema(ctx context.Context, in *SetMetadataSchemaRequest, opts ...grpc.CallOption) (*SetMetadataSchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMetadataSchemaResponse)
+	err := c.cc.Invoke(ctx, MetadataSchemaService_SetMetadataSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataSchemaServiceClient) DeleteMetadataSchema(ctx context.Context, in *DeleteMetadataSchemaRequest, opts ...grpc.CallOption) (*DeleteMetadataSchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMetadataSchemaResponse)
+	err := c.cc.Invoke(ctx, MetadataSchemaService_DeleteMetadataSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MetadataSchemaServiceServer is the server API for MetadataSchemaService service.
+// All implementations must embed UnimplementedMetadataSchemaServiceServer
+// for forward compatibility.
+//
+// MetadataSchemaService configures the schemas darta metadata is validated
+// against. Changes require the admin role.
+type MetadataSchemaServiceServer interface {
+	ListMetadataSchemas(context.Context, *ListMetadataSchemasRequest) (*ListMetadataSchemasResponse, error)
+	SetMetadataSchema(context.Context, *SetMetadataSchemaRequest) (*SetMetadataSchemaResponse, error)
+	DeleteMetadataSchema(context.Context, *DeleteMetadataSchemaRequest) (*DeleteMetadataSchemaResponse, error)
+	mustEmbedUnimplementedMetadataSchemaServiceServer()
+}
+
+// UnimplementedMetadataSchemaServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMetadataSchemaServiceServer struct{}
+
+func (UnimplementedMetadataSchemaServiceServer) ListMetadataSchemas(context.Context, *ListMetadataSchemasRequest) (*ListMetadataSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetadataSchemas not implemented")
+}
+func (UnimplementedMetadataSchemaServiceServer) SetMetadataSchema(context.Context, *SetMetadataSchemaRequest) (*SetMetadataSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMetadataSchema not implemented")
+}
+func (UnimplementedMetadataSchemaServiceServer) DeleteMetadataSchema(context.Context, *DeleteMetadataSchemaRequest) (*DeleteMetadataSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMetadataSchema not implemented")
+}
+func (UnimplementedMetadataSchemaServiceServer) mustEmbedUnimplementedMetadataSchemaServiceServer() {}
+func (UnimplementedMetadataSchemaServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeMetadataSchemaServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MetadataSchemaServiceServer will
+// result in compilation errors.
+type UnsafeMetadataSchemaServiceServer interface {
+	mustEmbedUnimplementedMetadataSchemaServiceServer()
+}
+
+func RegisterMetadataSchemaServiceServer(s grpc.ServiceRegistrar, srv MetadataSchemaServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMetadataSchemaServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MetadataSchemaService_ServiceDesc, srv)
+}
+
+func _MetadataSchemaService_ListMetadataSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetadataSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataSchemaServiceServer).ListMetadataSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataSchemaService_ListMetadataSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataSchemaServiceServer).ListMetadataSchemas(ctx, req.(*ListMetadataSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataSchemaService_SetMetadataSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMetadataSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataSchemaServiceServer).SetMetadataSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataSchemaService_SetMetadataSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataSchemaServiceServer).SetMetadataSchema(ctx, req.(*SetMetadataSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataSchemaService_DeleteMetadataSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMetadataSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataSchemaServiceServer).DeleteMetadataSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataSchemaService_DeleteMetadataSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataSchemaServiceServer).DeleteMetadataSchema(ctx, req.(*DeleteMetadataSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MetadataSchemaService_ServiceDesc is the grpc.ServiceDesc for MetadataSchemaService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MetadataSchemaService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "darta.v1.MetadataSchemaService",
+	HandlerType: (*MetadataSchemaServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMetadataSchemas",
+			Handler:    _MetadataSchemaService_ListMetadataSchemas_Handler,
+		},
+		{
+			MethodName: "SetMetadataSchema",
+			Handler:    _MetadataSchemaService_SetMetadataSchema_Handler,
+		},
+		{
+			MethodName: "DeleteMetadataSchema",
+			Handler:    _MetadataSchemaService_DeleteMetadataSchema_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "darta/v1/metadata.proto",
+}
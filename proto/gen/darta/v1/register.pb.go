@@ -0,0 +1,486 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
+// source: darta/v1/register.proto
+
+package dartav1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RegisterBook is a statutory register: the darta kitab of incoming and the
+// chalani kitab of outgoing correspondence
+type RegisterBook int32
+
+const (
+	RegisterBook_REGISTER_BOOK_UNSPECIFIED RegisterBook = 0
+	RegisterBook_REGISTER_BOOK_DARTA       RegisterBook = 1
+	RegisterBook_REGISTER_BOOK_CHALANI     RegisterBook = 2
+)
+
+// Enum value maps for RegisterBook.
+var (
+	RegisterBook_name = map[int32]string{
+		0: "REGISTER_BOOK_UNSPECIFIED",
+		1: "REGISTER_BOOK_DARTA",
+		2: "REGISTER_BOOK_CHALANI",
+	}
+	RegisterBook_value = map[string]int32{
+		"REGISTER_BOOK_UNSPECIFIED": 0,
+		"REGISTER_BOOK_DARTA":       1,
+		"REGISTER_BOOK_CHALANI":     2,
+	}
+)
+
+func (x RegisterBook) Enum() *RegisterBook {
+	p := new(RegisterBook)
+	*p = x
+	return p
+}
+
+func (x RegisterBook) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RegisterBook) Descriptor() protoreflect.EnumDescriptor {
+	return file_darta_v1_register_proto_enumTypes[0].Descriptor()
+}
+
+func (RegisterBook) Type() protoreflect.EnumType {
+	return &file_darta_v1_register_proto_enumTypes[0]
+}
+
+func (x RegisterBook) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RegisterBook.Descriptor instead.
+func (RegisterBook) EnumDescriptor() ([]byte, []int) {
+	return file_darta_v1_register_proto_rawDescGZIP(), []int{0}
+}
+
+type RegisterFormat int32
+
+const (
+	RegisterFormat_REGISTER_FORMAT_UNSPECIFIED RegisterFormat = 0
+	RegisterFormat_REGISTER_FORMAT_CSV         RegisterFormat = 1
+	RegisterFormat_REGISTER_FORMAT_XLSX        RegisterFormat = 2
+	RegisterFormat_REGISTER_FORMAT_PDF         RegisterFormat = 3
+)
+
+// Enum value maps for RegisterFormat.
+var (
+	RegisterFormat_name = map[int32]string{
+		0: "REGISTER_FORMAT_UNSPECIFIED",
+		1: "REGISTER_FORMAT_CSV",
+		2: "REGISTER_FORMAT_XLSX",
+		3: "REGISTER_FORMAT_PDF",
+	}
+	RegisterFormat_value = map[string]int32{
+		"REGISTER_FORMAT_UNSPECIFIED": 0,
+		"REGISTER_FORMAT_CSV":         1,
+		"REGISTER_FORMAT_XLSX":        2,
+		"REGISTER_FORMAT_PDF":         3,
+	}
+)
+
+func (x RegisterFormat) Enum() *RegisterFormat {
+	p := new(RegisterFormat)
+	*p = x
+	return p
+}
+
+func (x RegisterFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RegisterFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_darta_v1_register_proto_enumTypes[1].Descriptor()
+}
+
+func (RegisterFormat) Type() protoreflect.EnumType {
+	return &file_darta_v1_register_proto_enumTypes[1]
+}
+
+func (x RegisterFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RegisterFormat.Descriptor instead.
+func (RegisterFormat) EnumDescriptor() ([]byte, []int) {
+	return file_darta_v1_register_proto_rawDescGZIP(), []int{1}
+}
+
+// RegisterSeal makes a printed register tamper evident. sha256 is the
+// digest of the register's canonical form, which includes generated_at and
+// generated_by; signature is an Ed25519 signature of that digest.
+type RegisterSeal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sha256        string                 `protobuf:"bytes,1,opt,name=sha256,proto3" json:"sha256,omitempty"`       // Hex
+	Signature     string                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"` // Base64
+	KeyId         string                 `protobuf:"bytes,3,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // Base64 Ed25519 public key
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	GeneratedBy   string                 `protobuf:"bytes,6,opt,name=generated_by,json=generatedBy,proto3" json:"generated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterSeal) Reset() {
+	*x = RegisterSeal{}
+	mi := &file_darta_v1_register_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterSeal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterSeal) ProtoMessage() {}
+
+func (x *RegisterSeal) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_register_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterSeal.ProtoReflect.Descriptor instead.
+func (*RegisterSeal) Descriptor() ([]byte, []int) {
+	return file_darta_v1_register_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RegisterSeal) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *RegisterSeal) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *RegisterSeal) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *RegisterSeal) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *RegisterSeal) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+func (x *RegisterSeal) GetGeneratedBy() string {
+	if x != nil {
+		return x.GeneratedBy
+	}
+	return ""
+}
+
+type ExportRegisterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Book          RegisterBook           `protobuf:"varint,1,opt,name=book,proto3,enum=darta.v1.RegisterBook" json:"book,omitempty"`
+	FiscalYearId  string                 `protobuf:"bytes,2,opt,name=fiscal_year_id,json=fiscalYearId,proto3" json:"fiscal_year_id,omitempty"`
+	Scope         Scope                  `protobuf:"varint,3,opt,name=scope,proto3,enum=darta.v1.Scope" json:"scope,omitempty"`
+	WardId        string                 `protobuf:"bytes,4,opt,name=ward_id,json=wardId,proto3" json:"ward_id,omitempty"` // Required for the ward scope
+	Format        RegisterFormat         `protobuf:"varint,5,opt,name=format,proto3,enum=darta.v1.RegisterFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRegisterRequest) Reset() {
+	*x = ExportRegisterRequest{}
+	mi := &file_darta_v1_register_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRegisterRequest) ProtoMessage() {}
+
+func (x *ExportRegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_register_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRegisterRequest.ProtoReflect.Descriptor instead.
+func (*ExportRegisterRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_register_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportRegisterRequest) GetBook() RegisterBook {
+	if x != nil {
+		return x.Book
+	}
+	return RegisterBook_REGISTER_BOOK_UNSPECIFIED
+}
+
+func (x *ExportRegisterRequest) GetFiscalYearId() string {
+	if x != nil {
+		return x.FiscalYearId
+	}
+	return ""
+}
+
+func (x *ExportRegisterRequest) GetScope() Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return Scope_SCOPE_UNSPECIFIED
+}
+
+func (x *ExportRegisterRequest) GetWardId() string {
+	if x != nil {
+		return x.WardId
+	}
+	return ""
+}
+
+func (x *ExportRegisterRequest) GetFormat() RegisterFormat {
+	if x != nil {
+		return x.Format
+	}
+	return RegisterFormat_REGISTER_FORMAT_UNSPECIFIED
+}
+
+type ExportRegisterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Seal          *RegisterSeal          `protobuf:"bytes,4,opt,name=seal,proto3" json:"seal,omitempty"`
+	EntryCount    int32                  `protobuf:"varint,5,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"` // Numbers from 1 to the last one issued, gaps included
+	GapCount      int32                  `protobuf:"varint,6,opt,name=gap_count,json=gapCount,proto3" json:"gap_count,omitempty"`       // Numbers never issued to a record
+	VoidCount     int32                  `protobuf:"varint,7,opt,name=void_count,json=voidCount,proto3" json:"void_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRegisterResponse) Reset() {
+	*x = ExportRegisterResponse{}
+	mi := &file_darta_v1_register_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRegisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRegisterResponse) ProtoMessage() {}
+
+func (x *ExportRegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_register_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRegisterResponse.ProtoReflect.Descriptor instead.
+func (*ExportRegisterResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_register_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportRegisterResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportRegisterResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportRegisterResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportRegisterResponse) GetSeal() *RegisterSeal {
+	if x != nil {
+		return x.Seal
+	}
+	return nil
+}
+
+func (x *ExportRegisterResponse) GetEntryCount() int32 {
+	if x != nil {
+		return x.EntryCount
+	}
+	return 0
+}
+
+func (x *ExportRegisterResponse) GetGapCount() int32 {
+	if x != nil {
+		return x.GapCount
+	}
+	return 0
+}
+
+func (x *ExportRegisterResponse) GetVoidCount() int32 {
+	if x != nil {
+		return x.VoidCount
+	}
+	return 0
+}
+
+var File_darta_v1_register_proto protoreflect.FileDescriptor
+
+const file_darta_v1_register_proto_rawDesc = "" +
+	"\n" +
+	"\x17darta/v1/register.proto\x12\bdarta.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15darta/v1/common.proto\"\xdc\x01\n" +
+	"\fRegisterSeal\x12\x16\n" +
+	"\x06sha256\x18\x01 \x01(\tR\x06sha256\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\tR\tsignature\x12\x15\n" +
+	"\x06key_id\x18\x03 \x01(\tR\x05keyId\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x04 \x01(\tR\tpublicKey\x12=\n" +
+	"\fgenerated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12!\n" +
+	"\fgenerated_by\x18\x06 \x01(\tR\vgeneratedBy\"\xdb\x01\n" +
+	"\x15ExportRegisterRequest\x12*\n" +
+	"\x04book\x18\x01 \x01(\x0e2\x16.darta.v1.RegisterBookR\x04book\x12$\n" +
+	"\x0efiscal_year_id\x18\x02 \x01(\tR\ffiscalYearId\x12%\n" +
+	"\x05scope\x18\x03 \x01(\x0e2\x0f.darta.v1.ScopeR\x05scope\x12\x17\n" +
+	"\award_id\x18\x04 \x01(\tR\x06wardId\x120\n" +
+	"\x06format\x18\x05 \x01(\x0e2\x18.darta.v1.RegisterFormatR\x06format\"\xfa\x01\n" +
+	"\x16ExportRegisterResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\x12*\n" +
+	"\x04seal\x18\x04 \x01(\v2\x16.darta.v1.RegisterSealR\x04seal\x12\x1f\n" +
+	"\ventry_count\x18\x05 \x01(\x05R\n" +
+	"entryCount\x12\x1b\n" +
+	"\tgap_count\x18\x06 \x01(\x05R\bgapCount\x12\x1d\n" +
+	"\n" +
+	"void_count\x18\a \x01(\x05R\tvoidCount*a\n" +
+	"\fRegisterBook\x12\x1d\n" +
+	"\x19REGISTER_BOOK_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13REGISTER_BOOK_DARTA\x10\x01\x12\x19\n" +
+	"\x15REGISTER_BOOK_CHALANI\x10\x02*}\n" +
+	"\x0eRegisterFormat\x12\x1f\n" +
+	"\x1bREGISTER_FORMAT_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13REGISTER_FORMAT_CSV\x10\x01\x12\x18\n" +
+	"\x14REGISTER_FORMAT_XLSX\x10\x02\x12\x17\n" +
+	"\x13REGISTER_FORMAT_PDF\x10\x032f\n" +
+	"\x0fRegisterService\x12S\n" +
+	"\x0eExportRegister\x12\x1f.darta.v1.ExportRegisterRequest\x1a .darta.v1.ExportRegisterResponseB9Z7git.ninjainfosys.com/ePalika/proto/gen/darta/v1;dartav1b\x06proto3"
+
+var (
+	file_darta_v1_register_proto_rawDescOnce sync.Once
+	file_darta_v1_register_proto_rawDescData []byte
+)
+
+func file_darta_v1_register_proto_rawDescGZIP() []byte {
+	file_darta_v1_register_proto_rawDescOnce.Do(func() {
+		file_darta_v1_register_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_darta_v1_register_proto_rawDesc), len(file_darta_v1_register_proto_rawDesc)))
+	})
+	return file_darta_v1_register_proto_rawDescData
+}
+
+var file_darta_v1_register_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_darta_v1_register_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_darta_v1_register_proto_goTypes = []any{
+	(RegisterBook)(0),              // 0: darta.v1.RegisterBook
+	(RegisterFormat)(0),            // 1: darta.v1.RegisterFormat
+	(*RegisterSeal)(nil),           // 2: darta.v1.RegisterSeal
+	(*ExportRegisterRequest)(nil),  // 3: darta.v1.ExportRegisterRequest
+	(*ExportRegisterResponse)(nil), // 4: darta.v1.ExportRegisterResponse
+	(*timestamppb.Timestamp)(nil),  // 5: google.protobuf.Timestamp
+	(Scope)(0),                     // 6: darta.v1.Scope
+}
+var file_darta_v1_register_proto_depIdxs = []int32{
+	5, // 0: darta.v1.RegisterSeal.generated_at:type_name -> google.protobuf.Timestamp
+	0, // 1: darta.v1.ExportRegisterRequest.book:type_name -> darta.v1.RegisterBook
+	6, // 2: darta.v1.ExportRegisterRequest.scope:type_name -> darta.v1.Scope
+	1, // 3: darta.v1.ExportRegisterRequest.format:type_name -> darta.v1.RegisterFormat
+	2, // 4: darta.v1.ExportRegisterResponse.seal:type_name -> darta.v1.RegisterSeal
+	3, // 5: darta.v1.RegisterService.ExportRegister:input_type -> darta.v1.ExportRegisterRequest
+	4, // 6: darta.v1.RegisterService.ExportRegister:output_type -> darta.v1.ExportRegisterResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_darta_v1_register_proto_init() }
+func file_darta_v1_register_proto_init() {
+	if File_darta_v1_register_proto != nil {
+		return
+	}
+	file_darta_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_darta_v1_register_proto_rawDesc), len(file_darta_v1_register_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_darta_v1_register_proto_goTypes,
+		DependencyIndexes: file_darta_v1_register_proto_depIdxs,
+		EnumInfos:         file_darta_v1_register_proto_enumTypes,
+		MessageInfos:      file_darta_v1_register_proto_msgTypes,
+	}.Build()
+	File_darta_v1_register_proto = out.File
+	file_darta_v1_register_proto_goTypes = nil
+	file_darta_v1_register_proto_depIdxs = nil
+}
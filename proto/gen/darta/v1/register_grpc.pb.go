@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
+// source: darta/v1/register.proto
+
+package dartav1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RegisterService_ExportRegister_FullMethodName = "/darta.v1.RegisterService/ExportRegister"
+)
+
+// RegisterServiceClient is the client API for RegisterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RegisterService produces the statutory registers of a fiscal year in
+// number order
+type RegisterServiceClient interface {
+	ExportRegister(ctx context.Context, in *ExportRegisterRequest, opts ...grpc.CallOption) (*ExportRegisterResponse, error)
+}
+
+type registerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRegisterServiceClient(cc grpc.ClientConnInterface) RegisterServiceClient {
+	return &registerServiceClient{cc}
+}
+
+func (c *registerServiceClient) ExportRegister(ctx context.Context, in *ExportRegisterRequest, opts ...grpc.CallOption) (*ExportRegisterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportRegisterResponse)
+	err := c.cc.Invoke(ctx, RegisterService_ExportRegister_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RegisterServiceServer is the server API for RegisterService service.
+// All implementations must embed UnimplementedRegisterServiceServer
+// for forward compatibility.
+//
+// RegisterService produces the statutory registers of a fiscal year in
+// number order
+type RegisterServiceServer interface {
+	ExportRegister(context.Context, *ExportRegisterRequest) (*ExportRegisterResponse, error)
+	mustEmbedUnimplementedRegisterServiceServer()
+}
+
+// UnimplementedRegisterServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRegisterServiceServer struct{}
+
+func (UnimplementedRegisterServiceServer) ExportRegister(context.Context, *ExportRegisterRequest) (*ExportRegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportRegister not implemented")
+}
+func (UnimplementedRegisterServiceServer) mustEmbedUnimplementedRegisterServiceServer() {}
+func (UnimplementedRegisterServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeRegisterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RegisterServiceServer will
+// result in compilation errors.
+type UnsafeRegisterServiceServer interface {
+	mustEmbedUnimplementedRegisterServiceServer()
+}
+
+func RegisterRegisterServiceServer(s grpc.ServiceRegistrar, srv RegisterServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRegisterServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RegisterService_ServiceDesc, srv)
+}
+
+func _RegisterService_ExportRegister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegisterServiceServer).ExportRegister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegisterService_ExportRegister_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegisterServiceServer).ExportRegister(ctx, req.(*ExportRegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RegisterService_ServiceDesc is the grpc.ServiceDesc for RegisterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RegisterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "darta.v1.RegisterService",
+	HandlerType: (*RegisterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportRegister",
+			Handler:    _RegisterService_ExportRegister_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "darta/v1/register.proto",
+}
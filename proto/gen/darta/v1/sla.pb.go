@@ -0,0 +1,1564 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
+// source: darta/v1/sla.proto
+
+package dartav1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BusinessCalendar is a tenant's working week and office hours, in Nepal
+// time. SLA targets count only business minutes.
+type BusinessCalendar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WeeklyOff     []int32                `protobuf:"varint,1,rep,packed,name=weekly_off,json=weeklyOff,proto3" json:"weekly_off,omitempty"` // Days off, 0 = Sunday ... 6 = Saturday
+	OfficeStart   string                 `protobuf:"bytes,2,opt,name=office_start,json=officeStart,proto3" json:"office_start,omitempty"`   // "HH:MM"
+	OfficeEnd     string                 `protobuf:"bytes,3,opt,name=office_end,json=officeEnd,proto3" json:"office_end,omitempty"`         // "HH:MM"
+	Holidays      []*Holiday             `protobuf:"bytes,4,rep,name=holidays,proto3" json:"holidays,omitempty"`                            // Holidays in the requested range
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BusinessCalendar) Reset() {
+	*x = BusinessCalendar{}
+	mi := &file_darta_v1_sla_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BusinessCalendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusinessCalendar) ProtoMessage() {}
+
+func (x *BusinessCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusinessCalendar.ProtoReflect.Descriptor instead.
+func (*BusinessCalendar) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BusinessCalendar) GetWeeklyOff() []int32 {
+	if x != nil {
+		return x.WeeklyOff
+	}
+	return nil
+}
+
+func (x *BusinessCalendar) GetOfficeStart() string {
+	if x != nil {
+		return x.OfficeStart
+	}
+	return ""
+}
+
+func (x *BusinessCalendar) GetOfficeEnd() string {
+	if x != nil {
+		return x.OfficeEnd
+	}
+	return ""
+}
+
+func (x *BusinessCalendar) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type Holiday struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                   // AD date, "YYYY-MM-DD"
+	DateBs        string                 `protobuf:"bytes,2,opt,name=date_bs,json=dateBs,proto3" json:"date_bs,omitempty"` // BS date, "YYYY-MM-DD"
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	National      bool                   `protobuf:"varint,4,opt,name=national,proto3" json:"national,omitempty"` // Built-in public holiday fixed to a BS date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	mi := &file_darta_v1_sla_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Holiday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holiday) ProtoMessage() {}
+
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Holiday) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Holiday) GetDateBs() string {
+	if x != nil {
+		return x.DateBs
+	}
+	return ""
+}
+
+func (x *Holiday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Holiday) GetNational() bool {
+	if x != nil {
+		return x.National
+	}
+	return false
+}
+
+// SLAPolicy sets the target of a stage. Unset priority or classification
+// matches any; the most specific policy applies.
+type SLAPolicy struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Stage                string                 `protobuf:"bytes,2,opt,name=stage,proto3" json:"stage,omitempty"` // REVIEW, CLARIFICATION, RESPONSE or CHALANI_APPROVAL
+	Priority             Priority               `protobuf:"varint,3,opt,name=priority,proto3,enum=darta.v1.Priority" json:"priority,omitempty"`
+	ClassificationCode   string                 `protobuf:"bytes,4,opt,name=classification_code,json=classificationCode,proto3" json:"classification_code,omitempty"`
+	TargetMinutes        int32                  `protobuf:"varint,5,opt,name=target_minutes,json=targetMinutes,proto3" json:"target_minutes,omitempty"`                        // Business minutes
+	EscalateAfterMinutes int32                  `protobuf:"varint,6,opt,name=escalate_after_minutes,json=escalateAfterMinutes,proto3" json:"escalate_after_minutes,omitempty"` // Business minutes after a breach; 0 escalates at the breach
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SLAPolicy) Reset() {
+	*x = SLAPolicy{}
+	mi := &file_darta_v1_sla_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAPolicy) ProtoMessage() {}
+
+func (x *SLAPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAPolicy.ProtoReflect.Descriptor instead.
+func (*SLAPolicy) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SLAPolicy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SLAPolicy) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *SLAPolicy) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *SLAPolicy) GetClassificationCode() string {
+	if x != nil {
+		return x.ClassificationCode
+	}
+	return ""
+}
+
+func (x *SLAPolicy) GetTargetMinutes() int32 {
+	if x != nil {
+		return x.TargetMinutes
+	}
+	return 0
+}
+
+func (x *SLAPolicy) GetEscalateAfterMinutes() int32 {
+	if x != nil {
+		return x.EscalateAfterMinutes
+	}
+	return 0
+}
+
+type UnitHead struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnitId        string                 `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"` // "*" is the tenant's fallback
+	HeadUserId    string                 `protobuf:"bytes,2,opt,name=head_user_id,json=headUserId,proto3" json:"head_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnitHead) Reset() {
+	*x = UnitHead{}
+	mi := &file_darta_v1_sla_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnitHead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitHead) ProtoMessage() {}
+
+func (x *UnitHead) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnitHead.ProtoReflect.Descriptor instead.
+func (*UnitHead) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnitHead) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *UnitHead) GetHeadUserId() string {
+	if x != nil {
+		return x.HeadUserId
+	}
+	return ""
+}
+
+// SLAClock tracks one stage of a darta or chalani against its target
+type SLAClock struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntityType     string                 `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"` // DARTA or CHALANI
+	EntityId       string                 `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Stage          string                 `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`
+	PolicyId       string                 `protobuf:"bytes,5,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"` // Empty when the built-in default applied
+	TargetMinutes  int32                  `protobuf:"varint,6,opt,name=target_minutes,json=targetMinutes,proto3" json:"target_minutes,omitempty"`
+	ElapsedMinutes int32                  `protobuf:"varint,7,opt,name=elapsed_minutes,json=elapsedMinutes,proto3" json:"elapsed_minutes,omitempty"` // Business minutes banked before the last pause
+	Paused         bool                   `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+	StartedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	StoppedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=stopped_at,json=stoppedAt,proto3" json:"stopped_at,omitempty"`
+	Deadline       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deadline,proto3" json:"deadline,omitempty"` // Unset while paused
+	BreachedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=breached_at,json=breachedAt,proto3" json:"breached_at,omitempty"`
+	EscalatedAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=escalated_at,json=escalatedAt,proto3" json:"escalated_at,omitempty"`
+	EscalatedToId  string                 `protobuf:"bytes,14,opt,name=escalated_to_id,json=escalatedToId,proto3" json:"escalated_to_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SLAClock) Reset() {
+	*x = SLAClock{}
+	mi := &file_darta_v1_sla_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAClock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAClock) ProtoMessage() {}
+
+func (x *SLAClock) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAClock.ProtoReflect.Descriptor instead.
+func (*SLAClock) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SLAClock) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SLAClock) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *SLAClock) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *SLAClock) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *SLAClock) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *SLAClock) GetTargetMinutes() int32 {
+	if x != nil {
+		return x.TargetMinutes
+	}
+	return 0
+}
+
+func (x *SLAClock) GetElapsedMinutes() int32 {
+	if x != nil {
+		return x.ElapsedMinutes
+	}
+	return 0
+}
+
+func (x *SLAClock) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *SLAClock) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *SLAClock) GetStoppedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StoppedAt
+	}
+	return nil
+}
+
+func (x *SLAClock) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *SLAClock) GetBreachedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BreachedAt
+	}
+	return nil
+}
+
+func (x *SLAClock) GetEscalatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EscalatedAt
+	}
+	return nil
+}
+
+func (x *SLAClock) GetEscalatedToId() string {
+	if x != nil {
+		return x.EscalatedToId
+	}
+	return ""
+}
+
+type GetBusinessCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromDate      string                 `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // AD date; defaults to today
+	ToDate        string                 `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`       // AD date; defaults to a year after from_date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBusinessCalendarRequest) Reset() {
+	*x = GetBusinessCalendarRequest{}
+	mi := &file_darta_v1_sla_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBusinessCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBusinessCalendarRequest) ProtoMessage() {}
+
+func (x *GetBusinessCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBusinessCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetBusinessCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetBusinessCalendarRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetBusinessCalendarRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type GetBusinessCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *BusinessCalendar      `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBusinessCalendarResponse) Reset() {
+	*x = GetBusinessCalendarResponse{}
+	mi := &file_darta_v1_sla_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBusinessCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBusinessCalendarResponse) ProtoMessage() {}
+
+func (x *GetBusinessCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBusinessCalendarResponse.ProtoReflect.Descriptor instead.
+func (*GetBusinessCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetBusinessCalendarResponse) GetCalendar() *BusinessCalendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type UpdateBusinessCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WeeklyOff     []int32                `protobuf:"varint,1,rep,packed,name=weekly_off,json=weeklyOff,proto3" json:"weekly_off,omitempty"`
+	OfficeStart   string                 `protobuf:"bytes,2,opt,name=office_start,json=officeStart,proto3" json:"office_start,omitempty"`
+	OfficeEnd     string                 `protobuf:"bytes,3,opt,name=office_end,json=officeEnd,proto3" json:"office_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBusinessCalendarRequest) Reset() {
+	*x = UpdateBusinessCalendarRequest{}
+	mi := &file_darta_v1_sla_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBusinessCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBusinessCalendarRequest) ProtoMessage() {}
+
+func (x *UpdateBusinessCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBusinessCalendarRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBusinessCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateBusinessCalendarRequest) GetWeeklyOff() []int32 {
+	if x != nil {
+		return x.WeeklyOff
+	}
+	return nil
+}
+
+func (x *UpdateBusinessCalendarRequest) GetOfficeStart() string {
+	if x != nil {
+		return x.OfficeStart
+	}
+	return ""
+}
+
+func (x *UpdateBusinessCalendarRequest) GetOfficeEnd() string {
+	if x != nil {
+		return x.OfficeEnd
+	}
+	return ""
+}
+
+type UpdateBusinessCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *BusinessCalendar      `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBusinessCalendarResponse) Reset() {
+	*x = UpdateBusinessCalendarResponse{}
+	mi := &file_darta_v1_sla_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBusinessCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBusinessCalendarResponse) ProtoMessage() {}
+
+func (x *UpdateBusinessCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBusinessCalendarResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBusinessCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateBusinessCalendarResponse) GetCalendar() *BusinessCalendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type SetHolidayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // AD date, or a BS date in date_bs
+	DateBs        string                 `protobuf:"bytes,2,opt,name=date_bs,json=dateBs,proto3" json:"date_bs,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHolidayRequest) Reset() {
+	*x = SetHolidayRequest{}
+	mi := &file_darta_v1_sla_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHolidayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHolidayRequest) ProtoMessage() {}
+
+func (x *SetHolidayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHolidayRequest.ProtoReflect.Descriptor instead.
+func (*SetHolidayRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetHolidayRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SetHolidayRequest) GetDateBs() string {
+	if x != nil {
+		return x.DateBs
+	}
+	return ""
+}
+
+func (x *SetHolidayRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SetHolidayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holiday       *Holiday               `protobuf:"bytes,1,opt,name=holiday,proto3" json:"holiday,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHolidayResponse) Reset() {
+	*x = SetHolidayResponse{}
+	mi := &file_darta_v1_sla_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHolidayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHolidayResponse) ProtoMessage() {}
+
+func (x *SetHolidayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHolidayResponse.ProtoReflect.Descriptor instead.
+func (*SetHolidayResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetHolidayResponse) GetHoliday() *Holiday {
+	if x != nil {
+		return x.Holiday
+	}
+	return nil
+}
+
+type RemoveHolidayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveHolidayRequest) Reset() {
+	*x = RemoveHolidayRequest{}
+	mi := &file_darta_v1_sla_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveHolidayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveHolidayRequest) ProtoMessage() {}
+
+func (x *RemoveHolidayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveHolidayRequest.ProtoReflect.Descriptor instead.
+func (*RemoveHolidayRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RemoveHolidayRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type RemoveHolidayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveHolidayResponse) Reset() {
+	*x = RemoveHolidayResponse{}
+	mi := &file_darta_v1_sla_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveHolidayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveHolidayResponse) ProtoMessage() {}
+
+func (x *RemoveHolidayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveHolidayResponse.ProtoReflect.Descriptor instead.
+func (*RemoveHolidayResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{12}
+}
+
+type ListSLAPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSLAPoliciesRequest) Reset() {
+	*x = ListSLAPoliciesRequest{}
+	mi := &file_darta_v1_sla_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSLAPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSLAPoliciesRequest) ProtoMessage() {}
+
+func (x *ListSLAPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSLAPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListSLAPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{13}
+}
+
+type ListSLAPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*SLAPolicy           `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSLAPoliciesResponse) Reset() {
+	*x = ListSLAPoliciesResponse{}
+	mi := &file_darta_v1_sla_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSLAPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSLAPoliciesResponse) ProtoMessage() {}
+
+func (x *ListSLAPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSLAPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListSLAPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListSLAPoliciesResponse) GetPolicies() []*SLAPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type SetSLAPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *SLAPolicy             `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"` // id is ignored; stage, priority and classification identify it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSLAPolicyRequest) Reset() {
+	*x = SetSLAPolicyRequest{}
+	mi := &file_darta_v1_sla_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSLAPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSLAPolicyRequest) ProtoMessage() {}
+
+func (x *SetSLAPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSLAPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetSLAPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetSLAPolicyRequest) GetPolicy() *SLAPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetSLAPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *SLAPolicy             `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSLAPolicyResponse) Reset() {
+	*x = SetSLAPolicyResponse{}
+	mi := &file_darta_v1_sla_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSLAPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSLAPolicyResponse) ProtoMessage() {}
+
+func (x *SetSLAPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSLAPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetSLAPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetSLAPolicyResponse) GetPolicy() *SLAPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type DeleteSLAPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSLAPolicyRequest) Reset() {
+	*x = DeleteSLAPolicyRequest{}
+	mi := &file_darta_v1_sla_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSLAPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSLAPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteSLAPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSLAPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSLAPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteSLAPolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSLAPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSLAPolicyResponse) Reset() {
+	*x = DeleteSLAPolicyResponse{}
+	mi := &file_darta_v1_sla_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSLAPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSLAPolicyResponse) ProtoMessage() {}
+
+func (x *DeleteSLAPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSLAPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSLAPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{18}
+}
+
+type ListUnitHeadsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUnitHeadsRequest) Reset() {
+	*x = ListUnitHeadsRequest{}
+	mi := &file_darta_v1_sla_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnitHeadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnitHeadsRequest) ProtoMessage() {}
+
+func (x *ListUnitHeadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnitHeadsRequest.ProtoReflect.Descriptor instead.
+func (*ListUnitHeadsRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{19}
+}
+
+type ListUnitHeadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnitHeads     []*UnitHead            `protobuf:"bytes,1,rep,name=unit_heads,json=unitHeads,proto3" json:"unit_heads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUnitHeadsResponse) Reset() {
+	*x = ListUnitHeadsResponse{}
+	mi := &file_darta_v1_sla_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnitHeadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnitHeadsResponse) ProtoMessage() {}
+
+func (x *ListUnitHeadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnitHeadsResponse.ProtoReflect.Descriptor instead.
+func (*ListUnitHeadsResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListUnitHeadsResponse) GetUnitHeads() []*UnitHead {
+	if x != nil {
+		return x.UnitHeads
+	}
+	return nil
+}
+
+type SetUnitHeadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnitHead      *UnitHead              `protobuf:"bytes,1,opt,name=unit_head,json=unitHead,proto3" json:"unit_head,omitempty"` // Empty head_user_id removes the unit's head
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUnitHeadRequest) Reset() {
+	*x = SetUnitHeadRequest{}
+	mi := &file_darta_v1_sla_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUnitHeadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUnitHeadRequest) ProtoMessage() {}
+
+func (x *SetUnitHeadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUnitHeadRequest.ProtoReflect.Descriptor instead.
+func (*SetUnitHeadRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetUnitHeadRequest) GetUnitHead() *UnitHead {
+	if x != nil {
+		return x.UnitHead
+	}
+	return nil
+}
+
+type SetUnitHeadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnitHead      *UnitHead              `protobuf:"bytes,1,opt,name=unit_head,json=unitHead,proto3" json:"unit_head,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUnitHeadResponse) Reset() {
+	*x = SetUnitHeadResponse{}
+	mi := &file_darta_v1_sla_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUnitHeadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUnitHeadResponse) ProtoMessage() {}
+
+func (x *SetUnitHeadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUnitHeadResponse.ProtoReflect.Descriptor instead.
+func (*SetUnitHeadResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetUnitHeadResponse) GetUnitHead() *UnitHead {
+	if x != nil {
+		return x.UnitHead
+	}
+	return nil
+}
+
+type ListSLAClocksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"` // DARTA or CHALANI
+	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSLAClocksRequest) Reset() {
+	*x = ListSLAClocksRequest{}
+	mi := &file_darta_v1_sla_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSLAClocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSLAClocksRequest) ProtoMessage() {}
+
+func (x *ListSLAClocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSLAClocksRequest.ProtoReflect.Descriptor instead.
+func (*ListSLAClocksRequest) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListSLAClocksRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ListSLAClocksRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+type ListSLAClocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clocks        []*SLAClock            `protobuf:"bytes,1,rep,name=clocks,proto3" json:"clocks,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSLAClocksResponse) Reset() {
+	*x = ListSLAClocksResponse{}
+	mi := &file_darta_v1_sla_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSLAClocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSLAClocksResponse) ProtoMessage() {}
+
+func (x *ListSLAClocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_darta_v1_sla_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSLAClocksResponse.ProtoReflect.Descriptor instead.
+func (*ListSLAClocksResponse) Descriptor() ([]byte, []int) {
+	return file_darta_v1_sla_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListSLAClocksResponse) GetClocks() []*SLAClock {
+	if x != nil {
+		return x.Clocks
+	}
+	return nil
+}
+
+var File_darta_v1_sla_proto protoreflect.FileDescriptor
+
+const file_darta_v1_sla_proto_rawDesc = "" +
+	"\n" +
+	"\x12darta/v1/sla.proto\x12\bdarta.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15darta/v1/common.proto\"\xa2\x01\n" +
+	"\x10BusinessCalendar\x12\x1d\n" +
+	"\n" +
+	"weekly_off\x18\x01 \x03(\x05R\tweeklyOff\x12!\n" +
+	"\foffice_start\x18\x02 \x01(\tR\vofficeStart\x12\x1d\n" +
+	"\n" +
+	"office_end\x18\x03 \x01(\tR\tofficeEnd\x12-\n" +
+	"\bholidays\x18\x04 \x03(\v2\x11.darta.v1.HolidayR\bholidays\"f\n" +
+	"\aHoliday\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x17\n" +
+	"\adate_bs\x18\x02 \x01(\tR\x06dateBs\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bnational\x18\x04 \x01(\bR\bnational\"\xef\x01\n" +
+	"\tSLAPolicy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05stage\x18\x02 \x01(\tR\x05stage\x12.\n" +
+	"\bpriority\x18\x03 \x01(\x0e2\x12.darta.v1.PriorityR\bpriority\x12/\n" +
+	"\x13classification_code\x18\x04 \x01(\tR\x12classificationCode\x12%\n" +
+	"\x0etarget_minutes\x18\x05 \x01(\x05R\rtargetMinutes\x124\n" +
+	"\x16escalate_after_minutes\x18\x06 \x01(\x05R\x14escalateAfterMinutes\"E\n" +
+	"\bUnitHead\x12\x17\n" +
+	"\aunit_id\x18\x01 \x01(\tR\x06unitId\x12 \n" +
+	"\fhead_user_id\x18\x02 \x01(\tR\n" +
+	"headUserId\"\xc5\x04\n" +
+	"\bSLAClock\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\ventity_type\x18\x02 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x03 \x01(\tR\bentityId\x12\x14\n" +
+	"\x05stage\x18\x04 \x01(\tR\x05stage\x12\x1b\n" +
+	"\tpolicy_id\x18\x05 \x01(\tR\bpolicyId\x12%\n" +
+	"\x0etarget_minutes\x18\x06 \x01(\x05R\rtargetMinutes\x12'\n" +
+	"\x0felapsed_minutes\x18\a \x01(\x05R\x0eelapsedMinutes\x12\x16\n" +
+	"\x06paused\x18\b \x01(\bR\x06paused\x129\n" +
+	"\n" +
+	"started_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x129\n" +
+	"\n" +
+	"stopped_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tstoppedAt\x126\n" +
+	"\bdeadline\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x12;\n" +
+	"\vbreached_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"breachedAt\x12=\n" +
+	"\fescalated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vescalatedAt\x12&\n" +
+	"\x0fescalated_to_id\x18\x0e \x01(\tR\rescalatedToId\"R\n" +
+	"\x1aGetBusinessCalendarRequest\x12\x1b\n" +
+	"\tfrom_date\x18\x01 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x02 \x01(\tR\x06toDate\"U\n" +
+	"\x1bGetBusinessCalendarResponse\x126\n" +
+	"\bcalendar\x18\x01 \x01(\v2\x1a.darta.v1.BusinessCalendarR\bcalendar\"\x80\x01\n" +
+	"\x1dUpdateBusinessCalendarRequest\x12\x1d\n" +
+	"\n" +
+	"weekly_off\x18\x01 \x03(\x05R\tweeklyOff\x12!\n" +
+	"\foffice_start\x18\x02 \x01(\tR\vofficeStart\x12\x1d\n" +
+	"\n" +
+	"office_end\x18\x03 \x01(\tR\tofficeEnd\"X\n" +
+	"\x1eUpdateBusinessCalendarResponse\x126\n" +
+	"\bcalendar\x18\x01 \x01(\v2\x1a.darta.v1.BusinessCalendarR\bcalendar\"T\n" +
+	"\x11SetHolidayRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x17\n" +
+	"\adate_bs\x18\x02 \x01(\tR\x06dateBs\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"A\n" +
+	"\x12SetHolidayResponse\x12+\n" +
+	"\aholiday\x18\x01 \x01(\v2\x11.darta.v1.HolidayR\aholiday\"*\n" +
+	"\x14RemoveHolidayRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\"\x17\n" +
+	"\x15RemoveHolidayResponse\"\x18\n" +
+	"\x16ListSLAPoliciesRequest\"J\n" +
+	"\x17ListSLAPoliciesResponse\x12/\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x13.darta.v1.SLAPolicyR\bpolicies\"B\n" +
+	"\x13SetSLAPolicyRequest\x12+\n" +
+	"\x06policy\x18\x01 \x01(\v2\x13.darta.v1.SLAPolicyR\x06policy\"C\n" +
+	"\x14SetSLAPolicyResponse\x12+\n" +
+	"\x06policy\x18\x01 \x01(\v2\x13.darta.v1.SLAPolicyR\x06policy\"(\n" +
+	"\x16DeleteSLAPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x19\n" +
+	"\x17DeleteSLAPolicyResponse\"\x16\n" +
+	"\x14ListUnitHeadsRequest\"J\n" +
+	"\x15ListUnitHeadsResponse\x121\n" +
+	"\n" +
+	"unit_heads\x18\x01 \x03(\v2\x12.darta.v1.UnitHeadR\tunitHeads\"E\n" +
+	"\x12SetUnitHeadRequest\x12/\n" +
+	"\tunit_head\x18\x01 \x01(\v2\x12.darta.v1.UnitHeadR\bunitHead\"F\n" +
+	"\x13SetUnitHeadResponse\x12/\n" +
+	"\tunit_head\x18\x01 \x01(\v2\x12.darta.v1.UnitHeadR\bunitHead\"T\n" +
+	"\x14ListSLAClocksRequest\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\"C\n" +
+	"\x15ListSLAClocksResponse\x12*\n" +
+	"\x06clocks\x18\x01 \x03(\v2\x12.darta.v1.SLAClockR\x06clocks2\xe7\x06\n" +
+	"\n" +
+	"SLAService\x12b\n" +
+	"\x13GetBusinessCalendar\x12$.darta.v1.GetBusinessCalendarRequest\x1a%.darta.v1.GetBusinessCalendarResponse\x12k\n" +
+	"\x16UpdateBusinessCalendar\x12'.darta.v1.UpdateBusinessCalendarRequest\x1a(.darta.v1.UpdateBusinessCalendarResponse\x12G\n" +
+	"\n" +
+	"SetHoliday\x12\x1b.darta.v1.SetHolidayRequest\x1a\x1c.darta.v1.SetHolidayResponse\x12P\n" +
+	"\rRemoveHoliday\x12\x1e.darta.v1.RemoveHolidayRequest\x1a\x1f.darta.v1.RemoveHolidayResponse\x12V\n" +
+	"\x0fListSLAPolicies\x12 .darta.v1.ListSLAPoliciesRequest\x1a!.darta.v1.ListSLAPoliciesResponse\x12M\n" +
+	"\fSetSLAPolicy\x12\x1d.darta.v1.SetSLAPolicyRequest\x1a\x1e.darta.v1.SetSLAPolicyResponse\x12V\n" +
+	"\x0fDeleteSLAPolicy\x12 .darta.v1.DeleteSLAPolicyRequest\x1a!.darta.v1.DeleteSLAPolicyResponse\x12P\n" +
+	"\rListUnitHeads\x12\x1e.darta.v1.ListUnitHeadsRequest\x1a\x1f.darta.v1.ListUnitHeadsResponse\x12J\n" +
+	"\vSetUnitHead\x12\x1c.darta.v1.SetUnitHeadRequest\x1a\x1d.darta.v1.SetUnitHeadResponse\x12P\n" +
+	"\rListSLAClocks\x12\x1e.darta.v1.ListSLAClocksRequest\x1a\x1f.darta.v1.ListSLAClocksResponseB9Z7git.ninjainfosys.com/ePalika/proto/gen/darta/v1;dartav1b\x06proto3"
+
+var (
+	file_darta_v1_sla_proto_rawDescOnce sync.Once
+	file_darta_v1_sla_proto_rawDescData []byte
+)
+
+func file_darta_v1_sla_proto_rawDescGZIP() []byte {
+	file_darta_v1_sla_proto_rawDescOnce.Do(func() {
+		file_darta_v1_sla_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_darta_v1_sla_proto_rawDesc), len(file_darta_v1_sla_proto_rawDesc)))
+	})
+	return file_darta_v1_sla_proto_rawDescData
+}
+
+var file_darta_v1_sla_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_darta_v1_sla_proto_goTypes = []any{
+	(*BusinessCalendar)(nil),               // 0: darta.v1.BusinessCalendar
+	(*Holiday)(nil),                        // 1: darta.v1.Holiday
+	(*SLAPolicy)(nil),                      // 2: darta.v1.SLAPolicy
+	(*UnitHead)(nil),                       // 3: darta.v1.UnitHead
+	(*SLAClock)(nil),                       // 4: darta.v1.SLAClock
+	(*GetBusinessCalendarRequest)(nil),     // 5: darta.v1.GetBusinessCalendarRequest
+	(*GetBusinessCalendarResponse)(nil),    // 6: darta.v1.GetBusinessCalendarResponse
+	(*UpdateBusinessCalendarRequest)(nil),  // 7: darta.v1.UpdateBusinessCalendarRequest
+	(*UpdateBusinessCalendarResponse)(nil), // 8: darta.v1.UpdateBusinessCalendarResponse
+	(*SetHolidayRequest)(nil),              // 9: darta.v1.SetHolidayRequest
+	(*SetHolidayResponse)(nil),             // 10: darta.v1.SetHolidayResponse
+	(*RemoveHolidayRequest)(nil),           // 11: darta.v1.RemoveHolidayRequest
+	(*RemoveHolidayResponse)(nil),          // 12: darta.v1.RemoveHolidayResponse
+	(*ListSLAPoliciesRequest)(nil),         // 13: darta.v1.ListSLAPoliciesRequest
+	(*ListSLAPoliciesResponse)(nil),        // 14: darta.v1.ListSLAPoliciesResponse
+	(*SetSLAPolicyRequest)(nil),            // 15: darta.v1.SetSLAPolicyRequest
+	(*SetSLAPolicyResponse)(nil),           // 16: darta.v1.SetSLAPolicyResponse
+	(*DeleteSLAPolicyRequest)(nil),         // 17: darta.v1.DeleteSLAPolicyRequest
+	(*DeleteSLAPolicyResponse)(nil),        // 18: darta.v1.DeleteSLAPolicyResponse
+	(*ListUnitHeadsRequest)(nil),           // 19: darta.v1.ListUnitHeadsRequest
+	(*ListUnitHeadsResponse)(nil),          // 20: darta.v1.ListUnitHeadsResponse
+	(*SetUnitHeadRequest)(nil),             // 21: darta.v1.SetUnitHeadRequest
+	(*SetUnitHeadResponse)(nil),            // 22: darta.v1.SetUnitHeadResponse
+	(*ListSLAClocksRequest)(nil),           // 23: darta.v1.ListSLAClocksRequest
+	(*ListSLAClocksResponse)(nil),          // 24: darta.v1.ListSLAClocksResponse
+	(Priority)(0),                          // 25: darta.v1.Priority
+	(*timestamppb.Timestamp)(nil),          // 26: google.protobuf.Timestamp
+}
+var file_darta_v1_sla_proto_depIdxs = []int32{
+	1,  // 0: darta.v1.BusinessCalendar.holidays:type_name -> darta.v1.Holiday
+	25, // 1: darta.v1.SLAPolicy.priority:type_name -> darta.v1.Priority
+	26, // 2: darta.v1.SLAClock.started_at:type_name -> google.protobuf.Timestamp
+	26, // 3: darta.v1.SLAClock.stopped_at:type_name -> google.protobuf.Timestamp
+	26, // 4: darta.v1.SLAClock.deadline:type_name -> google.protobuf.Timestamp
+	26, // 5: darta.v1.SLAClock.breached_at:type_name -> google.protobuf.Timestamp
+	26, // 6: darta.v1.SLAClock.escalated_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: darta.v1.GetBusinessCalendarResponse.calendar:type_name -> darta.v1.BusinessCalendar
+	0,  // 8: darta.v1.UpdateBusinessCalendarResponse.calendar:type_name -> darta.v1.BusinessCalendar
+	1,  // 9: darta.v1.SetHolidayResponse.holiday:type_name -> darta.v1.Holiday
+	2,  // 10: darta.v1.ListSLAPoliciesResponse.policies:type_name -> darta.v1.SLAPolicy
+	2,  // 11: darta.v1.SetSLAPolicyRequest.policy:type_name -> darta.v1.SLAPolicy
+	2,  // 12: darta.v1.SetSLAPolicyResponse.policy:type_name -> darta.v1.SLAPolicy
+	3,  // 13: darta.v1.ListUnitHeadsResponse.unit_heads:type_name -> darta.v1.UnitHead
+	3,  // 14: darta.v1.SetUnitHeadRequest.unit_head:type_name -> darta.v1.UnitHead
+	3,  // 15: darta.v1.SetUnitHeadResponse.unit_head:type_name -> darta.v1.UnitHead
+	4,  // 16: darta.v1.ListSLAClocksResponse.clocks:type_name -> darta.v1.SLAClock
+	5,  // 17: darta.v1.SLAService.GetBusinessCalendar:input_type -> darta.v1.GetBusinessCalendarRequest
+	7,  // 18: darta.v1.SLAService.UpdateBusinessCalendar:input_type -> darta.v1.UpdateBusinessCalendarRequest
+	9,  // 19: darta.v1.SLAService.SetHoliday:input_type -> darta.v1.SetHolidayRequest
+	11, // 20: darta.v1.SLAService.RemoveHoliday:input_type -> darta.v1.RemoveHolidayRequest
+	13, // 21: darta.v1.SLAService.ListSLAPolicies:input_type -> darta.v1.ListSLAPoliciesRequest
+	15, // 22: darta.v1.SLAService.SetSLAPolicy:input_type -> darta.v1.SetSLAPolicyRequest
+	17, // 23: darta.v1.SLAService.DeleteSLAPolicy:input_type -> darta.v1.DeleteSLAPolicyRequest
+	19, // 24: darta.v1.SLAService.ListUnitHeads:input_type -> darta.v1.ListUnitHeadsRequest
+	21, // 25: darta.v1.SLAService.SetUnitHead:input_type -> darta.v1.SetUnitHeadRequest
+	23, // 26: darta.v1.SLAService.ListSLAClocks:input_type -> darta.v1.ListSLAClocksRequest
+	6,  // 27: darta.v1.SLAService.GetBusinessCalendar:output_type -> darta.v1.GetBusinessCalendarResponse
+	8,  // 28: darta.v1.SLAService.UpdateBusinessCalendar:output_type -> darta.v1.UpdateBusinessCalendarResponse
+	10, // 29: darta.v1.SLAService.SetHoliday:output_type -> darta.v1.SetHolidayResponse
+	12, // 30: darta.v1.SLAService.RemoveHoliday:output_type -> darta.v1.RemoveHolidayResponse
+	14, // 31: darta.v1.SLAService.ListSLAPolicies:output_type -> darta.v1.ListSLAPoliciesResponse
+	16, // 32: darta.v1.SLAService.SetSLAPolicy:output_type -> darta.v1.SetSLAPolicyResponse
+	18, // 33: darta.v1.SLAService.DeleteSLAPolicy:output_type -> darta.v1.DeleteSLAPolicyResponse
+	20, // 34: darta.v1.SLAService.ListUnitHeads:output_type -> darta.v1.ListUnitHeadsResponse
+	22, // 35: darta.v1.SLAService.SetUnitHead:output_type -> darta.v1.SetUnitHeadResponse
+	24, // 36: darta.v1.SLAService.ListSLAClocks:output_type -> darta.v1.ListSLAClocksResponse
+	27, // [27:37] is the sub-list for method output_type
+	17, // [17:27] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_darta_v1_sla_proto_init() }
+func file_darta_v1_sla_proto_init() {
+	if File_darta_v1_sla_proto != nil {
+		return
+	}
+	file_darta_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_darta_v1_sla_proto_rawDesc), len(file_darta_v1_sla_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_darta_v1_sla_proto_goTypes,
+		DependencyIndexes: file_darta_v1_sla_proto_depIdxs,
+		MessageInfos:      file_darta_v1_sla_proto_msgTypes,
+	}.Build()
+	File_darta_v1_sla_proto = out.File
+	file_darta_v1_sla_proto_goTypes = nil
+	file_darta_v1_sla_proto_depIdxs = nil
+}
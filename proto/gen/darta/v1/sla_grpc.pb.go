@@ -0,0 +1,469 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
+// source: darta/v1/sla.proto
+
+package dartav1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SLAService_GetBusinessCalendar_FullMethodName    = "/darta.v1.SLAService/GetBusinessCalendar"
+	SLAService_UpdateBusinessCalendar_FullMethodName = "/darta.v1.SLAService/UpdateBusinessCalendar"
+	SLAService_SetHoliday_FullMethodName             = "/darta.v1.SLAService/SetHoliday"
+	SLAService_RemoveHoliday_FullMethodName          = "/darta.v1.SLAService/RemoveHoliday"
+	SLAService_ListSLAPolicies_FullMethodName        = "/darta.v1.SLAService/ListSLAPolicies"
+	SLAService_SetSLAPolicy_FullMethodName           = "/darta.v1.SLAService/SetSLAPolicy"
+	SLAService_DeleteSLAPolicy_FullMethodName        = "/darta.v1.SLAService/DeleteSLAPolicy"
+	SLAService_ListUnitHeads_FullMethodName          = "/darta.v1.SLAService/ListUnitHeads"
+	SLAService_SetUnitHead_FullMethodName            = "/darta.v1.SLAService/SetUnitHead"
+	SLAService_ListSLAClocks_FullMethodName          = "/darta.v1.SLAService/ListSLAClocks"
+)
+
+// SLAServiceClient is the client API for SLAService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SLAService configures business calendars, SLA policies and unit heads, and
+// reports the SLA clocks of a record. Changes require the admin role.
+type SLAServiceClient interface {
+	GetBusinessCalendar(ctx context.Context, in *GetBusinessCalendarRequest, opts ...grpc.CallOption) (*GetBusinessCalendarResponse, error)
+	UpdateBusinessCalendar(ctx context.Context, in *UpdateBusinessCalendarRequest, opts ...grpc.CallOption) (*UpdateBusinessCalendarResponse, error)
+	SetHoliday(ctx context.Context, in *SetHolidayRequest, opts ...grpc.CallOption) (*SetHolidayResponse, error)
+	RemoveHoliday(ctx context.Context, in *RemoveHolidayRequest, opts ...grpc.CallOption) (*RemoveHolidayResponse, error)
+	ListSLAPolicies(ctx context.Context, in *ListSLAPoliciesRequest, opts ...grpc.CallOption) (*ListSLAPoliciesResponse, error)
+	SetSLAPolicy(ctx context.Context, in *SetSLAPolicyRequest, opts ...grpc.CallOption) (*SetSLAPolicyResponse, error)
+	DeleteSLAPolicy(ctx context.Context, in *DeleteSLAPolicyRequest, opts ...grpc.CallOption) (*DeleteSLAPolicyResponse, error)
+	ListUnitHeads(ctx context.Context, in *ListUnitHeadsRequest, opts ...grpc.CallOption) (*ListUnitHeadsResponse, error)
+	SetUnitHead(ctx context.Context, in *SetUnitHeadRequest, opts ...grpc.CallOption) (*SetUnitHeadResponse, error)
+	ListSLAClocks(ctx context.Context, in *ListSLAClocksRequest, opts ...grpc.CallOption) (*ListSLAClocksResponse, error)
+}
+
+type sLAServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSLAServiceClient(cc grpc.ClientConnInterface) SLAServiceClient {
+	return &sLAServiceClient{cc}
+}
+
+func (c *sLAServiceClient) GetBusinessCalendar(ctx context.Context, in *GetBusinessCalendarRequest, opts ...grpc.CallOption) (*GetBusinessCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBusinessCalendarResponse)
+	err := c.cc.Invoke(ctx, SLAService_GetBusinessCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) UpdateBusinessCalendar(ctx context.Context, in *UpdateBusinessCalendarRequest, opts ...grpc.CallOption) (*UpdateBusinessCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBusinessCalendarResponse)
+	err := c.cc.Invoke(ctx, SLAService_UpdateBusinessCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) SetHoliday(ctx context.Context, in *SetHolidayRequest, opts ...grpc.CallOption) (*SetHolidayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetHolidayResponse)
+	err := c.cc.Invoke(ctx, SLAService_SetHoliday_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) RemoveHoliday(ctx context.Context, in *RemoveHolidayRequest, opts ...grpc.CallOption) (*RemoveHolidayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveHolidayResponse)
+	err := c.cc.Invoke(ctx, SLAService_RemoveHoliday_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) ListSLAPolicies(ctx context.Context, in *ListSLAPoliciesRequest, opts ...grpc.CallOption) (*ListSLAPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSLAPoliciesResponse)
+	err := c.cc.Invoke(ctx, SLAService_ListSLAPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) SetSLAPolicy(ctx context.Context, in *SetSLAPolicyRequest, opts ...grpc.CallOption) (*SetSLAPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSLAPolicyResponse)
+	err := c.cc.Invoke(ctx, SLAService_SetSLAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) DeleteSLAPolicy(ctx context.Context, in *DeleteSLAPolicyRequest, opts ...grpc.CallOption) (*DeleteSLAPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSLAPolicyResponse)
+	err := c.cc.Invoke(ctx, SLAService_DeleteSLAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) ListUnitHeads(ctx context.Context, in *ListUnitHeadsRequest, opts ...grpc.CallOption) (*ListUnitHeadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUnitHeadsResponse)
+	err := c.cc.Invoke(ctx, SLAService_ListUnitHeads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) SetUnitHead(ctx context.Context, in *SetUnitHeadRequest, opts ...grpc.CallOption) (*SetUnitHeadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUnitHeadResponse)
+	err := c.cc.Invoke(ctx, SLAService_SetUnitHead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) ListSLAClocks(ctx context.Context, in *ListSLAClocksRequest, opts ...grpc.CallOption) (*ListSLAClocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSLAClocksResponse)
+	err := c.cc.Invoke(ctx, SLAService_ListSLAClocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SLAServiceServer is the server API for SLAService service.
+// All implementations must embed UnimplementedSLAServiceServer
+// for forward compatibility.
+//
+// SLAService configures business calendars, SLA policies and unit heads, and
+// reports the SLA clocks of a record. Changes require the admin role.
+type SLAServiceServer interface {
+	GetBusinessCalendar(context.Context, *GetBusinessCalendarRequest) (*GetBusinessCalendarResponse, error)
+	UpdateBusinessCalendar(context.Context, *UpdateBusinessCalendarRequest) (*UpdateBusinessCalendarResponse, error)
+	SetHoliday(context.Context, *SetHolidayRequest) (*SetHolidayResponse, error)
+	RemoveHoliday(context.Context, *RemoveHolidayRequest) (*RemoveHolidayResponse, error)
+	ListSLAPolicies(context.Context, *ListSLAPoliciesRequest) (*ListSLAPoliciesResponse, error)
+	SetSLAPolicy(context.Context, *SetSLAPolicyRequest) (*SetSLAPolicyResponse, error)
+	DeleteSLAPolicy(context.Context, *DeleteSLAPolicyRequest) (*DeleteSLAPolicyResponse, error)
+	ListUnitHeads(context.Context, *ListUnitHeadsRequest) (*ListUnitHeadsResponse, error)
+	SetUnitHead(context.Context, *SetUnitHeadRequest) (*SetUnitHeadResponse, error)
+	ListSLAClocks(context.Context, *ListSLAClocksRequest) (*ListSLAClocksResponse, error)
+	mustEmbedUnimplementedSLAServiceServer()
+}
+
+// UnimplementedSLAServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSLAServiceServer struct{}
+
+func (UnimplementedSLAServiceServer) GetBusinessCalendar(context.Context, *GetBusinessCalendarRequest) (*GetBusinessCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBusinessCalendar not implemented")
+}
+func (UnimplementedSLAServiceServer) UpdateBusinessCalendar(context.Context, *UpdateBusinessCalendarRequest) (*UpdateBusinessCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBusinessCalendar not implemented")
+}
+func (UnimplementedSLAServiceServer) SetHoliday(context.Context, *SetHolidayRequest) (*SetHolidayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHoliday not implemented")
+}
+func (UnimplementedSLAServiceServer) RemoveHoliday(context.Context, *RemoveHolidayRequest) (*RemoveHolidayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveHoliday not implemented")
+}
+func (UnimplementedSLAServiceServer) ListSLAPolicies(context.Context, *ListSLAPoliciesRequest) (*ListSLAPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSLAPolicies not implemented")
+}
+func (UnimplementedSLAServiceServer) SetSLAPolicy(context.Context, *SetSLAPolicyRequest) (*SetSLAPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSLAPolicy not implemented")
+}
+func (UnimplementedSLAServiceServer) DeleteSLAPolicy(context.Context, *DeleteSLAPolicyRequest) (*DeleteSLAPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSLAPolicy not implemented")
+}
+func (UnimplementedSLAServiceServer) ListUnitHeads(context.Context, *ListUnitHeadsRequest) (*ListUnitHeadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnitHeads not implemented")
+}
+func (UnimplementedSLAServiceServer) SetUnitHead(context.Context, *SetUnitHeadRequest) (*SetUnitHeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUnitHead not implemented")
+}
+func (UnimplementedSLAServiceServer) ListSLAClocks(context.Context, *ListSLAClocksRequest) (*ListSLAClocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSLAClocks not implemented")
+}
+func (UnimplementedSLAServiceServer) mustEmbedUnimplementedSLAServiceServer() {}
+func (UnimplementedSLAServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeSLAServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SLAServiceServer will
+// result in compilation errors.
+type UnsafeSLAServiceServer interface {
+	mustEmbedUnimplementedSLAServiceServer()
+}
+
+func RegisterSLAServiceServer(s grpc.ServiceRegistrar, srv SLAServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSLAServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SLAService_ServiceDesc, srv)
+}
+
+func _SLAService_GetBusinessCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBusinessCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).GetBusinessCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_GetBusinessCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).GetBusinessCalendar(ctx, req.(*GetBusinessCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_UpdateBusinessCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBusinessCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).UpdateBusinessCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_UpdateBusinessCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).UpdateBusinessCalendar(ctx, req.(*UpdateBusinessCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_SetHoliday_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHolidayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).SetHoliday(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_SetHoliday_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).SetHoliday(ctx, req.(*SetHolidayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_RemoveHoliday_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveHolidayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).RemoveHoliday(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_RemoveHoliday_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).RemoveHoliday(ctx, req.(*RemoveHolidayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_ListSLAPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSLAPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).ListSLAPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_ListSLAPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).ListSLAPolicies(ctx, req.(*ListSLAPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_SetSLAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSLAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).SetSLAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_SetSLAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).SetSLAPolicy(ctx, req.(*SetSLAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_DeleteSLAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSLAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).DeleteSLAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_DeleteSLAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).DeleteSLAPolicy(ctx, req.(*DeleteSLAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_ListUnitHeads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnitHeadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).ListUnitHeads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_ListUnitHeads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).ListUnitHeads(ctx, req.(*ListUnitHeadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_SetUnitHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUnitHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).SetUnitHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_SetUnitHead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).SetUnitHead(ctx, req.(*SetUnitHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_ListSLAClocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSLAClocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).ListSLAClocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_ListSLAClocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).ListSLAClocks(ctx, req.(*ListSLAClocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SLAService_ServiceDesc is the grpc.ServiceDesc for SLAService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SLAService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "darta.v1.SLAService",
+	HandlerType: (*SLAServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBusinessCalendar",
+			Handler:    _SLAService_GetBusinessCalendar_Handler,
+		},
+		{
+			MethodName: "UpdateBusinessCalendar",
+			Handler:    _SLAService_UpdateBusinessCalendar_Handler,
+		},
+		{
+			MethodName: "SetHoliday",
+			Handler:    _SLAService_SetHoliday_Handler,
+		},
+		{
+			MethodName: "RemoveHoliday",
+			Handler:    _SLAService_RemoveHoliday_Handler,
+		},
+		{
+			MethodName: "ListSLAPolicies",
+			Handler:    _SLAService_ListSLAPolicies_Handler,
+		},
+		{
+			MethodName: "SetSLAPolicy",
+			Handler:    _SLAService_SetSLAPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteSLAPolicy",
+			Handler:    _SLAService_DeleteSLAPolicy_Handler,
+		},
+		{
+			MethodName: "ListUnitHeads",
+			Handler:    _SLAService_ListUnitHeads_Handler,
+		},
+		{
+			MethodName: "SetUnitHead",
+			Handler:    _SLAService_SetUnitHead_Handler,
+		},
+		{
+			MethodName: "ListSLAClocks",
+			Handler:    _SLAService_ListSLAClocks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "darta/v1/sla.proto",
+}
@@ -2175,14 +2175,18 @@ func (x *GetUserResponse) GetUser() *User {
 
 // ListUsers
 type ListUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        UserStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=identity.v1.UserStatus" json:"status,omitempty"`
-	OrgUnitId     string                 `protobuf:"bytes,2,opt,name=org_unit_id,json=orgUnitId,proto3" json:"org_unit_id,omitempty"`
-	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Status         UserStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=identity.v1.UserStatus" json:"status,omitempty"`
+	OrgUnitId      string                 `protobuf:"bytes,2,opt,name=org_unit_id,json=orgUnitId,proto3" json:"org_unit_id,omitempty"`
+	Search         string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`   // Page size (Keycloak "max"), defaults to 20
+	Offset         int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"` // Page start (Keycloak "first")
+	RoleKey        string                 `protobuf:"bytes,6,opt,name=role_key,json=roleKey,proto3" json:"role_key,omitempty"`
+	Enabled        *bool                  `protobuf:"varint,7,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"` // Overrides status when set
+	AttributeKey   string                 `protobuf:"bytes,8,opt,name=attribute_key,json=attributeKey,proto3" json:"attribute_key,omitempty"`
+	AttributeValue string                 `protobuf:"bytes,9,opt,name=attribute_value,json=attributeValue,proto3" json:"attribute_value,omitempty"` // Requires attribute_key
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListUsersRequest) Reset() {
@@ -2250,6 +2254,34 @@ func (x *ListUsersRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *ListUsersRequest) GetRoleKey() string {
+	if x != nil {
+		return x.RoleKey
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *ListUsersRequest) GetAttributeKey() string {
+	if x != nil {
+		return x.AttributeKey
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetAttributeValue() string {
+	if x != nil {
+		return x.AttributeValue
+	}
+	return ""
+}
+
 type ListUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
@@ -2302,6 +2334,103 @@ func (x *ListUsersResponse) GetTotal() int64 {
 	return 0
 }
 
+// GetUsersByIds - Bulk lookup for resolving user references
+type GetUsersByIdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersByIdsRequest) Reset() {
+	*x = GetUsersByIdsRequest{}
+	mi := &file_identity_v1_identity_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByIdsRequest) ProtoMessage() {}
+
+func (x *GetUsersByIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetUsersByIdsRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetUsersByIdsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetUsersByIdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	MissingIds    []string               `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"` // IDs that do not exist; other lookup failures fail the call
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersByIdsResponse) Reset() {
+	*x = GetUsersByIdsResponse{}
+	mi := &file_identity_v1_identity_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByIdsResponse) ProtoMessage() {}
+
+func (x *GetUsersByIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetUsersByIdsResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetUsersByIdsResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetUsersByIdsResponse) GetMissingIds() []string {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
 // InviteUser
 type InviteUserInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2312,13 +2441,15 @@ type InviteUserInput struct {
 	OrgUnitId     string                 `protobuf:"bytes,5,opt,name=org_unit_id,json=orgUnitId,proto3" json:"org_unit_id,omitempty"`
 	ActorType     string                 `protobuf:"bytes,6,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
 	Attributes    *structpb.Struct       `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	RoleKeys      []string               `protobuf:"bytes,8,rep,name=role_keys,json=roleKeys,proto3" json:"role_keys,omitempty"`          // Realm roles granted on acceptance
+	RedirectUri   string                 `protobuf:"bytes,9,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"` // Where Keycloak sends the user after completing actions
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InviteUserInput) Reset() {
 	*x = InviteUserInput{}
-	mi := &file_identity_v1_identity_proto_msgTypes[23]
+	mi := &file_identity_v1_identity_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2330,7 +2461,7 @@ func (x *InviteUserInput) String() string {
 func (*InviteUserInput) ProtoMessage() {}
 
 func (x *InviteUserInput) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[23]
+	mi := &file_identity_v1_identity_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2343,7 +2474,7 @@ func (x *InviteUserInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InviteUserInput.ProtoReflect.Descriptor instead.
 func (*InviteUserInput) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{23}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *InviteUserInput) GetUsername() string {
@@ -2395,6 +2526,20 @@ func (x *InviteUserInput) GetAttributes() *structpb.Struct {
 	return nil
 }
 
+func (x *InviteUserInput) GetRoleKeys() []string {
+	if x != nil {
+		return x.RoleKeys
+	}
+	return nil
+}
+
+func (x *InviteUserInput) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
 type PersonInput struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	LegalName      string                 `protobuf:"bytes,1,opt,name=legal_name,json=legalName,proto3" json:"legal_name,omitempty"`
@@ -2409,7 +2554,7 @@ type PersonInput struct {
 
 func (x *PersonInput) Reset() {
 	*x = PersonInput{}
-	mi := &file_identity_v1_identity_proto_msgTypes[24]
+	mi := &file_identity_v1_identity_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2421,7 +2566,7 @@ func (x *PersonInput) String() string {
 func (*PersonInput) ProtoMessage() {}
 
 func (x *PersonInput) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[24]
+	mi := &file_identity_v1_identity_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2434,7 +2579,7 @@ func (x *PersonInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PersonInput.ProtoReflect.Descriptor instead.
 func (*PersonInput) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{24}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *PersonInput) GetLegalName() string {
@@ -2489,7 +2634,7 @@ type ContactInput struct {
 
 func (x *ContactInput) Reset() {
 	*x = ContactInput{}
-	mi := &file_identity_v1_identity_proto_msgTypes[25]
+	mi := &file_identity_v1_identity_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2501,7 +2646,7 @@ func (x *ContactInput) String() string {
 func (*ContactInput) ProtoMessage() {}
 
 func (x *ContactInput) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[25]
+	mi := &file_identity_v1_identity_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2514,7 +2659,7 @@ func (x *ContactInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContactInput.ProtoReflect.Descriptor instead.
 func (*ContactInput) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{25}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ContactInput) GetType() string {
@@ -2543,7 +2688,7 @@ type AddressInput struct {
 
 func (x *AddressInput) Reset() {
 	*x = AddressInput{}
-	mi := &file_identity_v1_identity_proto_msgTypes[26]
+	mi := &file_identity_v1_identity_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2555,7 +2700,7 @@ func (x *AddressInput) String() string {
 func (*AddressInput) ProtoMessage() {}
 
 func (x *AddressInput) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[26]
+	mi := &file_identity_v1_identity_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2568,7 +2713,7 @@ func (x *AddressInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddressInput.ProtoReflect.Descriptor instead.
 func (*AddressInput) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{26}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *AddressInput) GetRaw() string {
@@ -2611,7 +2756,7 @@ type GovIdRefInput struct {
 
 func (x *GovIdRefInput) Reset() {
 	*x = GovIdRefInput{}
-	mi := &file_identity_v1_identity_proto_msgTypes[27]
+	mi := &file_identity_v1_identity_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2623,7 +2768,7 @@ func (x *GovIdRefInput) String() string {
 func (*GovIdRefInput) ProtoMessage() {}
 
 func (x *GovIdRefInput) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[27]
+	mi := &file_identity_v1_identity_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2636,7 +2781,7 @@ func (x *GovIdRefInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GovIdRefInput.ProtoReflect.Descriptor instead.
 func (*GovIdRefInput) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{27}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GovIdRefInput) GetType() string {
@@ -2676,7 +2821,7 @@ type InviteUserRequest struct {
 
 func (x *InviteUserRequest) Reset() {
 	*x = InviteUserRequest{}
-	mi := &file_identity_v1_identity_proto_msgTypes[28]
+	mi := &file_identity_v1_identity_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2688,7 +2833,7 @@ func (x *InviteUserRequest) String() string {
 func (*InviteUserRequest) ProtoMessage() {}
 
 func (x *InviteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[28]
+	mi := &file_identity_v1_identity_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2701,7 +2846,7 @@ func (x *InviteUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InviteUserRequest.ProtoReflect.Descriptor instead.
 func (*InviteUserRequest) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{28}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *InviteUserRequest) GetInput() *InviteUserInput {
@@ -2714,13 +2859,14 @@ func (x *InviteUserRequest) GetInput() *InviteUserInput {
 type InviteUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Invitation    *Invitation            `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InviteUserResponse) Reset() {
 	*x = InviteUserResponse{}
-	mi := &file_identity_v1_identity_proto_msgTypes[29]
+	mi := &file_identity_v1_identity_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2732,7 +2878,7 @@ func (x *InviteUserResponse) String() string {
 func (*InviteUserResponse) ProtoMessage() {}
 
 func (x *InviteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[29]
+	mi := &file_identity_v1_identity_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2745,7 +2891,7 @@ func (x *InviteUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InviteUserResponse.ProtoReflect.Descriptor instead.
 func (*InviteUserResponse) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{29}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *InviteUserResponse) GetUser() *User {
@@ -2755,29 +2901,44 @@ func (x *InviteUserResponse) GetUser() *User {
 	return nil
 }
 
-// OrgUnit operations
-type GetOrgUnitRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *InviteUserResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
 }
 
-func (x *GetOrgUnitRequest) Reset() {
-	*x = GetOrgUnitRequest{}
-	mi := &file_identity_v1_identity_proto_msgTypes[30]
+// Invitation records how and when a user was invited
+type Invitation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email           string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	OrgUnitId       string                 `protobuf:"bytes,3,opt,name=org_unit_id,json=orgUnitId,proto3" json:"org_unit_id,omitempty"`
+	RoleKeys        []string               `protobuf:"bytes,4,rep,name=role_keys,json=roleKeys,proto3" json:"role_keys,omitempty"`
+	RequiredActions []string               `protobuf:"bytes,5,rep,name=required_actions,json=requiredActions,proto3" json:"required_actions,omitempty"` // e.g. VERIFY_EMAIL, UPDATE_PASSWORD
+	InvitedBy       string                 `protobuf:"bytes,6,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	SentAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	EmailSent       bool                   `protobuf:"varint,9,opt,name=email_sent,json=emailSent,proto3" json:"email_sent,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_identity_v1_identity_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrgUnitRequest) String() string {
+func (x *Invitation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrgUnitRequest) ProtoMessage() {}
+func (*Invitation) ProtoMessage() {}
 
-func (x *GetOrgUnitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[30]
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2788,86 +2949,98 @@ func (x *GetOrgUnitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrgUnitRequest.ProtoReflect.Descriptor instead.
-func (*GetOrgUnitRequest) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetOrgUnitRequest) GetId() string {
+func (x *Invitation) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-type GetOrgUnitResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrgUnit       *OrgUnit               `protobuf:"bytes,1,opt,name=org_unit,json=orgUnit,proto3" json:"org_unit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Invitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
 }
 
-func (x *GetOrgUnitResponse) Reset() {
-	*x = GetOrgUnitResponse{}
-	mi := &file_identity_v1_identity_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Invitation) GetOrgUnitId() string {
+	if x != nil {
+		return x.OrgUnitId
+	}
+	return ""
 }
 
-func (x *GetOrgUnitResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Invitation) GetRoleKeys() []string {
+	if x != nil {
+		return x.RoleKeys
+	}
+	return nil
 }
 
-func (*GetOrgUnitResponse) ProtoMessage() {}
+func (x *Invitation) GetRequiredActions() []string {
+	if x != nil {
+		return x.RequiredActions
+	}
+	return nil
+}
 
-func (x *GetOrgUnitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[31]
+func (x *Invitation) GetInvitedBy() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.InvitedBy
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetOrgUnitResponse.ProtoReflect.Descriptor instead.
-func (*GetOrgUnitResponse) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{31}
+func (x *Invitation) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
 }
 
-func (x *GetOrgUnitResponse) GetOrgUnit() *OrgUnit {
+func (x *Invitation) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OrgUnit
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-type ListOrgUnitsRequest struct {
+func (x *Invitation) GetEmailSent() bool {
+	if x != nil {
+		return x.EmailSent
+	}
+	return false
+}
+
+// DeactivateUser
+type DeactivateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ParentId      string                 `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
-	Type          OrgUnitType            `protobuf:"varint,2,opt,name=type,proto3,enum=identity.v1.OrgUnitType" json:"type,omitempty"`
-	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrgUnitsRequest) Reset() {
-	*x = ListOrgUnitsRequest{}
-	mi := &file_identity_v1_identity_proto_msgTypes[32]
+func (x *DeactivateUserRequest) Reset() {
+	*x = DeactivateUserRequest{}
+	mi := &file_identity_v1_identity_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrgUnitsRequest) String() string {
+func (x *DeactivateUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrgUnitsRequest) ProtoMessage() {}
+func (*DeactivateUserRequest) ProtoMessage() {}
 
-func (x *ListOrgUnitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[32]
+func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2878,21 +3051,207 @@ func (x *ListOrgUnitsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrgUnitsRequest.ProtoReflect.Descriptor instead.
-func (*ListOrgUnitsRequest) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use DeactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListOrgUnitsRequest) GetParentId() string {
+func (x *DeactivateUserRequest) GetId() string {
 	if x != nil {
-		return x.ParentId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListOrgUnitsRequest) GetType() OrgUnitType {
+func (x *DeactivateUserRequest) GetReason() string {
 	if x != nil {
-		return x.Type
+		return x.Reason
+	}
+	return ""
+}
+
+type DeactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserResponse) Reset() {
+	*x = DeactivateUserResponse{}
+	mi := &file_identity_v1_identity_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserResponse) ProtoMessage() {}
+
+func (x *DeactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeactivateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// OrgUnit operations
+type GetOrgUnitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrgUnitRequest) Reset() {
+	*x = GetOrgUnitRequest{}
+	mi := &file_identity_v1_identity_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrgUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrgUnitRequest) ProtoMessage() {}
+
+func (x *GetOrgUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrgUnitRequest.ProtoReflect.Descriptor instead.
+func (*GetOrgUnitRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetOrgUnitRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetOrgUnitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgUnit       *OrgUnit               `protobuf:"bytes,1,opt,name=org_unit,json=orgUnit,proto3" json:"org_unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrgUnitResponse) Reset() {
+	*x = GetOrgUnitResponse{}
+	mi := &file_identity_v1_identity_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrgUnitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrgUnitResponse) ProtoMessage() {}
+
+func (x *GetOrgUnitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrgUnitResponse.ProtoReflect.Descriptor instead.
+func (*GetOrgUnitResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetOrgUnitResponse) GetOrgUnit() *OrgUnit {
+	if x != nil {
+		return x.OrgUnit
+	}
+	return nil
+}
+
+type ListOrgUnitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParentId      string                 `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Type          OrgUnitType            `protobuf:"varint,2,opt,name=type,proto3,enum=identity.v1.OrgUnitType" json:"type,omitempty"`
+	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrgUnitsRequest) Reset() {
+	*x = ListOrgUnitsRequest{}
+	mi := &file_identity_v1_identity_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrgUnitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrgUnitsRequest) ProtoMessage() {}
+
+func (x *ListOrgUnitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrgUnitsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrgUnitsRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListOrgUnitsRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *ListOrgUnitsRequest) GetType() OrgUnitType {
+	if x != nil {
+		return x.Type
 	}
 	return OrgUnitType_ORG_UNIT_TYPE_UNSPECIFIED
 }
@@ -2913,7 +3272,7 @@ type ListOrgUnitsResponse struct {
 
 func (x *ListOrgUnitsResponse) Reset() {
 	*x = ListOrgUnitsResponse{}
-	mi := &file_identity_v1_identity_proto_msgTypes[33]
+	mi := &file_identity_v1_identity_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2925,7 +3284,7 @@ func (x *ListOrgUnitsResponse) String() string {
 func (*ListOrgUnitsResponse) ProtoMessage() {}
 
 func (x *ListOrgUnitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[33]
+	mi := &file_identity_v1_identity_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2938,7 +3297,7 @@ func (x *ListOrgUnitsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrgUnitsResponse.ProtoReflect.Descriptor instead.
 func (*ListOrgUnitsResponse) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{33}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ListOrgUnitsResponse) GetOrgUnits() []*OrgUnit {
@@ -2961,7 +3320,7 @@ type CreateOrgUnitInput struct {
 
 func (x *CreateOrgUnitInput) Reset() {
 	*x = CreateOrgUnitInput{}
-	mi := &file_identity_v1_identity_proto_msgTypes[34]
+	mi := &file_identity_v1_identity_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2973,7 +3332,7 @@ func (x *CreateOrgUnitInput) String() string {
 func (*CreateOrgUnitInput) ProtoMessage() {}
 
 func (x *CreateOrgUnitInput) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[34]
+	mi := &file_identity_v1_identity_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2986,7 +3345,7 @@ func (x *CreateOrgUnitInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrgUnitInput.ProtoReflect.Descriptor instead.
 func (*CreateOrgUnitInput) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{34}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *CreateOrgUnitInput) GetName() string {
@@ -3033,7 +3392,7 @@ type CreateOrgUnitRequest struct {
 
 func (x *CreateOrgUnitRequest) Reset() {
 	*x = CreateOrgUnitRequest{}
-	mi := &file_identity_v1_identity_proto_msgTypes[35]
+	mi := &file_identity_v1_identity_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3045,7 +3404,7 @@ func (x *CreateOrgUnitRequest) String() string {
 func (*CreateOrgUnitRequest) ProtoMessage() {}
 
 func (x *CreateOrgUnitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[35]
+	mi := &file_identity_v1_identity_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3058,7 +3417,7 @@ func (x *CreateOrgUnitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrgUnitRequest.ProtoReflect.Descriptor instead.
 func (*CreateOrgUnitRequest) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{35}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *CreateOrgUnitRequest) GetInput() *CreateOrgUnitInput {
@@ -3077,7 +3436,7 @@ type CreateOrgUnitResponse struct {
 
 func (x *CreateOrgUnitResponse) Reset() {
 	*x = CreateOrgUnitResponse{}
-	mi := &file_identity_v1_identity_proto_msgTypes[36]
+	mi := &file_identity_v1_identity_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3089,7 +3448,7 @@ func (x *CreateOrgUnitResponse) String() string {
 func (*CreateOrgUnitResponse) ProtoMessage() {}
 
 func (x *CreateOrgUnitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[36]
+	mi := &file_identity_v1_identity_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3102,7 +3461,7 @@ func (x *CreateOrgUnitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrgUnitResponse.ProtoReflect.Descriptor instead.
 func (*CreateOrgUnitResponse) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{36}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *CreateOrgUnitResponse) GetOrgUnit() *OrgUnit {
@@ -3122,7 +3481,7 @@ type GetRoleRequest struct {
 
 func (x *GetRoleRequest) Reset() {
 	*x = GetRoleRequest{}
-	mi := &file_identity_v1_identity_proto_msgTypes[37]
+	mi := &file_identity_v1_identity_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3134,7 +3493,7 @@ func (x *GetRoleRequest) String() string {
 func (*GetRoleRequest) ProtoMessage() {}
 
 func (x *GetRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[37]
+	mi := &file_identity_v1_identity_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3147,7 +3506,7 @@ func (x *GetRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoleRequest.ProtoReflect.Descriptor instead.
 func (*GetRoleRequest) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{37}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GetRoleRequest) GetKey() string {
@@ -3166,7 +3525,7 @@ type GetRoleResponse struct {
 
 func (x *GetRoleResponse) Reset() {
 	*x = GetRoleResponse{}
-	mi := &file_identity_v1_identity_proto_msgTypes[38]
+	mi := &file_identity_v1_identity_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3178,7 +3537,7 @@ func (x *GetRoleResponse) String() string {
 func (*GetRoleResponse) ProtoMessage() {}
 
 func (x *GetRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[38]
+	mi := &file_identity_v1_identity_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3191,7 +3550,7 @@ func (x *GetRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoleResponse.ProtoReflect.Descriptor instead.
 func (*GetRoleResponse) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{38}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetRoleResponse) GetRole() *Role {
@@ -3212,7 +3571,7 @@ type ListRolesRequest struct {
 
 func (x *ListRolesRequest) Reset() {
 	*x = ListRolesRequest{}
-	mi := &file_identity_v1_identity_proto_msgTypes[39]
+	mi := &file_identity_v1_identity_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3224,7 +3583,7 @@ func (x *ListRolesRequest) String() string {
 func (*ListRolesRequest) ProtoMessage() {}
 
 func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[39]
+	mi := &file_identity_v1_identity_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3237,7 +3596,7 @@ func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
 func (*ListRolesRequest) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{39}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ListRolesRequest) GetSearch() string {
@@ -3271,7 +3630,7 @@ type ListRolesResponse struct {
 
 func (x *ListRolesResponse) Reset() {
 	*x = ListRolesResponse{}
-	mi := &file_identity_v1_identity_proto_msgTypes[40]
+	mi := &file_identity_v1_identity_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3283,7 +3642,7 @@ func (x *ListRolesResponse) String() string {
 func (*ListRolesResponse) ProtoMessage() {}
 
 func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[40]
+	mi := &file_identity_v1_identity_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3296,7 +3655,7 @@ func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
 func (*ListRolesResponse) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{40}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ListRolesResponse) GetRoles() []*Role {
@@ -3313,29 +3672,35 @@ func (x *ListRolesResponse) GetTotal() int64 {
 	return 0
 }
 
-// Grant operations
-type GetGrantRequest struct {
+// UserRole represents a realm role assigned to a user, optionally scoped
+type UserRole struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // "<user_id>:<role_key>"
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleKey       string                 `protobuf:"bytes,3,opt,name=role_key,json=roleKey,proto3" json:"role_key,omitempty"`
+	OrgUnitId     string                 `protobuf:"bytes,4,opt,name=org_unit_id,json=orgUnitId,proto3" json:"org_unit_id,omitempty"` // Role scoped to unit
+	WardId        string                 `protobuf:"bytes,5,opt,name=ward_id,json=wardId,proto3" json:"ward_id,omitempty"`            // Role scoped to ward
+	AssignedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	AssignedBy    string                 `protobuf:"bytes,7,opt,name=assigned_by,json=assignedBy,proto3" json:"assigned_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGrantRequest) Reset() {
-	*x = GetGrantRequest{}
-	mi := &file_identity_v1_identity_proto_msgTypes[41]
+func (x *UserRole) Reset() {
+	*x = UserRole{}
+	mi := &file_identity_v1_identity_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGrantRequest) String() string {
+func (x *UserRole) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGrantRequest) ProtoMessage() {}
+func (*UserRole) ProtoMessage() {}
 
-func (x *GetGrantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[41]
+func (x *UserRole) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3346,29 +3711,316 @@ func (x *GetGrantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGrantRequest.ProtoReflect.Descriptor instead.
-func (*GetGrantRequest) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use UserRole.ProtoReflect.Descriptor instead.
+func (*UserRole) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetGrantRequest) GetId() string {
+func (x *UserRole) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetGrantResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Grant         *Grant                 `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UserRole) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-func (x *GetGrantResponse) Reset() {
-	*x = GetGrantResponse{}
-	mi := &file_identity_v1_identity_proto_msgTypes[42]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+func (x *UserRole) GetRoleKey() string {
+	if x != nil {
+		return x.RoleKey
+	}
+	return ""
+}
+
+func (x *UserRole) GetOrgUnitId() string {
+	if x != nil {
+		return x.OrgUnitId
+	}
+	return ""
+}
+
+func (x *UserRole) GetWardId() string {
+	if x != nil {
+		return x.WardId
+	}
+	return ""
+}
+
+func (x *UserRole) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *UserRole) GetAssignedBy() string {
+	if x != nil {
+		return x.AssignedBy
+	}
+	return ""
+}
+
+type AssignRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleKey       string                 `protobuf:"bytes,2,opt,name=role_key,json=roleKey,proto3" json:"role_key,omitempty"`
+	OrgUnitId     string                 `protobuf:"bytes,3,opt,name=org_unit_id,json=orgUnitId,proto3" json:"org_unit_id,omitempty"`
+	WardId        string                 `protobuf:"bytes,4,opt,name=ward_id,json=wardId,proto3" json:"ward_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRoleRequest) Reset() {
+	*x = AssignRoleRequest{}
+	mi := &file_identity_v1_identity_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleRequest) ProtoMessage() {}
+
+func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignRoleRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AssignRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AssignRoleRequest) GetRoleKey() string {
+	if x != nil {
+		return x.RoleKey
+	}
+	return ""
+}
+
+func (x *AssignRoleRequest) GetOrgUnitId() string {
+	if x != nil {
+		return x.OrgUnitId
+	}
+	return ""
+}
+
+func (x *AssignRoleRequest) GetWardId() string {
+	if x != nil {
+		return x.WardId
+	}
+	return ""
+}
+
+type AssignRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserRole      *UserRole              `protobuf:"bytes,1,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRoleResponse) Reset() {
+	*x = AssignRoleResponse{}
+	mi := &file_identity_v1_identity_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleResponse) ProtoMessage() {}
+
+func (x *AssignRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRoleResponse.ProtoReflect.Descriptor instead.
+func (*AssignRoleResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AssignRoleResponse) GetUserRole() *UserRole {
+	if x != nil {
+		return x.UserRole
+	}
+	return nil
+}
+
+type RevokeRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserRoleId    string                 `protobuf:"bytes,1,opt,name=user_role_id,json=userRoleId,proto3" json:"user_role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRoleRequest) Reset() {
+	*x = RevokeRoleRequest{}
+	mi := &file_identity_v1_identity_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRoleRequest) ProtoMessage() {}
+
+func (x *RevokeRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRoleRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRoleRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RevokeRoleRequest) GetUserRoleId() string {
+	if x != nil {
+		return x.UserRoleId
+	}
+	return ""
+}
+
+type RevokeRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRoleResponse) Reset() {
+	*x = RevokeRoleResponse{}
+	mi := &file_identity_v1_identity_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRoleResponse) ProtoMessage() {}
+
+func (x *RevokeRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRoleResponse.ProtoReflect.Descriptor instead.
+func (*RevokeRoleResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RevokeRoleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Grant operations
+type GetGrantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGrantRequest) Reset() {
+	*x = GetGrantRequest{}
+	mi := &file_identity_v1_identity_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGrantRequest) ProtoMessage() {}
+
+func (x *GetGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGrantRequest.ProtoReflect.Descriptor instead.
+func (*GetGrantRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetGrantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetGrantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grant         *Grant                 `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGrantResponse) Reset() {
+	*x = GetGrantResponse{}
+	mi := &file_identity_v1_identity_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
@@ -3379,7 +4031,7 @@ func (x *GetGrantResponse) String() string {
 func (*GetGrantResponse) ProtoMessage() {}
 
 func (x *GetGrantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[42]
+	mi := &file_identity_v1_identity_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3392,7 +4044,7 @@ func (x *GetGrantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGrantResponse.ProtoReflect.Descriptor instead.
 func (*GetGrantResponse) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{42}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetGrantResponse) GetGrant() *Grant {
@@ -3416,7 +4068,7 @@ type ListGrantsRequest struct {
 
 func (x *ListGrantsRequest) Reset() {
 	*x = ListGrantsRequest{}
-	mi := &file_identity_v1_identity_proto_msgTypes[43]
+	mi := &file_identity_v1_identity_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3428,7 +4080,7 @@ func (x *ListGrantsRequest) String() string {
 func (*ListGrantsRequest) ProtoMessage() {}
 
 func (x *ListGrantsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[43]
+	mi := &file_identity_v1_identity_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3441,7 +4093,7 @@ func (x *ListGrantsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListGrantsRequest.ProtoReflect.Descriptor instead.
 func (*ListGrantsRequest) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{43}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ListGrantsRequest) GetUserId() string {
@@ -3494,21 +4146,367 @@ type ListGrantsResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGrantsResponse) Reset() {
-	*x = ListGrantsResponse{}
-	mi := &file_identity_v1_identity_proto_msgTypes[44]
+func (x *ListGrantsResponse) Reset() {
+	*x = ListGrantsResponse{}
+	mi := &file_identity_v1_identity_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGrantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGrantsResponse) ProtoMessage() {}
+
+func (x *ListGrantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGrantsResponse.ProtoReflect.Descriptor instead.
+func (*ListGrantsResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListGrantsResponse) GetGrants() []*Grant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+func (x *ListGrantsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RequestGrantInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleKey       string                 `protobuf:"bytes,2,opt,name=role_key,json=roleKey,proto3" json:"role_key,omitempty"`
+	OrgUnitId     string                 `protobuf:"bytes,3,opt,name=org_unit_id,json=orgUnitId,proto3" json:"org_unit_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	StartAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestGrantInput) Reset() {
+	*x = RequestGrantInput{}
+	mi := &file_identity_v1_identity_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestGrantInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestGrantInput) ProtoMessage() {}
+
+func (x *RequestGrantInput) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestGrantInput.ProtoReflect.Descriptor instead.
+func (*RequestGrantInput) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RequestGrantInput) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RequestGrantInput) GetRoleKey() string {
+	if x != nil {
+		return x.RoleKey
+	}
+	return ""
+}
+
+func (x *RequestGrantInput) GetOrgUnitId() string {
+	if x != nil {
+		return x.OrgUnitId
+	}
+	return ""
+}
+
+func (x *RequestGrantInput) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RequestGrantInput) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *RequestGrantInput) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+type RequestGrantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *RequestGrantInput     `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestGrantRequest) Reset() {
+	*x = RequestGrantRequest{}
+	mi := &file_identity_v1_identity_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestGrantRequest) ProtoMessage() {}
+
+func (x *RequestGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestGrantRequest.ProtoReflect.Descriptor instead.
+func (*RequestGrantRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RequestGrantRequest) GetInput() *RequestGrantInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type RequestGrantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grant         *Grant                 `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestGrantResponse) Reset() {
+	*x = RequestGrantResponse{}
+	mi := &file_identity_v1_identity_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestGrantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestGrantResponse) ProtoMessage() {}
+
+func (x *RequestGrantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestGrantResponse.ProtoReflect.Descriptor instead.
+func (*RequestGrantResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RequestGrantResponse) GetGrant() *Grant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+// Permission check
+type PermissionCheckInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ResourceType  string                 `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	OrgUnitId     string                 `protobuf:"bytes,5,opt,name=org_unit_id,json=orgUnitId,proto3" json:"org_unit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionCheckInput) Reset() {
+	*x = PermissionCheckInput{}
+	mi := &file_identity_v1_identity_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionCheckInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionCheckInput) ProtoMessage() {}
+
+func (x *PermissionCheckInput) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionCheckInput.ProtoReflect.Descriptor instead.
+func (*PermissionCheckInput) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PermissionCheckInput) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PermissionCheckInput) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *PermissionCheckInput) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *PermissionCheckInput) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PermissionCheckInput) GetOrgUnitId() string {
+	if x != nil {
+		return x.OrgUnitId
+	}
+	return ""
+}
+
+type CheckPermissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *PermissionCheckInput  `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPermissionRequest) Reset() {
+	*x = CheckPermissionRequest{}
+	mi := &file_identity_v1_identity_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionRequest) ProtoMessage() {}
+
+func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionRequest.ProtoReflect.Descriptor instead.
+func (*CheckPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CheckPermissionRequest) GetInput() *PermissionCheckInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type CheckPermissionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Allowed         bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	MatchedGrantIds []string               `protobuf:"bytes,2,rep,name=matched_grant_ids,json=matchedGrantIds,proto3" json:"matched_grant_ids,omitempty"`
+	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckPermissionResponse) Reset() {
+	*x = CheckPermissionResponse{}
+	mi := &file_identity_v1_identity_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGrantsResponse) String() string {
+func (x *CheckPermissionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGrantsResponse) ProtoMessage() {}
+func (*CheckPermissionResponse) ProtoMessage() {}
 
-func (x *ListGrantsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[44]
+func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3519,52 +4517,62 @@ func (x *ListGrantsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGrantsResponse.ProtoReflect.Descriptor instead.
-func (*ListGrantsResponse) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use CheckPermissionResponse.ProtoReflect.Descriptor instead.
+func (*CheckPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ListGrantsResponse) GetGrants() []*Grant {
+func (x *CheckPermissionResponse) GetAllowed() bool {
 	if x != nil {
-		return x.Grants
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckPermissionResponse) GetMatchedGrantIds() []string {
+	if x != nil {
+		return x.MatchedGrantIds
 	}
 	return nil
 }
 
-func (x *ListGrantsResponse) GetTotal() int64 {
+func (x *CheckPermissionResponse) GetReason() string {
 	if x != nil {
-		return x.Total
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-type RequestGrantInput struct {
+// Tenant operations. Each tenant (palika) is a Keycloak realm named by its slug.
+type Tenant struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RoleKey       string                 `protobuf:"bytes,2,opt,name=role_key,json=roleKey,proto3" json:"role_key,omitempty"`
-	OrgUnitId     string                 `protobuf:"bytes,3,opt,name=org_unit_id,json=orgUnitId,proto3" json:"org_unit_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
-	StartAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
-	EndAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Realm         string                 `protobuf:"bytes,3,opt,name=realm,proto3" json:"realm,omitempty"`
+	ClientId      string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,5,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"` // Only returned by OnboardTenant
+	Roles         []string               `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"`
+	OrgUnits      []*OrgUnit             `protobuf:"bytes,7,rep,name=org_units,json=orgUnits,proto3" json:"org_units,omitempty"`
+	Enabled       bool                   `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RequestGrantInput) Reset() {
-	*x = RequestGrantInput{}
-	mi := &file_identity_v1_identity_proto_msgTypes[45]
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	mi := &file_identity_v1_identity_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestGrantInput) String() string {
+func (x *Tenant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestGrantInput) ProtoMessage() {}
+func (*Tenant) ProtoMessage() {}
 
-func (x *RequestGrantInput) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[45]
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3575,75 +4583,93 @@ func (x *RequestGrantInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestGrantInput.ProtoReflect.Descriptor instead.
-func (*RequestGrantInput) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *RequestGrantInput) GetUserId() string {
+func (x *Tenant) GetSlug() string {
 	if x != nil {
-		return x.UserId
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *RequestGrantInput) GetRoleKey() string {
+func (x *Tenant) GetDisplayName() string {
 	if x != nil {
-		return x.RoleKey
+		return x.DisplayName
 	}
 	return ""
 }
 
-func (x *RequestGrantInput) GetOrgUnitId() string {
+func (x *Tenant) GetRealm() string {
 	if x != nil {
-		return x.OrgUnitId
+		return x.Realm
 	}
 	return ""
 }
 
-func (x *RequestGrantInput) GetReason() string {
+func (x *Tenant) GetClientId() string {
 	if x != nil {
-		return x.Reason
+		return x.ClientId
 	}
 	return ""
 }
 
-func (x *RequestGrantInput) GetStartAt() *timestamppb.Timestamp {
+func (x *Tenant) GetClientSecret() string {
 	if x != nil {
-		return x.StartAt
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *Tenant) GetRoles() []string {
+	if x != nil {
+		return x.Roles
 	}
 	return nil
 }
 
-func (x *RequestGrantInput) GetEndAt() *timestamppb.Timestamp {
+func (x *Tenant) GetOrgUnits() []*OrgUnit {
 	if x != nil {
-		return x.EndAt
+		return x.OrgUnits
 	}
 	return nil
 }
 
-type RequestGrantRequest struct {
+func (x *Tenant) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type OnboardTenantInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         *RequestGrantInput     `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"` // e.g. "palika_bagmati"
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	RedirectUri   string                 `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"` // OAuth client redirect URI
+	WebOrigin     string                 `protobuf:"bytes,4,opt,name=web_origin,json=webOrigin,proto3" json:"web_origin,omitempty"`
+	DefaultLocale string                 `protobuf:"bytes,5,opt,name=default_locale,json=defaultLocale,proto3" json:"default_locale,omitempty"` // Defaults to "en"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RequestGrantRequest) Reset() {
-	*x = RequestGrantRequest{}
-	mi := &file_identity_v1_identity_proto_msgTypes[46]
+func (x *OnboardTenantInput) Reset() {
+	*x = OnboardTenantInput{}
+	mi := &file_identity_v1_identity_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestGrantRequest) String() string {
+func (x *OnboardTenantInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestGrantRequest) ProtoMessage() {}
+func (*OnboardTenantInput) ProtoMessage() {}
 
-func (x *RequestGrantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[46]
+func (x *OnboardTenantInput) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3654,40 +4680,68 @@ func (x *RequestGrantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestGrantRequest.ProtoReflect.Descriptor instead.
-func (*RequestGrantRequest) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use OnboardTenantInput.ProtoReflect.Descriptor instead.
+func (*OnboardTenantInput) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *RequestGrantRequest) GetInput() *RequestGrantInput {
+func (x *OnboardTenantInput) GetSlug() string {
 	if x != nil {
-		return x.Input
+		return x.Slug
 	}
-	return nil
+	return ""
 }
 
-type RequestGrantResponse struct {
+func (x *OnboardTenantInput) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *OnboardTenantInput) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+func (x *OnboardTenantInput) GetWebOrigin() string {
+	if x != nil {
+		return x.WebOrigin
+	}
+	return ""
+}
+
+func (x *OnboardTenantInput) GetDefaultLocale() string {
+	if x != nil {
+		return x.DefaultLocale
+	}
+	return ""
+}
+
+type OnboardTenantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Grant         *Grant                 `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
+	Input         *OnboardTenantInput    `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RequestGrantResponse) Reset() {
-	*x = RequestGrantResponse{}
-	mi := &file_identity_v1_identity_proto_msgTypes[47]
+func (x *OnboardTenantRequest) Reset() {
+	*x = OnboardTenantRequest{}
+	mi := &file_identity_v1_identity_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestGrantResponse) String() string {
+func (x *OnboardTenantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestGrantResponse) ProtoMessage() {}
+func (*OnboardTenantRequest) ProtoMessage() {}
 
-func (x *RequestGrantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[47]
+func (x *OnboardTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3698,45 +4752,41 @@ func (x *RequestGrantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestGrantResponse.ProtoReflect.Descriptor instead.
-func (*RequestGrantResponse) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use OnboardTenantRequest.ProtoReflect.Descriptor instead.
+func (*OnboardTenantRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *RequestGrantResponse) GetGrant() *Grant {
+func (x *OnboardTenantRequest) GetInput() *OnboardTenantInput {
 	if x != nil {
-		return x.Grant
+		return x.Input
 	}
 	return nil
 }
 
-// Permission check
-type PermissionCheckInput struct {
+type OnboardTenantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ResourceType  string                 `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
-	ResourceId    string                 `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
-	OrgUnitId     string                 `protobuf:"bytes,5,opt,name=org_unit_id,json=orgUnitId,proto3" json:"org_unit_id,omitempty"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Created       bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // False when the realm already existed and was only reconciled
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PermissionCheckInput) Reset() {
-	*x = PermissionCheckInput{}
-	mi := &file_identity_v1_identity_proto_msgTypes[48]
+func (x *OnboardTenantResponse) Reset() {
+	*x = OnboardTenantResponse{}
+	mi := &file_identity_v1_identity_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PermissionCheckInput) String() string {
+func (x *OnboardTenantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PermissionCheckInput) ProtoMessage() {}
+func (*OnboardTenantResponse) ProtoMessage() {}
 
-func (x *PermissionCheckInput) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[48]
+func (x *OnboardTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3747,68 +4797,48 @@ func (x *PermissionCheckInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PermissionCheckInput.ProtoReflect.Descriptor instead.
-func (*PermissionCheckInput) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{48}
-}
-
-func (x *PermissionCheckInput) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *PermissionCheckInput) GetResourceType() string {
-	if x != nil {
-		return x.ResourceType
-	}
-	return ""
-}
-
-func (x *PermissionCheckInput) GetResourceId() string {
-	if x != nil {
-		return x.ResourceId
-	}
-	return ""
+// Deprecated: Use OnboardTenantResponse.ProtoReflect.Descriptor instead.
+func (*OnboardTenantResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *PermissionCheckInput) GetAction() string {
+func (x *OnboardTenantResponse) GetTenant() *Tenant {
 	if x != nil {
-		return x.Action
+		return x.Tenant
 	}
-	return ""
+	return nil
 }
 
-func (x *PermissionCheckInput) GetOrgUnitId() string {
+func (x *OnboardTenantResponse) GetCreated() bool {
 	if x != nil {
-		return x.OrgUnitId
+		return x.Created
 	}
-	return ""
+	return false
 }
 
-type CheckPermissionRequest struct {
+type OffboardTenantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         *PermissionCheckInput  `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	ConfirmSlug   string                 `protobuf:"bytes,2,opt,name=confirm_slug,json=confirmSlug,proto3" json:"confirm_slug,omitempty"` // Must repeat slug
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckPermissionRequest) Reset() {
-	*x = CheckPermissionRequest{}
-	mi := &file_identity_v1_identity_proto_msgTypes[49]
+func (x *OffboardTenantRequest) Reset() {
+	*x = OffboardTenantRequest{}
+	mi := &file_identity_v1_identity_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckPermissionRequest) String() string {
+func (x *OffboardTenantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckPermissionRequest) ProtoMessage() {}
+func (*OffboardTenantRequest) ProtoMessage() {}
 
-func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[49]
+func (x *OffboardTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3819,42 +4849,47 @@ func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckPermissionRequest.ProtoReflect.Descriptor instead.
-func (*CheckPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use OffboardTenantRequest.ProtoReflect.Descriptor instead.
+func (*OffboardTenantRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *CheckPermissionRequest) GetInput() *PermissionCheckInput {
+func (x *OffboardTenantRequest) GetSlug() string {
 	if x != nil {
-		return x.Input
+		return x.Slug
 	}
-	return nil
+	return ""
 }
 
-type CheckPermissionResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Allowed         bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
-	MatchedGrantIds []string               `protobuf:"bytes,2,rep,name=matched_grant_ids,json=matchedGrantIds,proto3" json:"matched_grant_ids,omitempty"`
-	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *OffboardTenantRequest) GetConfirmSlug() string {
+	if x != nil {
+		return x.ConfirmSlug
+	}
+	return ""
 }
 
-func (x *CheckPermissionResponse) Reset() {
-	*x = CheckPermissionResponse{}
-	mi := &file_identity_v1_identity_proto_msgTypes[50]
+type OffboardTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OffboardTenantResponse) Reset() {
+	*x = OffboardTenantResponse{}
+	mi := &file_identity_v1_identity_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckPermissionResponse) String() string {
+func (x *OffboardTenantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckPermissionResponse) ProtoMessage() {}
+func (*OffboardTenantResponse) ProtoMessage() {}
 
-func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[50]
+func (x *OffboardTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_identity_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3865,32 +4900,18 @@ func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckPermissionResponse.ProtoReflect.Descriptor instead.
-func (*CheckPermissionResponse) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use OffboardTenantResponse.ProtoReflect.Descriptor instead.
+func (*OffboardTenantResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *CheckPermissionResponse) GetAllowed() bool {
+func (x *OffboardTenantResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Allowed
+		return x.Success
 	}
 	return false
 }
 
-func (x *CheckPermissionResponse) GetMatchedGrantIds() []string {
-	if x != nil {
-		return x.MatchedGrantIds
-	}
-	return nil
-}
-
-func (x *CheckPermissionResponse) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
 // Health check
 type HealthCheckRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3900,7 +4921,7 @@ type HealthCheckRequest struct {
 
 func (x *HealthCheckRequest) Reset() {
 	*x = HealthCheckRequest{}
-	mi := &file_identity_v1_identity_proto_msgTypes[51]
+	mi := &file_identity_v1_identity_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3912,7 +4933,7 @@ func (x *HealthCheckRequest) String() string {
 func (*HealthCheckRequest) ProtoMessage() {}
 
 func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[51]
+	mi := &file_identity_v1_identity_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3925,7 +4946,7 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
 func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{51}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{67}
 }
 
 type HealthCheckResponse struct {
@@ -3939,7 +4960,7 @@ type HealthCheckResponse struct {
 
 func (x *HealthCheckResponse) Reset() {
 	*x = HealthCheckResponse{}
-	mi := &file_identity_v1_identity_proto_msgTypes[52]
+	mi := &file_identity_v1_identity_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3951,7 +4972,7 @@ func (x *HealthCheckResponse) String() string {
 func (*HealthCheckResponse) ProtoMessage() {}
 
 func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_v1_identity_proto_msgTypes[52]
+	mi := &file_identity_v1_identity_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3964,7 +4985,7 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
 func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_identity_v1_identity_proto_rawDescGZIP(), []int{52}
+	return file_identity_v1_identity_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *HealthCheckResponse) GetStatus() string {
@@ -4163,16 +5184,28 @@ const file_identity_v1_identity_proto_rawDesc = "" +
 	"\x0eGetUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
 	"\x0fGetUserResponse\x12%\n" +
-	"\x04user\x18\x01 \x01(\v2\x11.identity.v1.UserR\x04user\"\xa9\x01\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.identity.v1.UserR\x04user\"\xbd\x02\n" +
 	"\x10ListUsersRequest\x12/\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x17.identity.v1.UserStatusR\x06status\x12\x1e\n" +
 	"\vorg_unit_id\x18\x02 \x01(\tR\torgUnitId\x12\x16\n" +
 	"\x06search\x18\x03 \x01(\tR\x06search\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x05 \x01(\x05R\x06offset\"R\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\x12\x19\n" +
+	"\brole_key\x18\x06 \x01(\tR\aroleKey\x12\x1d\n" +
+	"\aenabled\x18\a \x01(\bH\x00R\aenabled\x88\x01\x01\x12#\n" +
+	"\rattribute_key\x18\b \x01(\tR\fattributeKey\x12'\n" +
+	"\x0fattribute_value\x18\t \x01(\tR\x0eattributeValueB\n" +
+	"\n" +
+	"\b_enabled\"R\n" +
 	"\x11ListUsersResponse\x12'\n" +
 	"\x05users\x18\x01 \x03(\v2\x11.identity.v1.UserR\x05users\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"\x83\x02\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"(\n" +
+	"\x14GetUsersByIdsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"a\n" +
+	"\x15GetUsersByIdsResponse\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\x11.identity.v1.UserR\x05users\x12\x1f\n" +
+	"\vmissing_ids\x18\x02 \x03(\tR\n" +
+	"missingIds\"\xc3\x02\n" +
 	"\x0fInviteUserInput\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
@@ -4183,7 +5216,9 @@ const file_identity_v1_identity_proto_rawDesc = "" +
 	"actor_type\x18\x06 \x01(\tR\tactorType\x127\n" +
 	"\n" +
 	"attributes\x18\a \x01(\v2\x17.google.protobuf.StructR\n" +
-	"attributes\"\xae\x02\n" +
+	"attributes\x12\x1b\n" +
+	"\trole_keys\x18\b \x03(\tR\broleKeys\x12!\n" +
+	"\fredirect_uri\x18\t \x01(\tR\vredirectUri\"\xae\x02\n" +
 	"\vPersonInput\x12\x1d\n" +
 	"\n" +
 	"legal_name\x18\x01 \x01(\tR\tlegalName\x12%\n" +
@@ -4209,8 +5244,30 @@ const file_identity_v1_identity_proto_rawDesc = "" +
 	"\n" +
 	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"G\n" +
 	"\x11InviteUserRequest\x122\n" +
-	"\x05input\x18\x01 \x01(\v2\x1c.identity.v1.InviteUserInputR\x05input\";\n" +
+	"\x05input\x18\x01 \x01(\v2\x1c.identity.v1.InviteUserInputR\x05input\"t\n" +
 	"\x12InviteUserResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.identity.v1.UserR\x04user\x127\n" +
+	"\n" +
+	"invitation\x18\x02 \x01(\v2\x17.identity.v1.InvitationR\n" +
+	"invitation\"\xd1\x02\n" +
+	"\n" +
+	"Invitation\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1e\n" +
+	"\vorg_unit_id\x18\x03 \x01(\tR\torgUnitId\x12\x1b\n" +
+	"\trole_keys\x18\x04 \x03(\tR\broleKeys\x12)\n" +
+	"\x10required_actions\x18\x05 \x03(\tR\x0frequiredActions\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x06 \x01(\tR\tinvitedBy\x123\n" +
+	"\asent_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"email_sent\x18\t \x01(\bR\temailSent\"?\n" +
+	"\x15DeactivateUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"?\n" +
+	"\x16DeactivateUserResponse\x12%\n" +
 	"\x04user\x18\x01 \x01(\v2\x11.identity.v1.UserR\x04user\"#\n" +
 	"\x11GetOrgUnitRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"E\n" +
@@ -4243,7 +5300,29 @@ const file_identity_v1_identity_proto_rawDesc = "" +
 	"\x06offset\x18\x03 \x01(\x05R\x06offset\"R\n" +
 	"\x11ListRolesResponse\x12'\n" +
 	"\x05roles\x18\x01 \x03(\v2\x11.identity.v1.RoleR\x05roles\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"!\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xe5\x01\n" +
+	"\bUserRole\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\brole_key\x18\x03 \x01(\tR\aroleKey\x12\x1e\n" +
+	"\vorg_unit_id\x18\x04 \x01(\tR\torgUnitId\x12\x17\n" +
+	"\award_id\x18\x05 \x01(\tR\x06wardId\x12;\n" +
+	"\vassigned_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\x12\x1f\n" +
+	"\vassigned_by\x18\a \x01(\tR\n" +
+	"assignedBy\"\x80\x01\n" +
+	"\x11AssignRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\brole_key\x18\x02 \x01(\tR\aroleKey\x12\x1e\n" +
+	"\vorg_unit_id\x18\x03 \x01(\tR\torgUnitId\x12\x17\n" +
+	"\award_id\x18\x04 \x01(\tR\x06wardId\"H\n" +
+	"\x12AssignRoleResponse\x122\n" +
+	"\tuser_role\x18\x01 \x01(\v2\x15.identity.v1.UserRoleR\buserRole\"5\n" +
+	"\x11RevokeRoleRequest\x12 \n" +
+	"\fuser_role_id\x18\x01 \x01(\tR\n" +
+	"userRoleId\".\n" +
+	"\x12RevokeRoleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"!\n" +
 	"\x0fGetGrantRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"<\n" +
 	"\x10GetGrantResponse\x12(\n" +
@@ -4281,7 +5360,33 @@ const file_identity_v1_identity_proto_rawDesc = "" +
 	"\x17CheckPermissionResponse\x12\x18\n" +
 	"\aallowed\x18\x01 \x01(\bR\aallowed\x12*\n" +
 	"\x11matched_grant_ids\x18\x02 \x03(\tR\x0fmatchedGrantIds\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x14\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xfa\x01\n" +
+	"\x06Tenant\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x14\n" +
+	"\x05realm\x18\x03 \x01(\tR\x05realm\x12\x1b\n" +
+	"\tclient_id\x18\x04 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x05 \x01(\tR\fclientSecret\x12\x14\n" +
+	"\x05roles\x18\x06 \x03(\tR\x05roles\x121\n" +
+	"\torg_units\x18\a \x03(\v2\x14.identity.v1.OrgUnitR\borgUnits\x12\x18\n" +
+	"\aenabled\x18\b \x01(\bR\aenabled\"\xb4\x01\n" +
+	"\x12OnboardTenantInput\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12!\n" +
+	"\fredirect_uri\x18\x03 \x01(\tR\vredirectUri\x12\x1d\n" +
+	"\n" +
+	"web_origin\x18\x04 \x01(\tR\twebOrigin\x12%\n" +
+	"\x0edefault_locale\x18\x05 \x01(\tR\rdefaultLocale\"M\n" +
+	"\x14OnboardTenantRequest\x125\n" +
+	"\x05input\x18\x01 \x01(\v2\x1f.identity.v1.OnboardTenantInputR\x05input\"^\n" +
+	"\x15OnboardTenantResponse\x12+\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x13.identity.v1.TenantR\x06tenant\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"N\n" +
+	"\x15OffboardTenantRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12!\n" +
+	"\fconfirm_slug\x18\x02 \x01(\tR\vconfirmSlug\"2\n" +
+	"\x16OffboardTenantResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x14\n" +
 	"\x12HealthCheckRequest\"\x81\x01\n" +
 	"\x13HealthCheckResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
@@ -4360,24 +5465,32 @@ const file_identity_v1_identity_proto_rawDesc = "" +
 	"\x1aADDRESS_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19ADDRESS_STATUS_UNVERIFIED\x10\x01\x12\x1b\n" +
 	"\x17ADDRESS_STATUS_VERIFIED\x10\x02\x12\x1b\n" +
-	"\x17ADDRESS_STATUS_REJECTED\x10\x032\xdd\b\n" +
+	"\x17ADDRESS_STATUS_REJECTED\x10\x032\xe1\f\n" +
 	"\x0fIdentityService\x12>\n" +
 	"\x05GetMe\x12\x19.identity.v1.GetMeRequest\x1a\x1a.identity.v1.GetMeResponse\x12D\n" +
 	"\aGetUser\x12\x1b.identity.v1.GetUserRequest\x1a\x1c.identity.v1.GetUserResponse\x12J\n" +
-	"\tListUsers\x12\x1d.identity.v1.ListUsersRequest\x1a\x1e.identity.v1.ListUsersResponse\x12M\n" +
+	"\tListUsers\x12\x1d.identity.v1.ListUsersRequest\x1a\x1e.identity.v1.ListUsersResponse\x12V\n" +
+	"\rGetUsersByIds\x12!.identity.v1.GetUsersByIdsRequest\x1a\".identity.v1.GetUsersByIdsResponse\x12M\n" +
 	"\n" +
-	"InviteUser\x12\x1e.identity.v1.InviteUserRequest\x1a\x1f.identity.v1.InviteUserResponse\x12M\n" +
+	"InviteUser\x12\x1e.identity.v1.InviteUserRequest\x1a\x1f.identity.v1.InviteUserResponse\x12Y\n" +
+	"\x0eDeactivateUser\x12\".identity.v1.DeactivateUserRequest\x1a#.identity.v1.DeactivateUserResponse\x12M\n" +
 	"\n" +
 	"GetOrgUnit\x12\x1e.identity.v1.GetOrgUnitRequest\x1a\x1f.identity.v1.GetOrgUnitResponse\x12S\n" +
 	"\fListOrgUnits\x12 .identity.v1.ListOrgUnitsRequest\x1a!.identity.v1.ListOrgUnitsResponse\x12V\n" +
 	"\rCreateOrgUnit\x12!.identity.v1.CreateOrgUnitRequest\x1a\".identity.v1.CreateOrgUnitResponse\x12D\n" +
 	"\aGetRole\x12\x1b.identity.v1.GetRoleRequest\x1a\x1c.identity.v1.GetRoleResponse\x12J\n" +
-	"\tListRoles\x12\x1d.identity.v1.ListRolesRequest\x1a\x1e.identity.v1.ListRolesResponse\x12G\n" +
+	"\tListRoles\x12\x1d.identity.v1.ListRolesRequest\x1a\x1e.identity.v1.ListRolesResponse\x12M\n" +
+	"\n" +
+	"AssignRole\x12\x1e.identity.v1.AssignRoleRequest\x1a\x1f.identity.v1.AssignRoleResponse\x12M\n" +
+	"\n" +
+	"RevokeRole\x12\x1e.identity.v1.RevokeRoleRequest\x1a\x1f.identity.v1.RevokeRoleResponse\x12G\n" +
 	"\bGetGrant\x12\x1c.identity.v1.GetGrantRequest\x1a\x1d.identity.v1.GetGrantResponse\x12M\n" +
 	"\n" +
 	"ListGrants\x12\x1e.identity.v1.ListGrantsRequest\x1a\x1f.identity.v1.ListGrantsResponse\x12S\n" +
 	"\fRequestGrant\x12 .identity.v1.RequestGrantRequest\x1a!.identity.v1.RequestGrantResponse\x12\\\n" +
-	"\x0fCheckPermission\x12#.identity.v1.CheckPermissionRequest\x1a$.identity.v1.CheckPermissionResponse\x12P\n" +
+	"\x0fCheckPermission\x12#.identity.v1.CheckPermissionRequest\x1a$.identity.v1.CheckPermissionResponse\x12V\n" +
+	"\rOnboardTenant\x12!.identity.v1.OnboardTenantRequest\x1a\".identity.v1.OnboardTenantResponse\x12Y\n" +
+	"\x0eOffboardTenant\x12\".identity.v1.OffboardTenantRequest\x1a#.identity.v1.OffboardTenantResponse\x12P\n" +
 	"\vHealthCheck\x12\x1f.identity.v1.HealthCheckRequest\x1a .identity.v1.HealthCheckResponseB?Z=git.ninjainfosys.com/ePalika/proto/gen/identity/v1;identityv1b\x06proto3"
 
 var (
@@ -4393,7 +5506,7 @@ func file_identity_v1_identity_proto_rawDescGZIP() []byte {
 }
 
 var file_identity_v1_identity_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_identity_v1_identity_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
+var file_identity_v1_identity_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
 var file_identity_v1_identity_proto_goTypes = []any{
 	(UserStatus)(0),                 // 0: identity.v1.UserStatus
 	(GrantStatus)(0),                // 1: identity.v1.GrantStatus
@@ -4426,165 +5539,203 @@ var file_identity_v1_identity_proto_goTypes = []any{
 	(*GetUserResponse)(nil),         // 28: identity.v1.GetUserResponse
 	(*ListUsersRequest)(nil),        // 29: identity.v1.ListUsersRequest
 	(*ListUsersResponse)(nil),       // 30: identity.v1.ListUsersResponse
-	(*InviteUserInput)(nil),         // 31: identity.v1.InviteUserInput
-	(*PersonInput)(nil),             // 32: identity.v1.PersonInput
-	(*ContactInput)(nil),            // 33: identity.v1.ContactInput
-	(*AddressInput)(nil),            // 34: identity.v1.AddressInput
-	(*GovIdRefInput)(nil),           // 35: identity.v1.GovIdRefInput
-	(*InviteUserRequest)(nil),       // 36: identity.v1.InviteUserRequest
-	(*InviteUserResponse)(nil),      // 37: identity.v1.InviteUserResponse
-	(*GetOrgUnitRequest)(nil),       // 38: identity.v1.GetOrgUnitRequest
-	(*GetOrgUnitResponse)(nil),      // 39: identity.v1.GetOrgUnitResponse
-	(*ListOrgUnitsRequest)(nil),     // 40: identity.v1.ListOrgUnitsRequest
-	(*ListOrgUnitsResponse)(nil),    // 41: identity.v1.ListOrgUnitsResponse
-	(*CreateOrgUnitInput)(nil),      // 42: identity.v1.CreateOrgUnitInput
-	(*CreateOrgUnitRequest)(nil),    // 43: identity.v1.CreateOrgUnitRequest
-	(*CreateOrgUnitResponse)(nil),   // 44: identity.v1.CreateOrgUnitResponse
-	(*GetRoleRequest)(nil),          // 45: identity.v1.GetRoleRequest
-	(*GetRoleResponse)(nil),         // 46: identity.v1.GetRoleResponse
-	(*ListRolesRequest)(nil),        // 47: identity.v1.ListRolesRequest
-	(*ListRolesResponse)(nil),       // 48: identity.v1.ListRolesResponse
-	(*GetGrantRequest)(nil),         // 49: identity.v1.GetGrantRequest
-	(*GetGrantResponse)(nil),        // 50: identity.v1.GetGrantResponse
-	(*ListGrantsRequest)(nil),       // 51: identity.v1.ListGrantsRequest
-	(*ListGrantsResponse)(nil),      // 52: identity.v1.ListGrantsResponse
-	(*RequestGrantInput)(nil),       // 53: identity.v1.RequestGrantInput
-	(*RequestGrantRequest)(nil),     // 54: identity.v1.RequestGrantRequest
-	(*RequestGrantResponse)(nil),    // 55: identity.v1.RequestGrantResponse
-	(*PermissionCheckInput)(nil),    // 56: identity.v1.PermissionCheckInput
-	(*CheckPermissionRequest)(nil),  // 57: identity.v1.CheckPermissionRequest
-	(*CheckPermissionResponse)(nil), // 58: identity.v1.CheckPermissionResponse
-	(*HealthCheckRequest)(nil),      // 59: identity.v1.HealthCheckRequest
-	(*HealthCheckResponse)(nil),     // 60: identity.v1.HealthCheckResponse
-	(*structpb.Struct)(nil),         // 61: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),   // 62: google.protobuf.Timestamp
+	(*GetUsersByIdsRequest)(nil),    // 31: identity.v1.GetUsersByIdsRequest
+	(*GetUsersByIdsResponse)(nil),   // 32: identity.v1.GetUsersByIdsResponse
+	(*InviteUserInput)(nil),         // 33: identity.v1.InviteUserInput
+	(*PersonInput)(nil),             // 34: identity.v1.PersonInput
+	(*ContactInput)(nil),            // 35: identity.v1.ContactInput
+	(*AddressInput)(nil),            // 36: identity.v1.AddressInput
+	(*GovIdRefInput)(nil),           // 37: identity.v1.GovIdRefInput
+	(*InviteUserRequest)(nil),       // 38: identity.v1.InviteUserRequest
+	(*InviteUserResponse)(nil),      // 39: identity.v1.InviteUserResponse
+	(*Invitation)(nil),              // 40: identity.v1.Invitation
+	(*DeactivateUserRequest)(nil),   // 41: identity.v1.DeactivateUserRequest
+	(*DeactivateUserResponse)(nil),  // 42: identity.v1.DeactivateUserResponse
+	(*GetOrgUnitRequest)(nil),       // 43: identity.v1.GetOrgUnitRequest
+	(*GetOrgUnitResponse)(nil),      // 44: identity.v1.GetOrgUnitResponse
+	(*ListOrgUnitsRequest)(nil),     // 45: identity.v1.ListOrgUnitsRequest
+	(*ListOrgUnitsResponse)(nil),    // 46: identity.v1.ListOrgUnitsResponse
+	(*CreateOrgUnitInput)(nil),      // 47: identity.v1.CreateOrgUnitInput
+	(*CreateOrgUnitRequest)(nil),    // 48: identity.v1.CreateOrgUnitRequest
+	(*CreateOrgUnitResponse)(nil),   // 49: identity.v1.CreateOrgUnitResponse
+	(*GetRoleRequest)(nil),          // 50: identity.v1.GetRoleRequest
+	(*GetRoleResponse)(nil),         // 51: identity.v1.GetRoleResponse
+	(*ListRolesRequest)(nil),        // 52: identity.v1.ListRolesRequest
+	(*ListRolesResponse)(nil),       // 53: identity.v1.ListRolesResponse
+	(*UserRole)(nil),                // 54: identity.v1.UserRole
+	(*AssignRoleRequest)(nil),       // 55: identity.v1.AssignRoleRequest
+	(*AssignRoleResponse)(nil),      // 56: identity.v1.AssignRoleResponse
+	(*RevokeRoleRequest)(nil),       // 57: identity.v1.RevokeRoleRequest
+	(*RevokeRoleResponse)(nil),      // 58: identity.v1.RevokeRoleResponse
+	(*GetGrantRequest)(nil),         // 59: identity.v1.GetGrantRequest
+	(*GetGrantResponse)(nil),        // 60: identity.v1.GetGrantResponse
+	(*ListGrantsRequest)(nil),       // 61: identity.v1.ListGrantsRequest
+	(*ListGrantsResponse)(nil),      // 62: identity.v1.ListGrantsResponse
+	(*RequestGrantInput)(nil),       // 63: identity.v1.RequestGrantInput
+	(*RequestGrantRequest)(nil),     // 64: identity.v1.RequestGrantRequest
+	(*RequestGrantResponse)(nil),    // 65: identity.v1.RequestGrantResponse
+	(*PermissionCheckInput)(nil),    // 66: identity.v1.PermissionCheckInput
+	(*CheckPermissionRequest)(nil),  // 67: identity.v1.CheckPermissionRequest
+	(*CheckPermissionResponse)(nil), // 68: identity.v1.CheckPermissionResponse
+	(*Tenant)(nil),                  // 69: identity.v1.Tenant
+	(*OnboardTenantInput)(nil),      // 70: identity.v1.OnboardTenantInput
+	(*OnboardTenantRequest)(nil),    // 71: identity.v1.OnboardTenantRequest
+	(*OnboardTenantResponse)(nil),   // 72: identity.v1.OnboardTenantResponse
+	(*OffboardTenantRequest)(nil),   // 73: identity.v1.OffboardTenantRequest
+	(*OffboardTenantResponse)(nil),  // 74: identity.v1.OffboardTenantResponse
+	(*HealthCheckRequest)(nil),      // 75: identity.v1.HealthCheckRequest
+	(*HealthCheckResponse)(nil),     // 76: identity.v1.HealthCheckResponse
+	(*structpb.Struct)(nil),         // 77: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),   // 78: google.protobuf.Timestamp
 }
 var file_identity_v1_identity_proto_depIdxs = []int32{
 	9,   // 0: identity.v1.User.person:type_name -> identity.v1.Person
 	0,   // 1: identity.v1.User.status:type_name -> identity.v1.UserStatus
-	61,  // 2: identity.v1.User.attributes:type_name -> google.protobuf.Struct
-	62,  // 3: identity.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	62,  // 4: identity.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	77,  // 2: identity.v1.User.attributes:type_name -> google.protobuf.Struct
+	78,  // 3: identity.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 4: identity.v1.User.updated_at:type_name -> google.protobuf.Timestamp
 	10,  // 5: identity.v1.Person.gov_id_refs:type_name -> identity.v1.GovIdRef
 	11,  // 6: identity.v1.Person.contacts:type_name -> identity.v1.Contact
 	12,  // 7: identity.v1.Person.primary_address:type_name -> identity.v1.Address
-	62,  // 8: identity.v1.Person.created_at:type_name -> google.protobuf.Timestamp
-	62,  // 9: identity.v1.Person.updated_at:type_name -> google.protobuf.Timestamp
-	62,  // 10: identity.v1.GovIdRef.expires_at:type_name -> google.protobuf.Timestamp
-	62,  // 11: identity.v1.Contact.verified_at:type_name -> google.protobuf.Timestamp
-	61,  // 12: identity.v1.Address.normalized:type_name -> google.protobuf.Struct
+	78,  // 8: identity.v1.Person.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 9: identity.v1.Person.updated_at:type_name -> google.protobuf.Timestamp
+	78,  // 10: identity.v1.GovIdRef.expires_at:type_name -> google.protobuf.Timestamp
+	78,  // 11: identity.v1.Contact.verified_at:type_name -> google.protobuf.Timestamp
+	77,  // 12: identity.v1.Address.normalized:type_name -> google.protobuf.Struct
 	13,  // 13: identity.v1.Address.geo:type_name -> identity.v1.GeoPoint
 	7,   // 14: identity.v1.Address.status:type_name -> identity.v1.AddressStatus
 	14,  // 15: identity.v1.Address.evidence:type_name -> identity.v1.AddressEvidence
-	62,  // 16: identity.v1.Address.created_at:type_name -> google.protobuf.Timestamp
-	62,  // 17: identity.v1.Address.verified_at:type_name -> google.protobuf.Timestamp
-	62,  // 18: identity.v1.AddressEvidence.uploaded_at:type_name -> google.protobuf.Timestamp
+	78,  // 16: identity.v1.Address.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 17: identity.v1.Address.verified_at:type_name -> google.protobuf.Timestamp
+	78,  // 18: identity.v1.AddressEvidence.uploaded_at:type_name -> google.protobuf.Timestamp
 	3,   // 19: identity.v1.OrgUnit.type:type_name -> identity.v1.OrgUnitType
 	15,  // 20: identity.v1.OrgUnit.parent:type_name -> identity.v1.OrgUnit
 	15,  // 21: identity.v1.OrgUnit.children:type_name -> identity.v1.OrgUnit
-	62,  // 22: identity.v1.OrgUnit.created_at:type_name -> google.protobuf.Timestamp
-	62,  // 23: identity.v1.OrgUnit.updated_at:type_name -> google.protobuf.Timestamp
+	78,  // 22: identity.v1.OrgUnit.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 23: identity.v1.OrgUnit.updated_at:type_name -> google.protobuf.Timestamp
 	17,  // 24: identity.v1.Role.permissions:type_name -> identity.v1.Permission
 	18,  // 25: identity.v1.Role.constraints:type_name -> identity.v1.RoleConstraints
-	62,  // 26: identity.v1.Role.created_at:type_name -> google.protobuf.Timestamp
-	62,  // 27: identity.v1.Role.updated_at:type_name -> google.protobuf.Timestamp
+	78,  // 26: identity.v1.Role.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 27: identity.v1.Role.updated_at:type_name -> google.protobuf.Timestamp
 	3,   // 28: identity.v1.RoleConstraints.scope_types:type_name -> identity.v1.OrgUnitType
 	8,   // 29: identity.v1.Group.members:type_name -> identity.v1.User
-	62,  // 30: identity.v1.Group.created_at:type_name -> google.protobuf.Timestamp
-	62,  // 31: identity.v1.Group.updated_at:type_name -> google.protobuf.Timestamp
+	78,  // 30: identity.v1.Group.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 31: identity.v1.Group.updated_at:type_name -> google.protobuf.Timestamp
 	16,  // 32: identity.v1.Grant.role:type_name -> identity.v1.Role
 	21,  // 33: identity.v1.Grant.subject:type_name -> identity.v1.GrantSubject
 	22,  // 34: identity.v1.Grant.scope:type_name -> identity.v1.ScopeRef
 	1,   // 35: identity.v1.Grant.status:type_name -> identity.v1.GrantStatus
 	8,   // 36: identity.v1.Grant.requested_by:type_name -> identity.v1.User
-	62,  // 37: identity.v1.Grant.requested_at:type_name -> google.protobuf.Timestamp
+	78,  // 37: identity.v1.Grant.requested_at:type_name -> google.protobuf.Timestamp
 	8,   // 38: identity.v1.Grant.decided_by:type_name -> identity.v1.User
-	62,  // 39: identity.v1.Grant.decided_at:type_name -> google.protobuf.Timestamp
-	62,  // 40: identity.v1.Grant.start_at:type_name -> google.protobuf.Timestamp
-	62,  // 41: identity.v1.Grant.end_at:type_name -> google.protobuf.Timestamp
-	61,  // 42: identity.v1.Grant.conditions:type_name -> google.protobuf.Struct
-	62,  // 43: identity.v1.Grant.created_at:type_name -> google.protobuf.Timestamp
-	62,  // 44: identity.v1.Grant.updated_at:type_name -> google.protobuf.Timestamp
+	78,  // 39: identity.v1.Grant.decided_at:type_name -> google.protobuf.Timestamp
+	78,  // 40: identity.v1.Grant.start_at:type_name -> google.protobuf.Timestamp
+	78,  // 41: identity.v1.Grant.end_at:type_name -> google.protobuf.Timestamp
+	77,  // 42: identity.v1.Grant.conditions:type_name -> google.protobuf.Struct
+	78,  // 43: identity.v1.Grant.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 44: identity.v1.Grant.updated_at:type_name -> google.protobuf.Timestamp
 	8,   // 45: identity.v1.GrantSubject.user:type_name -> identity.v1.User
 	19,  // 46: identity.v1.GrantSubject.group:type_name -> identity.v1.Group
 	15,  // 47: identity.v1.ScopeRef.org_unit:type_name -> identity.v1.OrgUnit
 	20,  // 48: identity.v1.Delegation.from_grant:type_name -> identity.v1.Grant
 	8,   // 49: identity.v1.Delegation.to_user:type_name -> identity.v1.User
 	2,   // 50: identity.v1.Delegation.status:type_name -> identity.v1.DelegationStatus
-	62,  // 51: identity.v1.Delegation.start_at:type_name -> google.protobuf.Timestamp
-	62,  // 52: identity.v1.Delegation.end_at:type_name -> google.protobuf.Timestamp
-	61,  // 53: identity.v1.Delegation.constraints:type_name -> google.protobuf.Struct
-	62,  // 54: identity.v1.Delegation.created_at:type_name -> google.protobuf.Timestamp
-	62,  // 55: identity.v1.Delegation.ended_at:type_name -> google.protobuf.Timestamp
+	78,  // 51: identity.v1.Delegation.start_at:type_name -> google.protobuf.Timestamp
+	78,  // 52: identity.v1.Delegation.end_at:type_name -> google.protobuf.Timestamp
+	77,  // 53: identity.v1.Delegation.constraints:type_name -> google.protobuf.Struct
+	78,  // 54: identity.v1.Delegation.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 55: identity.v1.Delegation.ended_at:type_name -> google.protobuf.Timestamp
 	5,   // 56: identity.v1.Credential.type:type_name -> identity.v1.CredentialType
 	6,   // 57: identity.v1.Credential.status:type_name -> identity.v1.CredentialStatus
-	62,  // 58: identity.v1.Credential.created_at:type_name -> google.protobuf.Timestamp
-	62,  // 59: identity.v1.Credential.last_used_at:type_name -> google.protobuf.Timestamp
-	62,  // 60: identity.v1.Credential.rotated_at:type_name -> google.protobuf.Timestamp
-	62,  // 61: identity.v1.Credential.expires_at:type_name -> google.protobuf.Timestamp
+	78,  // 58: identity.v1.Credential.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 59: identity.v1.Credential.last_used_at:type_name -> google.protobuf.Timestamp
+	78,  // 60: identity.v1.Credential.rotated_at:type_name -> google.protobuf.Timestamp
+	78,  // 61: identity.v1.Credential.expires_at:type_name -> google.protobuf.Timestamp
 	8,   // 62: identity.v1.GetMeResponse.user:type_name -> identity.v1.User
 	8,   // 63: identity.v1.GetUserResponse.user:type_name -> identity.v1.User
 	0,   // 64: identity.v1.ListUsersRequest.status:type_name -> identity.v1.UserStatus
 	8,   // 65: identity.v1.ListUsersResponse.users:type_name -> identity.v1.User
-	32,  // 66: identity.v1.InviteUserInput.person:type_name -> identity.v1.PersonInput
-	61,  // 67: identity.v1.InviteUserInput.attributes:type_name -> google.protobuf.Struct
-	33,  // 68: identity.v1.PersonInput.contacts:type_name -> identity.v1.ContactInput
-	34,  // 69: identity.v1.PersonInput.primary_address:type_name -> identity.v1.AddressInput
-	35,  // 70: identity.v1.PersonInput.gov_id_refs:type_name -> identity.v1.GovIdRefInput
-	61,  // 71: identity.v1.AddressInput.normalized:type_name -> google.protobuf.Struct
-	62,  // 72: identity.v1.GovIdRefInput.expires_at:type_name -> google.protobuf.Timestamp
-	31,  // 73: identity.v1.InviteUserRequest.input:type_name -> identity.v1.InviteUserInput
-	8,   // 74: identity.v1.InviteUserResponse.user:type_name -> identity.v1.User
-	15,  // 75: identity.v1.GetOrgUnitResponse.org_unit:type_name -> identity.v1.OrgUnit
-	3,   // 76: identity.v1.ListOrgUnitsRequest.type:type_name -> identity.v1.OrgUnitType
-	15,  // 77: identity.v1.ListOrgUnitsResponse.org_units:type_name -> identity.v1.OrgUnit
-	3,   // 78: identity.v1.CreateOrgUnitInput.type:type_name -> identity.v1.OrgUnitType
-	42,  // 79: identity.v1.CreateOrgUnitRequest.input:type_name -> identity.v1.CreateOrgUnitInput
-	15,  // 80: identity.v1.CreateOrgUnitResponse.org_unit:type_name -> identity.v1.OrgUnit
-	16,  // 81: identity.v1.GetRoleResponse.role:type_name -> identity.v1.Role
-	16,  // 82: identity.v1.ListRolesResponse.roles:type_name -> identity.v1.Role
-	20,  // 83: identity.v1.GetGrantResponse.grant:type_name -> identity.v1.Grant
-	1,   // 84: identity.v1.ListGrantsRequest.status:type_name -> identity.v1.GrantStatus
-	20,  // 85: identity.v1.ListGrantsResponse.grants:type_name -> identity.v1.Grant
-	62,  // 86: identity.v1.RequestGrantInput.start_at:type_name -> google.protobuf.Timestamp
-	62,  // 87: identity.v1.RequestGrantInput.end_at:type_name -> google.protobuf.Timestamp
-	53,  // 88: identity.v1.RequestGrantRequest.input:type_name -> identity.v1.RequestGrantInput
-	20,  // 89: identity.v1.RequestGrantResponse.grant:type_name -> identity.v1.Grant
-	56,  // 90: identity.v1.CheckPermissionRequest.input:type_name -> identity.v1.PermissionCheckInput
-	62,  // 91: identity.v1.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
-	25,  // 92: identity.v1.IdentityService.GetMe:input_type -> identity.v1.GetMeRequest
-	27,  // 93: identity.v1.IdentityService.GetUser:input_type -> identity.v1.GetUserRequest
-	29,  // 94: identity.v1.IdentityService.ListUsers:input_type -> identity.v1.ListUsersRequest
-	36,  // 95: identity.v1.IdentityService.InviteUser:input_type -> identity.v1.InviteUserRequest
-	38,  // 96: identity.v1.IdentityService.GetOrgUnit:input_type -> identity.v1.GetOrgUnitRequest
-	40,  // 97: identity.v1.IdentityService.ListOrgUnits:input_type -> identity.v1.ListOrgUnitsRequest
-	43,  // 98: identity.v1.IdentityService.CreateOrgUnit:input_type -> identity.v1.CreateOrgUnitRequest
-	45,  // 99: identity.v1.IdentityService.GetRole:input_type -> identity.v1.GetRoleRequest
-	47,  // 100: identity.v1.IdentityService.ListRoles:input_type -> identity.v1.ListRolesRequest
-	49,  // 101: identity.v1.IdentityService.GetGrant:input_type -> identity.v1.GetGrantRequest
-	51,  // 102: identity.v1.IdentityService.ListGrants:input_type -> identity.v1.ListGrantsRequest
-	54,  // 103: identity.v1.IdentityService.RequestGrant:input_type -> identity.v1.RequestGrantRequest
-	57,  // 104: identity.v1.IdentityService.CheckPermission:input_type -> identity.v1.CheckPermissionRequest
-	59,  // 105: identity.v1.IdentityService.HealthCheck:input_type -> identity.v1.HealthCheckRequest
-	26,  // 106: identity.v1.IdentityService.GetMe:output_type -> identity.v1.GetMeResponse
-	28,  // 107: identity.v1.IdentityService.GetUser:output_type -> identity.v1.GetUserResponse
-	30,  // 108: identity.v1.IdentityService.ListUsers:output_type -> identity.v1.ListUsersResponse
-	37,  // 109: identity.v1.IdentityService.InviteUser:output_type -> identity.v1.InviteUserResponse
-	39,  // 110: identity.v1.IdentityService.GetOrgUnit:output_type -> identity.v1.GetOrgUnitResponse
-	41,  // 111: identity.v1.IdentityService.ListOrgUnits:output_type -> identity.v1.ListOrgUnitsResponse
-	44,  // 112: identity.v1.IdentityService.CreateOrgUnit:output_type -> identity.v1.CreateOrgUnitResponse
-	46,  // 113: identity.v1.IdentityService.GetRole:output_type -> identity.v1.GetRoleResponse
-	48,  // 114: identity.v1.IdentityService.ListRoles:output_type -> identity.v1.ListRolesResponse
-	50,  // 115: identity.v1.IdentityService.GetGrant:output_type -> identity.v1.GetGrantResponse
-	52,  // 116: identity.v1.IdentityService.ListGrants:output_type -> identity.v1.ListGrantsResponse
-	55,  // 117: identity.v1.IdentityService.RequestGrant:output_type -> identity.v1.RequestGrantResponse
-	58,  // 118: identity.v1.IdentityService.CheckPermission:output_type -> identity.v1.CheckPermissionResponse
-	60,  // 119: identity.v1.IdentityService.HealthCheck:output_type -> identity.v1.HealthCheckResponse
-	106, // [106:120] is the sub-list for method output_type
-	92,  // [92:106] is the sub-list for method input_type
-	92,  // [92:92] is the sub-list for extension type_name
-	92,  // [92:92] is the sub-list for extension extendee
-	0,   // [0:92] is the sub-list for field type_name
+	8,   // 66: identity.v1.GetUsersByIdsResponse.users:type_name -> identity.v1.User
+	34,  // 67: identity.v1.InviteUserInput.person:type_name -> identity.v1.PersonInput
+	77,  // 68: identity.v1.InviteUserInput.attributes:type_name -> google.protobuf.Struct
+	35,  // 69: identity.v1.PersonInput.contacts:type_name -> identity.v1.ContactInput
+	36,  // 70: identity.v1.PersonInput.primary_address:type_name -> identity.v1.AddressInput
+	37,  // 71: identity.v1.PersonInput.gov_id_refs:type_name -> identity.v1.GovIdRefInput
+	77,  // 72: identity.v1.AddressInput.normalized:type_name -> google.protobuf.Struct
+	78,  // 73: identity.v1.GovIdRefInput.expires_at:type_name -> google.protobuf.Timestamp
+	33,  // 74: identity.v1.InviteUserRequest.input:type_name -> identity.v1.InviteUserInput
+	8,   // 75: identity.v1.InviteUserResponse.user:type_name -> identity.v1.User
+	40,  // 76: identity.v1.InviteUserResponse.invitation:type_name -> identity.v1.Invitation
+	78,  // 77: identity.v1.Invitation.sent_at:type_name -> google.protobuf.Timestamp
+	78,  // 78: identity.v1.Invitation.expires_at:type_name -> google.protobuf.Timestamp
+	8,   // 79: identity.v1.DeactivateUserResponse.user:type_name -> identity.v1.User
+	15,  // 80: identity.v1.GetOrgUnitResponse.org_unit:type_name -> identity.v1.OrgUnit
+	3,   // 81: identity.v1.ListOrgUnitsRequest.type:type_name -> identity.v1.OrgUnitType
+	15,  // 82: identity.v1.ListOrgUnitsResponse.org_units:type_name -> identity.v1.OrgUnit
+	3,   // 83: identity.v1.CreateOrgUnitInput.type:type_name -> identity.v1.OrgUnitType
+	47,  // 84: identity.v1.CreateOrgUnitRequest.input:type_name -> identity.v1.CreateOrgUnitInput
+	15,  // 85: identity.v1.CreateOrgUnitResponse.org_unit:type_name -> identity.v1.OrgUnit
+	16,  // 86: identity.v1.GetRoleResponse.role:type_name -> identity.v1.Role
+	16,  // 87: identity.v1.ListRolesResponse.roles:type_name -> identity.v1.Role
+	78,  // 88: identity.v1.UserRole.assigned_at:type_name -> google.protobuf.Timestamp
+	54,  // 89: identity.v1.AssignRoleResponse.user_role:type_name -> identity.v1.UserRole
+	20,  // 90: identity.v1.GetGrantResponse.grant:type_name -> identity.v1.Grant
+	1,   // 91: identity.v1.ListGrantsRequest.status:type_name -> identity.v1.GrantStatus
+	20,  // 92: identity.v1.ListGrantsResponse.grants:type_name -> identity.v1.Grant
+	78,  // 93: identity.v1.RequestGrantInput.start_at:type_name -> google.protobuf.Timestamp
+	78,  // 94: identity.v1.RequestGrantInput.end_at:type_name -> google.protobuf.Timestamp
+	63,  // 95: identity.v1.RequestGrantRequest.input:type_name -> identity.v1.RequestGrantInput
+	20,  // 96: identity.v1.RequestGrantResponse.grant:type_name -> identity.v1.Grant
+	66,  // 97: identity.v1.CheckPermissionRequest.input:type_name -> identity.v1.PermissionCheckInput
+	15,  // 98: identity.v1.Tenant.org_units:type_name -> identity.v1.OrgUnit
+	70,  // 99: identity.v1.OnboardTenantRequest.input:type_name -> identity.v1.OnboardTenantInput
+	69,  // 100: identity.v1.OnboardTenantResponse.tenant:type_name -> identity.v1.Tenant
+	78,  // 101: identity.v1.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
+	25,  // 102: identity.v1.IdentityService.GetMe:input_type -> identity.v1.GetMeRequest
+	27,  // 103: identity.v1.IdentityService.GetUser:input_type -> identity.v1.GetUserRequest
+	29,  // 104: identity.v1.IdentityService.ListUsers:input_type -> identity.v1.ListUsersRequest
+	31,  // 105: identity.v1.IdentityService.GetUsersByIds:input_type -> identity.v1.GetUsersByIdsRequest
+	38,  // 106: identity.v1.IdentityService.InviteUser:input_type -> identity.v1.InviteUserRequest
+	41,  // 107: identity.v1.IdentityService.DeactivateUser:input_type -> identity.v1.DeactivateUserRequest
+	43,  // 108: identity.v1.IdentityService.GetOrgUnit:input_type -> identity.v1.GetOrgUnitRequest
+	45,  // 109: identity.v1.IdentityService.ListOrgUnits:input_type -> identity.v1.ListOrgUnitsRequest
+	48,  // 110: identity.v1.IdentityService.CreateOrgUnit:input_type -> identity.v1.CreateOrgUnitRequest
+	50,  // 111: identity.v1.IdentityService.GetRole:input_type -> identity.v1.GetRoleRequest
+	52,  // 112: identity.v1.IdentityService.ListRoles:input_type -> identity.v1.ListRolesRequest
+	55,  // 113: identity.v1.IdentityService.AssignRole:input_type -> identity.v1.AssignRoleRequest
+	57,  // 114: identity.v1.IdentityService.RevokeRole:input_type -> identity.v1.RevokeRoleRequest
+	59,  // 115: identity.v1.IdentityService.GetGrant:input_type -> identity.v1.GetGrantRequest
+	61,  // 116: identity.v1.IdentityService.ListGrants:input_type -> identity.v1.ListGrantsRequest
+	64,  // 117: identity.v1.IdentityService.RequestGrant:input_type -> identity.v1.RequestGrantRequest
+	67,  // 118: identity.v1.IdentityService.CheckPermission:input_type -> identity.v1.CheckPermissionRequest
+	71,  // 119: identity.v1.IdentityService.OnboardTenant:input_type -> identity.v1.OnboardTenantRequest
+	73,  // 120: identity.v1.IdentityService.OffboardTenant:input_type -> identity.v1.OffboardTenantRequest
+	75,  // 121: identity.v1.IdentityService.HealthCheck:input_type -> identity.v1.HealthCheckRequest
+	26,  // 122: identity.v1.IdentityService.GetMe:output_type -> identity.v1.GetMeResponse
+	28,  // 123: identity.v1.IdentityService.GetUser:output_type -> identity.v1.GetUserResponse
+	30,  // 124: identity.v1.IdentityService.ListUsers:output_type -> identity.v1.ListUsersResponse
+	32,  // 125: identity.v1.IdentityService.GetUsersByIds:output_type -> identity.v1.GetUsersByIdsResponse
+	39,  // 126: identity.v1.IdentityService.InviteUser:output_type -> identity.v1.InviteUserResponse
+	42,  // 127: identity.v1.IdentityService.DeactivateUser:output_type -> identity.v1.DeactivateUserResponse
+	44,  // 128: identity.v1.IdentityService.GetOrgUnit:output_type -> identity.v1.GetOrgUnitResponse
+	46,  // 129: identity.v1.IdentityService.ListOrgUnits:output_type -> identity.v1.ListOrgUnitsResponse
+	49,  // 130: identity.v1.IdentityService.CreateOrgUnit:output_type -> identity.v1.CreateOrgUnitResponse
+	51,  // 131: identity.v1.IdentityService.GetRole:output_type -> identity.v1.GetRoleResponse
+	53,  // 132: identity.v1.IdentityService.ListRoles:output_type -> identity.v1.ListRolesResponse
+	56,  // 133: identity.v1.IdentityService.AssignRole:output_type -> identity.v1.AssignRoleResponse
+	58,  // 134: identity.v1.IdentityService.RevokeRole:output_type -> identity.v1.RevokeRoleResponse
+	60,  // 135: identity.v1.IdentityService.GetGrant:output_type -> identity.v1.GetGrantResponse
+	62,  // 136: identity.v1.IdentityService.ListGrants:output_type -> identity.v1.ListGrantsResponse
+	65,  // 137: identity.v1.IdentityService.RequestGrant:output_type -> identity.v1.RequestGrantResponse
+	68,  // 138: identity.v1.IdentityService.CheckPermission:output_type -> identity.v1.CheckPermissionResponse
+	72,  // 139: identity.v1.IdentityService.OnboardTenant:output_type -> identity.v1.OnboardTenantResponse
+	74,  // 140: identity.v1.IdentityService.OffboardTenant:output_type -> identity.v1.OffboardTenantResponse
+	76,  // 141: identity.v1.IdentityService.HealthCheck:output_type -> identity.v1.HealthCheckResponse
+	122, // [122:142] is the sub-list for method output_type
+	102, // [102:122] is the sub-list for method input_type
+	102, // [102:102] is the sub-list for extension type_name
+	102, // [102:102] is the sub-list for extension extendee
+	0,   // [0:102] is the sub-list for field type_name
 }
 
 func init() { file_identity_v1_identity_proto_init() }
@@ -4592,13 +5743,14 @@ func file_identity_v1_identity_proto_init() {
 	if File_identity_v1_identity_proto != nil {
 		return
 	}
+	file_identity_v1_identity_proto_msgTypes[21].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_identity_v1_identity_proto_rawDesc), len(file_identity_v1_identity_proto_rawDesc)),
 			NumEnums:      8,
-			NumMessages:   53,
+			NumMessages:   69,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,16 +22,22 @@ const (
 	IdentityService_GetMe_FullMethodName           = "/identity.v1.IdentityService/GetMe"
 	IdentityService_GetUser_FullMethodName         = "/identity.v1.IdentityService/GetUser"
 	IdentityService_ListUsers_FullMethodName       = "/identity.v1.IdentityService/ListUsers"
+	IdentityService_GetUsersByIds_FullMethodName   = "/identity.v1.IdentityService/GetUsersByIds"
 	IdentityService_InviteUser_FullMethodName      = "/identity.v1.IdentityService/InviteUser"
+	IdentityService_DeactivateUser_FullMethodName  = "/identity.v1.IdentityService/DeactivateUser"
 	IdentityService_GetOrgUnit_FullMethodName      = "/identity.v1.IdentityService/GetOrgUnit"
 	IdentityService_ListOrgUnits_FullMethodName    = "/identity.v1.IdentityService/ListOrgUnits"
 	IdentityService_CreateOrgUnit_FullMethodName   = "/identity.v1.IdentityService/CreateOrgUnit"
 	IdentityService_GetRole_FullMethodName         = "/identity.v1.IdentityService/GetRole"
 	IdentityService_ListRoles_FullMethodName       = "/identity.v1.IdentityService/ListRoles"
+	IdentityService_AssignRole_FullMethodName      = "/identity.v1.IdentityService/AssignRole"
+	IdentityService_RevokeRole_FullMethodName      = "/identity.v1.IdentityService/RevokeRole"
 	IdentityService_GetGrant_FullMethodName        = "/identity.v1.IdentityService/GetGrant"
 	IdentityService_ListGrants_FullMethodName      = "/identity.v1.IdentityService/ListGrants"
 	IdentityService_RequestGrant_FullMethodName    = "/identity.v1.IdentityService/RequestGrant"
 	IdentityService_CheckPermission_FullMethodName = "/identity.v1.IdentityService/CheckPermission"
+	IdentityService_OnboardTenant_FullMethodName   = "/identity.v1.IdentityService/OnboardTenant"
+	IdentityService_OffboardTenant_FullMethodName  = "/identity.v1.IdentityService/OffboardTenant"
 	IdentityService_HealthCheck_FullMethodName     = "/identity.v1.IdentityService/HealthCheck"
 )
 
@@ -43,7 +49,9 @@ type IdentityServiceClient interface {
 	GetMe(ctx context.Context, in *GetMeRequest, opts ...grpc.CallOption) (*GetMeResponse, error)
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	GetUsersByIds(ctx context.Context, in *GetUsersByIdsRequest, opts ...grpc.CallOption) (*GetUsersByIdsResponse, error)
 	InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InviteUserResponse, error)
+	DeactivateUser(ctx context.Context, in *DeactivateUserRequest, opts ...grpc.CallOption) (*DeactivateUserResponse, error)
 	// OrgUnit operations
 	GetOrgUnit(ctx context.Context, in *GetOrgUnitRequest, opts ...grpc.CallOption) (*GetOrgUnitResponse, error)
 	ListOrgUnits(ctx context.Context, in *ListOrgUnitsRequest, opts ...grpc.CallOption) (*ListOrgUnitsResponse, error)
@@ -51,12 +59,17 @@ type IdentityServiceClient interface {
 	// Role operations
 	GetRole(ctx context.Context, in *GetRoleRequest, opts ...grpc.CallOption) (*GetRoleResponse, error)
 	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
+	AssignRole(ctx context.Context, in *AssignRoleRequest, opts ...grpc.CallOption) (*AssignRoleResponse, error)
+	RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*RevokeRoleResponse, error)
 	// Grant operations
 	GetGrant(ctx context.Context, in *GetGrantRequest, opts ...grpc.CallOption) (*GetGrantResponse, error)
 	ListGrants(ctx context.Context, in *ListGrantsRequest, opts ...grpc.CallOption) (*ListGrantsResponse, error)
 	RequestGrant(ctx context.Context, in *RequestGrantRequest, opts ...grpc.CallOption) (*RequestGrantResponse, error)
 	// Permission check
 	CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error)
+	// Tenant operations, allowed to platform_admin in the platform realm only
+	OnboardTenant(ctx context.Context, in *OnboardTenantRequest, opts ...grpc.CallOption) (*OnboardTenantResponse, error)
+	OffboardTenant(ctx context.Context, in *OffboardTenantRequest, opts ...grpc.CallOption) (*OffboardTenantResponse, error)
 	// Health
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
@@ -99,6 +112,16 @@ func (c *identityServiceClient) ListUsers(ctx context.Context, in *ListUsersRequ
 	return out, nil
 }
 
+func (c *identityServiceClient) GetUsersByIds(ctx context.Context, in *GetUsersByIdsRequest, opts ...grpc.CallOption) (*GetUsersByIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersByIdsResponse)
+	err := c.cc.Invoke(ctx, IdentityService_GetUsersByIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *identityServiceClient) InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InviteUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InviteUserResponse)
@@ -109,6 +132,16 @@ func (c *identityServiceClient) InviteUser(ctx context.Context, in *InviteUserRe
 	return out, nil
 }
 
+func (c *identityServiceClient) DeactivateUser(ctx context.Context, in *DeactivateUserRequest, opts ...grpc.CallOption) (*DeactivateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateUserResponse)
+	err := c.cc.Invoke(ctx, IdentityService_DeactivateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *identityServiceClient) GetOrgUnit(ctx context.Context, in *GetOrgUnitRequest, opts ...grpc.CallOption) (*GetOrgUnitResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetOrgUnitResponse)
@@ -159,6 +192,26 @@ func (c *identityServiceClient) ListRoles(ctx context.Context, in *ListRolesRequ
 	return out, nil
 }
 
+func (c *identityServiceClient) AssignRole(ctx context.Context, in *AssignRoleRequest, opts ...grpc.CallOption) (*AssignRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignRoleResponse)
+	err := c.cc.Invoke(ctx, IdentityService_AssignRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*RevokeRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeRoleResponse)
+	err := c.cc.Invoke(ctx, IdentityService_RevokeRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *identityServiceClient) GetGrant(ctx context.Context, in *GetGrantRequest, opts ...grpc.CallOption) (*GetGrantResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetGrantResponse)
@@ -199,6 +252,26 @@ func (c *identityServiceClient) CheckPermission(ctx context.Context, in *CheckPe
 	return out, nil
 }
 
+func (c *identityServiceClient) OnboardTenant(ctx context.Context, in *OnboardTenantRequest, opts ...grpc.CallOption) (*OnboardTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnboardTenantResponse)
+	err := c.cc.Invoke(ctx, IdentityService_OnboardTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) OffboardTenant(ctx context.Context, in *OffboardTenantRequest, opts ...grpc.CallOption) (*OffboardTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OffboardTenantResponse)
+	err := c.cc.Invoke(ctx, IdentityService_OffboardTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *identityServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthCheckResponse)
@@ -217,7 +290,9 @@ type IdentityServiceServer interface {
 	GetMe(context.Context, *GetMeRequest) (*GetMeResponse, error)
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	GetUsersByIds(context.Context, *GetUsersByIdsRequest) (*GetUsersByIdsResponse, error)
 	InviteUser(context.Context, *InviteUserRequest) (*InviteUserResponse, error)
+	DeactivateUser(context.Context, *DeactivateUserRequest) (*DeactivateUserResponse, error)
 	// OrgUnit operations
 	GetOrgUnit(context.Context, *GetOrgUnitRequest) (*GetOrgUnitResponse, error)
 	ListOrgUnits(context.Context, *ListOrgUnitsRequest) (*ListOrgUnitsResponse, error)
@@ -225,12 +300,17 @@ type IdentityServiceServer interface {
 	// Role operations
 	GetRole(context.Context, *GetRoleRequest) (*GetRoleResponse, error)
 	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	AssignRole(context.Context, *AssignRoleRequest) (*AssignRoleResponse, error)
+	RevokeRole(context.Context, *RevokeRoleRequest) (*RevokeRoleResponse, error)
 	// Grant operations
 	GetGrant(context.Context, *GetGrantRequest) (*GetGrantResponse, error)
 	ListGrants(context.Context, *ListGrantsRequest) (*ListGrantsResponse, error)
 	RequestGrant(context.Context, *RequestGrantRequest) (*RequestGrantResponse, error)
 	// Permission check
 	CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error)
+	// Tenant operations, allowed to platform_admin in the platform realm only
+	OnboardTenant(context.Context, *OnboardTenantRequest) (*OnboardTenantResponse, error)
+	OffboardTenant(context.Context, *OffboardTenantRequest) (*OffboardTenantResponse, error)
 	// Health
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
 	mustEmbedUnimplementedIdentityServiceServer()
@@ -252,9 +332,15 @@ func (UnimplementedIdentityServiceServer) GetUser(context.Context, *GetUserReque
 func (UnimplementedIdentityServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
 }
+func (UnimplementedIdentityServiceServer) GetUsersByIds(context.Context, *GetUsersByIdsRequest) (*GetUsersByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByIds not implemented")
+}
 func (UnimplementedIdentityServiceServer) InviteUser(context.Context, *InviteUserRequest) (*InviteUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InviteUser not implemented")
 }
+func (UnimplementedIdentityServiceServer) DeactivateUser(context.Context, *DeactivateUserRequest) (*DeactivateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateUser not implemented")
+}
 func (UnimplementedIdentityServiceServer) GetOrgUnit(context.Context, *GetOrgUnitRequest) (*GetOrgUnitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrgUnit not implemented")
 }
@@ -270,6 +356,12 @@ func (UnimplementedIdentityServiceServer) GetRole(context.Context, *GetRoleReque
 func (UnimplementedIdentityServiceServer) ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
 }
+func (UnimplementedIdentityServiceServer) AssignRole(context.Context, *AssignRoleRequest) (*AssignRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRole not implemented")
+}
+func (UnimplementedIdentityServiceServer) RevokeRole(context.Context, *RevokeRoleRequest) (*RevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
 func (UnimplementedIdentityServiceServer) GetGrant(context.Context, *GetGrantRequest) (*GetGrantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGrant not implemented")
 }
@@ -282,6 +374,12 @@ func (UnimplementedIdentityServiceServer) RequestGrant(context.Context, *Request
 func (UnimplementedIdentityServiceServer) CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckPermission not implemented")
 }
+func (UnimplementedIdentityServiceServer) OnboardTenant(context.Context, *OnboardTenantRequest) (*OnboardTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnboardTenant not implemented")
+}
+func (UnimplementedIdentityServiceServer) OffboardTenant(context.Context, *OffboardTenantRequest) (*OffboardTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffboardTenant not implemented")
+}
 func (UnimplementedIdentityServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -360,6 +458,24 @@ func _IdentityService_ListUsers_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IdentityService_GetUsersByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).GetUsersByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_GetUsersByIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).GetUsersByIds(ctx, req.(*GetUsersByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IdentityService_InviteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InviteUserRequest)
 	if err := dec(in); err != nil {
@@ -378,6 +494,24 @@ func _IdentityService_InviteUser_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IdentityService_DeactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).DeactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_DeactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).DeactivateUser(ctx, req.(*DeactivateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IdentityService_GetOrgUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetOrgUnitRequest)
 	if err := dec(in); err != nil {
@@ -468,6 +602,42 @@ func _IdentityService_ListRoles_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IdentityService_AssignRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).AssignRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_AssignRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).AssignRole(ctx, req.(*AssignRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_RevokeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).RevokeRole(ctx, req.(*RevokeRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IdentityService_GetGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetGrantRequest)
 	if err := dec(in); err != nil {
@@ -540,6 +710,42 @@ func _IdentityService_CheckPermission_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IdentityService_OnboardTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnboardTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).OnboardTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_OnboardTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).OnboardTenant(ctx, req.(*OnboardTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_OffboardTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OffboardTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).OffboardTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_OffboardTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).OffboardTenant(ctx, req.(*OffboardTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IdentityService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HealthCheckRequest)
 	if err := dec(in); err != nil {
@@ -577,10 +783,18 @@ var IdentityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUsers",
 			Handler:    _IdentityService_ListUsers_Handler,
 		},
+		{
+			MethodName: "GetUsersByIds",
+			Handler:    _IdentityService_GetUsersByIds_Handler,
+		},
 		{
 			MethodName: "InviteUser",
 			Handler:    _IdentityService_InviteUser_Handler,
 		},
+		{
+			MethodName: "DeactivateUser",
+			Handler:    _IdentityService_DeactivateUser_Handler,
+		},
 		{
 			MethodName: "GetOrgUnit",
 			Handler:    _IdentityService_GetOrgUnit_Handler,
@@ -601,6 +815,14 @@ var IdentityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListRoles",
 			Handler:    _IdentityService_ListRoles_Handler,
 		},
+		{
+			MethodName: "AssignRole",
+			Handler:    _IdentityService_AssignRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _IdentityService_RevokeRole_Handler,
+		},
 		{
 			MethodName: "GetGrant",
 			Handler:    _IdentityService_GetGrant_Handler,
@@ -617,6 +839,14 @@ var IdentityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckPermission",
 			Handler:    _IdentityService_CheckPermission_Handler,
 		},
+		{
+			MethodName: "OnboardTenant",
+			Handler:    _IdentityService_OnboardTenant_Handler,
+		},
+		{
+			MethodName: "OffboardTenant",
+			Handler:    _IdentityService_OffboardTenant_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _IdentityService_HealthCheck_Handler,
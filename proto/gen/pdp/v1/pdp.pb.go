@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.36.10
-// 	protoc        (unknown)
+// 	protoc        v6.32.1
 // source: pdp/v1/pdp.proto
 
 package pdpv1
@@ -119,13 +119,15 @@ func (x *HealthCheckResponse) GetTimestamp() *timestamppb.Timestamp {
 }
 
 type CheckAuthorizationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          string                 `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Relation      string                 `protobuf:"bytes,2,opt,name=relation,proto3" json:"relation,omitempty"`
-	Object        string                 `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
-	Context       map[string]string      `protobuf:"bytes,4,rep,name=context,proto3" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	User     string                 `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Relation string                 `protobuf:"bytes,2,opt,name=relation,proto3" json:"relation,omitempty"`
+	Object   string                 `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	Context  map[string]string      `protobuf:"bytes,4,rep,name=context,proto3" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Tuples assumed for this check only, e.g. the tenant a record belongs to
+	ContextualTuples []*TupleKey `protobuf:"bytes,5,rep,name=contextual_tuples,json=contextualTuples,proto3" json:"contextual_tuples,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CheckAuthorizationRequest) Reset() {
@@ -186,18 +188,88 @@ func (x *CheckAuthorizationRequest) GetContext() map[string]string {
 	return nil
 }
 
-type CheckAuthorizationResponse struct {
+func (x *CheckAuthorizationRequest) GetContextualTuples() []*TupleKey {
+	if x != nil {
+		return x.ContextualTuples
+	}
+	return nil
+}
+
+type TupleKey struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	User          string                 `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Relation      string                 `protobuf:"bytes,2,opt,name=relation,proto3" json:"relation,omitempty"`
+	Object        string                 `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TupleKey) Reset() {
+	*x = TupleKey{}
+	mi := &file_pdp_v1_pdp_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TupleKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TupleKey) ProtoMessage() {}
+
+func (x *TupleKey) ProtoReflect() protoreflect.Message {
+	mi := &file_pdp_v1_pdp_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TupleKey.ProtoReflect.Descriptor instead.
+func (*TupleKey) Descriptor() ([]byte, []int) {
+	return file_pdp_v1_pdp_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TupleKey) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *TupleKey) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *TupleKey) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+type CheckAuthorizationResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Allowed bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Reason  string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Identifies this decision in the PDP's decision log, so records of what
+	// it allowed can name it
+	DecisionId    string `protobuf:"bytes,4,opt,name=decision_id,json=decisionId,proto3" json:"decision_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CheckAuthorizationResponse) Reset() {
 	*x = CheckAuthorizationResponse{}
-	mi := &file_pdp_v1_pdp_proto_msgTypes[3]
+	mi := &file_pdp_v1_pdp_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -209,7 +281,7 @@ func (x *CheckAuthorizationResponse) String() string {
 func (*CheckAuthorizationResponse) ProtoMessage() {}
 
 func (x *CheckAuthorizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pdp_v1_pdp_proto_msgTypes[3]
+	mi := &file_pdp_v1_pdp_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -222,7 +294,7 @@ func (x *CheckAuthorizationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckAuthorizationResponse.ProtoReflect.Descriptor instead.
 func (*CheckAuthorizationResponse) Descriptor() ([]byte, []int) {
-	return file_pdp_v1_pdp_proto_rawDescGZIP(), []int{3}
+	return file_pdp_v1_pdp_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CheckAuthorizationResponse) GetAllowed() bool {
@@ -246,6 +318,13 @@ func (x *CheckAuthorizationResponse) GetReason() string {
 	return ""
 }
 
+func (x *CheckAuthorizationResponse) GetDecisionId() string {
+	if x != nil {
+		return x.DecisionId
+	}
+	return ""
+}
+
 var File_pdp_v1_pdp_proto protoreflect.FileDescriptor
 
 const file_pdp_v1_pdp_proto_rawDesc = "" +
@@ -255,24 +334,29 @@ const file_pdp_v1_pdp_proto_rawDesc = "" +
 	"\x13HealthCheckResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\aservice\x18\x02 \x01(\tR\aservice\x128\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xe9\x01\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xa8\x02\n" +
 	"\x19CheckAuthorizationRequest\x12\x12\n" +
 	"\x04user\x18\x01 \x01(\tR\x04user\x12\x1a\n" +
 	"\brelation\x18\x02 \x01(\tR\brelation\x12\x16\n" +
 	"\x06object\x18\x03 \x01(\tR\x06object\x12H\n" +
-	"\acontext\x18\x04 \x03(\v2..pdp.v1.CheckAuthorizationRequest.ContextEntryR\acontext\x1a:\n" +
+	"\acontext\x18\x04 \x03(\v2..pdp.v1.CheckAuthorizationRequest.ContextEntryR\acontext\x12=\n" +
+	"\x11contextual_tuples\x18\x05 \x03(\v2\x10.pdp.v1.TupleKeyR\x10contextualTuples\x1a:\n" +
 	"\fContextEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"h\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"R\n" +
+	"\bTupleKey\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\x12\x1a\n" +
+	"\brelation\x18\x02 \x01(\tR\brelation\x12\x16\n" +
+	"\x06object\x18\x03 \x01(\tR\x06object\"\x89\x01\n" +
 	"\x1aCheckAuthorizationResponse\x12\x18\n" +
 	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason2\xbc\x01\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1f\n" +
+	"\vdecision_id\x18\x04 \x01(\tR\n" +
+	"decisionId2\xbc\x01\n" +
 	"\x15PolicyDecisionService\x12F\n" +
 	"\vHealthCheck\x12\x1a.pdp.v1.HealthCheckRequest\x1a\x1b.pdp.v1.HealthCheckResponse\x12[\n" +
-	"\x12CheckAuthorization\x12!.pdp.v1.CheckAuthorizationRequest\x1a\".pdp.v1.CheckAuthorizationResponseB\x84\x01\n" +
-	"\n" +
-	"com.pdp.v1B\bPdpProtoP\x01Z3git.ninjainfosys.com/ePalika/proto/gen/pdp/v1;pdpv1\xa2\x02\x03PXX\xaa\x02\x06Pdp.V1\xca\x02\x06Pdp\\V1\xe2\x02\x12Pdp\\V1\\GPBMetadata\xea\x02\aPdp::V1b\x06proto3"
+	"\x12CheckAuthorization\x12!.pdp.v1.CheckAuthorizationRequest\x1a\".pdp.v1.CheckAuthorizationResponseB5Z3git.ninjainfosys.com/ePalika/proto/gen/pdp/v1;pdpv1b\x06proto3"
 
 var (
 	file_pdp_v1_pdp_proto_rawDescOnce sync.Once
@@ -286,27 +370,29 @@ func file_pdp_v1_pdp_proto_rawDescGZIP() []byte {
 	return file_pdp_v1_pdp_proto_rawDescData
 }
 
-var file_pdp_v1_pdp_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pdp_v1_pdp_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_pdp_v1_pdp_proto_goTypes = []any{
 	(*HealthCheckRequest)(nil),         // 0: pdp.v1.HealthCheckRequest
 	(*HealthCheckResponse)(nil),        // 1: pdp.v1.HealthCheckResponse
 	(*CheckAuthorizationRequest)(nil),  // 2: pdp.v1.CheckAuthorizationRequest
-	(*CheckAuthorizationResponse)(nil), // 3: pdp.v1.CheckAuthorizationResponse
-	nil,                                // 4: pdp.v1.CheckAuthorizationRequest.ContextEntry
-	(*timestamppb.Timestamp)(nil),      // 5: google.protobuf.Timestamp
+	(*TupleKey)(nil),                   // 3: pdp.v1.TupleKey
+	(*CheckAuthorizationResponse)(nil), // 4: pdp.v1.CheckAuthorizationResponse
+	nil,                                // 5: pdp.v1.CheckAuthorizationRequest.ContextEntry
+	(*timestamppb.Timestamp)(nil),      // 6: google.protobuf.Timestamp
 }
 var file_pdp_v1_pdp_proto_depIdxs = []int32{
-	5, // 0: pdp.v1.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
-	4, // 1: pdp.v1.CheckAuthorizationRequest.context:type_name -> pdp.v1.CheckAuthorizationRequest.ContextEntry
-	0, // 2: pdp.v1.PolicyDecisionService.HealthCheck:input_type -> pdp.v1.HealthCheckRequest
-	2, // 3: pdp.v1.PolicyDecisionService.CheckAuthorization:input_type -> pdp.v1.CheckAuthorizationRequest
-	1, // 4: pdp.v1.PolicyDecisionService.HealthCheck:output_type -> pdp.v1.HealthCheckResponse
-	3, // 5: pdp.v1.PolicyDecisionService.CheckAuthorization:output_type -> pdp.v1.CheckAuthorizationResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	6, // 0: pdp.v1.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
+	5, // 1: pdp.v1.CheckAuthorizationRequest.context:type_name -> pdp.v1.CheckAuthorizationRequest.ContextEntry
+	3, // 2: pdp.v1.CheckAuthorizationRequest.contextual_tuples:type_name -> pdp.v1.TupleKey
+	0, // 3: pdp.v1.PolicyDecisionService.HealthCheck:input_type -> pdp.v1.HealthCheckRequest
+	2, // 4: pdp.v1.PolicyDecisionService.CheckAuthorization:input_type -> pdp.v1.CheckAuthorizationRequest
+	1, // 5: pdp.v1.PolicyDecisionService.HealthCheck:output_type -> pdp.v1.HealthCheckResponse
+	4, // 6: pdp.v1.PolicyDecisionService.CheckAuthorization:output_type -> pdp.v1.CheckAuthorizationResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_pdp_v1_pdp_proto_init() }
@@ -320,7 +406,7 @@ func file_pdp_v1_pdp_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pdp_v1_pdp_proto_rawDesc), len(file_pdp_v1_pdp_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
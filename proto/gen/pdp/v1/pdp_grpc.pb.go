@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.5.1
-// - protoc             (unknown)
+// - protoc             v6.32.1
 // source: pdp/v1/pdp.proto
 
 package pdpv1
@@ -15,7 +15,7 @@ echo "${BOLD}🏛️  Keycloak Tenant Deletion${RESET}"
 echo "--------------------------------"
 
 # ---- Dependencies ----
-for tool in curl jq; do
+for tool in curl jq grpcurl; do
   if ! command -v "$tool" >/dev/null 2>&1; then
     echo "❌ Required tool '$tool' not found in PATH"
     exit 1
@@ -31,6 +31,7 @@ fi
 KEYCLOAK_BASE_URL=${KEYCLOAK_BASE_URL:-http://localhost:8083}
 KEYCLOAK_ADMIN_USER=${KEYCLOAK_ADMIN_USER:-admin}
 KEYCLOAK_ADMIN_PASSWORD=${KEYCLOAK_ADMIN_PASSWORD:-admin}
+IDENTITY_GRPC_ADDR=${IDENTITY_GRPC_ADDR:-localhost:9001}
 
 # OpenFGA (optional cleanup)
 OPENFGA_API_URL=${OPENFGA_API_URL:-}
@@ -61,6 +62,7 @@ Environment:
   KEYCLOAK_BASE_URL          (default: ${KEYCLOAK_BASE_URL})
   KEYCLOAK_ADMIN_USER        (default: ${KEYCLOAK_ADMIN_USER})
   KEYCLOAK_ADMIN_PASSWORD    (default: **hidden**)
+  IDENTITY_GRPC_ADDR         (default: ${IDENTITY_GRPC_ADDR})
   OPENFGA_API_URL            (optional; enables FGA cleanup)
   FGA_STORE_ID               (optional; enables FGA cleanup)
   FGA_MODEL_ID               (optional; used for FGA delete/write operations)
@@ -233,30 +235,20 @@ if [ "$FGA_CLEANUP" = "true" ]; then
   fi
 fi
 
-# ---- Delete realm ----
-echo "🗑️  Deleting Keycloak realm '${TENANT_SLUG}' ..."
+# ---- Offboard tenant via identity service ----
+echo "🗑️  Offboarding tenant '${TENANT_SLUG}' via identity service (${IDENTITY_GRPC_ADDR}) ..."
 if [ "$DRY_RUN" = "true" ]; then
-  echo "   ${DIM}(dry-run) Would DELETE ${KEYCLOAK_BASE_URL}/admin/realms/${TENANT_SLUG}${RESET}"
+  echo "   ${DIM}(dry-run) Would call identity.v1.IdentityService/OffboardTenant for ${TENANT_SLUG}${RESET}"
 else
-  DEL_STATUS=$(curl -s -o /dev/null -w "%{http_code}" \
-    -H "Authorization: Bearer ${TOKEN}" \
-    -X DELETE "${KEYCLOAK_BASE_URL}/admin/realms/${TENANT_SLUG}")
-  if [ "$DEL_STATUS" != "204" ]; then
-    echo "❌ Failed to delete realm (HTTP ${DEL_STATUS})"
+  OFFBOARD_PAYLOAD=$(jq -n --arg slug "$TENANT_SLUG" '{ slug: $slug, confirm_slug: $slug }')
+  if ! grpcurl -plaintext \
+    -H "x-user-id: ${KEYCLOAK_ADMIN_USER}" \
+    -d "$OFFBOARD_PAYLOAD" \
+    "$IDENTITY_GRPC_ADDR" identity.v1.IdentityService/OffboardTenant >/dev/null; then
+    echo "❌ OffboardTenant failed"
     exit 1
   fi
-  echo "   ${GREEN}Realm deletion request accepted (204).${RESET}"
-
-  # Verify gone
-  sleep 1
-  CHECK=$(curl -s -o /dev/null -w "%{http_code}" \
-    -H "Authorization: Bearer ${TOKEN}" \
-    "${KEYCLOAK_BASE_URL}/admin/realms/${TENANT_SLUG}")
-  if [ "$CHECK" = "404" ]; then
-    echo "   ${GREEN}Verified: realm no longer exists.${RESET}"
-  else
-    echo "   ${YELLOW}Note: verification returned HTTP ${CHECK}. The realm may still be deleting asynchronously.${RESET}"
-  fi
+  echo "   ${GREEN}Realm deleted.${RESET}"
 fi
 
 echo ""
@@ -17,10 +17,15 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/audit"
 	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/config"
 	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
 	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
 	grpcserver "git.ninjainfosys.com/ePalika/services/darta-chalani/internal/grpc"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/idempotency"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/kitab"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/search"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/sla"
 )
 
 func main() {
@@ -50,23 +55,72 @@ func main() {
 	queries := db.New(pool)
 
 	// Create domain services
-	dartaService := domain.NewDartaService(queries)
+	dartaService := domain.NewDartaService(queries, domain.DuplicatePolicy{
+		Window:         cfg.DuplicateWindow,
+		BlockThreshold: cfg.DuplicateBlockThreshold,
+	})
 	chalaniService := domain.NewChalaniService(queries)
 
+	// Mutations are fanned out to Watch streams through the event hub
+	events := grpcserver.NewEventHub()
+
+	// Search documents are rebuilt in the background after mutations
+	indexer := search.NewIndexer(queries, cfg.SearchIndexInterval)
+	go indexer.Run(ctx)
+
+	// SLA clocks follow record changes; breaches and escalations are
+	// published to Watch streams
+	slaEngine := sla.NewEngine(queries, cfg.SLAEvaluateInterval, grpcserver.SLAAlertPublisher(events, queries))
+	go slaEngine.Run(ctx)
+
+	// Audit chain heads are anchored for notarization
+	anchorer := audit.NewAnchorer(queries, cfg.AuditAnchorInterval)
+	go anchorer.Run(ctx)
+
+	// Responses to mutations sent with an idempotency key are kept for
+	// replay until they expire
+	idempotencyStore := idempotency.NewStore(queries, cfg.IdempotencyKeyTTL, cfg.IdempotencySweepInterval)
+	go idempotencyStore.Run(ctx)
+
 	// Create gRPC server with interceptors
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			grpcserver.UnaryAuthInterceptor(),
+			grpcserver.UnaryIdempotencyInterceptor(idempotencyStore),
+			grpcserver.UnaryEventInterceptor(events, queries),
+			grpcserver.UnarySearchIndexInterceptor(indexer),
+			grpcserver.UnarySLAInterceptor(slaEngine),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcserver.StreamAuthInterceptor(),
 		),
 	)
 
 	// Register services
-	dartaServer := grpcserver.NewDartaServer(dartaService, queries)
+	dartaServer := grpcserver.NewDartaServer(dartaService, queries, events)
 	dartav1.RegisterDartaServiceServer(grpcServer, dartaServer)
 
-	// TODO: Register Chalani service when proto is generated
-	// chalaniServer := grpcserver.NewChalaniServer(queries)
-	// chalaniv1.RegisterChalaniServiceServer(grpcServer, chalaniServer)
+	chalaniServer := grpcserver.NewChalaniServer(queries, events)
+	dartav1.RegisterChalaniServiceServer(grpcServer, chalaniServer)
+
+	dartav1.RegisterSLAServiceServer(grpcServer, grpcserver.NewSLAServer(queries))
+
+	dartav1.RegisterMetadataSchemaServiceServer(grpcServer, grpcserver.NewMetadataSchemaServer(queries))
+
+	dartav1.RegisterAuditServiceServer(grpcServer, grpcserver.NewAuditServer(queries))
+
+	// Exported registers are sealed with the configured signing key
+	var signer *kitab.Signer
+	if cfg.KitabSigningKey == nil {
+		log.Println("KITAB_EPHEMERAL_SIGNING_KEY is set; exported registers are signed with a key that lasts until restart")
+		signer, err = kitab.NewEphemeralSigner()
+	} else {
+		signer, err = kitab.NewSigner(cfg.KitabSigningKey)
+	}
+	if err != nil {
+		log.Fatalf("failed to create register signer: %v", err)
+	}
+	dartav1.RegisterRegisterServiceServer(grpcServer, grpcserver.NewRegisterServer(queries, signer, cfg.KitabFontPath, cfg.KitabLatinFontPath))
 
 	// Suppress unused variable warnings
 	_ = chalaniService
@@ -4,11 +4,17 @@ go 1.25.1
 
 replace git.ninjainfosys.com/ePalika/proto => ../../proto
 
+replace git.ninjainfosys.com/ePalika/pkg => ../../pkg
+
 require (
+	git.ninjainfosys.com/ePalika/pkg v0.0.0-00010101000000-000000000000
 	git.ninjainfosys.com/ePalika/proto v0.0.0-00010101000000-000000000000
+	github.com/go-text/typesetting v0.3.5
 	github.com/google/uuid v1.6.0
 	github.com/jackc/pgx/v5 v5.7.6
 	github.com/pressly/goose/v3 v3.26.0
+	github.com/santhosh-tekuri/jsonschema/v5 v5.3.1
+	golang.org/x/image v0.23.0
 	google.golang.org/grpc v1.75.1
 	google.golang.org/protobuf v1.36.10
 )
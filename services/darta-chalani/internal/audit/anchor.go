@@ -0,0 +1,74 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+)
+
+// Anchorer periodically records the head of every tenant's chain. Anchors
+// are exported for notarization outside the database, such as a signed
+// letter or a public timestamping service; a chain rewritten wholesale
+// around the database's checks no longer matches them.
+type Anchorer struct {
+	queries  db.Querier
+	interval time.Duration
+}
+
+// NewAnchorer creates an Anchorer that anchors every interval
+func NewAnchorer(queries db.Querier, interval time.Duration) *Anchorer {
+	return &Anchorer{queries: queries, interval: interval}
+}
+
+// Run anchors until ctx is done. Replicas may each run one; a head already
+// anchored is skipped.
+func (a *Anchorer) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := a.Anchor(ctx); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("audit anchoring failed: %v", err)
+		}
+	}
+}
+
+// Anchor records the current head of every tenant's chain
+func (a *Anchorer) Anchor(ctx context.Context) error {
+	heads, err := a.queries.ListAuditChainHeads(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list audit chain heads: %w", err)
+	}
+	for _, h := range heads {
+		if err := a.queries.CreateAuditAnchor(ctx, db.CreateAuditAnchorParams{
+			TenantID:  h.TenantID,
+			ChainSeq:  h.ChainSeq,
+			EntryHash: h.EntryHash,
+		}); err != nil {
+			return fmt.Errorf("failed to anchor audit chain of tenant %s: %w", h.TenantID, err)
+		}
+	}
+	return nil
+}
+
+// Statement is the canonical JSON of an anchor, the text to notarize
+func Statement(anchor db.AuditAnchor) string {
+	b, _ := marshal(map[string]interface{}{
+		"tenant_id":   anchor.TenantID,
+		"chain_seq":   anchor.ChainSeq,
+		"entry_hash":  anchor.EntryHash,
+		"anchored_at": anchor.AnchoredAt.Time.UTC().Format(time.RFC3339Nano),
+	})
+	return string(b)
+}
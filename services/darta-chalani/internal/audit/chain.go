@@ -0,0 +1,269 @@
+// Package audit keeps the audit trail tamper evident.
+//
+// Each tenant's audit entries form a hash chain. An entry's hash is the
+// SHA-256 of its canonical JSON form, which includes the hash of the entry
+// before it, so editing, removing or reordering an entry breaks every later
+// link. The database refuses updates and deletes and checks each insert
+// extends the chain; Verify recomputes the chain to catch changes made
+// around those checks, and anchors record chain heads that can be
+// notarized outside the database.
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+)
+
+// maxAppendAttempts bounds how often Append retries after concurrent
+// writers claimed the position it read
+const maxAppendAttempts = 10
+
+// chainIndex is the unique index on each tenant's chain positions
+const chainIndex = "idx_audit_trail_chain"
+
+// Entry is an audit entry to append to its tenant's chain
+type Entry struct {
+	TenantID    string
+	Category    string
+	EntityType  string
+	EntityID    uuid.UUID
+	Action      string
+	PerformedBy string
+	Changes     map[string]interface{}
+	IPAddress   *string
+	UserAgent   *string
+	Notes       *string
+
+	// Provenance of the action
+	RequestID  *string
+	TraceID    *string
+	ActingRole *string
+	DecisionID *string
+}
+
+// Append writes e as the next entry of its tenant's chain. Writers racing
+// for the same position conflict on the chain index; the losers read the
+// new head and try again. Given queries bound to a transaction, the entry
+// commits with it.
+func Append(ctx context.Context, queries db.Querier, e Entry) (db.AuditTrail, error) {
+	var changes json.RawMessage
+	if e.Changes != nil {
+		var err error
+		if changes, err = json.Marshal(e.Changes); err != nil {
+			return db.AuditTrail{}, err
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		seq := int64(1)
+		var prev *string
+		head, err := queries.GetAuditChainHead(ctx, e.TenantID)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+		case err != nil:
+			return db.AuditTrail{}, fmt.Errorf("failed to read audit chain head: %w", err)
+		default:
+			seq = head.ChainSeq + 1
+			prev = &head.EntryHash
+		}
+
+		params := db.CreateAuditEntryParams{
+			ID:          uuid.New(),
+			EntityType:  e.EntityType,
+			EntityID:    pgtype.UUID{Bytes: e.EntityID, Valid: true},
+			Action:      e.Action,
+			PerformedBy: e.PerformedBy,
+			// The database keeps microseconds; the hash must see the same
+			PerformedAt: pgtype.Timestamptz{Time: time.Now().UTC().Truncate(time.Microsecond), Valid: true},
+			Changes:     changes,
+			IpAddress:   e.IPAddress,
+			UserAgent:   e.UserAgent,
+			Notes:       e.Notes,
+			TenantID:    e.TenantID,
+			Category:    e.Category,
+			ChainSeq:    &seq,
+			PrevHash:    prev,
+			RequestID:   e.RequestID,
+			TraceID:     e.TraceID,
+			ActingRole:  e.ActingRole,
+			DecisionID:  e.DecisionID,
+		}
+		hash, err := Hash(entryFromParams(params))
+		if err != nil {
+			return db.AuditTrail{}, err
+		}
+		params.EntryHash = &hash
+
+		// Each attempt gets its own savepoint when the caller is in a
+		// transaction, so losing the race does not abort the caller's work
+		var row db.AuditTrail
+		err = db.InTx(ctx, queries, func(q db.Querier) error {
+			row, err = q.CreateAuditEntry(ctx, params)
+			return err
+		})
+		if err == nil {
+			return row, nil
+		}
+		if !retryable(err) || attempt == maxAppendAttempts {
+			return db.AuditTrail{}, fmt.Errorf("failed to append audit entry: %w", err)
+		}
+	}
+}
+
+// retryable reports whether an insert lost a race for its chain position
+func retryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	switch pgErr.Code {
+	case "23505":
+		return pgErr.ConstraintName == chainIndex
+	case "40001": // Serialization failure between distributed transactions
+		return true
+	}
+	return false
+}
+
+func entryFromParams(p db.CreateAuditEntryParams) db.AuditTrail {
+	return db.AuditTrail{
+		ID:          p.ID,
+		EntityType:  p.EntityType,
+		EntityID:    p.EntityID,
+		Action:      p.Action,
+		PerformedBy: p.PerformedBy,
+		PerformedAt: p.PerformedAt,
+		Changes:     p.Changes,
+		IpAddress:   p.IpAddress,
+		UserAgent:   p.UserAgent,
+		Notes:       p.Notes,
+		TenantID:    p.TenantID,
+		Category:    p.Category,
+		ChainSeq:    p.ChainSeq,
+		PrevHash:    p.PrevHash,
+		RequestID:   p.RequestID,
+		TraceID:     p.TraceID,
+		ActingRole:  p.ActingRole,
+		DecisionID:  p.DecisionID,
+	}
+}
+
+// Hash returns the hex SHA-256 of an entry's canonical form
+func Hash(row db.AuditTrail) (string, error) {
+	b, err := Canonical(row)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Canonical is the JSON form an entry's hash covers: an object with sorted
+// keys and no insignificant whitespace. Unset optional fields are left out,
+// so fields added later do not change the hash of older entries. Changes
+// are re-encoded the same way, whatever form the database returns them in.
+func Canonical(row db.AuditTrail) ([]byte, error) {
+	if row.ChainSeq == nil {
+		return nil, fmt.Errorf("audit entry %s has no chain position", row.ID)
+	}
+	fields := map[string]interface{}{
+		"id":           row.ID.String(),
+		"tenant_id":    row.TenantID,
+		"seq":          *row.ChainSeq,
+		"category":     row.Category,
+		"entity_type":  row.EntityType,
+		"entity_id":    uuid.UUID(row.EntityID.Bytes).String(),
+		"action":       row.Action,
+		"performed_by": row.PerformedBy,
+		"performed_at": row.PerformedAt.Time.UTC().Format(time.RFC3339Nano),
+	}
+	optional := map[string]*string{
+		"prev_hash":   row.PrevHash,
+		"ip_address":  row.IpAddress,
+		"user_agent":  row.UserAgent,
+		"notes":       row.Notes,
+		"request_id":  row.RequestID,
+		"trace_id":    row.TraceID,
+		"acting_role": row.ActingRole,
+		"decision_id": row.DecisionID,
+	}
+	for k, v := range optional {
+		if v != nil {
+			fields[k] = *v
+		}
+	}
+	if len(row.Changes) > 0 {
+		changes, err := canonicalValue(row.Changes)
+		if err != nil {
+			return nil, fmt.Errorf("audit entry %s has invalid changes: %w", row.ID, err)
+		}
+		fields["changes"] = changes
+	}
+	return marshal(fields)
+}
+
+// canonicalValue decodes JSON with numbers in one spelling, so 1.0, 1 and
+// 1e0 hash alike however the database stored them
+func canonicalValue(raw []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return normalizeNumbers(v)
+}
+
+func normalizeNumbers(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case json.Number:
+		f, err := strconv.ParseFloat(v.String(), 64)
+		if err != nil {
+			return nil, err
+		}
+		return json.Number(strconv.FormatFloat(f, 'f', -1, 64)), nil
+	case map[string]interface{}:
+		for k, item := range v {
+			n, err := normalizeNumbers(item)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = n
+		}
+	case []interface{}:
+		for i, item := range v {
+			n, err := normalizeNumbers(item)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = n
+		}
+	}
+	return v, nil
+}
+
+// marshal encodes JSON without HTML escaping or a trailing newline.
+// encoding/json sorts map keys.
+func marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte{'\n'}), nil
+}
@@ -0,0 +1,233 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+)
+
+// chainStore keeps one tenant's chain in memory, in order
+type chainStore struct {
+	db.Querier
+
+	rows    []db.AuditTrail
+	anchors []db.AuditAnchor
+}
+
+func (c *chainStore) GetAuditChainHead(ctx context.Context, tenantID string) (db.GetAuditChainHeadRow, error) {
+	if len(c.rows) == 0 {
+		return db.GetAuditChainHeadRow{}, pgx.ErrNoRows
+	}
+	head := c.rows[len(c.rows)-1]
+	return db.GetAuditChainHeadRow{ChainSeq: *head.ChainSeq, EntryHash: *head.EntryHash}, nil
+}
+
+func (c *chainStore) CreateAuditEntry(ctx context.Context, arg db.CreateAuditEntryParams) (db.AuditTrail, error) {
+	row := entryFromParams(arg)
+	row.EntryHash = arg.EntryHash
+	c.rows = append(c.rows, row)
+	return row, nil
+}
+
+func (c *chainStore) ListAuditAnchors(ctx context.Context, arg db.ListAuditAnchorsParams) ([]db.AuditAnchor, error) {
+	return c.anchors, nil
+}
+
+func (c *chainStore) ListAuditChain(ctx context.Context, arg db.ListAuditChainParams) ([]db.AuditTrail, error) {
+	var rows []db.AuditTrail
+	for _, r := range c.rows {
+		if *r.ChainSeq > arg.AfterSeq && len(rows) < int(arg.LimitCount) {
+			rows = append(rows, r)
+		}
+	}
+	return rows, nil
+}
+
+func (c *chainStore) CountUnchainedAuditEntries(ctx context.Context, arg db.CountUnchainedAuditEntriesParams) (int64, error) {
+	return 0, nil
+}
+
+// appendEntries writes n status changes to the store's chain
+func appendEntries(t *testing.T, store *chainStore, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		if _, err := Append(context.Background(), store, Entry{
+			TenantID:    "t1",
+			Category:    "ACTIVITY",
+			EntityType:  "DARTA",
+			EntityID:    uuid.New(),
+			Action:      "STATUS_CHANGED",
+			PerformedBy: "user-7",
+			Changes:     map[string]interface{}{"status": map[string]string{"from": "DRAFT", "to": "PENDING_REVIEW"}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func problemKinds(r *Report) map[string]int64 {
+	kinds := map[string]int64{}
+	for _, p := range r.Problems {
+		kinds[p.Kind] = p.Seq
+	}
+	return kinds
+}
+
+func TestCanonicalIsIndependentOfStorage(t *testing.T) {
+	seq := int64(4)
+	prev := "abc"
+	row := db.AuditTrail{
+		ID:          uuid.MustParse("6f1c2b9e-8a4d-4c5e-9f7a-1b2c3d4e5f60"),
+		TenantID:    "t1",
+		Category:    "ACTIVITY",
+		EntityType:  "DARTA",
+		EntityID:    pgtype.UUID{Bytes: uuid.MustParse("0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"), Valid: true},
+		Action:      "SLA_SET",
+		PerformedBy: "user-7",
+		PerformedAt: pgtype.Timestamptz{Time: time.Date(2025, 4, 15, 10, 30, 0, 123456000, time.UTC), Valid: true},
+		Changes:     json.RawMessage(`{"hours": 1.0, "unit": "<राजस्व>"}`),
+		ChainSeq:    &seq,
+		PrevHash:    &prev,
+	}
+	want := `{"action":"SLA_SET","category":"ACTIVITY","changes":{"hours":1,"unit":"<राजस्व>"},` +
+		`"entity_id":"0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d","entity_type":"DARTA",` +
+		`"id":"6f1c2b9e-8a4d-4c5e-9f7a-1b2c3d4e5f60","performed_at":"2025-04-15T10:30:00.123456Z",` +
+		`"performed_by":"user-7","prev_hash":"abc","seq":4,"tenant_id":"t1"}`
+	got, err := Canonical(row)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != want {
+		t.Errorf("Canonical =\n%s\nwant\n%s", got, want)
+	}
+
+	// The database may hand the same entry back with its JSON respelled
+	// and its time in another zone
+	stored := row
+	stored.Changes = json.RawMessage(`{"unit":"<राजस्व>","hours":1}`)
+	stored.PerformedAt.Time = row.PerformedAt.Time.In(time.FixedZone("NPT", 5*3600+45*60))
+	h1, err := Hash(row)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h2, err := Hash(stored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h1 != h2 {
+		t.Errorf("hash changed with storage: %s != %s", h1, h2)
+	}
+
+	stored.Notes = &prev
+	if h3, _ := Hash(stored); h3 == h1 {
+		t.Error("adding notes did not change the hash")
+	}
+	if _, err := Canonical(db.AuditTrail{ID: row.ID}); err == nil {
+		t.Error("an entry without a chain position was hashed")
+	}
+}
+
+func TestAppendChainsEntries(t *testing.T) {
+	store := &chainStore{}
+	appendEntries(t, store, 3)
+
+	for i, row := range store.rows {
+		if *row.ChainSeq != int64(i+1) {
+			t.Errorf("entry %d at position %d", i, *row.ChainSeq)
+		}
+		if i == 0 && row.PrevHash != nil {
+			t.Error("the first entry names a predecessor")
+		}
+		if i > 0 && *row.PrevHash != *store.rows[i-1].EntryHash {
+			t.Errorf("entry %d does not name its predecessor's hash", i+1)
+		}
+		if hash, _ := Hash(row); hash != *row.EntryHash {
+			t.Errorf("entry %d hash = %s, want %s", i+1, *row.EntryHash, hash)
+		}
+	}
+
+	report, err := Verify(context.Background(), store, "t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Intact() || report.Entries != 3 || report.HeadSeq != 3 || report.HeadHash != *store.rows[2].EntryHash {
+		t.Errorf("report = %+v, want 3 intact entries", report)
+	}
+}
+
+func TestVerifyFindsTampering(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(store *chainStore)
+		want   map[string]int64
+	}{
+		{
+			name: "changed entry",
+			tamper: func(store *chainStore) {
+				store.rows[1].Changes = json.RawMessage(`{"status":{"from":"DRAFT","to":"CLOSED"}}`)
+			},
+			want: map[string]int64{ProblemHashMismatch: 2},
+		},
+		{
+			name: "rehashed entry",
+			tamper: func(store *chainStore) {
+				store.rows[1].PerformedBy = "someone-else"
+				hash, _ := Hash(store.rows[1])
+				store.rows[1].EntryHash = &hash
+			},
+			want: map[string]int64{ProblemBrokenLink: 3},
+		},
+		{
+			name: "deleted entry",
+			tamper: func(store *chainStore) {
+				store.rows = append(store.rows[:1], store.rows[2:]...)
+			},
+			want: map[string]int64{ProblemMissingEntries: 3, ProblemBrokenLink: 3},
+		},
+		{
+			// Rewriting the chain from the change on hides it from
+			// everything but the anchor
+			name: "rewritten chain under an anchor",
+			tamper: func(store *chainStore) {
+				store.anchors = []db.AuditAnchor{{TenantID: "t1", ChainSeq: 2, EntryHash: *store.rows[1].EntryHash}}
+				store.rows[1].Action = "VOIDED"
+				for i := 1; i < len(store.rows); i++ {
+					if i > 1 {
+						store.rows[i].PrevHash = store.rows[i-1].EntryHash
+					}
+					hash, _ := Hash(store.rows[i])
+					store.rows[i].EntryHash = &hash
+				}
+			},
+			want: map[string]int64{ProblemAnchorMismatch: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &chainStore{}
+			appendEntries(t, store, 3)
+			tt.tamper(store)
+
+			report, err := Verify(context.Background(), store, "t1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := problemKinds(report)
+			if len(got) != len(tt.want) {
+				t.Fatalf("problems = %+v, want %v", report.Problems, tt.want)
+			}
+			for kind, seq := range tt.want {
+				if s, ok := got[kind]; !ok || s != seq {
+					t.Errorf("problems = %+v, want %s at %d", report.Problems, kind, seq)
+				}
+			}
+		})
+	}
+}
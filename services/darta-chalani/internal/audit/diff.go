@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// FieldChange is one field of an entry's changes as it was before and after
+// the action. Before is nil when the entry did not record it.
+type FieldChange struct {
+	Field  string
+	Before interface{}
+	After  interface{}
+}
+
+// Diff renders an entry's changes field by field, in field order. Changes
+// record a transition as {"from": ..., "to": ...}; any other value is what
+// the field was set to.
+func Diff(changes json.RawMessage) []FieldChange {
+	if len(changes) == 0 {
+		return nil
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(changes, &fields); err != nil {
+		return nil
+	}
+
+	diff := make([]FieldChange, 0, len(fields))
+	for field, v := range fields {
+		change := FieldChange{Field: field, After: v}
+		if from, to, ok := transition(v); ok {
+			change.Before, change.After = from, to
+		}
+		diff = append(diff, change)
+	}
+	sort.Slice(diff, func(i, j int) bool { return diff[i].Field < diff[j].Field })
+	return diff
+}
+
+// transition unpacks a {"from", "to"} object
+func transition(v interface{}) (from, to interface{}, ok bool) {
+	m, isMap := v.(map[string]interface{})
+	if !isMap || len(m) == 0 || len(m) > 2 {
+		return nil, nil, false
+	}
+	for k := range m {
+		if k != "from" && k != "to" {
+			return nil, nil, false
+		}
+	}
+	return m["from"], m["to"], true
+}
@@ -0,0 +1,167 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+)
+
+// verifyBatchSize is how many entries Verify reads per query
+const verifyBatchSize = 1000
+
+// maxProblems bounds the problems a report lists; a rewritten chain would
+// otherwise list every entry
+const maxProblems = 100
+
+// Kinds of problems Verify finds
+const (
+	ProblemHashMismatch   = "HASH_MISMATCH"   // The entry differs from what was hashed
+	ProblemBrokenLink     = "BROKEN_LINK"     // The entry does not name its predecessor's hash
+	ProblemMissingEntries = "MISSING_ENTRIES" // Positions in the chain are empty
+	ProblemAnchorMismatch = "ANCHOR_MISMATCH" // An anchored position holds another entry, or none
+	ProblemUnchained      = "UNCHAINED_ENTRIES"
+)
+
+// Problem is a place where the chain is not as written
+type Problem struct {
+	Kind    string
+	Seq     int64
+	EntryID string
+	Detail  string
+}
+
+// Report is the outcome of verifying a tenant's chain
+type Report struct {
+	Entries   int64 // Entries whose hashes were recomputed
+	HeadSeq   int64
+	HeadHash  string
+	Anchors   int // Anchors compared against the chain
+	Problems  []Problem
+	Truncated bool // More problems were found than listed
+}
+
+// Intact reports whether the chain verified without problems
+func (r *Report) Intact() bool {
+	return len(r.Problems) == 0
+}
+
+func (r *Report) add(p Problem) {
+	if len(r.Problems) == maxProblems {
+		r.Truncated = true
+		return
+	}
+	r.Problems = append(r.Problems, p)
+}
+
+// Verify recomputes a tenant's chain from its first entry, checking each
+// entry's hash and link, that no position is empty, and that every anchor
+// still matches the entry at its position
+func Verify(ctx context.Context, queries db.Querier, tenantID string) (*Report, error) {
+	anchors, err := queries.ListAuditAnchors(ctx, db.ListAuditAnchorsParams{TenantID: tenantID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit anchors: %w", err)
+	}
+	anchored := make(map[int64]string, len(anchors))
+	for _, a := range anchors {
+		anchored[a.ChainSeq] = a.EntryHash
+	}
+
+	report := &Report{}
+	var started pgtype.Timestamptz
+	var after int64
+	for {
+		rows, err := queries.ListAuditChain(ctx, db.ListAuditChainParams{
+			TenantID:   tenantID,
+			AfterSeq:   after,
+			LimitCount: verifyBatchSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read audit chain: %w", err)
+		}
+		for _, row := range rows {
+			report.check(row, anchored)
+			if !started.Valid {
+				started = row.PerformedAt
+			}
+		}
+		if len(rows) < verifyBatchSize {
+			break
+		}
+		after = *rows[len(rows)-1].ChainSeq
+	}
+
+	// Anchors left over name entries the chain no longer has
+	for _, a := range anchors {
+		if _, ok := anchored[a.ChainSeq]; !ok {
+			continue
+		}
+		detail := fmt.Sprintf("the entry anchored with hash %s is missing", a.EntryHash)
+		if a.ChainSeq > report.HeadSeq {
+			detail = fmt.Sprintf("the entry anchored with hash %s is beyond the head of the chain", a.EntryHash)
+		}
+		report.add(Problem{Kind: ProblemAnchorMismatch, Seq: a.ChainSeq, Detail: detail})
+		report.Anchors++
+	}
+
+	if started.Valid {
+		n, err := queries.CountUnchainedAuditEntries(ctx, db.CountUnchainedAuditEntriesParams{TenantID: tenantID, Since: started})
+		if err != nil {
+			return nil, fmt.Errorf("failed to count unchained audit entries: %w", err)
+		}
+		if n > 0 {
+			report.add(Problem{
+				Kind:   ProblemUnchained,
+				Detail: fmt.Sprintf("%d entries outside the chain were written after it began", n),
+			})
+		}
+	}
+	return report, nil
+}
+
+// check verifies the next entry of the chain against the one before it
+func (r *Report) check(row db.AuditTrail, anchored map[int64]string) {
+	seq := *row.ChainSeq
+	id := row.ID.String()
+	if seq != r.HeadSeq+1 {
+		r.add(Problem{
+			Kind:    ProblemMissingEntries,
+			Seq:     seq,
+			EntryID: id,
+			Detail:  fmt.Sprintf("entries %d to %d are missing", r.HeadSeq+1, seq-1),
+		})
+	}
+
+	switch {
+	case r.HeadSeq == 0 && seq == 1 && row.PrevHash != nil:
+		r.add(Problem{Kind: ProblemBrokenLink, Seq: seq, EntryID: id, Detail: "the first entry names a predecessor"})
+	case seq > 1 && (row.PrevHash == nil || *row.PrevHash != r.HeadHash):
+		r.add(Problem{Kind: ProblemBrokenLink, Seq: seq, EntryID: id, Detail: "the entry does not name the previous entry's hash"})
+	}
+
+	entryHash := ""
+	if row.EntryHash != nil {
+		entryHash = *row.EntryHash
+	}
+	hash, err := Hash(row)
+	switch {
+	case err != nil:
+		r.add(Problem{Kind: ProblemHashMismatch, Seq: seq, EntryID: id, Detail: err.Error()})
+	case hash != entryHash:
+		r.add(Problem{Kind: ProblemHashMismatch, Seq: seq, EntryID: id, Detail: "the entry was changed after it was written"})
+	}
+
+	if want, ok := anchored[seq]; ok {
+		if want != entryHash {
+			r.add(Problem{Kind: ProblemAnchorMismatch, Seq: seq, EntryID: id, Detail: fmt.Sprintf("anchored with hash %s", want)})
+		}
+		r.Anchors++
+		delete(anchored, seq)
+	}
+
+	r.Entries++
+	r.HeadSeq = seq
+	r.HeadHash = entryHash
+}
@@ -1,8 +1,11 @@
 package config
 
 import (
+	"encoding/base64"
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 )
 
 const (
@@ -11,6 +14,16 @@ const (
 	defaultDBMaxConns  int32 = 10
 	defaultDBMinConns  int32 = 2
 	defaultDBTenant          = "default"
+
+	defaultSearchIndexInterval = 30 * time.Second
+	defaultSLAEvaluateInterval = time.Minute
+	defaultDuplicateWindowDays = 30
+	defaultAuditAnchorInterval = time.Hour
+	defaultIdempotencyKeyTTL   = 24 * time.Hour
+	defaultIdempotencySweep    = time.Hour
+
+	defaultKitabFontPath      = "/usr/share/fonts/truetype/noto/NotoSansDevanagari-Regular.ttf"
+	defaultKitabLatinFontPath = "/usr/share/fonts/truetype/noto/NotoSans-Regular.ttf"
 )
 
 // Config captures runtime configuration for the darta-chalani service.
@@ -19,6 +32,44 @@ type Config struct {
 	DefaultTenant string
 	GRPCPort      string
 	DatabaseDSN   string
+
+	// SearchIndexInterval is how often search documents are synced when no
+	// mutation has prompted a sync
+	SearchIndexInterval time.Duration
+
+	// SLAEvaluateInterval is how often SLA clocks are checked for breaches
+	// and escalations
+	SLAEvaluateInterval time.Duration
+
+	// DuplicateWindow is how far either side of a darta's received date
+	// suspected duplicates are looked for
+	DuplicateWindow time.Duration
+	// DuplicateBlockThreshold blocks intake of a darta whose best suspected
+	// duplicate scores at least this much, between 0 and 1. Zero only
+	// reports suspected duplicates.
+	DuplicateBlockThreshold float64
+
+	// AuditAnchorInterval is how often the head of each tenant's audit
+	// chain is anchored
+	AuditAnchorInterval time.Duration
+
+	// IdempotencyKeyTTL is how long the response of a mutation sent with an
+	// idempotency key is replayed, and IdempotencySweepInterval how often
+	// expired keys are removed
+	IdempotencyKeyTTL        time.Duration
+	IdempotencySweepInterval time.Duration
+
+	// KitabFontPath is the Devanagari TrueType font PDF registers are set
+	// in, and KitabLatinFontPath the fallback for Latin text. An empty
+	// Latin path disables the fallback.
+	KitabFontPath      string
+	KitabLatinFontPath string
+	// KitabSigningKey is the Ed25519 seed exported registers are signed
+	// with. It is required unless KitabEphemeralKey is set for development,
+	// in which case a key is generated at startup and registers cannot be
+	// verified after a restart.
+	KitabSigningKey   []byte
+	KitabEphemeralKey bool
 }
 
 // Load gathers configuration from environment variables, falling back to
@@ -31,6 +82,92 @@ func Load() (*Config, error) {
 		DatabaseDSN:   os.Getenv("DARTA_DB_DSN"),
 	}
 
+	cfg.SearchIndexInterval = defaultSearchIndexInterval
+	if v := os.Getenv("SEARCH_INDEX_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid SEARCH_INDEX_INTERVAL %q", v)
+		}
+		cfg.SearchIndexInterval = d
+	}
+
+	cfg.SLAEvaluateInterval = defaultSLAEvaluateInterval
+	if v := os.Getenv("SLA_EVALUATE_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid SLA_EVALUATE_INTERVAL %q", v)
+		}
+		cfg.SLAEvaluateInterval = d
+	}
+
+	windowDays := defaultDuplicateWindowDays
+	if v := os.Getenv("DUPLICATE_WINDOW_DAYS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid DUPLICATE_WINDOW_DAYS %q", v)
+		}
+		windowDays = n
+	}
+	cfg.DuplicateWindow = time.Duration(windowDays) * 24 * time.Hour
+
+	if v := os.Getenv("DUPLICATE_BLOCK_THRESHOLD"); v != "" {
+		t, err := strconv.ParseFloat(v, 64)
+		if err != nil || t < 0 || t > 1 {
+			return nil, fmt.Errorf("invalid DUPLICATE_BLOCK_THRESHOLD %q", v)
+		}
+		cfg.DuplicateBlockThreshold = t
+	}
+
+	cfg.AuditAnchorInterval = defaultAuditAnchorInterval
+	if v := os.Getenv("AUDIT_ANCHOR_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid AUDIT_ANCHOR_INTERVAL %q", v)
+		}
+		cfg.AuditAnchorInterval = d
+	}
+
+	cfg.IdempotencyKeyTTL = defaultIdempotencyKeyTTL
+	if v := os.Getenv("IDEMPOTENCY_KEY_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid IDEMPOTENCY_KEY_TTL %q", v)
+		}
+		cfg.IdempotencyKeyTTL = d
+	}
+
+	cfg.IdempotencySweepInterval = defaultIdempotencySweep
+	if v := os.Getenv("IDEMPOTENCY_SWEEP_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid IDEMPOTENCY_SWEEP_INTERVAL %q", v)
+		}
+		cfg.IdempotencySweepInterval = d
+	}
+
+	cfg.KitabFontPath = getEnv("KITAB_FONT_PATH", defaultKitabFontPath)
+	cfg.KitabLatinFontPath = defaultKitabLatinFontPath
+	if v, ok := os.LookupEnv("KITAB_LATIN_FONT_PATH"); ok {
+		cfg.KitabLatinFontPath = v
+	}
+	if v := os.Getenv("KITAB_SIGNING_KEY"); v != "" {
+		seed, err := base64.StdEncoding.DecodeString(v)
+		if err != nil || len(seed) != 32 {
+			return nil, fmt.Errorf("invalid KITAB_SIGNING_KEY: must be a base64 32-byte Ed25519 seed")
+		}
+		cfg.KitabSigningKey = seed
+	}
+	if v := os.Getenv("KITAB_EPHEMERAL_SIGNING_KEY"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid KITAB_EPHEMERAL_SIGNING_KEY %q", v)
+		}
+		cfg.KitabEphemeralKey = b
+	}
+	if cfg.KitabSigningKey == nil && !cfg.KitabEphemeralKey {
+		return nil, fmt.Errorf("KITAB_SIGNING_KEY is required; set KITAB_EPHEMERAL_SIGNING_KEY=true to sign with a per-process key in development")
+	}
+
 	if cfg.DatabaseDSN == "" {
 		return nil, fmt.Errorf("DARTA_DB_DSN is required")
 	}
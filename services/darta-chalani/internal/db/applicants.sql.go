@@ -9,6 +9,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const countApplicants = `-- name: CountApplicants :one
@@ -172,6 +173,53 @@ func (q *Queries) GetApplicantByEmailOrPhone(ctx context.Context, arg GetApplica
 	return i, err
 }
 
+const getApplicantsByIDs = `-- name: GetApplicantsByIDs :many
+SELECT a.id, a.type, a.full_name, a.organization, a.email, a.phone, a.address, a.identification_number, a.created_at, a.updated_at FROM applicants a
+WHERE a.id = ANY($1::uuid[])
+  AND EXISTS (
+      SELECT 1 FROM dartas d
+      WHERE d.applicant_id = a.id AND d.tenant_id = $2
+  )
+`
+
+type GetApplicantsByIDsParams struct {
+	Ids      []pgtype.UUID `json:"ids"`
+	TenantID string        `json:"tenant_id"`
+}
+
+// Applicants are shared across tenants, so only those on a darta in the
+// caller's tenant are returned
+func (q *Queries) GetApplicantsByIDs(ctx context.Context, arg GetApplicantsByIDsParams) ([]Applicant, error) {
+	rows, err := q.db.Query(ctx, getApplicantsByIDs, arg.Ids, arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Applicant
+	for rows.Next() {
+		var i Applicant
+		if err := rows.Scan(
+			&i.ID,
+			&i.Type,
+			&i.FullName,
+			&i.Organization,
+			&i.Email,
+			&i.Phone,
+			&i.Address,
+			&i.IdentificationNumber,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listApplicants = `-- name: ListApplicants :many
 SELECT id, type, full_name, organization, email, phone, address, identification_number, created_at, updated_at FROM applicants
 WHERE
@@ -191,6 +191,50 @@ func (q *Queries) GetAttachmentsByIDs(ctx context.Context, dollar_1 []pgtype.UUI
 	return items, nil
 }
 
+const getTenantAttachmentsByIDs = `-- name: GetTenantAttachmentsByIDs :many
+SELECT id, filename, original_filename, mime_type, size_bytes, storage_path, checksum, uploaded_by, uploaded_at, metadata, tenant_id, created_at FROM attachments
+WHERE id = ANY($1::uuid[])
+  AND tenant_id = $2
+`
+
+type GetTenantAttachmentsByIDsParams struct {
+	Ids      []pgtype.UUID `json:"ids"`
+	TenantID string        `json:"tenant_id"`
+}
+
+func (q *Queries) GetTenantAttachmentsByIDs(ctx context.Context, arg GetTenantAttachmentsByIDsParams) ([]Attachment, error) {
+	rows, err := q.db.Query(ctx, getTenantAttachmentsByIDs, arg.Ids, arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Attachment
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.Filename,
+			&i.OriginalFilename,
+			&i.MimeType,
+			&i.SizeBytes,
+			&i.StoragePath,
+			&i.Checksum,
+			&i.UploadedBy,
+			&i.UploadedAt,
+			&i.Metadata,
+			&i.TenantID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listAttachments = `-- name: ListAttachments :many
 SELECT id, filename, original_filename, mime_type, size_bytes, storage_path, checksum, uploaded_by, uploaded_at, metadata, tenant_id, created_at FROM attachments
 WHERE tenant_id = $1
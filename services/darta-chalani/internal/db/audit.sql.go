@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -29,49 +30,155 @@ func (q *Queries) CountAuditEntries(ctx context.Context, arg CountAuditEntriesPa
 	return count, err
 }
 
+const countAuditEntriesMatching = `-- name: CountAuditEntriesMatching :one
+SELECT COUNT(*) FROM audit_trail
+WHERE tenant_id = $1
+  AND ($2::VARCHAR IS NULL OR entity_type = $2)
+  AND ($3::UUID IS NULL OR entity_id = $3)
+  AND ($4::VARCHAR IS NULL OR performed_by = $4)
+  AND ($5::TEXT[] IS NULL OR action = ANY($5::TEXT[]))
+  AND ($6::VARCHAR IS NULL OR category = $6)
+  AND ($7::TIMESTAMPTZ IS NULL OR performed_at >= $7)
+  AND ($8::TIMESTAMPTZ IS NULL OR performed_at < $8)
+`
+
+type CountAuditEntriesMatchingParams struct {
+	TenantID    string             `json:"tenant_id"`
+	EntityType  *string            `json:"entity_type"`
+	EntityID    pgtype.UUID        `json:"entity_id"`
+	PerformedBy *string            `json:"performed_by"`
+	Actions     []string           `json:"actions"`
+	Category    *string            `json:"category"`
+	FromTime    pgtype.Timestamptz `json:"from_time"`
+	ToTime      pgtype.Timestamptz `json:"to_time"`
+}
+
+func (q *Queries) CountAuditEntriesMatching(ctx context.Context, arg CountAuditEntriesMatchingParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countAuditEntriesMatching,
+		arg.TenantID,
+		arg.EntityType,
+		arg.EntityID,
+		arg.PerformedBy,
+		arg.Actions,
+		arg.Category,
+		arg.FromTime,
+		arg.ToTime,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUnchainedAuditEntries = `-- name: CountUnchainedAuditEntries :one
+SELECT COUNT(*) FROM audit_trail
+WHERE tenant_id = $1 AND chain_seq IS NULL AND performed_at >= $2
+`
+
+type CountUnchainedAuditEntriesParams struct {
+	TenantID string             `json:"tenant_id"`
+	Since    pgtype.Timestamptz `json:"since"`
+}
+
+// Entries without a chain position written since the chain began can only
+// have been inserted around the database's checks
+func (q *Queries) CountUnchainedAuditEntries(ctx context.Context, arg CountUnchainedAuditEntriesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnchainedAuditEntries, arg.TenantID, arg.Since)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAuditAnchor = `-- name: CreateAuditAnchor :exec
+INSERT INTO audit_anchors (tenant_id, chain_seq, entry_hash)
+VALUES ($1, $2, $3)
+ON CONFLICT (tenant_id, chain_seq) DO NOTHING
+`
+
+type CreateAuditAnchorParams struct {
+	TenantID  string `json:"tenant_id"`
+	ChainSeq  int64  `json:"chain_seq"`
+	EntryHash string `json:"entry_hash"`
+}
+
+func (q *Queries) CreateAuditAnchor(ctx context.Context, arg CreateAuditAnchorParams) error {
+	_, err := q.db.Exec(ctx, createAuditAnchor, arg.TenantID, arg.ChainSeq, arg.EntryHash)
+	return err
+}
+
 const createAuditEntry = `-- name: CreateAuditEntry :one
 
 INSERT INTO audit_trail (
+    id,
     entity_type,
     entity_id,
     action,
     performed_by,
+    performed_at,
     changes,
     ip_address,
     user_agent,
     notes,
-    tenant_id
+    tenant_id,
+    category,
+    chain_seq,
+    prev_hash,
+    entry_hash,
+    request_id,
+    trace_id,
+    acting_role,
+    decision_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
-) RETURNING id, entity_type, entity_id, action, performed_by, performed_at, changes, ip_address, user_agent, notes, tenant_id
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19
+) RETURNING id, entity_type, entity_id, action, performed_by, performed_at, changes, ip_address, user_agent, notes, tenant_id, category, chain_seq, prev_hash, entry_hash, request_id, trace_id, acting_role, decision_id
 `
 
 type CreateAuditEntryParams struct {
-	EntityType  string          `json:"entity_type"`
-	EntityID    pgtype.UUID     `json:"entity_id"`
-	Action      string          `json:"action"`
-	PerformedBy string          `json:"performed_by"`
-	Changes     json.RawMessage `json:"changes"`
-	IpAddress   *string         `json:"ip_address"`
-	UserAgent   *string         `json:"user_agent"`
-	Notes       *string         `json:"notes"`
-	TenantID    string          `json:"tenant_id"`
+	ID          uuid.UUID          `json:"id"`
+	EntityType  string             `json:"entity_type"`
+	EntityID    pgtype.UUID        `json:"entity_id"`
+	Action      string             `json:"action"`
+	PerformedBy string             `json:"performed_by"`
+	PerformedAt pgtype.Timestamptz `json:"performed_at"`
+	Changes     json.RawMessage    `json:"changes"`
+	IpAddress   *string            `json:"ip_address"`
+	UserAgent   *string            `json:"user_agent"`
+	Notes       *string            `json:"notes"`
+	TenantID    string             `json:"tenant_id"`
+	Category    string             `json:"category"`
+	ChainSeq    *int64             `json:"chain_seq"`
+	PrevHash    *string            `json:"prev_hash"`
+	EntryHash   *string            `json:"entry_hash"`
+	RequestID   *string            `json:"request_id"`
+	TraceID     *string            `json:"trace_id"`
+	ActingRole  *string            `json:"acting_role"`
+	DecisionID  *string            `json:"decision_id"`
 }
 
 // ============================================================================
 // AUDIT TRAIL
 // ============================================================================
+// The ID and time are part of the entry's hash, so the caller sets them
 func (q *Queries) CreateAuditEntry(ctx context.Context, arg CreateAuditEntryParams) (AuditTrail, error) {
 	row := q.db.QueryRow(ctx, createAuditEntry,
+		arg.ID,
 		arg.EntityType,
 		arg.EntityID,
 		arg.Action,
 		arg.PerformedBy,
+		arg.PerformedAt,
 		arg.Changes,
 		arg.IpAddress,
 		arg.UserAgent,
 		arg.Notes,
 		arg.TenantID,
+		arg.Category,
+		arg.ChainSeq,
+		arg.PrevHash,
+		arg.EntryHash,
+		arg.RequestID,
+		arg.TraceID,
+		arg.ActingRole,
+		arg.DecisionID,
 	)
 	var i AuditTrail
 	err := row.Scan(
@@ -86,12 +193,44 @@ func (q *Queries) CreateAuditEntry(ctx context.Context, arg CreateAuditEntryPara
 		&i.UserAgent,
 		&i.Notes,
 		&i.TenantID,
+		&i.Category,
+		&i.ChainSeq,
+		&i.PrevHash,
+		&i.EntryHash,
+		&i.RequestID,
+		&i.TraceID,
+		&i.ActingRole,
+		&i.DecisionID,
 	)
 	return i, err
 }
 
+const getAuditChainHead = `-- name: GetAuditChainHead :one
+
+SELECT chain_seq::BIGINT AS chain_seq, entry_hash::TEXT AS entry_hash
+FROM audit_trail
+WHERE tenant_id = $1 AND chain_seq IS NOT NULL
+ORDER BY chain_seq DESC
+LIMIT 1
+`
+
+type GetAuditChainHeadRow struct {
+	ChainSeq  int64  `json:"chain_seq"`
+	EntryHash string `json:"entry_hash"`
+}
+
+// ============================================================================
+// AUDIT CHAIN
+// ============================================================================
+func (q *Queries) GetAuditChainHead(ctx context.Context, tenantID string) (GetAuditChainHeadRow, error) {
+	row := q.db.QueryRow(ctx, getAuditChainHead, tenantID)
+	var i GetAuditChainHeadRow
+	err := row.Scan(&i.ChainSeq, &i.EntryHash)
+	return i, err
+}
+
 const getAuditTrail = `-- name: GetAuditTrail :many
-SELECT id, entity_type, entity_id, action, performed_by, performed_at, changes, ip_address, user_agent, notes, tenant_id FROM audit_trail
+SELECT id, entity_type, entity_id, action, performed_by, performed_at, changes, ip_address, user_agent, notes, tenant_id, category, chain_seq, prev_hash, entry_hash, request_id, trace_id, acting_role, decision_id FROM audit_trail
 WHERE entity_type = $1 AND entity_id = $2
 ORDER BY performed_at DESC
 LIMIT $3 OFFSET $4
@@ -130,6 +269,14 @@ func (q *Queries) GetAuditTrail(ctx context.Context, arg GetAuditTrailParams) ([
 			&i.UserAgent,
 			&i.Notes,
 			&i.TenantID,
+			&i.Category,
+			&i.ChainSeq,
+			&i.PrevHash,
+			&i.EntryHash,
+			&i.RequestID,
+			&i.TraceID,
+			&i.ActingRole,
+			&i.DecisionID,
 		); err != nil {
 			return nil, err
 		}
@@ -142,7 +289,7 @@ func (q *Queries) GetAuditTrail(ctx context.Context, arg GetAuditTrailParams) ([
 }
 
 const getAuditTrailByEntity = `-- name: GetAuditTrailByEntity :many
-SELECT id, entity_type, entity_id, action, performed_by, performed_at, changes, ip_address, user_agent, notes, tenant_id FROM audit_trail
+SELECT id, entity_type, entity_id, action, performed_by, performed_at, changes, ip_address, user_agent, notes, tenant_id, category, chain_seq, prev_hash, entry_hash, request_id, trace_id, acting_role, decision_id FROM audit_trail
 WHERE entity_type = $1 
   AND tenant_id = $2
   AND ($5::TIMESTAMPTZ IS NULL OR performed_at >= $5)
@@ -188,6 +335,14 @@ func (q *Queries) GetAuditTrailByEntity(ctx context.Context, arg GetAuditTrailBy
 			&i.UserAgent,
 			&i.Notes,
 			&i.TenantID,
+			&i.Category,
+			&i.ChainSeq,
+			&i.PrevHash,
+			&i.EntryHash,
+			&i.RequestID,
+			&i.TraceID,
+			&i.ActingRole,
+			&i.DecisionID,
 		); err != nil {
 			return nil, err
 		}
@@ -200,7 +355,7 @@ func (q *Queries) GetAuditTrailByEntity(ctx context.Context, arg GetAuditTrailBy
 }
 
 const getAuditTrailByUser = `-- name: GetAuditTrailByUser :many
-SELECT id, entity_type, entity_id, action, performed_by, performed_at, changes, ip_address, user_agent, notes, tenant_id FROM audit_trail
+SELECT id, entity_type, entity_id, action, performed_by, performed_at, changes, ip_address, user_agent, notes, tenant_id, category, chain_seq, prev_hash, entry_hash, request_id, trace_id, acting_role, decision_id FROM audit_trail
 WHERE performed_by = $1 AND tenant_id = $2
 ORDER BY performed_at DESC
 LIMIT $3 OFFSET $4
@@ -239,6 +394,526 @@ func (q *Queries) GetAuditTrailByUser(ctx context.Context, arg GetAuditTrailByUs
 			&i.UserAgent,
 			&i.Notes,
 			&i.TenantID,
+			&i.Category,
+			&i.ChainSeq,
+			&i.PrevHash,
+			&i.EntryHash,
+			&i.RequestID,
+			&i.TraceID,
+			&i.ActingRole,
+			&i.DecisionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const hasPerformedAuditAction = `-- name: HasPerformedAuditAction :one
+SELECT EXISTS (
+    SELECT 1 FROM audit_trail
+    WHERE entity_type = $1
+      AND entity_id = $2
+      AND performed_by = $3
+      AND category = 'ACTIVITY'
+      AND action = ANY($4::TEXT[])
+)
+`
+
+type HasPerformedAuditActionParams struct {
+	EntityType  string      `json:"entity_type"`
+	EntityID    pgtype.UUID `json:"entity_id"`
+	PerformedBy string      `json:"performed_by"`
+	Actions     []string    `json:"actions"`
+}
+
+func (q *Queries) HasPerformedAuditAction(ctx context.Context, arg HasPerformedAuditActionParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasPerformedAuditAction,
+		arg.EntityType,
+		arg.EntityID,
+		arg.PerformedBy,
+		arg.Actions,
+	)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listAuditAnchors = `-- name: ListAuditAnchors :many
+SELECT id, tenant_id, chain_seq, entry_hash, anchored_at FROM audit_anchors
+WHERE tenant_id = $1
+  AND ($2::TIMESTAMPTZ IS NULL OR anchored_at >= $2)
+  AND ($3::TIMESTAMPTZ IS NULL OR anchored_at < $3)
+ORDER BY chain_seq
+`
+
+type ListAuditAnchorsParams struct {
+	TenantID string             `json:"tenant_id"`
+	FromTime pgtype.Timestamptz `json:"from_time"`
+	ToTime   pgtype.Timestamptz `json:"to_time"`
+}
+
+func (q *Queries) ListAuditAnchors(ctx context.Context, arg ListAuditAnchorsParams) ([]AuditAnchor, error) {
+	rows, err := q.db.Query(ctx, listAuditAnchors, arg.TenantID, arg.FromTime, arg.ToTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditAnchor
+	for rows.Next() {
+		var i AuditAnchor
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.ChainSeq,
+			&i.EntryHash,
+			&i.AnchoredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditChain = `-- name: ListAuditChain :many
+SELECT id, entity_type, entity_id, action, performed_by, performed_at, changes, ip_address, user_agent, notes, tenant_id, category, chain_seq, prev_hash, entry_hash, request_id, trace_id, acting_role, decision_id FROM audit_trail
+WHERE tenant_id = $1 AND chain_seq > $2::BIGINT
+ORDER BY chain_seq
+LIMIT $3
+`
+
+type ListAuditChainParams struct {
+	TenantID   string `json:"tenant_id"`
+	AfterSeq   int64  `json:"after_seq"`
+	LimitCount int32  `json:"limit_count"`
+}
+
+func (q *Queries) ListAuditChain(ctx context.Context, arg ListAuditChainParams) ([]AuditTrail, error) {
+	rows, err := q.db.Query(ctx, listAuditChain, arg.TenantID, arg.AfterSeq, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditTrail
+	for rows.Next() {
+		var i AuditTrail
+		if err := rows.Scan(
+			&i.ID,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Action,
+			&i.PerformedBy,
+			&i.PerformedAt,
+			&i.Changes,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Notes,
+			&i.TenantID,
+			&i.Category,
+			&i.ChainSeq,
+			&i.PrevHash,
+			&i.EntryHash,
+			&i.RequestID,
+			&i.TraceID,
+			&i.ActingRole,
+			&i.DecisionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditChainHeads = `-- name: ListAuditChainHeads :many
+SELECT DISTINCT ON (tenant_id) tenant_id, chain_seq::BIGINT AS chain_seq, entry_hash::TEXT AS entry_hash
+FROM audit_trail
+WHERE chain_seq IS NOT NULL
+ORDER BY tenant_id, chain_seq DESC
+`
+
+type ListAuditChainHeadsRow struct {
+	TenantID  string `json:"tenant_id"`
+	ChainSeq  int64  `json:"chain_seq"`
+	EntryHash string `json:"entry_hash"`
+}
+
+func (q *Queries) ListAuditChainHeads(ctx context.Context) ([]ListAuditChainHeadsRow, error) {
+	rows, err := q.db.Query(ctx, listAuditChainHeads)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuditChainHeadsRow
+	for rows.Next() {
+		var i ListAuditChainHeadsRow
+		if err := rows.Scan(&i.TenantID, &i.ChainSeq, &i.EntryHash); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditEntriesAsc = `-- name: ListAuditEntriesAsc :many
+SELECT id, entity_type, entity_id, action, performed_by, performed_at, changes, ip_address, user_agent, notes, tenant_id, category, chain_seq, prev_hash, entry_hash, request_id, trace_id, acting_role, decision_id FROM audit_trail
+WHERE tenant_id = $1
+  AND ($2::VARCHAR IS NULL OR entity_type = $2)
+  AND ($3::UUID IS NULL OR entity_id = $3)
+  AND ($4::VARCHAR IS NULL OR performed_by = $4)
+  AND ($5::TEXT[] IS NULL OR action = ANY($5::TEXT[]))
+  AND ($6::VARCHAR IS NULL OR category = $6)
+  AND ($7::TIMESTAMPTZ IS NULL OR performed_at >= $7)
+  AND ($8::TIMESTAMPTZ IS NULL OR performed_at < $8)
+  AND (performed_at, id) > ($9::TIMESTAMPTZ, $10::UUID)
+ORDER BY performed_at ASC, id ASC
+LIMIT $11
+`
+
+type ListAuditEntriesAscParams struct {
+	TenantID    string             `json:"tenant_id"`
+	EntityType  *string            `json:"entity_type"`
+	EntityID    pgtype.UUID        `json:"entity_id"`
+	PerformedBy *string            `json:"performed_by"`
+	Actions     []string           `json:"actions"`
+	Category    *string            `json:"category"`
+	FromTime    pgtype.Timestamptz `json:"from_time"`
+	ToTime      pgtype.Timestamptz `json:"to_time"`
+	CursorKey   pgtype.Timestamptz `json:"cursor_key"`
+	CursorID    pgtype.UUID        `json:"cursor_id"`
+	Limit       int32              `json:"limit"`
+}
+
+func (q *Queries) ListAuditEntriesAsc(ctx context.Context, arg ListAuditEntriesAscParams) ([]AuditTrail, error) {
+	rows, err := q.db.Query(ctx, listAuditEntriesAsc,
+		arg.TenantID,
+		arg.EntityType,
+		arg.EntityID,
+		arg.PerformedBy,
+		arg.Actions,
+		arg.Category,
+		arg.FromTime,
+		arg.ToTime,
+		arg.CursorKey,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditTrail
+	for rows.Next() {
+		var i AuditTrail
+		if err := rows.Scan(
+			&i.ID,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Action,
+			&i.PerformedBy,
+			&i.PerformedAt,
+			&i.Changes,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Notes,
+			&i.TenantID,
+			&i.Category,
+			&i.ChainSeq,
+			&i.PrevHash,
+			&i.EntryHash,
+			&i.RequestID,
+			&i.TraceID,
+			&i.ActingRole,
+			&i.DecisionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditEntriesByCategory = `-- name: ListAuditEntriesByCategory :many
+SELECT id, entity_type, entity_id, action, performed_by, performed_at, changes, ip_address, user_agent, notes, tenant_id, category, chain_seq, prev_hash, entry_hash, request_id, trace_id, acting_role, decision_id FROM audit_trail
+WHERE tenant_id = $1 AND category = $2
+ORDER BY performed_at DESC
+LIMIT $3 OFFSET $4
+`
+
+type ListAuditEntriesByCategoryParams struct {
+	TenantID string `json:"tenant_id"`
+	Category string `json:"category"`
+	Limit    int32  `json:"limit"`
+	Offset   int32  `json:"offset"`
+}
+
+func (q *Queries) ListAuditEntriesByCategory(ctx context.Context, arg ListAuditEntriesByCategoryParams) ([]AuditTrail, error) {
+	rows, err := q.db.Query(ctx, listAuditEntriesByCategory,
+		arg.TenantID,
+		arg.Category,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditTrail
+	for rows.Next() {
+		var i AuditTrail
+		if err := rows.Scan(
+			&i.ID,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Action,
+			&i.PerformedBy,
+			&i.PerformedAt,
+			&i.Changes,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Notes,
+			&i.TenantID,
+			&i.Category,
+			&i.ChainSeq,
+			&i.PrevHash,
+			&i.EntryHash,
+			&i.RequestID,
+			&i.TraceID,
+			&i.ActingRole,
+			&i.DecisionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditEntriesDesc = `-- name: ListAuditEntriesDesc :many
+SELECT id, entity_type, entity_id, action, performed_by, performed_at, changes, ip_address, user_agent, notes, tenant_id, category, chain_seq, prev_hash, entry_hash, request_id, trace_id, acting_role, decision_id FROM audit_trail
+WHERE tenant_id = $1
+  AND ($2::VARCHAR IS NULL OR entity_type = $2)
+  AND ($3::UUID IS NULL OR entity_id = $3)
+  AND ($4::VARCHAR IS NULL OR performed_by = $4)
+  AND ($5::TEXT[] IS NULL OR action = ANY($5::TEXT[]))
+  AND ($6::VARCHAR IS NULL OR category = $6)
+  AND ($7::TIMESTAMPTZ IS NULL OR performed_at >= $7)
+  AND ($8::TIMESTAMPTZ IS NULL OR performed_at < $8)
+  AND (performed_at, id) < ($9::TIMESTAMPTZ, $10::UUID)
+ORDER BY performed_at DESC, id DESC
+LIMIT $11
+`
+
+type ListAuditEntriesDescParams struct {
+	TenantID    string             `json:"tenant_id"`
+	EntityType  *string            `json:"entity_type"`
+	EntityID    pgtype.UUID        `json:"entity_id"`
+	PerformedBy *string            `json:"performed_by"`
+	Actions     []string           `json:"actions"`
+	Category    *string            `json:"category"`
+	FromTime    pgtype.Timestamptz `json:"from_time"`
+	ToTime      pgtype.Timestamptz `json:"to_time"`
+	CursorKey   pgtype.Timestamptz `json:"cursor_key"`
+	CursorID    pgtype.UUID        `json:"cursor_id"`
+	Limit       int32              `json:"limit"`
+}
+
+func (q *Queries) ListAuditEntriesDesc(ctx context.Context, arg ListAuditEntriesDescParams) ([]AuditTrail, error) {
+	rows, err := q.db.Query(ctx, listAuditEntriesDesc,
+		arg.TenantID,
+		arg.EntityType,
+		arg.EntityID,
+		arg.PerformedBy,
+		arg.Actions,
+		arg.Category,
+		arg.FromTime,
+		arg.ToTime,
+		arg.CursorKey,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditTrail
+	for rows.Next() {
+		var i AuditTrail
+		if err := rows.Scan(
+			&i.ID,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Action,
+			&i.PerformedBy,
+			&i.PerformedAt,
+			&i.Changes,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Notes,
+			&i.TenantID,
+			&i.Category,
+			&i.ChainSeq,
+			&i.PrevHash,
+			&i.EntryHash,
+			&i.RequestID,
+			&i.TraceID,
+			&i.ActingRole,
+			&i.DecisionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEntityTimeline = `-- name: ListEntityTimeline :many
+SELECT id, entity_type, entity_id, action, performed_by, performed_at, changes, ip_address, user_agent, notes, tenant_id, category, chain_seq, prev_hash, entry_hash, request_id, trace_id, acting_role, decision_id FROM (
+    SELECT id, entity_type, entity_id, action, performed_by, performed_at, changes, ip_address, user_agent, notes, tenant_id, category, chain_seq, prev_hash, entry_hash, request_id, trace_id, acting_role, decision_id FROM audit_trail
+    WHERE tenant_id = $1
+      AND entity_type = $2
+      AND entity_id = $3
+      AND category = 'ACTIVITY'
+    ORDER BY performed_at DESC, id DESC
+    LIMIT $4
+) recent
+ORDER BY performed_at, id
+`
+
+type ListEntityTimelineParams struct {
+	TenantID   string      `json:"tenant_id"`
+	EntityType string      `json:"entity_type"`
+	EntityID   pgtype.UUID `json:"entity_id"`
+	LimitCount int32       `json:"limit_count"`
+}
+
+// The newest limit_count activity entries of an entity, returned oldest first
+func (q *Queries) ListEntityTimeline(ctx context.Context, arg ListEntityTimelineParams) ([]AuditTrail, error) {
+	rows, err := q.db.Query(ctx, listEntityTimeline,
+		arg.TenantID,
+		arg.EntityType,
+		arg.EntityID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditTrail
+	for rows.Next() {
+		var i AuditTrail
+		if err := rows.Scan(
+			&i.ID,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Action,
+			&i.PerformedBy,
+			&i.PerformedAt,
+			&i.Changes,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Notes,
+			&i.TenantID,
+			&i.Category,
+			&i.ChainSeq,
+			&i.PrevHash,
+			&i.EntryHash,
+			&i.RequestID,
+			&i.TraceID,
+			&i.ActingRole,
+			&i.DecisionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentAuditEntriesForEntities = `-- name: ListRecentAuditEntriesForEntities :many
+SELECT id, entity_type, entity_id, action, performed_by, performed_at, changes,
+       ip_address, user_agent, notes, tenant_id, category, chain_seq, prev_hash, entry_hash,
+       request_id, trace_id, acting_role, decision_id
+FROM (
+    SELECT at.id, at.entity_type, at.entity_id, at.action, at.performed_by, at.performed_at, at.changes, at.ip_address, at.user_agent, at.notes, at.tenant_id, at.category, at.chain_seq, at.prev_hash, at.entry_hash, at.request_id, at.trace_id, at.acting_role, at.decision_id,
+           ROW_NUMBER() OVER (PARTITION BY at.entity_id ORDER BY at.performed_at DESC) AS rn
+    FROM audit_trail at
+    WHERE at.entity_type = $1
+      AND at.tenant_id = $2
+      AND at.category = 'ACTIVITY'
+      AND at.entity_id = ANY($3::uuid[])
+) ranked
+WHERE rn <= $4::INT
+ORDER BY entity_id, performed_at DESC
+`
+
+type ListRecentAuditEntriesForEntitiesParams struct {
+	EntityType string        `json:"entity_type"`
+	TenantID   string        `json:"tenant_id"`
+	EntityIds  []pgtype.UUID `json:"entity_ids"`
+	PerEntity  int32         `json:"per_entity"`
+}
+
+func (q *Queries) ListRecentAuditEntriesForEntities(ctx context.Context, arg ListRecentAuditEntriesForEntitiesParams) ([]AuditTrail, error) {
+	rows, err := q.db.Query(ctx, listRecentAuditEntriesForEntities,
+		arg.EntityType,
+		arg.TenantID,
+		arg.EntityIds,
+		arg.PerEntity,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditTrail
+	for rows.Next() {
+		var i AuditTrail
+		if err := rows.Scan(
+			&i.ID,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Action,
+			&i.PerformedBy,
+			&i.PerformedAt,
+			&i.Changes,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Notes,
+			&i.TenantID,
+			&i.Category,
+			&i.ChainSeq,
+			&i.PrevHash,
+			&i.EntryHash,
+			&i.RequestID,
+			&i.TraceID,
+			&i.ActingRole,
+			&i.DecisionID,
 		); err != nil {
 			return nil, err
 		}
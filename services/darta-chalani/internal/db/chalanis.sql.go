@@ -17,13 +17,20 @@ const closeChalani = `-- name: CloseChalani :one
 UPDATE chalanis
 SET 
     status = 'CLOSED',
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+WHERE id = $1 AND tenant_id = $2 AND version = $3
+RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, sla_synced_at, version
 `
 
-func (q *Queries) CloseChalani(ctx context.Context, id uuid.UUID) (Chalani, error) {
-	row := q.db.QueryRow(ctx, closeChalani, id)
+type CloseChalaniParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID string    `json:"tenant_id"`
+	Version  int64     `json:"version"`
+}
+
+func (q *Queries) CloseChalani(ctx context.Context, arg CloseChalaniParams) (Chalani, error) {
+	row := q.db.QueryRow(ctx, closeChalani, arg.ID, arg.TenantID, arg.Version)
 	var i Chalani
 	err := row.Scan(
 		&i.ID,
@@ -58,6 +65,8 @@ func (q *Queries) CloseChalani(ctx context.Context, id uuid.UUID) (Chalani, erro
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.SlaSyncedAt,
+		&i.Version,
 	)
 	return i, err
 }
@@ -67,29 +76,44 @@ SELECT COUNT(*)
 FROM chalanis c
 WHERE
     ($1::VARCHAR IS NULL OR c.fiscal_year_id = $1)
-    AND ($2::VARCHAR IS NULL OR c.status = $2)
-    AND ($3::UUID IS NULL OR c.linked_darta_id = $3)
-    AND ($4::TIMESTAMPTZ IS NULL OR c.created_at >= $4)
-    AND ($5::TIMESTAMPTZ IS NULL OR c.created_at <= $5)
-    AND c.tenant_id = $6
+    AND ($2::VARCHAR IS NULL OR c.scope = $2)
+    AND ($3::VARCHAR IS NULL OR c.ward_id = $3)
+    AND ($4::VARCHAR IS NULL OR c.status = $4)
+    AND ($5::VARCHAR IS NULL OR c.dispatch_channel = $5)
+    AND ($6::UUID IS NULL OR c.linked_darta_id = $6)
+    AND ($7::VARCHAR IS NULL OR c.created_by = $7)
+    AND ($8::TIMESTAMPTZ IS NULL OR c.created_at >= $8)
+    AND ($9::TIMESTAMPTZ IS NULL OR c.created_at <= $9)
+    AND ($10::TEXT IS NULL OR c.subject ILIKE '%' || $10 || '%')
+    AND c.tenant_id = $11
 `
 
 type CountChalanisParams struct {
-	FiscalYearID  *string            `json:"fiscal_year_id"`
-	Status        *string            `json:"status"`
-	LinkedDartaID pgtype.UUID        `json:"linked_darta_id"`
-	FromDate      pgtype.Timestamptz `json:"from_date"`
-	ToDate        pgtype.Timestamptz `json:"to_date"`
-	TenantID      string             `json:"tenant_id"`
+	FiscalYearID    *string            `json:"fiscal_year_id"`
+	Scope           *string            `json:"scope"`
+	WardID          *string            `json:"ward_id"`
+	Status          *string            `json:"status"`
+	DispatchChannel *string            `json:"dispatch_channel"`
+	LinkedDartaID   pgtype.UUID        `json:"linked_darta_id"`
+	CreatedBy       *string            `json:"created_by"`
+	FromDate        pgtype.Timestamptz `json:"from_date"`
+	ToDate          pgtype.Timestamptz `json:"to_date"`
+	Search          *string            `json:"search"`
+	TenantID        string             `json:"tenant_id"`
 }
 
 func (q *Queries) CountChalanis(ctx context.Context, arg CountChalanisParams) (int64, error) {
 	row := q.db.QueryRow(ctx, countChalanis,
 		arg.FiscalYearID,
+		arg.Scope,
+		arg.WardID,
 		arg.Status,
+		arg.DispatchChannel,
 		arg.LinkedDartaID,
+		arg.CreatedBy,
 		arg.FromDate,
 		arg.ToDate,
+		arg.Search,
 		arg.TenantID,
 	)
 	var count int64
@@ -115,7 +139,7 @@ INSERT INTO chalanis (
     metadata
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
-) RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+) RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, sla_synced_at, version
 `
 
 type CreateChalaniParams struct {
@@ -187,6 +211,8 @@ func (q *Queries) CreateChalani(ctx context.Context, arg CreateChalaniParams) (C
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.SlaSyncedAt,
+		&i.Version,
 	)
 	return i, err
 }
@@ -219,12 +245,17 @@ func (q *Queries) GetAcknowledgementRate(ctx context.Context, arg GetAcknowledge
 }
 
 const getChalani = `-- name: GetChalani :one
-SELECT c.id, c.chalani_number, c.formatted_chalani_number, c.fiscal_year_id, c.scope, c.ward_id, c.subject, c.body, c.template_id, c.linked_darta_id, c.recipient_id, c.status, c.is_fully_approved, c.dispatch_channel, c.dispatched_at, c.dispatched_by, c.tracking_id, c.courier_name, c.is_acknowledged, c.acknowledged_at, c.acknowledged_by, c.acknowledgement_proof_id, c.delivered_at, c.delivered_proof_id, c.superseded_by_id, c.supersedes_id, c.created_by, c.created_at, c.updated_at, c.tenant_id, c.idempotency_key, c.metadata, r.id, r.type, r.name, r.organization, r.email, r.phone, r.address, r.created_at, r.updated_at
+SELECT c.id, c.chalani_number, c.formatted_chalani_number, c.fiscal_year_id, c.scope, c.ward_id, c.subject, c.body, c.template_id, c.linked_darta_id, c.recipient_id, c.status, c.is_fully_approved, c.dispatch_channel, c.dispatched_at, c.dispatched_by, c.tracking_id, c.courier_name, c.is_acknowledged, c.acknowledged_at, c.acknowledged_by, c.acknowledgement_proof_id, c.delivered_at, c.delivered_proof_id, c.superseded_by_id, c.supersedes_id, c.created_by, c.created_at, c.updated_at, c.tenant_id, c.idempotency_key, c.metadata, c.sla_synced_at, c.version, r.id, r.type, r.name, r.organization, r.email, r.phone, r.address, r.created_at, r.updated_at
 FROM chalanis c
 JOIN recipients r ON c.recipient_id = r.id
-WHERE c.id = $1
+WHERE c.id = $1 AND c.tenant_id = $2
 `
 
+type GetChalaniParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID string    `json:"tenant_id"`
+}
+
 type GetChalaniRow struct {
 	ID                     uuid.UUID          `json:"id"`
 	ChalaniNumber          *int32             `json:"chalani_number"`
@@ -258,6 +289,8 @@ type GetChalaniRow struct {
 	TenantID               string             `json:"tenant_id"`
 	IdempotencyKey         *string            `json:"idempotency_key"`
 	Metadata               json.RawMessage    `json:"metadata"`
+	SlaSyncedAt            pgtype.Timestamptz `json:"sla_synced_at"`
+	Version                int64              `json:"version"`
 	ID_2                   uuid.UUID          `json:"id_2"`
 	Type                   string             `json:"type"`
 	Name                   string             `json:"name"`
@@ -269,8 +302,8 @@ type GetChalaniRow struct {
 	UpdatedAt_2            pgtype.Timestamptz `json:"updated_at_2"`
 }
 
-func (q *Queries) GetChalani(ctx context.Context, id uuid.UUID) (GetChalaniRow, error) {
-	row := q.db.QueryRow(ctx, getChalani, id)
+func (q *Queries) GetChalani(ctx context.Context, arg GetChalaniParams) (GetChalaniRow, error) {
+	row := q.db.QueryRow(ctx, getChalani, arg.ID, arg.TenantID)
 	var i GetChalaniRow
 	err := row.Scan(
 		&i.ID,
@@ -305,6 +338,8 @@ func (q *Queries) GetChalani(ctx context.Context, id uuid.UUID) (GetChalaniRow,
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.SlaSyncedAt,
+		&i.Version,
 		&i.ID_2,
 		&i.Type,
 		&i.Name,
@@ -319,7 +354,7 @@ func (q *Queries) GetChalani(ctx context.Context, id uuid.UUID) (GetChalaniRow,
 }
 
 const getChalaniByIdempotencyKey = `-- name: GetChalaniByIdempotencyKey :one
-SELECT id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata FROM chalanis
+SELECT id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, sla_synced_at, version FROM chalanis
 WHERE idempotency_key = $1 AND tenant_id = $2
 `
 
@@ -364,24 +399,28 @@ func (q *Queries) GetChalaniByIdempotencyKey(ctx context.Context, arg GetChalani
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.SlaSyncedAt,
+		&i.Version,
 	)
 	return i, err
 }
 
 const getChalaniByNumber = `-- name: GetChalaniByNumber :one
-SELECT c.id, c.chalani_number, c.formatted_chalani_number, c.fiscal_year_id, c.scope, c.ward_id, c.subject, c.body, c.template_id, c.linked_darta_id, c.recipient_id, c.status, c.is_fully_approved, c.dispatch_channel, c.dispatched_at, c.dispatched_by, c.tracking_id, c.courier_name, c.is_acknowledged, c.acknowledged_at, c.acknowledged_by, c.acknowledgement_proof_id, c.delivered_at, c.delivered_proof_id, c.superseded_by_id, c.supersedes_id, c.created_by, c.created_at, c.updated_at, c.tenant_id, c.idempotency_key, c.metadata, r.id, r.type, r.name, r.organization, r.email, r.phone, r.address, r.created_at, r.updated_at
+SELECT c.id, c.chalani_number, c.formatted_chalani_number, c.fiscal_year_id, c.scope, c.ward_id, c.subject, c.body, c.template_id, c.linked_darta_id, c.recipient_id, c.status, c.is_fully_approved, c.dispatch_channel, c.dispatched_at, c.dispatched_by, c.tracking_id, c.courier_name, c.is_acknowledged, c.acknowledged_at, c.acknowledged_by, c.acknowledgement_proof_id, c.delivered_at, c.delivered_proof_id, c.superseded_by_id, c.supersedes_id, c.created_by, c.created_at, c.updated_at, c.tenant_id, c.idempotency_key, c.metadata, c.sla_synced_at, c.version, r.id, r.type, r.name, r.organization, r.email, r.phone, r.address, r.created_at, r.updated_at
 FROM chalanis c
 JOIN recipients r ON c.recipient_id = r.id
 WHERE c.chalani_number = $1 
   AND c.fiscal_year_id = $2
   AND c.scope = $3
-  AND (c.ward_id = $4 OR (c.ward_id IS NULL AND $4::VARCHAR IS NULL))
+  AND c.tenant_id = $4
+  AND (c.ward_id = $5 OR (c.ward_id IS NULL AND $5::VARCHAR IS NULL))
 `
 
 type GetChalaniByNumberParams struct {
 	ChalaniNumber *int32  `json:"chalani_number"`
 	FiscalYearID  string  `json:"fiscal_year_id"`
 	Scope         string  `json:"scope"`
+	TenantID      string  `json:"tenant_id"`
 	WardID        *string `json:"ward_id"`
 }
 
@@ -418,6 +457,8 @@ type GetChalaniByNumberRow struct {
 	TenantID               string             `json:"tenant_id"`
 	IdempotencyKey         *string            `json:"idempotency_key"`
 	Metadata               json.RawMessage    `json:"metadata"`
+	SlaSyncedAt            pgtype.Timestamptz `json:"sla_synced_at"`
+	Version                int64              `json:"version"`
 	ID_2                   uuid.UUID          `json:"id_2"`
 	Type                   string             `json:"type"`
 	Name                   string             `json:"name"`
@@ -434,6 +475,7 @@ func (q *Queries) GetChalaniByNumber(ctx context.Context, arg GetChalaniByNumber
 		arg.ChalaniNumber,
 		arg.FiscalYearID,
 		arg.Scope,
+		arg.TenantID,
 		arg.WardID,
 	)
 	var i GetChalaniByNumberRow
@@ -470,6 +512,8 @@ func (q *Queries) GetChalaniByNumber(ctx context.Context, arg GetChalaniByNumber
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.SlaSyncedAt,
+		&i.Version,
 		&i.ID_2,
 		&i.Type,
 		&i.Name,
@@ -484,11 +528,16 @@ func (q *Queries) GetChalaniByNumber(ctx context.Context, arg GetChalaniByNumber
 }
 
 const getChalaniSimple = `-- name: GetChalaniSimple :one
-SELECT id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata FROM chalanis WHERE id = $1
+SELECT id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, sla_synced_at, version FROM chalanis WHERE id = $1 AND tenant_id = $2
 `
 
-func (q *Queries) GetChalaniSimple(ctx context.Context, id uuid.UUID) (Chalani, error) {
-	row := q.db.QueryRow(ctx, getChalaniSimple, id)
+type GetChalaniSimpleParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID string    `json:"tenant_id"`
+}
+
+func (q *Queries) GetChalaniSimple(ctx context.Context, arg GetChalaniSimpleParams) (Chalani, error) {
+	row := q.db.QueryRow(ctx, getChalaniSimple, arg.ID, arg.TenantID)
 	var i Chalani
 	err := row.Scan(
 		&i.ID,
@@ -523,6 +572,8 @@ func (q *Queries) GetChalaniSimple(ctx context.Context, id uuid.UUID) (Chalani,
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.SlaSyncedAt,
+		&i.Version,
 	)
 	return i, err
 }
@@ -605,84 +656,95 @@ func (q *Queries) GetChalaniStatsByStatus(ctx context.Context, arg GetChalaniSta
 	return items, nil
 }
 
-const getMyChalani = `-- name: GetMyChalani :many
-SELECT c.id, c.chalani_number, c.formatted_chalani_number, c.fiscal_year_id, c.scope, c.ward_id, c.subject, c.body, c.template_id, c.linked_darta_id, c.recipient_id, c.status, c.is_fully_approved, c.dispatch_channel, c.dispatched_at, c.dispatched_by, c.tracking_id, c.courier_name, c.is_acknowledged, c.acknowledged_at, c.acknowledged_by, c.acknowledgement_proof_id, c.delivered_at, c.delivered_proof_id, c.superseded_by_id, c.supersedes_id, c.created_by, c.created_at, c.updated_at, c.tenant_id, c.idempotency_key, c.metadata, r.id, r.type, r.name, r.organization, r.email, r.phone, r.address, r.created_at, r.updated_at
-FROM chalanis c
-JOIN recipients r ON c.recipient_id = r.id
-WHERE c.created_by = $1
-  AND ($5::VARCHAR IS NULL OR c.status = $5)
-  AND c.tenant_id = $2
-ORDER BY c.created_at DESC
-LIMIT $3 OFFSET $4
+const getNextChalaniNumber = `-- name: GetNextChalaniNumber :one
+SELECT COALESCE(MAX(chalani_number), 0) + 1 as next_number
+FROM chalanis
+WHERE fiscal_year_id = $1
+  AND scope = $2
+  AND (ward_id = $4 OR (ward_id IS NULL AND $4::VARCHAR IS NULL))
+  AND tenant_id = $3
 `
 
-type GetMyChalaniParams struct {
-	CreatedBy string  `json:"created_by"`
-	TenantID  string  `json:"tenant_id"`
-	Limit     int32   `json:"limit"`
-	Offset    int32   `json:"offset"`
-	Status    *string `json:"status"`
+type GetNextChalaniNumberParams struct {
+	FiscalYearID string  `json:"fiscal_year_id"`
+	Scope        string  `json:"scope"`
+	TenantID     string  `json:"tenant_id"`
+	WardID       *string `json:"ward_id"`
 }
 
-type GetMyChalaniRow struct {
-	ID                     uuid.UUID          `json:"id"`
-	ChalaniNumber          *int32             `json:"chalani_number"`
-	FormattedChalaniNumber *string            `json:"formatted_chalani_number"`
-	FiscalYearID           string             `json:"fiscal_year_id"`
-	Scope                  string             `json:"scope"`
-	WardID                 *string            `json:"ward_id"`
-	Subject                string             `json:"subject"`
-	Body                   string             `json:"body"`
-	TemplateID             *string            `json:"template_id"`
-	LinkedDartaID          *uuid.UUID         `json:"linked_darta_id"`
-	RecipientID            uuid.UUID          `json:"recipient_id"`
-	Status                 string             `json:"status"`
-	IsFullyApproved        bool               `json:"is_fully_approved"`
-	DispatchChannel        *string            `json:"dispatch_channel"`
-	DispatchedAt           pgtype.Timestamptz `json:"dispatched_at"`
-	DispatchedBy           *string            `json:"dispatched_by"`
-	TrackingID             *string            `json:"tracking_id"`
-	CourierName            *string            `json:"courier_name"`
-	IsAcknowledged         bool               `json:"is_acknowledged"`
-	AcknowledgedAt         pgtype.Timestamptz `json:"acknowledged_at"`
-	AcknowledgedBy         *string            `json:"acknowledged_by"`
-	AcknowledgementProofID pgtype.UUID        `json:"acknowledgement_proof_id"`
-	DeliveredAt            pgtype.Timestamptz `json:"delivered_at"`
-	DeliveredProofID       pgtype.UUID        `json:"delivered_proof_id"`
-	SupersededByID         pgtype.UUID        `json:"superseded_by_id"`
-	SupersedesID           pgtype.UUID        `json:"supersedes_id"`
-	CreatedBy              string             `json:"created_by"`
-	CreatedAt              pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt              pgtype.Timestamptz `json:"updated_at"`
-	TenantID               string             `json:"tenant_id"`
-	IdempotencyKey         *string            `json:"idempotency_key"`
-	Metadata               json.RawMessage    `json:"metadata"`
-	ID_2                   uuid.UUID          `json:"id_2"`
-	Type                   string             `json:"type"`
-	Name                   string             `json:"name"`
-	Organization           *string            `json:"organization"`
-	Email                  *string            `json:"email"`
-	Phone                  *string            `json:"phone"`
-	Address                string             `json:"address"`
-	CreatedAt_2            pgtype.Timestamptz `json:"created_at_2"`
-	UpdatedAt_2            pgtype.Timestamptz `json:"updated_at_2"`
+func (q *Queries) GetNextChalaniNumber(ctx context.Context, arg GetNextChalaniNumberParams) (int32, error) {
+	row := q.db.QueryRow(ctx, getNextChalaniNumber,
+		arg.FiscalYearID,
+		arg.Scope,
+		arg.TenantID,
+		arg.WardID,
+	)
+	var next_number int32
+	err := row.Scan(&next_number)
+	return next_number, err
 }
 
-func (q *Queries) GetMyChalani(ctx context.Context, arg GetMyChalaniParams) ([]GetMyChalaniRow, error) {
-	rows, err := q.db.Query(ctx, getMyChalani,
+const listChalanisByChalaniNumberAsc = `-- name: ListChalanisByChalaniNumberAsc :many
+SELECT c.id, c.chalani_number, c.formatted_chalani_number, c.fiscal_year_id, c.scope, c.ward_id, c.subject, c.body, c.template_id, c.linked_darta_id, c.recipient_id, c.status, c.is_fully_approved, c.dispatch_channel, c.dispatched_at, c.dispatched_by, c.tracking_id, c.courier_name, c.is_acknowledged, c.acknowledged_at, c.acknowledged_by, c.acknowledgement_proof_id, c.delivered_at, c.delivered_proof_id, c.superseded_by_id, c.supersedes_id, c.created_by, c.created_at, c.updated_at, c.tenant_id, c.idempotency_key, c.metadata, c.sla_synced_at, c.version
+FROM chalanis c
+WHERE
+    ($1::VARCHAR IS NULL OR c.fiscal_year_id = $1)
+    AND ($2::VARCHAR IS NULL OR c.scope = $2)
+    AND ($3::VARCHAR IS NULL OR c.ward_id = $3)
+    AND ($4::VARCHAR IS NULL OR c.status = $4)
+    AND ($5::VARCHAR IS NULL OR c.dispatch_channel = $5)
+    AND ($6::UUID IS NULL OR c.linked_darta_id = $6)
+    AND ($7::VARCHAR IS NULL OR c.created_by = $7)
+    AND ($8::TIMESTAMPTZ IS NULL OR c.created_at >= $8)
+    AND ($9::TIMESTAMPTZ IS NULL OR c.created_at <= $9)
+    AND ($10::TEXT IS NULL OR c.subject ILIKE '%' || $10 || '%')
+    AND c.tenant_id = $11
+    AND (COALESCE(c.chalani_number, 0), c.id) > ($12::INT, $13::UUID)
+ORDER BY COALESCE(c.chalani_number, 0) ASC, c.id ASC
+LIMIT $14
+`
+
+type ListChalanisByChalaniNumberAscParams struct {
+	FiscalYearID    *string            `json:"fiscal_year_id"`
+	Scope           *string            `json:"scope"`
+	WardID          *string            `json:"ward_id"`
+	Status          *string            `json:"status"`
+	DispatchChannel *string            `json:"dispatch_channel"`
+	LinkedDartaID   pgtype.UUID        `json:"linked_darta_id"`
+	CreatedBy       *string            `json:"created_by"`
+	FromDate        pgtype.Timestamptz `json:"from_date"`
+	ToDate          pgtype.Timestamptz `json:"to_date"`
+	Search          *string            `json:"search"`
+	TenantID        string             `json:"tenant_id"`
+	CursorKey       int32              `json:"cursor_key"`
+	CursorID        pgtype.UUID        `json:"cursor_id"`
+	Limit           int32              `json:"limit"`
+}
+
+func (q *Queries) ListChalanisByChalaniNumberAsc(ctx context.Context, arg ListChalanisByChalaniNumberAscParams) ([]Chalani, error) {
+	rows, err := q.db.Query(ctx, listChalanisByChalaniNumberAsc,
+		arg.FiscalYearID,
+		arg.Scope,
+		arg.WardID,
+		arg.Status,
+		arg.DispatchChannel,
+		arg.LinkedDartaID,
 		arg.CreatedBy,
+		arg.FromDate,
+		arg.ToDate,
+		arg.Search,
 		arg.TenantID,
+		arg.CursorKey,
+		arg.CursorID,
 		arg.Limit,
-		arg.Offset,
-		arg.Status,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetMyChalaniRow
+	var items []Chalani
 	for rows.Next() {
-		var i GetMyChalaniRow
+		var i Chalani
 		if err := rows.Scan(
 			&i.ID,
 			&i.ChalaniNumber,
@@ -716,15 +778,8 @@ func (q *Queries) GetMyChalani(ctx context.Context, arg GetMyChalaniParams) ([]G
 			&i.TenantID,
 			&i.IdempotencyKey,
 			&i.Metadata,
-			&i.ID_2,
-			&i.Type,
-			&i.Name,
-			&i.Organization,
-			&i.Email,
-			&i.Phone,
-			&i.Address,
-			&i.CreatedAt_2,
-			&i.UpdatedAt_2,
+			&i.SlaSyncedAt,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -736,38 +791,116 @@ func (q *Queries) GetMyChalani(ctx context.Context, arg GetMyChalaniParams) ([]G
 	return items, nil
 }
 
-const getNextChalaniNumber = `-- name: GetNextChalaniNumber :one
-SELECT COALESCE(MAX(chalani_number), 0) + 1 as next_number
-FROM chalanis
-WHERE fiscal_year_id = $1
-  AND scope = $2
-  AND (ward_id = $4 OR (ward_id IS NULL AND $4::VARCHAR IS NULL))
-  AND tenant_id = $3
+const listChalanisByChalaniNumberDesc = `-- name: ListChalanisByChalaniNumberDesc :many
+SELECT c.id, c.chalani_number, c.formatted_chalani_number, c.fiscal_year_id, c.scope, c.ward_id, c.subject, c.body, c.template_id, c.linked_darta_id, c.recipient_id, c.status, c.is_fully_approved, c.dispatch_channel, c.dispatched_at, c.dispatched_by, c.tracking_id, c.courier_name, c.is_acknowledged, c.acknowledged_at, c.acknowledged_by, c.acknowledgement_proof_id, c.delivered_at, c.delivered_proof_id, c.superseded_by_id, c.supersedes_id, c.created_by, c.created_at, c.updated_at, c.tenant_id, c.idempotency_key, c.metadata, c.sla_synced_at, c.version
+FROM chalanis c
+WHERE
+    ($1::VARCHAR IS NULL OR c.fiscal_year_id = $1)
+    AND ($2::VARCHAR IS NULL OR c.scope = $2)
+    AND ($3::VARCHAR IS NULL OR c.ward_id = $3)
+    AND ($4::VARCHAR IS NULL OR c.status = $4)
+    AND ($5::VARCHAR IS NULL OR c.dispatch_channel = $5)
+    AND ($6::UUID IS NULL OR c.linked_darta_id = $6)
+    AND ($7::VARCHAR IS NULL OR c.created_by = $7)
+    AND ($8::TIMESTAMPTZ IS NULL OR c.created_at >= $8)
+    AND ($9::TIMESTAMPTZ IS NULL OR c.created_at <= $9)
+    AND ($10::TEXT IS NULL OR c.subject ILIKE '%' || $10 || '%')
+    AND c.tenant_id = $11
+    AND (COALESCE(c.chalani_number, 0), c.id) < ($12::INT, $13::UUID)
+ORDER BY COALESCE(c.chalani_number, 0) DESC, c.id DESC
+LIMIT $14
 `
 
-type GetNextChalaniNumberParams struct {
-	FiscalYearID string  `json:"fiscal_year_id"`
-	Scope        string  `json:"scope"`
-	TenantID     string  `json:"tenant_id"`
-	WardID       *string `json:"ward_id"`
+type ListChalanisByChalaniNumberDescParams struct {
+	FiscalYearID    *string            `json:"fiscal_year_id"`
+	Scope           *string            `json:"scope"`
+	WardID          *string            `json:"ward_id"`
+	Status          *string            `json:"status"`
+	DispatchChannel *string            `json:"dispatch_channel"`
+	LinkedDartaID   pgtype.UUID        `json:"linked_darta_id"`
+	CreatedBy       *string            `json:"created_by"`
+	FromDate        pgtype.Timestamptz `json:"from_date"`
+	ToDate          pgtype.Timestamptz `json:"to_date"`
+	Search          *string            `json:"search"`
+	TenantID        string             `json:"tenant_id"`
+	CursorKey       int32              `json:"cursor_key"`
+	CursorID        pgtype.UUID        `json:"cursor_id"`
+	Limit           int32              `json:"limit"`
 }
 
-func (q *Queries) GetNextChalaniNumber(ctx context.Context, arg GetNextChalaniNumberParams) (int32, error) {
-	row := q.db.QueryRow(ctx, getNextChalaniNumber,
+func (q *Queries) ListChalanisByChalaniNumberDesc(ctx context.Context, arg ListChalanisByChalaniNumberDescParams) ([]Chalani, error) {
+	rows, err := q.db.Query(ctx, listChalanisByChalaniNumberDesc,
 		arg.FiscalYearID,
 		arg.Scope,
-		arg.TenantID,
 		arg.WardID,
+		arg.Status,
+		arg.DispatchChannel,
+		arg.LinkedDartaID,
+		arg.CreatedBy,
+		arg.FromDate,
+		arg.ToDate,
+		arg.Search,
+		arg.TenantID,
+		arg.CursorKey,
+		arg.CursorID,
+		arg.Limit,
 	)
-	var next_number int32
-	err := row.Scan(&next_number)
-	return next_number, err
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Chalani
+	for rows.Next() {
+		var i Chalani
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChalaniNumber,
+			&i.FormattedChalaniNumber,
+			&i.FiscalYearID,
+			&i.Scope,
+			&i.WardID,
+			&i.Subject,
+			&i.Body,
+			&i.TemplateID,
+			&i.LinkedDartaID,
+			&i.RecipientID,
+			&i.Status,
+			&i.IsFullyApproved,
+			&i.DispatchChannel,
+			&i.DispatchedAt,
+			&i.DispatchedBy,
+			&i.TrackingID,
+			&i.CourierName,
+			&i.IsAcknowledged,
+			&i.AcknowledgedAt,
+			&i.AcknowledgedBy,
+			&i.AcknowledgementProofID,
+			&i.DeliveredAt,
+			&i.DeliveredProofID,
+			&i.SupersededByID,
+			&i.SupersedesID,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TenantID,
+			&i.IdempotencyKey,
+			&i.Metadata,
+			&i.SlaSyncedAt,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const listChalanis = `-- name: ListChalanis :many
-SELECT c.id, c.chalani_number, c.formatted_chalani_number, c.fiscal_year_id, c.scope, c.ward_id, c.subject, c.body, c.template_id, c.linked_darta_id, c.recipient_id, c.status, c.is_fully_approved, c.dispatch_channel, c.dispatched_at, c.dispatched_by, c.tracking_id, c.courier_name, c.is_acknowledged, c.acknowledged_at, c.acknowledged_by, c.acknowledgement_proof_id, c.delivered_at, c.delivered_proof_id, c.superseded_by_id, c.supersedes_id, c.created_by, c.created_at, c.updated_at, c.tenant_id, c.idempotency_key, c.metadata, r.id, r.type, r.name, r.organization, r.email, r.phone, r.address, r.created_at, r.updated_at
+const listChalanisByCreatedAtAsc = `-- name: ListChalanisByCreatedAtAsc :many
+SELECT c.id, c.chalani_number, c.formatted_chalani_number, c.fiscal_year_id, c.scope, c.ward_id, c.subject, c.body, c.template_id, c.linked_darta_id, c.recipient_id, c.status, c.is_fully_approved, c.dispatch_channel, c.dispatched_at, c.dispatched_by, c.tracking_id, c.courier_name, c.is_acknowledged, c.acknowledged_at, c.acknowledged_by, c.acknowledgement_proof_id, c.delivered_at, c.delivered_proof_id, c.superseded_by_id, c.supersedes_id, c.created_by, c.created_at, c.updated_at, c.tenant_id, c.idempotency_key, c.metadata, c.sla_synced_at, c.version
 FROM chalanis c
-JOIN recipients r ON c.recipient_id = r.id
 WHERE
     ($1::VARCHAR IS NULL OR c.fiscal_year_id = $1)
     AND ($2::VARCHAR IS NULL OR c.scope = $2)
@@ -780,12 +913,12 @@ WHERE
     AND ($9::TIMESTAMPTZ IS NULL OR c.created_at <= $9)
     AND ($10::TEXT IS NULL OR c.subject ILIKE '%' || $10 || '%')
     AND c.tenant_id = $11
-ORDER BY c.created_at DESC
-LIMIT $13
-OFFSET $12
+    AND (c.created_at, c.id) > ($12::TIMESTAMPTZ, $13::UUID)
+ORDER BY c.created_at ASC, c.id ASC
+LIMIT $14
 `
 
-type ListChalanisParams struct {
+type ListChalanisByCreatedAtAscParams struct {
 	FiscalYearID    *string            `json:"fiscal_year_id"`
 	Scope           *string            `json:"scope"`
 	WardID          *string            `json:"ward_id"`
@@ -797,57 +930,123 @@ type ListChalanisParams struct {
 	ToDate          pgtype.Timestamptz `json:"to_date"`
 	Search          *string            `json:"search"`
 	TenantID        string             `json:"tenant_id"`
-	Offset          int32              `json:"offset"`
+	CursorKey       pgtype.Timestamptz `json:"cursor_key"`
+	CursorID        pgtype.UUID        `json:"cursor_id"`
 	Limit           int32              `json:"limit"`
 }
 
-type ListChalanisRow struct {
-	ID                     uuid.UUID          `json:"id"`
-	ChalaniNumber          *int32             `json:"chalani_number"`
-	FormattedChalaniNumber *string            `json:"formatted_chalani_number"`
-	FiscalYearID           string             `json:"fiscal_year_id"`
-	Scope                  string             `json:"scope"`
-	WardID                 *string            `json:"ward_id"`
-	Subject                string             `json:"subject"`
-	Body                   string             `json:"body"`
-	TemplateID             *string            `json:"template_id"`
-	LinkedDartaID          *uuid.UUID         `json:"linked_darta_id"`
-	RecipientID            uuid.UUID          `json:"recipient_id"`
-	Status                 string             `json:"status"`
-	IsFullyApproved        bool               `json:"is_fully_approved"`
-	DispatchChannel        *string            `json:"dispatch_channel"`
-	DispatchedAt           pgtype.Timestamptz `json:"dispatched_at"`
-	DispatchedBy           *string            `json:"dispatched_by"`
-	TrackingID             *string            `json:"tracking_id"`
-	CourierName            *string            `json:"courier_name"`
-	IsAcknowledged         bool               `json:"is_acknowledged"`
-	AcknowledgedAt         pgtype.Timestamptz `json:"acknowledged_at"`
-	AcknowledgedBy         *string            `json:"acknowledged_by"`
-	AcknowledgementProofID pgtype.UUID        `json:"acknowledgement_proof_id"`
-	DeliveredAt            pgtype.Timestamptz `json:"delivered_at"`
-	DeliveredProofID       pgtype.UUID        `json:"delivered_proof_id"`
-	SupersededByID         pgtype.UUID        `json:"superseded_by_id"`
-	SupersedesID           pgtype.UUID        `json:"supersedes_id"`
-	CreatedBy              string             `json:"created_by"`
-	CreatedAt              pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt              pgtype.Timestamptz `json:"updated_at"`
-	TenantID               string             `json:"tenant_id"`
-	IdempotencyKey         *string            `json:"idempotency_key"`
-	Metadata               json.RawMessage    `json:"metadata"`
-	ID_2                   uuid.UUID          `json:"id_2"`
-	Type                   string             `json:"type"`
-	Name                   string             `json:"name"`
-	Organization           *string            `json:"organization"`
-	Email                  *string            `json:"email"`
-	Phone                  *string            `json:"phone"`
-	Address                string             `json:"address"`
-	CreatedAt_2            pgtype.Timestamptz `json:"created_at_2"`
-	UpdatedAt_2            pgtype.Timestamptz `json:"updated_at_2"`
+func (q *Queries) ListChalanisByCreatedAtAsc(ctx context.Context, arg ListChalanisByCreatedAtAscParams) ([]Chalani, error) {
+	rows, err := q.db.Query(ctx, listChalanisByCreatedAtAsc,
+		arg.FiscalYearID,
+		arg.Scope,
+		arg.WardID,
+		arg.Status,
+		arg.DispatchChannel,
+		arg.LinkedDartaID,
+		arg.CreatedBy,
+		arg.FromDate,
+		arg.ToDate,
+		arg.Search,
+		arg.TenantID,
+		arg.CursorKey,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Chalani
+	for rows.Next() {
+		var i Chalani
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChalaniNumber,
+			&i.FormattedChalaniNumber,
+			&i.FiscalYearID,
+			&i.Scope,
+			&i.WardID,
+			&i.Subject,
+			&i.Body,
+			&i.TemplateID,
+			&i.LinkedDartaID,
+			&i.RecipientID,
+			&i.Status,
+			&i.IsFullyApproved,
+			&i.DispatchChannel,
+			&i.DispatchedAt,
+			&i.DispatchedBy,
+			&i.TrackingID,
+			&i.CourierName,
+			&i.IsAcknowledged,
+			&i.AcknowledgedAt,
+			&i.AcknowledgedBy,
+			&i.AcknowledgementProofID,
+			&i.DeliveredAt,
+			&i.DeliveredProofID,
+			&i.SupersededByID,
+			&i.SupersedesID,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TenantID,
+			&i.IdempotencyKey,
+			&i.Metadata,
+			&i.SlaSyncedAt,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listChalanisByCreatedAtDesc = `-- name: ListChalanisByCreatedAtDesc :many
+SELECT c.id, c.chalani_number, c.formatted_chalani_number, c.fiscal_year_id, c.scope, c.ward_id, c.subject, c.body, c.template_id, c.linked_darta_id, c.recipient_id, c.status, c.is_fully_approved, c.dispatch_channel, c.dispatched_at, c.dispatched_by, c.tracking_id, c.courier_name, c.is_acknowledged, c.acknowledged_at, c.acknowledged_by, c.acknowledgement_proof_id, c.delivered_at, c.delivered_proof_id, c.superseded_by_id, c.supersedes_id, c.created_by, c.created_at, c.updated_at, c.tenant_id, c.idempotency_key, c.metadata, c.sla_synced_at, c.version
+FROM chalanis c
+WHERE
+    ($1::VARCHAR IS NULL OR c.fiscal_year_id = $1)
+    AND ($2::VARCHAR IS NULL OR c.scope = $2)
+    AND ($3::VARCHAR IS NULL OR c.ward_id = $3)
+    AND ($4::VARCHAR IS NULL OR c.status = $4)
+    AND ($5::VARCHAR IS NULL OR c.dispatch_channel = $5)
+    AND ($6::UUID IS NULL OR c.linked_darta_id = $6)
+    AND ($7::VARCHAR IS NULL OR c.created_by = $7)
+    AND ($8::TIMESTAMPTZ IS NULL OR c.created_at >= $8)
+    AND ($9::TIMESTAMPTZ IS NULL OR c.created_at <= $9)
+    AND ($10::TEXT IS NULL OR c.subject ILIKE '%' || $10 || '%')
+    AND c.tenant_id = $11
+    AND (c.created_at, c.id) < ($12::TIMESTAMPTZ, $13::UUID)
+ORDER BY c.created_at DESC, c.id DESC
+LIMIT $14
+`
+
+type ListChalanisByCreatedAtDescParams struct {
+	FiscalYearID    *string            `json:"fiscal_year_id"`
+	Scope           *string            `json:"scope"`
+	WardID          *string            `json:"ward_id"`
+	Status          *string            `json:"status"`
+	DispatchChannel *string            `json:"dispatch_channel"`
+	LinkedDartaID   pgtype.UUID        `json:"linked_darta_id"`
+	CreatedBy       *string            `json:"created_by"`
+	FromDate        pgtype.Timestamptz `json:"from_date"`
+	ToDate          pgtype.Timestamptz `json:"to_date"`
+	Search          *string            `json:"search"`
+	TenantID        string             `json:"tenant_id"`
+	CursorKey       pgtype.Timestamptz `json:"cursor_key"`
+	CursorID        pgtype.UUID        `json:"cursor_id"`
+	Limit           int32              `json:"limit"`
 }
 
 // List with filtering
-func (q *Queries) ListChalanis(ctx context.Context, arg ListChalanisParams) ([]ListChalanisRow, error) {
-	rows, err := q.db.Query(ctx, listChalanis,
+// Keyset pages, as for dartas: rows strictly after the cursor (sort key, id)
+// in the order of an idx_chalanis_tenant_* index
+func (q *Queries) ListChalanisByCreatedAtDesc(ctx context.Context, arg ListChalanisByCreatedAtDescParams) ([]Chalani, error) {
+	rows, err := q.db.Query(ctx, listChalanisByCreatedAtDesc,
 		arg.FiscalYearID,
 		arg.Scope,
 		arg.WardID,
@@ -859,16 +1058,17 @@ func (q *Queries) ListChalanis(ctx context.Context, arg ListChalanisParams) ([]L
 		arg.ToDate,
 		arg.Search,
 		arg.TenantID,
-		arg.Offset,
+		arg.CursorKey,
+		arg.CursorID,
 		arg.Limit,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListChalanisRow
+	var items []Chalani
 	for rows.Next() {
-		var i ListChalanisRow
+		var i Chalani
 		if err := rows.Scan(
 			&i.ID,
 			&i.ChalaniNumber,
@@ -902,15 +1102,8 @@ func (q *Queries) ListChalanis(ctx context.Context, arg ListChalanisParams) ([]L
 			&i.TenantID,
 			&i.IdempotencyKey,
 			&i.Metadata,
-			&i.ID_2,
-			&i.Type,
-			&i.Name,
-			&i.Organization,
-			&i.Email,
-			&i.Phone,
-			&i.Address,
-			&i.CreatedAt_2,
-			&i.UpdatedAt_2,
+			&i.SlaSyncedAt,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -928,19 +1121,26 @@ SET
     delivered_at = $2,
     delivered_proof_id = $3,
     status = 'DELIVERED',
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+WHERE id = $1 AND version = $4
+RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, sla_synced_at, version
 `
 
 type MarkChalaniDeliveredParams struct {
 	ID               uuid.UUID          `json:"id"`
 	DeliveredAt      pgtype.Timestamptz `json:"delivered_at"`
 	DeliveredProofID pgtype.UUID        `json:"delivered_proof_id"`
+	Version          int64              `json:"version"`
 }
 
 func (q *Queries) MarkChalaniDelivered(ctx context.Context, arg MarkChalaniDeliveredParams) (Chalani, error) {
-	row := q.db.QueryRow(ctx, markChalaniDelivered, arg.ID, arg.DeliveredAt, arg.DeliveredProofID)
+	row := q.db.QueryRow(ctx, markChalaniDelivered,
+		arg.ID,
+		arg.DeliveredAt,
+		arg.DeliveredProofID,
+		arg.Version,
+	)
 	var i Chalani
 	err := row.Scan(
 		&i.ID,
@@ -975,6 +1175,8 @@ func (q *Queries) MarkChalaniDelivered(ctx context.Context, arg MarkChalaniDeliv
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.SlaSyncedAt,
+		&i.Version,
 	)
 	return i, err
 }
@@ -987,9 +1189,10 @@ SET
     acknowledged_by = $3,
     acknowledgement_proof_id = $4,
     status = 'ACKNOWLEDGED',
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+WHERE id = $1 AND version = $5
+RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, sla_synced_at, version
 `
 
 type UpdateChalaniAcknowledgementParams struct {
@@ -997,6 +1200,7 @@ type UpdateChalaniAcknowledgementParams struct {
 	AcknowledgedAt         pgtype.Timestamptz `json:"acknowledged_at"`
 	AcknowledgedBy         *string            `json:"acknowledged_by"`
 	AcknowledgementProofID pgtype.UUID        `json:"acknowledgement_proof_id"`
+	Version                int64              `json:"version"`
 }
 
 func (q *Queries) UpdateChalaniAcknowledgement(ctx context.Context, arg UpdateChalaniAcknowledgementParams) (Chalani, error) {
@@ -1005,6 +1209,7 @@ func (q *Queries) UpdateChalaniAcknowledgement(ctx context.Context, arg UpdateCh
 		arg.AcknowledgedAt,
 		arg.AcknowledgedBy,
 		arg.AcknowledgementProofID,
+		arg.Version,
 	)
 	var i Chalani
 	err := row.Scan(
@@ -1040,6 +1245,8 @@ func (q *Queries) UpdateChalaniAcknowledgement(ctx context.Context, arg UpdateCh
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.SlaSyncedAt,
+		&i.Version,
 	)
 	return i, err
 }
@@ -1048,18 +1255,20 @@ const updateChalaniApprovalStatus = `-- name: UpdateChalaniApprovalStatus :one
 UPDATE chalanis
 SET 
     is_fully_approved = $2,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+WHERE id = $1 AND version = $3
+RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, sla_synced_at, version
 `
 
 type UpdateChalaniApprovalStatusParams struct {
 	ID              uuid.UUID `json:"id"`
 	IsFullyApproved bool      `json:"is_fully_approved"`
+	Version         int64     `json:"version"`
 }
 
 func (q *Queries) UpdateChalaniApprovalStatus(ctx context.Context, arg UpdateChalaniApprovalStatusParams) (Chalani, error) {
-	row := q.db.QueryRow(ctx, updateChalaniApprovalStatus, arg.ID, arg.IsFullyApproved)
+	row := q.db.QueryRow(ctx, updateChalaniApprovalStatus, arg.ID, arg.IsFullyApproved, arg.Version)
 	var i Chalani
 	err := row.Scan(
 		&i.ID,
@@ -1094,6 +1303,8 @@ func (q *Queries) UpdateChalaniApprovalStatus(ctx context.Context, arg UpdateCha
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.SlaSyncedAt,
+		&i.Version,
 	)
 	return i, err
 }
@@ -1107,9 +1318,10 @@ SET
     tracking_id = $5,
     courier_name = $6,
     status = 'DISPATCHED',
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+WHERE id = $1 AND version = $7
+RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, sla_synced_at, version
 `
 
 type UpdateChalaniDispatchParams struct {
@@ -1119,6 +1331,7 @@ type UpdateChalaniDispatchParams struct {
 	DispatchedBy    *string            `json:"dispatched_by"`
 	TrackingID      *string            `json:"tracking_id"`
 	CourierName     *string            `json:"courier_name"`
+	Version         int64              `json:"version"`
 }
 
 func (q *Queries) UpdateChalaniDispatch(ctx context.Context, arg UpdateChalaniDispatchParams) (Chalani, error) {
@@ -1129,6 +1342,7 @@ func (q *Queries) UpdateChalaniDispatch(ctx context.Context, arg UpdateChalaniDi
 		arg.DispatchedBy,
 		arg.TrackingID,
 		arg.CourierName,
+		arg.Version,
 	)
 	var i Chalani
 	err := row.Scan(
@@ -1164,6 +1378,8 @@ func (q *Queries) UpdateChalaniDispatch(ctx context.Context, arg UpdateChalaniDi
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.SlaSyncedAt,
+		&i.Version,
 	)
 	return i, err
 }
@@ -1173,19 +1389,26 @@ UPDATE chalanis
 SET 
     chalani_number = $2,
     formatted_chalani_number = $3,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+WHERE id = $1 AND version = $4
+RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, sla_synced_at, version
 `
 
 type UpdateChalaniNumberParams struct {
 	ID                     uuid.UUID `json:"id"`
 	ChalaniNumber          *int32    `json:"chalani_number"`
 	FormattedChalaniNumber *string   `json:"formatted_chalani_number"`
+	Version                int64     `json:"version"`
 }
 
 func (q *Queries) UpdateChalaniNumber(ctx context.Context, arg UpdateChalaniNumberParams) (Chalani, error) {
-	row := q.db.QueryRow(ctx, updateChalaniNumber, arg.ID, arg.ChalaniNumber, arg.FormattedChalaniNumber)
+	row := q.db.QueryRow(ctx, updateChalaniNumber,
+		arg.ID,
+		arg.ChalaniNumber,
+		arg.FormattedChalaniNumber,
+		arg.Version,
+	)
 	var i Chalani
 	err := row.Scan(
 		&i.ID,
@@ -1220,24 +1443,33 @@ func (q *Queries) UpdateChalaniNumber(ctx context.Context, arg UpdateChalaniNumb
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.SlaSyncedAt,
+		&i.Version,
 	)
 	return i, err
 }
 
 const updateChalaniStatus = `-- name: UpdateChalaniStatus :one
 UPDATE chalanis
-SET status = $2, updated_at = NOW()
-WHERE id = $1
-RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+SET status = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND tenant_id = $3 AND version = $4
+RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, sla_synced_at, version
 `
 
 type UpdateChalaniStatusParams struct {
-	ID     uuid.UUID `json:"id"`
-	Status string    `json:"status"`
+	ID       uuid.UUID `json:"id"`
+	Status   string    `json:"status"`
+	TenantID string    `json:"tenant_id"`
+	Version  int64     `json:"version"`
 }
 
 func (q *Queries) UpdateChalaniStatus(ctx context.Context, arg UpdateChalaniStatusParams) (Chalani, error) {
-	row := q.db.QueryRow(ctx, updateChalaniStatus, arg.ID, arg.Status)
+	row := q.db.QueryRow(ctx, updateChalaniStatus,
+		arg.ID,
+		arg.Status,
+		arg.TenantID,
+		arg.Version,
+	)
 	var i Chalani
 	err := row.Scan(
 		&i.ID,
@@ -1272,6 +1504,8 @@ func (q *Queries) UpdateChalaniStatus(ctx context.Context, arg UpdateChalaniStat
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.SlaSyncedAt,
+		&i.Version,
 	)
 	return i, err
 }
@@ -1280,13 +1514,20 @@ const voidChalani = `-- name: VoidChalani :one
 UPDATE chalanis
 SET 
     status = 'VOIDED',
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+WHERE id = $1 AND tenant_id = $2 AND version = $3
+RETURNING id, chalani_number, formatted_chalani_number, fiscal_year_id, scope, ward_id, subject, body, template_id, linked_darta_id, recipient_id, status, is_fully_approved, dispatch_channel, dispatched_at, dispatched_by, tracking_id, courier_name, is_acknowledged, acknowledged_at, acknowledged_by, acknowledgement_proof_id, delivered_at, delivered_proof_id, superseded_by_id, supersedes_id, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, sla_synced_at, version
 `
 
-func (q *Queries) VoidChalani(ctx context.Context, id uuid.UUID) (Chalani, error) {
-	row := q.db.QueryRow(ctx, voidChalani, id)
+type VoidChalaniParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID string    `json:"tenant_id"`
+	Version  int64     `json:"version"`
+}
+
+func (q *Queries) VoidChalani(ctx context.Context, arg VoidChalaniParams) (Chalani, error) {
+	row := q.db.QueryRow(ctx, voidChalani, arg.ID, arg.TenantID, arg.Version)
 	var i Chalani
 	err := row.Scan(
 		&i.ID,
@@ -1321,6 +1562,8 @@ func (q *Queries) VoidChalani(ctx context.Context, id uuid.UUID) (Chalani, error
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.SlaSyncedAt,
+		&i.Version,
 	)
 	return i, err
 }
@@ -96,7 +96,7 @@ func (q *Queries) GetDartaAnnexes(ctx context.Context, dartaID pgtype.UUID) ([]A
 }
 
 const getRelatedDartas = `-- name: GetRelatedDartas :many
-SELECT d.id, d.darta_number, d.formatted_darta_number, d.fiscal_year_id, d.scope, d.ward_id, d.subject, d.applicant_id, d.intake_channel, d.received_date, d.entry_date, d.is_backdated, d.backdate_reason, d.backdate_approver_id, d.primary_document_id, d.status, d.priority, d.classification_code, d.assigned_to_unit_id, d.current_assignee_id, d.sla_deadline, d.is_overdue, d.created_by, d.created_at, d.updated_at, d.tenant_id, d.idempotency_key, d.metadata, a.id, a.type, a.full_name, a.organization, a.email, a.phone, a.address, a.identification_number, a.created_at, a.updated_at
+SELECT d.id, d.darta_number, d.formatted_darta_number, d.fiscal_year_id, d.scope, d.ward_id, d.subject, d.applicant_id, d.intake_channel, d.received_date, d.entry_date, d.is_backdated, d.backdate_reason, d.backdate_approver_id, d.primary_document_id, d.status, d.priority, d.classification_code, d.assigned_to_unit_id, d.current_assignee_id, d.sla_deadline, d.created_by, d.created_at, d.updated_at, d.tenant_id, d.idempotency_key, d.metadata, d.is_overdue, d.sla_target_minutes, d.sla_synced_at, d.superseded_by_id, d.supersedes_id, d.supersedes_number, d.version, a.id, a.type, a.full_name, a.organization, a.email, a.phone, a.address, a.identification_number, a.created_at, a.updated_at
 FROM dartas d
 JOIN darta_relationships dr ON d.id = dr.related_darta_id
 JOIN applicants a ON d.applicant_id = a.id
@@ -126,13 +126,19 @@ type GetRelatedDartasRow struct {
 	AssignedToUnitID     *string            `json:"assigned_to_unit_id"`
 	CurrentAssigneeID    *string            `json:"current_assignee_id"`
 	SlaDeadline          pgtype.Timestamptz `json:"sla_deadline"`
-	IsOverdue            *bool              `json:"is_overdue"`
 	CreatedBy            string             `json:"created_by"`
 	CreatedAt            pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
 	TenantID             string             `json:"tenant_id"`
 	IdempotencyKey       *string            `json:"idempotency_key"`
 	Metadata             json.RawMessage    `json:"metadata"`
+	IsOverdue            bool               `json:"is_overdue"`
+	SlaTargetMinutes     *int32             `json:"sla_target_minutes"`
+	SlaSyncedAt          pgtype.Timestamptz `json:"sla_synced_at"`
+	SupersededByID       pgtype.UUID        `json:"superseded_by_id"`
+	SupersedesID         pgtype.UUID        `json:"supersedes_id"`
+	SupersedesNumber     *string            `json:"supersedes_number"`
+	Version              int64              `json:"version"`
 	ID_2                 uuid.UUID          `json:"id_2"`
 	Type                 string             `json:"type"`
 	FullName             string             `json:"full_name"`
@@ -176,13 +182,19 @@ func (q *Queries) GetRelatedDartas(ctx context.Context, dartaID pgtype.UUID) ([]
 			&i.AssignedToUnitID,
 			&i.CurrentAssigneeID,
 			&i.SlaDeadline,
-			&i.IsOverdue,
 			&i.CreatedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.TenantID,
 			&i.IdempotencyKey,
 			&i.Metadata,
+			&i.IsOverdue,
+			&i.SlaTargetMinutes,
+			&i.SlaSyncedAt,
+			&i.SupersededByID,
+			&i.SupersedesID,
+			&i.SupersedesNumber,
+			&i.Version,
 			&i.ID_2,
 			&i.Type,
 			&i.FullName,
@@ -204,6 +216,85 @@ func (q *Queries) GetRelatedDartas(ctx context.Context, dartaID pgtype.UUID) ([]
 	return items, nil
 }
 
+const listDartaAnnexIDs = `-- name: ListDartaAnnexIDs :many
+SELECT da.darta_id, da.attachment_id
+FROM darta_annexes da
+JOIN dartas d ON d.id = da.darta_id
+WHERE da.darta_id = ANY($1::uuid[])
+  AND d.tenant_id = $2
+ORDER BY da.added_at ASC
+`
+
+type ListDartaAnnexIDsParams struct {
+	DartaIds []pgtype.UUID `json:"darta_ids"`
+	TenantID string        `json:"tenant_id"`
+}
+
+type ListDartaAnnexIDsRow struct {
+	DartaID      pgtype.UUID `json:"darta_id"`
+	AttachmentID pgtype.UUID `json:"attachment_id"`
+}
+
+func (q *Queries) ListDartaAnnexIDs(ctx context.Context, arg ListDartaAnnexIDsParams) ([]ListDartaAnnexIDsRow, error) {
+	rows, err := q.db.Query(ctx, listDartaAnnexIDs, arg.DartaIds, arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDartaAnnexIDsRow
+	for rows.Next() {
+		var i ListDartaAnnexIDsRow
+		if err := rows.Scan(&i.DartaID, &i.AttachmentID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDartaRelationships = `-- name: ListDartaRelationships :many
+SELECT dr.darta_id, dr.related_darta_id, dr.relationship_type
+FROM darta_relationships dr
+JOIN dartas d ON d.id = dr.darta_id
+WHERE dr.darta_id = ANY($1::uuid[])
+  AND d.tenant_id = $2
+ORDER BY dr.created_at DESC
+`
+
+type ListDartaRelationshipsParams struct {
+	DartaIds []pgtype.UUID `json:"darta_ids"`
+	TenantID string        `json:"tenant_id"`
+}
+
+type ListDartaRelationshipsRow struct {
+	DartaID          pgtype.UUID `json:"darta_id"`
+	RelatedDartaID   pgtype.UUID `json:"related_darta_id"`
+	RelationshipType string      `json:"relationship_type"`
+}
+
+func (q *Queries) ListDartaRelationships(ctx context.Context, arg ListDartaRelationshipsParams) ([]ListDartaRelationshipsRow, error) {
+	rows, err := q.db.Query(ctx, listDartaRelationships, arg.DartaIds, arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDartaRelationshipsRow
+	for rows.Next() {
+		var i ListDartaRelationshipsRow
+		if err := rows.Scan(&i.DartaID, &i.RelatedDartaID, &i.RelationshipType); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const removeAllDartaAnnexes = `-- name: RemoveAllDartaAnnexes :exec
 DELETE FROM darta_annexes WHERE darta_id = $1
 `
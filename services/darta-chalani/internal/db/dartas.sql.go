@@ -17,13 +17,20 @@ const closeDarta = `-- name: CloseDarta :one
 UPDATE dartas
 SET 
     status = 'CLOSED',
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, is_overdue, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+WHERE id = $1 AND tenant_id = $2 AND version = $3
+RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, is_overdue, sla_target_minutes, sla_synced_at, superseded_by_id, supersedes_id, supersedes_number, version
 `
 
-func (q *Queries) CloseDarta(ctx context.Context, id uuid.UUID) (Darta, error) {
-	row := q.db.QueryRow(ctx, closeDarta, id)
+type CloseDartaParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID string    `json:"tenant_id"`
+	Version  int64     `json:"version"`
+}
+
+func (q *Queries) CloseDarta(ctx context.Context, arg CloseDartaParams) (Darta, error) {
+	row := q.db.QueryRow(ctx, closeDarta, arg.ID, arg.TenantID, arg.Version)
 	var i Darta
 	err := row.Scan(
 		&i.ID,
@@ -47,13 +54,19 @@ func (q *Queries) CloseDarta(ctx context.Context, id uuid.UUID) (Darta, error) {
 		&i.AssignedToUnitID,
 		&i.CurrentAssigneeID,
 		&i.SlaDeadline,
-		&i.IsOverdue,
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.IsOverdue,
+		&i.SlaTargetMinutes,
+		&i.SlaSyncedAt,
+		&i.SupersededByID,
+		&i.SupersedesID,
+		&i.SupersedesNumber,
+		&i.Version,
 	)
 	return i, err
 }
@@ -67,13 +80,15 @@ WHERE
     AND ($3::VARCHAR IS NULL OR d.ward_id = $3)
     AND ($4::VARCHAR IS NULL OR d.status = $4)
     AND ($5::VARCHAR IS NULL OR d.priority = $5)
-    AND ($6::VARCHAR IS NULL OR d.assigned_to_unit_id = $6)
-    AND ($7::VARCHAR IS NULL OR d.current_assignee_id = $7)
-    AND ($8::BOOLEAN IS NULL OR d.is_overdue = $8)
-    AND ($9::TIMESTAMPTZ IS NULL OR d.received_date >= $9)
-    AND ($10::TIMESTAMPTZ IS NULL OR d.received_date <= $10)
-    AND ($11::TEXT IS NULL OR d.subject ILIKE '%' || $11 || '%')
-    AND d.tenant_id = $12
+    AND ($6::VARCHAR IS NULL OR d.intake_channel = $6)
+    AND ($7::VARCHAR IS NULL OR d.assigned_to_unit_id = $7)
+    AND ($8::VARCHAR IS NULL OR d.current_assignee_id = $8)
+    AND ($9::VARCHAR IS NULL OR d.created_by = $9)
+    AND ($10::BOOLEAN IS NULL OR d.is_overdue = $10)
+    AND ($11::TIMESTAMPTZ IS NULL OR d.received_date >= $11)
+    AND ($12::TIMESTAMPTZ IS NULL OR d.received_date <= $12)
+    AND ($13::TEXT IS NULL OR d.subject ILIKE '%' || $13 || '%')
+    AND d.tenant_id = $14
 `
 
 type CountDartasParams struct {
@@ -82,8 +97,10 @@ type CountDartasParams struct {
 	WardID            *string            `json:"ward_id"`
 	Status            *string            `json:"status"`
 	Priority          *string            `json:"priority"`
+	IntakeChannel     *string            `json:"intake_channel"`
 	AssignedToUnitID  *string            `json:"assigned_to_unit_id"`
 	CurrentAssigneeID *string            `json:"current_assignee_id"`
+	CreatedBy         *string            `json:"created_by"`
 	IsOverdue         *bool              `json:"is_overdue"`
 	FromDate          pgtype.Timestamptz `json:"from_date"`
 	ToDate            pgtype.Timestamptz `json:"to_date"`
@@ -98,8 +115,10 @@ func (q *Queries) CountDartas(ctx context.Context, arg CountDartasParams) (int64
 		arg.WardID,
 		arg.Status,
 		arg.Priority,
+		arg.IntakeChannel,
 		arg.AssignedToUnitID,
 		arg.CurrentAssigneeID,
+		arg.CreatedBy,
 		arg.IsOverdue,
 		arg.FromDate,
 		arg.ToDate,
@@ -111,27 +130,6 @@ func (q *Queries) CountDartas(ctx context.Context, arg CountDartasParams) (int64
 	return count, err
 }
 
-const countMyDartas = `-- name: CountMyDartas :one
-SELECT COUNT(*)
-FROM dartas
-WHERE current_assignee_id = $1
-  AND ($3::VARCHAR IS NULL OR status = $3)
-  AND tenant_id = $2
-`
-
-type CountMyDartasParams struct {
-	CurrentAssigneeID *string `json:"current_assignee_id"`
-	TenantID          string  `json:"tenant_id"`
-	Status            *string `json:"status"`
-}
-
-func (q *Queries) CountMyDartas(ctx context.Context, arg CountMyDartasParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countMyDartas, arg.CurrentAssigneeID, arg.TenantID, arg.Status)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
-}
-
 const createDarta = `-- name: CreateDarta :one
 
 INSERT INTO dartas (
@@ -155,7 +153,7 @@ INSERT INTO dartas (
     metadata
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
-) RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, is_overdue, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+) RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, is_overdue, sla_target_minutes, sla_synced_at, superseded_by_id, supersedes_id, supersedes_number, version
 `
 
 type CreateDartaParams struct {
@@ -226,24 +224,35 @@ func (q *Queries) CreateDarta(ctx context.Context, arg CreateDartaParams) (Darta
 		&i.AssignedToUnitID,
 		&i.CurrentAssigneeID,
 		&i.SlaDeadline,
-		&i.IsOverdue,
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.IsOverdue,
+		&i.SlaTargetMinutes,
+		&i.SlaSyncedAt,
+		&i.SupersededByID,
+		&i.SupersedesID,
+		&i.SupersedesNumber,
+		&i.Version,
 	)
 	return i, err
 }
 
 const getDarta = `-- name: GetDarta :one
-SELECT d.id, d.darta_number, d.formatted_darta_number, d.fiscal_year_id, d.scope, d.ward_id, d.subject, d.applicant_id, d.intake_channel, d.received_date, d.entry_date, d.is_backdated, d.backdate_reason, d.backdate_approver_id, d.primary_document_id, d.status, d.priority, d.classification_code, d.assigned_to_unit_id, d.current_assignee_id, d.sla_deadline, d.is_overdue, d.created_by, d.created_at, d.updated_at, d.tenant_id, d.idempotency_key, d.metadata, a.id, a.type, a.full_name, a.organization, a.email, a.phone, a.address, a.identification_number, a.created_at, a.updated_at 
+SELECT d.id, d.darta_number, d.formatted_darta_number, d.fiscal_year_id, d.scope, d.ward_id, d.subject, d.applicant_id, d.intake_channel, d.received_date, d.entry_date, d.is_backdated, d.backdate_reason, d.backdate_approver_id, d.primary_document_id, d.status, d.priority, d.classification_code, d.assigned_to_unit_id, d.current_assignee_id, d.sla_deadline, d.created_by, d.created_at, d.updated_at, d.tenant_id, d.idempotency_key, d.metadata, d.is_overdue, d.sla_target_minutes, d.sla_synced_at, d.superseded_by_id, d.supersedes_id, d.supersedes_number, d.version, a.id, a.type, a.full_name, a.organization, a.email, a.phone, a.address, a.identification_number, a.created_at, a.updated_at 
 FROM dartas d
 JOIN applicants a ON d.applicant_id = a.id
-WHERE d.id = $1
+WHERE d.id = $1 AND d.tenant_id = $2
 `
 
+type GetDartaParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID string    `json:"tenant_id"`
+}
+
 type GetDartaRow struct {
 	ID                   uuid.UUID          `json:"id"`
 	DartaNumber          *int32             `json:"darta_number"`
@@ -266,13 +275,19 @@ type GetDartaRow struct {
 	AssignedToUnitID     *string            `json:"assigned_to_unit_id"`
 	CurrentAssigneeID    *string            `json:"current_assignee_id"`
 	SlaDeadline          pgtype.Timestamptz `json:"sla_deadline"`
-	IsOverdue            *bool              `json:"is_overdue"`
 	CreatedBy            string             `json:"created_by"`
 	CreatedAt            pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
 	TenantID             string             `json:"tenant_id"`
 	IdempotencyKey       *string            `json:"idempotency_key"`
 	Metadata             json.RawMessage    `json:"metadata"`
+	IsOverdue            bool               `json:"is_overdue"`
+	SlaTargetMinutes     *int32             `json:"sla_target_minutes"`
+	SlaSyncedAt          pgtype.Timestamptz `json:"sla_synced_at"`
+	SupersededByID       pgtype.UUID        `json:"superseded_by_id"`
+	SupersedesID         pgtype.UUID        `json:"supersedes_id"`
+	SupersedesNumber     *string            `json:"supersedes_number"`
+	Version              int64              `json:"version"`
 	ID_2                 uuid.UUID          `json:"id_2"`
 	Type                 string             `json:"type"`
 	FullName             string             `json:"full_name"`
@@ -285,8 +300,8 @@ type GetDartaRow struct {
 	UpdatedAt_2          pgtype.Timestamptz `json:"updated_at_2"`
 }
 
-func (q *Queries) GetDarta(ctx context.Context, id uuid.UUID) (GetDartaRow, error) {
-	row := q.db.QueryRow(ctx, getDarta, id)
+func (q *Queries) GetDarta(ctx context.Context, arg GetDartaParams) (GetDartaRow, error) {
+	row := q.db.QueryRow(ctx, getDarta, arg.ID, arg.TenantID)
 	var i GetDartaRow
 	err := row.Scan(
 		&i.ID,
@@ -310,13 +325,19 @@ func (q *Queries) GetDarta(ctx context.Context, id uuid.UUID) (GetDartaRow, erro
 		&i.AssignedToUnitID,
 		&i.CurrentAssigneeID,
 		&i.SlaDeadline,
-		&i.IsOverdue,
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.IsOverdue,
+		&i.SlaTargetMinutes,
+		&i.SlaSyncedAt,
+		&i.SupersededByID,
+		&i.SupersedesID,
+		&i.SupersedesNumber,
+		&i.Version,
 		&i.ID_2,
 		&i.Type,
 		&i.FullName,
@@ -332,7 +353,7 @@ func (q *Queries) GetDarta(ctx context.Context, id uuid.UUID) (GetDartaRow, erro
 }
 
 const getDartaByIdempotencyKey = `-- name: GetDartaByIdempotencyKey :one
-SELECT id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, is_overdue, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata FROM dartas
+SELECT id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, is_overdue, sla_target_minutes, sla_synced_at, superseded_by_id, supersedes_id, supersedes_number, version FROM dartas
 WHERE idempotency_key = $1 AND tenant_id = $2
 `
 
@@ -366,31 +387,39 @@ func (q *Queries) GetDartaByIdempotencyKey(ctx context.Context, arg GetDartaById
 		&i.AssignedToUnitID,
 		&i.CurrentAssigneeID,
 		&i.SlaDeadline,
-		&i.IsOverdue,
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.IsOverdue,
+		&i.SlaTargetMinutes,
+		&i.SlaSyncedAt,
+		&i.SupersededByID,
+		&i.SupersedesID,
+		&i.SupersedesNumber,
+		&i.Version,
 	)
 	return i, err
 }
 
 const getDartaByNumber = `-- name: GetDartaByNumber :one
-SELECT d.id, d.darta_number, d.formatted_darta_number, d.fiscal_year_id, d.scope, d.ward_id, d.subject, d.applicant_id, d.intake_channel, d.received_date, d.entry_date, d.is_backdated, d.backdate_reason, d.backdate_approver_id, d.primary_document_id, d.status, d.priority, d.classification_code, d.assigned_to_unit_id, d.current_assignee_id, d.sla_deadline, d.is_overdue, d.created_by, d.created_at, d.updated_at, d.tenant_id, d.idempotency_key, d.metadata, a.id, a.type, a.full_name, a.organization, a.email, a.phone, a.address, a.identification_number, a.created_at, a.updated_at
+SELECT d.id, d.darta_number, d.formatted_darta_number, d.fiscal_year_id, d.scope, d.ward_id, d.subject, d.applicant_id, d.intake_channel, d.received_date, d.entry_date, d.is_backdated, d.backdate_reason, d.backdate_approver_id, d.primary_document_id, d.status, d.priority, d.classification_code, d.assigned_to_unit_id, d.current_assignee_id, d.sla_deadline, d.created_by, d.created_at, d.updated_at, d.tenant_id, d.idempotency_key, d.metadata, d.is_overdue, d.sla_target_minutes, d.sla_synced_at, d.superseded_by_id, d.supersedes_id, d.supersedes_number, d.version, a.id, a.type, a.full_name, a.organization, a.email, a.phone, a.address, a.identification_number, a.created_at, a.updated_at
 FROM dartas d
 JOIN applicants a ON d.applicant_id = a.id
 WHERE d.darta_number = $1 
   AND d.fiscal_year_id = $2
   AND d.scope = $3
-  AND (d.ward_id = $4 OR (d.ward_id IS NULL AND $4::VARCHAR IS NULL))
+  AND d.tenant_id = $4
+  AND (d.ward_id = $5 OR (d.ward_id IS NULL AND $5::VARCHAR IS NULL))
 `
 
 type GetDartaByNumberParams struct {
 	DartaNumber  *int32  `json:"darta_number"`
 	FiscalYearID string  `json:"fiscal_year_id"`
 	Scope        string  `json:"scope"`
+	TenantID     string  `json:"tenant_id"`
 	WardID       *string `json:"ward_id"`
 }
 
@@ -416,13 +445,19 @@ type GetDartaByNumberRow struct {
 	AssignedToUnitID     *string            `json:"assigned_to_unit_id"`
 	CurrentAssigneeID    *string            `json:"current_assignee_id"`
 	SlaDeadline          pgtype.Timestamptz `json:"sla_deadline"`
-	IsOverdue            *bool              `json:"is_overdue"`
 	CreatedBy            string             `json:"created_by"`
 	CreatedAt            pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
 	TenantID             string             `json:"tenant_id"`
 	IdempotencyKey       *string            `json:"idempotency_key"`
 	Metadata             json.RawMessage    `json:"metadata"`
+	IsOverdue            bool               `json:"is_overdue"`
+	SlaTargetMinutes     *int32             `json:"sla_target_minutes"`
+	SlaSyncedAt          pgtype.Timestamptz `json:"sla_synced_at"`
+	SupersededByID       pgtype.UUID        `json:"superseded_by_id"`
+	SupersedesID         pgtype.UUID        `json:"supersedes_id"`
+	SupersedesNumber     *string            `json:"supersedes_number"`
+	Version              int64              `json:"version"`
 	ID_2                 uuid.UUID          `json:"id_2"`
 	Type                 string             `json:"type"`
 	FullName             string             `json:"full_name"`
@@ -440,6 +475,7 @@ func (q *Queries) GetDartaByNumber(ctx context.Context, arg GetDartaByNumberPara
 		arg.DartaNumber,
 		arg.FiscalYearID,
 		arg.Scope,
+		arg.TenantID,
 		arg.WardID,
 	)
 	var i GetDartaByNumberRow
@@ -465,13 +501,19 @@ func (q *Queries) GetDartaByNumber(ctx context.Context, arg GetDartaByNumberPara
 		&i.AssignedToUnitID,
 		&i.CurrentAssigneeID,
 		&i.SlaDeadline,
-		&i.IsOverdue,
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.IsOverdue,
+		&i.SlaTargetMinutes,
+		&i.SlaSyncedAt,
+		&i.SupersededByID,
+		&i.SupersedesID,
+		&i.SupersedesNumber,
+		&i.Version,
 		&i.ID_2,
 		&i.Type,
 		&i.FullName,
@@ -487,11 +529,16 @@ func (q *Queries) GetDartaByNumber(ctx context.Context, arg GetDartaByNumberPara
 }
 
 const getDartaSimple = `-- name: GetDartaSimple :one
-SELECT id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, is_overdue, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata FROM dartas WHERE id = $1
+SELECT id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, is_overdue, sla_target_minutes, sla_synced_at, superseded_by_id, supersedes_id, supersedes_number, version FROM dartas WHERE id = $1 AND tenant_id = $2
 `
 
-func (q *Queries) GetDartaSimple(ctx context.Context, id uuid.UUID) (Darta, error) {
-	row := q.db.QueryRow(ctx, getDartaSimple, id)
+type GetDartaSimpleParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID string    `json:"tenant_id"`
+}
+
+func (q *Queries) GetDartaSimple(ctx context.Context, arg GetDartaSimpleParams) (Darta, error) {
+	row := q.db.QueryRow(ctx, getDartaSimple, arg.ID, arg.TenantID)
 	var i Darta
 	err := row.Scan(
 		&i.ID,
@@ -515,13 +562,19 @@ func (q *Queries) GetDartaSimple(ctx context.Context, id uuid.UUID) (Darta, erro
 		&i.AssignedToUnitID,
 		&i.CurrentAssigneeID,
 		&i.SlaDeadline,
-		&i.IsOverdue,
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.IsOverdue,
+		&i.SlaTargetMinutes,
+		&i.SlaSyncedAt,
+		&i.SupersededByID,
+		&i.SupersedesID,
+		&i.SupersedesNumber,
+		&i.Version,
 	)
 	return i, err
 }
@@ -533,14 +586,18 @@ WHERE tenant_id = $1
   AND ($2::VARCHAR IS NULL OR fiscal_year_id = $2)
   AND ($3::VARCHAR IS NULL OR scope = $3)
   AND ($4::VARCHAR IS NULL OR ward_id = $4)
+  AND ($5::TIMESTAMPTZ IS NULL OR received_date >= $5)
+  AND ($6::TIMESTAMPTZ IS NULL OR received_date < $6)
 GROUP BY intake_channel
 `
 
 type GetDartaStatsByChannelParams struct {
-	TenantID     string  `json:"tenant_id"`
-	FiscalYearID *string `json:"fiscal_year_id"`
-	Scope        *string `json:"scope"`
-	WardID       *string `json:"ward_id"`
+	TenantID     string             `json:"tenant_id"`
+	FiscalYearID *string            `json:"fiscal_year_id"`
+	Scope        *string            `json:"scope"`
+	WardID       *string            `json:"ward_id"`
+	ReceivedFrom pgtype.Timestamptz `json:"received_from"`
+	ReceivedTo   pgtype.Timestamptz `json:"received_to"`
 }
 
 type GetDartaStatsByChannelRow struct {
@@ -554,6 +611,8 @@ func (q *Queries) GetDartaStatsByChannel(ctx context.Context, arg GetDartaStatsB
 		arg.FiscalYearID,
 		arg.Scope,
 		arg.WardID,
+		arg.ReceivedFrom,
+		arg.ReceivedTo,
 	)
 	if err != nil {
 		return nil, err
@@ -580,14 +639,18 @@ WHERE tenant_id = $1
   AND ($2::VARCHAR IS NULL OR fiscal_year_id = $2)
   AND ($3::VARCHAR IS NULL OR scope = $3)
   AND ($4::VARCHAR IS NULL OR ward_id = $4)
+  AND ($5::TIMESTAMPTZ IS NULL OR received_date >= $5)
+  AND ($6::TIMESTAMPTZ IS NULL OR received_date < $6)
 GROUP BY status
 `
 
 type GetDartaStatsByStatusParams struct {
-	TenantID     string  `json:"tenant_id"`
-	FiscalYearID *string `json:"fiscal_year_id"`
-	Scope        *string `json:"scope"`
-	WardID       *string `json:"ward_id"`
+	TenantID     string             `json:"tenant_id"`
+	FiscalYearID *string            `json:"fiscal_year_id"`
+	Scope        *string            `json:"scope"`
+	WardID       *string            `json:"ward_id"`
+	ReceivedFrom pgtype.Timestamptz `json:"received_from"`
+	ReceivedTo   pgtype.Timestamptz `json:"received_to"`
 }
 
 type GetDartaStatsByStatusRow struct {
@@ -602,6 +665,8 @@ func (q *Queries) GetDartaStatsByStatus(ctx context.Context, arg GetDartaStatsBy
 		arg.FiscalYearID,
 		arg.Scope,
 		arg.WardID,
+		arg.ReceivedFrom,
+		arg.ReceivedTo,
 	)
 	if err != nil {
 		return nil, err
@@ -621,84 +686,118 @@ func (q *Queries) GetDartaStatsByStatus(ctx context.Context, arg GetDartaStatsBy
 	return items, nil
 }
 
-const getMyDartas = `-- name: GetMyDartas :many
-SELECT d.id, d.darta_number, d.formatted_darta_number, d.fiscal_year_id, d.scope, d.ward_id, d.subject, d.applicant_id, d.intake_channel, d.received_date, d.entry_date, d.is_backdated, d.backdate_reason, d.backdate_approver_id, d.primary_document_id, d.status, d.priority, d.classification_code, d.assigned_to_unit_id, d.current_assignee_id, d.sla_deadline, d.is_overdue, d.created_by, d.created_at, d.updated_at, d.tenant_id, d.idempotency_key, d.metadata, a.id, a.type, a.full_name, a.organization, a.email, a.phone, a.address, a.identification_number, a.created_at, a.updated_at
+const getDartaSupersessionChain = `-- name: GetDartaSupersessionChain :many
+WITH RECURSIVE earlier AS (
+    SELECT d.id, d.supersedes_id, 0 AS depth
+    FROM dartas d
+    WHERE d.id = $1 AND d.tenant_id = $2
+    UNION ALL
+    SELECT p.id, p.supersedes_id, e.depth - 1
+    FROM dartas p
+    JOIN earlier e ON p.id = e.supersedes_id
+    WHERE e.depth > -$3::INT
+), later AS (
+    SELECT d.id, d.superseded_by_id, 0 AS depth
+    FROM dartas d
+    WHERE d.id = $1 AND d.tenant_id = $2
+    UNION ALL
+    SELECT n.id, n.superseded_by_id, l.depth + 1
+    FROM dartas n
+    JOIN later l ON n.id = l.superseded_by_id
+    WHERE l.depth < $3::INT
+), chain AS (
+    SELECT id, depth FROM earlier
+    UNION
+    SELECT id, depth FROM later
+)
+SELECT d.id, d.darta_number, d.formatted_darta_number, d.fiscal_year_id, d.scope, d.ward_id, d.subject, d.applicant_id, d.intake_channel, d.received_date, d.entry_date, d.is_backdated, d.backdate_reason, d.backdate_approver_id, d.primary_document_id, d.status, d.priority, d.classification_code, d.assigned_to_unit_id, d.current_assignee_id, d.sla_deadline, d.created_by, d.created_at, d.updated_at, d.tenant_id, d.idempotency_key, d.metadata, d.is_overdue, d.sla_target_minutes, d.sla_synced_at, d.superseded_by_id, d.supersedes_id, d.supersedes_number, d.version
 FROM dartas d
-JOIN applicants a ON d.applicant_id = a.id
-WHERE d.current_assignee_id = $1
-  AND ($5::VARCHAR IS NULL OR d.status = $5)
-  AND d.tenant_id = $2
-ORDER BY 
-    CASE WHEN d.is_overdue THEN 0 ELSE 1 END,
-    d.priority DESC,
-    d.received_date DESC
-LIMIT $3 OFFSET $4
+JOIN chain c ON c.id = d.id
+ORDER BY c.depth
 `
 
-type GetMyDartasParams struct {
-	CurrentAssigneeID *string `json:"current_assignee_id"`
-	TenantID          string  `json:"tenant_id"`
-	Limit             int32   `json:"limit"`
-	Offset            int32   `json:"offset"`
-	Status            *string `json:"status"`
+type GetDartaSupersessionChainParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID string    `json:"tenant_id"`
+	MaxDepth int32     `json:"max_depth"`
 }
 
-type GetMyDartasRow struct {
-	ID                   uuid.UUID          `json:"id"`
-	DartaNumber          *int32             `json:"darta_number"`
-	FormattedDartaNumber *string            `json:"formatted_darta_number"`
-	FiscalYearID         string             `json:"fiscal_year_id"`
-	Scope                string             `json:"scope"`
-	WardID               *string            `json:"ward_id"`
-	Subject              string             `json:"subject"`
-	ApplicantID          uuid.UUID          `json:"applicant_id"`
-	IntakeChannel        string             `json:"intake_channel"`
-	ReceivedDate         pgtype.Timestamptz `json:"received_date"`
-	EntryDate            pgtype.Timestamptz `json:"entry_date"`
-	IsBackdated          bool               `json:"is_backdated"`
-	BackdateReason       *string            `json:"backdate_reason"`
-	BackdateApproverID   *string            `json:"backdate_approver_id"`
-	PrimaryDocumentID    uuid.UUID          `json:"primary_document_id"`
-	Status               string             `json:"status"`
-	Priority             string             `json:"priority"`
-	ClassificationCode   *string            `json:"classification_code"`
-	AssignedToUnitID     *string            `json:"assigned_to_unit_id"`
-	CurrentAssigneeID    *string            `json:"current_assignee_id"`
-	SlaDeadline          pgtype.Timestamptz `json:"sla_deadline"`
-	IsOverdue            *bool              `json:"is_overdue"`
-	CreatedBy            string             `json:"created_by"`
-	CreatedAt            pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
-	TenantID             string             `json:"tenant_id"`
-	IdempotencyKey       *string            `json:"idempotency_key"`
-	Metadata             json.RawMessage    `json:"metadata"`
-	ID_2                 uuid.UUID          `json:"id_2"`
-	Type                 string             `json:"type"`
-	FullName             string             `json:"full_name"`
-	Organization         *string            `json:"organization"`
-	Email                *string            `json:"email"`
-	Phone                *string            `json:"phone"`
-	Address              *string            `json:"address"`
-	IdentificationNumber *string            `json:"identification_number"`
-	CreatedAt_2          pgtype.Timestamptz `json:"created_at_2"`
-	UpdatedAt_2          pgtype.Timestamptz `json:"updated_at_2"`
+// Every darta in the supersession chain through a darta, oldest first
+func (q *Queries) GetDartaSupersessionChain(ctx context.Context, arg GetDartaSupersessionChainParams) ([]Darta, error) {
+	rows, err := q.db.Query(ctx, getDartaSupersessionChain, arg.ID, arg.TenantID, arg.MaxDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Darta
+	for rows.Next() {
+		var i Darta
+		if err := rows.Scan(
+			&i.ID,
+			&i.DartaNumber,
+			&i.FormattedDartaNumber,
+			&i.FiscalYearID,
+			&i.Scope,
+			&i.WardID,
+			&i.Subject,
+			&i.ApplicantID,
+			&i.IntakeChannel,
+			&i.ReceivedDate,
+			&i.EntryDate,
+			&i.IsBackdated,
+			&i.BackdateReason,
+			&i.BackdateApproverID,
+			&i.PrimaryDocumentID,
+			&i.Status,
+			&i.Priority,
+			&i.ClassificationCode,
+			&i.AssignedToUnitID,
+			&i.CurrentAssigneeID,
+			&i.SlaDeadline,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TenantID,
+			&i.IdempotencyKey,
+			&i.Metadata,
+			&i.IsOverdue,
+			&i.SlaTargetMinutes,
+			&i.SlaSyncedAt,
+			&i.SupersededByID,
+			&i.SupersedesID,
+			&i.SupersedesNumber,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) GetMyDartas(ctx context.Context, arg GetMyDartasParams) ([]GetMyDartasRow, error) {
-	rows, err := q.db.Query(ctx, getMyDartas,
-		arg.CurrentAssigneeID,
-		arg.TenantID,
-		arg.Limit,
-		arg.Offset,
-		arg.Status,
-	)
+const getDartasByIDs = `-- name: GetDartasByIDs :many
+SELECT id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, is_overdue, sla_target_minutes, sla_synced_at, superseded_by_id, supersedes_id, supersedes_number, version FROM dartas
+WHERE id = ANY($1::uuid[])
+  AND tenant_id = $2
+`
+
+type GetDartasByIDsParams struct {
+	Ids      []pgtype.UUID `json:"ids"`
+	TenantID string        `json:"tenant_id"`
+}
+
+func (q *Queries) GetDartasByIDs(ctx context.Context, arg GetDartasByIDsParams) ([]Darta, error) {
+	rows, err := q.db.Query(ctx, getDartasByIDs, arg.Ids, arg.TenantID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetMyDartasRow
+	var items []Darta
 	for rows.Next() {
-		var i GetMyDartasRow
+		var i Darta
 		if err := rows.Scan(
 			&i.ID,
 			&i.DartaNumber,
@@ -721,23 +820,19 @@ func (q *Queries) GetMyDartas(ctx context.Context, arg GetMyDartasParams) ([]Get
 			&i.AssignedToUnitID,
 			&i.CurrentAssigneeID,
 			&i.SlaDeadline,
-			&i.IsOverdue,
 			&i.CreatedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.TenantID,
 			&i.IdempotencyKey,
 			&i.Metadata,
-			&i.ID_2,
-			&i.Type,
-			&i.FullName,
-			&i.Organization,
-			&i.Email,
-			&i.Phone,
-			&i.Address,
-			&i.IdentificationNumber,
-			&i.CreatedAt_2,
-			&i.UpdatedAt_2,
+			&i.IsOverdue,
+			&i.SlaTargetMinutes,
+			&i.SlaSyncedAt,
+			&i.SupersededByID,
+			&i.SupersedesID,
+			&i.SupersedesNumber,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -806,10 +901,9 @@ func (q *Queries) GetOverdueCount(ctx context.Context, arg GetOverdueCountParams
 	return count, err
 }
 
-const listDartas = `-- name: ListDartas :many
-SELECT d.id, d.darta_number, d.formatted_darta_number, d.fiscal_year_id, d.scope, d.ward_id, d.subject, d.applicant_id, d.intake_channel, d.received_date, d.entry_date, d.is_backdated, d.backdate_reason, d.backdate_approver_id, d.primary_document_id, d.status, d.priority, d.classification_code, d.assigned_to_unit_id, d.current_assignee_id, d.sla_deadline, d.is_overdue, d.created_by, d.created_at, d.updated_at, d.tenant_id, d.idempotency_key, d.metadata, a.id, a.type, a.full_name, a.organization, a.email, a.phone, a.address, a.identification_number, a.created_at, a.updated_at
+const listDartasByCreatedAtAsc = `-- name: ListDartasByCreatedAtAsc :many
+SELECT d.id, d.darta_number, d.formatted_darta_number, d.fiscal_year_id, d.scope, d.ward_id, d.subject, d.applicant_id, d.intake_channel, d.received_date, d.entry_date, d.is_backdated, d.backdate_reason, d.backdate_approver_id, d.primary_document_id, d.status, d.priority, d.classification_code, d.assigned_to_unit_id, d.current_assignee_id, d.sla_deadline, d.created_by, d.created_at, d.updated_at, d.tenant_id, d.idempotency_key, d.metadata, d.is_overdue, d.sla_target_minutes, d.sla_synced_at, d.superseded_by_id, d.supersedes_id, d.supersedes_number, d.version
 FROM dartas d
-JOIN applicants a ON d.applicant_id = a.id
 WHERE
     ($1::VARCHAR IS NULL OR d.fiscal_year_id = $1)
     AND ($2::VARCHAR IS NULL OR d.scope = $2)
@@ -825,15 +919,12 @@ WHERE
     AND ($12::TIMESTAMPTZ IS NULL OR d.received_date <= $12)
     AND ($13::TEXT IS NULL OR d.subject ILIKE '%' || $13 || '%')
     AND d.tenant_id = $14
-ORDER BY 
-    CASE WHEN $15::TEXT = 'received_date' THEN d.received_date END DESC,
-    CASE WHEN $15::TEXT = 'darta_number' THEN d.darta_number END DESC,
-    d.created_at DESC
+    AND (d.created_at, d.id) > ($15::TIMESTAMPTZ, $16::UUID)
+ORDER BY d.created_at ASC, d.id ASC
 LIMIT $17
-OFFSET $16
 `
 
-type ListDartasParams struct {
+type ListDartasByCreatedAtAscParams struct {
 	FiscalYearID      *string            `json:"fiscal_year_id"`
 	Scope             *string            `json:"scope"`
 	WardID            *string            `json:"ward_id"`
@@ -848,55 +939,13 @@ type ListDartasParams struct {
 	ToDate            pgtype.Timestamptz `json:"to_date"`
 	Search            *string            `json:"search"`
 	TenantID          string             `json:"tenant_id"`
-	SortBy            string             `json:"sort_by"`
-	Offset            int32              `json:"offset"`
+	CursorKey         pgtype.Timestamptz `json:"cursor_key"`
+	CursorID          pgtype.UUID        `json:"cursor_id"`
 	Limit             int32              `json:"limit"`
 }
 
-type ListDartasRow struct {
-	ID                   uuid.UUID          `json:"id"`
-	DartaNumber          *int32             `json:"darta_number"`
-	FormattedDartaNumber *string            `json:"formatted_darta_number"`
-	FiscalYearID         string             `json:"fiscal_year_id"`
-	Scope                string             `json:"scope"`
-	WardID               *string            `json:"ward_id"`
-	Subject              string             `json:"subject"`
-	ApplicantID          uuid.UUID          `json:"applicant_id"`
-	IntakeChannel        string             `json:"intake_channel"`
-	ReceivedDate         pgtype.Timestamptz `json:"received_date"`
-	EntryDate            pgtype.Timestamptz `json:"entry_date"`
-	IsBackdated          bool               `json:"is_backdated"`
-	BackdateReason       *string            `json:"backdate_reason"`
-	BackdateApproverID   *string            `json:"backdate_approver_id"`
-	PrimaryDocumentID    uuid.UUID          `json:"primary_document_id"`
-	Status               string             `json:"status"`
-	Priority             string             `json:"priority"`
-	ClassificationCode   *string            `json:"classification_code"`
-	AssignedToUnitID     *string            `json:"assigned_to_unit_id"`
-	CurrentAssigneeID    *string            `json:"current_assignee_id"`
-	SlaDeadline          pgtype.Timestamptz `json:"sla_deadline"`
-	IsOverdue            *bool              `json:"is_overdue"`
-	CreatedBy            string             `json:"created_by"`
-	CreatedAt            pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
-	TenantID             string             `json:"tenant_id"`
-	IdempotencyKey       *string            `json:"idempotency_key"`
-	Metadata             json.RawMessage    `json:"metadata"`
-	ID_2                 uuid.UUID          `json:"id_2"`
-	Type                 string             `json:"type"`
-	FullName             string             `json:"full_name"`
-	Organization         *string            `json:"organization"`
-	Email                *string            `json:"email"`
-	Phone                *string            `json:"phone"`
-	Address              *string            `json:"address"`
-	IdentificationNumber *string            `json:"identification_number"`
-	CreatedAt_2          pgtype.Timestamptz `json:"created_at_2"`
-	UpdatedAt_2          pgtype.Timestamptz `json:"updated_at_2"`
-}
-
-// Complex queries with filtering
-func (q *Queries) ListDartas(ctx context.Context, arg ListDartasParams) ([]ListDartasRow, error) {
-	rows, err := q.db.Query(ctx, listDartas,
+func (q *Queries) ListDartasByCreatedAtAsc(ctx context.Context, arg ListDartasByCreatedAtAscParams) ([]Darta, error) {
+	rows, err := q.db.Query(ctx, listDartasByCreatedAtAsc,
 		arg.FiscalYearID,
 		arg.Scope,
 		arg.WardID,
@@ -911,17 +960,17 @@ func (q *Queries) ListDartas(ctx context.Context, arg ListDartasParams) ([]ListD
 		arg.ToDate,
 		arg.Search,
 		arg.TenantID,
-		arg.SortBy,
-		arg.Offset,
+		arg.CursorKey,
+		arg.CursorID,
 		arg.Limit,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListDartasRow
+	var items []Darta
 	for rows.Next() {
-		var i ListDartasRow
+		var i Darta
 		if err := rows.Scan(
 			&i.ID,
 			&i.DartaNumber,
@@ -944,23 +993,19 @@ func (q *Queries) ListDartas(ctx context.Context, arg ListDartasParams) ([]ListD
 			&i.AssignedToUnitID,
 			&i.CurrentAssigneeID,
 			&i.SlaDeadline,
-			&i.IsOverdue,
 			&i.CreatedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.TenantID,
 			&i.IdempotencyKey,
 			&i.Metadata,
-			&i.ID_2,
-			&i.Type,
-			&i.FullName,
-			&i.Organization,
-			&i.Email,
-			&i.Phone,
-			&i.Address,
-			&i.IdentificationNumber,
-			&i.CreatedAt_2,
-			&i.UpdatedAt_2,
+			&i.IsOverdue,
+			&i.SlaTargetMinutes,
+			&i.SlaSyncedAt,
+			&i.SupersededByID,
+			&i.SupersedesID,
+			&i.SupersedesNumber,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -972,43 +1017,647 @@ func (q *Queries) ListDartas(ctx context.Context, arg ListDartasParams) ([]ListD
 	return items, nil
 }
 
-const updateDartaAssignment = `-- name: UpdateDartaAssignment :one
-UPDATE dartas
-SET 
-    assigned_to_unit_id = $2,
-    current_assignee_id = $3,
-    sla_deadline = $4,
-    priority = COALESCE($5, priority),
-    updated_at = NOW()
-WHERE id = $1
-RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, is_overdue, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+const listDartasByCreatedAtDesc = `-- name: ListDartasByCreatedAtDesc :many
+SELECT d.id, d.darta_number, d.formatted_darta_number, d.fiscal_year_id, d.scope, d.ward_id, d.subject, d.applicant_id, d.intake_channel, d.received_date, d.entry_date, d.is_backdated, d.backdate_reason, d.backdate_approver_id, d.primary_document_id, d.status, d.priority, d.classification_code, d.assigned_to_unit_id, d.current_assignee_id, d.sla_deadline, d.created_by, d.created_at, d.updated_at, d.tenant_id, d.idempotency_key, d.metadata, d.is_overdue, d.sla_target_minutes, d.sla_synced_at, d.superseded_by_id, d.supersedes_id, d.supersedes_number, d.version
+FROM dartas d
+WHERE
+    ($1::VARCHAR IS NULL OR d.fiscal_year_id = $1)
+    AND ($2::VARCHAR IS NULL OR d.scope = $2)
+    AND ($3::VARCHAR IS NULL OR d.ward_id = $3)
+    AND ($4::VARCHAR IS NULL OR d.status = $4)
+    AND ($5::VARCHAR IS NULL OR d.priority = $5)
+    AND ($6::VARCHAR IS NULL OR d.intake_channel = $6)
+    AND ($7::VARCHAR IS NULL OR d.assigned_to_unit_id = $7)
+    AND ($8::VARCHAR IS NULL OR d.current_assignee_id = $8)
+    AND ($9::VARCHAR IS NULL OR d.created_by = $9)
+    AND ($10::BOOLEAN IS NULL OR d.is_overdue = $10)
+    AND ($11::TIMESTAMPTZ IS NULL OR d.received_date >= $11)
+    AND ($12::TIMESTAMPTZ IS NULL OR d.received_date <= $12)
+    AND ($13::TEXT IS NULL OR d.subject ILIKE '%' || $13 || '%')
+    AND d.tenant_id = $14
+    AND (d.created_at, d.id) < ($15::TIMESTAMPTZ, $16::UUID)
+ORDER BY d.created_at DESC, d.id DESC
+LIMIT $17
 `
 
-type UpdateDartaAssignmentParams struct {
-	ID                uuid.UUID          `json:"id"`
+type ListDartasByCreatedAtDescParams struct {
+	FiscalYearID      *string            `json:"fiscal_year_id"`
+	Scope             *string            `json:"scope"`
+	WardID            *string            `json:"ward_id"`
+	Status            *string            `json:"status"`
+	Priority          *string            `json:"priority"`
+	IntakeChannel     *string            `json:"intake_channel"`
 	AssignedToUnitID  *string            `json:"assigned_to_unit_id"`
 	CurrentAssigneeID *string            `json:"current_assignee_id"`
-	SlaDeadline       pgtype.Timestamptz `json:"sla_deadline"`
-	Priority          *string            `json:"priority"`
+	CreatedBy         *string            `json:"created_by"`
+	IsOverdue         *bool              `json:"is_overdue"`
+	FromDate          pgtype.Timestamptz `json:"from_date"`
+	ToDate            pgtype.Timestamptz `json:"to_date"`
+	Search            *string            `json:"search"`
+	TenantID          string             `json:"tenant_id"`
+	CursorKey         pgtype.Timestamptz `json:"cursor_key"`
+	CursorID          pgtype.UUID        `json:"cursor_id"`
+	Limit             int32              `json:"limit"`
 }
 
-func (q *Queries) UpdateDartaAssignment(ctx context.Context, arg UpdateDartaAssignmentParams) (Darta, error) {
-	row := q.db.QueryRow(ctx, updateDartaAssignment,
-		arg.ID,
+// Complex queries with filtering
+//
+// Lists page by keyset: each sort order has an ascending and a descending
+// query that returns the rows strictly after the cursor (sort key, id). The
+// first page passes a sentinel cursor before every row. The ORDER BY matches
+// an idx_dartas_tenant_* index, so a page costs an index range scan however
+// deep it is.
+func (q *Queries) ListDartasByCreatedAtDesc(ctx context.Context, arg ListDartasByCreatedAtDescParams) ([]Darta, error) {
+	rows, err := q.db.Query(ctx, listDartasByCreatedAtDesc,
+		arg.FiscalYearID,
+		arg.Scope,
+		arg.WardID,
+		arg.Status,
+		arg.Priority,
+		arg.IntakeChannel,
 		arg.AssignedToUnitID,
 		arg.CurrentAssigneeID,
-		arg.SlaDeadline,
-		arg.Priority,
+		arg.CreatedBy,
+		arg.IsOverdue,
+		arg.FromDate,
+		arg.ToDate,
+		arg.Search,
+		arg.TenantID,
+		arg.CursorKey,
+		arg.CursorID,
+		arg.Limit,
 	)
-	var i Darta
-	err := row.Scan(
-		&i.ID,
-		&i.DartaNumber,
-		&i.FormattedDartaNumber,
-		&i.FiscalYearID,
-		&i.Scope,
-		&i.WardID,
-		&i.Subject,
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Darta
+	for rows.Next() {
+		var i Darta
+		if err := rows.Scan(
+			&i.ID,
+			&i.DartaNumber,
+			&i.FormattedDartaNumber,
+			&i.FiscalYearID,
+			&i.Scope,
+			&i.WardID,
+			&i.Subject,
+			&i.ApplicantID,
+			&i.IntakeChannel,
+			&i.ReceivedDate,
+			&i.EntryDate,
+			&i.IsBackdated,
+			&i.BackdateReason,
+			&i.BackdateApproverID,
+			&i.PrimaryDocumentID,
+			&i.Status,
+			&i.Priority,
+			&i.ClassificationCode,
+			&i.AssignedToUnitID,
+			&i.CurrentAssigneeID,
+			&i.SlaDeadline,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TenantID,
+			&i.IdempotencyKey,
+			&i.Metadata,
+			&i.IsOverdue,
+			&i.SlaTargetMinutes,
+			&i.SlaSyncedAt,
+			&i.SupersededByID,
+			&i.SupersedesID,
+			&i.SupersedesNumber,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDartasByDartaNumberAsc = `-- name: ListDartasByDartaNumberAsc :many
+SELECT d.id, d.darta_number, d.formatted_darta_number, d.fiscal_year_id, d.scope, d.ward_id, d.subject, d.applicant_id, d.intake_channel, d.received_date, d.entry_date, d.is_backdated, d.backdate_reason, d.backdate_approver_id, d.primary_document_id, d.status, d.priority, d.classification_code, d.assigned_to_unit_id, d.current_assignee_id, d.sla_deadline, d.created_by, d.created_at, d.updated_at, d.tenant_id, d.idempotency_key, d.metadata, d.is_overdue, d.sla_target_minutes, d.sla_synced_at, d.superseded_by_id, d.supersedes_id, d.supersedes_number, d.version
+FROM dartas d
+WHERE
+    ($1::VARCHAR IS NULL OR d.fiscal_year_id = $1)
+    AND ($2::VARCHAR IS NULL OR d.scope = $2)
+    AND ($3::VARCHAR IS NULL OR d.ward_id = $3)
+    AND ($4::VARCHAR IS NULL OR d.status = $4)
+    AND ($5::VARCHAR IS NULL OR d.priority = $5)
+    AND ($6::VARCHAR IS NULL OR d.intake_channel = $6)
+    AND ($7::VARCHAR IS NULL OR d.assigned_to_unit_id = $7)
+    AND ($8::VARCHAR IS NULL OR d.current_assignee_id = $8)
+    AND ($9::VARCHAR IS NULL OR d.created_by = $9)
+    AND ($10::BOOLEAN IS NULL OR d.is_overdue = $10)
+    AND ($11::TIMESTAMPTZ IS NULL OR d.received_date >= $11)
+    AND ($12::TIMESTAMPTZ IS NULL OR d.received_date <= $12)
+    AND ($13::TEXT IS NULL OR d.subject ILIKE '%' || $13 || '%')
+    AND d.tenant_id = $14
+    AND (COALESCE(d.darta_number, 0), d.id) > ($15::INT, $16::UUID)
+ORDER BY COALESCE(d.darta_number, 0) ASC, d.id ASC
+LIMIT $17
+`
+
+type ListDartasByDartaNumberAscParams struct {
+	FiscalYearID      *string            `json:"fiscal_year_id"`
+	Scope             *string            `json:"scope"`
+	WardID            *string            `json:"ward_id"`
+	Status            *string            `json:"status"`
+	Priority          *string            `json:"priority"`
+	IntakeChannel     *string            `json:"intake_channel"`
+	AssignedToUnitID  *string            `json:"assigned_to_unit_id"`
+	CurrentAssigneeID *string            `json:"current_assignee_id"`
+	CreatedBy         *string            `json:"created_by"`
+	IsOverdue         *bool              `json:"is_overdue"`
+	FromDate          pgtype.Timestamptz `json:"from_date"`
+	ToDate            pgtype.Timestamptz `json:"to_date"`
+	Search            *string            `json:"search"`
+	TenantID          string             `json:"tenant_id"`
+	CursorKey         int32              `json:"cursor_key"`
+	CursorID          pgtype.UUID        `json:"cursor_id"`
+	Limit             int32              `json:"limit"`
+}
+
+func (q *Queries) ListDartasByDartaNumberAsc(ctx context.Context, arg ListDartasByDartaNumberAscParams) ([]Darta, error) {
+	rows, err := q.db.Query(ctx, listDartasByDartaNumberAsc,
+		arg.FiscalYearID,
+		arg.Scope,
+		arg.WardID,
+		arg.Status,
+		arg.Priority,
+		arg.IntakeChannel,
+		arg.AssignedToUnitID,
+		arg.CurrentAssigneeID,
+		arg.CreatedBy,
+		arg.IsOverdue,
+		arg.FromDate,
+		arg.ToDate,
+		arg.Search,
+		arg.TenantID,
+		arg.CursorKey,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Darta
+	for rows.Next() {
+		var i Darta
+		if err := rows.Scan(
+			&i.ID,
+			&i.DartaNumber,
+			&i.FormattedDartaNumber,
+			&i.FiscalYearID,
+			&i.Scope,
+			&i.WardID,
+			&i.Subject,
+			&i.ApplicantID,
+			&i.IntakeChannel,
+			&i.ReceivedDate,
+			&i.EntryDate,
+			&i.IsBackdated,
+			&i.BackdateReason,
+			&i.BackdateApproverID,
+			&i.PrimaryDocumentID,
+			&i.Status,
+			&i.Priority,
+			&i.ClassificationCode,
+			&i.AssignedToUnitID,
+			&i.CurrentAssigneeID,
+			&i.SlaDeadline,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TenantID,
+			&i.IdempotencyKey,
+			&i.Metadata,
+			&i.IsOverdue,
+			&i.SlaTargetMinutes,
+			&i.SlaSyncedAt,
+			&i.SupersededByID,
+			&i.SupersedesID,
+			&i.SupersedesNumber,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDartasByDartaNumberDesc = `-- name: ListDartasByDartaNumberDesc :many
+SELECT d.id, d.darta_number, d.formatted_darta_number, d.fiscal_year_id, d.scope, d.ward_id, d.subject, d.applicant_id, d.intake_channel, d.received_date, d.entry_date, d.is_backdated, d.backdate_reason, d.backdate_approver_id, d.primary_document_id, d.status, d.priority, d.classification_code, d.assigned_to_unit_id, d.current_assignee_id, d.sla_deadline, d.created_by, d.created_at, d.updated_at, d.tenant_id, d.idempotency_key, d.metadata, d.is_overdue, d.sla_target_minutes, d.sla_synced_at, d.superseded_by_id, d.supersedes_id, d.supersedes_number, d.version
+FROM dartas d
+WHERE
+    ($1::VARCHAR IS NULL OR d.fiscal_year_id = $1)
+    AND ($2::VARCHAR IS NULL OR d.scope = $2)
+    AND ($3::VARCHAR IS NULL OR d.ward_id = $3)
+    AND ($4::VARCHAR IS NULL OR d.status = $4)
+    AND ($5::VARCHAR IS NULL OR d.priority = $5)
+    AND ($6::VARCHAR IS NULL OR d.intake_channel = $6)
+    AND ($7::VARCHAR IS NULL OR d.assigned_to_unit_id = $7)
+    AND ($8::VARCHAR IS NULL OR d.current_assignee_id = $8)
+    AND ($9::VARCHAR IS NULL OR d.created_by = $9)
+    AND ($10::BOOLEAN IS NULL OR d.is_overdue = $10)
+    AND ($11::TIMESTAMPTZ IS NULL OR d.received_date >= $11)
+    AND ($12::TIMESTAMPTZ IS NULL OR d.received_date <= $12)
+    AND ($13::TEXT IS NULL OR d.subject ILIKE '%' || $13 || '%')
+    AND d.tenant_id = $14
+    AND (COALESCE(d.darta_number, 0), d.id) < ($15::INT, $16::UUID)
+ORDER BY COALESCE(d.darta_number, 0) DESC, d.id DESC
+LIMIT $17
+`
+
+type ListDartasByDartaNumberDescParams struct {
+	FiscalYearID      *string            `json:"fiscal_year_id"`
+	Scope             *string            `json:"scope"`
+	WardID            *string            `json:"ward_id"`
+	Status            *string            `json:"status"`
+	Priority          *string            `json:"priority"`
+	IntakeChannel     *string            `json:"intake_channel"`
+	AssignedToUnitID  *string            `json:"assigned_to_unit_id"`
+	CurrentAssigneeID *string            `json:"current_assignee_id"`
+	CreatedBy         *string            `json:"created_by"`
+	IsOverdue         *bool              `json:"is_overdue"`
+	FromDate          pgtype.Timestamptz `json:"from_date"`
+	ToDate            pgtype.Timestamptz `json:"to_date"`
+	Search            *string            `json:"search"`
+	TenantID          string             `json:"tenant_id"`
+	CursorKey         int32              `json:"cursor_key"`
+	CursorID          pgtype.UUID        `json:"cursor_id"`
+	Limit             int32              `json:"limit"`
+}
+
+func (q *Queries) ListDartasByDartaNumberDesc(ctx context.Context, arg ListDartasByDartaNumberDescParams) ([]Darta, error) {
+	rows, err := q.db.Query(ctx, listDartasByDartaNumberDesc,
+		arg.FiscalYearID,
+		arg.Scope,
+		arg.WardID,
+		arg.Status,
+		arg.Priority,
+		arg.IntakeChannel,
+		arg.AssignedToUnitID,
+		arg.CurrentAssigneeID,
+		arg.CreatedBy,
+		arg.IsOverdue,
+		arg.FromDate,
+		arg.ToDate,
+		arg.Search,
+		arg.TenantID,
+		arg.CursorKey,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Darta
+	for rows.Next() {
+		var i Darta
+		if err := rows.Scan(
+			&i.ID,
+			&i.DartaNumber,
+			&i.FormattedDartaNumber,
+			&i.FiscalYearID,
+			&i.Scope,
+			&i.WardID,
+			&i.Subject,
+			&i.ApplicantID,
+			&i.IntakeChannel,
+			&i.ReceivedDate,
+			&i.EntryDate,
+			&i.IsBackdated,
+			&i.BackdateReason,
+			&i.BackdateApproverID,
+			&i.PrimaryDocumentID,
+			&i.Status,
+			&i.Priority,
+			&i.ClassificationCode,
+			&i.AssignedToUnitID,
+			&i.CurrentAssigneeID,
+			&i.SlaDeadline,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TenantID,
+			&i.IdempotencyKey,
+			&i.Metadata,
+			&i.IsOverdue,
+			&i.SlaTargetMinutes,
+			&i.SlaSyncedAt,
+			&i.SupersededByID,
+			&i.SupersedesID,
+			&i.SupersedesNumber,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDartasByReceivedDateAsc = `-- name: ListDartasByReceivedDateAsc :many
+SELECT d.id, d.darta_number, d.formatted_darta_number, d.fiscal_year_id, d.scope, d.ward_id, d.subject, d.applicant_id, d.intake_channel, d.received_date, d.entry_date, d.is_backdated, d.backdate_reason, d.backdate_approver_id, d.primary_document_id, d.status, d.priority, d.classification_code, d.assigned_to_unit_id, d.current_assignee_id, d.sla_deadline, d.created_by, d.created_at, d.updated_at, d.tenant_id, d.idempotency_key, d.metadata, d.is_overdue, d.sla_target_minutes, d.sla_synced_at, d.superseded_by_id, d.supersedes_id, d.supersedes_number, d.version
+FROM dartas d
+WHERE
+    ($1::VARCHAR IS NULL OR d.fiscal_year_id = $1)
+    AND ($2::VARCHAR IS NULL OR d.scope = $2)
+    AND ($3::VARCHAR IS NULL OR d.ward_id = $3)
+    AND ($4::VARCHAR IS NULL OR d.status = $4)
+    AND ($5::VARCHAR IS NULL OR d.priority = $5)
+    AND ($6::VARCHAR IS NULL OR d.intake_channel = $6)
+    AND ($7::VARCHAR IS NULL OR d.assigned_to_unit_id = $7)
+    AND ($8::VARCHAR IS NULL OR d.current_assignee_id = $8)
+    AND ($9::VARCHAR IS NULL OR d.created_by = $9)
+    AND ($10::BOOLEAN IS NULL OR d.is_overdue = $10)
+    AND ($11::TIMESTAMPTZ IS NULL OR d.received_date >= $11)
+    AND ($12::TIMESTAMPTZ IS NULL OR d.received_date <= $12)
+    AND ($13::TEXT IS NULL OR d.subject ILIKE '%' || $13 || '%')
+    AND d.tenant_id = $14
+    AND (d.received_date, d.id) > ($15::TIMESTAMPTZ, $16::UUID)
+ORDER BY d.received_date ASC, d.id ASC
+LIMIT $17
+`
+
+type ListDartasByReceivedDateAscParams struct {
+	FiscalYearID      *string            `json:"fiscal_year_id"`
+	Scope             *string            `json:"scope"`
+	WardID            *string            `json:"ward_id"`
+	Status            *string            `json:"status"`
+	Priority          *string            `json:"priority"`
+	IntakeChannel     *string            `json:"intake_channel"`
+	AssignedToUnitID  *string            `json:"assigned_to_unit_id"`
+	CurrentAssigneeID *string            `json:"current_assignee_id"`
+	CreatedBy         *string            `json:"created_by"`
+	IsOverdue         *bool              `json:"is_overdue"`
+	FromDate          pgtype.Timestamptz `json:"from_date"`
+	ToDate            pgtype.Timestamptz `json:"to_date"`
+	Search            *string            `json:"search"`
+	TenantID          string             `json:"tenant_id"`
+	CursorKey         pgtype.Timestamptz `json:"cursor_key"`
+	CursorID          pgtype.UUID        `json:"cursor_id"`
+	Limit             int32              `json:"limit"`
+}
+
+func (q *Queries) ListDartasByReceivedDateAsc(ctx context.Context, arg ListDartasByReceivedDateAscParams) ([]Darta, error) {
+	rows, err := q.db.Query(ctx, listDartasByReceivedDateAsc,
+		arg.FiscalYearID,
+		arg.Scope,
+		arg.WardID,
+		arg.Status,
+		arg.Priority,
+		arg.IntakeChannel,
+		arg.AssignedToUnitID,
+		arg.CurrentAssigneeID,
+		arg.CreatedBy,
+		arg.IsOverdue,
+		arg.FromDate,
+		arg.ToDate,
+		arg.Search,
+		arg.TenantID,
+		arg.CursorKey,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Darta
+	for rows.Next() {
+		var i Darta
+		if err := rows.Scan(
+			&i.ID,
+			&i.DartaNumber,
+			&i.FormattedDartaNumber,
+			&i.FiscalYearID,
+			&i.Scope,
+			&i.WardID,
+			&i.Subject,
+			&i.ApplicantID,
+			&i.IntakeChannel,
+			&i.ReceivedDate,
+			&i.EntryDate,
+			&i.IsBackdated,
+			&i.BackdateReason,
+			&i.BackdateApproverID,
+			&i.PrimaryDocumentID,
+			&i.Status,
+			&i.Priority,
+			&i.ClassificationCode,
+			&i.AssignedToUnitID,
+			&i.CurrentAssigneeID,
+			&i.SlaDeadline,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TenantID,
+			&i.IdempotencyKey,
+			&i.Metadata,
+			&i.IsOverdue,
+			&i.SlaTargetMinutes,
+			&i.SlaSyncedAt,
+			&i.SupersededByID,
+			&i.SupersedesID,
+			&i.SupersedesNumber,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDartasByReceivedDateDesc = `-- name: ListDartasByReceivedDateDesc :many
+SELECT d.id, d.darta_number, d.formatted_darta_number, d.fiscal_year_id, d.scope, d.ward_id, d.subject, d.applicant_id, d.intake_channel, d.received_date, d.entry_date, d.is_backdated, d.backdate_reason, d.backdate_approver_id, d.primary_document_id, d.status, d.priority, d.classification_code, d.assigned_to_unit_id, d.current_assignee_id, d.sla_deadline, d.created_by, d.created_at, d.updated_at, d.tenant_id, d.idempotency_key, d.metadata, d.is_overdue, d.sla_target_minutes, d.sla_synced_at, d.superseded_by_id, d.supersedes_id, d.supersedes_number, d.version
+FROM dartas d
+WHERE
+    ($1::VARCHAR IS NULL OR d.fiscal_year_id = $1)
+    AND ($2::VARCHAR IS NULL OR d.scope = $2)
+    AND ($3::VARCHAR IS NULL OR d.ward_id = $3)
+    AND ($4::VARCHAR IS NULL OR d.status = $4)
+    AND ($5::VARCHAR IS NULL OR d.priority = $5)
+    AND ($6::VARCHAR IS NULL OR d.intake_channel = $6)
+    AND ($7::VARCHAR IS NULL OR d.assigned_to_unit_id = $7)
+    AND ($8::VARCHAR IS NULL OR d.current_assignee_id = $8)
+    AND ($9::VARCHAR IS NULL OR d.created_by = $9)
+    AND ($10::BOOLEAN IS NULL OR d.is_overdue = $10)
+    AND ($11::TIMESTAMPTZ IS NULL OR d.received_date >= $11)
+    AND ($12::TIMESTAMPTZ IS NULL OR d.received_date <= $12)
+    AND ($13::TEXT IS NULL OR d.subject ILIKE '%' || $13 || '%')
+    AND d.tenant_id = $14
+    AND (d.received_date, d.id) < ($15::TIMESTAMPTZ, $16::UUID)
+ORDER BY d.received_date DESC, d.id DESC
+LIMIT $17
+`
+
+type ListDartasByReceivedDateDescParams struct {
+	FiscalYearID      *string            `json:"fiscal_year_id"`
+	Scope             *string            `json:"scope"`
+	WardID            *string            `json:"ward_id"`
+	Status            *string            `json:"status"`
+	Priority          *string            `json:"priority"`
+	IntakeChannel     *string            `json:"intake_channel"`
+	AssignedToUnitID  *string            `json:"assigned_to_unit_id"`
+	CurrentAssigneeID *string            `json:"current_assignee_id"`
+	CreatedBy         *string            `json:"created_by"`
+	IsOverdue         *bool              `json:"is_overdue"`
+	FromDate          pgtype.Timestamptz `json:"from_date"`
+	ToDate            pgtype.Timestamptz `json:"to_date"`
+	Search            *string            `json:"search"`
+	TenantID          string             `json:"tenant_id"`
+	CursorKey         pgtype.Timestamptz `json:"cursor_key"`
+	CursorID          pgtype.UUID        `json:"cursor_id"`
+	Limit             int32              `json:"limit"`
+}
+
+func (q *Queries) ListDartasByReceivedDateDesc(ctx context.Context, arg ListDartasByReceivedDateDescParams) ([]Darta, error) {
+	rows, err := q.db.Query(ctx, listDartasByReceivedDateDesc,
+		arg.FiscalYearID,
+		arg.Scope,
+		arg.WardID,
+		arg.Status,
+		arg.Priority,
+		arg.IntakeChannel,
+		arg.AssignedToUnitID,
+		arg.CurrentAssigneeID,
+		arg.CreatedBy,
+		arg.IsOverdue,
+		arg.FromDate,
+		arg.ToDate,
+		arg.Search,
+		arg.TenantID,
+		arg.CursorKey,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Darta
+	for rows.Next() {
+		var i Darta
+		if err := rows.Scan(
+			&i.ID,
+			&i.DartaNumber,
+			&i.FormattedDartaNumber,
+			&i.FiscalYearID,
+			&i.Scope,
+			&i.WardID,
+			&i.Subject,
+			&i.ApplicantID,
+			&i.IntakeChannel,
+			&i.ReceivedDate,
+			&i.EntryDate,
+			&i.IsBackdated,
+			&i.BackdateReason,
+			&i.BackdateApproverID,
+			&i.PrimaryDocumentID,
+			&i.Status,
+			&i.Priority,
+			&i.ClassificationCode,
+			&i.AssignedToUnitID,
+			&i.CurrentAssigneeID,
+			&i.SlaDeadline,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TenantID,
+			&i.IdempotencyKey,
+			&i.Metadata,
+			&i.IsOverdue,
+			&i.SlaTargetMinutes,
+			&i.SlaSyncedAt,
+			&i.SupersededByID,
+			&i.SupersedesID,
+			&i.SupersedesNumber,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const supersedeDarta = `-- name: SupersedeDarta :one
+WITH replacement AS (
+    UPDATE dartas r
+    SET supersedes_id = o.id,
+        supersedes_number = o.formatted_darta_number,
+        version = r.version + 1,
+        updated_at = NOW()
+    FROM dartas o
+    WHERE r.id = $2
+      AND o.id = $1
+      AND r.tenant_id = $3
+      AND o.tenant_id = r.tenant_id
+      AND r.supersedes_id IS NULL
+      AND r.superseded_by_id IS NULL
+      AND o.superseded_by_id IS NULL
+      AND o.version = $4
+    RETURNING r.id
+)
+UPDATE dartas
+SET status = 'SUPERSEDED',
+    superseded_by_id = (SELECT id FROM replacement),
+    version = version + 1,
+    updated_at = NOW()
+WHERE dartas.id = $1
+  AND EXISTS (SELECT 1 FROM replacement)
+RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, is_overdue, sla_target_minutes, sla_synced_at, superseded_by_id, supersedes_id, supersedes_number, version
+`
+
+type SupersedeDartaParams struct {
+	ID            uuid.UUID `json:"id"`
+	ReplacementID uuid.UUID `json:"replacement_id"`
+	TenantID      string    `json:"tenant_id"`
+	Version       int64     `json:"version"`
+}
+
+// Marks a darta superseded by its replacement and links the replacement
+// back, in one statement. Neither row changes unless both are still
+// unlinked and the darta is still at version.
+func (q *Queries) SupersedeDarta(ctx context.Context, arg SupersedeDartaParams) (Darta, error) {
+	row := q.db.QueryRow(ctx, supersedeDarta,
+		arg.ID,
+		arg.ReplacementID,
+		arg.TenantID,
+		arg.Version,
+	)
+	var i Darta
+	err := row.Scan(
+		&i.ID,
+		&i.DartaNumber,
+		&i.FormattedDartaNumber,
+		&i.FiscalYearID,
+		&i.Scope,
+		&i.WardID,
+		&i.Subject,
 		&i.ApplicantID,
 		&i.IntakeChannel,
 		&i.ReceivedDate,
@@ -1023,13 +1672,90 @@ func (q *Queries) UpdateDartaAssignment(ctx context.Context, arg UpdateDartaAssi
 		&i.AssignedToUnitID,
 		&i.CurrentAssigneeID,
 		&i.SlaDeadline,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantID,
+		&i.IdempotencyKey,
+		&i.Metadata,
 		&i.IsOverdue,
+		&i.SlaTargetMinutes,
+		&i.SlaSyncedAt,
+		&i.SupersededByID,
+		&i.SupersedesID,
+		&i.SupersedesNumber,
+		&i.Version,
+	)
+	return i, err
+}
+
+const updateDartaAssignment = `-- name: UpdateDartaAssignment :one
+UPDATE dartas
+SET 
+    assigned_to_unit_id = $2,
+    current_assignee_id = $3,
+    sla_target_minutes = $4,
+    priority = COALESCE($5, priority),
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $1 AND version = $6
+RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, is_overdue, sla_target_minutes, sla_synced_at, superseded_by_id, supersedes_id, supersedes_number, version
+`
+
+type UpdateDartaAssignmentParams struct {
+	ID                uuid.UUID `json:"id"`
+	AssignedToUnitID  *string   `json:"assigned_to_unit_id"`
+	CurrentAssigneeID *string   `json:"current_assignee_id"`
+	SlaTargetMinutes  *int32    `json:"sla_target_minutes"`
+	Priority          *string   `json:"priority"`
+	Version           int64     `json:"version"`
+}
+
+func (q *Queries) UpdateDartaAssignment(ctx context.Context, arg UpdateDartaAssignmentParams) (Darta, error) {
+	row := q.db.QueryRow(ctx, updateDartaAssignment,
+		arg.ID,
+		arg.AssignedToUnitID,
+		arg.CurrentAssigneeID,
+		arg.SlaTargetMinutes,
+		arg.Priority,
+		arg.Version,
+	)
+	var i Darta
+	err := row.Scan(
+		&i.ID,
+		&i.DartaNumber,
+		&i.FormattedDartaNumber,
+		&i.FiscalYearID,
+		&i.Scope,
+		&i.WardID,
+		&i.Subject,
+		&i.ApplicantID,
+		&i.IntakeChannel,
+		&i.ReceivedDate,
+		&i.EntryDate,
+		&i.IsBackdated,
+		&i.BackdateReason,
+		&i.BackdateApproverID,
+		&i.PrimaryDocumentID,
+		&i.Status,
+		&i.Priority,
+		&i.ClassificationCode,
+		&i.AssignedToUnitID,
+		&i.CurrentAssigneeID,
+		&i.SlaDeadline,
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.IsOverdue,
+		&i.SlaTargetMinutes,
+		&i.SlaSyncedAt,
+		&i.SupersededByID,
+		&i.SupersedesID,
+		&i.SupersedesNumber,
+		&i.Version,
 	)
 	return i, err
 }
@@ -1038,18 +1764,20 @@ const updateDartaClassification = `-- name: UpdateDartaClassification :one
 UPDATE dartas
 SET 
     classification_code = $2,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, is_overdue, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+WHERE id = $1 AND version = $3
+RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, is_overdue, sla_target_minutes, sla_synced_at, superseded_by_id, supersedes_id, supersedes_number, version
 `
 
 type UpdateDartaClassificationParams struct {
 	ID                 uuid.UUID `json:"id"`
 	ClassificationCode *string   `json:"classification_code"`
+	Version            int64     `json:"version"`
 }
 
 func (q *Queries) UpdateDartaClassification(ctx context.Context, arg UpdateDartaClassificationParams) (Darta, error) {
-	row := q.db.QueryRow(ctx, updateDartaClassification, arg.ID, arg.ClassificationCode)
+	row := q.db.QueryRow(ctx, updateDartaClassification, arg.ID, arg.ClassificationCode, arg.Version)
 	var i Darta
 	err := row.Scan(
 		&i.ID,
@@ -1073,13 +1801,19 @@ func (q *Queries) UpdateDartaClassification(ctx context.Context, arg UpdateDarta
 		&i.AssignedToUnitID,
 		&i.CurrentAssigneeID,
 		&i.SlaDeadline,
-		&i.IsOverdue,
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.IsOverdue,
+		&i.SlaTargetMinutes,
+		&i.SlaSyncedAt,
+		&i.SupersededByID,
+		&i.SupersedesID,
+		&i.SupersedesNumber,
+		&i.Version,
 	)
 	return i, err
 }
@@ -1088,18 +1822,21 @@ const updateDartaMetadata = `-- name: UpdateDartaMetadata :one
 UPDATE dartas
 SET 
     metadata = $2,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, is_overdue, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+WHERE id = $1 AND version = $3
+RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, is_overdue, sla_target_minutes, sla_synced_at, superseded_by_id, supersedes_id, supersedes_number, version
 `
 
 type UpdateDartaMetadataParams struct {
 	ID       uuid.UUID       `json:"id"`
 	Metadata json.RawMessage `json:"metadata"`
+	Version  int64           `json:"version"`
 }
 
+// Replaces a darta's metadata if it is still at version, bumping the version
 func (q *Queries) UpdateDartaMetadata(ctx context.Context, arg UpdateDartaMetadataParams) (Darta, error) {
-	row := q.db.QueryRow(ctx, updateDartaMetadata, arg.ID, arg.Metadata)
+	row := q.db.QueryRow(ctx, updateDartaMetadata, arg.ID, arg.Metadata, arg.Version)
 	var i Darta
 	err := row.Scan(
 		&i.ID,
@@ -1123,13 +1860,19 @@ func (q *Queries) UpdateDartaMetadata(ctx context.Context, arg UpdateDartaMetada
 		&i.AssignedToUnitID,
 		&i.CurrentAssigneeID,
 		&i.SlaDeadline,
-		&i.IsOverdue,
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.IsOverdue,
+		&i.SlaTargetMinutes,
+		&i.SlaSyncedAt,
+		&i.SupersededByID,
+		&i.SupersedesID,
+		&i.SupersedesNumber,
+		&i.Version,
 	)
 	return i, err
 }
@@ -1139,19 +1882,29 @@ UPDATE dartas
 SET 
     darta_number = $2,
     formatted_darta_number = $3,
+    status = 'NUMBER_RESERVED',
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, is_overdue, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+WHERE id = $1 AND version = $4
+RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, is_overdue, sla_target_minutes, sla_synced_at, superseded_by_id, supersedes_id, supersedes_number, version
 `
 
 type UpdateDartaNumberParams struct {
 	ID                   uuid.UUID `json:"id"`
 	DartaNumber          *int32    `json:"darta_number"`
 	FormattedDartaNumber *string   `json:"formatted_darta_number"`
+	Version              int64     `json:"version"`
 }
 
+// Assigns the number and moves the darta to NUMBER_RESERVED in one versioned
+// update, so a concurrent change cannot leave a number without its status
 func (q *Queries) UpdateDartaNumber(ctx context.Context, arg UpdateDartaNumberParams) (Darta, error) {
-	row := q.db.QueryRow(ctx, updateDartaNumber, arg.ID, arg.DartaNumber, arg.FormattedDartaNumber)
+	row := q.db.QueryRow(ctx, updateDartaNumber,
+		arg.ID,
+		arg.DartaNumber,
+		arg.FormattedDartaNumber,
+		arg.Version,
+	)
 	var i Darta
 	err := row.Scan(
 		&i.ID,
@@ -1175,31 +1928,44 @@ func (q *Queries) UpdateDartaNumber(ctx context.Context, arg UpdateDartaNumberPa
 		&i.AssignedToUnitID,
 		&i.CurrentAssigneeID,
 		&i.SlaDeadline,
-		&i.IsOverdue,
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.IsOverdue,
+		&i.SlaTargetMinutes,
+		&i.SlaSyncedAt,
+		&i.SupersededByID,
+		&i.SupersedesID,
+		&i.SupersedesNumber,
+		&i.Version,
 	)
 	return i, err
 }
 
 const updateDartaStatus = `-- name: UpdateDartaStatus :one
 UPDATE dartas
-SET status = $2, updated_at = NOW()
-WHERE id = $1
-RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, is_overdue, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+SET status = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND tenant_id = $3 AND version = $4
+RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, is_overdue, sla_target_minutes, sla_synced_at, superseded_by_id, supersedes_id, supersedes_number, version
 `
 
 type UpdateDartaStatusParams struct {
-	ID     uuid.UUID `json:"id"`
-	Status string    `json:"status"`
+	ID       uuid.UUID `json:"id"`
+	Status   string    `json:"status"`
+	TenantID string    `json:"tenant_id"`
+	Version  int64     `json:"version"`
 }
 
 func (q *Queries) UpdateDartaStatus(ctx context.Context, arg UpdateDartaStatusParams) (Darta, error) {
-	row := q.db.QueryRow(ctx, updateDartaStatus, arg.ID, arg.Status)
+	row := q.db.QueryRow(ctx, updateDartaStatus,
+		arg.ID,
+		arg.Status,
+		arg.TenantID,
+		arg.Version,
+	)
 	var i Darta
 	err := row.Scan(
 		&i.ID,
@@ -1223,13 +1989,19 @@ func (q *Queries) UpdateDartaStatus(ctx context.Context, arg UpdateDartaStatusPa
 		&i.AssignedToUnitID,
 		&i.CurrentAssigneeID,
 		&i.SlaDeadline,
-		&i.IsOverdue,
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.IsOverdue,
+		&i.SlaTargetMinutes,
+		&i.SlaSyncedAt,
+		&i.SupersededByID,
+		&i.SupersedesID,
+		&i.SupersedesNumber,
+		&i.Version,
 	)
 	return i, err
 }
@@ -1238,13 +2010,20 @@ const voidDarta = `-- name: VoidDarta :one
 UPDATE dartas
 SET 
     status = 'VOIDED',
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, is_overdue, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata
+WHERE id = $1 AND tenant_id = $2 AND version = $3
+RETURNING id, darta_number, formatted_darta_number, fiscal_year_id, scope, ward_id, subject, applicant_id, intake_channel, received_date, entry_date, is_backdated, backdate_reason, backdate_approver_id, primary_document_id, status, priority, classification_code, assigned_to_unit_id, current_assignee_id, sla_deadline, created_by, created_at, updated_at, tenant_id, idempotency_key, metadata, is_overdue, sla_target_minutes, sla_synced_at, superseded_by_id, supersedes_id, supersedes_number, version
 `
 
-func (q *Queries) VoidDarta(ctx context.Context, id uuid.UUID) (Darta, error) {
-	row := q.db.QueryRow(ctx, voidDarta, id)
+type VoidDartaParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID string    `json:"tenant_id"`
+	Version  int64     `json:"version"`
+}
+
+func (q *Queries) VoidDarta(ctx context.Context, arg VoidDartaParams) (Darta, error) {
+	row := q.db.QueryRow(ctx, voidDarta, arg.ID, arg.TenantID, arg.Version)
 	var i Darta
 	err := row.Scan(
 		&i.ID,
@@ -1268,13 +2047,19 @@ func (q *Queries) VoidDarta(ctx context.Context, id uuid.UUID) (Darta, error) {
 		&i.AssignedToUnitID,
 		&i.CurrentAssigneeID,
 		&i.SlaDeadline,
-		&i.IsOverdue,
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.TenantID,
 		&i.IdempotencyKey,
 		&i.Metadata,
+		&i.IsOverdue,
+		&i.SlaTargetMinutes,
+		&i.SlaSyncedAt,
+		&i.SupersededByID,
+		&i.SupersedesID,
+		&i.SupersedesNumber,
+		&i.Version,
 	)
 	return i, err
 }
@@ -0,0 +1,131 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: duplicates.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const listDuplicateCandidates = `-- name: ListDuplicateCandidates :many
+
+SELECT
+    d.id,
+    d.subject,
+    d.formatted_darta_number,
+    d.status,
+    d.ward_id,
+    d.received_date,
+    a.full_name,
+    (d.applicant_id = $1::UUID)::BOOLEAN AS same_applicant,
+    COALESCE(a.identification_number = $2::TEXT, false)::BOOLEAN AS same_identification,
+    COALESCE(right(regexp_replace(a.phone, '[^0-9]', '', 'g'), 10) = $3::TEXT, false)::BOOLEAN AS same_phone,
+    COALESCE(lower(a.email) = $4::TEXT, false)::BOOLEAN AS same_email,
+    (att.checksum = $5::TEXT)::BOOLEAN AS same_document,
+    similarity(d.subject, $6::TEXT)::FLOAT8 AS subject_similarity
+FROM dartas d
+JOIN applicants a ON a.id = d.applicant_id
+JOIN attachments att ON att.id = d.primary_document_id
+WHERE d.tenant_id = $7
+    AND d.id <> $8::UUID
+    AND d.status NOT IN ('VOIDED', 'SUPERSEDED')
+    AND d.received_date BETWEEN $9::TIMESTAMPTZ AND $10::TIMESTAMPTZ
+    AND (
+        d.applicant_id = $1::UUID
+        OR a.identification_number = $2::TEXT
+        OR right(regexp_replace(a.phone, '[^0-9]', '', 'g'), 10) = $3::TEXT
+        OR lower(a.email) = $4::TEXT
+        OR att.checksum = $5::TEXT
+        OR d.subject % $6::TEXT
+    )
+ORDER BY d.received_date DESC
+LIMIT $11
+`
+
+type ListDuplicateCandidatesParams struct {
+	ApplicantID          pgtype.UUID        `json:"applicant_id"`
+	IdentificationNumber *string            `json:"identification_number"`
+	PhoneDigits          *string            `json:"phone_digits"`
+	Email                *string            `json:"email"`
+	Checksum             string             `json:"checksum"`
+	Subject              string             `json:"subject"`
+	TenantID             string             `json:"tenant_id"`
+	ExcludeID            pgtype.UUID        `json:"exclude_id"`
+	ReceivedFrom         pgtype.Timestamptz `json:"received_from"`
+	ReceivedTo           pgtype.Timestamptz `json:"received_to"`
+	Limit                int32              `json:"limit"`
+}
+
+type ListDuplicateCandidatesRow struct {
+	ID                   uuid.UUID          `json:"id"`
+	Subject              string             `json:"subject"`
+	FormattedDartaNumber *string            `json:"formatted_darta_number"`
+	Status               string             `json:"status"`
+	WardID               *string            `json:"ward_id"`
+	ReceivedDate         pgtype.Timestamptz `json:"received_date"`
+	FullName             string             `json:"full_name"`
+	SameApplicant        bool               `json:"same_applicant"`
+	SameIdentification   bool               `json:"same_identification"`
+	SamePhone            bool               `json:"same_phone"`
+	SameEmail            bool               `json:"same_email"`
+	SameDocument         bool               `json:"same_document"`
+	SubjectSimilarity    float64            `json:"subject_similarity"`
+}
+
+// ============================================================================
+// DUPLICATE DETECTION
+// ============================================================================
+// Live dartas in the tenant, in any ward, received within the window that
+// share an applicant identifier or the primary document with the probe or
+// have a similar subject (pg_trgm's % operator). Phones are compared by
+// their last ten digits.
+func (q *Queries) ListDuplicateCandidates(ctx context.Context, arg ListDuplicateCandidatesParams) ([]ListDuplicateCandidatesRow, error) {
+	rows, err := q.db.Query(ctx, listDuplicateCandidates,
+		arg.ApplicantID,
+		arg.IdentificationNumber,
+		arg.PhoneDigits,
+		arg.Email,
+		arg.Checksum,
+		arg.Subject,
+		arg.TenantID,
+		arg.ExcludeID,
+		arg.ReceivedFrom,
+		arg.ReceivedTo,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDuplicateCandidatesRow
+	for rows.Next() {
+		var i ListDuplicateCandidatesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Subject,
+			&i.FormattedDartaNumber,
+			&i.Status,
+			&i.WardID,
+			&i.ReceivedDate,
+			&i.FullName,
+			&i.SameApplicant,
+			&i.SameIdentification,
+			&i.SamePhone,
+			&i.SameEmail,
+			&i.SameDocument,
+			&i.SubjectSimilarity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,136 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: idempotency_keys.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimIdempotencyKey = `-- name: ClaimIdempotencyKey :execrows
+
+INSERT INTO idempotency_keys (tenant_id, idempotency_key, method, request_hash, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (tenant_id, idempotency_key) DO UPDATE SET
+    method = EXCLUDED.method,
+    request_hash = EXCLUDED.request_hash,
+    response = NULL,
+    created_at = NOW(),
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at < NOW()
+    OR (idempotency_keys.response IS NULL
+        AND idempotency_keys.request_hash = EXCLUDED.request_hash
+        AND idempotency_keys.created_at < $6::TIMESTAMPTZ)
+`
+
+type ClaimIdempotencyKeyParams struct {
+	TenantID        string             `json:"tenant_id"`
+	IdempotencyKey  string             `json:"idempotency_key"`
+	Method          string             `json:"method"`
+	RequestHash     string             `json:"request_hash"`
+	ExpiresAt       pgtype.Timestamptz `json:"expires_at"`
+	AbandonedBefore pgtype.Timestamptz `json:"abandoned_before"`
+}
+
+// ============================================================================
+// IDEMPOTENCY KEYS
+// ============================================================================
+// Claims a key for a request. An expired key is claimed afresh, as is an
+// abandoned claim of the same request; otherwise no row is claimed.
+func (q *Queries) ClaimIdempotencyKey(ctx context.Context, arg ClaimIdempotencyKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimIdempotencyKey,
+		arg.TenantID,
+		arg.IdempotencyKey,
+		arg.Method,
+		arg.RequestHash,
+		arg.ExpiresAt,
+		arg.AbandonedBefore,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const completeIdempotencyKey = `-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys
+SET response = $4
+WHERE tenant_id = $1 AND idempotency_key = $2 AND request_hash = $3
+`
+
+type CompleteIdempotencyKeyParams struct {
+	TenantID       string `json:"tenant_id"`
+	IdempotencyKey string `json:"idempotency_key"`
+	RequestHash    string `json:"request_hash"`
+	Response       []byte `json:"response"`
+}
+
+func (q *Queries) CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) error {
+	_, err := q.db.Exec(ctx, completeIdempotencyKey,
+		arg.TenantID,
+		arg.IdempotencyKey,
+		arg.RequestHash,
+		arg.Response,
+	)
+	return err
+}
+
+const deleteExpiredIdempotencyKeys = `-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys
+WHERE expires_at < NOW()
+`
+
+func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredIdempotencyKeys)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT tenant_id, idempotency_key, method, request_hash, response, created_at, expires_at FROM idempotency_keys
+WHERE tenant_id = $1 AND idempotency_key = $2
+`
+
+type GetIdempotencyKeyParams struct {
+	TenantID       string `json:"tenant_id"`
+	IdempotencyKey string `json:"idempotency_key"`
+}
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, getIdempotencyKey, arg.TenantID, arg.IdempotencyKey)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.TenantID,
+		&i.IdempotencyKey,
+		&i.Method,
+		&i.RequestHash,
+		&i.Response,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const releaseIdempotencyKey = `-- name: ReleaseIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE tenant_id = $1 AND idempotency_key = $2 AND request_hash = $3
+    AND response IS NULL
+`
+
+type ReleaseIdempotencyKeyParams struct {
+	TenantID       string `json:"tenant_id"`
+	IdempotencyKey string `json:"idempotency_key"`
+	RequestHash    string `json:"request_hash"`
+}
+
+// Frees a claim whose request failed, so it can be retried
+func (q *Queries) ReleaseIdempotencyKey(ctx context.Context, arg ReleaseIdempotencyKeyParams) error {
+	_, err := q.db.Exec(ctx, releaseIdempotencyKey, arg.TenantID, arg.IdempotencyKey, arg.RequestHash)
+	return err
+}
@@ -0,0 +1,133 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: metadata_schemas.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+)
+
+const deleteMetadataSchema = `-- name: DeleteMetadataSchema :execrows
+DELETE FROM metadata_schemas
+WHERE tenant_id = $1 AND classification_code = $2
+`
+
+type DeleteMetadataSchemaParams struct {
+	TenantID           string `json:"tenant_id"`
+	ClassificationCode string `json:"classification_code"`
+}
+
+func (q *Queries) DeleteMetadataSchema(ctx context.Context, arg DeleteMetadataSchemaParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteMetadataSchema, arg.TenantID, arg.ClassificationCode)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getApplicableMetadataSchema = `-- name: GetApplicableMetadataSchema :one
+SELECT id, tenant_id, classification_code, schema, updated_by, created_at, updated_at FROM metadata_schemas
+WHERE tenant_id = $1
+    AND classification_code IN ($2::VARCHAR, '')
+ORDER BY classification_code DESC
+LIMIT 1
+`
+
+type GetApplicableMetadataSchemaParams struct {
+	TenantID           string `json:"tenant_id"`
+	ClassificationCode string `json:"classification_code"`
+}
+
+// The schema for a classification code, else the tenant's default schema
+func (q *Queries) GetApplicableMetadataSchema(ctx context.Context, arg GetApplicableMetadataSchemaParams) (MetadataSchema, error) {
+	row := q.db.QueryRow(ctx, getApplicableMetadataSchema, arg.TenantID, arg.ClassificationCode)
+	var i MetadataSchema
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.ClassificationCode,
+		&i.Schema,
+		&i.UpdatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listMetadataSchemas = `-- name: ListMetadataSchemas :many
+
+SELECT id, tenant_id, classification_code, schema, updated_by, created_at, updated_at FROM metadata_schemas
+WHERE tenant_id = $1
+ORDER BY classification_code
+`
+
+// ============================================================================
+// METADATA SCHEMAS
+// ============================================================================
+func (q *Queries) ListMetadataSchemas(ctx context.Context, tenantID string) ([]MetadataSchema, error) {
+	rows, err := q.db.Query(ctx, listMetadataSchemas, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MetadataSchema
+	for rows.Next() {
+		var i MetadataSchema
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.ClassificationCode,
+			&i.Schema,
+			&i.UpdatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertMetadataSchema = `-- name: UpsertMetadataSchema :one
+INSERT INTO metadata_schemas (tenant_id, classification_code, schema, updated_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (tenant_id, classification_code) DO UPDATE SET
+    schema = EXCLUDED.schema,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = NOW()
+RETURNING id, tenant_id, classification_code, schema, updated_by, created_at, updated_at
+`
+
+type UpsertMetadataSchemaParams struct {
+	TenantID           string          `json:"tenant_id"`
+	ClassificationCode string          `json:"classification_code"`
+	Schema             json.RawMessage `json:"schema"`
+	UpdatedBy          string          `json:"updated_by"`
+}
+
+func (q *Queries) UpsertMetadataSchema(ctx context.Context, arg UpsertMetadataSchemaParams) (MetadataSchema, error) {
+	row := q.db.QueryRow(ctx, upsertMetadataSchema,
+		arg.TenantID,
+		arg.ClassificationCode,
+		arg.Schema,
+		arg.UpdatedBy,
+	)
+	var i MetadataSchema
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.ClassificationCode,
+		&i.Schema,
+		&i.UpdatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -39,6 +39,14 @@ type Attachment struct {
 	CreatedAt        pgtype.Timestamptz `json:"created_at"`
 }
 
+type AuditAnchor struct {
+	ID         uuid.UUID          `json:"id"`
+	TenantID   string             `json:"tenant_id"`
+	ChainSeq   int64              `json:"chain_seq"`
+	EntryHash  string             `json:"entry_hash"`
+	AnchoredAt pgtype.Timestamptz `json:"anchored_at"`
+}
+
 type AuditTrail struct {
 	ID          uuid.UUID          `json:"id"`
 	EntityType  string             `json:"entity_type"`
@@ -51,6 +59,29 @@ type AuditTrail struct {
 	UserAgent   *string            `json:"user_agent"`
 	Notes       *string            `json:"notes"`
 	TenantID    string             `json:"tenant_id"`
+	Category    string             `json:"category"`
+	ChainSeq    *int64             `json:"chain_seq"`
+	PrevHash    *string            `json:"prev_hash"`
+	EntryHash   *string            `json:"entry_hash"`
+	RequestID   *string            `json:"request_id"`
+	TraceID     *string            `json:"trace_id"`
+	ActingRole  *string            `json:"acting_role"`
+	DecisionID  *string            `json:"decision_id"`
+}
+
+type BusinessCalendar struct {
+	TenantID    string             `json:"tenant_id"`
+	WeeklyOff   []int16            `json:"weekly_off"`
+	OfficeStart pgtype.Time        `json:"office_start"`
+	OfficeEnd   pgtype.Time        `json:"office_end"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type CalendarHoliday struct {
+	TenantID    string             `json:"tenant_id"`
+	HolidayDate pgtype.Date        `json:"holiday_date"`
+	Name        string             `json:"name"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 }
 
 type Chalani struct {
@@ -86,6 +117,8 @@ type Chalani struct {
 	TenantID               string             `json:"tenant_id"`
 	IdempotencyKey         *string            `json:"idempotency_key"`
 	Metadata               json.RawMessage    `json:"metadata"`
+	SlaSyncedAt            pgtype.Timestamptz `json:"sla_synced_at"`
+	Version                int64              `json:"version"`
 }
 
 type ChalaniApproval struct {
@@ -147,13 +180,19 @@ type Darta struct {
 	AssignedToUnitID     *string            `json:"assigned_to_unit_id"`
 	CurrentAssigneeID    *string            `json:"current_assignee_id"`
 	SlaDeadline          pgtype.Timestamptz `json:"sla_deadline"`
-	IsOverdue            *bool              `json:"is_overdue"`
 	CreatedBy            string             `json:"created_by"`
 	CreatedAt            pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
 	TenantID             string             `json:"tenant_id"`
 	IdempotencyKey       *string            `json:"idempotency_key"`
 	Metadata             json.RawMessage    `json:"metadata"`
+	IsOverdue            bool               `json:"is_overdue"`
+	SlaTargetMinutes     *int32             `json:"sla_target_minutes"`
+	SlaSyncedAt          pgtype.Timestamptz `json:"sla_synced_at"`
+	SupersededByID       pgtype.UUID        `json:"superseded_by_id"`
+	SupersedesID         pgtype.UUID        `json:"supersedes_id"`
+	SupersedesNumber     *string            `json:"supersedes_number"`
+	Version              int64              `json:"version"`
 }
 
 type DartaAnnex struct {
@@ -169,6 +208,26 @@ type DartaRelationship struct {
 	CreatedAt        pgtype.Timestamptz `json:"created_at"`
 }
 
+type IdempotencyKey struct {
+	TenantID       string             `json:"tenant_id"`
+	IdempotencyKey string             `json:"idempotency_key"`
+	Method         string             `json:"method"`
+	RequestHash    string             `json:"request_hash"`
+	Response       []byte             `json:"response"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	ExpiresAt      pgtype.Timestamptz `json:"expires_at"`
+}
+
+type MetadataSchema struct {
+	ID                 uuid.UUID          `json:"id"`
+	TenantID           string             `json:"tenant_id"`
+	ClassificationCode string             `json:"classification_code"`
+	Schema             json.RawMessage    `json:"schema"`
+	UpdatedBy          string             `json:"updated_by"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+}
+
 type Recipient struct {
 	ID           uuid.UUID          `json:"id"`
 	Type         string             `json:"type"`
@@ -180,3 +239,65 @@ type Recipient struct {
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
 }
+
+type SearchDocument struct {
+	EntityType      string             `json:"entity_type"`
+	EntityID        pgtype.UUID        `json:"entity_id"`
+	TenantID        string             `json:"tenant_id"`
+	Title           string             `json:"title"`
+	Number          *string            `json:"number"`
+	Party           string             `json:"party"`
+	Content         string             `json:"content"`
+	Status          string             `json:"status"`
+	FiscalYearID    string             `json:"fiscal_year_id"`
+	WardID          *string            `json:"ward_id"`
+	Channel         *string            `json:"channel"`
+	NumberTerms     string             `json:"number_terms"`
+	TitleTerms      string             `json:"title_terms"`
+	BodyTerms       string             `json:"body_terms"`
+	FoldKey         string             `json:"fold_key"`
+	Document        interface{}        `json:"document"`
+	SourceUpdatedAt pgtype.Timestamptz `json:"source_updated_at"`
+	IndexedAt       pgtype.Timestamptz `json:"indexed_at"`
+}
+
+type SlaClock struct {
+	ID                   uuid.UUID          `json:"id"`
+	TenantID             string             `json:"tenant_id"`
+	EntityType           string             `json:"entity_type"`
+	EntityID             pgtype.UUID        `json:"entity_id"`
+	Stage                string             `json:"stage"`
+	PolicyID             pgtype.UUID        `json:"policy_id"`
+	TargetMinutes        int32              `json:"target_minutes"`
+	EscalateAfterMinutes int32              `json:"escalate_after_minutes"`
+	ElapsedMinutes       int32              `json:"elapsed_minutes"`
+	StartedAt            pgtype.Timestamptz `json:"started_at"`
+	ResumedAt            pgtype.Timestamptz `json:"resumed_at"`
+	PausedAt             pgtype.Timestamptz `json:"paused_at"`
+	StoppedAt            pgtype.Timestamptz `json:"stopped_at"`
+	Deadline             pgtype.Timestamptz `json:"deadline"`
+	EscalateAt           pgtype.Timestamptz `json:"escalate_at"`
+	BreachedAt           pgtype.Timestamptz `json:"breached_at"`
+	EscalatedAt          pgtype.Timestamptz `json:"escalated_at"`
+	EscalatedTo          *string            `json:"escalated_to"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+}
+
+type SlaPolicy struct {
+	ID                   uuid.UUID          `json:"id"`
+	TenantID             string             `json:"tenant_id"`
+	Stage                string             `json:"stage"`
+	Priority             *string            `json:"priority"`
+	ClassificationCode   *string            `json:"classification_code"`
+	TargetMinutes        int32              `json:"target_minutes"`
+	EscalateAfterMinutes int32              `json:"escalate_after_minutes"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+}
+
+type UnitHead struct {
+	TenantID   string             `json:"tenant_id"`
+	UnitID     string             `json:"unit_id"`
+	HeadUserID string             `json:"head_user_id"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
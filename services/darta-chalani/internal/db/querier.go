@@ -29,16 +29,27 @@ type Querier interface {
 	// ============================================================================
 	AddDartaRelationship(ctx context.Context, arg AddDartaRelationshipParams) error
 	CheckAllSignatoriesApproved(ctx context.Context, chalaniID pgtype.UUID) (CheckAllSignatoriesApprovedRow, error)
-	CloseChalani(ctx context.Context, id uuid.UUID) (Chalani, error)
-	CloseDarta(ctx context.Context, id uuid.UUID) (Darta, error)
+	// ============================================================================
+	// IDEMPOTENCY KEYS
+	// ============================================================================
+	// Claims a key for a request. An expired key is claimed afresh, as is an
+	// abandoned claim of the same request; otherwise no row is claimed.
+	ClaimIdempotencyKey(ctx context.Context, arg ClaimIdempotencyKeyParams) (int64, error)
+	CloseChalani(ctx context.Context, arg CloseChalaniParams) (Chalani, error)
+	CloseDarta(ctx context.Context, arg CloseDartaParams) (Darta, error)
+	CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) error
 	CountApplicants(ctx context.Context, arg CountApplicantsParams) (int64, error)
 	CountAttachments(ctx context.Context, tenantID string) (int64, error)
 	CountAuditEntries(ctx context.Context, arg CountAuditEntriesParams) (int64, error)
+	CountAuditEntriesMatching(ctx context.Context, arg CountAuditEntriesMatchingParams) (int64, error)
 	CountChalaniTemplates(ctx context.Context, arg CountChalaniTemplatesParams) (int64, error)
 	CountChalanis(ctx context.Context, arg CountChalanisParams) (int64, error)
 	CountDartas(ctx context.Context, arg CountDartasParams) (int64, error)
-	CountMyDartas(ctx context.Context, arg CountMyDartasParams) (int64, error)
 	CountRecipients(ctx context.Context, arg CountRecipientsParams) (int64, error)
+	CountSearchDocuments(ctx context.Context, arg CountSearchDocumentsParams) (int64, error)
+	// Entries without a chain position written since the chain began can only
+	// have been inserted around the database's checks
+	CountUnchainedAuditEntries(ctx context.Context, arg CountUnchainedAuditEntriesParams) (int64, error)
 	// ============================================================================
 	// APPLICANTS - People/Organizations submitting darta
 	// ============================================================================
@@ -47,9 +58,11 @@ type Querier interface {
 	// ATTACHMENTS - File attachments
 	// ============================================================================
 	CreateAttachment(ctx context.Context, arg CreateAttachmentParams) (Attachment, error)
+	CreateAuditAnchor(ctx context.Context, arg CreateAuditAnchorParams) error
 	// ============================================================================
 	// AUDIT TRAIL
 	// ============================================================================
+	// The ID and time are part of the entry's hash, so the caller sets them
 	CreateAuditEntry(ctx context.Context, arg CreateAuditEntryParams) (AuditTrail, error)
 	// ============================================================================
 	// CHALANIS - Outgoing correspondence
@@ -71,22 +84,41 @@ type Querier interface {
 	// RECIPIENTS - People/Organizations receiving chalani
 	// ============================================================================
 	CreateRecipient(ctx context.Context, arg CreateRecipientParams) (Recipient, error)
+	CreateSLAClock(ctx context.Context, arg CreateSLAClockParams) (SlaClock, error)
 	DeleteApplicant(ctx context.Context, id uuid.UUID) error
 	DeleteAttachment(ctx context.Context, id uuid.UUID) error
+	DeleteCalendarHoliday(ctx context.Context, arg DeleteCalendarHolidayParams) error
 	DeleteChalaniTemplate(ctx context.Context, id uuid.UUID) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+	DeleteMetadataSchema(ctx context.Context, arg DeleteMetadataSchemaParams) (int64, error)
 	DeleteRecipient(ctx context.Context, id uuid.UUID) error
+	DeleteSLAPolicy(ctx context.Context, arg DeleteSLAPolicyParams) error
+	DeleteUnitHead(ctx context.Context, arg DeleteUnitHeadParams) error
 	FindApplicantByIdentification(ctx context.Context, identificationNumber *string) (Applicant, error)
 	FindRecipientByContact(ctx context.Context, arg FindRecipientByContactParams) (Recipient, error)
 	GetAcknowledgementRate(ctx context.Context, arg GetAcknowledgementRateParams) (GetAcknowledgementRateRow, error)
+	// The schema for a classification code, else the tenant's default schema
+	GetApplicableMetadataSchema(ctx context.Context, arg GetApplicableMetadataSchemaParams) (MetadataSchema, error)
 	GetApplicant(ctx context.Context, id uuid.UUID) (Applicant, error)
 	GetApplicantByEmailOrPhone(ctx context.Context, arg GetApplicantByEmailOrPhoneParams) (Applicant, error)
+	// Applicants are shared across tenants, so only those on a darta in the
+	// caller's tenant are returned
+	GetApplicantsByIDs(ctx context.Context, arg GetApplicantsByIDsParams) ([]Applicant, error)
 	GetAttachment(ctx context.Context, id uuid.UUID) (Attachment, error)
 	GetAttachmentByChecksum(ctx context.Context, arg GetAttachmentByChecksumParams) (Attachment, error)
 	GetAttachmentsByIDs(ctx context.Context, dollar_1 []pgtype.UUID) ([]Attachment, error)
+	// ============================================================================
+	// AUDIT CHAIN
+	// ============================================================================
+	GetAuditChainHead(ctx context.Context, tenantID string) (GetAuditChainHeadRow, error)
 	GetAuditTrail(ctx context.Context, arg GetAuditTrailParams) ([]AuditTrail, error)
 	GetAuditTrailByEntity(ctx context.Context, arg GetAuditTrailByEntityParams) ([]AuditTrail, error)
 	GetAuditTrailByUser(ctx context.Context, arg GetAuditTrailByUserParams) ([]AuditTrail, error)
-	GetChalani(ctx context.Context, id uuid.UUID) (GetChalaniRow, error)
+	// ============================================================================
+	// SLA ENGINE
+	// ============================================================================
+	GetBusinessCalendar(ctx context.Context, tenantID string) (BusinessCalendar, error)
+	GetChalani(ctx context.Context, arg GetChalaniParams) (GetChalaniRow, error)
 	GetChalaniApproval(ctx context.Context, id uuid.UUID) (ChalaniApproval, error)
 	GetChalaniApprovals(ctx context.Context, chalaniID pgtype.UUID) ([]GetChalaniApprovalsRow, error)
 	GetChalaniAttachments(ctx context.Context, chalaniID pgtype.UUID) ([]Attachment, error)
@@ -94,42 +126,152 @@ type Querier interface {
 	GetChalaniByNumber(ctx context.Context, arg GetChalaniByNumberParams) (GetChalaniByNumberRow, error)
 	GetChalaniSignatories(ctx context.Context, chalaniID pgtype.UUID) ([]ChalaniSignatory, error)
 	GetChalaniSignatory(ctx context.Context, id uuid.UUID) (ChalaniSignatory, error)
-	GetChalaniSimple(ctx context.Context, id uuid.UUID) (Chalani, error)
+	GetChalaniSimple(ctx context.Context, arg GetChalaniSimpleParams) (Chalani, error)
 	GetChalaniStatsByChannel(ctx context.Context, arg GetChalaniStatsByChannelParams) ([]GetChalaniStatsByChannelRow, error)
 	// Statistics
 	GetChalaniStatsByStatus(ctx context.Context, arg GetChalaniStatsByStatusParams) ([]GetChalaniStatsByStatusRow, error)
 	GetChalaniTemplate(ctx context.Context, id uuid.UUID) (ChalaniTemplate, error)
-	GetDarta(ctx context.Context, id uuid.UUID) (GetDartaRow, error)
+	GetDarta(ctx context.Context, arg GetDartaParams) (GetDartaRow, error)
 	GetDartaAnnexes(ctx context.Context, dartaID pgtype.UUID) ([]Attachment, error)
 	GetDartaByIdempotencyKey(ctx context.Context, arg GetDartaByIdempotencyKeyParams) (Darta, error)
 	GetDartaByNumber(ctx context.Context, arg GetDartaByNumberParams) (GetDartaByNumberRow, error)
-	GetDartaSimple(ctx context.Context, id uuid.UUID) (Darta, error)
+	GetDartaSimple(ctx context.Context, arg GetDartaSimpleParams) (Darta, error)
 	GetDartaStatsByChannel(ctx context.Context, arg GetDartaStatsByChannelParams) ([]GetDartaStatsByChannelRow, error)
 	// Statistics queries
 	GetDartaStatsByStatus(ctx context.Context, arg GetDartaStatsByStatusParams) ([]GetDartaStatsByStatusRow, error)
-	GetMyChalani(ctx context.Context, arg GetMyChalaniParams) ([]GetMyChalaniRow, error)
-	GetMyDartas(ctx context.Context, arg GetMyDartasParams) ([]GetMyDartasRow, error)
+	// ============================================================================
+	// DARTA STATISTICS
+	// ============================================================================
+	//
+	// Times in state come from the audit trail: a darta is registered and closed
+	// when a STATUS_CHANGED entry moves it there, and it is with a section from
+	// an ASSIGNED entry until the next assignment, response or close.
+	// One row for all matching dartas, then one per ward, section, channel and
+	// priority. Durations are elapsed hours.
+	GetDartaStatsSummary(ctx context.Context, arg GetDartaStatsSummaryParams) ([]GetDartaStatsSummaryRow, error)
+	// Every darta in the supersession chain through a darta, oldest first
+	GetDartaSupersessionChain(ctx context.Context, arg GetDartaSupersessionChainParams) ([]Darta, error)
+	GetDartasByIDs(ctx context.Context, arg GetDartasByIDsParams) ([]Darta, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
 	GetNextChalaniNumber(ctx context.Context, arg GetNextChalaniNumberParams) (int32, error)
 	GetNextDartaNumber(ctx context.Context, arg GetNextDartaNumberParams) (int32, error)
 	GetOverdueCount(ctx context.Context, arg GetOverdueCountParams) (int64, error)
 	GetRecipient(ctx context.Context, id uuid.UUID) (Recipient, error)
 	GetRelatedDartas(ctx context.Context, dartaID pgtype.UUID) ([]GetRelatedDartasRow, error)
+	// How long dartas stay with each section they are assigned to. Spans still
+	// open are left out.
+	GetSectionTurnaround(ctx context.Context, arg GetSectionTurnaroundParams) ([]GetSectionTurnaroundRow, error)
+	GetTenantAttachmentsByIDs(ctx context.Context, arg GetTenantAttachmentsByIDsParams) ([]Attachment, error)
+	// The head of a unit, falling back to the tenant's '*' head
+	GetUnitHead(ctx context.Context, arg GetUnitHeadParams) (string, error)
+	HasPerformedAuditAction(ctx context.Context, arg HasPerformedAuditActionParams) (bool, error)
 	ListApplicants(ctx context.Context, arg ListApplicantsParams) ([]Applicant, error)
 	ListAttachments(ctx context.Context, arg ListAttachmentsParams) ([]Attachment, error)
 	ListAttachmentsByUploader(ctx context.Context, arg ListAttachmentsByUploaderParams) ([]Attachment, error)
+	ListAuditAnchors(ctx context.Context, arg ListAuditAnchorsParams) ([]AuditAnchor, error)
+	ListAuditChain(ctx context.Context, arg ListAuditChainParams) ([]AuditTrail, error)
+	ListAuditChainHeads(ctx context.Context) ([]ListAuditChainHeadsRow, error)
+	ListAuditEntriesAsc(ctx context.Context, arg ListAuditEntriesAscParams) ([]AuditTrail, error)
+	ListAuditEntriesByCategory(ctx context.Context, arg ListAuditEntriesByCategoryParams) ([]AuditTrail, error)
+	ListAuditEntriesDesc(ctx context.Context, arg ListAuditEntriesDescParams) ([]AuditTrail, error)
+	ListCalendarHolidays(ctx context.Context, arg ListCalendarHolidaysParams) ([]CalendarHoliday, error)
+	// A chalani's section is that of the darta it answers
+	ListChalaniRegister(ctx context.Context, arg ListChalaniRegisterParams) ([]ListChalaniRegisterRow, error)
 	ListChalaniTemplates(ctx context.Context, arg ListChalaniTemplatesParams) ([]ChalaniTemplate, error)
+	ListChalanisByChalaniNumberAsc(ctx context.Context, arg ListChalanisByChalaniNumberAscParams) ([]Chalani, error)
+	ListChalanisByChalaniNumberDesc(ctx context.Context, arg ListChalanisByChalaniNumberDescParams) ([]Chalani, error)
+	ListChalanisByCreatedAtAsc(ctx context.Context, arg ListChalanisByCreatedAtAscParams) ([]Chalani, error)
 	// List with filtering
-	ListChalanis(ctx context.Context, arg ListChalanisParams) ([]ListChalanisRow, error)
+	// Keyset pages, as for dartas: rows strictly after the cursor (sort key, id)
+	// in the order of an idx_chalanis_tenant_* index
+	ListChalanisByCreatedAtDesc(ctx context.Context, arg ListChalanisByCreatedAtDescParams) ([]Chalani, error)
+	ListDartaAnnexIDs(ctx context.Context, arg ListDartaAnnexIDsParams) ([]ListDartaAnnexIDsRow, error)
+	// Dartas received, registered and closed per Nepal day in [from, to)
+	ListDartaDailyActivity(ctx context.Context, arg ListDartaDailyActivityParams) ([]ListDartaDailyActivityRow, error)
+	// ============================================================================
+	// STATUTORY REGISTERS
+	// ============================================================================
+	//
+	// Every numbered record of a fiscal year's register, in number order. The
+	// municipality register is the one without a ward.
+	ListDartaRegister(ctx context.Context, arg ListDartaRegisterParams) ([]ListDartaRegisterRow, error)
+	ListDartaRelationships(ctx context.Context, arg ListDartaRelationshipsParams) ([]ListDartaRelationshipsRow, error)
+	ListDartasByCreatedAtAsc(ctx context.Context, arg ListDartasByCreatedAtAscParams) ([]Darta, error)
 	// Complex queries with filtering
-	ListDartas(ctx context.Context, arg ListDartasParams) ([]ListDartasRow, error)
+	//
+	// Lists page by keyset: each sort order has an ascending and a descending
+	// query that returns the rows strictly after the cursor (sort key, id). The
+	// first page passes a sentinel cursor before every row. The ORDER BY matches
+	// an idx_dartas_tenant_* index, so a page costs an index range scan however
+	// deep it is.
+	ListDartasByCreatedAtDesc(ctx context.Context, arg ListDartasByCreatedAtDescParams) ([]Darta, error)
+	ListDartasByDartaNumberAsc(ctx context.Context, arg ListDartasByDartaNumberAscParams) ([]Darta, error)
+	ListDartasByDartaNumberDesc(ctx context.Context, arg ListDartasByDartaNumberDescParams) ([]Darta, error)
+	ListDartasByReceivedDateAsc(ctx context.Context, arg ListDartasByReceivedDateAscParams) ([]Darta, error)
+	ListDartasByReceivedDateDesc(ctx context.Context, arg ListDartasByReceivedDateDescParams) ([]Darta, error)
+	// Running clocks past their deadline and not yet marked breached, or past
+	// escalate_at and not yet escalated
+	ListDueSLAClocks(ctx context.Context, arg ListDueSLAClocksParams) ([]SlaClock, error)
+	// ============================================================================
+	// DUPLICATE DETECTION
+	// ============================================================================
+	// Live dartas in the tenant, in any ward, received within the window that
+	// share an applicant identifier or the primary document with the probe or
+	// have a similar subject (pg_trgm's % operator). Phones are compared by
+	// their last ten digits.
+	ListDuplicateCandidates(ctx context.Context, arg ListDuplicateCandidatesParams) ([]ListDuplicateCandidatesRow, error)
+	// The newest limit_count activity entries of an entity, returned oldest first
+	ListEntityTimeline(ctx context.Context, arg ListEntityTimelineParams) ([]AuditTrail, error)
+	// ============================================================================
+	// METADATA SCHEMAS
+	// ============================================================================
+	ListMetadataSchemas(ctx context.Context, tenantID string) ([]MetadataSchema, error)
+	ListOpenSLAClocks(ctx context.Context, arg ListOpenSLAClocksParams) ([]SlaClock, error)
+	ListRecentAuditEntriesForEntities(ctx context.Context, arg ListRecentAuditEntriesForEntitiesParams) ([]AuditTrail, error)
 	ListRecipients(ctx context.Context, arg ListRecipientsParams) ([]Recipient, error)
+	ListSLAClocks(ctx context.Context, arg ListSLAClocksParams) ([]SlaClock, error)
+	ListSLAPolicies(ctx context.Context, tenantID string) ([]SlaPolicy, error)
+	ListStaleChalaniSearchSources(ctx context.Context, limit int32) ([]ListStaleChalaniSearchSourcesRow, error)
+	// ============================================================================
+	// SEARCH DOCUMENTS
+	// ============================================================================
+	// Dartas with no search document, or whose darta or applicant changed since
+	// it was built, with everything the document is built from
+	ListStaleDartaSearchSources(ctx context.Context, limit int32) ([]ListStaleDartaSearchSourcesRow, error)
+	ListUnitHeads(ctx context.Context, tenantID string) ([]UnitHead, error)
+	ListUnsyncedChalaniSLASources(ctx context.Context, limit int32) ([]ListUnsyncedChalaniSLASourcesRow, error)
+	// Dartas changed since the SLA engine last reconciled their clocks
+	ListUnsyncedDartaSLASources(ctx context.Context, limit int32) ([]ListUnsyncedDartaSLASourcesRow, error)
 	MarkChalaniDelivered(ctx context.Context, arg MarkChalaniDeliveredParams) (Chalani, error)
+	MarkChalaniSLASynced(ctx context.Context, arg MarkChalaniSLASyncedParams) error
+	MarkSLAClockBreached(ctx context.Context, arg MarkSLAClockBreachedParams) (SlaClock, error)
+	MarkSLAClockEscalated(ctx context.Context, arg MarkSLAClockEscalatedParams) (SlaClock, error)
+	// The most specific policy for a stage: classification outranks priority,
+	// and either outranks a catch-all
+	MatchSLAPolicy(ctx context.Context, arg MatchSLAPolicyParams) (SlaPolicy, error)
+	// Derives sla_deadline and is_overdue from the darta's open clocks. Leaves
+	// updated_at alone so the refresh does not look like a change.
+	RefreshDartaSLAState(ctx context.Context, arg RefreshDartaSLAStateParams) error
+	// Frees a claim whose request failed, so it can be retried
+	ReleaseIdempotencyKey(ctx context.Context, arg ReleaseIdempotencyKeyParams) error
 	RemoveAllDartaAnnexes(ctx context.Context, dartaID pgtype.UUID) error
 	RemoveAllDartaRelationships(ctx context.Context, dartaID pgtype.UUID) error
 	RemoveChalaniAttachment(ctx context.Context, arg RemoveChalaniAttachmentParams) error
 	RemoveChalaniSignatory(ctx context.Context, id uuid.UUID) error
 	RemoveDartaAnnex(ctx context.Context, arg RemoveDartaAnnexParams) error
 	RemoveDartaRelationship(ctx context.Context, arg RemoveDartaRelationshipParams) error
+	// Searches match the tsquery built from the query's phonetic keys, or are
+	// close enough to fold_key by trigram word similarity (pg_trgm's <%
+	// operator, threshold pg_trgm.word_similarity_threshold). Facet filters are
+	// ANY-of lists; NULL applies no filter.
+	SearchDocuments(ctx context.Context, arg SearchDocumentsParams) ([]SearchDocumentsRow, error)
+	// Counts per facet value over every match of the query, ignoring the facet
+	// filters so that other values stay visible
+	SearchFacets(ctx context.Context, arg SearchFacetsParams) ([]SearchFacetsRow, error)
+	// Marks a darta superseded by its replacement and links the replacement
+	// back, in one statement. Neither row changes unless both are still
+	// unlinked and the darta is still at version.
+	SupersedeDarta(ctx context.Context, arg SupersedeDartaParams) (Darta, error)
 	UpdateApplicant(ctx context.Context, arg UpdateApplicantParams) (Applicant, error)
 	UpdateChalaniAcknowledgement(ctx context.Context, arg UpdateChalaniAcknowledgementParams) (Chalani, error)
 	UpdateChalaniApprovalStatus(ctx context.Context, arg UpdateChalaniApprovalStatusParams) (Chalani, error)
@@ -139,12 +281,22 @@ type Querier interface {
 	UpdateChalaniTemplate(ctx context.Context, arg UpdateChalaniTemplateParams) (ChalaniTemplate, error)
 	UpdateDartaAssignment(ctx context.Context, arg UpdateDartaAssignmentParams) (Darta, error)
 	UpdateDartaClassification(ctx context.Context, arg UpdateDartaClassificationParams) (Darta, error)
+	// Replaces a darta's metadata if it is still at version, bumping the version
 	UpdateDartaMetadata(ctx context.Context, arg UpdateDartaMetadataParams) (Darta, error)
+	// Assigns the number and moves the darta to NUMBER_RESERVED in one versioned
+	// update, so a concurrent change cannot leave a number without its status
 	UpdateDartaNumber(ctx context.Context, arg UpdateDartaNumberParams) (Darta, error)
 	UpdateDartaStatus(ctx context.Context, arg UpdateDartaStatusParams) (Darta, error)
 	UpdateRecipient(ctx context.Context, arg UpdateRecipientParams) (Recipient, error)
-	VoidChalani(ctx context.Context, id uuid.UUID) (Chalani, error)
-	VoidDarta(ctx context.Context, id uuid.UUID) (Darta, error)
+	UpdateSLAClock(ctx context.Context, arg UpdateSLAClockParams) (SlaClock, error)
+	UpsertBusinessCalendar(ctx context.Context, arg UpsertBusinessCalendarParams) (BusinessCalendar, error)
+	UpsertCalendarHoliday(ctx context.Context, arg UpsertCalendarHolidayParams) (CalendarHoliday, error)
+	UpsertMetadataSchema(ctx context.Context, arg UpsertMetadataSchemaParams) (MetadataSchema, error)
+	UpsertSLAPolicy(ctx context.Context, arg UpsertSLAPolicyParams) (SlaPolicy, error)
+	UpsertSearchDocument(ctx context.Context, arg UpsertSearchDocumentParams) error
+	UpsertUnitHead(ctx context.Context, arg UpsertUnitHeadParams) (UnitHead, error)
+	VoidChalani(ctx context.Context, arg VoidChalaniParams) (Chalani, error)
+	VoidDarta(ctx context.Context, arg VoidDartaParams) (Darta, error)
 }
 
 var _ Querier = (*Queries)(nil)
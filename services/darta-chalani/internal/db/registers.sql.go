@@ -0,0 +1,159 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: registers.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const listChalaniRegister = `-- name: ListChalaniRegister :many
+SELECT
+    c.chalani_number::INT AS number,
+    COALESCE(c.formatted_chalani_number, '')::TEXT AS formatted_number,
+    COALESCE(c.dispatched_at, c.created_at)::TIMESTAMPTZ AS recorded_at,
+    COALESCE(NULLIF(r.organization, ''), r.name)::TEXT AS party,
+    c.subject,
+    COALESCE(d.assigned_to_unit_id, '')::TEXT AS section,
+    c.status
+FROM chalanis c
+JOIN recipients r ON r.id = c.recipient_id
+LEFT JOIN dartas d ON d.id = c.linked_darta_id
+WHERE c.tenant_id = $1
+  AND c.fiscal_year_id = $2
+  AND c.scope = $3
+  AND (c.ward_id = $4 OR (c.ward_id IS NULL AND $4::VARCHAR IS NULL))
+  AND c.chalani_number IS NOT NULL
+ORDER BY c.chalani_number
+`
+
+type ListChalaniRegisterParams struct {
+	TenantID     string  `json:"tenant_id"`
+	FiscalYearID string  `json:"fiscal_year_id"`
+	Scope        string  `json:"scope"`
+	WardID       *string `json:"ward_id"`
+}
+
+type ListChalaniRegisterRow struct {
+	Number          int32              `json:"number"`
+	FormattedNumber string             `json:"formatted_number"`
+	RecordedAt      pgtype.Timestamptz `json:"recorded_at"`
+	Party           string             `json:"party"`
+	Subject         string             `json:"subject"`
+	Section         string             `json:"section"`
+	Status          string             `json:"status"`
+}
+
+// A chalani's section is that of the darta it answers
+func (q *Queries) ListChalaniRegister(ctx context.Context, arg ListChalaniRegisterParams) ([]ListChalaniRegisterRow, error) {
+	rows, err := q.db.Query(ctx, listChalaniRegister,
+		arg.TenantID,
+		arg.FiscalYearID,
+		arg.Scope,
+		arg.WardID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListChalaniRegisterRow
+	for rows.Next() {
+		var i ListChalaniRegisterRow
+		if err := rows.Scan(
+			&i.Number,
+			&i.FormattedNumber,
+			&i.RecordedAt,
+			&i.Party,
+			&i.Subject,
+			&i.Section,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDartaRegister = `-- name: ListDartaRegister :many
+
+SELECT
+    d.darta_number::INT AS number,
+    COALESCE(d.formatted_darta_number, '')::TEXT AS formatted_number,
+    d.received_date AS recorded_at,
+    COALESCE(NULLIF(a.organization, ''), a.full_name)::TEXT AS party,
+    d.subject,
+    COALESCE(d.assigned_to_unit_id, '')::TEXT AS section,
+    d.status
+FROM dartas d
+JOIN applicants a ON a.id = d.applicant_id
+WHERE d.tenant_id = $1
+  AND d.fiscal_year_id = $2
+  AND d.scope = $3
+  AND (d.ward_id = $4 OR (d.ward_id IS NULL AND $4::VARCHAR IS NULL))
+  AND d.darta_number IS NOT NULL
+ORDER BY d.darta_number
+`
+
+type ListDartaRegisterParams struct {
+	TenantID     string  `json:"tenant_id"`
+	FiscalYearID string  `json:"fiscal_year_id"`
+	Scope        string  `json:"scope"`
+	WardID       *string `json:"ward_id"`
+}
+
+type ListDartaRegisterRow struct {
+	Number          int32              `json:"number"`
+	FormattedNumber string             `json:"formatted_number"`
+	RecordedAt      pgtype.Timestamptz `json:"recorded_at"`
+	Party           string             `json:"party"`
+	Subject         string             `json:"subject"`
+	Section         string             `json:"section"`
+	Status          string             `json:"status"`
+}
+
+// ============================================================================
+// STATUTORY REGISTERS
+// ============================================================================
+//
+// Every numbered record of a fiscal year's register, in number order. The
+// municipality register is the one without a ward.
+func (q *Queries) ListDartaRegister(ctx context.Context, arg ListDartaRegisterParams) ([]ListDartaRegisterRow, error) {
+	rows, err := q.db.Query(ctx, listDartaRegister,
+		arg.TenantID,
+		arg.FiscalYearID,
+		arg.Scope,
+		arg.WardID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDartaRegisterRow
+	for rows.Next() {
+		var i ListDartaRegisterRow
+		if err := rows.Scan(
+			&i.Number,
+			&i.FormattedNumber,
+			&i.RecordedAt,
+			&i.Party,
+			&i.Subject,
+			&i.Section,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
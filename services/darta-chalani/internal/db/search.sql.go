@@ -0,0 +1,466 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: search.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countSearchDocuments = `-- name: CountSearchDocuments :one
+SELECT COUNT(*)
+FROM search_documents s
+WHERE s.tenant_id = $1
+    AND (s.document @@ to_tsquery('simple', $2::TEXT) OR $3::TEXT <% s.fold_key)
+    AND ($4::TEXT[] IS NULL OR s.entity_type = ANY($4::TEXT[]))
+    AND ($5::TEXT[] IS NULL OR s.status = ANY($5::TEXT[]))
+    AND ($6::TEXT[] IS NULL OR s.fiscal_year_id = ANY($6::TEXT[]))
+    AND ($7::TEXT[] IS NULL OR s.ward_id = ANY($7::TEXT[]))
+    AND ($8::TEXT[] IS NULL OR s.channel = ANY($8::TEXT[]))
+`
+
+type CountSearchDocumentsParams struct {
+	TenantID      string   `json:"tenant_id"`
+	Query         string   `json:"query"`
+	FoldKey       string   `json:"fold_key"`
+	EntityTypes   []string `json:"entity_types"`
+	Statuses      []string `json:"statuses"`
+	FiscalYearIds []string `json:"fiscal_year_ids"`
+	WardIds       []string `json:"ward_ids"`
+	Channels      []string `json:"channels"`
+}
+
+func (q *Queries) CountSearchDocuments(ctx context.Context, arg CountSearchDocumentsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSearchDocuments,
+		arg.TenantID,
+		arg.Query,
+		arg.FoldKey,
+		arg.EntityTypes,
+		arg.Statuses,
+		arg.FiscalYearIds,
+		arg.WardIds,
+		arg.Channels,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const listStaleChalaniSearchSources = `-- name: ListStaleChalaniSearchSources :many
+SELECT
+    c.id,
+    c.tenant_id,
+    c.subject,
+    c.body,
+    c.chalani_number,
+    c.formatted_chalani_number,
+    c.status,
+    c.fiscal_year_id,
+    c.ward_id,
+    c.dispatch_channel,
+    r.name,
+    r.organization,
+    COALESCE(c.metadata->>'ocr_text', '')::TEXT AS ocr_text,
+    COALESCE((
+        SELECT string_agg(att.metadata->>'ocr_text', E'\n')
+        FROM attachments att
+        JOIN chalani_attachments ca ON ca.attachment_id = att.id
+        WHERE ca.chalani_id = c.id
+    ), '')::TEXT AS attachment_text,
+    GREATEST(c.updated_at, r.updated_at)::TIMESTAMPTZ AS source_updated_at
+FROM chalanis c
+JOIN recipients r ON r.id = c.recipient_id
+LEFT JOIN search_documents s ON s.entity_type = 'CHALANI' AND s.entity_id = c.id
+WHERE s.entity_id IS NULL OR s.source_updated_at < GREATEST(c.updated_at, r.updated_at)
+ORDER BY c.updated_at
+LIMIT $1
+`
+
+type ListStaleChalaniSearchSourcesRow struct {
+	ID                     uuid.UUID          `json:"id"`
+	TenantID               string             `json:"tenant_id"`
+	Subject                string             `json:"subject"`
+	Body                   string             `json:"body"`
+	ChalaniNumber          *int32             `json:"chalani_number"`
+	FormattedChalaniNumber *string            `json:"formatted_chalani_number"`
+	Status                 string             `json:"status"`
+	FiscalYearID           string             `json:"fiscal_year_id"`
+	WardID                 *string            `json:"ward_id"`
+	DispatchChannel        *string            `json:"dispatch_channel"`
+	Name                   string             `json:"name"`
+	Organization           *string            `json:"organization"`
+	OcrText                string             `json:"ocr_text"`
+	AttachmentText         string             `json:"attachment_text"`
+	SourceUpdatedAt        pgtype.Timestamptz `json:"source_updated_at"`
+}
+
+func (q *Queries) ListStaleChalaniSearchSources(ctx context.Context, limit int32) ([]ListStaleChalaniSearchSourcesRow, error) {
+	rows, err := q.db.Query(ctx, listStaleChalaniSearchSources, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListStaleChalaniSearchSourcesRow
+	for rows.Next() {
+		var i ListStaleChalaniSearchSourcesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Subject,
+			&i.Body,
+			&i.ChalaniNumber,
+			&i.FormattedChalaniNumber,
+			&i.Status,
+			&i.FiscalYearID,
+			&i.WardID,
+			&i.DispatchChannel,
+			&i.Name,
+			&i.Organization,
+			&i.OcrText,
+			&i.AttachmentText,
+			&i.SourceUpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStaleDartaSearchSources = `-- name: ListStaleDartaSearchSources :many
+
+SELECT
+    d.id,
+    d.tenant_id,
+    d.subject,
+    d.darta_number,
+    d.formatted_darta_number,
+    d.classification_code,
+    d.status,
+    d.fiscal_year_id,
+    d.ward_id,
+    d.intake_channel,
+    a.full_name,
+    a.organization,
+    COALESCE(d.metadata->>'ocr_text', '')::TEXT AS ocr_text,
+    COALESCE((
+        SELECT string_agg(att.metadata->>'ocr_text', E'\n')
+        FROM attachments att
+        WHERE att.id = d.primary_document_id
+           OR att.id IN (SELECT da.attachment_id FROM darta_annexes da WHERE da.darta_id = d.id)
+    ), '')::TEXT AS attachment_text,
+    GREATEST(d.updated_at, a.updated_at)::TIMESTAMPTZ AS source_updated_at
+FROM dartas d
+JOIN applicants a ON a.id = d.applicant_id
+LEFT JOIN search_documents s ON s.entity_type = 'DARTA' AND s.entity_id = d.id
+WHERE s.entity_id IS NULL OR s.source_updated_at < GREATEST(d.updated_at, a.updated_at)
+ORDER BY d.updated_at
+LIMIT $1
+`
+
+type ListStaleDartaSearchSourcesRow struct {
+	ID                   uuid.UUID          `json:"id"`
+	TenantID             string             `json:"tenant_id"`
+	Subject              string             `json:"subject"`
+	DartaNumber          *int32             `json:"darta_number"`
+	FormattedDartaNumber *string            `json:"formatted_darta_number"`
+	ClassificationCode   *string            `json:"classification_code"`
+	Status               string             `json:"status"`
+	FiscalYearID         string             `json:"fiscal_year_id"`
+	WardID               *string            `json:"ward_id"`
+	IntakeChannel        string             `json:"intake_channel"`
+	FullName             string             `json:"full_name"`
+	Organization         *string            `json:"organization"`
+	OcrText              string             `json:"ocr_text"`
+	AttachmentText       string             `json:"attachment_text"`
+	SourceUpdatedAt      pgtype.Timestamptz `json:"source_updated_at"`
+}
+
+// ============================================================================
+// SEARCH DOCUMENTS
+// ============================================================================
+// Dartas with no search document, or whose darta or applicant changed since
+// it was built, with everything the document is built from
+func (q *Queries) ListStaleDartaSearchSources(ctx context.Context, limit int32) ([]ListStaleDartaSearchSourcesRow, error) {
+	rows, err := q.db.Query(ctx, listStaleDartaSearchSources, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListStaleDartaSearchSourcesRow
+	for rows.Next() {
+		var i ListStaleDartaSearchSourcesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Subject,
+			&i.DartaNumber,
+			&i.FormattedDartaNumber,
+			&i.ClassificationCode,
+			&i.Status,
+			&i.FiscalYearID,
+			&i.WardID,
+			&i.IntakeChannel,
+			&i.FullName,
+			&i.Organization,
+			&i.OcrText,
+			&i.AttachmentText,
+			&i.SourceUpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchDocuments = `-- name: SearchDocuments :many
+
+SELECT
+    s.entity_type,
+    s.entity_id,
+    s.title,
+    s.number,
+    s.party,
+    s.content,
+    s.status,
+    s.fiscal_year_id,
+    s.ward_id,
+    s.channel,
+    (ts_rank_cd(s.document, to_tsquery('simple', $1::TEXT))
+        + 0.5 * word_similarity($2::TEXT, s.fold_key))::FLOAT8 AS score
+FROM search_documents s
+WHERE s.tenant_id = $3
+    AND (s.document @@ to_tsquery('simple', $1::TEXT) OR $2::TEXT <% s.fold_key)
+    AND ($4::TEXT[] IS NULL OR s.entity_type = ANY($4::TEXT[]))
+    AND ($5::TEXT[] IS NULL OR s.status = ANY($5::TEXT[]))
+    AND ($6::TEXT[] IS NULL OR s.fiscal_year_id = ANY($6::TEXT[]))
+    AND ($7::TEXT[] IS NULL OR s.ward_id = ANY($7::TEXT[]))
+    AND ($8::TEXT[] IS NULL OR s.channel = ANY($8::TEXT[]))
+ORDER BY score DESC, s.entity_id
+LIMIT $10
+OFFSET $9
+`
+
+type SearchDocumentsParams struct {
+	Query         string   `json:"query"`
+	FoldKey       string   `json:"fold_key"`
+	TenantID      string   `json:"tenant_id"`
+	EntityTypes   []string `json:"entity_types"`
+	Statuses      []string `json:"statuses"`
+	FiscalYearIds []string `json:"fiscal_year_ids"`
+	WardIds       []string `json:"ward_ids"`
+	Channels      []string `json:"channels"`
+	Offset        int32    `json:"offset"`
+	Limit         int32    `json:"limit"`
+}
+
+type SearchDocumentsRow struct {
+	EntityType   string      `json:"entity_type"`
+	EntityID     pgtype.UUID `json:"entity_id"`
+	Title        string      `json:"title"`
+	Number       *string     `json:"number"`
+	Party        string      `json:"party"`
+	Content      string      `json:"content"`
+	Status       string      `json:"status"`
+	FiscalYearID string      `json:"fiscal_year_id"`
+	WardID       *string     `json:"ward_id"`
+	Channel      *string     `json:"channel"`
+	Score        float64     `json:"score"`
+}
+
+// Searches match the tsquery built from the query's phonetic keys, or are
+// close enough to fold_key by trigram word similarity (pg_trgm's <%
+// operator, threshold pg_trgm.word_similarity_threshold). Facet filters are
+// ANY-of lists; NULL applies no filter.
+func (q *Queries) SearchDocuments(ctx context.Context, arg SearchDocumentsParams) ([]SearchDocumentsRow, error) {
+	rows, err := q.db.Query(ctx, searchDocuments,
+		arg.Query,
+		arg.FoldKey,
+		arg.TenantID,
+		arg.EntityTypes,
+		arg.Statuses,
+		arg.FiscalYearIds,
+		arg.WardIds,
+		arg.Channels,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchDocumentsRow
+	for rows.Next() {
+		var i SearchDocumentsRow
+		if err := rows.Scan(
+			&i.EntityType,
+			&i.EntityID,
+			&i.Title,
+			&i.Number,
+			&i.Party,
+			&i.Content,
+			&i.Status,
+			&i.FiscalYearID,
+			&i.WardID,
+			&i.Channel,
+			&i.Score,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchFacets = `-- name: SearchFacets :many
+WITH matches AS (
+    SELECT s.status, s.fiscal_year_id, s.ward_id, s.channel
+    FROM search_documents s
+    WHERE s.tenant_id = $1
+        AND (s.document @@ to_tsquery('simple', $2::TEXT) OR $3::TEXT <% s.fold_key)
+        AND ($4::TEXT[] IS NULL OR s.entity_type = ANY($4::TEXT[]))
+)
+SELECT 'status'::TEXT AS facet, m.status::TEXT AS value, COUNT(*) AS count FROM matches m GROUP BY m.status
+UNION ALL
+SELECT 'fiscal_year', m.fiscal_year_id, COUNT(*) FROM matches m GROUP BY m.fiscal_year_id
+UNION ALL
+SELECT 'ward', m.ward_id, COUNT(*) FROM matches m WHERE m.ward_id IS NOT NULL GROUP BY m.ward_id
+UNION ALL
+SELECT 'channel', m.channel, COUNT(*) FROM matches m WHERE m.channel IS NOT NULL GROUP BY m.channel
+ORDER BY facet, count DESC, value
+`
+
+type SearchFacetsParams struct {
+	TenantID    string   `json:"tenant_id"`
+	Query       string   `json:"query"`
+	FoldKey     string   `json:"fold_key"`
+	EntityTypes []string `json:"entity_types"`
+}
+
+type SearchFacetsRow struct {
+	Facet string `json:"facet"`
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// Counts per facet value over every match of the query, ignoring the facet
+// filters so that other values stay visible
+func (q *Queries) SearchFacets(ctx context.Context, arg SearchFacetsParams) ([]SearchFacetsRow, error) {
+	rows, err := q.db.Query(ctx, searchFacets,
+		arg.TenantID,
+		arg.Query,
+		arg.FoldKey,
+		arg.EntityTypes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchFacetsRow
+	for rows.Next() {
+		var i SearchFacetsRow
+		if err := rows.Scan(&i.Facet, &i.Value, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertSearchDocument = `-- name: UpsertSearchDocument :exec
+INSERT INTO search_documents (
+    entity_type,
+    entity_id,
+    tenant_id,
+    title,
+    number,
+    party,
+    content,
+    status,
+    fiscal_year_id,
+    ward_id,
+    channel,
+    number_terms,
+    title_terms,
+    body_terms,
+    fold_key,
+    source_updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+)
+ON CONFLICT (entity_type, entity_id) DO UPDATE SET
+    tenant_id = EXCLUDED.tenant_id,
+    title = EXCLUDED.title,
+    number = EXCLUDED.number,
+    party = EXCLUDED.party,
+    content = EXCLUDED.content,
+    status = EXCLUDED.status,
+    fiscal_year_id = EXCLUDED.fiscal_year_id,
+    ward_id = EXCLUDED.ward_id,
+    channel = EXCLUDED.channel,
+    number_terms = EXCLUDED.number_terms,
+    title_terms = EXCLUDED.title_terms,
+    body_terms = EXCLUDED.body_terms,
+    fold_key = EXCLUDED.fold_key,
+    source_updated_at = EXCLUDED.source_updated_at,
+    indexed_at = NOW()
+`
+
+type UpsertSearchDocumentParams struct {
+	EntityType      string             `json:"entity_type"`
+	EntityID        pgtype.UUID        `json:"entity_id"`
+	TenantID        string             `json:"tenant_id"`
+	Title           string             `json:"title"`
+	Number          *string            `json:"number"`
+	Party           string             `json:"party"`
+	Content         string             `json:"content"`
+	Status          string             `json:"status"`
+	FiscalYearID    string             `json:"fiscal_year_id"`
+	WardID          *string            `json:"ward_id"`
+	Channel         *string            `json:"channel"`
+	NumberTerms     string             `json:"number_terms"`
+	TitleTerms      string             `json:"title_terms"`
+	BodyTerms       string             `json:"body_terms"`
+	FoldKey         string             `json:"fold_key"`
+	SourceUpdatedAt pgtype.Timestamptz `json:"source_updated_at"`
+}
+
+func (q *Queries) UpsertSearchDocument(ctx context.Context, arg UpsertSearchDocumentParams) error {
+	_, err := q.db.Exec(ctx, upsertSearchDocument,
+		arg.EntityType,
+		arg.EntityID,
+		arg.TenantID,
+		arg.Title,
+		arg.Number,
+		arg.Party,
+		arg.Content,
+		arg.Status,
+		arg.FiscalYearID,
+		arg.WardID,
+		arg.Channel,
+		arg.NumberTerms,
+		arg.TitleTerms,
+		arg.BodyTerms,
+		arg.FoldKey,
+		arg.SourceUpdatedAt,
+	)
+	return err
+}
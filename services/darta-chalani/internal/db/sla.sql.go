@@ -0,0 +1,873 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: sla.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createSLAClock = `-- name: CreateSLAClock :one
+INSERT INTO sla_clocks (
+    tenant_id, entity_type, entity_id, stage, policy_id,
+    target_minutes, escalate_after_minutes, started_at, resumed_at,
+    deadline, escalate_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $8, $9, $10
+)
+ON CONFLICT (entity_type, entity_id, stage) WHERE stopped_at IS NULL DO NOTHING
+RETURNING id, tenant_id, entity_type, entity_id, stage, policy_id, target_minutes, escalate_after_minutes, elapsed_minutes, started_at, resumed_at, paused_at, stopped_at, deadline, escalate_at, breached_at, escalated_at, escalated_to, updated_at
+`
+
+type CreateSLAClockParams struct {
+	TenantID             string             `json:"tenant_id"`
+	EntityType           string             `json:"entity_type"`
+	EntityID             pgtype.UUID        `json:"entity_id"`
+	Stage                string             `json:"stage"`
+	PolicyID             pgtype.UUID        `json:"policy_id"`
+	TargetMinutes        int32              `json:"target_minutes"`
+	EscalateAfterMinutes int32              `json:"escalate_after_minutes"`
+	StartedAt            pgtype.Timestamptz `json:"started_at"`
+	Deadline             pgtype.Timestamptz `json:"deadline"`
+	EscalateAt           pgtype.Timestamptz `json:"escalate_at"`
+}
+
+func (q *Queries) CreateSLAClock(ctx context.Context, arg CreateSLAClockParams) (SlaClock, error) {
+	row := q.db.QueryRow(ctx, createSLAClock,
+		arg.TenantID,
+		arg.EntityType,
+		arg.EntityID,
+		arg.Stage,
+		arg.PolicyID,
+		arg.TargetMinutes,
+		arg.EscalateAfterMinutes,
+		arg.StartedAt,
+		arg.Deadline,
+		arg.EscalateAt,
+	)
+	var i SlaClock
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.EntityType,
+		&i.EntityID,
+		&i.Stage,
+		&i.PolicyID,
+		&i.TargetMinutes,
+		&i.EscalateAfterMinutes,
+		&i.ElapsedMinutes,
+		&i.StartedAt,
+		&i.ResumedAt,
+		&i.PausedAt,
+		&i.StoppedAt,
+		&i.Deadline,
+		&i.EscalateAt,
+		&i.BreachedAt,
+		&i.EscalatedAt,
+		&i.EscalatedTo,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteCalendarHoliday = `-- name: DeleteCalendarHoliday :exec
+DELETE FROM calendar_holidays WHERE tenant_id = $1 AND holiday_date = $2
+`
+
+type DeleteCalendarHolidayParams struct {
+	TenantID    string      `json:"tenant_id"`
+	HolidayDate pgtype.Date `json:"holiday_date"`
+}
+
+func (q *Queries) DeleteCalendarHoliday(ctx context.Context, arg DeleteCalendarHolidayParams) error {
+	_, err := q.db.Exec(ctx, deleteCalendarHoliday, arg.TenantID, arg.HolidayDate)
+	return err
+}
+
+const deleteSLAPolicy = `-- name: DeleteSLAPolicy :exec
+DELETE FROM sla_policies WHERE id = $1 AND tenant_id = $2
+`
+
+type DeleteSLAPolicyParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID string    `json:"tenant_id"`
+}
+
+func (q *Queries) DeleteSLAPolicy(ctx context.Context, arg DeleteSLAPolicyParams) error {
+	_, err := q.db.Exec(ctx, deleteSLAPolicy, arg.ID, arg.TenantID)
+	return err
+}
+
+const deleteUnitHead = `-- name: DeleteUnitHead :exec
+DELETE FROM unit_heads WHERE tenant_id = $1 AND unit_id = $2
+`
+
+type DeleteUnitHeadParams struct {
+	TenantID string `json:"tenant_id"`
+	UnitID   string `json:"unit_id"`
+}
+
+func (q *Queries) DeleteUnitHead(ctx context.Context, arg DeleteUnitHeadParams) error {
+	_, err := q.db.Exec(ctx, deleteUnitHead, arg.TenantID, arg.UnitID)
+	return err
+}
+
+const getBusinessCalendar = `-- name: GetBusinessCalendar :one
+
+SELECT tenant_id, weekly_off, office_start, office_end, updated_at FROM business_calendars WHERE tenant_id = $1
+`
+
+// ============================================================================
+// SLA ENGINE
+// ============================================================================
+func (q *Queries) GetBusinessCalendar(ctx context.Context, tenantID string) (BusinessCalendar, error) {
+	row := q.db.QueryRow(ctx, getBusinessCalendar, tenantID)
+	var i BusinessCalendar
+	err := row.Scan(
+		&i.TenantID,
+		&i.WeeklyOff,
+		&i.OfficeStart,
+		&i.OfficeEnd,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getUnitHead = `-- name: GetUnitHead :one
+SELECT head_user_id FROM unit_heads
+WHERE tenant_id = $1 AND unit_id IN ($2::VARCHAR, '*')
+ORDER BY (unit_id = '*')
+LIMIT 1
+`
+
+type GetUnitHeadParams struct {
+	TenantID string `json:"tenant_id"`
+	UnitID   string `json:"unit_id"`
+}
+
+// The head of a unit, falling back to the tenant's '*' head
+func (q *Queries) GetUnitHead(ctx context.Context, arg GetUnitHeadParams) (string, error) {
+	row := q.db.QueryRow(ctx, getUnitHead, arg.TenantID, arg.UnitID)
+	var head_user_id string
+	err := row.Scan(&head_user_id)
+	return head_user_id, err
+}
+
+const listCalendarHolidays = `-- name: ListCalendarHolidays :many
+SELECT tenant_id, holiday_date, name, created_at FROM calendar_holidays
+WHERE tenant_id = $1
+    AND holiday_date >= $2::DATE
+    AND holiday_date <= $3::DATE
+ORDER BY holiday_date
+`
+
+type ListCalendarHolidaysParams struct {
+	TenantID string      `json:"tenant_id"`
+	FromDate pgtype.Date `json:"from_date"`
+	ToDate   pgtype.Date `json:"to_date"`
+}
+
+func (q *Queries) ListCalendarHolidays(ctx context.Context, arg ListCalendarHolidaysParams) ([]CalendarHoliday, error) {
+	rows, err := q.db.Query(ctx, listCalendarHolidays, arg.TenantID, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CalendarHoliday
+	for rows.Next() {
+		var i CalendarHoliday
+		if err := rows.Scan(
+			&i.TenantID,
+			&i.HolidayDate,
+			&i.Name,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDueSLAClocks = `-- name: ListDueSLAClocks :many
+SELECT id, tenant_id, entity_type, entity_id, stage, policy_id, target_minutes, escalate_after_minutes, elapsed_minutes, started_at, resumed_at, paused_at, stopped_at, deadline, escalate_at, breached_at, escalated_at, escalated_to, updated_at FROM sla_clocks
+WHERE stopped_at IS NULL
+    AND (
+        (breached_at IS NULL AND deadline <= $1::TIMESTAMPTZ)
+        OR (escalated_at IS NULL AND escalate_at <= $1::TIMESTAMPTZ)
+    )
+ORDER BY deadline
+LIMIT $2
+`
+
+type ListDueSLAClocksParams struct {
+	Now   pgtype.Timestamptz `json:"now"`
+	Limit int32              `json:"limit"`
+}
+
+// Running clocks past their deadline and not yet marked breached, or past
+// escalate_at and not yet escalated
+func (q *Queries) ListDueSLAClocks(ctx context.Context, arg ListDueSLAClocksParams) ([]SlaClock, error) {
+	rows, err := q.db.Query(ctx, listDueSLAClocks, arg.Now, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SlaClock
+	for rows.Next() {
+		var i SlaClock
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Stage,
+			&i.PolicyID,
+			&i.TargetMinutes,
+			&i.EscalateAfterMinutes,
+			&i.ElapsedMinutes,
+			&i.StartedAt,
+			&i.ResumedAt,
+			&i.PausedAt,
+			&i.StoppedAt,
+			&i.Deadline,
+			&i.EscalateAt,
+			&i.BreachedAt,
+			&i.EscalatedAt,
+			&i.EscalatedTo,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOpenSLAClocks = `-- name: ListOpenSLAClocks :many
+SELECT id, tenant_id, entity_type, entity_id, stage, policy_id, target_minutes, escalate_after_minutes, elapsed_minutes, started_at, resumed_at, paused_at, stopped_at, deadline, escalate_at, breached_at, escalated_at, escalated_to, updated_at FROM sla_clocks
+WHERE entity_type = $1 AND entity_id = $2 AND stopped_at IS NULL
+`
+
+type ListOpenSLAClocksParams struct {
+	EntityType string      `json:"entity_type"`
+	EntityID   pgtype.UUID `json:"entity_id"`
+}
+
+func (q *Queries) ListOpenSLAClocks(ctx context.Context, arg ListOpenSLAClocksParams) ([]SlaClock, error) {
+	rows, err := q.db.Query(ctx, listOpenSLAClocks, arg.EntityType, arg.EntityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SlaClock
+	for rows.Next() {
+		var i SlaClock
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Stage,
+			&i.PolicyID,
+			&i.TargetMinutes,
+			&i.EscalateAfterMinutes,
+			&i.ElapsedMinutes,
+			&i.StartedAt,
+			&i.ResumedAt,
+			&i.PausedAt,
+			&i.StoppedAt,
+			&i.Deadline,
+			&i.EscalateAt,
+			&i.BreachedAt,
+			&i.EscalatedAt,
+			&i.EscalatedTo,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSLAClocks = `-- name: ListSLAClocks :many
+SELECT id, tenant_id, entity_type, entity_id, stage, policy_id, target_minutes, escalate_after_minutes, elapsed_minutes, started_at, resumed_at, paused_at, stopped_at, deadline, escalate_at, breached_at, escalated_at, escalated_to, updated_at FROM sla_clocks
+WHERE entity_type = $1 AND entity_id = $2 AND tenant_id = $3
+ORDER BY started_at, stage
+`
+
+type ListSLAClocksParams struct {
+	EntityType string      `json:"entity_type"`
+	EntityID   pgtype.UUID `json:"entity_id"`
+	TenantID   string      `json:"tenant_id"`
+}
+
+func (q *Queries) ListSLAClocks(ctx context.Context, arg ListSLAClocksParams) ([]SlaClock, error) {
+	rows, err := q.db.Query(ctx, listSLAClocks, arg.EntityType, arg.EntityID, arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SlaClock
+	for rows.Next() {
+		var i SlaClock
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Stage,
+			&i.PolicyID,
+			&i.TargetMinutes,
+			&i.EscalateAfterMinutes,
+			&i.ElapsedMinutes,
+			&i.StartedAt,
+			&i.ResumedAt,
+			&i.PausedAt,
+			&i.StoppedAt,
+			&i.Deadline,
+			&i.EscalateAt,
+			&i.BreachedAt,
+			&i.EscalatedAt,
+			&i.EscalatedTo,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSLAPolicies = `-- name: ListSLAPolicies :many
+SELECT id, tenant_id, stage, priority, classification_code, target_minutes, escalate_after_minutes, created_at, updated_at FROM sla_policies
+WHERE tenant_id = $1
+ORDER BY stage, priority NULLS FIRST, classification_code NULLS FIRST
+`
+
+func (q *Queries) ListSLAPolicies(ctx context.Context, tenantID string) ([]SlaPolicy, error) {
+	rows, err := q.db.Query(ctx, listSLAPolicies, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SlaPolicy
+	for rows.Next() {
+		var i SlaPolicy
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Stage,
+			&i.Priority,
+			&i.ClassificationCode,
+			&i.TargetMinutes,
+			&i.EscalateAfterMinutes,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnitHeads = `-- name: ListUnitHeads :many
+SELECT tenant_id, unit_id, head_user_id, updated_at FROM unit_heads WHERE tenant_id = $1 ORDER BY unit_id
+`
+
+func (q *Queries) ListUnitHeads(ctx context.Context, tenantID string) ([]UnitHead, error) {
+	rows, err := q.db.Query(ctx, listUnitHeads, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UnitHead
+	for rows.Next() {
+		var i UnitHead
+		if err := rows.Scan(
+			&i.TenantID,
+			&i.UnitID,
+			&i.HeadUserID,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnsyncedChalaniSLASources = `-- name: ListUnsyncedChalaniSLASources :many
+SELECT id, tenant_id, status, updated_at
+FROM chalanis
+WHERE sla_synced_at IS DISTINCT FROM updated_at
+ORDER BY updated_at
+LIMIT $1
+`
+
+type ListUnsyncedChalaniSLASourcesRow struct {
+	ID        uuid.UUID          `json:"id"`
+	TenantID  string             `json:"tenant_id"`
+	Status    string             `json:"status"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+func (q *Queries) ListUnsyncedChalaniSLASources(ctx context.Context, limit int32) ([]ListUnsyncedChalaniSLASourcesRow, error) {
+	rows, err := q.db.Query(ctx, listUnsyncedChalaniSLASources, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUnsyncedChalaniSLASourcesRow
+	for rows.Next() {
+		var i ListUnsyncedChalaniSLASourcesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Status,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnsyncedDartaSLASources = `-- name: ListUnsyncedDartaSLASources :many
+SELECT id, tenant_id, status, priority, classification_code, assigned_to_unit_id, sla_target_minutes, updated_at
+FROM dartas
+WHERE sla_synced_at IS DISTINCT FROM updated_at
+ORDER BY updated_at
+LIMIT $1
+`
+
+type ListUnsyncedDartaSLASourcesRow struct {
+	ID                 uuid.UUID          `json:"id"`
+	TenantID           string             `json:"tenant_id"`
+	Status             string             `json:"status"`
+	Priority           string             `json:"priority"`
+	ClassificationCode *string            `json:"classification_code"`
+	AssignedToUnitID   *string            `json:"assigned_to_unit_id"`
+	SlaTargetMinutes   *int32             `json:"sla_target_minutes"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+}
+
+// Dartas changed since the SLA engine last reconciled their clocks
+func (q *Queries) ListUnsyncedDartaSLASources(ctx context.Context, limit int32) ([]ListUnsyncedDartaSLASourcesRow, error) {
+	rows, err := q.db.Query(ctx, listUnsyncedDartaSLASources, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUnsyncedDartaSLASourcesRow
+	for rows.Next() {
+		var i ListUnsyncedDartaSLASourcesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Status,
+			&i.Priority,
+			&i.ClassificationCode,
+			&i.AssignedToUnitID,
+			&i.SlaTargetMinutes,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markChalaniSLASynced = `-- name: MarkChalaniSLASynced :exec
+UPDATE chalanis SET sla_synced_at = $2 WHERE id = $1
+`
+
+type MarkChalaniSLASyncedParams struct {
+	ID          uuid.UUID          `json:"id"`
+	SlaSyncedAt pgtype.Timestamptz `json:"sla_synced_at"`
+}
+
+func (q *Queries) MarkChalaniSLASynced(ctx context.Context, arg MarkChalaniSLASyncedParams) error {
+	_, err := q.db.Exec(ctx, markChalaniSLASynced, arg.ID, arg.SlaSyncedAt)
+	return err
+}
+
+const markSLAClockBreached = `-- name: MarkSLAClockBreached :one
+UPDATE sla_clocks
+SET breached_at = $2, updated_at = NOW()
+WHERE id = $1 AND breached_at IS NULL
+RETURNING id, tenant_id, entity_type, entity_id, stage, policy_id, target_minutes, escalate_after_minutes, elapsed_minutes, started_at, resumed_at, paused_at, stopped_at, deadline, escalate_at, breached_at, escalated_at, escalated_to, updated_at
+`
+
+type MarkSLAClockBreachedParams struct {
+	ID         uuid.UUID          `json:"id"`
+	BreachedAt pgtype.Timestamptz `json:"breached_at"`
+}
+
+func (q *Queries) MarkSLAClockBreached(ctx context.Context, arg MarkSLAClockBreachedParams) (SlaClock, error) {
+	row := q.db.QueryRow(ctx, markSLAClockBreached, arg.ID, arg.BreachedAt)
+	var i SlaClock
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.EntityType,
+		&i.EntityID,
+		&i.Stage,
+		&i.PolicyID,
+		&i.TargetMinutes,
+		&i.EscalateAfterMinutes,
+		&i.ElapsedMinutes,
+		&i.StartedAt,
+		&i.ResumedAt,
+		&i.PausedAt,
+		&i.StoppedAt,
+		&i.Deadline,
+		&i.EscalateAt,
+		&i.BreachedAt,
+		&i.EscalatedAt,
+		&i.EscalatedTo,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const markSLAClockEscalated = `-- name: MarkSLAClockEscalated :one
+UPDATE sla_clocks
+SET escalated_at = $2, escalated_to = $3, updated_at = NOW()
+WHERE id = $1 AND escalated_at IS NULL
+RETURNING id, tenant_id, entity_type, entity_id, stage, policy_id, target_minutes, escalate_after_minutes, elapsed_minutes, started_at, resumed_at, paused_at, stopped_at, deadline, escalate_at, breached_at, escalated_at, escalated_to, updated_at
+`
+
+type MarkSLAClockEscalatedParams struct {
+	ID          uuid.UUID          `json:"id"`
+	EscalatedAt pgtype.Timestamptz `json:"escalated_at"`
+	EscalatedTo *string            `json:"escalated_to"`
+}
+
+func (q *Queries) MarkSLAClockEscalated(ctx context.Context, arg MarkSLAClockEscalatedParams) (SlaClock, error) {
+	row := q.db.QueryRow(ctx, markSLAClockEscalated, arg.ID, arg.EscalatedAt, arg.EscalatedTo)
+	var i SlaClock
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.EntityType,
+		&i.EntityID,
+		&i.Stage,
+		&i.PolicyID,
+		&i.TargetMinutes,
+		&i.EscalateAfterMinutes,
+		&i.ElapsedMinutes,
+		&i.StartedAt,
+		&i.ResumedAt,
+		&i.PausedAt,
+		&i.StoppedAt,
+		&i.Deadline,
+		&i.EscalateAt,
+		&i.BreachedAt,
+		&i.EscalatedAt,
+		&i.EscalatedTo,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const matchSLAPolicy = `-- name: MatchSLAPolicy :one
+SELECT id, tenant_id, stage, priority, classification_code, target_minutes, escalate_after_minutes, created_at, updated_at FROM sla_policies
+WHERE tenant_id = $1
+    AND stage = $2
+    AND (priority IS NULL OR priority = $3)
+    AND (classification_code IS NULL OR classification_code = $4)
+ORDER BY (classification_code IS NOT NULL) DESC, (priority IS NOT NULL) DESC
+LIMIT 1
+`
+
+type MatchSLAPolicyParams struct {
+	TenantID           string  `json:"tenant_id"`
+	Stage              string  `json:"stage"`
+	Priority           *string `json:"priority"`
+	ClassificationCode *string `json:"classification_code"`
+}
+
+// The most specific policy for a stage: classification outranks priority,
+// and either outranks a catch-all
+func (q *Queries) MatchSLAPolicy(ctx context.Context, arg MatchSLAPolicyParams) (SlaPolicy, error) {
+	row := q.db.QueryRow(ctx, matchSLAPolicy,
+		arg.TenantID,
+		arg.Stage,
+		arg.Priority,
+		arg.ClassificationCode,
+	)
+	var i SlaPolicy
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Stage,
+		&i.Priority,
+		&i.ClassificationCode,
+		&i.TargetMinutes,
+		&i.EscalateAfterMinutes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const refreshDartaSLAState = `-- name: RefreshDartaSLAState :exec
+UPDATE dartas d
+SET
+    sla_deadline = (
+        SELECT MIN(c.deadline) FROM sla_clocks c
+        WHERE c.entity_type = 'DARTA' AND c.entity_id = d.id AND c.stopped_at IS NULL
+    ),
+    is_overdue = EXISTS (
+        SELECT 1 FROM sla_clocks c
+        WHERE c.entity_type = 'DARTA' AND c.entity_id = d.id
+            AND c.stopped_at IS NULL AND c.breached_at IS NOT NULL
+    ),
+    sla_synced_at = COALESCE($2, d.sla_synced_at)
+WHERE d.id = $1
+`
+
+type RefreshDartaSLAStateParams struct {
+	ID       uuid.UUID          `json:"id"`
+	SyncedAt pgtype.Timestamptz `json:"synced_at"`
+}
+
+// Derives sla_deadline and is_overdue from the darta's open clocks. Leaves
+// updated_at alone so the refresh does not look like a change.
+func (q *Queries) RefreshDartaSLAState(ctx context.Context, arg RefreshDartaSLAStateParams) error {
+	_, err := q.db.Exec(ctx, refreshDartaSLAState, arg.ID, arg.SyncedAt)
+	return err
+}
+
+const updateSLAClock = `-- name: UpdateSLAClock :one
+UPDATE sla_clocks
+SET
+    policy_id = $2,
+    target_minutes = $3,
+    escalate_after_minutes = $4,
+    elapsed_minutes = $5,
+    resumed_at = $6,
+    paused_at = $7,
+    stopped_at = $8,
+    deadline = $9,
+    escalate_at = $10,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, tenant_id, entity_type, entity_id, stage, policy_id, target_minutes, escalate_after_minutes, elapsed_minutes, started_at, resumed_at, paused_at, stopped_at, deadline, escalate_at, breached_at, escalated_at, escalated_to, updated_at
+`
+
+type UpdateSLAClockParams struct {
+	ID                   uuid.UUID          `json:"id"`
+	PolicyID             pgtype.UUID        `json:"policy_id"`
+	TargetMinutes        int32              `json:"target_minutes"`
+	EscalateAfterMinutes int32              `json:"escalate_after_minutes"`
+	ElapsedMinutes       int32              `json:"elapsed_minutes"`
+	ResumedAt            pgtype.Timestamptz `json:"resumed_at"`
+	PausedAt             pgtype.Timestamptz `json:"paused_at"`
+	StoppedAt            pgtype.Timestamptz `json:"stopped_at"`
+	Deadline             pgtype.Timestamptz `json:"deadline"`
+	EscalateAt           pgtype.Timestamptz `json:"escalate_at"`
+}
+
+func (q *Queries) UpdateSLAClock(ctx context.Context, arg UpdateSLAClockParams) (SlaClock, error) {
+	row := q.db.QueryRow(ctx, updateSLAClock,
+		arg.ID,
+		arg.PolicyID,
+		arg.TargetMinutes,
+		arg.EscalateAfterMinutes,
+		arg.ElapsedMinutes,
+		arg.ResumedAt,
+		arg.PausedAt,
+		arg.StoppedAt,
+		arg.Deadline,
+		arg.EscalateAt,
+	)
+	var i SlaClock
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.EntityType,
+		&i.EntityID,
+		&i.Stage,
+		&i.PolicyID,
+		&i.TargetMinutes,
+		&i.EscalateAfterMinutes,
+		&i.ElapsedMinutes,
+		&i.StartedAt,
+		&i.ResumedAt,
+		&i.PausedAt,
+		&i.StoppedAt,
+		&i.Deadline,
+		&i.EscalateAt,
+		&i.BreachedAt,
+		&i.EscalatedAt,
+		&i.EscalatedTo,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertBusinessCalendar = `-- name: UpsertBusinessCalendar :one
+INSERT INTO business_calendars (tenant_id, weekly_off, office_start, office_end)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (tenant_id) DO UPDATE SET
+    weekly_off = EXCLUDED.weekly_off,
+    office_start = EXCLUDED.office_start,
+    office_end = EXCLUDED.office_end,
+    updated_at = NOW()
+RETURNING tenant_id, weekly_off, office_start, office_end, updated_at
+`
+
+type UpsertBusinessCalendarParams struct {
+	TenantID    string      `json:"tenant_id"`
+	WeeklyOff   []int16     `json:"weekly_off"`
+	OfficeStart pgtype.Time `json:"office_start"`
+	OfficeEnd   pgtype.Time `json:"office_end"`
+}
+
+func (q *Queries) UpsertBusinessCalendar(ctx context.Context, arg UpsertBusinessCalendarParams) (BusinessCalendar, error) {
+	row := q.db.QueryRow(ctx, upsertBusinessCalendar,
+		arg.TenantID,
+		arg.WeeklyOff,
+		arg.OfficeStart,
+		arg.OfficeEnd,
+	)
+	var i BusinessCalendar
+	err := row.Scan(
+		&i.TenantID,
+		&i.WeeklyOff,
+		&i.OfficeStart,
+		&i.OfficeEnd,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertCalendarHoliday = `-- name: UpsertCalendarHoliday :one
+INSERT INTO calendar_holidays (tenant_id, holiday_date, name)
+VALUES ($1, $2, $3)
+ON CONFLICT (tenant_id, holiday_date) DO UPDATE SET name = EXCLUDED.name
+RETURNING tenant_id, holiday_date, name, created_at
+`
+
+type UpsertCalendarHolidayParams struct {
+	TenantID    string      `json:"tenant_id"`
+	HolidayDate pgtype.Date `json:"holiday_date"`
+	Name        string      `json:"name"`
+}
+
+func (q *Queries) UpsertCalendarHoliday(ctx context.Context, arg UpsertCalendarHolidayParams) (CalendarHoliday, error) {
+	row := q.db.QueryRow(ctx, upsertCalendarHoliday, arg.TenantID, arg.HolidayDate, arg.Name)
+	var i CalendarHoliday
+	err := row.Scan(
+		&i.TenantID,
+		&i.HolidayDate,
+		&i.Name,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const upsertSLAPolicy = `-- name: UpsertSLAPolicy :one
+INSERT INTO sla_policies (tenant_id, stage, priority, classification_code, target_minutes, escalate_after_minutes)
+VALUES ($1, $2, $5, $6, $3, $4)
+ON CONFLICT (tenant_id, stage, (COALESCE(priority, '')), (COALESCE(classification_code, ''))) DO UPDATE SET
+    target_minutes = EXCLUDED.target_minutes,
+    escalate_after_minutes = EXCLUDED.escalate_after_minutes,
+    updated_at = NOW()
+RETURNING id, tenant_id, stage, priority, classification_code, target_minutes, escalate_after_minutes, created_at, updated_at
+`
+
+type UpsertSLAPolicyParams struct {
+	TenantID             string  `json:"tenant_id"`
+	Stage                string  `json:"stage"`
+	TargetMinutes        int32   `json:"target_minutes"`
+	EscalateAfterMinutes int32   `json:"escalate_after_minutes"`
+	Priority             *string `json:"priority"`
+	ClassificationCode   *string `json:"classification_code"`
+}
+
+func (q *Queries) UpsertSLAPolicy(ctx context.Context, arg UpsertSLAPolicyParams) (SlaPolicy, error) {
+	row := q.db.QueryRow(ctx, upsertSLAPolicy,
+		arg.TenantID,
+		arg.Stage,
+		arg.TargetMinutes,
+		arg.EscalateAfterMinutes,
+		arg.Priority,
+		arg.ClassificationCode,
+	)
+	var i SlaPolicy
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Stage,
+		&i.Priority,
+		&i.ClassificationCode,
+		&i.TargetMinutes,
+		&i.EscalateAfterMinutes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertUnitHead = `-- name: UpsertUnitHead :one
+INSERT INTO unit_heads (tenant_id, unit_id, head_user_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (tenant_id, unit_id) DO UPDATE SET
+    head_user_id = EXCLUDED.head_user_id,
+    updated_at = NOW()
+RETURNING tenant_id, unit_id, head_user_id, updated_at
+`
+
+type UpsertUnitHeadParams struct {
+	TenantID   string `json:"tenant_id"`
+	UnitID     string `json:"unit_id"`
+	HeadUserID string `json:"head_user_id"`
+}
+
+func (q *Queries) UpsertUnitHead(ctx context.Context, arg UpsertUnitHeadParams) (UnitHead, error) {
+	row := q.db.QueryRow(ctx, upsertUnitHead, arg.TenantID, arg.UnitID, arg.HeadUserID)
+	var i UnitHead
+	err := row.Scan(
+		&i.TenantID,
+		&i.UnitID,
+		&i.HeadUserID,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,289 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: stats.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getDartaStatsSummary = `-- name: GetDartaStatsSummary :many
+
+WITH lifecycle AS (
+    SELECT
+        d.ward_id,
+        d.assigned_to_unit_id,
+        d.intake_channel,
+        d.priority,
+        d.is_overdue,
+        d.received_date,
+        (SELECT MIN(a.performed_at) FROM audit_trail a
+         WHERE a.entity_type = 'DARTA' AND a.entity_id = d.id AND a.action = 'STATUS_CHANGED'
+           AND a.changes->'status'->>'to' = 'REGISTERED') AS registered_at,
+        (SELECT MIN(a.performed_at) FROM audit_trail a
+         WHERE a.entity_type = 'DARTA' AND a.entity_id = d.id AND a.action = 'STATUS_CHANGED'
+           AND a.changes->'status'->>'to' = 'CLOSED') AS closed_at
+    FROM dartas d
+    WHERE d.tenant_id = $1
+      AND ($2::VARCHAR IS NULL OR d.fiscal_year_id = $2)
+      AND ($3::VARCHAR IS NULL OR d.scope = $3)
+      AND ($4::VARCHAR IS NULL OR d.ward_id = $4)
+      AND ($5::TIMESTAMPTZ IS NULL OR d.received_date >= $5)
+      AND ($6::TIMESTAMPTZ IS NULL OR d.received_date < $6)
+)
+SELECT
+    (CASE
+        WHEN GROUPING(ward_id) = 0 THEN 'WARD'
+        WHEN GROUPING(assigned_to_unit_id) = 0 THEN 'SECTION'
+        WHEN GROUPING(intake_channel) = 0 THEN 'CHANNEL'
+        WHEN GROUPING(priority) = 0 THEN 'PRIORITY'
+        ELSE ''
+    END)::TEXT AS dimension,
+    COALESCE(ward_id, assigned_to_unit_id, intake_channel, priority, '')::TEXT AS key,
+    COUNT(*)::INT AS total,
+    (COUNT(*) FILTER (WHERE is_overdue))::INT AS overdue_count,
+    COUNT(registered_at)::INT AS registered_count,
+    COALESCE(AVG(EXTRACT(EPOCH FROM registered_at - received_date)) / 3600, 0)::FLOAT8 AS registration_avg_hours,
+    COALESCE(percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM registered_at - received_date)) / 3600, 0)::FLOAT8 AS registration_p90_hours,
+    COUNT(closed_at - registered_at)::INT AS closure_count,
+    COALESCE(AVG(EXTRACT(EPOCH FROM closed_at - registered_at)) / 3600, 0)::FLOAT8 AS closure_avg_hours,
+    COALESCE(percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM closed_at - registered_at)) / 3600, 0)::FLOAT8 AS closure_p90_hours,
+    COALESCE(AVG(EXTRACT(EPOCH FROM closed_at - received_date)) / 3600, 0)::FLOAT8 AS processing_avg_hours
+FROM lifecycle
+GROUP BY GROUPING SETS ((), (ward_id), (assigned_to_unit_id), (intake_channel), (priority))
+`
+
+type GetDartaStatsSummaryParams struct {
+	TenantID     string             `json:"tenant_id"`
+	FiscalYearID *string            `json:"fiscal_year_id"`
+	Scope        *string            `json:"scope"`
+	WardID       *string            `json:"ward_id"`
+	ReceivedFrom pgtype.Timestamptz `json:"received_from"`
+	ReceivedTo   pgtype.Timestamptz `json:"received_to"`
+}
+
+type GetDartaStatsSummaryRow struct {
+	Dimension            string  `json:"dimension"`
+	Key                  string  `json:"key"`
+	Total                int32   `json:"total"`
+	OverdueCount         int32   `json:"overdue_count"`
+	RegisteredCount      int32   `json:"registered_count"`
+	RegistrationAvgHours float64 `json:"registration_avg_hours"`
+	RegistrationP90Hours float64 `json:"registration_p90_hours"`
+	ClosureCount         int32   `json:"closure_count"`
+	ClosureAvgHours      float64 `json:"closure_avg_hours"`
+	ClosureP90Hours      float64 `json:"closure_p90_hours"`
+	ProcessingAvgHours   float64 `json:"processing_avg_hours"`
+}
+
+// ============================================================================
+// DARTA STATISTICS
+// ============================================================================
+//
+// Times in state come from the audit trail: a darta is registered and closed
+// when a STATUS_CHANGED entry moves it there, and it is with a section from
+// an ASSIGNED entry until the next assignment, response or close.
+// One row for all matching dartas, then one per ward, section, channel and
+// priority. Durations are elapsed hours.
+func (q *Queries) GetDartaStatsSummary(ctx context.Context, arg GetDartaStatsSummaryParams) ([]GetDartaStatsSummaryRow, error) {
+	rows, err := q.db.Query(ctx, getDartaStatsSummary,
+		arg.TenantID,
+		arg.FiscalYearID,
+		arg.Scope,
+		arg.WardID,
+		arg.ReceivedFrom,
+		arg.ReceivedTo,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDartaStatsSummaryRow
+	for rows.Next() {
+		var i GetDartaStatsSummaryRow
+		if err := rows.Scan(
+			&i.Dimension,
+			&i.Key,
+			&i.Total,
+			&i.OverdueCount,
+			&i.RegisteredCount,
+			&i.RegistrationAvgHours,
+			&i.RegistrationP90Hours,
+			&i.ClosureCount,
+			&i.ClosureAvgHours,
+			&i.ClosureP90Hours,
+			&i.ProcessingAvgHours,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSectionTurnaround = `-- name: GetSectionTurnaround :many
+WITH scoped AS (
+    SELECT d.id FROM dartas d
+    WHERE d.tenant_id = $1
+      AND ($2::VARCHAR IS NULL OR d.fiscal_year_id = $2)
+      AND ($3::VARCHAR IS NULL OR d.scope = $3)
+      AND ($4::VARCHAR IS NULL OR d.ward_id = $4)
+      AND ($5::TIMESTAMPTZ IS NULL OR d.received_date >= $5)
+      AND ($6::TIMESTAMPTZ IS NULL OR d.received_date < $6)
+), spans AS (
+    SELECT
+        CASE WHEN a.action = 'ASSIGNED' THEN a.changes->>'assigned_to_unit' END AS section_id,
+        a.performed_at AS started_at,
+        LEAD(a.performed_at) OVER (PARTITION BY a.entity_id ORDER BY a.performed_at) AS ended_at
+    FROM audit_trail a
+    JOIN scoped s ON s.id = a.entity_id
+    WHERE a.entity_type = 'DARTA'
+      AND a.category = 'ACTIVITY'
+      AND (a.action = 'ASSIGNED'
+           OR (a.action = 'STATUS_CHANGED' AND a.changes->'status'->>'to' IN ('RESPONSE_ISSUED', 'CLOSED', 'VOIDED')))
+)
+SELECT
+    section_id::TEXT AS section_id,
+    COUNT(*)::INT AS span_count,
+    AVG(EXTRACT(EPOCH FROM ended_at - started_at) / 3600)::FLOAT8 AS avg_hours,
+    (percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM ended_at - started_at)) / 3600)::FLOAT8 AS p90_hours
+FROM spans
+WHERE section_id IS NOT NULL AND ended_at IS NOT NULL
+GROUP BY section_id
+ORDER BY section_id
+`
+
+type GetSectionTurnaroundParams struct {
+	TenantID     string             `json:"tenant_id"`
+	FiscalYearID *string            `json:"fiscal_year_id"`
+	Scope        *string            `json:"scope"`
+	WardID       *string            `json:"ward_id"`
+	ReceivedFrom pgtype.Timestamptz `json:"received_from"`
+	ReceivedTo   pgtype.Timestamptz `json:"received_to"`
+}
+
+type GetSectionTurnaroundRow struct {
+	SectionID string  `json:"section_id"`
+	SpanCount int32   `json:"span_count"`
+	AvgHours  float64 `json:"avg_hours"`
+	P90Hours  float64 `json:"p90_hours"`
+}
+
+// How long dartas stay with each section they are assigned to. Spans still
+// open are left out.
+func (q *Queries) GetSectionTurnaround(ctx context.Context, arg GetSectionTurnaroundParams) ([]GetSectionTurnaroundRow, error) {
+	rows, err := q.db.Query(ctx, getSectionTurnaround,
+		arg.TenantID,
+		arg.FiscalYearID,
+		arg.Scope,
+		arg.WardID,
+		arg.ReceivedFrom,
+		arg.ReceivedTo,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSectionTurnaroundRow
+	for rows.Next() {
+		var i GetSectionTurnaroundRow
+		if err := rows.Scan(
+			&i.SectionID,
+			&i.SpanCount,
+			&i.AvgHours,
+			&i.P90Hours,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDartaDailyActivity = `-- name: ListDartaDailyActivity :many
+WITH scoped AS (
+    SELECT d.id, d.received_date FROM dartas d
+    WHERE d.tenant_id = $1
+      AND ($2::VARCHAR IS NULL OR d.fiscal_year_id = $2)
+      AND ($3::VARCHAR IS NULL OR d.scope = $3)
+      AND ($4::VARCHAR IS NULL OR d.ward_id = $4)
+), events AS (
+    SELECT s.received_date AS event_at, 'RECEIVED'::TEXT AS kind FROM scoped s
+    WHERE s.received_date >= $5::TIMESTAMPTZ AND s.received_date < $6::TIMESTAMPTZ
+    UNION ALL
+    SELECT a.performed_at, a.changes->'status'->>'to'
+    FROM audit_trail a
+    JOIN scoped s ON s.id = a.entity_id
+    WHERE a.entity_type = 'DARTA'
+      AND a.action = 'STATUS_CHANGED'
+      AND a.changes->'status'->>'to' IN ('REGISTERED', 'CLOSED')
+      AND a.performed_at >= $5::TIMESTAMPTZ AND a.performed_at < $6::TIMESTAMPTZ
+)
+SELECT
+    (event_at AT TIME ZONE 'Asia/Kathmandu')::DATE AS day,
+    (COUNT(*) FILTER (WHERE kind = 'RECEIVED'))::INT AS received,
+    (COUNT(*) FILTER (WHERE kind = 'REGISTERED'))::INT AS registered,
+    (COUNT(*) FILTER (WHERE kind = 'CLOSED'))::INT AS closed
+FROM events
+GROUP BY 1
+ORDER BY 1
+`
+
+type ListDartaDailyActivityParams struct {
+	TenantID     string             `json:"tenant_id"`
+	FiscalYearID *string            `json:"fiscal_year_id"`
+	Scope        *string            `json:"scope"`
+	WardID       *string            `json:"ward_id"`
+	FromTime     pgtype.Timestamptz `json:"from_time"`
+	ToTime       pgtype.Timestamptz `json:"to_time"`
+}
+
+type ListDartaDailyActivityRow struct {
+	Day        pgtype.Date `json:"day"`
+	Received   int32       `json:"received"`
+	Registered int32       `json:"registered"`
+	Closed     int32       `json:"closed"`
+}
+
+// Dartas received, registered and closed per Nepal day in [from, to)
+func (q *Queries) ListDartaDailyActivity(ctx context.Context, arg ListDartaDailyActivityParams) ([]ListDartaDailyActivityRow, error) {
+	rows, err := q.db.Query(ctx, listDartaDailyActivity,
+		arg.TenantID,
+		arg.FiscalYearID,
+		arg.Scope,
+		arg.WardID,
+		arg.FromTime,
+		arg.ToTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDartaDailyActivityRow
+	for rows.Next() {
+		var i ListDartaDailyActivityRow
+		if err := rows.Scan(
+			&i.Day,
+			&i.Received,
+			&i.Registered,
+			&i.Closed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
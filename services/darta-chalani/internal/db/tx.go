@@ -0,0 +1,43 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// beginner is a connection that can start a transaction. Pools begin a
+// transaction; a transaction begins a savepoint within itself.
+type beginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// InTx runs fn with queries bound to one transaction, committing it when fn
+// succeeds and rolling it back otherwise. Inside a transaction it uses a
+// savepoint, so a failed fn leaves the outer transaction usable. Queriers
+// not backed by a database, such as test fakes, run fn as they are.
+func InTx(ctx context.Context, queries Querier, fn func(Querier) error) error {
+	q, ok := queries.(*Queries)
+	if !ok {
+		return fn(queries)
+	}
+	conn, ok := q.db.(beginner)
+	if !ok {
+		return fn(queries)
+	}
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(q.WithTx(tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+-- +goose Up
+-- ============================================================================
+-- AUDIT CATEGORIES - Separate routine activity from control violations
+-- ============================================================================
+ALTER TABLE audit_trail
+    ADD COLUMN category VARCHAR(50) NOT NULL DEFAULT 'ACTIVITY',
+    ADD CONSTRAINT audit_trail_category_check
+        CHECK (category IN ('ACTIVITY', 'SOD_VIOLATION'));
+
+CREATE INDEX idx_audit_trail_category ON audit_trail(tenant_id, category, performed_at DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_audit_trail_category;
+ALTER TABLE audit_trail
+    DROP CONSTRAINT IF EXISTS audit_trail_category_check,
+    DROP COLUMN IF EXISTS category;
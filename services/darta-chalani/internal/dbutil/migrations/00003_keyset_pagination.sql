@@ -0,0 +1,18 @@
+-- +goose Up
+-- ============================================================================
+-- KEYSET PAGINATION - One index per list sort order, ending in id so that the
+-- (sort key, id) cursor comparison is an index range
+-- ============================================================================
+CREATE INDEX idx_dartas_tenant_created ON dartas(tenant_id, created_at, id);
+CREATE INDEX idx_dartas_tenant_received ON dartas(tenant_id, received_date, id);
+CREATE INDEX idx_dartas_tenant_number ON dartas(tenant_id, (COALESCE(darta_number, 0)), id);
+
+CREATE INDEX idx_chalanis_tenant_created ON chalanis(tenant_id, created_at, id);
+CREATE INDEX idx_chalanis_tenant_number ON chalanis(tenant_id, (COALESCE(chalani_number, 0)), id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_chalanis_tenant_number;
+DROP INDEX IF EXISTS idx_chalanis_tenant_created;
+DROP INDEX IF EXISTS idx_dartas_tenant_number;
+DROP INDEX IF EXISTS idx_dartas_tenant_received;
+DROP INDEX IF EXISTS idx_dartas_tenant_created;
@@ -0,0 +1,57 @@
+-- +goose Up
+-- ============================================================================
+-- SEARCH DOCUMENTS - One searchable document per darta and chalani
+-- Maintained by the service's search indexer, which normalizes Devanagari and
+-- romanizes it, so the *_terms columns hold ASCII phonetic keys. A document is
+-- stale when its source row changed after source_updated_at.
+-- ============================================================================
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE TABLE search_documents (
+    entity_type VARCHAR(20) NOT NULL,
+    entity_id UUID NOT NULL,
+    tenant_id VARCHAR(100) NOT NULL,
+
+    -- Display text, as written, for results and snippets
+    title TEXT NOT NULL,
+    number VARCHAR(50),
+    party TEXT NOT NULL,
+    content TEXT NOT NULL,
+
+    -- Facets
+    status VARCHAR(50) NOT NULL,
+    fiscal_year_id VARCHAR(100) NOT NULL,
+    ward_id VARCHAR(100),
+    channel VARCHAR(50),
+
+    -- Search terms: numbers and codes rank above subject and names, which
+    -- rank above body and OCR text
+    number_terms TEXT NOT NULL,
+    title_terms TEXT NOT NULL,
+    body_terms TEXT NOT NULL,
+    fold_key TEXT NOT NULL,
+    document TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', number_terms), 'A') ||
+        setweight(to_tsvector('simple', title_terms), 'B') ||
+        setweight(to_tsvector('simple', body_terms), 'C')
+    ) STORED,
+
+    source_updated_at TIMESTAMPTZ NOT NULL,
+    indexed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (entity_type, entity_id),
+    CHECK (entity_type IN ('DARTA', 'CHALANI'))
+);
+
+CREATE INDEX idx_search_documents_document ON search_documents USING gin(document);
+CREATE INDEX idx_search_documents_fold_key ON search_documents USING gin(fold_key gin_trgm_ops);
+CREATE INDEX idx_search_documents_tenant ON search_documents(tenant_id, entity_type);
+
+-- Superseded by search_documents; subject search never used them
+DROP INDEX IF EXISTS idx_dartas_subject_search;
+DROP INDEX IF EXISTS idx_chalanis_subject_search;
+
+-- +goose Down
+CREATE INDEX idx_dartas_subject_search ON dartas USING gin(to_tsvector('simple', subject));
+CREATE INDEX idx_chalanis_subject_search ON chalanis USING gin(to_tsvector('simple', subject));
+DROP TABLE IF EXISTS search_documents;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- ============================================================================
+-- DUPLICATE DETECTION - Lookups for the signals a suspected duplicate darta is
+-- found by: applicant identifiers, primary document checksum (already indexed)
+-- and subject similarity
+-- ============================================================================
+CREATE INDEX idx_applicants_identification ON applicants(identification_number)
+    WHERE identification_number IS NOT NULL;
+CREATE INDEX idx_applicants_phone_digits ON applicants((right(regexp_replace(phone, '[^0-9]', '', 'g'), 10)))
+    WHERE phone IS NOT NULL;
+CREATE INDEX idx_applicants_email_lower ON applicants((lower(email)))
+    WHERE email IS NOT NULL;
+CREATE INDEX idx_dartas_subject_trgm ON dartas USING gin(subject gin_trgm_ops);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_dartas_subject_trgm;
+DROP INDEX IF EXISTS idx_applicants_email_lower;
+DROP INDEX IF EXISTS idx_applicants_phone_digits;
+DROP INDEX IF EXISTS idx_applicants_identification;
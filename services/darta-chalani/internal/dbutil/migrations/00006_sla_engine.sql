@@ -0,0 +1,131 @@
+-- +goose Up
+-- ============================================================================
+-- SLA ENGINE - Business calendars, SLA policies and the clocks that track
+-- each darta and chalani against them
+-- ============================================================================
+
+-- Working week and office hours per tenant, in Nepal time. Tenants without a
+-- row use the defaults: Saturday off, 10:00 to 17:00.
+CREATE TABLE business_calendars (
+    tenant_id VARCHAR(100) PRIMARY KEY,
+    weekly_off SMALLINT[] NOT NULL DEFAULT '{6}', -- 0 = Sunday ... 6 = Saturday
+    office_start TIME NOT NULL DEFAULT '10:00',
+    office_end TIME NOT NULL DEFAULT '17:00',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (office_start < office_end)
+);
+
+CREATE TABLE calendar_holidays (
+    tenant_id VARCHAR(100) NOT NULL,
+    holiday_date DATE NOT NULL,
+    name TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, holiday_date)
+);
+
+-- Targets in business minutes per stage. NULL priority or classification
+-- matches any; the most specific policy wins.
+CREATE TABLE sla_policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(100) NOT NULL,
+    stage VARCHAR(30) NOT NULL,
+    priority VARCHAR(20),
+    classification_code VARCHAR(100),
+    target_minutes INT NOT NULL,
+    escalate_after_minutes INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (stage IN ('REVIEW', 'CLARIFICATION', 'RESPONSE', 'CHALANI_APPROVAL')),
+    CHECK (priority IS NULL OR priority IN ('LOW', 'MEDIUM', 'HIGH', 'URGENT')),
+    CHECK (target_minutes > 0),
+    CHECK (escalate_after_minutes >= 0)
+);
+
+CREATE UNIQUE INDEX idx_sla_policies_match ON sla_policies(
+    tenant_id, stage, (COALESCE(priority, '')), (COALESCE(classification_code, ''))
+);
+
+-- One clock per record and stage while it is open. Elapsed business minutes
+-- are banked when a clock pauses; deadline and escalate_at are set only
+-- while it runs.
+CREATE TABLE sla_clocks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(100) NOT NULL,
+    entity_type VARCHAR(20) NOT NULL,
+    entity_id UUID NOT NULL,
+    stage VARCHAR(30) NOT NULL,
+    policy_id UUID REFERENCES sla_policies(id) ON DELETE SET NULL,
+    target_minutes INT NOT NULL,
+    escalate_after_minutes INT NOT NULL DEFAULT 0,
+    elapsed_minutes INT NOT NULL DEFAULT 0,
+    started_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resumed_at TIMESTAMPTZ,
+    paused_at TIMESTAMPTZ,
+    stopped_at TIMESTAMPTZ,
+    deadline TIMESTAMPTZ,
+    escalate_at TIMESTAMPTZ,
+    breached_at TIMESTAMPTZ,
+    escalated_at TIMESTAMPTZ,
+    escalated_to VARCHAR(100),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (entity_type IN ('DARTA', 'CHALANI')),
+    CHECK (stage IN ('REVIEW', 'CLARIFICATION', 'RESPONSE', 'CHALANI_APPROVAL'))
+);
+
+CREATE UNIQUE INDEX idx_sla_clocks_open ON sla_clocks(entity_type, entity_id, stage)
+    WHERE stopped_at IS NULL;
+CREATE INDEX idx_sla_clocks_entity ON sla_clocks(entity_type, entity_id, started_at);
+CREATE INDEX idx_sla_clocks_due ON sla_clocks(deadline)
+    WHERE stopped_at IS NULL AND breached_at IS NULL AND deadline IS NOT NULL;
+CREATE INDEX idx_sla_clocks_escalate ON sla_clocks(escalate_at)
+    WHERE stopped_at IS NULL AND escalated_at IS NULL AND escalate_at IS NOT NULL;
+
+-- Who breaches are escalated to. unit_id '*' is the tenant's fallback for
+-- records without a unit, such as chalanis.
+CREATE TABLE unit_heads (
+    tenant_id VARCHAR(100) NOT NULL,
+    unit_id VARCHAR(100) NOT NULL,
+    head_user_id VARCHAR(100) NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, unit_id)
+);
+
+-- is_overdue was generated from NOW() at write time, so it never changed
+-- once stored. The SLA evaluator maintains it now.
+DROP INDEX IF EXISTS idx_dartas_overdue;
+ALTER TABLE dartas DROP COLUMN is_overdue;
+ALTER TABLE dartas ADD COLUMN is_overdue BOOLEAN NOT NULL DEFAULT false;
+CREATE INDEX idx_dartas_overdue ON dartas(tenant_id) WHERE is_overdue;
+
+-- Explicit response target set when routing, in business minutes
+ALTER TABLE dartas ADD COLUMN sla_target_minutes INT;
+
+-- The updated_at the SLA engine last reconciled clocks against. A record is
+-- due for reconciliation when it has changed since.
+ALTER TABLE dartas ADD COLUMN sla_synced_at TIMESTAMPTZ;
+ALTER TABLE chalanis ADD COLUMN sla_synced_at TIMESTAMPTZ;
+CREATE INDEX idx_dartas_sla_unsynced ON dartas(updated_at)
+    WHERE sla_synced_at IS DISTINCT FROM updated_at;
+CREATE INDEX idx_chalanis_sla_unsynced ON chalanis(updated_at)
+    WHERE sla_synced_at IS DISTINCT FROM updated_at;
+
+-- +goose Down
+ALTER TABLE chalanis DROP COLUMN sla_synced_at;
+ALTER TABLE dartas DROP COLUMN sla_synced_at;
+ALTER TABLE dartas DROP COLUMN sla_target_minutes;
+
+DROP INDEX IF EXISTS idx_dartas_overdue;
+ALTER TABLE dartas DROP COLUMN is_overdue;
+ALTER TABLE dartas ADD COLUMN is_overdue BOOLEAN GENERATED ALWAYS AS (
+    CASE
+        WHEN sla_deadline IS NOT NULL AND sla_deadline < NOW()
+             AND status NOT IN ('CLOSED', 'VOIDED', 'SUPERSEDED')
+        THEN true ELSE false
+    END
+) STORED;
+
+DROP TABLE IF EXISTS unit_heads;
+DROP TABLE IF EXISTS sla_clocks;
+DROP TABLE IF EXISTS sla_policies;
+DROP TABLE IF EXISTS calendar_holidays;
+DROP TABLE IF EXISTS business_calendars;
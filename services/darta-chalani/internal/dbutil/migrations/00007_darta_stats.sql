@@ -0,0 +1,11 @@
+-- +goose Up
+-- ============================================================================
+-- DARTA STATISTICS - Registration and closing times are read from status
+-- changes in the audit trail, looked up by the status moved to
+-- ============================================================================
+
+CREATE INDEX idx_audit_trail_status_to ON audit_trail (entity_id, ((changes->'status'->>'to')), performed_at)
+    WHERE entity_type = 'DARTA' AND action = 'STATUS_CHANGED';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_audit_trail_status_to;
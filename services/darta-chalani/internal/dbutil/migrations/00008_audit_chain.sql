@@ -0,0 +1,97 @@
+-- +goose Up
+-- ============================================================================
+-- AUDIT CHAIN - Each tenant's audit entries form a SHA-256 hash chain. An
+-- entry's hash covers its canonical JSON form and the previous entry's hash,
+-- so editing, removing or reordering entries breaks the chain. The tables
+-- are append-only.
+-- ============================================================================
+
+-- Entries written before the chain existed keep NULL chain columns
+ALTER TABLE audit_trail
+    ADD COLUMN chain_seq BIGINT,
+    ADD COLUMN prev_hash VARCHAR(64),
+    ADD COLUMN entry_hash VARCHAR(64);
+
+-- Concurrent writers claiming the same position conflict here and retry
+CREATE UNIQUE INDEX idx_audit_trail_chain ON audit_trail(tenant_id, chain_seq);
+
+-- Settings changes are audited alongside records
+ALTER TABLE audit_trail DROP CONSTRAINT audit_trail_entity_type_check;
+ALTER TABLE audit_trail ADD CONSTRAINT audit_trail_entity_type_check
+    CHECK (entity_type IN ('DARTA', 'CHALANI', 'ATTACHMENT', 'APPLICANT', 'RECIPIENT',
+                           'SLA_POLICY', 'BUSINESS_CALENDAR', 'UNIT_HEAD'));
+
+-- Chain heads recorded periodically, for notarization outside the database.
+-- An anchor the chain no longer matches shows it was rewritten.
+CREATE TABLE audit_anchors (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(100) NOT NULL,
+    chain_seq BIGINT NOT NULL,
+    entry_hash VARCHAR(64) NOT NULL,
+    anchored_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, chain_seq)
+);
+
+CREATE INDEX idx_audit_anchors_anchored_at ON audit_anchors(tenant_id, anchored_at);
+
+-- New entries must extend their tenant's chain from its current head
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION audit_trail_check_chain()
+RETURNS TRIGGER AS $$
+DECLARE
+    previous VARCHAR(64);
+BEGIN
+    IF NEW.chain_seq IS NULL OR NEW.entry_hash IS NULL THEN
+        RAISE EXCEPTION 'audit entries must be hash chained';
+    END IF;
+    IF NEW.chain_seq = 1 THEN
+        IF NEW.prev_hash IS NOT NULL THEN
+            RAISE EXCEPTION 'the first audit entry of tenant % has no predecessor', NEW.tenant_id;
+        END IF;
+        RETURN NEW;
+    END IF;
+    SELECT entry_hash INTO previous FROM audit_trail
+    WHERE tenant_id = NEW.tenant_id AND chain_seq = NEW.chain_seq - 1;
+    IF previous IS NULL OR previous IS DISTINCT FROM NEW.prev_hash THEN
+        RAISE EXCEPTION 'audit entry % of tenant % does not extend the chain', NEW.chain_seq, NEW.tenant_id;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION audit_append_only()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION '% is append-only', TG_TABLE_NAME;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER audit_trail_chain BEFORE INSERT ON audit_trail
+    FOR EACH ROW EXECUTE FUNCTION audit_trail_check_chain();
+
+CREATE TRIGGER audit_trail_append_only BEFORE UPDATE OR DELETE ON audit_trail
+    FOR EACH ROW EXECUTE FUNCTION audit_append_only();
+
+CREATE TRIGGER audit_anchors_append_only BEFORE UPDATE OR DELETE ON audit_anchors
+    FOR EACH ROW EXECUTE FUNCTION audit_append_only();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS audit_anchors_append_only ON audit_anchors;
+DROP TRIGGER IF EXISTS audit_trail_append_only ON audit_trail;
+DROP TRIGGER IF EXISTS audit_trail_chain ON audit_trail;
+DROP FUNCTION IF EXISTS audit_append_only();
+DROP FUNCTION IF EXISTS audit_trail_check_chain();
+DROP TABLE IF EXISTS audit_anchors;
+
+ALTER TABLE audit_trail DROP CONSTRAINT audit_trail_entity_type_check;
+ALTER TABLE audit_trail ADD CONSTRAINT audit_trail_entity_type_check
+    CHECK (entity_type IN ('DARTA', 'CHALANI', 'ATTACHMENT', 'APPLICANT', 'RECIPIENT'));
+
+DROP INDEX IF EXISTS idx_audit_trail_chain;
+ALTER TABLE audit_trail
+    DROP COLUMN entry_hash,
+    DROP COLUMN prev_hash,
+    DROP COLUMN chain_seq;
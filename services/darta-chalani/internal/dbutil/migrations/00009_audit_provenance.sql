@@ -0,0 +1,22 @@
+-- +goose Up
+-- ============================================================================
+-- AUDIT PROVENANCE - Where each audited action came from: the request and
+-- trace it was part of, the role the user acted in and the PDP decision that
+-- allowed it. Entries written earlier keep NULLs; the trail is append-only.
+-- ============================================================================
+
+ALTER TABLE audit_trail
+    ADD COLUMN request_id VARCHAR(100),
+    ADD COLUMN trace_id VARCHAR(32),
+    ADD COLUMN acting_role VARCHAR(100),
+    ADD COLUMN decision_id VARCHAR(100);
+
+CREATE INDEX idx_audit_trail_request ON audit_trail(tenant_id, request_id) WHERE request_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_audit_trail_request;
+ALTER TABLE audit_trail
+    DROP COLUMN decision_id,
+    DROP COLUMN acting_role,
+    DROP COLUMN trace_id,
+    DROP COLUMN request_id;
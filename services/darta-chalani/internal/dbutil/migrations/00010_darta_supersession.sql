@@ -0,0 +1,53 @@
+-- +goose Up
+-- ============================================================================
+-- DARTA SUPERSESSION - A darta replaced by another links to its replacement,
+-- and the replacement back to it and the number it replaces. Each darta is
+-- replaced at most once and replaces at most one, so supersessions form
+-- chains.
+-- ============================================================================
+ALTER TABLE dartas
+    ADD COLUMN superseded_by_id UUID REFERENCES dartas(id),
+    ADD COLUMN supersedes_id UUID REFERENCES dartas(id),
+    ADD COLUMN supersedes_number VARCHAR(50),
+    ADD CONSTRAINT dartas_superseded_by_other CHECK (superseded_by_id <> id),
+    ADD CONSTRAINT dartas_supersedes_other CHECK (supersedes_id <> id);
+
+CREATE UNIQUE INDEX idx_dartas_superseded_by ON dartas(superseded_by_id)
+    WHERE superseded_by_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_dartas_supersedes ON dartas(supersedes_id)
+    WHERE supersedes_id IS NOT NULL;
+
+-- Supersessions were recorded by overwriting metadata. Link those whose
+-- replacement exists in the same tenant and replaces nothing else.
+WITH marked AS (
+    SELECT d.id, (d.metadata->>'superseded_by')::UUID AS replacement_id
+    FROM dartas d
+    WHERE d.metadata->>'superseded' = 'true'
+      AND d.metadata->>'superseded_by' ~ '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$'
+), linkable AS (
+    SELECT m.id, m.replacement_id
+    FROM marked m
+    JOIN dartas o ON o.id = m.id
+    JOIN dartas r ON r.id = m.replacement_id AND r.tenant_id = o.tenant_id AND r.id <> o.id
+    WHERE (SELECT COUNT(*) FROM marked m2 WHERE m2.replacement_id = m.replacement_id) = 1
+), old AS (
+    UPDATE dartas d
+    SET superseded_by_id = l.replacement_id, status = 'SUPERSEDED'
+    FROM linkable l
+    WHERE d.id = l.id
+    RETURNING d.id, d.superseded_by_id, d.formatted_darta_number
+)
+UPDATE dartas r
+SET supersedes_id = old.id, supersedes_number = old.formatted_darta_number
+FROM old
+WHERE r.id = old.superseded_by_id;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_dartas_supersedes;
+DROP INDEX IF EXISTS idx_dartas_superseded_by;
+ALTER TABLE dartas
+    DROP CONSTRAINT IF EXISTS dartas_supersedes_other,
+    DROP CONSTRAINT IF EXISTS dartas_superseded_by_other,
+    DROP COLUMN supersedes_number,
+    DROP COLUMN supersedes_id,
+    DROP COLUMN superseded_by_id;
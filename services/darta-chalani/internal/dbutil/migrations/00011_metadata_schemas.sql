@@ -0,0 +1,35 @@
+-- +goose Up
+-- ============================================================================
+-- METADATA SCHEMAS - Darta metadata is changed by JSON merge patches, each
+-- bumping the darta's version so concurrent edits cannot overwrite each
+-- other. Tenants may define a JSON Schema the metadata of dartas of each
+-- classification code must satisfy; the schema with an empty code applies
+-- to dartas no other schema covers.
+-- ============================================================================
+ALTER TABLE dartas ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+CREATE TABLE metadata_schemas (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(100) NOT NULL,
+    classification_code VARCHAR(100) NOT NULL DEFAULT '',
+    schema JSONB NOT NULL,
+    updated_by VARCHAR(100) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, classification_code),
+    CHECK (jsonb_typeof(schema) = 'object')
+);
+
+ALTER TABLE audit_trail DROP CONSTRAINT audit_trail_entity_type_check;
+ALTER TABLE audit_trail ADD CONSTRAINT audit_trail_entity_type_check
+    CHECK (entity_type IN ('DARTA', 'CHALANI', 'ATTACHMENT', 'APPLICANT', 'RECIPIENT',
+                           'SLA_POLICY', 'BUSINESS_CALENDAR', 'UNIT_HEAD', 'METADATA_SCHEMA'));
+
+-- +goose Down
+ALTER TABLE audit_trail DROP CONSTRAINT audit_trail_entity_type_check;
+ALTER TABLE audit_trail ADD CONSTRAINT audit_trail_entity_type_check
+    CHECK (entity_type IN ('DARTA', 'CHALANI', 'ATTACHMENT', 'APPLICANT', 'RECIPIENT',
+                           'SLA_POLICY', 'BUSINESS_CALENDAR', 'UNIT_HEAD'));
+
+DROP TABLE IF EXISTS metadata_schemas;
+ALTER TABLE dartas DROP COLUMN version;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- ============================================================================
+-- RECORD VERSIONS - Every change to a darta or chalani bumps its version and
+-- applies only to the version it was based on, so two officers acting on the
+-- same record cannot silently overwrite each other.
+-- ============================================================================
+ALTER TABLE chalanis ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE chalanis DROP COLUMN version;
@@ -0,0 +1,40 @@
+-- +goose Up
+-- ============================================================================
+-- IDEMPOTENCY KEYS - The response of every mutation sent with an idempotency
+-- key, so a retry replays it instead of applying the mutation again. A key
+-- belongs to one request of one tenant until it expires.
+-- ============================================================================
+CREATE TABLE idempotency_keys (
+    tenant_id VARCHAR(100) NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    method VARCHAR(255) NOT NULL,
+    -- SHA-256 of the method and request, hex encoded
+    request_hash VARCHAR(64) NOT NULL,
+    -- NULL while the first request is in progress
+    response BYTEA,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (tenant_id, idempotency_key)
+);
+
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
+
+-- Keys were unique across tenants, so one tenant's key could block another's
+DROP INDEX idx_dartas_idempotency_key;
+CREATE UNIQUE INDEX idx_dartas_idempotency_key ON dartas(tenant_id, idempotency_key)
+    WHERE idempotency_key IS NOT NULL;
+
+DROP INDEX idx_chalanis_idempotency_key;
+CREATE UNIQUE INDEX idx_chalanis_idempotency_key ON chalanis(tenant_id, idempotency_key)
+    WHERE idempotency_key IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_chalanis_idempotency_key;
+CREATE UNIQUE INDEX idx_chalanis_idempotency_key ON chalanis(idempotency_key)
+    WHERE idempotency_key IS NOT NULL;
+
+DROP INDEX idx_dartas_idempotency_key;
+CREATE UNIQUE INDEX idx_dartas_idempotency_key ON dartas(idempotency_key)
+    WHERE idempotency_key IS NOT NULL;
+
+DROP TABLE idempotency_keys;
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/audit"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+)
+
+// Audit trail categories
+const (
+	AuditCategoryActivity     = "ACTIVITY"
+	AuditCategorySoDViolation = "SOD_VIOLATION"
+)
+
+// RecordAudit appends an entry in the given category to the tenant's audit
+// chain
+func RecordAudit(ctx context.Context, queries db.Querier, category, entityType string, entityID uuid.UUID, action string, userCtx *UserContext, changes map[string]interface{}) error {
+	return RecordAuditWithReason(ctx, queries, category, entityType, entityID, action, userCtx, changes, "")
+}
+
+// RecordAuditWithReason is RecordAudit for actions taken for a stated
+// reason, which is kept in the entry's notes
+func RecordAuditWithReason(ctx context.Context, queries db.Querier, category, entityType string, entityID uuid.UUID, action string, userCtx *UserContext, changes map[string]interface{}, reason string) error {
+	_, err := audit.Append(ctx, queries, audit.Entry{
+		TenantID:    userCtx.TenantID,
+		Category:    category,
+		EntityType:  entityType,
+		EntityID:    entityID,
+		Action:      action,
+		PerformedBy: userCtx.UserID,
+		Changes:     changes,
+		IPAddress:   stringPtrIfNotEmpty(userCtx.IPAddress),
+		UserAgent:   stringPtrIfNotEmpty(userCtx.UserAgent),
+		Notes:       stringPtrIfNotEmpty(reason),
+		RequestID:   stringPtrIfNotEmpty(userCtx.RequestID),
+		TraceID:     stringPtrIfNotEmpty(userCtx.TraceID),
+		ActingRole:  stringPtrIfNotEmpty(userCtx.ActingRole),
+		DecisionID:  stringPtrIfNotEmpty(userCtx.DecisionID),
+	})
+	return err
+}
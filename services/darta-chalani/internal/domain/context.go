@@ -14,6 +14,9 @@ const (
 	RequestIDKey  contextKey = "request_id"
 	IPAddressKey  contextKey = "ip_address"
 	UserAgentKey  contextKey = "user_agent"
+	TraceIDKey    contextKey = "trace_id"
+	ActingRoleKey contextKey = "acting_role"
+	DecisionIDKey contextKey = "decision_id"
 )
 
 // UserContext contains authenticated user information
@@ -24,6 +27,13 @@ type UserContext struct {
 	RequestID string
 	IPAddress string
 	UserAgent string
+
+	// TraceID is the W3C trace the request belongs to, ActingRole the role
+	// the user acted in and DecisionID the PDP decision that allowed the
+	// request, as forwarded by the gateway
+	TraceID    string
+	ActingRole string
+	DecisionID string
 }
 
 // GetUserContext extracts user context from context.Context
@@ -35,6 +45,10 @@ func GetUserContext(ctx context.Context) *UserContext {
 		RequestID: GetStringValue(ctx, RequestIDKey),
 		IPAddress: GetStringValue(ctx, IPAddressKey),
 		UserAgent: GetStringValue(ctx, UserAgentKey),
+
+		TraceID:    GetStringValue(ctx, TraceIDKey),
+		ActingRole: GetStringValue(ctx, ActingRoleKey),
+		DecisionID: GetStringValue(ctx, DecisionIDKey),
 	}
 }
 
@@ -46,6 +60,9 @@ func WithUserContext(ctx context.Context, uc *UserContext) context.Context {
 	ctx = context.WithValue(ctx, RequestIDKey, uc.RequestID)
 	ctx = context.WithValue(ctx, IPAddressKey, uc.IPAddress)
 	ctx = context.WithValue(ctx, UserAgentKey, uc.UserAgent)
+	ctx = context.WithValue(ctx, TraceIDKey, uc.TraceID)
+	ctx = context.WithValue(ctx, ActingRoleKey, uc.ActingRole)
+	ctx = context.WithValue(ctx, DecisionIDKey, uc.DecisionID)
 	return ctx
 }
 
@@ -3,11 +3,13 @@ package domain
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
 )
@@ -46,13 +48,15 @@ func stringPtrIfNotEmpty(s string) *string {
 
 // DartaService handles Darta business logic
 type DartaService struct {
-	queries db.Querier
+	queries    db.Querier
+	duplicates DuplicatePolicy
 }
 
 // NewDartaService creates a new Darta service
-func NewDartaService(queries db.Querier) *DartaService {
+func NewDartaService(queries db.Querier, duplicates DuplicatePolicy) *DartaService {
 	return &DartaService{
-		queries: queries,
+		queries:    queries,
+		duplicates: duplicates,
 	}
 }
 
@@ -73,15 +77,49 @@ type CreateDartaInput struct {
 	BackdateApproverID *string
 	IdempotencyKey     string
 	Metadata           map[string]interface{}
+
+	// AcknowledgeDuplicates creates the darta even if it is blocked as a
+	// suspected duplicate
+	AcknowledgeDuplicates bool
 }
 
-// CreateDarta creates a new darta record
-func (s *DartaService) CreateDarta(ctx context.Context, input CreateDartaInput) (*db.Darta, error) {
+// CreateDarta creates a new darta record. It also returns the existing dartas
+// the new one is suspected to duplicate, best match first.
+func (s *DartaService) CreateDarta(ctx context.Context, input CreateDartaInput) (*db.Darta, []DuplicateCandidate, error) {
 	userCtx := GetUserContext(ctx)
 	
 	// Validate input
 	if err := s.validateCreateDartaInput(input); err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// A darta received on an earlier day than it is entered is backdated,
+	// which needs a reason
+	now := time.Now()
+	input.IsBackdated = input.ReceivedDate.Before(now) && !sameDay(input.ReceivedDate, now)
+	if input.IsBackdated {
+		var reason string
+		if input.BackdateReason != nil {
+			reason = *input.BackdateReason
+		}
+		reason, err := ValidateReason("backdate_reason", reason)
+		if err != nil {
+			return nil, nil, err
+		}
+		input.BackdateReason = &reason
+	} else {
+		input.BackdateReason = nil
+	}
+
+	// Assign fiscal year from the received date when not supplied
+	if input.FiscalYearID == "" {
+		fiscalYearID, err := FiscalYearIDFor(input.ReceivedDate)
+		if err != nil {
+			return nil, nil, NewValidationError("received_date", "outside supported Bikram Sambat range")
+		}
+		input.FiscalYearID = fiscalYearID
+	} else {
+		input.FiscalYearID = NormalizeFiscalYearID(input.FiscalYearID)
 	}
 	
 	// Check idempotency
@@ -92,20 +130,31 @@ func (s *DartaService) CreateDarta(ctx context.Context, input CreateDartaInput)
 			TenantID:       userCtx.TenantID,
 		})
 		if err == nil && existing.ID != uuid.Nil {
-			return &existing, nil // Return existing darta
+			return &existing, nil, nil // Return existing darta
 		}
 	}
 	
 	// Verify applicant exists
-	_, err := s.queries.GetApplicant(ctx, input.ApplicantID)
+	applicant, err := s.queries.GetApplicant(ctx, input.ApplicantID)
 	if err != nil {
-		return nil, fmt.Errorf("applicant not found: %w", err)
+		return nil, nil, fmt.Errorf("applicant not found: %w", err)
 	}
 	
 	// Verify primary document exists
-	_, err = s.queries.GetAttachment(ctx, input.PrimaryDocumentID)
+	document, err := s.queries.GetAttachment(ctx, input.PrimaryDocumentID)
 	if err != nil {
-		return nil, fmt.Errorf("primary document not found: %w", err)
+		return nil, nil, fmt.Errorf("primary document not found: %w", err)
+	}
+
+	// Look for dartas this one may duplicate
+	duplicates, err := s.findDuplicates(ctx, duplicateProbe{
+		subject:      input.Subject,
+		receivedDate: input.ReceivedDate,
+		applicant:    applicant,
+		checksum:     document.Checksum,
+	}, input.AcknowledgeDuplicates)
+	if err != nil {
+		return nil, nil, err
 	}
 	
 	// Prepare metadata
@@ -113,7 +162,7 @@ func (s *DartaService) CreateDarta(ctx context.Context, input CreateDartaInput)
 	if input.Metadata != nil {
 		metadataJSON, err = json.Marshal(input.Metadata)
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal metadata: %w", err)
+			return nil, nil, fmt.Errorf("failed to marshal metadata: %w", err)
 		}
 	}
 	
@@ -126,7 +175,7 @@ func (s *DartaService) CreateDarta(ctx context.Context, input CreateDartaInput)
 		ApplicantID:        input.ApplicantID,
 		IntakeChannel:      input.IntakeChannel,
 		ReceivedDate:       timeToPgTimestamptz(input.ReceivedDate),
-		EntryDate:          timeToPgTimestamptz(time.Now()),
+		EntryDate:          timeToPgTimestamptz(now),
 		IsBackdated:        input.IsBackdated,
 		BackdateReason:     input.BackdateReason,
 		BackdateApproverID: input.BackdateApproverID,
@@ -139,7 +188,7 @@ func (s *DartaService) CreateDarta(ctx context.Context, input CreateDartaInput)
 		Metadata:           metadataJSON,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create darta: %w", err)
+		return nil, nil, fmt.Errorf("failed to create darta: %w", err)
 	}
 	
 	// Add annexes
@@ -155,14 +204,28 @@ func (s *DartaService) CreateDarta(ctx context.Context, input CreateDartaInput)
 	}
 	
 	// Create audit entry
-	_ = s.createAuditEntry(ctx, "DARTA", darta.ID, "CREATED", userCtx, nil)
+	var changes map[string]interface{}
+	if len(duplicates) > 0 {
+		changes = map[string]interface{}{"suspected_duplicates": duplicateIDs(duplicates)}
+	}
+	var backdateReason string
+	if input.IsBackdated {
+		if changes == nil {
+			changes = map[string]interface{}{}
+		}
+		changes["is_backdated"] = true
+		backdateReason = *input.BackdateReason
+	}
+	if err := RecordAuditWithReason(ctx, s.queries, AuditCategoryActivity, "DARTA", darta.ID, "CREATED", userCtx, changes, backdateReason); err != nil {
+		return nil, nil, err
+	}
 	
-	return &darta, nil
+	return &darta, duplicates, nil
 }
 
 // GetDarta retrieves a darta by ID
 func (s *DartaService) GetDarta(ctx context.Context, id uuid.UUID) (*db.GetDartaRow, error) {
-	darta, err := s.queries.GetDarta(ctx, id)
+	darta, err := s.queries.GetDarta(ctx, db.GetDartaParams{ID: id, TenantID: GetUserContext(ctx).TenantID})
 	if err != nil {
 		return nil, ErrDartaNotFound
 	}
@@ -170,51 +233,99 @@ func (s *DartaService) GetDarta(ctx context.Context, id uuid.UUID) (*db.GetDarta
 }
 
 // UpdateDartaStatus updates the status of a darta
-func (s *DartaService) UpdateDartaStatus(ctx context.Context, id uuid.UUID, newStatus string) (*db.Darta, error) {
+func (s *DartaService) UpdateDartaStatus(ctx context.Context, id uuid.UUID, newStatus string, expectedVersion int64) (*db.Darta, error) {
+	return s.UpdateDartaStatusWithReason(ctx, id, newStatus, "", expectedVersion)
+}
+
+// UpdateDartaStatusWithReason updates the status of a darta, recording the
+// reason given for it, if any, with the review it completes
+func (s *DartaService) UpdateDartaStatusWithReason(ctx context.Context, id uuid.UUID, newStatus, reason string, expectedVersion int64) (*db.Darta, error) {
 	userCtx := GetUserContext(ctx)
 	
 	// Get current darta
-	current, err := s.queries.GetDartaSimple(ctx, id)
+	current, err := s.queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: id, TenantID: userCtx.TenantID})
 	if err != nil {
 		return nil, ErrDartaNotFound
 	}
+	if err := CheckVersion(expectedVersion, current.Version); err != nil {
+		return nil, err
+	}
 	
 	// Validate status transition
 	if !s.isValidStatusTransition(current.Status, newStatus) {
-		return nil, ErrInvalidDartaStatus
+		return nil, NewTransitionError(ErrInvalidDartaStatus, current.Status, newStatus)
 	}
-	
-	// Update status
-	updated, err := s.queries.UpdateDartaStatus(ctx, db.UpdateDartaStatusParams{
-		ID:     id,
-		Status: newStatus,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to update status: %w", err)
+
+	// Leaving intake review, approved or sent back to draft, completes the
+	// review, which must not be done by the darta's creator
+	isReview := current.Status == "PENDING_REVIEW" && (newStatus == "CLASSIFICATION" || newStatus == "DRAFT")
+	if isReview {
+		if err := s.EnforceSoD(ctx, &current, DutyReview); err != nil {
+			return nil, err
+		}
 	}
 	
-	// Create audit entry
+	// The status change and its audit entries commit together
 	changes := map[string]interface{}{
 		"status": map[string]string{"from": current.Status, "to": newStatus},
 	}
-	_ = s.createAuditEntry(ctx, "DARTA", id, "STATUS_CHANGED", userCtx, changes)
+	var updated db.Darta
+	err = db.InTx(ctx, s.queries, func(q db.Querier) error {
+		var err error
+		updated, err = q.UpdateDartaStatus(ctx, db.UpdateDartaStatusParams{
+			ID:       id,
+			Status:   newStatus,
+			TenantID: userCtx.TenantID,
+			Version:  current.Version,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return DartaVersionConflict(ctx, q, id, current.Version)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update status: %w", err)
+		}
+		if err := RecordAudit(ctx, q, AuditCategoryActivity, "DARTA", id, "STATUS_CHANGED", userCtx, changes); err != nil {
+			return err
+		}
+		if isReview {
+			return RecordAuditWithReason(ctx, q, AuditCategoryActivity, "DARTA", id, DutyReview, userCtx, changes, reason)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 	
 	return &updated, nil
 }
 
-// ReserveDartaNumber reserves a darta number
-func (s *DartaService) ReserveDartaNumber(ctx context.Context, id uuid.UUID) (*db.Darta, error) {
+// ReserveDartaNumber reserves a darta number. Registration is where
+// duplicates are caught, so the darta is checked again first; see CreateDarta.
+func (s *DartaService) ReserveDartaNumber(ctx context.Context, id uuid.UUID, acknowledgeDuplicates bool, expectedVersion int64) (*db.Darta, []DuplicateCandidate, error) {
 	userCtx := GetUserContext(ctx)
 	
 	// Get current darta
-	current, err := s.queries.GetDartaSimple(ctx, id)
+	current, err := s.queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: id, TenantID: userCtx.TenantID})
 	if err != nil {
-		return nil, ErrDartaNotFound
+		return nil, nil, ErrDartaNotFound
 	}
 	
-	// Check if already has number
+	// Check if already has number. Reserving again changes nothing, so a
+	// retry succeeds whatever version it expected.
 	if current.DartaNumber != nil {
-		return &current, nil
+		return &current, nil, nil
+	}
+	if err := CheckVersion(expectedVersion, current.Version); err != nil {
+		return nil, nil, err
+	}
+
+	probe, err := s.probeFor(ctx, current)
+	if err != nil {
+		return nil, nil, err
+	}
+	duplicates, err := s.findDuplicates(ctx, probe, acknowledgeDuplicates)
+	if err != nil {
+		return nil, nil, err
 	}
 	
 	// Get next number
@@ -225,64 +336,85 @@ func (s *DartaService) ReserveDartaNumber(ctx context.Context, id uuid.UUID) (*d
 		TenantID:     current.TenantID,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get next number: %w", err)
+		return nil, nil, fmt.Errorf("failed to get next number: %w", err)
 	}
 	
 	// Format number
-	formatted := s.formatDartaNumber(current.FiscalYearID, current.Scope, current.WardID, int(nextNum))
+	formatted := FormatDartaNumber(current.FiscalYearID, current.Scope, current.WardID, int(nextNum))
 	
-	// Update darta
+	// Assign the number, moving the darta to NUMBER_RESERVED
 	updated, err := s.queries.UpdateDartaNumber(ctx, db.UpdateDartaNumberParams{
 		ID:                    id,
 		DartaNumber:           &nextNum,
 		FormattedDartaNumber:  &formatted,
+		Version:               current.Version,
 	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, DartaVersionConflict(ctx, s.queries, id, current.Version)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to update number: %w", err)
+		return nil, nil, fmt.Errorf("failed to update number: %w", err)
 	}
 	
-	// Update status to NUMBER_RESERVED
-	_, _ = s.queries.UpdateDartaStatus(ctx, db.UpdateDartaStatusParams{
-		ID:     id,
-		Status: "NUMBER_RESERVED",
-	})
-	
 	// Create audit entry
 	changes := map[string]interface{}{
 		"darta_number": nextNum,
 		"formatted":    formatted,
 	}
-	_ = s.createAuditEntry(ctx, "DARTA", id, "NUMBER_RESERVED", userCtx, changes)
+	if len(duplicates) > 0 {
+		changes["suspected_duplicates"] = duplicateIDs(duplicates)
+	}
+	if err := s.createAuditEntry(ctx, "DARTA", id, "NUMBER_RESERVED", userCtx, changes); err != nil {
+		return nil, nil, err
+	}
 	
-	return &updated, nil
+	return &updated, duplicates, nil
 }
 
 // AssignDarta assigns darta to a unit/user
-func (s *DartaService) AssignDarta(ctx context.Context, id uuid.UUID, unitID, assigneeID *string, priority *string, slaHours *int32) (*db.Darta, error) {
+func (s *DartaService) AssignDarta(ctx context.Context, id uuid.UUID, unitID, assigneeID *string, priority *string, slaHours *int32, expectedVersion int64) (*db.Darta, error) {
 	userCtx := GetUserContext(ctx)
 	
-	var slaDeadline *time.Time
+	current, err := s.queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: id, TenantID: userCtx.TenantID})
+	if err != nil {
+		return nil, ErrDartaNotFound
+	}
+	if err := CheckVersion(expectedVersion, current.Version); err != nil {
+		return nil, err
+	}
+	
+	// slaHours are business hours; the SLA engine turns them into a
+	// deadline on the tenant's calendar. Without them the policy applies.
+	var slaTarget *int32
 	if slaHours != nil && *slaHours > 0 {
-		deadline := time.Now().Add(time.Duration(*slaHours) * time.Hour)
-		slaDeadline = &deadline
+		minutes := *slaHours * 60
+		slaTarget = &minutes
 	}
 	
 	updated, err := s.queries.UpdateDartaAssignment(ctx, db.UpdateDartaAssignmentParams{
 		ID:                 id,
 		AssignedToUnitID:   unitID,
 		CurrentAssigneeID:  assigneeID,
-		SlaDeadline:        timePtrToPgTimestamptz(slaDeadline),
+		SlaTargetMinutes:   slaTarget,
 		Priority:           priority,
+		Version:            current.Version,
 	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, DartaVersionConflict(ctx, s.queries, id, current.Version)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to assign darta: %w", err)
 	}
 	
 	// Update status to ASSIGNED
-	_, _ = s.queries.UpdateDartaStatus(ctx, db.UpdateDartaStatusParams{
-		ID:     id,
-		Status: "ASSIGNED",
-	})
+	if assigned, err := s.queries.UpdateDartaStatus(ctx, db.UpdateDartaStatusParams{
+		ID:       id,
+		Status:   "ASSIGNED",
+		TenantID: userCtx.TenantID,
+		Version:  updated.Version,
+	}); err == nil {
+		updated = assigned
+	}
 	
 	// Create audit entry
 	changes := map[string]interface{}{
@@ -290,8 +422,86 @@ func (s *DartaService) AssignDarta(ctx context.Context, id uuid.UUID, unitID, as
 		"assigned_to_user": assigneeID,
 		"sla_hours":        slaHours,
 	}
-	_ = s.createAuditEntry(ctx, "DARTA", id, "ASSIGNED", userCtx, changes)
-	
+	if err := s.createAuditEntry(ctx, "DARTA", id, "ASSIGNED", userCtx, changes); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// CloseDarta closes a darta. Unlike UpdateDartaStatus it may close from any
+// status, but the change is audited the same way, which is what processing
+// time analytics read.
+func (s *DartaService) CloseDarta(ctx context.Context, id uuid.UUID, expectedVersion int64) (*db.Darta, error) {
+	return s.forceStatus(ctx, id, "CLOSED", "", expectedVersion, func(ctx context.Context, q db.Querier, id uuid.UUID, version int64) (db.Darta, error) {
+		return q.CloseDarta(ctx, db.CloseDartaParams{ID: id, TenantID: GetUserContext(ctx).TenantID, Version: version})
+	})
+}
+
+// VoidDarta voids a darta for the given reason, auditing the change like
+// CloseDarta
+func (s *DartaService) VoidDarta(ctx context.Context, id uuid.UUID, reason string, expectedVersion int64) (*db.Darta, error) {
+	reason, err := ValidateReason("reason", reason)
+	if err != nil {
+		return nil, err
+	}
+	return s.forceStatus(ctx, id, "VOIDED", reason, expectedVersion, func(ctx context.Context, q db.Querier, id uuid.UUID, version int64) (db.Darta, error) {
+		return q.VoidDarta(ctx, db.VoidDartaParams{ID: id, TenantID: GetUserContext(ctx).TenantID, Version: version})
+	})
+}
+
+// ArchiveDarta marks a darta's digital archive final, auditing the change
+// like CloseDarta
+func (s *DartaService) ArchiveDarta(ctx context.Context, id uuid.UUID, expectedVersion int64) (*db.Darta, error) {
+	return s.forceStatus(ctx, id, "ARCHIVED", "", expectedVersion, s.setStatus("ARCHIVED"))
+}
+
+// AcceptDarta records that the assignee took a darta up, auditing the
+// change like CloseDarta
+func (s *DartaService) AcceptDarta(ctx context.Context, id uuid.UUID, expectedVersion int64) (*db.Darta, error) {
+	return s.forceStatus(ctx, id, "UNDER_PROCESSING", "", expectedVersion, s.setStatus("UNDER_PROCESSING"))
+}
+
+// setStatus returns an update to newStatus for forceStatus
+func (s *DartaService) setStatus(newStatus string) func(context.Context, db.Querier, uuid.UUID, int64) (db.Darta, error) {
+	return func(ctx context.Context, q db.Querier, id uuid.UUID, version int64) (db.Darta, error) {
+		return q.UpdateDartaStatus(ctx, db.UpdateDartaStatusParams{ID: id, Status: newStatus, TenantID: GetUserContext(ctx).TenantID, Version: version})
+	}
+}
+
+// forceStatus applies a status change from any status, to the darta as it
+// was read, and audits it with the reason given for it, if any
+func (s *DartaService) forceStatus(ctx context.Context, id uuid.UUID, newStatus, reason string, expectedVersion int64, apply func(context.Context, db.Querier, uuid.UUID, int64) (db.Darta, error)) (*db.Darta, error) {
+	userCtx := GetUserContext(ctx)
+
+	current, err := s.queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: id, TenantID: userCtx.TenantID})
+	if err != nil {
+		return nil, ErrDartaNotFound
+	}
+	if err := CheckVersion(expectedVersion, current.Version); err != nil {
+		return nil, err
+	}
+
+	// The status change and its audit entry commit together
+	changes := map[string]interface{}{
+		"status": map[string]string{"from": current.Status, "to": newStatus},
+	}
+	var updated db.Darta
+	err = db.InTx(ctx, s.queries, func(q db.Querier) error {
+		var err error
+		updated, err = apply(ctx, q, id, current.Version)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return DartaVersionConflict(ctx, q, id, current.Version)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update status: %w", err)
+		}
+		return RecordAuditWithReason(ctx, q, AuditCategoryActivity, "DARTA", id, "STATUS_CHANGED", userCtx, changes, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &updated, nil
 }
 
@@ -313,6 +523,9 @@ func (s *DartaService) validateCreateDartaInput(input CreateDartaInput) error {
 	if input.Scope == "WARD" && (input.WardID == nil || *input.WardID == "") {
 		return NewValidationError("ward_id", "required when scope is WARD")
 	}
+	if input.ReceivedDate.IsZero() {
+		return NewValidationError("received_date", "required")
+	}
 	return nil
 }
 
@@ -350,9 +563,9 @@ func (s *DartaService) isValidStatusTransition(from, to string) bool {
 	return false
 }
 
-func (s *DartaService) formatDartaNumber(fiscalYear, scope string, wardID *string, number int) string {
-	// Format: FY-SCOPE-NUMBER or FY-WARD-NUMBER
-	// Example: 2081-82/MUN/D-00123 or 2081-82/W05/D-00123
+// FormatDartaNumber formats a darta registration number.
+// Example: 2081-82/MUN/D-00123 or 2081-82/W05/D-00123
+func FormatDartaNumber(fiscalYear, scope string, wardID *string, number int) string {
 	var scopePart string
 	if scope == "MUNICIPALITY" {
 		scopePart = "MUN"
@@ -363,24 +576,10 @@ func (s *DartaService) formatDartaNumber(fiscalYear, scope string, wardID *strin
 }
 
 func (s *DartaService) createAuditEntry(ctx context.Context, entityType string, entityID uuid.UUID, action string, userCtx *UserContext, changes map[string]interface{}) error {
-	var changesJSON json.RawMessage
-	if changes != nil {
-		var err error
-		changesJSON, err = json.Marshal(changes)
-		if err != nil {
-			return err
-		}
-	}
-	
-	_, err := s.queries.CreateAuditEntry(ctx, db.CreateAuditEntryParams{
-		EntityType:  entityType,
-		EntityID:    uuidToPgUUID(entityID),
-		Action:      action,
-		PerformedBy: userCtx.UserID,
-		Changes:     changesJSON,
-		IpAddress:   &userCtx.IPAddress,
-		UserAgent:   &userCtx.UserAgent,
-		TenantID:    userCtx.TenantID,
-	})
-	return err
+	return RecordAudit(ctx, s.queries, AuditCategoryActivity, entityType, entityID, action, userCtx, changes)
+}
+
+// EnforceSoD checks the current user may perform duty on a darta
+func (s *DartaService) EnforceSoD(ctx context.Context, darta *db.Darta, duty string) error {
+	return EnforceSoD(ctx, s.queries, "DARTA", darta.ID, darta.CreatedBy, duty)
 }
@@ -0,0 +1,301 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"git.ninjainfosys.com/ePalika/pkg/bsdate"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+)
+
+// Reasons a darta is suspected to duplicate another
+const (
+	DuplicateReasonSameApplicant      = "SAME_APPLICANT"
+	DuplicateReasonSameIdentification = "SAME_IDENTIFICATION_NUMBER"
+	DuplicateReasonSamePhone          = "SAME_PHONE"
+	DuplicateReasonSameEmail          = "SAME_EMAIL"
+	DuplicateReasonSameDocument       = "SAME_PRIMARY_DOCUMENT"
+	DuplicateReasonSimilarSubject     = "SIMILAR_SUBJECT"
+	DuplicateReasonSameDay            = "RECEIVED_SAME_DAY"
+)
+
+// RelationshipDuplicateOf links a darta to the earlier darta it duplicates
+const RelationshipDuplicateOf = "DUPLICATE_OF"
+
+// Weights of the duplicate signals. Signals are combined as independent
+// evidence, score = 1 - Π(1 - weight), so no single weak signal dominates
+// and the score stays below 1.
+const (
+	weightIdentity = 0.45 // same applicant record or identification number
+	weightContact  = 0.25 // each of phone and email
+	weightDocument = 0.8
+	weightSubject  = 0.7 // scaled by trigram similarity
+	weightSameDay  = 0.2
+)
+
+const (
+	// minDuplicateScore is the lowest score reported as a suspected duplicate
+	minDuplicateScore = 0.3
+	// maxDuplicateCandidates bounds both the rows scored and those reported
+	maxDuplicateCandidates = 50
+	maxReportedDuplicates  = 10
+)
+
+// DuplicatePolicy configures duplicate detection at intake
+type DuplicatePolicy struct {
+	// Window is how far either side of the received date candidates are
+	// looked for
+	Window time.Duration
+	// BlockThreshold blocks creation and number reservation when a candidate
+	// scores at least this much, unless the caller acknowledges the
+	// duplicates. Zero only reports them.
+	BlockThreshold float64
+}
+
+// DuplicateCandidate is an existing darta suspected to duplicate another
+type DuplicateCandidate struct {
+	DartaID              uuid.UUID
+	FormattedDartaNumber *string
+	Subject              string
+	Status               string
+	WardID               *string
+	ReceivedDate         time.Time
+	ApplicantName        string
+	Score                float64
+	Reasons              []string
+}
+
+// DuplicateError blocks a darta whose best candidate reaches the policy's
+// threshold. It unwraps to ErrDuplicateDarta.
+type DuplicateError struct {
+	Candidates []DuplicateCandidate
+}
+
+func (e *DuplicateError) Error() string {
+	return fmt.Sprintf("%v: suspected duplicate of %s (score %.2f)", ErrDuplicateDarta, e.Candidates[0].DartaID, e.Candidates[0].Score)
+}
+
+func (e *DuplicateError) Unwrap() error {
+	return ErrDuplicateDarta
+}
+
+// duplicateProbe is what a darta is compared by
+type duplicateProbe struct {
+	excludeID    uuid.UUID
+	subject      string
+	receivedDate time.Time
+	applicant    db.Applicant
+	checksum     string
+}
+
+// findDuplicates returns the suspected duplicates of probe, best first. When
+// the best reaches the block threshold and acknowledged is false, it returns
+// them in a DuplicateError too.
+func (s *DartaService) findDuplicates(ctx context.Context, probe duplicateProbe, acknowledged bool) ([]DuplicateCandidate, error) {
+	userCtx := GetUserContext(ctx)
+
+	rows, err := s.queries.ListDuplicateCandidates(ctx, db.ListDuplicateCandidatesParams{
+		ApplicantID:          uuidToPgUUID(probe.applicant.ID),
+		IdentificationNumber: nonBlank(probe.applicant.IdentificationNumber, strings.TrimSpace),
+		PhoneDigits:          nonBlank(probe.applicant.Phone, phoneDigits),
+		Email:                nonBlank(probe.applicant.Email, normalizeEmail),
+		Checksum:             probe.checksum,
+		Subject:              probe.subject,
+		TenantID:             userCtx.TenantID,
+		ExcludeID:            pgtype.UUID{Bytes: probe.excludeID, Valid: true},
+		ReceivedFrom:         timeToPgTimestamptz(probe.receivedDate.Add(-s.duplicates.Window)),
+		ReceivedTo:           timeToPgTimestamptz(probe.receivedDate.Add(s.duplicates.Window)),
+		Limit:                maxDuplicateCandidates,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list duplicate candidates: %w", err)
+	}
+
+	var candidates []DuplicateCandidate
+	for _, row := range rows {
+		c := scoreDuplicate(row, probe.receivedDate)
+		if c.Score >= minDuplicateScore {
+			candidates = append(candidates, c)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+	if len(candidates) > maxReportedDuplicates {
+		candidates = candidates[:maxReportedDuplicates]
+	}
+
+	if !acknowledged && s.duplicates.BlockThreshold > 0 &&
+		len(candidates) > 0 && candidates[0].Score >= s.duplicates.BlockThreshold {
+		return candidates, &DuplicateError{Candidates: candidates}
+	}
+	return candidates, nil
+}
+
+// scoreDuplicate combines the signals a candidate matched on
+func scoreDuplicate(row db.ListDuplicateCandidatesRow, receivedDate time.Time) DuplicateCandidate {
+	c := DuplicateCandidate{
+		DartaID:              row.ID,
+		FormattedDartaNumber: row.FormattedDartaNumber,
+		Subject:              row.Subject,
+		Status:               row.Status,
+		WardID:               row.WardID,
+		ReceivedDate:         row.ReceivedDate.Time,
+		ApplicantName:        row.FullName,
+	}
+
+	miss := 1.0
+	add := func(reason string, weight float64) {
+		c.Reasons = append(c.Reasons, reason)
+		miss *= 1 - weight
+	}
+
+	// The same applicant record carries the same identifiers, so identity
+	// counts once
+	switch {
+	case row.SameIdentification:
+		add(DuplicateReasonSameIdentification, weightIdentity)
+	case row.SameApplicant:
+		add(DuplicateReasonSameApplicant, weightIdentity)
+	}
+	if row.SamePhone {
+		add(DuplicateReasonSamePhone, weightContact)
+	}
+	if row.SameEmail {
+		add(DuplicateReasonSameEmail, weightContact)
+	}
+	if row.SameDocument {
+		add(DuplicateReasonSameDocument, weightDocument)
+	}
+	if row.SubjectSimilarity >= minDuplicateScore {
+		add(DuplicateReasonSimilarSubject, weightSubject*row.SubjectSimilarity)
+	}
+	if len(c.Reasons) > 0 && sameDay(row.ReceivedDate.Time, receivedDate) {
+		add(DuplicateReasonSameDay, weightSameDay)
+	}
+
+	c.Score = 1 - miss
+	return c
+}
+
+// LinkDuplicate records that darta id duplicates an earlier darta
+func (s *DartaService) LinkDuplicate(ctx context.Context, id, duplicateOf uuid.UUID, notes string, expectedVersion int64) (*db.Darta, error) {
+	userCtx := GetUserContext(ctx)
+
+	if id == duplicateOf {
+		return nil, NewValidationError("duplicate_of_id", "a darta cannot duplicate itself")
+	}
+
+	current, err := s.queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: id, TenantID: userCtx.TenantID})
+	if err != nil {
+		return nil, ErrDartaNotFound
+	}
+	if err := CheckVersion(expectedVersion, current.Version); err != nil {
+		return nil, err
+	}
+	if _, err := s.queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: duplicateOf, TenantID: userCtx.TenantID}); err != nil {
+		return nil, NewValidationError("duplicate_of_id", "darta not found")
+	}
+
+	if err := s.queries.AddDartaRelationship(ctx, db.AddDartaRelationshipParams{
+		DartaID:          uuidToPgUUID(id),
+		RelatedDartaID:   uuidToPgUUID(duplicateOf),
+		RelationshipType: RelationshipDuplicateOf,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to link duplicate: %w", err)
+	}
+
+	changes := map[string]interface{}{
+		"duplicate_of": duplicateOf.String(),
+	}
+	if notes != "" {
+		changes["notes"] = notes
+	}
+	if err := s.createAuditEntry(ctx, "DARTA", id, "LINKED_DUPLICATE", userCtx, changes); err != nil {
+		return nil, err
+	}
+
+	return &current, nil
+}
+
+// ListDuplicates returns the suspected duplicates of an existing darta
+func (s *DartaService) ListDuplicates(ctx context.Context, id uuid.UUID) ([]DuplicateCandidate, error) {
+	userCtx := GetUserContext(ctx)
+
+	current, err := s.queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: id, TenantID: userCtx.TenantID})
+	if err != nil {
+		return nil, ErrDartaNotFound
+	}
+	probe, err := s.probeFor(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+	return s.findDuplicates(ctx, probe, true)
+}
+
+// probeFor builds the duplicate probe of an existing darta
+func (s *DartaService) probeFor(ctx context.Context, d db.Darta) (duplicateProbe, error) {
+	applicant, err := s.queries.GetApplicant(ctx, d.ApplicantID)
+	if err != nil {
+		return duplicateProbe{}, fmt.Errorf("failed to get applicant: %w", err)
+	}
+	document, err := s.queries.GetAttachment(ctx, d.PrimaryDocumentID)
+	if err != nil {
+		return duplicateProbe{}, fmt.Errorf("failed to get primary document: %w", err)
+	}
+	return duplicateProbe{
+		excludeID:    d.ID,
+		subject:      d.Subject,
+		receivedDate: d.ReceivedDate.Time,
+		applicant:    applicant,
+		checksum:     document.Checksum,
+	}, nil
+}
+
+// duplicateIDs lists the darta IDs of candidates for the audit trail
+func duplicateIDs(candidates []DuplicateCandidate) []string {
+	ids := make([]string, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.DartaID.String()
+	}
+	return ids
+}
+
+// nonBlank normalizes s, returning nil when nothing is left
+func nonBlank(s *string, normalize func(string) string) *string {
+	if s == nil {
+		return nil
+	}
+	return stringPtrIfNotEmpty(normalize(*s))
+}
+
+// phoneDigits is the last ten digits of a phone number, which ignores
+// country codes and formatting
+func phoneDigits(phone string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+	if len(digits) > 10 {
+		digits = digits[len(digits)-10:]
+	}
+	return digits
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func sameDay(a, b time.Time) bool {
+	y1, m1, d1 := a.In(bsdate.Nepal).Date()
+	y2, m2, d2 := b.In(bsdate.Nepal).Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
@@ -14,6 +14,7 @@ var (
 	ErrUnauthorized       = errors.New("unauthorized")
 	ErrForbidden          = errors.New("forbidden")
 	ErrConflict           = errors.New("conflict")
+	ErrVersionConflict    = errors.New("record was changed by another request")
 	ErrInternalServer     = errors.New("internal server error")
 	
 	// Darta specific errors
@@ -61,6 +62,47 @@ func NewDomainError(err error, message string, code string) *DomainError {
 	}
 }
 
+// TransitionError reports a status change the lifecycle does not allow. It
+// unwraps to ErrInvalidDartaStatus or ErrInvalidChalaniStatus.
+type TransitionError struct {
+	Err  error
+	From string
+	To   string
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("%v: %s -> %s", e.Err, e.From, e.To)
+}
+
+func (e *TransitionError) Unwrap() error {
+	return e.Err
+}
+
+// NewTransitionError creates a new transition error
+func NewTransitionError(err error, from, to string) *TransitionError {
+	return &TransitionError{Err: err, From: from, To: to}
+}
+
+// VersionConflictError reports a change made against a version of a record
+// that is no longer current. It unwraps to ErrVersionConflict.
+type VersionConflictError struct {
+	Expected int64
+	Current  int64
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("%v: expected version %d, current version %d", ErrVersionConflict, e.Expected, e.Current)
+}
+
+func (e *VersionConflictError) Unwrap() error {
+	return ErrVersionConflict
+}
+
+// NewVersionConflictError creates a new version conflict error
+func NewVersionConflictError(expected, current int64) *VersionConflictError {
+	return &VersionConflictError{Expected: expected, Current: current}
+}
+
 // Validation error
 type ValidationError struct {
 	Field   string
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"time"
+
+	"git.ninjainfosys.com/ePalika/pkg/bsdate"
+)
+
+// FiscalYearIDFor returns the fiscal year code (e.g. "2081-82") for the
+// Nepali fiscal year containing t. Fiscal years are identified by their code
+// in fiscal_year_id columns and in formatted register numbers.
+func FiscalYearIDFor(t time.Time) (string, error) {
+	fy, err := bsdate.FiscalYearForTime(t)
+	if err != nil {
+		return "", err
+	}
+	return fy.Code(), nil
+}
+
+// NormalizeFiscalYearID converts user supplied fiscal year forms such as
+// "2081/82", "2081/2082" or "२०८१-८२" to the canonical code. Values that
+// cannot be parsed are returned unchanged.
+func NormalizeFiscalYearID(id string) string {
+	if id == "" {
+		return id
+	}
+	fy, err := bsdate.ParseFiscalYear(id)
+	if err != nil {
+		return id
+	}
+	return fy.Code()
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/metadata"
+)
+
+// maxMetadataAttempts bounds how often a patch sent without an expected
+// version is reapplied when another change lands between read and write
+const maxMetadataAttempts = 3
+
+// ApplyMetadataPatch merges an RFC 7396 JSON merge patch into a record's
+// metadata and checks the result against the tenant's schema for the
+// classification code, returning the new metadata
+func ApplyMetadataPatch(ctx context.Context, queries db.Querier, tenantID, classificationCode string, current, patch json.RawMessage) (json.RawMessage, error) {
+	if !bytes.HasPrefix(bytes.TrimSpace(patch), []byte("{")) {
+		return nil, NewValidationError("metadata", "must be a JSON object")
+	}
+	merged, err := metadata.MergePatch(current, patch)
+	if err != nil {
+		return nil, NewValidationError("metadata", err.Error())
+	}
+
+	schemaRow, err := queries.GetApplicableMetadataSchema(ctx, db.GetApplicableMetadataSchemaParams{
+		TenantID:           tenantID,
+		ClassificationCode: classificationCode,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return merged, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata schema: %w", err)
+	}
+	schema, err := metadata.CompileSchema(schemaRow.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("metadata schema %q: %w", schemaRow.ClassificationCode, err)
+	}
+
+	err = schema.Validate(merged)
+	var violation *metadata.Violation
+	if errors.As(err, &violation) {
+		field := "metadata"
+		if violation.Path != "" {
+			field += "." + violation.Path
+		}
+		return nil, NewValidationError(field, violation.Message)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate metadata: %w", err)
+	}
+	return merged, nil
+}
+
+// metadataChanges is what an audit entry records of a metadata patch
+func metadataChanges(patch json.RawMessage, from, to int64) map[string]interface{} {
+	var fields map[string]interface{}
+	_ = json.Unmarshal(patch, &fields)
+	return map[string]interface{}{
+		"metadata": fields,
+		"version":  map[string]int64{"from": from, "to": to},
+	}
+}
+
+// PatchMetadata applies a JSON merge patch to a darta's metadata, recorded
+// as action. Given an expected version the patch applies to that version
+// only; without one it is reapplied should another change land first.
+func (s *DartaService) PatchMetadata(ctx context.Context, id uuid.UUID, patch json.RawMessage, expectedVersion int64, action string) (*db.Darta, error) {
+	userCtx := GetUserContext(ctx)
+
+	for attempt := 1; ; attempt++ {
+		current, err := s.queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: id, TenantID: userCtx.TenantID})
+		if err != nil {
+			return nil, ErrDartaNotFound
+		}
+		if err := CheckVersion(expectedVersion, current.Version); err != nil {
+			return nil, err
+		}
+
+		var classificationCode string
+		if current.ClassificationCode != nil {
+			classificationCode = *current.ClassificationCode
+		}
+		merged, err := ApplyMetadataPatch(ctx, s.queries, userCtx.TenantID, classificationCode, current.Metadata, patch)
+		if err != nil {
+			return nil, err
+		}
+
+		updated, err := s.queries.UpdateDartaMetadata(ctx, db.UpdateDartaMetadataParams{
+			ID:       id,
+			Metadata: merged,
+			Version:  current.Version,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			if attempt == maxMetadataAttempts {
+				return nil, NewDomainError(ErrVersionConflict, "darta metadata kept changing", "")
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to update metadata: %w", err)
+		}
+
+		changes := metadataChanges(patch, current.Version, updated.Version)
+		if err := s.createAuditEntry(ctx, "DARTA", id, action, userCtx, changes); err != nil {
+			return nil, err
+		}
+		return &updated, nil
+	}
+}
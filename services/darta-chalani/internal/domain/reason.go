@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Bounds on the reasons recorded for voids, rejections, supersessions and
+// backdated entries, in characters
+const (
+	minReasonLength = 10
+	maxReasonLength = 1000
+)
+
+// ValidateReason checks a reason the lifecycle requires for an action and
+// returns it trimmed. A reason must say something: a few characters or a
+// placeholder will not do.
+func ValidateReason(field, reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	n := utf8.RuneCountInString(reason)
+	switch {
+	case n == 0:
+		return "", NewValidationError(field, "a reason is required")
+	case n < minReasonLength:
+		return "", NewValidationError(field, fmt.Sprintf("must be at least %d characters", minReasonLength))
+	case n > maxReasonLength:
+		return "", NewValidationError(field, fmt.Sprintf("must be at most %d characters", maxReasonLength))
+	}
+	return reason, nil
+}
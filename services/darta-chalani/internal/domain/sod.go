@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+	"github.com/google/uuid"
+)
+
+// Duties are audit trail actions that segregation-of-duties rules compare
+const (
+	DutyCreate  = "CREATED"
+	DutyReview  = "REVIEWED"
+	DutyApprove = "APPROVED"
+)
+
+// ErrSoDViolation is returned when a user attempts a duty that conflicts with
+// one they already performed on the same record
+var ErrSoDViolation = errors.New("segregation of duties violation")
+
+// SoDRule forbids the user performing Duty from having performed any of
+// Conflicts on the same record
+type SoDRule struct {
+	EntityType string
+	Duty       string
+	Conflicts  []string
+	Message    string
+}
+
+// sodRules are the separation-of-duties rules from the darta and chalani
+// lifecycles
+var sodRules = []SoDRule{
+	{
+		EntityType: "DARTA",
+		Duty:       DutyReview,
+		Conflicts:  []string{DutyCreate},
+		Message:    "reviewer must differ from creator",
+	},
+	{
+		EntityType: "CHALANI",
+		Duty:       DutyApprove,
+		Conflicts:  []string{DutyCreate},
+		Message:    "approver must differ from drafter",
+	},
+}
+
+// EnforceSoD checks the acting user against the audit trail of a record
+// before they perform duty. createdBy is the record's creator, used for
+// records that predate their CREATED audit entry. A violation is recorded in
+// the SOD_VIOLATION audit category and returned as ErrSoDViolation.
+func EnforceSoD(ctx context.Context, queries db.Querier, entityType string, entityID uuid.UUID, createdBy, duty string) error {
+	userCtx := GetUserContext(ctx)
+
+	for _, rule := range sodRules {
+		if rule.EntityType != entityType || rule.Duty != duty {
+			continue
+		}
+
+		violated := createdBy != "" && createdBy == userCtx.UserID && containsString(rule.Conflicts, DutyCreate)
+		if !violated {
+			performed, err := queries.HasPerformedAuditAction(ctx, db.HasPerformedAuditActionParams{
+				EntityType:  entityType,
+				EntityID:    uuidToPgUUID(entityID),
+				PerformedBy: userCtx.UserID,
+				Actions:     rule.Conflicts,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to check audit trail: %w", err)
+			}
+			violated = performed
+		}
+		if !violated {
+			continue
+		}
+
+		changes := map[string]interface{}{
+			"duty":      duty,
+			"conflicts": rule.Conflicts,
+			"rule":      rule.Message,
+		}
+		if err := RecordAudit(ctx, queries, AuditCategorySoDViolation, entityType, entityID, duty, userCtx, changes); err != nil {
+			log.Printf("failed to record SoD violation on %s %s: %v", entityType, entityID, err)
+		}
+		return NewDomainError(ErrSoDViolation, rule.Message, AuditCategorySoDViolation)
+	}
+	return nil
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
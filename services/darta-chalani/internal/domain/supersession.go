@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+)
+
+// maxSupersessionDepth bounds how far a supersession chain is followed
+// either side of a darta
+const maxSupersessionDepth = 100
+
+// Supersede marks a registered darta superseded by its replacement for the
+// given reason. The darta keeps its number and links forward to the
+// replacement; the replacement gets its own number and links back to the
+// darta and the number it replaces.
+func (s *DartaService) Supersede(ctx context.Context, id, replacementID uuid.UUID, reason string, expectedVersion int64) (*db.Darta, error) {
+	userCtx := GetUserContext(ctx)
+
+	reason, err := ValidateReason("reason", reason)
+	if err != nil {
+		return nil, err
+	}
+	if id == replacementID {
+		return nil, NewValidationError("new_darta_id", "a darta cannot supersede itself")
+	}
+
+	current, err := s.queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: id, TenantID: userCtx.TenantID})
+	if err != nil {
+		return nil, ErrDartaNotFound
+	}
+	if err := CheckVersion(expectedVersion, current.Version); err != nil {
+		return nil, err
+	}
+	replacement, err := s.queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: replacementID, TenantID: userCtx.TenantID})
+	if err != nil {
+		return nil, NewValidationError("new_darta_id", "darta not found")
+	}
+
+	// Only a registered darta has a number to supersede, and a darta is
+	// superseded at most once
+	if current.DartaNumber == nil || current.Status == "VOIDED" || current.Status == "SUPERSEDED" {
+		return nil, NewTransitionError(ErrInvalidDartaStatus, current.Status, "SUPERSEDED")
+	}
+	switch {
+	case replacement.Status == "VOIDED" || replacement.Status == "SUPERSEDED":
+		return nil, NewValidationError("new_darta_id", fmt.Sprintf("a %s darta cannot replace another", replacement.Status))
+	case replacement.SupersedesID.Valid:
+		return nil, NewValidationError("new_darta_id", "darta already replaces another darta")
+	}
+
+	updated, err := s.queries.SupersedeDarta(ctx, db.SupersedeDartaParams{
+		ID:            id,
+		ReplacementID: replacementID,
+		TenantID:      userCtx.TenantID,
+		Version:       current.Version,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, NewDomainError(ErrVersionConflict, "darta or its replacement changed while superseding", "")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to supersede darta: %w", err)
+	}
+
+	changes := map[string]interface{}{
+		"status":        map[string]string{"from": current.Status, "to": "SUPERSEDED"},
+		"superseded_by": replacementID.String(),
+	}
+	if err := RecordAuditWithReason(ctx, s.queries, AuditCategoryActivity, "DARTA", id, "SUPERSEDED", userCtx, changes, reason); err != nil {
+		return nil, err
+	}
+	changes = map[string]interface{}{
+		"supersedes": id.String(),
+	}
+	if current.FormattedDartaNumber != nil {
+		changes["supersedes_number"] = *current.FormattedDartaNumber
+	}
+	if err := RecordAuditWithReason(ctx, s.queries, AuditCategoryActivity, "DARTA", replacementID, "SUPERSEDES", userCtx, changes, reason); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// SupersessionChain returns the dartas a darta replaced and was replaced by,
+// itself included, oldest first
+func (s *DartaService) SupersessionChain(ctx context.Context, id uuid.UUID) ([]db.Darta, error) {
+	chain, err := s.queries.GetDartaSupersessionChain(ctx, db.GetDartaSupersessionChainParams{
+		ID:       id,
+		TenantID: GetUserContext(ctx).TenantID,
+		MaxDepth: maxSupersessionDepth,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get supersession chain: %w", err)
+	}
+	if len(chain) == 0 {
+		return nil, ErrDartaNotFound
+	}
+	return chain, nil
+}
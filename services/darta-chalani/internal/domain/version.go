@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+)
+
+// Every change to a darta or chalani is applied only to the version of the
+// record it was decided on, and bumps the version. Requests may name the
+// version their caller last saw; 0 accepts whatever version is current.
+
+// CheckVersion reports a conflict when a request expected a version of a
+// record other than its current one
+func CheckVersion(expected, current int64) error {
+	if expected != 0 && expected != current {
+		return NewVersionConflictError(expected, current)
+	}
+	return nil
+}
+
+// DartaVersionConflict reports that a darta changed after it was read at
+// version, giving the version it is at now
+func DartaVersionConflict(ctx context.Context, queries db.Querier, id uuid.UUID, version int64) error {
+	current, err := queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: id, TenantID: GetUserContext(ctx).TenantID})
+	if err != nil {
+		return ErrDartaNotFound
+	}
+	return NewVersionConflictError(version, current.Version)
+}
+
+// ChalaniVersionConflict reports that a chalani changed after it was read at
+// version, giving the version it is at now
+func ChalaniVersionConflict(ctx context.Context, queries db.Querier, id uuid.UUID, version int64) error {
+	current, err := queries.GetChalaniSimple(ctx, db.GetChalaniSimpleParams{ID: id, TenantID: GetUserContext(ctx).TenantID})
+	if err != nil {
+		return ErrChalaniNotFound
+	}
+	return NewVersionConflictError(version, current.Version)
+}
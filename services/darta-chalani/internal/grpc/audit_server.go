@@ -0,0 +1,235 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/audit"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
+)
+
+// auditRoles may query the whole audit trail, verify its chain and export
+// its anchors
+var auditRoles = []string{"admin", "auditor"}
+
+// auditSorts are the sort orders ListAuditEntries accepts
+var auditSorts = map[string]sortKind{
+	"performed_at": sortByTime,
+}
+
+// maxTimelineEntries bounds the entries of an entity timeline
+const maxTimelineEntries = 500
+
+// AuditServer implements the AuditService gRPC service
+type AuditServer struct {
+	dartav1.UnimplementedAuditServiceServer
+	queries db.Querier
+}
+
+// NewAuditServer creates a new AuditServer
+func NewAuditServer(queries db.Querier) *AuditServer {
+	return &AuditServer{queries: queries}
+}
+
+// ListAuditEntries lists the audit entries of the caller's tenant matching a
+// filter, newest first unless the request sorts otherwise
+func (s *AuditServer) ListAuditEntries(ctx context.Context, req *dartav1.ListAuditEntriesRequest) (*dartav1.ListAuditEntriesResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+	if err := requireAuditor(userCtx); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	f := req.Filter
+	if t := f.GetTenantId(); t != "" && t != userCtx.TenantID {
+		return nil, mapDomainError(ctx, domain.NewDomainError(domain.ErrForbidden, "audit entries of other tenants are not visible", ""))
+	}
+	filter := db.CountAuditEntriesMatchingParams{
+		TenantID:    userCtx.TenantID,
+		EntityType:  stringPtr(f.GetEntityType()),
+		PerformedBy: stringPtr(f.GetPerformedBy()),
+		Actions:     f.GetActions(),
+		Category:    stringPtr(f.GetCategory()),
+		FromTime:    protoToPgTimestamptz(f.GetFrom()),
+		ToTime:      protoToPgTimestamptz(f.GetTo()),
+	}
+	if id := f.GetEntityId(); id != "" {
+		entityID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, invalidArgument("filter.entity_id", "invalid entity ID")
+		}
+		filter.EntityID = pgtype.UUID{Bytes: entityID, Valid: true}
+	}
+	if c := filter.Category; c != nil && *c != domain.AuditCategoryActivity && *c != domain.AuditCategorySoDViolation {
+		return nil, invalidArgument("filter.category", "must be ACTIVITY or SOD_VIOLATION")
+	}
+	if len(filter.Actions) == 0 {
+		filter.Actions = nil
+	}
+
+	page, err := parsePage(req.Pagination, auditSorts, "performed_at", 20)
+	if err != nil {
+		return nil, err
+	}
+	key, err := page.timeKey()
+	if err != nil {
+		return nil, err
+	}
+	arg := db.ListAuditEntriesDescParams{
+		TenantID: filter.TenantID, EntityType: filter.EntityType, EntityID: filter.EntityID,
+		PerformedBy: filter.PerformedBy, Actions: filter.Actions, Category: filter.Category,
+		FromTime: filter.FromTime, ToTime: filter.ToTime,
+		CursorKey: key, CursorID: page.cursorID(), Limit: page.fetchLimit(),
+	}
+	var rows []db.AuditTrail
+	if page.scanDesc() {
+		rows, err = s.queries.ListAuditEntriesDesc(ctx, arg)
+	} else {
+		rows, err = s.queries.ListAuditEntriesAsc(ctx, db.ListAuditEntriesAscParams(arg))
+	}
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to list audit entries: %w", err))
+	}
+	rows, more := trimPage(page, rows)
+
+	total, err := s.queries.CountAuditEntriesMatching(ctx, filter)
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to count audit entries: %w", err))
+	}
+
+	edges := make([]*dartav1.AuditEntryEdge, len(rows))
+	cursors := make([]string, len(rows))
+	for i := range rows {
+		cursors[i] = page.cursorAt(rows[i].ID, rows[i].PerformedAt, 0)
+		edges[i] = &dartav1.AuditEntryEdge{Cursor: cursors[i], Node: toProtoAuditEntry(&rows[i])}
+	}
+	return &dartav1.ListAuditEntriesResponse{
+		Connection: &dartav1.AuditEntryConnection{
+			Edges:    edges,
+			PageInfo: page.pageInfo(cursors, more, total),
+		},
+	}, nil
+}
+
+// GetEntityTimeline returns the activity on a darta or chalani, oldest
+// first. Whoever may read the record may read its timeline; the gateway
+// checks that before asking.
+func (s *AuditServer) GetEntityTimeline(ctx context.Context, req *dartav1.GetEntityTimelineRequest) (*dartav1.GetEntityTimelineResponse, error) {
+	if req.EntityType != "DARTA" && req.EntityType != "CHALANI" {
+		return nil, invalidArgument("entity_type", "must be DARTA or CHALANI")
+	}
+	entityID, err := uuid.Parse(req.EntityId)
+	if err != nil {
+		return nil, invalidArgument("entity_id", "invalid entity ID")
+	}
+
+	rows, err := s.queries.ListEntityTimeline(ctx, db.ListEntityTimelineParams{
+		TenantID:   domain.GetUserContext(ctx).TenantID,
+		EntityType: req.EntityType,
+		EntityID:   pgtype.UUID{Bytes: entityID, Valid: true},
+		LimitCount: maxTimelineEntries + 1,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to get timeline: %w", err))
+	}
+
+	// The extra row fetched is the oldest one
+	resp := &dartav1.GetEntityTimelineResponse{}
+	if len(rows) > maxTimelineEntries {
+		rows, resp.Truncated = rows[1:], true
+	}
+	resp.Entries = make([]*dartav1.AuditEntry, len(rows))
+	for i := range rows {
+		resp.Entries[i] = toProtoAuditEntry(&rows[i])
+	}
+	return resp, nil
+}
+
+// VerifyAuditChain recomputes the caller's tenant audit chain and reports
+// where it was tampered with
+func (s *AuditServer) VerifyAuditChain(ctx context.Context, req *dartav1.VerifyAuditChainRequest) (*dartav1.VerifyAuditChainResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+	if err := requireAuditor(userCtx); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	report, err := audit.Verify(ctx, s.queries, userCtx.TenantID)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	resp := &dartav1.VerifyAuditChainResponse{
+		Intact:            report.Intact(),
+		EntriesVerified:   report.Entries,
+		HeadSeq:           report.HeadSeq,
+		HeadHash:          report.HeadHash,
+		AnchorsVerified:   int32(report.Anchors),
+		Problems:          make([]*dartav1.AuditChainProblem, len(report.Problems)),
+		ProblemsTruncated: report.Truncated,
+	}
+	for i, p := range report.Problems {
+		resp.Problems[i] = &dartav1.AuditChainProblem{
+			Kind:     p.Kind,
+			ChainSeq: p.Seq,
+			EntryId:  p.EntryID,
+			Detail:   p.Detail,
+		}
+	}
+	return resp, nil
+}
+
+// ListAuditAnchors exports the anchors of the caller's tenant chain for
+// notarization
+func (s *AuditServer) ListAuditAnchors(ctx context.Context, req *dartav1.ListAuditAnchorsRequest) (*dartav1.ListAuditAnchorsResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+	if err := requireAuditor(userCtx); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	params := db.ListAuditAnchorsParams{TenantID: userCtx.TenantID}
+	if req.From != nil {
+		params.FromTime = pgtype.Timestamptz{Time: req.From.AsTime(), Valid: true}
+	}
+	if req.To != nil {
+		params.ToTime = pgtype.Timestamptz{Time: req.To.AsTime(), Valid: true}
+	}
+	anchors, err := s.queries.ListAuditAnchors(ctx, params)
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to list audit anchors: %w", err))
+	}
+
+	resp := &dartav1.ListAuditAnchorsResponse{Anchors: make([]*dartav1.AuditAnchor, len(anchors))}
+	for i, a := range anchors {
+		resp.Anchors[i] = &dartav1.AuditAnchor{
+			Id:         a.ID.String(),
+			ChainSeq:   a.ChainSeq,
+			EntryHash:  a.EntryHash,
+			AnchoredAt: timestamppb.New(a.AnchoredAt.Time),
+			Statement:  audit.Statement(a),
+		}
+	}
+	return resp, nil
+}
+
+func requireAuditor(userCtx *domain.UserContext) error {
+	if !hasAnyRole(userCtx, auditRoles...) {
+		return domain.NewDomainError(domain.ErrForbidden, "the audit trail requires the admin or auditor role", "")
+	}
+	return nil
+}
+
+// recordActivity audits an action the caller took on a record
+func recordActivity(ctx context.Context, queries db.Querier, entityType string, entityID uuid.UUID, action string, changes map[string]interface{}) error {
+	return recordActivityWithReason(ctx, queries, entityType, entityID, action, changes, "")
+}
+
+// recordActivityWithReason audits an action the caller took on a record for
+// a stated reason
+func recordActivityWithReason(ctx context.Context, queries db.Querier, entityType string, entityID uuid.UUID, action string, changes map[string]interface{}, reason string) error {
+	return domain.RecordAuditWithReason(ctx, queries, domain.AuditCategoryActivity, entityType, entityID, action, domain.GetUserContext(ctx), changes, reason)
+}
@@ -0,0 +1,318 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
+)
+
+// auditTrail is an in-memory stand-in for the ListAuditEntries and
+// CountAuditEntriesMatching queries, filtering and paging as the SQL does.
+// It keeps the last count filter it was given.
+type auditTrail struct {
+	db.Querier
+
+	rows      []db.AuditTrail
+	lastCount db.CountAuditEntriesMatchingParams
+}
+
+func (a *auditTrail) matches(e db.AuditTrail, f db.CountAuditEntriesMatchingParams) bool {
+	return e.TenantID == f.TenantID &&
+		(f.EntityType == nil || e.EntityType == *f.EntityType) &&
+		(!f.EntityID.Valid || e.EntityID == f.EntityID) &&
+		(f.PerformedBy == nil || e.PerformedBy == *f.PerformedBy) &&
+		(f.Actions == nil || slices.Contains(f.Actions, e.Action)) &&
+		(f.Category == nil || e.Category == *f.Category) &&
+		(!f.FromTime.Valid || !e.PerformedAt.Time.Before(f.FromTime.Time)) &&
+		(!f.ToTime.Valid || e.PerformedAt.Time.Before(f.ToTime.Time))
+}
+
+func (a *auditTrail) scan(arg db.ListAuditEntriesDescParams, desc bool) []db.AuditTrail {
+	f := db.CountAuditEntriesMatchingParams{
+		TenantID: arg.TenantID, EntityType: arg.EntityType, EntityID: arg.EntityID,
+		PerformedBy: arg.PerformedBy, Actions: arg.Actions, Category: arg.Category,
+		FromTime: arg.FromTime, ToTime: arg.ToTime,
+	}
+	var out []db.AuditTrail
+	for _, e := range a.rows {
+		c := compareKey(e.PerformedAt, e.ID, arg.CursorKey, arg.CursorID)
+		if a.matches(e, f) && ((desc && c < 0) || (!desc && c > 0)) {
+			out = append(out, e)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		c := compareKey(out[i].PerformedAt, out[i].ID, out[j].PerformedAt, pgtype.UUID{Bytes: out[j].ID, Valid: true})
+		return (c < 0) != desc
+	})
+	if int32(len(out)) > arg.Limit {
+		out = out[:arg.Limit]
+	}
+	return out
+}
+
+func (a *auditTrail) ListAuditEntriesDesc(ctx context.Context, arg db.ListAuditEntriesDescParams) ([]db.AuditTrail, error) {
+	return a.scan(arg, true), nil
+}
+
+func (a *auditTrail) ListAuditEntriesAsc(ctx context.Context, arg db.ListAuditEntriesAscParams) ([]db.AuditTrail, error) {
+	return a.scan(db.ListAuditEntriesDescParams(arg), false), nil
+}
+
+func (a *auditTrail) CountAuditEntriesMatching(ctx context.Context, arg db.CountAuditEntriesMatchingParams) (int64, error) {
+	a.lastCount = arg
+	var n int64
+	for _, e := range a.rows {
+		if a.matches(e, arg) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+var auditStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newAuditTrail returns a trail of n entries on one darta of tenant t1, a
+// minute apart and alternating between two users, plus one entry of t2.
+// Entries are returned oldest first.
+func newAuditTrail(n int) (*auditTrail, uuid.UUID) {
+	a := &auditTrail{}
+	dartaID := uuid.New()
+	for i := 0; i < n; i++ {
+		e := db.AuditTrail{
+			ID:          uuid.New(),
+			TenantID:    "t1",
+			EntityType:  "DARTA",
+			EntityID:    pgtype.UUID{Bytes: dartaID, Valid: true},
+			Action:      "STATUS_CHANGED",
+			PerformedBy: "user-a",
+			PerformedAt: pgtype.Timestamptz{Time: auditStart.Add(time.Duration(i) * time.Minute), Valid: true},
+			Category:    domain.AuditCategoryActivity,
+		}
+		if i%2 == 1 {
+			e.PerformedBy = "user-b"
+		}
+		if i == 0 {
+			e.Action = "CREATED"
+		}
+		a.rows = append(a.rows, e)
+	}
+	a.rows = append(a.rows, db.AuditTrail{
+		ID:          uuid.New(),
+		TenantID:    "t2",
+		EntityType:  "DARTA",
+		Action:      "CREATED",
+		PerformedBy: "user-a",
+		PerformedAt: pgtype.Timestamptz{Time: auditStart, Valid: true},
+		Category:    domain.AuditCategoryActivity,
+	})
+	return a, dartaID
+}
+
+func auditorContext(roles ...string) context.Context {
+	return domain.WithUserContext(context.Background(), &domain.UserContext{
+		TenantID: "t1",
+		UserID:   "auditor-1",
+		Roles:    roles,
+	})
+}
+
+func auditEntryIDs(t *testing.T, resp *dartav1.ListAuditEntriesResponse) []uuid.UUID {
+	t.Helper()
+	out := make([]uuid.UUID, len(resp.Connection.Edges))
+	for i, e := range resp.Connection.Edges {
+		out[i] = uuid.MustParse(e.Node.Id)
+	}
+	return out
+}
+
+func TestListAuditEntriesRejectsBadRequests(t *testing.T) {
+	store, _ := newAuditTrail(3)
+	s := NewAuditServer(store)
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		req   *dartav1.ListAuditEntriesRequest
+		code  codes.Code
+		field string
+	}{
+		{
+			name: "not an auditor",
+			ctx:  auditorContext("darta_registrar"),
+			req:  &dartav1.ListAuditEntriesRequest{},
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "other tenant",
+			ctx:  auditorContext("auditor"),
+			req:  &dartav1.ListAuditEntriesRequest{Filter: &dartav1.AuditEntryFilter{TenantId: "t2"}},
+			code: codes.PermissionDenied,
+		},
+		{
+			name:  "bad entity ID",
+			ctx:   auditorContext("auditor"),
+			req:   &dartav1.ListAuditEntriesRequest{Filter: &dartav1.AuditEntryFilter{EntityId: "not-a-uuid"}},
+			code:  codes.InvalidArgument,
+			field: "filter.entity_id",
+		},
+		{
+			name:  "unknown category",
+			ctx:   auditorContext("auditor"),
+			req:   &dartav1.ListAuditEntriesRequest{Filter: &dartav1.AuditEntryFilter{Category: "SECURITY"}},
+			code:  codes.InvalidArgument,
+			field: "filter.category",
+		},
+		{
+			name: "offset paging",
+			ctx:  auditorContext("auditor"),
+			req:  &dartav1.ListAuditEntriesRequest{Pagination: &dartav1.PaginationInput{Offset: 10}},
+			code: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.ListAuditEntries(tt.ctx, tt.req)
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("code = %v, want %v (%v)", got, tt.code, err)
+			}
+			if tt.field != "" {
+				if got := errorField(t, err); got != tt.field {
+					t.Errorf("field = %q, want %q", got, tt.field)
+				}
+			}
+		})
+	}
+}
+
+func TestListAuditEntriesFilters(t *testing.T) {
+	store, dartaID := newAuditTrail(6)
+	s := NewAuditServer(store)
+	ctx := auditorContext("auditor")
+
+	all := make([]uuid.UUID, 6)
+	for i := range all {
+		all[i] = store.rows[5-i].ID
+	}
+
+	tests := []struct {
+		name   string
+		filter *dartav1.AuditEntryFilter
+		want   []uuid.UUID
+	}{
+		{"no filter lists the caller's tenant only", nil, all},
+		{"own tenant", &dartav1.AuditEntryFilter{TenantId: "t1"}, all},
+		{"empty actions match any", &dartav1.AuditEntryFilter{Actions: []string{}}, all},
+		{"entity", &dartav1.AuditEntryFilter{EntityType: "DARTA", EntityId: dartaID.String()}, all},
+		{"other entity", &dartav1.AuditEntryFilter{EntityId: uuid.NewString()}, nil},
+		{"performer", &dartav1.AuditEntryFilter{PerformedBy: "user-b"}, []uuid.UUID{all[0], all[2], all[4]}},
+		{"actions", &dartav1.AuditEntryFilter{Actions: []string{"CREATED", "SIGNED"}}, []uuid.UUID{all[5]}},
+		{"category", &dartav1.AuditEntryFilter{Category: domain.AuditCategorySoDViolation}, nil},
+		{
+			// from is inclusive and to exclusive
+			"time range",
+			&dartav1.AuditEntryFilter{
+				From: timestamppb.New(auditStart.Add(time.Minute)),
+				To:   timestamppb.New(auditStart.Add(3 * time.Minute)),
+			},
+			[]uuid.UUID{all[3], all[4]},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.ListAuditEntries(ctx, &dartav1.ListAuditEntriesRequest{Filter: tt.filter})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := auditEntryIDs(t, resp); !slices.Equal(got, tt.want) {
+				t.Errorf("listed %v, want %v", got, tt.want)
+			}
+			if got := resp.Connection.PageInfo.TotalCount; got != int64(len(tt.want)) {
+				t.Errorf("total = %d, want %d", got, len(tt.want))
+			}
+			if store.lastCount.TenantID != "t1" {
+				t.Errorf("counted tenant %q, want t1", store.lastCount.TenantID)
+			}
+			if a := store.lastCount.Actions; a != nil && len(a) == 0 {
+				t.Error("empty actions were passed on instead of nil")
+			}
+		})
+	}
+}
+
+func TestListAuditEntriesCursors(t *testing.T) {
+	store, _ := newAuditTrail(7)
+	s := NewAuditServer(store)
+	ctx := auditorContext("admin")
+
+	for _, desc := range []bool{true, false} {
+		var want []uuid.UUID
+		for _, e := range store.rows[:7] {
+			want = append(want, e.ID)
+		}
+		if desc {
+			slices.Reverse(want)
+		}
+
+		pagination := func(after, before string) *dartav1.PaginationInput {
+			return &dartav1.PaginationInput{Limit: 3, SortBy: "performed_at", SortDesc: desc, After: after, Before: before}
+		}
+		list := func(in *dartav1.PaginationInput) *dartav1.ListAuditEntriesResponse {
+			resp, err := s.ListAuditEntries(ctx, &dartav1.ListAuditEntriesRequest{Pagination: in})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return resp
+		}
+
+		var forward []uuid.UUID
+		var pages []*dartav1.PageInfo
+		in := pagination("", "")
+		for {
+			resp := list(in)
+			forward = append(forward, auditEntryIDs(t, resp)...)
+			info := resp.Connection.PageInfo
+			pages = append(pages, info)
+			if !info.HasNextPage {
+				break
+			}
+			in = pagination(info.EndCursor, "")
+		}
+		if !slices.Equal(forward, want) {
+			t.Fatalf("desc=%v: forward listed %v, want %v", desc, forward, want)
+		}
+		if len(pages) != 3 {
+			t.Fatalf("desc=%v: %d pages, want 3", desc, len(pages))
+		}
+		if pages[0].HasPreviousPage || !pages[1].HasPreviousPage || !pages[2].HasPreviousPage {
+			t.Errorf("desc=%v: wrong HasPreviousPage going forward", desc)
+		}
+
+		var backward []uuid.UUID
+		for info := pages[2]; ; {
+			resp := list(pagination("", info.StartCursor))
+			backward = append(auditEntryIDs(t, resp), backward...)
+			info = resp.Connection.PageInfo
+			if !info.HasNextPage {
+				t.Errorf("desc=%v: a page before another reported no next page", desc)
+			}
+			if !info.HasPreviousPage {
+				break
+			}
+		}
+		if want := want[:6]; !slices.Equal(backward, want) {
+			t.Fatalf("desc=%v: backward listed %v, want %v", desc, backward, want)
+		}
+	}
+}
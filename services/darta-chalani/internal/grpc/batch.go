@@ -0,0 +1,342 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/audit"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
+)
+
+// maxBatchSize bounds the IDs accepted by a single batch call
+const maxBatchSize = 500
+
+// defaultAuditEntriesPerEntity is used when a batch audit call gives no limit
+const defaultAuditEntriesPerEntity = 20
+
+// parseBatchIDs validates and de-duplicates the IDs of a batch request
+func parseBatchIDs(field string, ids []string) ([]pgtype.UUID, error) {
+	if len(ids) > maxBatchSize {
+		return nil, invalidArgument(field, fmt.Sprintf("at most %d IDs per call", maxBatchSize))
+	}
+
+	seen := make(map[uuid.UUID]bool, len(ids))
+	parsed := make([]pgtype.UUID, 0, len(ids))
+	for _, raw := range ids {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, invalidArgument(field, fmt.Sprintf("invalid ID: %s", raw))
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		parsed = append(parsed, pgtype.UUID{Bytes: id, Valid: true})
+	}
+	return parsed, nil
+}
+
+func pgUUIDString(u pgtype.UUID) string {
+	if !u.Valid {
+		return ""
+	}
+	return uuid.UUID(u.Bytes).String()
+}
+
+// BatchGetDartas returns the dartas with the given IDs in the caller's tenant
+func (s *DartaServer) BatchGetDartas(ctx context.Context, req *dartav1.BatchGetDartasRequest) (*dartav1.BatchGetDartasResponse, error) {
+	ids, err := parseBatchIDs("ids", req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return &dartav1.BatchGetDartasResponse{}, nil
+	}
+
+	rows, err := s.queries.GetDartasByIDs(ctx, db.GetDartasByIDsParams{
+		Ids:      ids,
+		TenantID: domain.GetUserContext(ctx).TenantID,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to batch get dartas: %w", err))
+	}
+
+	dartas := make([]*dartav1.Darta, len(rows))
+	for i := range rows {
+		dartas[i] = toProtoDarta(&rows[i])
+	}
+	return &dartav1.BatchGetDartasResponse{Dartas: dartas}, nil
+}
+
+// BatchGetApplicants returns the applicants with the given IDs that appear on
+// a darta in the caller's tenant
+func (s *DartaServer) BatchGetApplicants(ctx context.Context, req *dartav1.BatchGetApplicantsRequest) (*dartav1.BatchGetApplicantsResponse, error) {
+	ids, err := parseBatchIDs("ids", req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return &dartav1.BatchGetApplicantsResponse{}, nil
+	}
+
+	rows, err := s.queries.GetApplicantsByIDs(ctx, db.GetApplicantsByIDsParams{
+		Ids:      ids,
+		TenantID: domain.GetUserContext(ctx).TenantID,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to batch get applicants: %w", err))
+	}
+
+	applicants := make([]*dartav1.Applicant, len(rows))
+	for i := range rows {
+		applicants[i] = toProtoApplicant(&rows[i])
+	}
+	return &dartav1.BatchGetApplicantsResponse{Applicants: applicants}, nil
+}
+
+// BatchGetAttachments returns the attachments with the given IDs in the
+// caller's tenant
+func (s *DartaServer) BatchGetAttachments(ctx context.Context, req *dartav1.BatchGetAttachmentsRequest) (*dartav1.BatchGetAttachmentsResponse, error) {
+	ids, err := parseBatchIDs("ids", req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return &dartav1.BatchGetAttachmentsResponse{}, nil
+	}
+
+	rows, err := s.queries.GetTenantAttachmentsByIDs(ctx, db.GetTenantAttachmentsByIDsParams{
+		Ids:      ids,
+		TenantID: domain.GetUserContext(ctx).TenantID,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to batch get attachments: %w", err))
+	}
+
+	attachments := make([]*dartav1.Attachment, len(rows))
+	for i := range rows {
+		attachments[i] = toProtoAttachment(&rows[i])
+	}
+	return &dartav1.BatchGetAttachmentsResponse{Attachments: attachments}, nil
+}
+
+// BatchGetDartaLinks returns the annex and related darta IDs of each darta
+func (s *DartaServer) BatchGetDartaLinks(ctx context.Context, req *dartav1.BatchGetDartaLinksRequest) (*dartav1.BatchGetDartaLinksResponse, error) {
+	ids, err := parseBatchIDs("darta_ids", req.DartaIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return &dartav1.BatchGetDartaLinksResponse{}, nil
+	}
+	tenantID := domain.GetUserContext(ctx).TenantID
+
+	annexes, err := s.queries.ListDartaAnnexIDs(ctx, db.ListDartaAnnexIDsParams{
+		DartaIds: ids,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to list darta annexes: %w", err))
+	}
+	relations, err := s.queries.ListDartaRelationships(ctx, db.ListDartaRelationshipsParams{
+		DartaIds: ids,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to list darta relationships: %w", err))
+	}
+
+	byDarta := make(map[string]*dartav1.DartaLinks, len(ids))
+	links := make([]*dartav1.DartaLinks, 0, len(ids))
+	linksFor := func(id string) *dartav1.DartaLinks {
+		l, ok := byDarta[id]
+		if !ok {
+			l = &dartav1.DartaLinks{DartaId: id}
+			byDarta[id] = l
+			links = append(links, l)
+		}
+		return l
+	}
+	for _, a := range annexes {
+		l := linksFor(pgUUIDString(a.DartaID))
+		l.AnnexIds = append(l.AnnexIds, pgUUIDString(a.AttachmentID))
+	}
+	for _, r := range relations {
+		l := linksFor(pgUUIDString(r.DartaID))
+		l.Relations = append(l.Relations, &dartav1.DartaRelation{
+			RelatedDartaId:   pgUUIDString(r.RelatedDartaID),
+			RelationshipType: r.RelationshipType,
+		})
+	}
+
+	return &dartav1.BatchGetDartaLinksResponse{Links: links}, nil
+}
+
+// BatchGetAuditTrails returns the most recent activity entries of each entity
+func (s *DartaServer) BatchGetAuditTrails(ctx context.Context, req *dartav1.BatchGetAuditTrailsRequest) (*dartav1.BatchGetAuditTrailsResponse, error) {
+	if req.EntityType != "DARTA" && req.EntityType != "CHALANI" {
+		return nil, invalidArgument("entity_type", "must be DARTA or CHALANI")
+	}
+	ids, err := parseBatchIDs("entity_ids", req.EntityIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return &dartav1.BatchGetAuditTrailsResponse{}, nil
+	}
+	limit := req.LimitPerEntity
+	if limit <= 0 {
+		limit = defaultAuditEntriesPerEntity
+	}
+
+	rows, err := s.queries.ListRecentAuditEntriesForEntities(ctx, db.ListRecentAuditEntriesForEntitiesParams{
+		EntityType: req.EntityType,
+		TenantID:   domain.GetUserContext(ctx).TenantID,
+		EntityIds:  ids,
+		PerEntity:  limit,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to batch get audit trails: %w", err))
+	}
+
+	entries := make([]*dartav1.AuditEntry, len(rows))
+	for i := range rows {
+		entries[i] = toProtoAuditEntry(&rows[i])
+	}
+	return &dartav1.BatchGetAuditTrailsResponse{Entries: entries}, nil
+}
+
+func toProtoApplicant(a *db.Applicant) *dartav1.Applicant {
+	applicant := &dartav1.Applicant{
+		Id:       a.ID.String(),
+		Type:     stringToApplicantType(a.Type),
+		FullName: a.FullName,
+	}
+	if a.Organization != nil {
+		applicant.Organization = *a.Organization
+	}
+	if a.Email != nil {
+		applicant.Email = *a.Email
+	}
+	if a.Phone != nil {
+		applicant.Phone = *a.Phone
+	}
+	if a.Address != nil {
+		applicant.Address = *a.Address
+	}
+	if a.IdentificationNumber != nil {
+		applicant.IdentificationNumber = *a.IdentificationNumber
+	}
+	return applicant
+}
+
+func toProtoAttachment(a *db.Attachment) *dartav1.Attachment {
+	return &dartav1.Attachment{
+		Id:               a.ID.String(),
+		Filename:         a.Filename,
+		OriginalFilename: a.OriginalFilename,
+		MimeType:         a.MimeType,
+		SizeBytes:        a.SizeBytes,
+		StoragePath:      a.StoragePath,
+		Checksum:         a.Checksum,
+		UploadedBy:       a.UploadedBy,
+		UploadedAt:       pgTimestamptzToProto(a.UploadedAt),
+		Metadata:         jsonToStruct(a.Metadata),
+	}
+}
+
+func toProtoAuditEntry(e *db.AuditTrail) *dartav1.AuditEntry {
+	entry := &dartav1.AuditEntry{
+		Id:          e.ID.String(),
+		EntityType:  e.EntityType,
+		EntityId:    pgUUIDString(e.EntityID),
+		Action:      e.Action,
+		PerformedBy: e.PerformedBy,
+		PerformedAt: pgTimestamptzToProto(e.PerformedAt),
+		Changes:     jsonToStruct(e.Changes),
+	}
+	for _, c := range audit.Diff(e.Changes) {
+		entry.Diff = append(entry.Diff, &dartav1.AuditFieldChange{
+			Field:  c.Field,
+			Before: jsonToValue(c.Before),
+			After:  jsonToValue(c.After),
+		})
+	}
+	if e.IpAddress != nil {
+		entry.IpAddress = *e.IpAddress
+	}
+	if e.UserAgent != nil {
+		entry.UserAgent = *e.UserAgent
+	}
+	if e.Notes != nil {
+		entry.Notes = *e.Notes
+	}
+	if e.RequestID != nil {
+		entry.RequestId = *e.RequestID
+	}
+	if e.TraceID != nil {
+		entry.TraceId = *e.TraceID
+	}
+	if e.ActingRole != nil {
+		entry.ActingRole = *e.ActingRole
+	}
+	if e.DecisionID != nil {
+		entry.DecisionId = *e.DecisionID
+	}
+	return entry
+}
+
+// jsonToStruct converts a JSONB object column to a Struct, ignoring values
+// that are not objects
+func jsonToStruct(raw json.RawMessage) *structpb.Struct {
+	if len(raw) == 0 {
+		return nil
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(raw, &m); err != nil || m == nil {
+		return nil
+	}
+	s, err := structpb.NewStruct(m)
+	if err != nil {
+		return nil
+	}
+	return s
+}
+
+// jsonToValue converts a decoded JSON value to a Value, leaving nil unset
+func jsonToValue(v interface{}) *structpb.Value {
+	if v == nil {
+		return nil
+	}
+	value, err := structpb.NewValue(v)
+	if err != nil {
+		return nil
+	}
+	return value
+}
+
+// recentAuditTrail returns an entity's most recent activity entries, newest
+// first
+func recentAuditTrail(ctx context.Context, queries db.Querier, entityType string, id uuid.UUID) ([]*dartav1.AuditEntry, error) {
+	rows, err := queries.ListRecentAuditEntriesForEntities(ctx, db.ListRecentAuditEntriesForEntitiesParams{
+		EntityType: entityType,
+		TenantID:   domain.GetUserContext(ctx).TenantID,
+		EntityIds:  []pgtype.UUID{{Bytes: id, Valid: true}},
+		PerEntity:  defaultAuditEntriesPerEntity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit trail: %w", err)
+	}
+	entries := make([]*dartav1.AuditEntry, len(rows))
+	for i := range rows {
+		entries[i] = toProtoAuditEntry(&rows[i])
+	}
+	return entries, nil
+}
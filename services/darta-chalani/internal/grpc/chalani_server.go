@@ -2,12 +2,16 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	chalaniv1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
 	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
 	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -17,12 +21,14 @@ import (
 type ChalaniServer struct {
 	chalaniv1.UnimplementedChalaniServiceServer
 	queries db.Querier
+	events  *EventHub
 }
 
 // NewChalaniServer creates a new ChalaniServer instance
-func NewChalaniServer(queries db.Querier) *ChalaniServer {
+func NewChalaniServer(queries db.Querier, events *EventHub) *ChalaniServer {
 	return &ChalaniServer{
 		queries: queries,
+		events:  events,
 	}
 }
 
@@ -30,16 +36,35 @@ func NewChalaniServer(queries db.Querier) *ChalaniServer {
 func (s *ChalaniServer) CreateChalani(ctx context.Context, req *chalaniv1.CreateChalaniRequest) (*chalaniv1.CreateChalaniResponse, error) {
 	userCtx := domain.GetUserContext(ctx)
 
+	if req.Input == nil {
+		return nil, invalidArgument("input", "input is required")
+	}
+
+	// A retry after the stored response expired still returns the chalani
+	// created first
+	if req.Input.IdempotencyKey != "" {
+		existing, err := s.queries.GetChalaniByIdempotencyKey(ctx, db.GetChalaniByIdempotencyKeyParams{
+			IdempotencyKey: sqlNullString(req.Input.IdempotencyKey),
+			TenantID:       userCtx.TenantID,
+		})
+		if err == nil {
+			return &chalaniv1.CreateChalaniResponse{Chalani: toProtoChalani(&existing)}, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, mapDomainError(ctx, err)
+		}
+	}
+
 	// Parse recipient
 	recipientID, err := parseRecipientInput(ctx, s.queries, req.Input.Recipient)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient: %v", err)
+		return nil, mapDomainError(ctx, err)
 	}
 
-	// Get fiscal year ID from tenant ID (placeholder - adjust as needed)
-	fiscalYearID := req.Input.TenantId
-	if fiscalYearID == "" {
-		fiscalYearID = userCtx.TenantID
+	// Chalanis belong to the fiscal year in which they are drafted
+	fiscalYearID, err := domain.FiscalYearIDFor(time.Now())
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to determine fiscal year: %w", err))
 	}
 
 	// Create chalani
@@ -59,7 +84,11 @@ func (s *ChalaniServer) CreateChalani(ctx context.Context, req *chalaniv1.Create
 		Metadata:       []byte("{}"),
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create chalani: %v", err)
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to create chalani: %w", err))
+	}
+
+	if err := recordActivity(ctx, s.queries, "CHALANI", chalani.ID, domain.DutyCreate, nil); err != nil {
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &chalaniv1.CreateChalaniResponse{
@@ -71,109 +100,211 @@ func (s *ChalaniServer) CreateChalani(ctx context.Context, req *chalaniv1.Create
 func (s *ChalaniServer) GetChalani(ctx context.Context, req *chalaniv1.GetChalaniRequest) (*chalaniv1.GetChalaniResponse, error) {
 	chalaniID, err := uuid.Parse(req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid chalani ID: %v", err)
+		return nil, invalidArgument("id", "invalid chalani ID")
 	}
 
-	chalani, err := s.queries.GetChalaniSimple(ctx, chalaniID)
+	chalani, err := s.queries.GetChalaniSimple(ctx, db.GetChalaniSimpleParams{ID: chalaniID, TenantID: domain.GetUserContext(ctx).TenantID})
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "chalani not found: %v", err)
+		return nil, notFound(ctx, err, domain.ErrChalaniNotFound)
 	}
 
-	return &chalaniv1.GetChalaniResponse{
-		Chalani: toProtoChalani(&chalani),
-	}, nil
+	resp := toProtoChalani(&chalani)
+	if resp.AuditTrail, err = recentAuditTrail(ctx, s.queries, "CHALANI", chalaniID); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return &chalaniv1.GetChalaniResponse{Chalani: resp}, nil
+}
+
+// chalaniSorts are the sort orders ListChalanis and GetMyChalani accept
+var chalaniSorts = map[string]sortKind{
+	"created_at":     sortByTime,
+	"chalani_number": sortByNumber,
 }
 
-// ListChalanis lists chalanis with filtering and pagination
+// ListChalanis lists chalanis with filtering and keyset pagination
 func (s *ChalaniServer) ListChalanis(ctx context.Context, req *chalaniv1.ListChalanisRequest) (*chalaniv1.ListChalanisResponse, error) {
 	userCtx := domain.GetUserContext(ctx)
 
-	limit := int32(20)
-	offset := int32(0)
-	if req.Pagination != nil {
-		if req.Pagination.Limit > 0 {
-			limit = req.Pagination.Limit
+	page, err := parsePage(req.Pagination, chalaniSorts, "created_at", 20)
+	if err != nil {
+		return nil, err
+	}
+
+	f := req.Filter
+	filter := db.CountChalanisParams{
+		FiscalYearID:    sqlNullString(domain.NormalizeFiscalYearID(f.GetFiscalYearId())),
+		Scope:           enumFilter(f.GetScope().String(), "SCOPE_"),
+		WardID:          sqlNullString(f.GetWardId()),
+		Status:          enumFilter(f.GetStatus().String(), "CHALANI_STATUS_"),
+		DispatchChannel: enumFilter(f.GetDispatchChannel().String(), "DISPATCH_CHANNEL_"),
+		FromDate:        protoToPgTimestamptz(f.GetFromDate()),
+		ToDate:          protoToPgTimestamptz(f.GetToDate()),
+		Search:          sqlNullString(f.GetSearch()),
+		TenantID:        userCtx.TenantID,
+	}
+	if id := f.GetLinkedDartaId(); id != "" {
+		linked, err := uuid.Parse(id)
+		if err != nil {
+			return nil, invalidArgument("filter.linked_darta_id", "invalid darta ID")
 		}
-		offset = req.Pagination.Offset
+		filter.LinkedDartaID = pgtype.UUID{Bytes: linked, Valid: true}
 	}
 
-	chalanis, err := s.queries.ListChalanis(ctx, db.ListChalanisParams{
-		FiscalYearID:    sqlNullString(req.Filter.GetFiscalYearId()),
-		Scope:           sqlNullString(req.Filter.GetScope().String()),
-		WardID:          sqlNullString(req.Filter.GetWardId()),
-		Status:          sqlNullString(req.Filter.GetStatus().String()),
-		DispatchChannel: sqlNullString(req.Filter.GetDispatchChannel().String()),
-		TenantID:        userCtx.TenantID,
-		Limit:           limit,
-		Offset:          offset,
-	})
+	conn, err := s.listChalaniPage(ctx, filter, page)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list chalanis: %v", err)
-	}
-
-	// Convert to proto
-	edges := make([]*chalaniv1.ChalaniEdge, len(chalanis))
-	for i, c := range chalanis {
-		chalaniProto := &chalaniv1.Chalani{
-			Id:              c.ID.String(),
-			FiscalYear:      &chalaniv1.FiscalYear{Id: c.FiscalYearID},
-			Scope:           stringToScope(c.Scope),
-			Subject:         c.Subject,
-			Status:          stringToChalaniStatus(c.Status),
-			CreatedAt:       timestamppb.New(c.CreatedAt.Time),
-			UpdatedAt:       timestamppb.New(c.UpdatedAt.Time),
-		}
-		edges[i] = &chalaniv1.ChalaniEdge{
-			Cursor: fmt.Sprintf("%d", offset+int32(i)),
-			Node:   chalaniProto,
+		return nil, err
+	}
+	return &chalaniv1.ListChalanisResponse{Connection: conn}, nil
+}
+
+// listChalaniPage fetches one keyset page of the chalanis matching filter,
+// with the total number of matches
+func (s *ChalaniServer) listChalaniPage(ctx context.Context, filter db.CountChalanisParams, page pageRequest) (*chalaniv1.ChalaniConnection, error) {
+	rows, err := s.queryChalaniPage(ctx, filter, page)
+	if err != nil {
+		return nil, err
+	}
+	rows, more := trimPage(page, rows)
+
+	total, err := s.queries.CountChalanis(ctx, filter)
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to count chalanis: %w", err))
+	}
+
+	edges := make([]*chalaniv1.ChalaniEdge, len(rows))
+	cursors := make([]string, len(rows))
+	for i := range rows {
+		c := &rows[i]
+		number := int32(0)
+		if c.ChalaniNumber != nil {
+			number = *c.ChalaniNumber
 		}
+		cursors[i] = page.cursorAt(c.ID, c.CreatedAt, number)
+		edges[i] = &chalaniv1.ChalaniEdge{Cursor: cursors[i], Node: toProtoChalani(c)}
 	}
 
-	return &chalaniv1.ListChalanisResponse{
-		Connection: &chalaniv1.ChalaniConnection{
-			Edges: edges,
-			PageInfo: &chalaniv1.PageInfo{
-				TotalCount:  int64(len(chalanis)),
-				HasNextPage: int32(len(chalanis)) >= limit,
-			},
-		},
+	return &chalaniv1.ChalaniConnection{
+		Edges:    edges,
+		PageInfo: page.pageInfo(cursors, more, total),
 	}, nil
 }
 
+// queryChalaniPage runs the ListChalanisBy* query for the page's sort order
+// and scan direction
+func (s *ChalaniServer) queryChalaniPage(ctx context.Context, f db.CountChalanisParams, page pageRequest) ([]db.Chalani, error) {
+	id := page.cursorID()
+	var rows []db.Chalani
+	var err error
+
+	if page.kind == sortByNumber {
+		var key int32
+		if key, err = page.numberKey(); err != nil {
+			return nil, err
+		}
+		arg := db.ListChalanisByChalaniNumberDescParams{
+			FiscalYearID: f.FiscalYearID, Scope: f.Scope, WardID: f.WardID, Status: f.Status,
+			DispatchChannel: f.DispatchChannel, LinkedDartaID: f.LinkedDartaID, CreatedBy: f.CreatedBy,
+			FromDate: f.FromDate, ToDate: f.ToDate, Search: f.Search, TenantID: f.TenantID,
+			CursorKey: key, CursorID: id, Limit: page.fetchLimit(),
+		}
+		if page.scanDesc() {
+			rows, err = s.queries.ListChalanisByChalaniNumberDesc(ctx, arg)
+		} else {
+			rows, err = s.queries.ListChalanisByChalaniNumberAsc(ctx, db.ListChalanisByChalaniNumberAscParams(arg))
+		}
+	} else {
+		var key pgtype.Timestamptz
+		if key, err = page.timeKey(); err != nil {
+			return nil, err
+		}
+		arg := db.ListChalanisByCreatedAtDescParams{
+			FiscalYearID: f.FiscalYearID, Scope: f.Scope, WardID: f.WardID, Status: f.Status,
+			DispatchChannel: f.DispatchChannel, LinkedDartaID: f.LinkedDartaID, CreatedBy: f.CreatedBy,
+			FromDate: f.FromDate, ToDate: f.ToDate, Search: f.Search, TenantID: f.TenantID,
+			CursorKey: key, CursorID: id, Limit: page.fetchLimit(),
+		}
+		if page.scanDesc() {
+			rows, err = s.queries.ListChalanisByCreatedAtDesc(ctx, arg)
+		} else {
+			rows, err = s.queries.ListChalanisByCreatedAtAsc(ctx, db.ListChalanisByCreatedAtAscParams(arg))
+		}
+	}
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to list chalanis: %w", err))
+	}
+	return rows, nil
+}
+
 // SubmitChalani submits chalani for review
 func (s *ChalaniServer) SubmitChalani(ctx context.Context, req *chalaniv1.SubmitChalaniRequest) (*chalaniv1.SubmitChalaniResponse, error) {
 	chalaniID, err := uuid.Parse(req.ChalaniId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid chalani ID: %v", err)
+		return nil, invalidArgument("chalani_id", "invalid chalani ID")
 	}
 
-	updated, err := s.queries.UpdateChalaniStatus(ctx, db.UpdateChalaniStatusParams{
-		ID:     chalaniID,
-		Status: "PENDING_REVIEW",
-	})
+	updated, err := s.setStatus(ctx, chalaniID, "PENDING_REVIEW", "", req.ExpectedVersion)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update status: %v", err)
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &chalaniv1.SubmitChalaniResponse{
-		Chalani: toProtoChalani(&updated),
+		Chalani: toProtoChalani(updated),
 	}, nil
 }
 
-// ApproveChalani approves a chalani
+// ApproveChalani approves a chalani, or rejects it back to draft
 func (s *ChalaniServer) ApproveChalani(ctx context.Context, req *chalaniv1.ApproveChalaniRequest) (*chalaniv1.ApproveChalaniResponse, error) {
 	chalaniID, err := uuid.Parse(req.Input.ChalaniId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid chalani ID: %v", err)
+		return nil, invalidArgument("chalani_id", "invalid chalani ID")
 	}
 
-	// Update status
-	updated, err := s.queries.UpdateChalaniStatus(ctx, db.UpdateChalaniStatusParams{
-		ID:     chalaniID,
-		Status: "APPROVED",
+	current, err := s.queries.GetChalaniSimple(ctx, db.GetChalaniSimpleParams{ID: chalaniID, TenantID: domain.GetUserContext(ctx).TenantID})
+	if err != nil {
+		return nil, notFound(ctx, err, domain.ErrChalaniNotFound)
+	}
+	if err := domain.CheckVersion(req.Input.ExpectedVersion, current.Version); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	// A chalani may not be approved by its drafter
+	if err := domain.EnforceSoD(ctx, s.queries, "CHALANI", chalaniID, current.CreatedBy, domain.DutyApprove); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	// A rejected chalani goes back to its drafter, who is told why
+	newStatus, action, reason := "APPROVED", domain.DutyApprove, req.Input.Notes
+	if req.Input.Decision == chalaniv1.ApprovalDecision_APPROVAL_DECISION_REJECTED {
+		if reason, err = domain.ValidateReason("notes", reason); err != nil {
+			return nil, mapDomainError(ctx, err)
+		}
+		newStatus, action = "DRAFT", "REJECTED"
+	}
+
+	// The decision and its audit entry commit together
+	changes := map[string]interface{}{
+		"decision": req.Input.Decision.String(),
+		"status":   map[string]string{"from": current.Status, "to": newStatus},
+	}
+	var updated db.Chalani
+	err = db.InTx(ctx, s.queries, func(q db.Querier) error {
+		var err error
+		updated, err = q.UpdateChalaniStatus(ctx, db.UpdateChalaniStatusParams{
+			ID:       chalaniID,
+			Status:   newStatus,
+			TenantID: current.TenantID,
+			Version:  current.Version,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ChalaniVersionConflict(ctx, q, chalaniID, current.Version)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update status: %w", err)
+		}
+		return recordActivityWithReason(ctx, q, "CHALANI", chalaniID, action, changes, reason)
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update status: %v", err)
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &chalaniv1.ApproveChalaniResponse{
@@ -187,13 +318,16 @@ func (s *ChalaniServer) ReserveChalaniNumber(ctx context.Context, req *chalaniv1
 
 	chalaniID, err := uuid.Parse(req.Input.ChalaniId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid chalani ID: %v", err)
+		return nil, invalidArgument("chalani_id", "invalid chalani ID")
 	}
 
 	// Get chalani
-	chalani, err := s.queries.GetChalaniSimple(ctx, chalaniID)
+	chalani, err := s.queries.GetChalaniSimple(ctx, db.GetChalaniSimpleParams{ID: chalaniID, TenantID: userCtx.TenantID})
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "chalani not found: %v", err)
+		return nil, notFound(ctx, err, domain.ErrChalaniNotFound)
+	}
+	if err := domain.CheckVersion(req.Input.ExpectedVersion, chalani.Version); err != nil {
+		return nil, mapDomainError(ctx, err)
 	}
 
 	// Get next number
@@ -203,7 +337,7 @@ func (s *ChalaniServer) ReserveChalaniNumber(ctx context.Context, req *chalaniv1
 		TenantID:     userCtx.TenantID,
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get next number: %v", err)
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to get next number: %w", err))
 	}
 
 	// Update the chalani (for now, just return the current one since UpdateChalaniNumber might not exist)
@@ -219,19 +353,16 @@ func (s *ChalaniServer) ReserveChalaniNumber(ctx context.Context, req *chalaniv1
 func (s *ChalaniServer) DispatchChalani(ctx context.Context, req *chalaniv1.DispatchChalaniRequest) (*chalaniv1.DispatchChalaniResponse, error) {
 	chalaniID, err := uuid.Parse(req.Input.ChalaniId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid chalani ID: %v", err)
+		return nil, invalidArgument("chalani_id", "invalid chalani ID")
 	}
 
-	updated, err := s.queries.UpdateChalaniStatus(ctx, db.UpdateChalaniStatusParams{
-		ID:     chalaniID,
-		Status: "DISPATCHED",
-	})
+	updated, err := s.setStatus(ctx, chalaniID, "DISPATCHED", "", req.Input.ExpectedVersion)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update status: %v", err)
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &chalaniv1.DispatchChalaniResponse{
-		Chalani: toProtoChalani(&updated),
+		Chalani: toProtoChalani(updated),
 	}, nil
 }
 
@@ -239,19 +370,16 @@ func (s *ChalaniServer) DispatchChalani(ctx context.Context, req *chalaniv1.Disp
 func (s *ChalaniServer) MarkChalaniDelivered(ctx context.Context, req *chalaniv1.MarkChalaniDeliveredRequest) (*chalaniv1.MarkChalaniDeliveredResponse, error) {
 	chalaniID, err := uuid.Parse(req.Input.ChalaniId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid chalani ID: %v", err)
+		return nil, invalidArgument("chalani_id", "invalid chalani ID")
 	}
 
-	updated, err := s.queries.UpdateChalaniStatus(ctx, db.UpdateChalaniStatusParams{
-		ID:     chalaniID,
-		Status: "DELIVERED",
-	})
+	updated, err := s.setStatus(ctx, chalaniID, "DELIVERED", "", req.Input.ExpectedVersion)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update status: %v", err)
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &chalaniv1.MarkChalaniDeliveredResponse{
-		Chalani: toProtoChalani(&updated),
+		Chalani: toProtoChalani(updated),
 	}, nil
 }
 
@@ -259,22 +387,62 @@ func (s *ChalaniServer) MarkChalaniDelivered(ctx context.Context, req *chalaniv1
 func (s *ChalaniServer) VoidChalani(ctx context.Context, req *chalaniv1.VoidChalaniRequest) (*chalaniv1.VoidChalaniResponse, error) {
 	chalaniID, err := uuid.Parse(req.Input.ChalaniId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid chalani ID: %v", err)
+		return nil, invalidArgument("chalani_id", "invalid chalani ID")
 	}
 
-	updated, err := s.queries.UpdateChalaniStatus(ctx, db.UpdateChalaniStatusParams{
-		ID:     chalaniID,
-		Status: "VOIDED",
-	})
+	reason, err := domain.ValidateReason("reason", req.Input.Reason)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	updated, err := s.setStatus(ctx, chalaniID, "VOIDED", reason, req.Input.ExpectedVersion)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update status: %v", err)
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &chalaniv1.VoidChalaniResponse{
-		Chalani: toProtoChalani(&updated),
+		Chalani: toProtoChalani(updated),
 	}, nil
 }
 
+// setStatus moves a chalani to status and audits the change with the reason
+// given for it, if any
+func (s *ChalaniServer) setStatus(ctx context.Context, id uuid.UUID, status, reason string, expectedVersion int64) (*db.Chalani, error) {
+	current, err := s.queries.GetChalaniSimple(ctx, db.GetChalaniSimpleParams{ID: id, TenantID: domain.GetUserContext(ctx).TenantID})
+	if err != nil {
+		return nil, notFound(ctx, err, domain.ErrChalaniNotFound)
+	}
+	if err := domain.CheckVersion(expectedVersion, current.Version); err != nil {
+		return nil, err
+	}
+
+	// The status change and its audit entry commit together
+	changes := map[string]interface{}{
+		"status": map[string]string{"from": current.Status, "to": status},
+	}
+	var updated db.Chalani
+	err = db.InTx(ctx, s.queries, func(q db.Querier) error {
+		var err error
+		updated, err = q.UpdateChalaniStatus(ctx, db.UpdateChalaniStatusParams{
+			ID:       id,
+			Status:   status,
+			TenantID: current.TenantID,
+			Version:  current.Version,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ChalaniVersionConflict(ctx, q, id, current.Version)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update status: %w", err)
+		}
+		return recordActivityWithReason(ctx, q, "CHALANI", id, "STATUS_CHANGED", changes, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
 // Helper function to format chalani number
 func formatChalaniNumber(fiscalYear, scope, wardID string, number int) string {
 	prefix := "C"
@@ -287,7 +455,10 @@ func formatChalaniNumber(fiscalYear, scope, wardID string, number int) string {
 // Helper functions for recipient parsing
 func parseRecipientInput(ctx context.Context, queries db.Querier, input *chalaniv1.RecipientInput) (uuid.UUID, error) {
 	if input == nil {
-		return uuid.Nil, status.Error(codes.InvalidArgument, "recipient is required")
+		return uuid.Nil, invalidArgument("recipient", "recipient is required")
+	}
+	if input.Name == "" {
+		return uuid.Nil, invalidArgument("recipient.name", "recipient name is required")
 	}
 
 	// Create new recipient
@@ -310,13 +481,14 @@ func parseRecipientInput(ctx context.Context, queries db.Querier, input *chalani
 func toProtoChalani(c *db.Chalani) *chalaniv1.Chalani {
 	chalani := &chalaniv1.Chalani{
 		Id:         c.ID.String(),
-		FiscalYear: &chalaniv1.FiscalYear{Id: c.FiscalYearID},
+		FiscalYear: toProtoFiscalYear(c.FiscalYearID),
 		Scope:      stringToScope(c.Scope),
 		Subject:    c.Subject,
 		Body:       c.Body,
 		Status:     stringToChalaniStatus(c.Status),
 		CreatedAt:  timestamppb.New(c.CreatedAt.Time),
 		UpdatedAt:  timestamppb.New(c.UpdatedAt.Time),
+		Version:    c.Version,
 	}
 
 	if c.WardID != nil {
@@ -403,12 +575,28 @@ func stringToDispatchChannel(s string) chalaniv1.DispatchChannel {
 	}
 }
 
-// Placeholder implementations for unimplemented RPCs
-func (s *ChalaniServer) GetChalaniByNumber(ctx context.Context, req *chalaniv1.GetChalaniByNumberRequest) (*chalaniv1.GetChalaniByNumberResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+// GetMyChalani lists the chalanis created by the current user
+func (s *ChalaniServer) GetMyChalani(ctx context.Context, req *chalaniv1.GetMyChalaniRequest) (*chalaniv1.GetMyChalaniResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+
+	page, err := parsePage(req.Pagination, chalaniSorts, "created_at", 20)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.listChalaniPage(ctx, db.CountChalanisParams{
+		CreatedBy: &userCtx.UserID,
+		Status:    enumFilter(req.Status.String(), "CHALANI_STATUS_"),
+		TenantID:  userCtx.TenantID,
+	}, page)
+	if err != nil {
+		return nil, err
+	}
+	return &chalaniv1.GetMyChalaniResponse{Connection: conn}, nil
 }
 
-func (s *ChalaniServer) GetMyChalani(ctx context.Context, req *chalaniv1.GetMyChalaniRequest) (*chalaniv1.GetMyChalaniResponse, error) {
+// Placeholder implementations for unimplemented RPCs
+func (s *ChalaniServer) GetChalaniByNumber(ctx context.Context, req *chalaniv1.GetChalaniByNumberRequest) (*chalaniv1.GetChalaniByNumberResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "not implemented")
 }
 
@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	chalaniv1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
+)
+
+// approvalStore holds one chalani pending approval and records the audit
+// entries written
+type approvalStore struct {
+	db.Querier
+
+	mu      sync.Mutex
+	chalani db.Chalani
+	entries []db.CreateAuditEntryParams
+}
+
+func (a *approvalStore) GetChalaniSimple(ctx context.Context, arg db.GetChalaniSimpleParams) (db.Chalani, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if arg.ID != a.chalani.ID || arg.TenantID != a.chalani.TenantID {
+		return db.Chalani{}, pgx.ErrNoRows
+	}
+	return a.chalani, nil
+}
+
+func (a *approvalStore) HasPerformedAuditAction(ctx context.Context, arg db.HasPerformedAuditActionParams) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, e := range a.entries {
+		if e.Category == domain.AuditCategoryActivity && e.PerformedBy == arg.PerformedBy && containsString(arg.Actions, e.Action) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (a *approvalStore) UpdateChalaniStatus(ctx context.Context, arg db.UpdateChalaniStatusParams) (db.Chalani, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.chalani.Status = arg.Status
+	a.chalani.Version++
+	return a.chalani, nil
+}
+
+func (a *approvalStore) GetAuditChainHead(ctx context.Context, tenantID string) (db.GetAuditChainHeadRow, error) {
+	return db.GetAuditChainHeadRow{}, pgx.ErrNoRows
+}
+
+func (a *approvalStore) CreateAuditEntry(ctx context.Context, arg db.CreateAuditEntryParams) (db.AuditTrail, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.entries = append(a.entries, arg)
+	return db.AuditTrail{ID: arg.ID}, nil
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func approveAs(s *ChalaniServer, userID string, chalaniID uuid.UUID) error {
+	ctx := domain.WithUserContext(context.Background(), &domain.UserContext{TenantID: "t1", UserID: userID})
+	_, err := s.ApproveChalani(ctx, &chalaniv1.ApproveChalaniRequest{Input: &chalaniv1.ApproveChalaniInput{
+		ChalaniId: chalaniID.String(),
+		Decision:  chalaniv1.ApprovalDecision_APPROVAL_DECISION_APPROVED,
+	}})
+	return err
+}
+
+func TestApproveChalaniSegregatesApproverFromDrafter(t *testing.T) {
+	store := &approvalStore{chalani: db.Chalani{
+		ID:        uuid.New(),
+		TenantID:  "t1",
+		Status:    "PENDING_APPROVAL",
+		CreatedBy: "drafter",
+		Version:   1,
+	}}
+	s := NewChalaniServer(store, nil)
+
+	if err := approveAs(s, "drafter", store.chalani.ID); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("drafter approving: %v, want PermissionDenied", err)
+	}
+	if len(store.entries) != 1 {
+		t.Fatalf("%d audit entries, want the violation only", len(store.entries))
+	}
+	if v := store.entries[0]; v.Category != domain.AuditCategorySoDViolation || v.Action != domain.DutyApprove {
+		t.Errorf("violation audited as %s %s, want %s %s", v.Category, v.Action, domain.AuditCategorySoDViolation, domain.DutyApprove)
+	}
+
+	if err := approveAs(s, "approver", store.chalani.ID); err != nil {
+		t.Fatal(err)
+	}
+	approval := store.entries[len(store.entries)-1]
+	if approval.Action != domain.DutyApprove || approval.PerformedBy != "approver" {
+		t.Errorf("approval audited as %s by %s, want %s by approver", approval.Action, approval.PerformedBy, domain.DutyApprove)
+	}
+}
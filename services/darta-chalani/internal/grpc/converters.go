@@ -2,18 +2,17 @@ package grpc
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"git.ninjainfosys.com/ePalika/pkg/bsdate"
 	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
 	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
-	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
 )
 
 // Helper to convert pgtype.Timestamptz to timestamppb
@@ -24,6 +23,49 @@ func pgTimestamptzToProto(ts pgtype.Timestamptz) *timestamppb.Timestamp {
 	return timestamppb.New(ts.Time)
 }
 
+// protoToPgTimestamptz converts an optional proto timestamp; nil is NULL
+func protoToPgTimestamptz(ts *timestamppb.Timestamp) pgtype.Timestamptz {
+	if ts == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: ts.AsTime(), Valid: true}
+}
+
+// pgTimestamptzToBS formats a timestamp as a Bikram Sambat date string
+func pgTimestamptzToBS(ts pgtype.Timestamptz) string {
+	if !ts.Valid {
+		return ""
+	}
+	d, err := bsdate.FromTime(ts.Time)
+	if err != nil {
+		return ""
+	}
+	return d.String()
+}
+
+// toProtoFiscalYear expands a fiscal year code such as "2081-82" into a
+// FiscalYear message. Unrecognised IDs are returned with only Id set.
+func toProtoFiscalYear(id string) *dartav1.FiscalYear {
+	fiscalYear := &dartav1.FiscalYear{Id: id}
+	fy, err := bsdate.ParseFiscalYear(id)
+	if err != nil {
+		return fiscalYear
+	}
+
+	fiscalYear.Code = fy.Code()
+	fiscalYear.Name = fy.Name()
+	fiscalYear.StartYear = int32(fy.StartYear)
+	fiscalYear.EndYear = int32(fy.EndYear())
+	if start, end, err := fy.Bounds(); err == nil {
+		fiscalYear.StartDate = timestamppb.New(start)
+		// Bounds is half-open; the last instant of the fiscal year is just before end
+		fiscalYear.EndDate = timestamppb.New(end.Add(-time.Nanosecond))
+		now := time.Now()
+		fiscalYear.IsActive = !now.Before(start) && now.Before(end)
+	}
+	return fiscalYear
+}
+
 // toProtoDarta converts db.Darta to proto Darta
 func toProtoDarta(d *db.Darta) *dartav1.Darta {
 	if d == nil {
@@ -31,20 +73,21 @@ func toProtoDarta(d *db.Darta) *dartav1.Darta {
 	}
 
 	darta := &dartav1.Darta{
-		Id:            d.ID.String(),
-		FiscalYear:    &dartav1.FiscalYear{Id: d.FiscalYearID},
-		Scope:         stringToScope(d.Scope),
-		Subject:       d.Subject,
-		IntakeChannel: stringToIntakeChannel(d.IntakeChannel),
-		ReceivedDate:  pgTimestamptzToProto(d.ReceivedDate),
-		EntryDate:     pgTimestamptzToProto(d.EntryDate),
-		IsBackdated:   d.IsBackdated,
-		Status:        stringToDartaStatus(d.Status),
-		Priority:      stringToPriority(d.Priority),
-		CreatedBy:     &dartav1.User{Id: d.CreatedBy},
-		CreatedAt:     pgTimestamptzToProto(d.CreatedAt),
-		UpdatedAt:     pgTimestamptzToProto(d.UpdatedAt),
-		TenantId:      d.TenantID,
+		Id:             d.ID.String(),
+		FiscalYear:     toProtoFiscalYear(d.FiscalYearID),
+		Scope:          stringToScope(d.Scope),
+		Subject:        d.Subject,
+		IntakeChannel:  stringToIntakeChannel(d.IntakeChannel),
+		ReceivedDate:   pgTimestamptzToProto(d.ReceivedDate),
+		ReceivedDateBs: pgTimestamptzToBS(d.ReceivedDate),
+		EntryDate:      pgTimestamptzToProto(d.EntryDate),
+		IsBackdated:    d.IsBackdated,
+		Status:         stringToDartaStatus(d.Status),
+		Priority:       stringToPriority(d.Priority),
+		CreatedBy:      &dartav1.User{Id: d.CreatedBy},
+		CreatedAt:      pgTimestamptzToProto(d.CreatedAt),
+		UpdatedAt:      pgTimestamptzToProto(d.UpdatedAt),
+		TenantId:       d.TenantID,
 	}
 
 	if d.DartaNumber != nil {
@@ -75,6 +118,13 @@ func toProtoDarta(d *db.Darta) *dartav1.Darta {
 		darta.SlaDeadline = pgTimestamptzToProto(d.SlaDeadline)
 	}
 
+	darta.SupersededById = pgUUIDString(d.SupersededByID)
+	darta.SupersedesId = pgUUIDString(d.SupersedesID)
+	if d.SupersedesNumber != nil {
+		darta.SupersedesDartaNumber = *d.SupersedesNumber
+	}
+	darta.Version = d.Version
+	darta.Metadata = jsonToStruct(d.Metadata)
 	darta.PrimaryDocument = &dartav1.Attachment{Id: d.PrimaryDocumentID.String()}
 	darta.Applicant = &dartav1.Applicant{Id: d.ApplicantID.String()}
 
@@ -88,20 +138,21 @@ func buildDartaFromRow(row *db.GetDartaRow) *dartav1.Darta {
 	}
 
 	darta := &dartav1.Darta{
-		Id:            row.ID.String(),
-		FiscalYear:    &dartav1.FiscalYear{Id: row.FiscalYearID},
-		Scope:         stringToScope(row.Scope),
-		Subject:       row.Subject,
-		IntakeChannel: stringToIntakeChannel(row.IntakeChannel),
-		ReceivedDate:  pgTimestamptzToProto(row.ReceivedDate),
-		EntryDate:     pgTimestamptzToProto(row.EntryDate),
-		IsBackdated:   row.IsBackdated,
-		Status:        stringToDartaStatus(row.Status),
-		Priority:      stringToPriority(row.Priority),
-		CreatedBy:     &dartav1.User{Id: row.CreatedBy},
-		CreatedAt:     pgTimestamptzToProto(row.CreatedAt),
-		UpdatedAt:     pgTimestamptzToProto(row.UpdatedAt),
-		TenantId:      row.TenantID,
+		Id:             row.ID.String(),
+		FiscalYear:     toProtoFiscalYear(row.FiscalYearID),
+		Scope:          stringToScope(row.Scope),
+		Subject:        row.Subject,
+		IntakeChannel:  stringToIntakeChannel(row.IntakeChannel),
+		ReceivedDate:   pgTimestamptzToProto(row.ReceivedDate),
+		ReceivedDateBs: pgTimestamptzToBS(row.ReceivedDate),
+		EntryDate:      pgTimestamptzToProto(row.EntryDate),
+		IsBackdated:    row.IsBackdated,
+		Status:         stringToDartaStatus(row.Status),
+		Priority:       stringToPriority(row.Priority),
+		CreatedBy:      &dartav1.User{Id: row.CreatedBy},
+		CreatedAt:      pgTimestamptzToProto(row.CreatedAt),
+		UpdatedAt:      pgTimestamptzToProto(row.UpdatedAt),
+		TenantId:       row.TenantID,
 		Applicant: &dartav1.Applicant{
 			Id:       row.ID_2.String(),
 			Type:     stringToApplicantType(row.Type),
@@ -115,82 +166,13 @@ func buildDartaFromRow(row *db.GetDartaRow) *dartav1.Darta {
 	if row.FormattedDartaNumber != nil {
 		darta.FormattedDartaNumber = *row.FormattedDartaNumber
 	}
-
-	return darta
-}
-
-// buildDartaFromListRow builds Darta from ListDartasRow
-func buildDartaFromListRow(row *db.ListDartasRow) *dartav1.Darta {
-	if row == nil {
-		return nil
-	}
-
-	darta := &dartav1.Darta{
-		Id:            row.ID.String(),
-		FiscalYear:    &dartav1.FiscalYear{Id: row.FiscalYearID},
-		Scope:         stringToScope(row.Scope),
-		Subject:       row.Subject,
-		IntakeChannel: stringToIntakeChannel(row.IntakeChannel),
-		ReceivedDate:  pgTimestamptzToProto(row.ReceivedDate),
-		EntryDate:     pgTimestamptzToProto(row.EntryDate),
-		IsBackdated:   row.IsBackdated,
-		Status:        stringToDartaStatus(row.Status),
-		Priority:      stringToPriority(row.Priority),
-		CreatedBy:     &dartav1.User{Id: row.CreatedBy},
-		CreatedAt:     pgTimestamptzToProto(row.CreatedAt),
-		UpdatedAt:     pgTimestamptzToProto(row.UpdatedAt),
-		TenantId:      row.TenantID,
-		Applicant: &dartav1.Applicant{
-			Id:       row.ID_2.String(),
-			Type:     stringToApplicantType(row.Type),
-			FullName: row.FullName,
-		},
-	}
-
-	if row.DartaNumber != nil {
-		darta.DartaNumber = *row.DartaNumber
-	}
-	if row.FormattedDartaNumber != nil {
-		darta.FormattedDartaNumber = *row.FormattedDartaNumber
-	}
-
-	return darta
-}
-
-// buildDartaFromMyDartasRow builds Darta from GetMyDartasRow
-func buildDartaFromMyDartasRow(row *db.GetMyDartasRow) *dartav1.Darta {
-	if row == nil {
-		return nil
-	}
-
-	darta := &dartav1.Darta{
-		Id:            row.ID.String(),
-		FiscalYear:    &dartav1.FiscalYear{Id: row.FiscalYearID},
-		Scope:         stringToScope(row.Scope),
-		Subject:       row.Subject,
-		IntakeChannel: stringToIntakeChannel(row.IntakeChannel),
-		ReceivedDate:  pgTimestamptzToProto(row.ReceivedDate),
-		EntryDate:     pgTimestamptzToProto(row.EntryDate),
-		IsBackdated:   row.IsBackdated,
-		Status:        stringToDartaStatus(row.Status),
-		Priority:      stringToPriority(row.Priority),
-		CreatedBy:     &dartav1.User{Id: row.CreatedBy},
-		CreatedAt:     pgTimestamptzToProto(row.CreatedAt),
-		UpdatedAt:     pgTimestamptzToProto(row.UpdatedAt),
-		TenantId:      row.TenantID,
-		Applicant: &dartav1.Applicant{
-			Id:       row.ID_2.String(),
-			Type:     stringToApplicantType(row.Type),
-			FullName: row.FullName,
-		},
-	}
-
-	if row.DartaNumber != nil {
-		darta.DartaNumber = *row.DartaNumber
-	}
-	if row.FormattedDartaNumber != nil {
-		darta.FormattedDartaNumber = *row.FormattedDartaNumber
+	darta.SupersededById = pgUUIDString(row.SupersededByID)
+	darta.SupersedesId = pgUUIDString(row.SupersedesID)
+	if row.SupersedesNumber != nil {
+		darta.SupersedesDartaNumber = *row.SupersedesNumber
 	}
+	darta.Version = row.Version
+	darta.Metadata = jsonToStruct(row.Metadata)
 
 	return darta
 }
@@ -198,7 +180,10 @@ func buildDartaFromMyDartasRow(row *db.GetMyDartasRow) *dartav1.Darta {
 // parseApplicantInput parses or creates an applicant
 func parseApplicantInput(ctx context.Context, queries db.Querier, input *dartav1.ApplicantInput) (uuid.UUID, error) {
 	if input == nil {
-		return uuid.Nil, errors.New("applicant input is required")
+		return uuid.Nil, invalidArgument("applicant", "applicant is required")
+	}
+	if input.FullName == "" {
+		return uuid.Nil, invalidArgument("applicant.full_name", "applicant name is required")
 	}
 
 	// Create or find existing applicant
@@ -303,6 +288,8 @@ func stringToDartaStatus(s string) dartav1.DartaStatus {
 		return dartav1.DartaStatus_DARTA_STATUS_VOIDED
 	case "ASSIGNED":
 		return dartav1.DartaStatus_DARTA_STATUS_ASSIGNED
+	case "SUPERSEDED":
+		return dartav1.DartaStatus_DARTA_STATUS_SUPERSEDED
 	case "CLOSED":
 		return dartav1.DartaStatus_DARTA_STATUS_CLOSED
 	default:
@@ -356,6 +343,17 @@ func sqlNullString(s string) *string {
 	return &s
 }
 
+// enumFilter converts the name of a proto enum value to the database value
+// a list filters on, e.g. DARTA_STATUS_REGISTERED to REGISTERED. The
+// unspecified value applies no filter.
+func enumFilter(name, prefix string) *string {
+	if name == prefix+"UNSPECIFIED" {
+		return nil
+	}
+	v := strings.TrimPrefix(name, prefix)
+	return &v
+}
+
 // sqlNullUUID converts a string to *uuid.UUID for sqlc
 func sqlNullUUID(s string) *uuid.UUID {
 	if s == "" {
@@ -380,36 +378,3 @@ func nullStringToPtr(s *string) string {
 func padNumber(num int, width int) string {
 	return fmt.Sprintf("%0*d", width, num)
 }
-
-// mapDomainError maps domain errors to gRPC status codes
-func mapDomainError(err error) error {
-	if err == nil {
-		return nil
-	}
-
-	switch {
-	case errors.Is(err, domain.ErrNotFound),
-		errors.Is(err, domain.ErrDartaNotFound),
-		errors.Is(err, domain.ErrChalaniNotFound):
-		return status.Error(codes.NotFound, err.Error())
-	case errors.Is(err, domain.ErrInvalidInput),
-		errors.Is(err, domain.ErrInvalidDartaStatus),
-		errors.Is(err, domain.ErrInvalidChalaniStatus):
-		return status.Error(codes.InvalidArgument, err.Error())
-	case errors.Is(err, domain.ErrAlreadyExists),
-		errors.Is(err, domain.ErrDuplicateDarta),
-		errors.Is(err, domain.ErrDuplicateChalani):
-		return status.Error(codes.AlreadyExists, err.Error())
-	case errors.Is(err, domain.ErrUnauthorized):
-		return status.Error(codes.Unauthenticated, err.Error())
-	case errors.Is(err, domain.ErrForbidden):
-		return status.Error(codes.PermissionDenied, err.Error())
-	default:
-		// Check if it's a validation error
-		var valErr *domain.ValidationError
-		if errors.As(err, &valErr) {
-			return status.Error(codes.InvalidArgument, valErr.Error())
-		}
-		return status.Error(codes.Internal, fmt.Sprintf("internal error: %v", err))
-	}
-}
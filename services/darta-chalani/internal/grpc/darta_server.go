@@ -3,10 +3,13 @@ package grpc
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -21,32 +24,34 @@ type DartaServer struct {
 	dartav1.UnimplementedDartaServiceServer
 	dartaService *domain.DartaService
 	queries      db.Querier
+	events       *EventHub
 }
 
 // NewDartaServer creates a new DartaServer
-func NewDartaServer(dartaService *domain.DartaService, queries db.Querier) *DartaServer {
+func NewDartaServer(dartaService *domain.DartaService, queries db.Querier, events *EventHub) *DartaServer {
 	return &DartaServer{
 		dartaService: dartaService,
 		queries:      queries,
+		events:       events,
 	}
 }
 
 // CreateDarta creates a new darta
 func (s *DartaServer) CreateDarta(ctx context.Context, req *dartav1.CreateDartaRequest) (*dartav1.CreateDartaResponse, error) {
 	if req.Input == nil {
-		return nil, status.Error(codes.InvalidArgument, "input is required")
+		return nil, invalidArgument("input", "input is required")
 	}
 
 	// Parse applicant
 	applicantID, err := parseApplicantInput(ctx, s.queries, req.Input.Applicant)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid applicant: %v", err)
+		return nil, mapDomainError(ctx, err)
 	}
 
 	// Parse primary document ID
 	primaryDocID, err := uuid.Parse(req.Input.PrimaryDocumentId)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid primary document ID")
+		return nil, invalidArgument("primary_document_id", "invalid primary document ID")
 	}
 
 	// Parse annex IDs
@@ -54,33 +59,42 @@ func (s *DartaServer) CreateDarta(ctx context.Context, req *dartav1.CreateDartaR
 	for _, id := range req.Input.AnnexIds {
 		annexID, err := uuid.Parse(id)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid annex ID: %s", id)
+			return nil, invalidArgument("annex_ids", fmt.Sprintf("invalid annex ID: %s", id))
 		}
 		annexIDs = append(annexIDs, annexID)
 	}
 
+	// Default received date to now; fiscal year is derived from it
+	receivedDate := time.Now()
+	if req.Input.ReceivedDate != nil {
+		receivedDate = req.Input.ReceivedDate.AsTime()
+	}
+
 	// Create darta
 	input := domain.CreateDartaInput{
-		FiscalYearID:      req.Input.Scope.String(),
 		Scope:             req.Input.Scope.String(),
 		WardID:            stringPtr(req.Input.WardId),
 		Subject:           req.Input.Subject,
 		ApplicantID:       applicantID,
 		IntakeChannel:     req.Input.IntakeChannel.String(),
-		ReceivedDate:      req.Input.ReceivedDate.AsTime(),
+		ReceivedDate:      receivedDate,
 		PrimaryDocumentID: primaryDocID,
 		AnnexIDs:          annexIDs,
 		Priority:          req.Input.Priority.String(),
 		IdempotencyKey:    req.Input.IdempotencyKey,
+		BackdateReason:    stringPtr(req.Input.BackdateReason),
+
+		AcknowledgeDuplicates: req.Input.AcknowledgeDuplicates,
 	}
 
-	darta, err := s.dartaService.CreateDarta(ctx, input)
+	darta, duplicates, err := s.dartaService.CreateDarta(ctx, input)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &dartav1.CreateDartaResponse{
-		Darta: toProtoDarta(darta),
+		Darta:               toProtoDarta(darta),
+		SuspectedDuplicates: toProtoDuplicateCandidates(duplicates),
 	}, nil
 }
 
@@ -88,16 +102,19 @@ func (s *DartaServer) CreateDarta(ctx context.Context, req *dartav1.CreateDartaR
 func (s *DartaServer) GetDarta(ctx context.Context, req *dartav1.GetDartaRequest) (*dartav1.GetDartaResponse, error) {
 	id, err := uuid.Parse(req.Id)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid darta ID")
+		return nil, invalidArgument("id", "invalid darta ID")
 	}
 
 	dartaRow, err := s.dartaService.GetDarta(ctx, id)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 
 	// Build darta proto
 	darta := buildDartaFromRow(dartaRow)
+	if darta.AuditTrail, err = recentAuditTrail(ctx, s.queries, "DARTA", id); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
 
 	return &dartav1.GetDartaResponse{
 		Darta: darta,
@@ -108,12 +125,12 @@ func (s *DartaServer) GetDarta(ctx context.Context, req *dartav1.GetDartaRequest
 // func (s *DartaServer) UpdateDartaStatus(ctx context.Context, req *dartav1.UpdateDartaStatusRequest) (*dartav1.UpdateDartaStatusResponse, error) {
 // 	id, err := uuid.Parse(req.DartaId)
 // 	if err != nil {
-// 		return nil, status.Error(codes.InvalidArgument, "invalid darta ID")
+// 		return nil, invalidArgument("darta_id", "invalid darta ID")
 // 	}
 //
 // 	darta, err := s.dartaService.UpdateDartaStatus(ctx, id, req.Status.String())
 // 	if err != nil {
-// 		return nil, mapDomainError(err)
+// 		return nil, mapDomainError(ctx, err)
 // 	}
 //
 // 	return &dartav1.UpdateDartaStatusResponse{
@@ -125,12 +142,12 @@ func (s *DartaServer) GetDarta(ctx context.Context, req *dartav1.GetDartaRequest
 func (s *DartaServer) SubmitDartaForReview(ctx context.Context, req *dartav1.SubmitDartaForReviewRequest) (*dartav1.SubmitDartaForReviewResponse, error) {
 	id, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid darta ID")
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
-	darta, err := s.dartaService.UpdateDartaStatus(ctx, id, "PENDING_REVIEW")
+	darta, err := s.dartaService.UpdateDartaStatus(ctx, id, "PENDING_REVIEW", req.ExpectedVersion)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &dartav1.SubmitDartaForReviewResponse{
@@ -142,20 +159,53 @@ func (s *DartaServer) SubmitDartaForReview(ctx context.Context, req *dartav1.Sub
 func (s *DartaServer) ClassifyDarta(ctx context.Context, req *dartav1.ClassifyDartaRequest) (*dartav1.ClassifyDartaResponse, error) {
 	id, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid darta ID")
+		return nil, invalidArgument("darta_id", "invalid darta ID")
+	}
+
+	current, err := s.queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: id, TenantID: domain.GetUserContext(ctx).TenantID})
+	if err != nil {
+		return nil, mapDomainError(ctx, domain.ErrDartaNotFound)
+	}
+	if err := domain.CheckVersion(req.ExpectedVersion, current.Version); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	// Classifying a darta pending review approves the review
+	if current.Status == "PENDING_REVIEW" {
+		if err := s.dartaService.EnforceSoD(ctx, &current, domain.DutyReview); err != nil {
+			return nil, mapDomainError(ctx, err)
+		}
 	}
 
 	// Update classification code
 	darta, err := s.queries.UpdateDartaClassification(ctx, db.UpdateDartaClassificationParams{
 		ID:                 id,
 		ClassificationCode: &req.ClassificationCode,
+		Version:            current.Version,
 	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, mapDomainError(ctx, domain.DartaVersionConflict(ctx, s.queries, id, current.Version))
+	}
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to classify darta: %v", err)
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to classify darta: %w", err))
+	}
+
+	changes := map[string]interface{}{
+		"classification_code": map[string]interface{}{"from": current.ClassificationCode, "to": req.ClassificationCode},
+	}
+	if err := recordActivity(ctx, s.queries, "DARTA", id, "CLASSIFIED", changes); err != nil {
+		return nil, mapDomainError(ctx, err)
 	}
 
-	// Update status
-	_, _ = s.dartaService.UpdateDartaStatus(ctx, id, "CLASSIFICATION")
+	// Classifying a darta pending review moves it on to classification;
+	// reclassifying a darta further along leaves its status alone
+	if current.Status == "PENDING_REVIEW" {
+		classified, err := s.dartaService.UpdateDartaStatus(ctx, id, "CLASSIFICATION", darta.Version)
+		if err != nil {
+			return nil, mapDomainError(ctx, err)
+		}
+		darta = *classified
+	}
 
 	return &dartav1.ClassifyDartaResponse{
 		Darta: toProtoDarta(&darta),
@@ -166,16 +216,17 @@ func (s *DartaServer) ClassifyDarta(ctx context.Context, req *dartav1.ClassifyDa
 func (s *DartaServer) ReserveDartaNumber(ctx context.Context, req *dartav1.ReserveDartaNumberRequest) (*dartav1.ReserveDartaNumberResponse, error) {
 	id, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid darta ID")
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
-	darta, err := s.dartaService.ReserveDartaNumber(ctx, id)
+	darta, duplicates, err := s.dartaService.ReserveDartaNumber(ctx, id, req.AcknowledgeDuplicates, req.ExpectedVersion)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &dartav1.ReserveDartaNumberResponse{
-		Darta: toProtoDarta(darta),
+		Darta:               toProtoDarta(darta),
+		SuspectedDuplicates: toProtoDuplicateCandidates(duplicates),
 	}, nil
 }
 
@@ -183,12 +234,12 @@ func (s *DartaServer) ReserveDartaNumber(ctx context.Context, req *dartav1.Reser
 func (s *DartaServer) FinalizeDartaRegistration(ctx context.Context, req *dartav1.FinalizeDartaRegistrationRequest) (*dartav1.FinalizeDartaRegistrationResponse, error) {
 	id, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid darta ID")
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
-	darta, err := s.dartaService.UpdateDartaStatus(ctx, id, "REGISTERED")
+	darta, err := s.dartaService.UpdateDartaStatus(ctx, id, "REGISTERED", req.ExpectedVersion)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &dartav1.FinalizeDartaRegistrationResponse{
@@ -199,12 +250,12 @@ func (s *DartaServer) FinalizeDartaRegistration(ctx context.Context, req *dartav
 // RouteDarta routes darta to a unit/user
 func (s *DartaServer) RouteDarta(ctx context.Context, req *dartav1.RouteDartaRequest) (*dartav1.RouteDartaResponse, error) {
 	if req.Input == nil {
-		return nil, status.Error(codes.InvalidArgument, "input is required")
+		return nil, invalidArgument("input", "input is required")
 	}
 
 	id, err := uuid.Parse(req.Input.DartaId)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid darta ID")
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
 	darta, err := s.dartaService.AssignDarta(
@@ -214,9 +265,10 @@ func (s *DartaServer) RouteDarta(ctx context.Context, req *dartav1.RouteDartaReq
 		stringPtr(req.Input.AssigneeId),
 		protoToPriority(req.Input.Priority),
 		int32Ptr(req.Input.SlaHours),
+		req.Input.ExpectedVersion,
 	)
 	if err != nil {
-		return nil, mapDomainError(err)
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &dartav1.RouteDartaResponse{
@@ -228,16 +280,16 @@ func (s *DartaServer) RouteDarta(ctx context.Context, req *dartav1.RouteDartaReq
 func (s *DartaServer) CloseDarta(ctx context.Context, req *dartav1.CloseDartaRequest) (*dartav1.CloseDartaResponse, error) {
 	id, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid darta ID")
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
-	darta, err := s.queries.CloseDarta(ctx, id)
+	darta, err := s.dartaService.CloseDarta(ctx, id, req.ExpectedVersion)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to close darta: %v", err)
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to close darta: %w", err))
 	}
 
 	return &dartav1.CloseDartaResponse{
-		Darta: toProtoDarta(&darta),
+		Darta: toProtoDarta(darta),
 	}, nil
 }
 
@@ -245,179 +297,193 @@ func (s *DartaServer) CloseDarta(ctx context.Context, req *dartav1.CloseDartaReq
 func (s *DartaServer) VoidDarta(ctx context.Context, req *dartav1.VoidDartaRequest) (*dartav1.VoidDartaResponse, error) {
 	id, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid darta ID")
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
-	darta, err := s.queries.VoidDarta(ctx, id)
+	darta, err := s.dartaService.VoidDarta(ctx, id, req.Reason, req.ExpectedVersion)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to void darta: %v", err)
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to void darta: %w", err))
 	}
 
 	return &dartav1.VoidDartaResponse{
-		Darta: toProtoDarta(&darta),
+		Darta: toProtoDarta(darta),
 	}, nil
 }
 
-// ListDartas lists dartas with filtering
+// dartaSorts are the sort orders ListDartas and GetMyDartas accept
+var dartaSorts = map[string]sortKind{
+	"created_at":    sortByTime,
+	"received_date": sortByTime,
+	"darta_number":  sortByNumber,
+}
+
+// ListDartas lists dartas with filtering and keyset pagination
 func (s *DartaServer) ListDartas(ctx context.Context, req *dartav1.ListDartasRequest) (*dartav1.ListDartasResponse, error) {
 	userCtx := domain.GetUserContext(ctx)
 
-	// Build filter params
-	limit := int32(10)
-	offset := int32(0)
-	if req.Pagination != nil {
-		if req.Pagination.Limit > 0 {
-			limit = req.Pagination.Limit
-		}
-		if req.Pagination.Offset > 0 {
-			offset = req.Pagination.Offset
-		}
+	page, err := parsePage(req.Pagination, dartaSorts, "created_at", 10)
+	if err != nil {
+		return nil, err
 	}
 
-	// Query dartas
-	rows, err := s.queries.ListDartas(ctx, db.ListDartasParams{
-		FiscalYearID:      stringPtr(req.Filter.GetFiscalYearId()),
-		Scope:             stringPtr(req.Filter.GetScope().String()),
-		WardID:            stringPtr(req.Filter.GetWardId()),
-		Status:            stringPtr(req.Filter.GetStatus().String()),
-		Priority:          stringPtr(req.Filter.GetPriority().String()),
-		AssignedToUnitID:  stringPtr(req.Filter.GetOrganizationalUnitId()),
-		CurrentAssigneeID: stringPtr(req.Filter.GetAssigneeId()),
-		Search:            stringPtr(req.Filter.GetSearch()),
+	f := req.Filter
+	filter := db.CountDartasParams{
+		FiscalYearID:      stringPtr(domain.NormalizeFiscalYearID(f.GetFiscalYearId())),
+		Scope:             enumFilter(f.GetScope().String(), "SCOPE_"),
+		WardID:            stringPtr(f.GetWardId()),
+		Status:            enumFilter(f.GetStatus().String(), "DARTA_STATUS_"),
+		Priority:          enumFilter(f.GetPriority().String(), "PRIORITY_"),
+		IntakeChannel:     enumFilter(f.GetIntakeChannel().String(), "INTAKE_CHANNEL_"),
+		AssignedToUnitID:  stringPtr(f.GetOrganizationalUnitId()),
+		CurrentAssigneeID: stringPtr(f.GetAssigneeId()),
+		FromDate:          protoToPgTimestamptz(f.GetFromDate()),
+		ToDate:            protoToPgTimestamptz(f.GetToDate()),
+		Search:            stringPtr(f.GetSearch()),
 		TenantID:          userCtx.TenantID,
-		SortBy:            "created_at",
-		Limit:             limit,
-		Offset:            offset,
-	})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list dartas: %v", err)
 	}
-
-	// Build response
-	edges := make([]*dartav1.DartaEdge, len(rows))
-	for i, row := range rows {
-		darta := buildDartaFromListRow(&row)
-		edges[i] = &dartav1.DartaEdge{
-			Cursor: fmt.Sprintf("%d", offset+int32(i)),
-			Node:   darta,
-		}
+	if f.GetIsOverdue() {
+		overdue := true
+		filter.IsOverdue = &overdue
 	}
 
-	// Get total count
-	total, _ := s.queries.CountDartas(ctx, db.CountDartasParams{
-		FiscalYearID: stringPtr(req.Filter.GetFiscalYearId()),
-		Status:       stringPtr(req.Filter.GetStatus().String()),
-		TenantID:     userCtx.TenantID,
-	})
-
-	return &dartav1.ListDartasResponse{
-		Connection: &dartav1.DartaConnection{
-			Edges: edges,
-			PageInfo: &dartav1.PageInfo{
-				HasNextPage:  int64(offset+limit) < total,
-				TotalCount:   total,
-			},
-		},
-	}, nil
+	conn, err := s.listDartaPage(ctx, filter, page)
+	if err != nil {
+		return nil, err
+	}
+	return &dartav1.ListDartasResponse{Connection: conn}, nil
 }
 
-// GetMyDartas gets dartas assigned to current user
+// GetMyDartas lists the dartas assigned to the current user, most recently
+// received first unless the request sorts otherwise
 func (s *DartaServer) GetMyDartas(ctx context.Context, req *dartav1.GetMyDartasRequest) (*dartav1.GetMyDartasResponse, error) {
 	userCtx := domain.GetUserContext(ctx)
 
-	limit := int32(10)
-	offset := int32(0)
-	if req.Pagination != nil {
-		if req.Pagination.Limit > 0 {
-			limit = req.Pagination.Limit
-		}
-		offset = req.Pagination.Offset
+	page, err := parsePage(req.Pagination, dartaSorts, "received_date", 10)
+	if err != nil {
+		return nil, err
 	}
 
-	rows, err := s.queries.GetMyDartas(ctx, db.GetMyDartasParams{
+	conn, err := s.listDartaPage(ctx, db.CountDartasParams{
 		CurrentAssigneeID: &userCtx.UserID,
-		Status:            stringPtr(req.Status.String()),
+		Status:            enumFilter(req.Status.String(), "DARTA_STATUS_"),
 		TenantID:          userCtx.TenantID,
-		Limit:             limit,
-		Offset:            offset,
-	})
+	}, page)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get my dartas: %v", err)
-	}
-
-	edges := make([]*dartav1.DartaEdge, len(rows))
-	for i, row := range rows {
-		darta := buildDartaFromMyDartasRow(&row)
-		edges[i] = &dartav1.DartaEdge{
-			Cursor: fmt.Sprintf("%d", i),
-			Node:   darta,
-		}
+		return nil, err
 	}
-
-	total, _ := s.queries.CountMyDartas(ctx, db.CountMyDartasParams{
-		CurrentAssigneeID: &userCtx.UserID,
-		Status:            stringPtr(req.Status.String()),
-		TenantID:          userCtx.TenantID,
-	})
-
-	return &dartav1.GetMyDartasResponse{
-		Connection: &dartav1.DartaConnection{
-			Edges: edges,
-			PageInfo: &dartav1.PageInfo{
-				HasNextPage: int64(offset+limit) < total,
-				TotalCount:  total,
-			},
-		},
-	}, nil
+	return &dartav1.GetMyDartasResponse{Connection: conn}, nil
 }
 
-// GetDartaStats gets darta statistics
-func (s *DartaServer) GetDartaStats(ctx context.Context, req *dartav1.GetDartaStatsRequest) (*dartav1.GetDartaStatsResponse, error) {
-	userCtx := domain.GetUserContext(ctx)
-
-	// Get counts by status
-	statusCounts, _ := s.queries.GetDartaStatsByStatus(ctx, db.GetDartaStatsByStatusParams{
-		TenantID: userCtx.TenantID,
-	})
+// listDartaPage fetches one keyset page of the dartas matching filter, with
+// their applicants and the total number of matches
+func (s *DartaServer) listDartaPage(ctx context.Context, filter db.CountDartasParams, page pageRequest) (*dartav1.DartaConnection, error) {
+	rows, err := s.queryDartaPage(ctx, filter, page)
+	if err != nil {
+		return nil, err
+	}
+	rows, more := trimPage(page, rows)
 
-	// Get counts by channel
-	channelCounts, _ := s.queries.GetDartaStatsByChannel(ctx, db.GetDartaStatsByChannelParams{
-		TenantID: userCtx.TenantID,
-	})
+	total, err := s.queries.CountDartas(ctx, filter)
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to count dartas: %w", err))
+	}
 
-	// Get overdue count
-	overdueCount, _ := s.queries.GetOverdueCount(ctx, db.GetOverdueCountParams{
-		TenantID: userCtx.TenantID,
+	applicantIDs := make([]pgtype.UUID, 0, len(rows))
+	for _, d := range rows {
+		applicantIDs = append(applicantIDs, pgtype.UUID{Bytes: d.ApplicantID, Valid: true})
+	}
+	applicants, err := s.queries.GetApplicantsByIDs(ctx, db.GetApplicantsByIDsParams{
+		Ids:      applicantIDs,
+		TenantID: filter.TenantID,
 	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to get applicants: %w", err))
+	}
+	applicantByID := make(map[uuid.UUID]*db.Applicant, len(applicants))
+	for i := range applicants {
+		applicantByID[applicants[i].ID] = &applicants[i]
+	}
 
-	// Build response
-	byStatus := make([]*dartav1.DartaStatusCount, len(statusCounts))
-	for i, sc := range statusCounts {
-		byStatus[i] = &dartav1.DartaStatusCount{
-			Status: stringToDartaStatus(sc.Status),
-			Count:  int32(sc.Count),
+	edges := make([]*dartav1.DartaEdge, len(rows))
+	cursors := make([]string, len(rows))
+	for i := range rows {
+		d := &rows[i]
+		number := int32(0)
+		if d.DartaNumber != nil {
+			number = *d.DartaNumber
 		}
-	}
+		key := d.CreatedAt
+		if page.sort == "received_date" {
+			key = d.ReceivedDate
+		}
+		cursors[i] = page.cursorAt(d.ID, key, number)
 
-	byChannel := make([]*dartav1.ChannelCount, len(channelCounts))
-	for i, cc := range channelCounts {
-		byChannel[i] = &dartav1.ChannelCount{
-			Channel: stringToIntakeChannel(cc.IntakeChannel),
-			Count:   int32(cc.Count),
+		node := toProtoDarta(d)
+		if a, ok := applicantByID[d.ApplicantID]; ok {
+			node.Applicant = toProtoApplicant(a)
 		}
+		edges[i] = &dartav1.DartaEdge{Cursor: cursors[i], Node: node}
 	}
 
-	return &dartav1.GetDartaStatsResponse{
-		Stats: &dartav1.DartaStats{
-			Total:                   int32(len(statusCounts)),
-			ByStatus:                byStatus,
-			ByChannel:               byChannel,
-			OverdueCount:            int32(overdueCount),
-			AvgProcessingTimeHours:  0, // TODO: Calculate
-		},
+	return &dartav1.DartaConnection{
+		Edges:    edges,
+		PageInfo: page.pageInfo(cursors, more, total),
 	}, nil
 }
 
+// queryDartaPage runs the ListDartasBy* query for the page's sort order and
+// scan direction
+func (s *DartaServer) queryDartaPage(ctx context.Context, f db.CountDartasParams, page pageRequest) ([]db.Darta, error) {
+	id := page.cursorID()
+	var rows []db.Darta
+	var err error
+
+	if page.kind == sortByNumber {
+		var key int32
+		if key, err = page.numberKey(); err != nil {
+			return nil, err
+		}
+		arg := db.ListDartasByDartaNumberDescParams{
+			FiscalYearID: f.FiscalYearID, Scope: f.Scope, WardID: f.WardID, Status: f.Status,
+			Priority: f.Priority, IntakeChannel: f.IntakeChannel, AssignedToUnitID: f.AssignedToUnitID,
+			CurrentAssigneeID: f.CurrentAssigneeID, CreatedBy: f.CreatedBy, IsOverdue: f.IsOverdue,
+			FromDate: f.FromDate, ToDate: f.ToDate, Search: f.Search, TenantID: f.TenantID,
+			CursorKey: key, CursorID: id, Limit: page.fetchLimit(),
+		}
+		if page.scanDesc() {
+			rows, err = s.queries.ListDartasByDartaNumberDesc(ctx, arg)
+		} else {
+			rows, err = s.queries.ListDartasByDartaNumberAsc(ctx, db.ListDartasByDartaNumberAscParams(arg))
+		}
+	} else {
+		var key pgtype.Timestamptz
+		if key, err = page.timeKey(); err != nil {
+			return nil, err
+		}
+		arg := db.ListDartasByCreatedAtDescParams{
+			FiscalYearID: f.FiscalYearID, Scope: f.Scope, WardID: f.WardID, Status: f.Status,
+			Priority: f.Priority, IntakeChannel: f.IntakeChannel, AssignedToUnitID: f.AssignedToUnitID,
+			CurrentAssigneeID: f.CurrentAssigneeID, CreatedBy: f.CreatedBy, IsOverdue: f.IsOverdue,
+			FromDate: f.FromDate, ToDate: f.ToDate, Search: f.Search, TenantID: f.TenantID,
+			CursorKey: key, CursorID: id, Limit: page.fetchLimit(),
+		}
+		switch {
+		case page.sort == "received_date" && page.scanDesc():
+			rows, err = s.queries.ListDartasByReceivedDateDesc(ctx, db.ListDartasByReceivedDateDescParams(arg))
+		case page.sort == "received_date":
+			rows, err = s.queries.ListDartasByReceivedDateAsc(ctx, db.ListDartasByReceivedDateAscParams(arg))
+		case page.scanDesc():
+			rows, err = s.queries.ListDartasByCreatedAtDesc(ctx, arg)
+		default:
+			rows, err = s.queries.ListDartasByCreatedAtAsc(ctx, db.ListDartasByCreatedAtAscParams(arg))
+		}
+	}
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to list dartas: %w", err))
+	}
+	return rows, nil
+}
+
 // HealthCheck returns health status
 func (s *DartaServer) HealthCheck(ctx context.Context, req *dartav1.HealthCheckRequest) (*dartav1.HealthCheckResponse, error) {
 	return &dartav1.HealthCheckResponse{
@@ -438,50 +504,56 @@ func (s *DartaServer) GetDartaByNumber(ctx context.Context, req *dartav1.GetDart
 // ReviewDarta handles review decision (approve/reject/request changes)
 func (s *DartaServer) ReviewDarta(ctx context.Context, req *dartav1.ReviewDartaRequest) (*dartav1.ReviewDartaResponse, error) {
 	if req.Input == nil {
-		return nil, status.Error(codes.InvalidArgument, "input is required")
+		return nil, invalidArgument("input", "input is required")
 	}
 
 	dartaID, err := uuid.Parse(req.Input.DartaId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid darta ID: %v", err)
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
 	// Get current darta
-	dartaRow, err := s.queries.GetDarta(ctx, dartaID)
+	dartaRow, err := s.queries.GetDarta(ctx, db.GetDartaParams{ID: dartaID, TenantID: domain.GetUserContext(ctx).TenantID})
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "darta not found: %v", err)
+		return nil, notFound(ctx, err, domain.ErrDartaNotFound)
 	}
 	darta := buildDartaFromRow(&dartaRow)
+	if err := domain.CheckVersion(req.Input.ExpectedVersion, dartaRow.Version); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
 
 	// Validate current status
 	if darta.Status != dartav1.DartaStatus_DARTA_STATUS_PENDING_REVIEW {
-		return nil, status.Errorf(codes.FailedPrecondition, "darta must be in PENDING_REVIEW status")
+		return nil, mapDomainError(ctx, domain.NewTransitionError(domain.ErrInvalidDartaStatus, dartaRow.Status, "REVIEWED"))
 	}
 
-	// Determine new status based on decision
+	// Determine new status based on decision. Sending a darta back to its
+	// drafter needs a reason, the notes or else the information requested;
+	// approving it may carry notes. The creator of a darta may not review it.
 	var newStatus string
+	reason := req.Input.Notes
 	switch req.Input.Decision {
 	case dartav1.DartaReviewDecision_DARTA_REVIEW_DECISION_APPROVE_REVIEW:
 		newStatus = "CLASSIFICATION"
 	case dartav1.DartaReviewDecision_DARTA_REVIEW_DECISION_EDIT_REQUIRED:
-		newStatus = "RETURNED_FOR_CLARIFICATION"
+		newStatus = "DRAFT"
+		if reason == "" {
+			reason = req.Input.RequestedInfo
+		}
+		if reason, err = domain.ValidateReason("notes", reason); err != nil {
+			return nil, mapDomainError(ctx, err)
+		}
 	default:
-		return nil, status.Error(codes.InvalidArgument, "invalid review decision")
+		return nil, invalidArgument("decision", "invalid review decision")
 	}
 
-	// Update status
-	updated, err := s.queries.UpdateDartaStatus(ctx, db.UpdateDartaStatusParams{
-		ID:     dartaID,
-		Status: newStatus,
-	})
+	updated, err := s.dartaService.UpdateDartaStatusWithReason(ctx, dartaID, newStatus, reason, dartaRow.Version)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update status: %v", err)
+		return nil, mapDomainError(ctx, err)
 	}
 
-	// TODO: Create audit trail when queries support it
-
 	return &dartav1.ReviewDartaResponse{
-		Darta: toProtoDarta(&updated),
+		Darta: toProtoDarta(updated),
 	}, nil
 }
 
@@ -489,24 +561,24 @@ func (s *DartaServer) ReviewDarta(ctx context.Context, req *dartav1.ReviewDartaR
 func (s *DartaServer) DirectRegisterDarta(ctx context.Context, req *dartav1.DirectRegisterDartaRequest) (*dartav1.DirectRegisterDartaResponse, error) {
 	dartaID, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid darta ID: %v", err)
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
 	// Reserve number and finalize immediately
-	_, err = s.dartaService.ReserveDartaNumber(ctx, dartaID)
+	reserved, _, err := s.dartaService.ReserveDartaNumber(ctx, dartaID, req.AcknowledgeDuplicates, req.ExpectedVersion)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to reserve number: %v", err)
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to reserve number: %w", err))
 	}
 
-	_, err = s.dartaService.UpdateDartaStatus(ctx, dartaID, "REGISTERED")
+	_, err = s.dartaService.UpdateDartaStatus(ctx, dartaID, "REGISTERED", reserved.Version)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to finalize: %v", err)
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to finalize: %w", err))
 	}
 
 	// Fetch updated darta
-	updatedRow, err := s.queries.GetDarta(ctx, dartaID)
+	updatedRow, err := s.queries.GetDarta(ctx, db.GetDartaParams{ID: dartaID, TenantID: domain.GetUserContext(ctx).TenantID})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to fetch updated darta: %v", err)
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to fetch updated darta: %w", err))
 	}
 
 	return &dartav1.DirectRegisterDartaResponse{
@@ -514,57 +586,48 @@ func (s *DartaServer) DirectRegisterDarta(ctx context.Context, req *dartav1.Dire
 	}, nil
 }
 
-// ScanDarta updates scan metadata for physical document scanning
+// ScanDarta records the scan of a physical document in the darta's metadata
 func (s *DartaServer) ScanDarta(ctx context.Context, req *dartav1.ScanDartaRequest) (*dartav1.ScanDartaResponse, error) {
 	dartaID, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid darta ID: %v", err)
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
-	// Update metadata with scan information
-	scanMetadata := map[string]interface{}{
-		"scan_date":         time.Now(),
+	patch, _ := json.Marshal(map[string]interface{}{
+		"scan_date":          time.Now(),
 		"scan_attachment_id": req.ScanAttachmentId,
-	}
-	metadataJSON, _ := json.Marshal(scanMetadata)
-
-	updated, err := s.queries.UpdateDartaMetadata(ctx, db.UpdateDartaMetadataParams{
-		ID:       dartaID,
-		Metadata: metadataJSON,
 	})
+	updated, err := s.dartaService.PatchMetadata(ctx, dartaID, patch, req.ExpectedVersion, "SCANNED")
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update metadata: %v", err)
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &dartav1.ScanDartaResponse{
-		Darta: toProtoDarta(&updated),
+		Darta: toProtoDarta(updated),
 	}, nil
 }
 
-// EnrichDartaMetadata adds or updates metadata fields
+// EnrichDartaMetadata merges metadata into the darta's as a JSON merge patch
 func (s *DartaServer) EnrichDartaMetadata(ctx context.Context, req *dartav1.EnrichDartaMetadataRequest) (*dartav1.EnrichDartaMetadataResponse, error) {
 	dartaID, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid darta ID: %v", err)
+		return nil, invalidArgument("darta_id", "invalid darta ID")
+	}
+	if req.Metadata == nil {
+		return nil, invalidArgument("metadata", "metadata is required")
 	}
 
-	// Convert protobuf Struct to JSON
-	metadataJSON, err := req.Metadata.MarshalJSON()
+	patch, err := req.Metadata.MarshalJSON()
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid metadata: %v", err)
+		return nil, invalidArgument("metadata", "invalid metadata")
 	}
-
-	// Merge new metadata with existing
-	updated, err := s.queries.UpdateDartaMetadata(ctx, db.UpdateDartaMetadataParams{
-		ID:       dartaID,
-		Metadata: metadataJSON,
-	})
+	updated, err := s.dartaService.PatchMetadata(ctx, dartaID, patch, req.ExpectedVersion, "METADATA_ENRICHED")
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update metadata: %v", err)
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &dartav1.EnrichDartaMetadataResponse{
-		Darta: toProtoDarta(&updated),
+		Darta: toProtoDarta(updated),
 	}, nil
 }
 
@@ -572,22 +635,16 @@ func (s *DartaServer) EnrichDartaMetadata(ctx context.Context, req *dartav1.Enri
 func (s *DartaServer) FinalizeDartaArchive(ctx context.Context, req *dartav1.FinalizeDartaArchiveRequest) (*dartav1.FinalizeDartaArchiveResponse, error) {
 	dartaID, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid darta ID: %v", err)
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
-	// Update status to ARCHIVED
-	updated, err := s.queries.UpdateDartaStatus(ctx, db.UpdateDartaStatusParams{
-		ID:     dartaID,
-		Status: "ARCHIVED",
-	})
+	updated, err := s.dartaService.ArchiveDarta(ctx, dartaID, req.ExpectedVersion)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to archive: %v", err)
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to archive: %w", err))
 	}
 
-	// TODO: Create audit trail when queries support it
-
 	return &dartav1.FinalizeDartaArchiveResponse{
-		Darta: toProtoDarta(&updated),
+		Darta: toProtoDarta(updated),
 	}, nil
 }
 
@@ -595,13 +652,19 @@ func (s *DartaServer) FinalizeDartaArchive(ctx context.Context, req *dartav1.Fin
 func (s *DartaServer) SectionReviewDarta(ctx context.Context, req *dartav1.SectionReviewDartaRequest) (*dartav1.SectionReviewDartaResponse, error) {
 	dartaID, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid darta ID: %v", err)
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
 	// Simply get the darta and return it (proto doesn't define what section review does)
-	dartaRow, err := s.queries.GetDarta(ctx, dartaID)
+	dartaRow, err := s.queries.GetDarta(ctx, db.GetDartaParams{ID: dartaID, TenantID: domain.GetUserContext(ctx).TenantID})
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "darta not found: %v", err)
+		return nil, notFound(ctx, err, domain.ErrDartaNotFound)
+	}
+	if err := domain.CheckVersion(req.ExpectedVersion, dartaRow.Version); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	if err := recordActivity(ctx, s.queries, "DARTA", dartaID, "SECTION_REVIEWED", nil); err != nil {
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &dartav1.SectionReviewDartaResponse{
@@ -613,21 +676,17 @@ func (s *DartaServer) SectionReviewDarta(ctx context.Context, req *dartav1.Secti
 func (s *DartaServer) RequestDartaClarification(ctx context.Context, req *dartav1.RequestDartaClarificationRequest) (*dartav1.RequestDartaClarificationResponse, error) {
 	dartaID, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid darta ID: %v", err)
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
-	updated, err := s.queries.UpdateDartaStatus(ctx, db.UpdateDartaStatusParams{
-		ID:     dartaID,
-		Status: "RETURNED_FOR_CLARIFICATION",
-	})
+	// The section waits on the applicant; the response SLA pauses meanwhile
+	updated, err := s.dartaService.UpdateDartaStatus(ctx, dartaID, "NEEDS_CLARIFICATION", req.ExpectedVersion)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update status: %v", err)
+		return nil, mapDomainError(ctx, err)
 	}
 
-	// TODO: Create audit trail when queries support it
-
 	return &dartav1.RequestDartaClarificationResponse{
-		Darta: toProtoDarta(&updated),
+		Darta: toProtoDarta(updated),
 	}, nil
 }
 
@@ -635,22 +694,31 @@ func (s *DartaServer) RequestDartaClarification(ctx context.Context, req *dartav
 func (s *DartaServer) ProvideDartaClarification(ctx context.Context, req *dartav1.ProvideDartaClarificationRequest) (*dartav1.ProvideDartaClarificationResponse, error) {
 	dartaID, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid darta ID: %v", err)
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
-	// Move back to PENDING_REVIEW after clarification provided
-	updated, err := s.queries.UpdateDartaStatus(ctx, db.UpdateDartaStatusParams{
-		ID:     dartaID,
-		Status: "PENDING_REVIEW",
-	})
+	current, err := s.queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: dartaID, TenantID: domain.GetUserContext(ctx).TenantID})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update status: %v", err)
+		return nil, notFound(ctx, err, domain.ErrDartaNotFound)
+	}
+	if err := domain.CheckVersion(req.ExpectedVersion, current.Version); err != nil {
+		return nil, mapDomainError(ctx, err)
 	}
 
-	// TODO: Create audit trail when queries support it
+	// A clarified darta goes back to the section that asked; a draft the
+	// intake reviewer sent back, which ReviewDarta leaves in DRAFT, is
+	// resubmitted for review
+	next := "PENDING_REVIEW"
+	if current.Status == "NEEDS_CLARIFICATION" {
+		next = "IN_REVIEW_BY_SECTION"
+	}
+	updated, err := s.dartaService.UpdateDartaStatus(ctx, dartaID, next, current.Version)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
 
 	return &dartav1.ProvideDartaClarificationResponse{
-		Darta: toProtoDarta(&updated),
+		Darta: toProtoDarta(updated),
 	}, nil
 }
 
@@ -658,21 +726,16 @@ func (s *DartaServer) ProvideDartaClarification(ctx context.Context, req *dartav
 func (s *DartaServer) AcceptDarta(ctx context.Context, req *dartav1.AcceptDartaRequest) (*dartav1.AcceptDartaResponse, error) {
 	dartaID, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid darta ID: %v", err)
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
-	updated, err := s.queries.UpdateDartaStatus(ctx, db.UpdateDartaStatusParams{
-		ID:     dartaID,
-		Status: "UNDER_PROCESSING",
-	})
+	updated, err := s.dartaService.AcceptDarta(ctx, dartaID, req.ExpectedVersion)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update status: %v", err)
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to update status: %w", err))
 	}
 
-	// TODO: Create audit trail when queries support it
-
 	return &dartav1.AcceptDartaResponse{
-		Darta: toProtoDarta(&updated),
+		Darta: toProtoDarta(updated),
 	}, nil
 }
 
@@ -680,15 +743,22 @@ func (s *DartaServer) AcceptDarta(ctx context.Context, req *dartav1.AcceptDartaR
 func (s *DartaServer) MarkDartaAction(ctx context.Context, req *dartav1.MarkDartaActionRequest) (*dartav1.MarkDartaActionResponse, error) {
 	dartaID, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid darta ID: %v", err)
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
-	// TODO: Create audit trail for action when queries support it
-
 	// Fetch darta for response
-	dartaRow, err := s.queries.GetDarta(ctx, dartaID)
+	dartaRow, err := s.queries.GetDarta(ctx, db.GetDartaParams{ID: dartaID, TenantID: domain.GetUserContext(ctx).TenantID})
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "darta not found: %v", err)
+		return nil, notFound(ctx, err, domain.ErrDartaNotFound)
+	}
+
+	// The audit entry is the record of the action
+	changes := map[string]interface{}{"note": req.ActionNote}
+	if err := domain.CheckVersion(req.ExpectedVersion, dartaRow.Version); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	if err := recordActivity(ctx, s.queries, "DARTA", dartaID, "ACTION_MARKED", changes); err != nil {
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &dartav1.MarkDartaActionResponse{
@@ -700,22 +770,16 @@ func (s *DartaServer) MarkDartaAction(ctx context.Context, req *dartav1.MarkDart
 func (s *DartaServer) IssueDartaResponse(ctx context.Context, req *dartav1.IssueDartaResponseRequest) (*dartav1.IssueDartaResponseResponse, error) {
 	dartaID, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid darta ID: %v", err)
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
-	// Move to RESOLVED status
-	updated, err := s.queries.UpdateDartaStatus(ctx, db.UpdateDartaStatusParams{
-		ID:     dartaID,
-		Status: "RESOLVED",
-	})
+	updated, err := s.dartaService.UpdateDartaStatus(ctx, dartaID, "RESPONSE_ISSUED", req.ExpectedVersion)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update status: %v", err)
+		return nil, mapDomainError(ctx, err)
 	}
 
-	// TODO: Create audit trail when queries support it
-
 	return &dartav1.IssueDartaResponseResponse{
-		Darta: toProtoDarta(&updated),
+		Darta: toProtoDarta(updated),
 	}, nil
 }
 
@@ -723,14 +787,18 @@ func (s *DartaServer) IssueDartaResponse(ctx context.Context, req *dartav1.Issue
 func (s *DartaServer) RequestDartaAck(ctx context.Context, req *dartav1.RequestDartaAckRequest) (*dartav1.RequestDartaAckResponse, error) {
 	dartaID, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid darta ID: %v", err)
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
-	// TODO: Create audit trail for acknowledgement request when queries support it
-
-	dartaRow, err := s.queries.GetDarta(ctx, dartaID)
+	dartaRow, err := s.queries.GetDarta(ctx, db.GetDartaParams{ID: dartaID, TenantID: domain.GetUserContext(ctx).TenantID})
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "darta not found: %v", err)
+		return nil, notFound(ctx, err, domain.ErrDartaNotFound)
+	}
+	if err := domain.CheckVersion(req.ExpectedVersion, dartaRow.Version); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	if err := recordActivity(ctx, s.queries, "DARTA", dartaID, "ACK_REQUESTED", nil); err != nil {
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &dartav1.RequestDartaAckResponse{
@@ -742,14 +810,18 @@ func (s *DartaServer) RequestDartaAck(ctx context.Context, req *dartav1.RequestD
 func (s *DartaServer) ReceiveDartaAck(ctx context.Context, req *dartav1.ReceiveDartaAckRequest) (*dartav1.ReceiveDartaAckResponse, error) {
 	dartaID, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid darta ID: %v", err)
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
-	// TODO: Create audit trail for acknowledgement received when queries support it
-
-	dartaRow, err := s.queries.GetDarta(ctx, dartaID)
+	dartaRow, err := s.queries.GetDarta(ctx, db.GetDartaParams{ID: dartaID, TenantID: domain.GetUserContext(ctx).TenantID})
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "darta not found: %v", err)
+		return nil, notFound(ctx, err, domain.ErrDartaNotFound)
+	}
+	if err := domain.CheckVersion(req.ExpectedVersion, dartaRow.Version); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	if err := recordActivity(ctx, s.queries, "DARTA", dartaID, "ACK_RECEIVED", nil); err != nil {
+		return nil, mapDomainError(ctx, err)
 	}
 
 	return &dartav1.ReceiveDartaAckResponse{
@@ -761,34 +833,40 @@ func (s *DartaServer) ReceiveDartaAck(ctx context.Context, req *dartav1.ReceiveD
 func (s *DartaServer) SupersedeDartaRecord(ctx context.Context, req *dartav1.SupersedeDartaRecordRequest) (*dartav1.SupersedeDartaRecordResponse, error) {
 	dartaID, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid darta ID: %v", err)
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
 	supersededByID, err := uuid.Parse(req.NewDartaId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid new darta ID: %v", err)
+		return nil, invalidArgument("new_darta_id", "invalid new darta ID")
 	}
 
-	// Update metadata to mark as superseded
-	metadata := map[string]interface{}{
-		"superseded": true,
-		"superseded_by": supersededByID.String(),
-		"superseded_at": time.Now(),
-		"superseded_reason": req.Reason,
+	updated, err := s.dartaService.Supersede(ctx, dartaID, supersededByID, req.Reason, req.ExpectedVersion)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
 	}
-	metadataJSON, _ := json.Marshal(metadata)
 
-	updated, err := s.queries.UpdateDartaMetadata(ctx, db.UpdateDartaMetadataParams{
-		ID:       dartaID,
-		Metadata: metadataJSON,
-	})
+	return &dartav1.SupersedeDartaRecordResponse{
+		Darta: toProtoDarta(updated),
+	}, nil
+}
+
+// GetDartaSupersessionChain returns the dartas a darta replaced and was
+// replaced by, oldest first
+func (s *DartaServer) GetDartaSupersessionChain(ctx context.Context, req *dartav1.GetDartaSupersessionChainRequest) (*dartav1.GetDartaSupersessionChainResponse, error) {
+	dartaID, err := uuid.Parse(req.DartaId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update metadata: %v", err)
+		return nil, invalidArgument("darta_id", "invalid darta ID")
 	}
 
-	// TODO: Create audit trail when queries support it
+	chain, err := s.dartaService.SupersessionChain(ctx, dartaID)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
 
-	return &dartav1.SupersedeDartaRecordResponse{
-		Darta: toProtoDarta(&updated),
-	}, nil
+	resp := &dartav1.GetDartaSupersessionChainResponse{Dartas: make([]*dartav1.Darta, len(chain))}
+	for i := range chain {
+		resp.Dartas[i] = toProtoDarta(&chain[i])
+	}
+	return resp, nil
 }
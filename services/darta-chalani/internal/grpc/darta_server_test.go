@@ -0,0 +1,169 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
+)
+
+// reviewStore holds one darta pending intake review and records the audit
+// entries written
+type reviewStore struct {
+	db.Querier
+
+	mu        sync.Mutex
+	darta     db.Darta
+	entries   []db.CreateAuditEntryParams
+	statusErr error // returned by UpdateDartaStatus when set
+}
+
+func (r *reviewStore) GetDarta(ctx context.Context, arg db.GetDartaParams) (db.GetDartaRow, error) {
+	d, err := r.GetDartaSimple(ctx, db.GetDartaSimpleParams(arg))
+	if err != nil {
+		return db.GetDartaRow{}, err
+	}
+	return db.GetDartaRow{ID: d.ID, TenantID: d.TenantID, Status: d.Status, CreatedBy: d.CreatedBy, Version: d.Version}, nil
+}
+
+func (r *reviewStore) GetDartaSimple(ctx context.Context, arg db.GetDartaSimpleParams) (db.Darta, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if arg.ID != r.darta.ID || arg.TenantID != r.darta.TenantID {
+		return db.Darta{}, pgx.ErrNoRows
+	}
+	return r.darta, nil
+}
+
+func (r *reviewStore) HasPerformedAuditAction(ctx context.Context, arg db.HasPerformedAuditActionParams) (bool, error) {
+	return false, nil
+}
+
+func (r *reviewStore) UpdateDartaStatus(ctx context.Context, arg db.UpdateDartaStatusParams) (db.Darta, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.statusErr != nil {
+		return db.Darta{}, r.statusErr
+	}
+	if arg.ID != r.darta.ID || arg.TenantID != r.darta.TenantID || arg.Version != r.darta.Version {
+		return db.Darta{}, pgx.ErrNoRows
+	}
+	r.darta.Status = arg.Status
+	r.darta.Version++
+	return r.darta, nil
+}
+
+func (r *reviewStore) UpdateDartaClassification(ctx context.Context, arg db.UpdateDartaClassificationParams) (db.Darta, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if arg.ID != r.darta.ID || arg.Version != r.darta.Version {
+		return db.Darta{}, pgx.ErrNoRows
+	}
+	r.darta.ClassificationCode = arg.ClassificationCode
+	r.darta.Version++
+	return r.darta, nil
+}
+
+func (r *reviewStore) GetAuditChainHead(ctx context.Context, tenantID string) (db.GetAuditChainHeadRow, error) {
+	return db.GetAuditChainHeadRow{}, pgx.ErrNoRows
+}
+
+func (r *reviewStore) CreateAuditEntry(ctx context.Context, arg db.CreateAuditEntryParams) (db.AuditTrail, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, arg)
+	return db.AuditTrail{ID: arg.ID}, nil
+}
+
+func reviewAs(s *DartaServer, userID string, input *dartav1.ReviewDartaInput) (*dartav1.ReviewDartaResponse, error) {
+	ctx := domain.WithUserContext(context.Background(), &domain.UserContext{TenantID: "t1", UserID: userID})
+	return s.ReviewDarta(ctx, &dartav1.ReviewDartaRequest{Input: input})
+}
+
+func TestReviewDartaSendsBackToDraft(t *testing.T) {
+	store := &reviewStore{darta: db.Darta{
+		ID:        uuid.New(),
+		TenantID:  "t1",
+		Status:    "PENDING_REVIEW",
+		CreatedBy: "clerk",
+		Version:   1,
+	}}
+	s := NewDartaServer(domain.NewDartaService(store, domain.DuplicatePolicy{}), store, nil)
+	sendBack := func(notes string) *dartav1.ReviewDartaInput {
+		return &dartav1.ReviewDartaInput{
+			DartaId:         store.darta.ID.String(),
+			Decision:        dartav1.DartaReviewDecision_DARTA_REVIEW_DECISION_EDIT_REQUIRED,
+			Notes:           notes,
+			ExpectedVersion: 1,
+		}
+	}
+
+	if _, err := reviewAs(s, "reviewer", sendBack("")); errorField(t, err) != "notes" {
+		t.Fatalf("sending back without a reason: %v, want notes rejected", err)
+	}
+	if _, err := reviewAs(s, "clerk", sendBack("applicant's citizenship number is missing")); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("creator sending back: %v, want PermissionDenied", err)
+	}
+
+	resp, err := reviewAs(s, "reviewer", sendBack("applicant's citizenship number is missing"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Darta.Status != dartav1.DartaStatus_DARTA_STATUS_DRAFT || store.darta.Status != "DRAFT" {
+		t.Errorf("status = %v (stored %s), want DRAFT", resp.Darta.Status, store.darta.Status)
+	}
+
+	review := store.entries[len(store.entries)-1]
+	if review.Action != domain.DutyReview || review.PerformedBy != "reviewer" {
+		t.Errorf("review audited as %s by %s, want %s by reviewer", review.Action, review.PerformedBy, domain.DutyReview)
+	}
+	if review.Notes == nil || *review.Notes != "applicant's citizenship number is missing" {
+		t.Errorf("review reason = %v, want the notes", review.Notes)
+	}
+}
+
+func TestClassifyDartaCompletesReview(t *testing.T) {
+	newStore := func() *reviewStore {
+		return &reviewStore{darta: db.Darta{
+			ID:        uuid.New(),
+			TenantID:  "t1",
+			Status:    "PENDING_REVIEW",
+			CreatedBy: "clerk",
+			Version:   1,
+		}}
+	}
+	classify := func(store *reviewStore) (*dartav1.ClassifyDartaResponse, error) {
+		s := NewDartaServer(domain.NewDartaService(store, domain.DuplicatePolicy{}), store, nil)
+		ctx := domain.WithUserContext(context.Background(), &domain.UserContext{TenantID: "t1", UserID: "reviewer"})
+		return s.ClassifyDarta(ctx, &dartav1.ClassifyDartaRequest{
+			DartaId:            store.darta.ID.String(),
+			ClassificationCode: "REV-01",
+			ExpectedVersion:    1,
+		})
+	}
+
+	store := newStore()
+	resp, err := classify(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Darta.Status != dartav1.DartaStatus_DARTA_STATUS_CLASSIFICATION {
+		t.Errorf("status = %v, want CLASSIFICATION", resp.Darta.Status)
+	}
+
+	// A failed status change must not be reported as a classified darta
+	store = newStore()
+	store.statusErr = errors.New("connection reset")
+	if _, err := classify(store); status.Code(err) != codes.Internal {
+		t.Errorf("status change failing: %v, want Internal", err)
+	}
+}
@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
+)
+
+// ListDuplicateCandidates returns the suspected duplicates of a darta
+func (s *DartaServer) ListDuplicateCandidates(ctx context.Context, req *dartav1.ListDuplicateCandidatesRequest) (*dartav1.ListDuplicateCandidatesResponse, error) {
+	id, err := uuid.Parse(req.DartaId)
+	if err != nil {
+		return nil, invalidArgument("darta_id", "invalid darta ID")
+	}
+
+	candidates, err := s.dartaService.ListDuplicates(ctx, id)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	return &dartav1.ListDuplicateCandidatesResponse{
+		Candidates: toProtoDuplicateCandidates(candidates),
+	}, nil
+}
+
+// LinkDuplicateDarta links a darta to the earlier darta it duplicates
+func (s *DartaServer) LinkDuplicateDarta(ctx context.Context, req *dartav1.LinkDuplicateDartaRequest) (*dartav1.LinkDuplicateDartaResponse, error) {
+	id, err := uuid.Parse(req.DartaId)
+	if err != nil {
+		return nil, invalidArgument("darta_id", "invalid darta ID")
+	}
+	duplicateOf, err := uuid.Parse(req.DuplicateOfId)
+	if err != nil {
+		return nil, invalidArgument("duplicate_of_id", "invalid darta ID")
+	}
+
+	darta, err := s.dartaService.LinkDuplicate(ctx, id, duplicateOf, req.Notes, req.ExpectedVersion)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	return &dartav1.LinkDuplicateDartaResponse{
+		Darta: toProtoDarta(darta),
+	}, nil
+}
+
+func toProtoDuplicateCandidates(candidates []domain.DuplicateCandidate) []*dartav1.DuplicateCandidate {
+	out := make([]*dartav1.DuplicateCandidate, len(candidates))
+	for i, c := range candidates {
+		out[i] = &dartav1.DuplicateCandidate{
+			DartaId:              c.DartaID.String(),
+			FormattedDartaNumber: nullStringToPtr(c.FormattedDartaNumber),
+			Subject:              c.Subject,
+			Status:               stringToDartaStatus(c.Status),
+			WardId:               nullStringToPtr(c.WardID),
+			ReceivedDate:         timestamppb.New(c.ReceivedDate),
+			ApplicantName:        c.ApplicantName,
+			Score:                c.Score,
+			Reasons:              c.Reasons,
+		}
+	}
+	return out
+}
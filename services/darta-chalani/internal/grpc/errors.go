@@ -0,0 +1,138 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
+)
+
+// Error codes carried in ErrorDetail.code. The gateway exposes them as
+// GraphQL extensions.code, so they must stay stable.
+const (
+	ErrCodeValidationFailed  = "VALIDATION_FAILED"
+	ErrCodeNotFound          = "NOT_FOUND"
+	ErrCodeInvalidTransition = "INVALID_TRANSITION"
+	ErrCodeForbidden         = "FORBIDDEN"
+	ErrCodeUnauthenticated   = "UNAUTHENTICATED"
+	ErrCodeConflict          = "CONFLICT"
+	ErrCodeInternal          = "INTERNAL"
+)
+
+// statusError builds a gRPC status error with an ErrorDetail attached.
+// field is the path of the offending request field relative to the request's
+// input message, e.g. "applicant.full_name".
+func statusError(c codes.Code, code, message, field string, meta map[string]interface{}) error {
+	detail := &dartav1.ErrorDetail{
+		Code:    code,
+		Message: message,
+		Field:   field,
+	}
+	if len(meta) > 0 {
+		if s, err := structpb.NewStruct(meta); err == nil {
+			detail.Metadata = s
+		}
+	}
+
+	st, err := status.New(c, message).WithDetails(detail)
+	if err != nil {
+		return status.Error(c, message)
+	}
+	return st.Err()
+}
+
+// invalidArgument reports a malformed request field
+func invalidArgument(field, message string) error {
+	return statusError(codes.InvalidArgument, ErrCodeValidationFailed, message, field, nil)
+}
+
+// notFound reports a missing record, treating any other lookup failure as
+// internal
+func notFound(ctx context.Context, err error, missing error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return mapDomainError(ctx, missing)
+	}
+	return mapDomainError(ctx, err)
+}
+
+// mapDomainError maps domain errors to gRPC status codes with an ErrorDetail.
+// Unrecognised errors are logged and returned without their message so that
+// database errors never reach clients.
+func mapDomainError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var meta map[string]interface{}
+	var transErr *domain.TransitionError
+	if errors.As(err, &transErr) {
+		meta = map[string]interface{}{"from": transErr.From, "to": transErr.To}
+	}
+	var dupErr *domain.DuplicateError
+	if errors.As(err, &dupErr) {
+		ids := make([]interface{}, len(dupErr.Candidates))
+		for i, c := range dupErr.Candidates {
+			ids[i] = c.DartaID.String()
+		}
+		meta = map[string]interface{}{"duplicate_of": ids, "score": dupErr.Candidates[0].Score}
+	}
+	var versionErr *domain.VersionConflictError
+	if errors.As(err, &versionErr) {
+		meta = map[string]interface{}{"expectedVersion": versionErr.Expected, "currentVersion": versionErr.Current}
+	}
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows),
+		errors.Is(err, domain.ErrNotFound),
+		errors.Is(err, domain.ErrDartaNotFound),
+		errors.Is(err, domain.ErrChalaniNotFound),
+		errors.Is(err, domain.ErrAttachmentNotFound):
+		return statusError(codes.NotFound, ErrCodeNotFound, err.Error(), "", nil)
+	case errors.Is(err, domain.ErrInvalidDartaStatus),
+		errors.Is(err, domain.ErrInvalidChalaniStatus),
+		errors.Is(err, domain.ErrNotFullyApproved):
+		return statusError(codes.FailedPrecondition, ErrCodeInvalidTransition, err.Error(), "", meta)
+	case errors.Is(err, domain.ErrInvalidInput),
+		errors.Is(err, domain.ErrInvalidFileType),
+		errors.Is(err, domain.ErrFileTooLarge):
+		return statusError(codes.InvalidArgument, ErrCodeValidationFailed, err.Error(), "", nil)
+	case errors.Is(err, domain.ErrVersionConflict):
+		return statusError(codes.Aborted, ErrCodeConflict, err.Error(), "", meta)
+	case errors.Is(err, domain.ErrAlreadyExists),
+		errors.Is(err, domain.ErrDuplicateDarta),
+		errors.Is(err, domain.ErrDuplicateChalani),
+		errors.Is(err, domain.ErrDartaNumberExists),
+		errors.Is(err, domain.ErrConflict):
+		return statusError(codes.AlreadyExists, ErrCodeConflict, err.Error(), "", meta)
+	case errors.Is(err, domain.ErrUnauthorized):
+		return statusError(codes.Unauthenticated, ErrCodeUnauthenticated, err.Error(), "", nil)
+	case errors.Is(err, domain.ErrForbidden),
+		errors.Is(err, domain.ErrSoDViolation):
+		var domErr *domain.DomainError
+		if errors.As(err, &domErr) && domErr.Code != "" {
+			meta = map[string]interface{}{"reason": domErr.Code}
+		}
+		return statusError(codes.PermissionDenied, ErrCodeForbidden, err.Error(), "", meta)
+	}
+
+	var valErr *domain.ValidationError
+	if errors.As(err, &valErr) {
+		return statusError(codes.InvalidArgument, ErrCodeValidationFailed, valErr.Message, valErr.Field, nil)
+	}
+
+	userCtx := domain.GetUserContext(ctx)
+	log.Printf("internal error request_id=%s tenant=%s: %v", userCtx.RequestID, userCtx.TenantID, err)
+	return statusError(codes.Internal, ErrCodeInternal, "internal error", "", map[string]interface{}{
+		"requestId": userCtx.RequestID,
+	})
+}
@@ -0,0 +1,335 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
+)
+
+// watchStatusHeader is sent once a Watch stream has been authorised
+const watchStatusHeader = "x-watch-status"
+
+// subscriberBuffer is how many events a slow watcher may fall behind before
+// events are dropped for it
+const subscriberBuffer = 64
+
+// event is a darta or chalani change published to watchers in the same tenant
+type event struct {
+	tenantID string
+	darta    *dartav1.DartaEvent
+	chalani  *dartav1.ChalaniEvent
+}
+
+type subscriber struct {
+	tenantID string
+	ch       chan event
+}
+
+// EventHub fans darta and chalani mutations out to the Watch streams. Events
+// are delivered in-process, so each replica serves the mutations it handled.
+type EventHub struct {
+	mu   sync.RWMutex
+	subs map[*subscriber]struct{}
+}
+
+// NewEventHub creates an empty EventHub
+func NewEventHub() *EventHub {
+	return &EventHub{subs: make(map[*subscriber]struct{})}
+}
+
+// subscribe registers a watcher for tenantID. The returned function
+// unregisters it and must be called when the stream ends.
+func (h *EventHub) subscribe(tenantID string) (*subscriber, func()) {
+	sub := &subscriber{tenantID: tenantID, ch: make(chan event, subscriberBuffer)}
+
+	h.mu.Lock()
+	h.subs[sub] = struct{}{}
+	h.mu.Unlock()
+
+	return sub, func() {
+		h.mu.Lock()
+		delete(h.subs, sub)
+		h.mu.Unlock()
+	}
+}
+
+// publish delivers ev to every watcher in its tenant without blocking
+func (h *EventHub) publish(ev event) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for sub := range h.subs {
+		if sub.tenantID != ev.tenantID {
+			continue
+		}
+		select {
+		case sub.ch <- ev:
+		default:
+			log.Printf("dropping event for slow watcher in tenant %s", ev.tenantID)
+		}
+	}
+}
+
+// mutations are the full gRPC method names of the state-changing calls.
+// Everything else, including reads named like actions such as
+// VerifyAuditChain and ExportRegister, is left alone by the idempotency,
+// event, search and SLA interceptors. A new mutating RPC must be added here.
+var mutations = map[string]bool{
+	dartav1.DartaService_CreateDarta_FullMethodName:               true,
+	dartav1.DartaService_SubmitDartaForReview_FullMethodName:      true,
+	dartav1.DartaService_ReviewDarta_FullMethodName:               true,
+	dartav1.DartaService_ClassifyDarta_FullMethodName:             true,
+	dartav1.DartaService_ReserveDartaNumber_FullMethodName:        true,
+	dartav1.DartaService_FinalizeDartaRegistration_FullMethodName: true,
+	dartav1.DartaService_DirectRegisterDarta_FullMethodName:       true,
+	dartav1.DartaService_VoidDarta_FullMethodName:                 true,
+	dartav1.DartaService_ScanDarta_FullMethodName:                 true,
+	dartav1.DartaService_EnrichDartaMetadata_FullMethodName:       true,
+	dartav1.DartaService_FinalizeDartaArchive_FullMethodName:      true,
+	dartav1.DartaService_RouteDarta_FullMethodName:                true,
+	dartav1.DartaService_SectionReviewDarta_FullMethodName:        true,
+	dartav1.DartaService_RequestDartaClarification_FullMethodName: true,
+	dartav1.DartaService_ProvideDartaClarification_FullMethodName: true,
+	dartav1.DartaService_AcceptDarta_FullMethodName:               true,
+	dartav1.DartaService_MarkDartaAction_FullMethodName:           true,
+	dartav1.DartaService_IssueDartaResponse_FullMethodName:        true,
+	dartav1.DartaService_RequestDartaAck_FullMethodName:           true,
+	dartav1.DartaService_ReceiveDartaAck_FullMethodName:           true,
+	dartav1.DartaService_SupersedeDartaRecord_FullMethodName:      true,
+	dartav1.DartaService_CloseDarta_FullMethodName:                true,
+	dartav1.DartaService_LinkDuplicateDarta_FullMethodName:        true,
+
+	dartav1.ChalaniService_CreateChalani_FullMethodName:                  true,
+	dartav1.ChalaniService_SubmitChalani_FullMethodName:                  true,
+	dartav1.ChalaniService_ReviewChalani_FullMethodName:                  true,
+	dartav1.ChalaniService_ApproveChalani_FullMethodName:                 true,
+	dartav1.ChalaniService_ReserveChalaniNumber_FullMethodName:           true,
+	dartav1.ChalaniService_FinalizeChalaniRegistration_FullMethodName:    true,
+	dartav1.ChalaniService_DirectRegisterChalani_FullMethodName:          true,
+	dartav1.ChalaniService_SignChalani_FullMethodName:                    true,
+	dartav1.ChalaniService_SealChalani_FullMethodName:                    true,
+	dartav1.ChalaniService_DispatchChalani_FullMethodName:                true,
+	dartav1.ChalaniService_MarkChalaniInTransit_FullMethodName:           true,
+	dartav1.ChalaniService_AcknowledgeChalani_FullMethodName:             true,
+	dartav1.ChalaniService_MarkChalaniDelivered_FullMethodName:           true,
+	dartav1.ChalaniService_MarkChalaniReturnedUndelivered_FullMethodName: true,
+	dartav1.ChalaniService_ResendChalani_FullMethodName:                  true,
+	dartav1.ChalaniService_VoidChalani_FullMethodName:                    true,
+	dartav1.ChalaniService_SupersedeChalani_FullMethodName:               true,
+	dartav1.ChalaniService_CloseChalani_FullMethodName:                   true,
+
+	dartav1.MetadataSchemaService_SetMetadataSchema_FullMethodName:    true,
+	dartav1.MetadataSchemaService_DeleteMetadataSchema_FullMethodName: true,
+
+	dartav1.SLAService_UpdateBusinessCalendar_FullMethodName: true,
+	dartav1.SLAService_SetHoliday_FullMethodName:             true,
+	dartav1.SLAService_RemoveHoliday_FullMethodName:          true,
+	dartav1.SLAService_SetSLAPolicy_FullMethodName:           true,
+	dartav1.SLAService_DeleteSLAPolicy_FullMethodName:        true,
+	dartav1.SLAService_SetUnitHead_FullMethodName:            true,
+}
+
+// isMutation reports whether a full gRPC method name is a state-changing call
+func isMutation(fullMethod string) bool {
+	return mutations[fullMethod]
+}
+
+// UnaryEventInterceptor publishes an event for every successful darta or
+// chalani mutation, using the record returned in the response
+func UnaryEventInterceptor(hub *EventHub, queries db.Querier) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !isMutation(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		// Routing changes the assignee; remember who loses the darta so
+		// their queue is updated too
+		var previousAssignee string
+		if r, ok := req.(*dartav1.RouteDartaRequest); ok && r.Input != nil {
+			if id, err := uuid.Parse(r.Input.DartaId); err == nil {
+				if current, err := queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: id, TenantID: domain.GetUserContext(ctx).TenantID}); err == nil && current.CurrentAssigneeID != nil {
+					previousAssignee = *current.CurrentAssigneeID
+				}
+			}
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+
+		userCtx := domain.GetUserContext(ctx)
+		action := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+		now := timestamppb.Now()
+
+		if r, ok := resp.(interface{ GetDarta() *dartav1.Darta }); ok && r.GetDarta() != nil {
+			hub.publish(event{
+				tenantID: userCtx.TenantID,
+				darta: &dartav1.DartaEvent{
+					Action:             action,
+					Darta:              r.GetDarta(),
+					ActorId:            userCtx.UserID,
+					PreviousAssigneeId: previousAssignee,
+					OccurredAt:         now,
+				},
+			})
+		}
+		if r, ok := resp.(interface{ GetChalani() *dartav1.Chalani }); ok && r.GetChalani() != nil {
+			hub.publish(event{
+				tenantID: userCtx.TenantID,
+				chalani: &dartav1.ChalaniEvent{
+					Action:     action,
+					Chalani:    r.GetChalani(),
+					ActorId:    userCtx.UserID,
+					OccurredAt: now,
+				},
+			})
+		}
+
+		return resp, nil
+	}
+}
+
+// hasAnyRole reports whether the caller holds one of roles
+func hasAnyRole(userCtx *domain.UserContext, roles ...string) bool {
+	for _, held := range userCtx.Roles {
+		for _, role := range roles {
+			if held == role {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Roles allowed to watch every darta in their tenant
+var tenantWideWatchRoles = []string{"darta_reviewer", "darta_registrar"}
+
+// Roles allowed to watch chalani dispatch and delivery
+var dispatchWatchRoles = []string{"chalani_dispatcher", "chalani_approver"}
+
+// dispatchStatuses are the chalani statuses of the dispatch and delivery stages
+var dispatchStatuses = map[dartav1.ChalaniStatus]bool{
+	dartav1.ChalaniStatus_CHALANI_STATUS_SEALED:               true,
+	dartav1.ChalaniStatus_CHALANI_STATUS_DISPATCHED:           true,
+	dartav1.ChalaniStatus_CHALANI_STATUS_IN_TRANSIT:           true,
+	dartav1.ChalaniStatus_CHALANI_STATUS_ACKNOWLEDGED:         true,
+	dartav1.ChalaniStatus_CHALANI_STATUS_RETURNED_UNDELIVERED: true,
+	dartav1.ChalaniStatus_CHALANI_STATUS_DELIVERED:            true,
+}
+
+// WatchDartas streams darta events in the caller's tenant. Watching a single
+// darta requires it to belong to the tenant, watching the caller's queue is
+// always allowed, and watching every darta requires a reviewer or registrar
+// role.
+func (s *DartaServer) WatchDartas(req *dartav1.WatchDartasRequest, stream dartav1.DartaService_WatchDartasServer) error {
+	ctx := stream.Context()
+	userCtx := domain.GetUserContext(ctx)
+
+	if req.DartaId != "" {
+		id, err := uuid.Parse(req.DartaId)
+		if err != nil {
+			return invalidArgument("darta_id", "invalid darta ID")
+		}
+		if _, err := s.queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: id, TenantID: userCtx.TenantID}); err != nil {
+			return notFound(ctx, err, domain.ErrDartaNotFound)
+		}
+	} else if !req.AssignedToMe && !hasAnyRole(userCtx, tenantWideWatchRoles...) {
+		return mapDomainError(ctx, domain.NewDomainError(domain.ErrForbidden, "watching all dartas requires a reviewer or registrar role", ""))
+	}
+
+	sub, unsubscribe := s.events.subscribe(userCtx.TenantID)
+	defer unsubscribe()
+
+	// Headers tell the caller the watch was accepted
+	if err := stream.SendHeader(metadata.Pairs(watchStatusHeader, "ready")); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev := <-sub.ch:
+			if ev.darta == nil || !dartaWatchMatches(req, userCtx.UserID, ev.darta) {
+				continue
+			}
+			if err := stream.Send(ev.darta); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func dartaWatchMatches(req *dartav1.WatchDartasRequest, userID string, ev *dartav1.DartaEvent) bool {
+	if req.DartaId != "" && ev.Darta.GetId() != req.DartaId {
+		return false
+	}
+	if req.AssignedToMe {
+		return ev.Darta.GetCurrentAssignee().GetId() == userID || ev.PreviousAssigneeId == userID ||
+			ev.EscalatedToId == userID
+	}
+	return true
+}
+
+// WatchChalanis streams chalani events in the caller's tenant. Watching the
+// dispatch stage requires a dispatcher or approver role.
+func (s *ChalaniServer) WatchChalanis(req *dartav1.WatchChalanisRequest, stream dartav1.ChalaniService_WatchChalanisServer) error {
+	ctx := stream.Context()
+	userCtx := domain.GetUserContext(ctx)
+
+	if req.ChalaniId != "" {
+		id, err := uuid.Parse(req.ChalaniId)
+		if err != nil {
+			return invalidArgument("chalani_id", "invalid chalani ID")
+		}
+		if _, err := s.queries.GetChalaniSimple(ctx, db.GetChalaniSimpleParams{ID: id, TenantID: userCtx.TenantID}); err != nil {
+			return notFound(ctx, err, domain.ErrChalaniNotFound)
+		}
+	} else if !hasAnyRole(userCtx, dispatchWatchRoles...) {
+		return mapDomainError(ctx, domain.NewDomainError(domain.ErrForbidden, "watching chalanis requires a dispatcher or approver role", ""))
+	}
+
+	sub, unsubscribe := s.events.subscribe(userCtx.TenantID)
+	defer unsubscribe()
+
+	// Headers tell the caller the watch was accepted
+	if err := stream.SendHeader(metadata.Pairs(watchStatusHeader, "ready")); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev := <-sub.ch:
+			if ev.chalani == nil {
+				continue
+			}
+			if req.ChalaniId != "" && ev.chalani.Chalani.GetId() != req.ChalaniId {
+				continue
+			}
+			if req.DispatchOnly && !dispatchStatuses[ev.chalani.Chalani.GetStatus()] {
+				continue
+			}
+			if err := stream.Send(ev.chalani); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc"
+
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+)
+
+// reads are the RPCs that change nothing, by service and method name
+var reads = map[string]bool{
+	"AuditService/ListAuditEntries":  true,
+	"AuditService/GetEntityTimeline": true,
+	"AuditService/VerifyAuditChain":  true,
+	"AuditService/ListAuditAnchors":  true,
+
+	"ChalaniService/GetChalani":           true,
+	"ChalaniService/GetChalaniByNumber":   true,
+	"ChalaniService/ListChalanis":         true,
+	"ChalaniService/GetMyChalani":         true,
+	"ChalaniService/GetChalaniStats":      true,
+	"ChalaniService/ListChalaniTemplates": true,
+	"ChalaniService/GetChalaniTemplate":   true,
+	"ChalaniService/WatchChalanis":        true,
+	"ChalaniService/HealthCheck":          true,
+
+	"DartaService/GetDarta":                  true,
+	"DartaService/GetDartaByNumber":          true,
+	"DartaService/ListDartas":                true,
+	"DartaService/GetMyDartas":               true,
+	"DartaService/GetDartaStats":             true,
+	"DartaService/GetDartaSupersessionChain": true,
+	"DartaService/ListDuplicateCandidates":   true,
+	"DartaService/BatchGetDartas":            true,
+	"DartaService/BatchGetApplicants":        true,
+	"DartaService/BatchGetAttachments":       true,
+	"DartaService/BatchGetDartaLinks":        true,
+	"DartaService/BatchGetAuditTrails":       true,
+	"DartaService/SearchRecords":             true,
+	"DartaService/WatchDartas":               true,
+	"DartaService/HealthCheck":               true,
+
+	"MetadataSchemaService/ListMetadataSchemas": true,
+
+	"RegisterService/ExportRegister": true,
+
+	"SLAService/GetBusinessCalendar": true,
+	"SLAService/ListSLAPolicies":     true,
+	"SLAService/ListUnitHeads":       true,
+	"SLAService/ListSLAClocks":       true,
+}
+
+// TestIsMutationClassifiesEveryRPC walks every service so that a new RPC
+// fails here until it is listed as a mutation or a read
+func TestIsMutationClassifiesEveryRPC(t *testing.T) {
+	services := []grpc.ServiceDesc{
+		dartav1.AuditService_ServiceDesc,
+		dartav1.ChalaniService_ServiceDesc,
+		dartav1.DartaService_ServiceDesc,
+		dartav1.MetadataSchemaService_ServiceDesc,
+		dartav1.RegisterService_ServiceDesc,
+		dartav1.SLAService_ServiceDesc,
+	}
+	seen := map[string]bool{}
+	for _, sd := range services {
+		var names []string
+		for _, m := range sd.Methods {
+			names = append(names, m.MethodName)
+		}
+		for _, s := range sd.Streams {
+			names = append(names, s.StreamName)
+		}
+		for _, name := range names {
+			fullMethod := "/" + sd.ServiceName + "/" + name
+			short := sd.ServiceName[len("darta.v1."):] + "/" + name
+			seen[fullMethod] = true
+			if got, want := isMutation(fullMethod), !reads[short]; got != want {
+				t.Errorf("isMutation(%s) = %v, want %v", fullMethod, got, want)
+			}
+		}
+	}
+	for fullMethod := range mutations {
+		if !seen[fullMethod] {
+			t.Errorf("%s is listed as a mutation but no service has it", fullMethod)
+		}
+	}
+}
+
+func TestIsMutationIgnoresOtherServices(t *testing.T) {
+	for _, fullMethod := range []string{
+		"/grpc.health.v1.Health/Check",
+		"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+		"/darta.v1.DartaService/CreateDartaLater",
+		"CreateDarta",
+	} {
+		if isMutation(fullMethod) {
+			t.Errorf("isMutation(%s) = true", fullMethod)
+		}
+	}
+}
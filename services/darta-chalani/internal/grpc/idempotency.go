@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/idempotency"
+)
+
+// maxIdempotencyKeyLength matches the idempotency_key columns
+const maxIdempotencyKeyLength = 255
+
+// requestIdempotencyKey returns the idempotency key carried in the request
+// itself, for the RPCs that have one
+func requestIdempotencyKey(req interface{}) string {
+	switch r := req.(type) {
+	case *dartav1.CreateDartaRequest:
+		return r.GetInput().GetIdempotencyKey()
+	case *dartav1.CreateChalaniRequest:
+		return r.GetInput().GetIdempotencyKey()
+	}
+	return ""
+}
+
+// UnaryIdempotencyInterceptor applies a mutation sent with an idempotency
+// key at most once per tenant. The key is read from the idempotency-key
+// metadata, else from the request. A retry with the same key and request
+// replays the stored response, and the same key with a different request
+// is rejected. Only successful responses are stored, so a failed request
+// can be retried as is.
+func UnaryIdempotencyInterceptor(store *idempotency.Store) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !isMutation(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		var key string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			key = getMetadataValue(md, "idempotency-key")
+		}
+		if key == "" {
+			key = requestIdempotencyKey(req)
+		}
+		msg, ok := req.(proto.Message)
+		if key == "" || !ok {
+			return handler(ctx, req)
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			return nil, invalidArgument("idempotency_key", "must be at most 255 characters")
+		}
+
+		r, err := idempotency.NewRequest(domain.GetUserContext(ctx).TenantID, key, info.FullMethod, msg)
+		if err != nil {
+			return nil, mapDomainError(ctx, err)
+		}
+		stored, err := store.Claim(ctx, r)
+		switch {
+		case errors.Is(err, idempotency.ErrKeyReused):
+			return nil, statusError(codes.AlreadyExists, ErrCodeConflict, err.Error(), "idempotency_key", nil)
+		case errors.Is(err, idempotency.ErrInProgress):
+			return nil, statusError(codes.Aborted, ErrCodeConflict, err.Error(), "idempotency_key", nil)
+		case err != nil:
+			return nil, mapDomainError(ctx, err)
+		case stored != nil:
+			return stored, nil
+		}
+
+		// The outcome is recorded even if the caller has gone away, since
+		// that is when a retry is most likely
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if releaseErr := store.Release(context.WithoutCancel(ctx), r); releaseErr != nil {
+				log.Printf("%s: %v", info.FullMethod, releaseErr)
+			}
+			return resp, err
+		}
+		if respMsg, ok := resp.(proto.Message); ok {
+			if completeErr := store.Complete(context.WithoutCancel(ctx), r, respMsg); completeErr != nil {
+				log.Printf("%s: %v", info.FullMethod, completeErr)
+			}
+		}
+		return resp, nil
+	}
+}
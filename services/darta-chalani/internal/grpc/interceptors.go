@@ -5,12 +5,20 @@ import (
 	"strings"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 
 	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
 )
 
+// unauthenticatedServices may be called without a caller identity
+var unauthenticatedServices = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.",
+}
+
 // UnaryAuthInterceptor extracts authentication context from gRPC metadata
+// and rejects calls that carry no user or tenant
 func UnaryAuthInterceptor() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -18,36 +26,98 @@ func UnaryAuthInterceptor() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		// Extract metadata from context
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			// No metadata, use defaults
+		if isUnauthenticated(info.FullMethod) {
 			return handler(ctx, req)
 		}
-
-		// Extract headers injected by Oathkeeper
-		userCtx := &domain.UserContext{
-			UserID:    getMetadataValue(md, "x-user-id"),
-			TenantID:  getMetadataValue(md, "x-tenant"),
-			Roles:     getMetadataValues(md, "x-roles"),
-			RequestID: getMetadataValue(md, "x-request-id"),
-			IPAddress: getMetadataValue(md, "x-forwarded-for"),
-			UserAgent: getMetadataValue(md, "user-agent"),
+		ctx, err := withAuthContext(ctx)
+		if err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		// Set defaults if missing
-		if userCtx.TenantID == "" {
-			userCtx.TenantID = "default"
+// StreamAuthInterceptor extracts authentication context from gRPC metadata
+// for streaming calls
+func StreamAuthInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if isUnauthenticated(info.FullMethod) {
+			return handler(srv, ss)
 		}
-		if userCtx.UserID == "" {
-			userCtx.UserID = "system"
+		ctx, err := withAuthContext(ss.Context())
+		if err != nil {
+			return err
 		}
+		return handler(srv, &authServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		// Add to context
-		ctx = domain.WithUserContext(ctx, userCtx)
+func isUnauthenticated(method string) bool {
+	for _, prefix := range unauthenticatedServices {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
 
-		return handler(ctx, req)
+// authServerStream overrides the context of a server stream
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// withAuthContext adds the user context carried in the incoming metadata.
+// Audit entries are attributed to that user, so a call without one fails
+// rather than being recorded against a placeholder.
+func withAuthContext(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	// Extract headers injected by Oathkeeper
+	userCtx := &domain.UserContext{
+		UserID:    getMetadataValue(md, "x-user-id"),
+		TenantID:  getMetadataValue(md, "x-tenant"),
+		Roles:     getMetadataValues(md, "x-roles"),
+		RequestID: getMetadataValue(md, "x-request-id"),
+		IPAddress: getMetadataValue(md, "x-forwarded-for"),
+		// The gateway forwards the browser's user agent; the user-agent
+		// key holds the gateway's own gRPC client
+		UserAgent: getMetadataValue(md, "x-user-agent"),
+
+		TraceID:    traceID(getMetadataValue(md, "traceparent")),
+		ActingRole: getMetadataValue(md, "x-acting-role"),
+		DecisionID: getMetadataValue(md, "x-pdp-decision-id"),
+	}
+
+	if userCtx.UserID == "" || userCtx.TenantID == "" {
+		return nil, statusError(codes.Unauthenticated, ErrCodeUnauthenticated, "missing caller identity: x-user-id and x-tenant are required", "", nil)
+	}
+
+	return domain.WithUserContext(ctx, userCtx), nil
+}
+
+// traceID returns the trace ID of a W3C traceparent header,
+// version-traceid-parentid-flags, or "" when it is malformed
+func traceID(traceparent string) string {
+	parts := strings.Split(traceparent, "-")
+	if len(parts) < 4 || len(parts[1]) != 32 || parts[1] == strings.Repeat("0", 32) {
+		return ""
+	}
+	for _, c := range parts[1] {
+		if !strings.ContainsRune("0123456789abcdef", c) {
+			return ""
+		}
 	}
+	return parts[1]
 }
 
 // getMetadataValue extracts a single value from metadata
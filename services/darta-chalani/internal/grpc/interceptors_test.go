@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
+)
+
+// classifyStore holds one registered darta for ClassifyDarta and records
+// the audit entries written
+type classifyStore struct {
+	db.Querier
+
+	mu      sync.Mutex
+	darta   db.Darta
+	entries []db.CreateAuditEntryParams
+}
+
+func (c *classifyStore) GetDartaSimple(ctx context.Context, arg db.GetDartaSimpleParams) (db.Darta, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if arg.ID != c.darta.ID || arg.TenantID != c.darta.TenantID {
+		return db.Darta{}, pgx.ErrNoRows
+	}
+	return c.darta, nil
+}
+
+func (c *classifyStore) UpdateDartaClassification(ctx context.Context, arg db.UpdateDartaClassificationParams) (db.Darta, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.darta.ClassificationCode = arg.ClassificationCode
+	c.darta.Version++
+	return c.darta, nil
+}
+
+func (c *classifyStore) GetAuditChainHead(ctx context.Context, tenantID string) (db.GetAuditChainHeadRow, error) {
+	return db.GetAuditChainHeadRow{}, pgx.ErrNoRows
+}
+
+func (c *classifyStore) CreateAuditEntry(ctx context.Context, arg db.CreateAuditEntryParams) (db.AuditTrail, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = append(c.entries, arg)
+	return db.AuditTrail{ID: arg.ID}, nil
+}
+
+// dialAuthServer serves the darta service and health checks behind the auth
+// interceptor over an in-memory connection
+func dialAuthServer(t *testing.T, store db.Querier) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(UnaryAuthInterceptor()))
+	dartav1.RegisterDartaServiceServer(srv, NewDartaServer(domain.NewDartaService(store, domain.DuplicatePolicy{}), store, nil))
+	grpc_health_v1.RegisterHealthServer(srv, health.NewServer())
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestAuthInterceptorAuditsTheCaller(t *testing.T) {
+	store := &classifyStore{darta: db.Darta{ID: uuid.New(), TenantID: "t1", Status: "REGISTERED", Version: 1}}
+	client := dartav1.NewDartaServiceClient(dialAuthServer(t, store))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		"x-user-id", "user-7",
+		"x-tenant", "t1",
+		"x-roles", "darta_registrar",
+		"x-request-id", "req-1",
+	)
+	if _, err := client.ClassifyDarta(ctx, &dartav1.ClassifyDartaRequest{
+		DartaId:            store.darta.ID.String(),
+		ClassificationCode: "REV-01",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(store.entries) != 1 {
+		t.Fatalf("%d audit entries written, want 1", len(store.entries))
+	}
+	e := store.entries[0]
+	if e.PerformedBy != "user-7" || e.TenantID != "t1" {
+		t.Errorf("audited as %q in tenant %q, want user-7 in t1", e.PerformedBy, e.TenantID)
+	}
+	if e.RequestID == nil || *e.RequestID != "req-1" {
+		t.Errorf("request ID = %v, want req-1", e.RequestID)
+	}
+}
+
+func TestDartaLookupsAreScopedToTheCallersTenant(t *testing.T) {
+	store := &classifyStore{darta: db.Darta{ID: uuid.New(), TenantID: "t1", Status: "REGISTERED", Version: 1}}
+	client := dartav1.NewDartaServiceClient(dialAuthServer(t, store))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		"x-user-id", "user-7",
+		"x-tenant", "t2",
+		"x-roles", "darta_registrar",
+	)
+	_, err := client.ClassifyDarta(ctx, &dartav1.ClassifyDartaRequest{
+		DartaId:            store.darta.ID.String(),
+		ClassificationCode: "REV-01",
+	})
+	if got := status.Code(err); got != codes.NotFound {
+		t.Fatalf("code = %v, want NotFound for another tenant's darta (%v)", got, err)
+	}
+	if store.darta.ClassificationCode != nil || len(store.entries) != 0 {
+		t.Error("another tenant's darta was changed")
+	}
+}
+
+func TestAuthInterceptorRejectsAnonymousCalls(t *testing.T) {
+	store := &classifyStore{darta: db.Darta{ID: uuid.New(), TenantID: "t1", Status: "REGISTERED", Version: 1}}
+	conn := dialAuthServer(t, store)
+	client := dartav1.NewDartaServiceClient(conn)
+
+	for name, md := range map[string][]string{
+		"no metadata": nil,
+		"no user":     {"x-tenant", "t1"},
+		"no tenant":   {"x-user-id", "user-7"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := metadata.AppendToOutgoingContext(context.Background(), md...)
+			_, err := client.ClassifyDarta(ctx, &dartav1.ClassifyDartaRequest{
+				DartaId:            store.darta.ID.String(),
+				ClassificationCode: "REV-01",
+			})
+			if got := status.Code(err); got != codes.Unauthenticated {
+				t.Fatalf("code = %v, want Unauthenticated (%v)", got, err)
+			}
+		})
+	}
+	if len(store.entries) != 0 {
+		t.Fatalf("anonymous calls wrote %d audit entries", len(store.entries))
+	}
+
+	// Health checks come from the orchestrator, which has no identity
+	resp, err := grpc_health_v1.NewHealthClient(conn).Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Errorf("health status = %v, want SERVING", resp.Status)
+	}
+}
@@ -0,0 +1,127 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/metadata"
+)
+
+// MetadataSchemaServer implements the MetadataSchemaService gRPC service
+type MetadataSchemaServer struct {
+	dartav1.UnimplementedMetadataSchemaServiceServer
+	queries db.Querier
+}
+
+// NewMetadataSchemaServer creates a new MetadataSchemaServer
+func NewMetadataSchemaServer(queries db.Querier) *MetadataSchemaServer {
+	return &MetadataSchemaServer{queries: queries}
+}
+
+// ListMetadataSchemas lists the tenant's metadata schemas, the default first
+func (s *MetadataSchemaServer) ListMetadataSchemas(ctx context.Context, req *dartav1.ListMetadataSchemasRequest) (*dartav1.ListMetadataSchemasResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+
+	schemas, err := s.queries.ListMetadataSchemas(ctx, userCtx.TenantID)
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to list metadata schemas: %w", err))
+	}
+
+	resp := &dartav1.ListMetadataSchemasResponse{Schemas: make([]*dartav1.MetadataSchema, len(schemas))}
+	for i, schema := range schemas {
+		resp.Schemas[i] = toProtoMetadataSchema(schema)
+	}
+	return resp, nil
+}
+
+// SetMetadataSchema creates or replaces the schema for a classification
+// code. Existing metadata is not revalidated; the schema applies to dartas
+// as their metadata next changes.
+func (s *MetadataSchemaServer) SetMetadataSchema(ctx context.Context, req *dartav1.SetMetadataSchemaRequest) (*dartav1.SetMetadataSchemaResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+	if err := requireMetadataAdmin(userCtx); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	if req.Schema == nil {
+		return nil, invalidArgument("schema", "required")
+	}
+	doc, err := req.Schema.MarshalJSON()
+	if err != nil {
+		return nil, invalidArgument("schema", "invalid schema")
+	}
+	if _, err := metadata.CompileSchema(doc); err != nil {
+		return nil, invalidArgument("schema", err.Error())
+	}
+
+	code := strings.TrimSpace(req.ClassificationCode)
+	schema, err := s.queries.UpsertMetadataSchema(ctx, db.UpsertMetadataSchemaParams{
+		TenantID:           userCtx.TenantID,
+		ClassificationCode: code,
+		Schema:             doc,
+		UpdatedBy:          userCtx.UserID,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to set metadata schema: %w", err))
+	}
+	changes := map[string]interface{}{
+		"classification_code": code,
+		"schema":              req.Schema.AsMap(),
+	}
+	if err := recordActivity(ctx, s.queries, "METADATA_SCHEMA", metadataSchemaEntityID(userCtx.TenantID, code), "SCHEMA_SET", changes); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return &dartav1.SetMetadataSchemaResponse{Schema: toProtoMetadataSchema(schema)}, nil
+}
+
+// DeleteMetadataSchema removes the schema for a classification code; its
+// dartas fall back to the tenant's default schema, if any
+func (s *MetadataSchemaServer) DeleteMetadataSchema(ctx context.Context, req *dartav1.DeleteMetadataSchemaRequest) (*dartav1.DeleteMetadataSchemaResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+	if err := requireMetadataAdmin(userCtx); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	code := strings.TrimSpace(req.ClassificationCode)
+	n, err := s.queries.DeleteMetadataSchema(ctx, db.DeleteMetadataSchemaParams{
+		TenantID:           userCtx.TenantID,
+		ClassificationCode: code,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to delete metadata schema: %w", err))
+	}
+	if n == 0 {
+		return nil, mapDomainError(ctx, domain.NewDomainError(domain.ErrNotFound, "metadata schema not found", ""))
+	}
+	changes := map[string]interface{}{"classification_code": code}
+	if err := recordActivity(ctx, s.queries, "METADATA_SCHEMA", metadataSchemaEntityID(userCtx.TenantID, code), "SCHEMA_DELETED", changes); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return &dartav1.DeleteMetadataSchemaResponse{}, nil
+}
+
+func metadataSchemaEntityID(tenantID, classificationCode string) uuid.UUID {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte("metadata-schema:"+tenantID+"/"+classificationCode))
+}
+
+func requireMetadataAdmin(userCtx *domain.UserContext) error {
+	if !hasAnyRole(userCtx, "admin") {
+		return domain.NewDomainError(domain.ErrForbidden, "changing metadata schemas requires the admin role", "")
+	}
+	return nil
+}
+
+func toProtoMetadataSchema(m db.MetadataSchema) *dartav1.MetadataSchema {
+	return &dartav1.MetadataSchema{
+		ClassificationCode: m.ClassificationCode,
+		Schema:             jsonToStruct(m.Schema),
+		UpdatedBy:          m.UpdatedBy,
+		UpdatedAt:          pgTimestamptzToProto(m.UpdatedAt),
+	}
+}
@@ -0,0 +1,223 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+)
+
+// maxPageSize bounds the limit accepted by list RPCs
+const maxPageSize = 100
+
+// sortKind is the type of a sort key, which decides how cursors encode it
+// and which list queries serve it
+type sortKind int
+
+const (
+	sortByTime sortKind = iota
+	sortByNumber
+)
+
+// pageCursor is the position after which a keyset page starts. Clients see
+// it only as an opaque string.
+type pageCursor struct {
+	Sort string    `json:"s"`
+	Desc bool      `json:"d"`
+	Key  string    `json:"k"`
+	ID   uuid.UUID `json:"i"`
+}
+
+func encodeCursor(c pageCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (*pageCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	var c pageCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// pageRequest is a validated PaginationInput
+type pageRequest struct {
+	sort  string
+	kind  sortKind
+	desc  bool
+	limit int32
+
+	// cursor is the after cursor, or the before cursor when backward
+	cursor   *pageCursor
+	backward bool
+}
+
+// parsePage validates p against the sort orders an entity supports. An
+// empty sort_by lists newest first by defaultSort; otherwise sort_desc picks
+// the direction. Offsets are rejected: pages are addressed by cursor only.
+func parsePage(p *dartav1.PaginationInput, sorts map[string]sortKind, defaultSort string, defaultLimit int32) (pageRequest, error) {
+	req := pageRequest{sort: defaultSort, desc: true, limit: defaultLimit}
+	if p == nil {
+		req.kind = sorts[defaultSort]
+		return req, nil
+	}
+
+	if p.SortBy != "" {
+		req.sort = p.SortBy
+		req.desc = p.SortDesc
+	}
+	kind, ok := sorts[req.sort]
+	if !ok {
+		return req, invalidArgument("pagination.sort_by", "unsupported sort order "+strconv.Quote(p.SortBy))
+	}
+	req.kind = kind
+
+	switch {
+	case p.Limit < 0:
+		return req, invalidArgument("pagination.limit", "must not be negative")
+	case p.Limit > maxPageSize:
+		return req, invalidArgument("pagination.limit", "must be at most "+strconv.Itoa(maxPageSize))
+	case p.Limit > 0:
+		req.limit = p.Limit
+	}
+	if p.Offset != 0 {
+		return req, invalidArgument("pagination.offset", "offset pagination is not supported, use after or before cursors")
+	}
+	if p.After != "" && p.Before != "" {
+		return req, invalidArgument("pagination.before", "after and before cannot be combined")
+	}
+
+	field, raw := "pagination.after", p.After
+	if p.Before != "" {
+		field, raw = "pagination.before", p.Before
+		req.backward = true
+	}
+	if raw == "" {
+		return req, nil
+	}
+	cursor, err := decodeCursor(raw)
+	if err != nil {
+		return req, invalidArgument(field, "malformed cursor")
+	}
+	if cursor.Sort != req.sort || cursor.Desc != req.desc {
+		return req, invalidArgument(field, "cursor was issued for a different sort order")
+	}
+	req.cursor = cursor
+	return req, nil
+}
+
+// scanDesc reports whether the list query runs descending. A backward page
+// scans against the requested order and is reversed afterwards.
+func (p pageRequest) scanDesc() bool {
+	return p.desc != p.backward
+}
+
+// cursorID returns the id half of the keyset position. Without a cursor it
+// is the extreme UUID on the side the scan starts from.
+func (p pageRequest) cursorID() pgtype.UUID {
+	if p.cursor != nil {
+		return pgtype.UUID{Bytes: p.cursor.ID, Valid: true}
+	}
+	if p.scanDesc() {
+		return pgtype.UUID{Bytes: uuid.Max, Valid: true}
+	}
+	return pgtype.UUID{Bytes: uuid.Nil, Valid: true}
+}
+
+// timeKey returns the keyset position for a sortByTime order, defaulting to
+// an infinite timestamp before every row
+func (p pageRequest) timeKey() (pgtype.Timestamptz, error) {
+	if p.cursor != nil {
+		t, err := time.Parse(time.RFC3339Nano, p.cursor.Key)
+		if err != nil {
+			return pgtype.Timestamptz{}, invalidArgument(p.cursorField(), "malformed cursor")
+		}
+		return pgtype.Timestamptz{Time: t, Valid: true}, nil
+	}
+	if p.scanDesc() {
+		return pgtype.Timestamptz{InfinityModifier: pgtype.Infinity, Valid: true}, nil
+	}
+	return pgtype.Timestamptz{InfinityModifier: pgtype.NegativeInfinity, Valid: true}, nil
+}
+
+// numberKey returns the keyset position for a sortByNumber order
+func (p pageRequest) numberKey() (int32, error) {
+	if p.cursor != nil {
+		n, err := strconv.ParseInt(p.cursor.Key, 10, 32)
+		if err != nil {
+			return 0, invalidArgument(p.cursorField(), "malformed cursor")
+		}
+		return int32(n), nil
+	}
+	if p.scanDesc() {
+		return math.MaxInt32, nil
+	}
+	return math.MinInt32, nil
+}
+
+func (p pageRequest) cursorField() string {
+	if p.backward {
+		return "pagination.before"
+	}
+	return "pagination.after"
+}
+
+// fetchLimit asks for one row more than the page, to learn whether another
+// page follows without counting
+func (p pageRequest) fetchLimit() int32 {
+	return p.limit + 1
+}
+
+// cursorAt encodes the cursor of a row whose sort key is a time or a number,
+// whichever the sort order uses
+func (p pageRequest) cursorAt(id uuid.UUID, t pgtype.Timestamptz, n int32) string {
+	c := pageCursor{Sort: p.sort, Desc: p.desc, ID: id}
+	if p.kind == sortByTime {
+		c.Key = t.Time.UTC().Format(time.RFC3339Nano)
+	} else {
+		c.Key = strconv.FormatInt(int64(n), 10)
+	}
+	return encodeCursor(c)
+}
+
+// trimPage drops the extra row fetched by fetchLimit and restores the
+// requested order of a backward page. more reports whether rows remain
+// beyond the page in the direction of travel.
+func trimPage[T any](p pageRequest, rows []T) (page []T, more bool) {
+	if int32(len(rows)) > p.limit {
+		rows, more = rows[:p.limit], true
+	}
+	if p.backward {
+		slices.Reverse(rows)
+	}
+	return rows, more
+}
+
+// pageInfo describes a page whose edges carry cursors
+func (p pageRequest) pageInfo(cursors []string, more bool, total int64) *dartav1.PageInfo {
+	info := &dartav1.PageInfo{TotalCount: total}
+	if p.backward {
+		info.HasPreviousPage = more
+		info.HasNextPage = p.cursor != nil
+	} else {
+		info.HasNextPage = more
+		info.HasPreviousPage = p.cursor != nil
+	}
+	if len(cursors) > 0 {
+		info.StartCursor = cursors[0]
+		info.EndCursor = cursors[len(cursors)-1]
+	}
+	return info
+}
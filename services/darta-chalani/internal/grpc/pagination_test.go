@@ -0,0 +1,369 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/dbutil"
+)
+
+var testSorts = map[string]sortKind{
+	"created_at":   sortByTime,
+	"darta_number": sortByNumber,
+}
+
+func TestParsePage(t *testing.T) {
+	after := encodeCursor(pageCursor{Sort: "created_at", Desc: true, Key: "2024-01-02T03:04:05Z", ID: uuid.New()})
+	ascCursor := encodeCursor(pageCursor{Sort: "created_at", Desc: false, Key: "2024-01-02T03:04:05Z", ID: uuid.New()})
+
+	tests := []struct {
+		name      string
+		in        *dartav1.PaginationInput
+		wantField string // offending field, "" when valid
+		wantSort  string
+		wantDesc  bool
+		wantLimit int32
+		backward  bool
+	}{
+		{name: "defaults", in: nil, wantSort: "created_at", wantDesc: true, wantLimit: 20},
+		{name: "explicit ascending sort", in: &dartav1.PaginationInput{SortBy: "darta_number", Limit: 5}, wantSort: "darta_number", wantLimit: 5},
+		{name: "after cursor", in: &dartav1.PaginationInput{After: after}, wantSort: "created_at", wantDesc: true, wantLimit: 20},
+		{name: "before cursor pages backward", in: &dartav1.PaginationInput{Before: after}, wantSort: "created_at", wantDesc: true, wantLimit: 20, backward: true},
+		{name: "unsupported sort", in: &dartav1.PaginationInput{SortBy: "subject"}, wantField: "pagination.sort_by"},
+		{name: "negative limit", in: &dartav1.PaginationInput{Limit: -1}, wantField: "pagination.limit"},
+		{name: "limit above maximum", in: &dartav1.PaginationInput{Limit: maxPageSize + 1}, wantField: "pagination.limit"},
+		{name: "offset", in: &dartav1.PaginationInput{Offset: 20}, wantField: "pagination.offset"},
+		{name: "after and before", in: &dartav1.PaginationInput{After: after, Before: after}, wantField: "pagination.before"},
+		{name: "malformed cursor", in: &dartav1.PaginationInput{After: "not a cursor"}, wantField: "pagination.after"},
+		{name: "cursor of another order", in: &dartav1.PaginationInput{Before: ascCursor}, wantField: "pagination.before"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := parsePage(tt.in, testSorts, "created_at", 20)
+			if tt.wantField != "" {
+				if got := errorField(t, err); got != tt.wantField {
+					t.Fatalf("error field = %q, want %q", got, tt.wantField)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if page.sort != tt.wantSort || page.desc != tt.wantDesc || page.limit != tt.wantLimit || page.backward != tt.backward {
+				t.Fatalf("page = %+v, want sort %s desc %t limit %d backward %t", page, tt.wantSort, tt.wantDesc, tt.wantLimit, tt.backward)
+			}
+		})
+	}
+}
+
+// errorField returns the field named by the ErrorDetail of a gRPC error
+func errorField(t *testing.T, err error) string {
+	t.Helper()
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("error = %v, want InvalidArgument", err)
+	}
+	for _, d := range st.Details() {
+		if detail, ok := d.(*dartav1.ErrorDetail); ok {
+			return detail.Field
+		}
+	}
+	t.Fatalf("error %v has no ErrorDetail", err)
+	return ""
+}
+
+func TestTrimPageAndPageInfo(t *testing.T) {
+	cursor := &pageCursor{Sort: "created_at", Desc: true}
+
+	tests := []struct {
+		name         string
+		page         pageRequest
+		rows         []int
+		wantRows     []int
+		wantNext     bool
+		wantPrevious bool
+	}{
+		{name: "first page with more", page: pageRequest{limit: 2}, rows: []int{1, 2, 3}, wantRows: []int{1, 2}, wantNext: true},
+		{name: "only page", page: pageRequest{limit: 2}, rows: []int{1, 2}, wantRows: []int{1, 2}},
+		{name: "middle page", page: pageRequest{limit: 2, cursor: cursor}, rows: []int{3, 4, 5}, wantRows: []int{3, 4}, wantNext: true, wantPrevious: true},
+		{name: "last page", page: pageRequest{limit: 2, cursor: cursor}, rows: []int{5}, wantRows: []int{5}, wantPrevious: true},
+		// Backward pages scan against the order, nearest row first
+		{name: "backward with more", page: pageRequest{limit: 2, cursor: cursor, backward: true}, rows: []int{4, 3, 2}, wantRows: []int{3, 4}, wantNext: true, wantPrevious: true},
+		{name: "backward to the start", page: pageRequest{limit: 2, cursor: cursor, backward: true}, rows: []int{2, 1}, wantRows: []int{1, 2}, wantNext: true},
+		{name: "empty", page: pageRequest{limit: 2}, rows: nil, wantRows: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, more := trimPage(tt.page, slices.Clone(tt.rows))
+			if !slices.Equal(rows, tt.wantRows) {
+				t.Fatalf("rows = %v, want %v", rows, tt.wantRows)
+			}
+
+			cursors := make([]string, len(rows))
+			for i, r := range rows {
+				cursors[i] = string(rune('a' + r))
+			}
+			info := tt.page.pageInfo(cursors, more, 42)
+			if info.HasNextPage != tt.wantNext || info.HasPreviousPage != tt.wantPrevious {
+				t.Fatalf("hasNext = %t, hasPrevious = %t, want %t, %t", info.HasNextPage, info.HasPreviousPage, tt.wantNext, tt.wantPrevious)
+			}
+			if info.TotalCount != 42 {
+				t.Fatalf("total = %d, want 42", info.TotalCount)
+			}
+			if len(rows) > 0 && (info.StartCursor != cursors[0] || info.EndCursor != cursors[len(cursors)-1]) {
+				t.Fatalf("cursors = %q..%q, want %q..%q", info.StartCursor, info.EndCursor, cursors[0], cursors[len(cursors)-1])
+			}
+		})
+	}
+}
+
+// keysetDartas is an in-memory stand-in for the ListDartasByCreatedAt
+// queries, comparing (created_at, id) rows to the cursor as Postgres does
+type keysetDartas struct {
+	db.Querier
+
+	mu    sync.Mutex
+	rows  []db.Darta
+	clock time.Time
+}
+
+// insert adds a darta created at the next tick of the store's clock
+func (k *keysetDartas) insert(tenantID string) uuid.UUID {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.clock = k.clock.Add(time.Second)
+	d := db.Darta{ID: uuid.New(), TenantID: tenantID, CreatedAt: pgtype.Timestamptz{Time: k.clock, Valid: true}}
+	k.rows = append(k.rows, d)
+	return d.ID
+}
+
+// compareKey orders a row's (created_at, id) against a keyset position,
+// which may be an infinite timestamp
+func compareKey(t pgtype.Timestamptz, id uuid.UUID, key pgtype.Timestamptz, keyID pgtype.UUID) int {
+	switch key.InfinityModifier {
+	case pgtype.Infinity:
+		return -1
+	case pgtype.NegativeInfinity:
+		return 1
+	}
+	if c := t.Time.Compare(key.Time); c != 0 {
+		return c
+	}
+	return bytes.Compare(id[:], keyID.Bytes[:])
+}
+
+func (k *keysetDartas) scan(tenantID string, key pgtype.Timestamptz, id pgtype.UUID, limit int32, desc bool) []db.Darta {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	var out []db.Darta
+	for _, d := range k.rows {
+		c := compareKey(d.CreatedAt, d.ID, key, id)
+		if d.TenantID == tenantID && ((desc && c < 0) || (!desc && c > 0)) {
+			out = append(out, d)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		c := compareKey(out[i].CreatedAt, out[i].ID, out[j].CreatedAt, pgtype.UUID{Bytes: out[j].ID, Valid: true})
+		return (c < 0) != desc
+	})
+	if int32(len(out)) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+func (k *keysetDartas) ListDartasByCreatedAtDesc(ctx context.Context, arg db.ListDartasByCreatedAtDescParams) ([]db.Darta, error) {
+	return k.scan(arg.TenantID, arg.CursorKey, arg.CursorID, arg.Limit, true), nil
+}
+
+func (k *keysetDartas) ListDartasByCreatedAtAsc(ctx context.Context, arg db.ListDartasByCreatedAtAscParams) ([]db.Darta, error) {
+	return k.scan(arg.TenantID, arg.CursorKey, arg.CursorID, arg.Limit, false), nil
+}
+
+func (k *keysetDartas) CountDartas(ctx context.Context, arg db.CountDartasParams) (int64, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	var n int64
+	for _, d := range k.rows {
+		if d.TenantID == arg.TenantID {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (k *keysetDartas) GetApplicantsByIDs(ctx context.Context, arg db.GetApplicantsByIDsParams) ([]db.Applicant, error) {
+	return nil, nil
+}
+
+// walkDartaPages lists every page of a tenant's dartas, newest first, and
+// then walks back from the last page. insert is called between pages with
+// the number of pages fetched so far. It returns the IDs of each direction
+// in list order.
+func walkDartaPages(t *testing.T, s *DartaServer, tenantID string, limit int32, insert func(pages int)) (forward, backward []uuid.UUID) {
+	t.Helper()
+	ctx := context.Background()
+	filter := db.CountDartasParams{TenantID: tenantID}
+
+	fetch := func(in *dartav1.PaginationInput) *dartav1.DartaConnection {
+		page, err := parsePage(in, dartaSorts, "created_at", 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn, err := s.listDartaPage(ctx, filter, page)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return conn
+	}
+	ids := func(conn *dartav1.DartaConnection) []uuid.UUID {
+		out := make([]uuid.UUID, len(conn.Edges))
+		for i, e := range conn.Edges {
+			out[i] = uuid.MustParse(e.Node.Id)
+		}
+		return out
+	}
+
+	in := &dartav1.PaginationInput{Limit: limit}
+	var last *dartav1.DartaConnection
+	for pages := 0; ; pages++ {
+		if pages > 100 {
+			t.Fatal("paging did not end")
+		}
+		conn := fetch(in)
+		forward = append(forward, ids(conn)...)
+		last = conn
+		if !conn.PageInfo.HasNextPage {
+			break
+		}
+		insert(pages + 1)
+		in = &dartav1.PaginationInput{Limit: limit, After: conn.PageInfo.EndCursor}
+	}
+
+	// Walk back from the last page to the first
+	backward = ids(last)
+	for info := last.PageInfo; info.HasPreviousPage; {
+		conn := fetch(&dartav1.PaginationInput{Limit: limit, Before: info.StartCursor})
+		backward = append(ids(conn), backward...)
+		info = conn.PageInfo
+	}
+	return forward, backward
+}
+
+// assertEachOnce checks that every original row was listed exactly once, in
+// order, whatever was inserted while paging
+func assertEachOnce(t *testing.T, listed, originals []uuid.UUID) {
+	t.Helper()
+	seen := make(map[uuid.UUID]int)
+	var order []uuid.UUID
+	for _, id := range listed {
+		seen[id]++
+		if seen[id] > 1 {
+			t.Fatalf("darta %s listed twice", id)
+		}
+		if slices.Contains(originals, id) {
+			order = append(order, id)
+		}
+	}
+	if !slices.Equal(order, originals) {
+		t.Fatalf("originals listed as %v, want %v", order, originals)
+	}
+}
+
+func TestKeysetPagingIsStableUnderInserts(t *testing.T) {
+	store := &keysetDartas{clock: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	s := &DartaServer{queries: store}
+
+	var originals []uuid.UUID
+	for i := 0; i < 7; i++ {
+		originals = append([]uuid.UUID{store.insert("t1")}, originals...)
+	}
+	store.insert("t2")
+
+	// New dartas land ahead of the pages already listed; with offsets they
+	// would push listed rows onto the next page and list them twice
+	forward, backward := walkDartaPages(t, s, "t1", 3, func(int) {
+		store.insert("t1")
+		store.insert("t1")
+	})
+	assertEachOnce(t, forward, originals)
+	if len(forward) != len(originals) {
+		t.Fatalf("listed %d dartas, want the %d that existed when paging started", len(forward), len(originals))
+	}
+	assertEachOnce(t, backward, originals)
+}
+
+// TestKeysetQueriesAreStableUnderInserts runs the same walk against the
+// ListDartasByCreatedAt queries in Postgres. It needs DARTA_TEST_DSN to
+// name a database the migrations may be applied to.
+func TestKeysetQueriesAreStableUnderInserts(t *testing.T) {
+	dsn := os.Getenv("DARTA_TEST_DSN")
+	if dsn == "" {
+		t.Skip("DARTA_TEST_DSN is not set")
+	}
+	ctx := context.Background()
+	if err := dbutil.RunMigrations(ctx, dsn); err != nil {
+		t.Fatal(err)
+	}
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Close()
+	queries := db.New(pool)
+
+	// A tenant of its own keeps earlier runs out of the pages
+	tenantID := "paging-" + uuid.NewString()
+	applicant, err := queries.CreateApplicant(ctx, db.CreateApplicantParams{Type: "CITIZEN", FullName: "Ram Bahadur"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	document, err := queries.CreateAttachment(ctx, db.CreateAttachmentParams{
+		Filename: "letter.pdf", OriginalFilename: "letter.pdf", MimeType: "application/pdf",
+		StoragePath: "test/letter.pdf", Checksum: "0", UploadedBy: "test", Metadata: []byte("{}"), TenantID: tenantID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	insert := func() uuid.UUID {
+		now := pgtype.Timestamptz{Time: time.Now(), Valid: true}
+		d, err := queries.CreateDarta(ctx, db.CreateDartaParams{
+			FiscalYearID: "2081-82", Scope: "MUNICIPALITY", Subject: "paging", ApplicantID: applicant.ID,
+			IntakeChannel: "COUNTER", ReceivedDate: now, EntryDate: now, PrimaryDocumentID: document.ID,
+			Status: "DRAFT", Priority: "MEDIUM", CreatedBy: "test", TenantID: tenantID, Metadata: []byte("{}"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d.ID
+	}
+
+	var originals []uuid.UUID
+	for i := 0; i < 7; i++ {
+		originals = append([]uuid.UUID{insert()}, originals...)
+	}
+
+	forward, backward := walkDartaPages(t, &DartaServer{queries: queries}, tenantID, 3, func(int) {
+		insert()
+		insert()
+	})
+	assertEachOnce(t, forward, originals)
+	if len(forward) != len(originals) {
+		t.Fatalf("listed %d dartas, want the %d that existed when paging started", len(forward), len(originals))
+	}
+	assertEachOnce(t, backward, originals)
+}
@@ -0,0 +1,202 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"git.ninjainfosys.com/ePalika/pkg/bsdate"
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/kitab"
+)
+
+// Content types of the register formats
+const (
+	contentTypeCSV  = "text/csv; charset=utf-8"
+	contentTypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	contentTypePDF  = "application/pdf"
+)
+
+// RegisterServer implements the RegisterService gRPC service
+type RegisterServer struct {
+	dartav1.UnimplementedRegisterServiceServer
+	queries db.Querier
+	signer  *kitab.Signer
+
+	// Fonts are loaded on the first PDF export, so the service starts
+	// without them and only PDF exports fail when they are missing
+	fontPath, latinFontPath string
+	fontsOnce               sync.Once
+	fonts                   *kitab.Fonts
+	fontsErr                error
+}
+
+// NewRegisterServer creates a new RegisterServer. PDF registers are set in
+// the fonts at fontPath and latinFontPath.
+func NewRegisterServer(queries db.Querier, signer *kitab.Signer, fontPath, latinFontPath string) *RegisterServer {
+	return &RegisterServer{
+		queries:       queries,
+		signer:        signer,
+		fontPath:      fontPath,
+		latinFontPath: latinFontPath,
+	}
+}
+
+// ExportRegister produces a fiscal year's darta or chalani kitab for the
+// municipality or a ward, sealed and in the requested format
+func (s *RegisterServer) ExportRegister(ctx context.Context, req *dartav1.ExportRegisterRequest) (*dartav1.ExportRegisterResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+
+	var book kitab.Book
+	switch req.Book {
+	case dartav1.RegisterBook_REGISTER_BOOK_DARTA:
+		book = kitab.BookDarta
+		if !hasAnyRole(userCtx, tenantWideWatchRoles...) {
+			return nil, mapDomainError(ctx, domain.NewDomainError(domain.ErrForbidden, "exporting the darta kitab requires the darta_registrar or darta_reviewer role", ""))
+		}
+	case dartav1.RegisterBook_REGISTER_BOOK_CHALANI:
+		book = kitab.BookChalani
+		if !hasAnyRole(userCtx, dispatchWatchRoles...) {
+			return nil, mapDomainError(ctx, domain.NewDomainError(domain.ErrForbidden, "exporting the chalani kitab requires the chalani_dispatcher or chalani_approver role", ""))
+		}
+	default:
+		return nil, invalidArgument("book", "book is required")
+	}
+
+	fiscalYear, err := bsdate.ParseFiscalYear(req.FiscalYearId)
+	if err != nil {
+		return nil, invalidArgument("fiscal_year_id", "must be a fiscal year such as 2082-83")
+	}
+
+	scope := enumFilter(req.Scope.String(), "SCOPE_")
+	if scope == nil {
+		return nil, invalidArgument("scope", "scope is required")
+	}
+	var wardID *string
+	if *scope == "WARD" {
+		if req.WardId == "" {
+			return nil, invalidArgument("ward_id", "ward_id is required for the ward scope")
+		}
+		wardID = &req.WardId
+	}
+
+	var ext, contentType string
+	switch req.Format {
+	case dartav1.RegisterFormat_REGISTER_FORMAT_CSV:
+		ext, contentType = "csv", contentTypeCSV
+	case dartav1.RegisterFormat_REGISTER_FORMAT_XLSX:
+		ext, contentType = "xlsx", contentTypeXLSX
+	case dartav1.RegisterFormat_REGISTER_FORMAT_PDF:
+		ext, contentType = "pdf", contentTypePDF
+	default:
+		return nil, invalidArgument("format", "format is required")
+	}
+
+	records, format, err := s.records(ctx, book, userCtx.TenantID, fiscalYear.Code(), *scope, wardID)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	register := &kitab.Register{
+		Book:        book,
+		FiscalYear:  fiscalYear,
+		Scope:       *scope,
+		WardID:      req.WardId,
+		Entries:     kitab.Build(records, format),
+		GeneratedAt: time.Now(),
+		GeneratedBy: userCtx.UserID,
+	}
+	seal := s.signer.Seal(register)
+
+	var buf bytes.Buffer
+	switch req.Format {
+	case dartav1.RegisterFormat_REGISTER_FORMAT_CSV:
+		err = kitab.WriteCSV(&buf, register)
+	case dartav1.RegisterFormat_REGISTER_FORMAT_XLSX:
+		err = kitab.WriteXLSX(&buf, register)
+	case dartav1.RegisterFormat_REGISTER_FORMAT_PDF:
+		fonts, fontsErr := s.loadFonts()
+		if fontsErr != nil {
+			return nil, statusError(codes.FailedPrecondition, ErrCodeInternal, "PDF registers are unavailable: the register fonts are not installed", "", nil)
+		}
+		err = fonts.WritePDF(&buf, register)
+	}
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	entries, gaps, voids := register.Counts()
+	return &dartav1.ExportRegisterResponse{
+		Filename:    register.Filename(ext),
+		ContentType: contentType,
+		Content:     buf.Bytes(),
+		Seal: &dartav1.RegisterSeal{
+			Sha256:      seal.SHA256,
+			Signature:   seal.Signature,
+			KeyId:       seal.KeyID,
+			PublicKey:   seal.PublicKey,
+			GeneratedAt: timestamppb.New(register.GeneratedAt),
+			GeneratedBy: register.GeneratedBy,
+		},
+		EntryCount: int32(entries),
+		GapCount:   int32(gaps),
+		VoidCount:  int32(voids),
+	}, nil
+}
+
+// records reads the numbered records of a register, with the formatter for
+// the numbers of its gaps
+func (s *RegisterServer) records(ctx context.Context, book kitab.Book, tenantID, fiscalYear, scope string, wardID *string) ([]kitab.Record, func(int) string, error) {
+	var records []kitab.Record
+	if book == kitab.BookChalani {
+		rows, err := s.queries.ListChalaniRegister(ctx, db.ListChalaniRegisterParams{
+			TenantID: tenantID, FiscalYearID: fiscalYear, Scope: scope, WardID: wardID,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, r := range rows {
+			records = append(records, kitab.Record{
+				Number: int(r.Number), FormattedNumber: r.FormattedNumber, RecordedAt: r.RecordedAt.Time,
+				Party: r.Party, Subject: r.Subject, Section: r.Section, Status: r.Status,
+			})
+		}
+		ward := ""
+		if wardID != nil {
+			ward = *wardID
+		}
+		return records, func(n int) string { return formatChalaniNumber(fiscalYear, scope, ward, n) }, nil
+	}
+
+	rows, err := s.queries.ListDartaRegister(ctx, db.ListDartaRegisterParams{
+		TenantID: tenantID, FiscalYearID: fiscalYear, Scope: scope, WardID: wardID,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, r := range rows {
+		records = append(records, kitab.Record{
+			Number: int(r.Number), FormattedNumber: r.FormattedNumber, RecordedAt: r.RecordedAt.Time,
+			Party: r.Party, Subject: r.Subject, Section: r.Section, Status: r.Status,
+		})
+	}
+	return records, func(n int) string { return domain.FormatDartaNumber(fiscalYear, scope, wardID, n) }, nil
+}
+
+// loadFonts loads the register fonts once, logging why they are missing
+func (s *RegisterServer) loadFonts() (*kitab.Fonts, error) {
+	s.fontsOnce.Do(func() {
+		s.fonts, s.fontsErr = kitab.LoadFonts(s.fontPath, s.latinFontPath)
+		if s.fontsErr != nil {
+			log.Printf("register fonts unavailable: %v", s.fontsErr)
+		}
+	})
+	return s.fonts, s.fontsErr
+}
@@ -0,0 +1,212 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/search"
+)
+
+// defaultSearchLimit is the page size of SearchRecords when none is given
+const defaultSearchLimit = 20
+
+// SearchRecords runs a ranked free-text search over the dartas and chalanis
+// of the caller's tenant. Results are ordered by relevance, so pages are
+// addressed by offset rather than by keyset.
+func (s *DartaServer) SearchRecords(ctx context.Context, req *dartav1.SearchRecordsRequest) (*dartav1.SearchRecordsResponse, error) {
+	q, ok := search.ParseQuery(req.Query)
+	if !ok {
+		return nil, invalidArgument("query", "query must contain at least one word")
+	}
+
+	entityTypes := nonEmpty(req.EntityTypes)
+	for _, t := range entityTypes {
+		if t != search.EntityDarta && t != search.EntityChalani {
+			return nil, invalidArgument("entity_types", fmt.Sprintf("unknown entity type %q", t))
+		}
+	}
+
+	limit := req.Limit
+	switch {
+	case limit < 0:
+		return nil, invalidArgument("limit", "limit must not be negative")
+	case limit == 0:
+		limit = defaultSearchLimit
+	case limit > maxPageSize:
+		limit = maxPageSize
+	}
+
+	var offset int32
+	if req.After != "" {
+		o, err := decodeSearchCursor(req.After)
+		if err != nil {
+			return nil, invalidArgument("after", "invalid cursor")
+		}
+		offset = o
+	}
+
+	filter := req.Filter
+	if filter == nil {
+		filter = &dartav1.SearchFilter{}
+	}
+	userCtx := domain.GetUserContext(ctx)
+
+	params := db.SearchDocumentsParams{
+		Query:         q.TSQuery,
+		FoldKey:       q.FoldKey,
+		TenantID:      userCtx.TenantID,
+		EntityTypes:   entityTypes,
+		Statuses:      nonEmpty(filter.Statuses),
+		FiscalYearIds: nonEmpty(filter.FiscalYearIds),
+		WardIds:       nonEmpty(filter.WardIds),
+		Channels:      nonEmpty(filter.Channels),
+		Offset:        offset,
+		Limit:         limit + 1,
+	}
+	rows, err := s.queries.SearchDocuments(ctx, params)
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to search records: %w", err))
+	}
+
+	total, err := s.queries.CountSearchDocuments(ctx, db.CountSearchDocumentsParams{
+		TenantID:      params.TenantID,
+		Query:         params.Query,
+		FoldKey:       params.FoldKey,
+		EntityTypes:   params.EntityTypes,
+		Statuses:      params.Statuses,
+		FiscalYearIds: params.FiscalYearIds,
+		WardIds:       params.WardIds,
+		Channels:      params.Channels,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to count search results: %w", err))
+	}
+
+	facetRows, err := s.queries.SearchFacets(ctx, db.SearchFacetsParams{
+		TenantID:    params.TenantID,
+		Query:       params.Query,
+		FoldKey:     params.FoldKey,
+		EntityTypes: params.EntityTypes,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to count search facets: %w", err))
+	}
+
+	more := len(rows) > int(limit)
+	if more {
+		rows = rows[:limit]
+	}
+
+	resp := &dartav1.SearchRecordsResponse{
+		Hits:        make([]*dartav1.SearchHit, 0, len(rows)),
+		TotalCount:  total,
+		HasNextPage: more,
+	}
+	if more {
+		resp.EndCursor = encodeSearchCursor(offset + limit)
+	}
+
+	for _, row := range rows {
+		number := ""
+		if row.Number != nil {
+			number = *row.Number
+		}
+		field, snippet, highlights := q.Snippet(
+			search.Field{Name: "content", Text: row.Content},
+			search.Field{Name: "title", Text: row.Title},
+			search.Field{Name: "party", Text: row.Party},
+			search.Field{Name: "number", Text: number},
+		)
+		if snippet == "" {
+			field, snippet = "title", row.Title
+		}
+
+		hit := &dartav1.SearchHit{
+			EntityType:   row.EntityType,
+			Id:           uuid.UUID(row.EntityID.Bytes).String(),
+			Title:        row.Title,
+			Number:       number,
+			Party:        row.Party,
+			Status:       row.Status,
+			FiscalYearId: row.FiscalYearID,
+			Score:        row.Score,
+			Snippet:      snippet,
+			SnippetField: field,
+			Highlights:   make([]*dartav1.TextRange, len(highlights)),
+		}
+		if row.WardID != nil {
+			hit.WardId = *row.WardID
+		}
+		if row.Channel != nil {
+			hit.Channel = *row.Channel
+		}
+		for i, h := range highlights {
+			hit.Highlights[i] = &dartav1.TextRange{Start: int32(h.Start), End: int32(h.End)}
+		}
+		resp.Hits = append(resp.Hits, hit)
+	}
+
+	facets := map[string]*dartav1.SearchFacet{}
+	for _, f := range facetRows {
+		facet, ok := facets[f.Facet]
+		if !ok {
+			facet = &dartav1.SearchFacet{Field: f.Facet}
+			facets[f.Facet] = facet
+			resp.Facets = append(resp.Facets, facet)
+		}
+		facet.Values = append(facet.Values, &dartav1.SearchFacetValue{Value: f.Value, Count: f.Count})
+	}
+
+	return resp, nil
+}
+
+// UnarySearchIndexInterceptor asks the search indexer to sync after every
+// successful mutation, so new and changed records become searchable without
+// waiting for the next periodic sync
+func UnarySearchIndexInterceptor(ix *search.Indexer) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err == nil && isMutation(info.FullMethod) {
+			ix.Notify()
+		}
+		return resp, err
+	}
+}
+
+func encodeSearchCursor(offset int32) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(int(offset))))
+}
+
+func decodeSearchCursor(s string) (int32, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(string(b), 10, 32)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid search cursor %q", s)
+	}
+	return int32(n), nil
+}
+
+// nonEmpty returns nil for an empty list, which the search queries read as
+// no filter
+func nonEmpty(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
@@ -0,0 +1,567 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"git.ninjainfosys.com/ePalika/pkg/bsdate"
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/sla"
+)
+
+// maxHolidayRange bounds the days GetBusinessCalendar lists holidays for
+const maxHolidayRange = 366
+
+// SLAServer implements the SLAService gRPC service
+type SLAServer struct {
+	dartav1.UnimplementedSLAServiceServer
+	queries db.Querier
+}
+
+// NewSLAServer creates a new SLAServer
+func NewSLAServer(queries db.Querier) *SLAServer {
+	return &SLAServer{queries: queries}
+}
+
+// GetBusinessCalendar returns the caller's tenant calendar with the
+// holidays in the requested range, national ones included
+func (s *SLAServer) GetBusinessCalendar(ctx context.Context, req *dartav1.GetBusinessCalendarRequest) (*dartav1.GetBusinessCalendarResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+
+	from := startOfNepalDay(time.Now())
+	if req.FromDate != "" {
+		d, err := time.ParseInLocation(time.DateOnly, req.FromDate, bsdate.Nepal)
+		if err != nil {
+			return nil, invalidArgument("from_date", "must be an AD date, YYYY-MM-DD")
+		}
+		from = d
+	}
+	to := from.AddDate(1, 0, 0)
+	if req.ToDate != "" {
+		d, err := time.ParseInLocation(time.DateOnly, req.ToDate, bsdate.Nepal)
+		if err != nil {
+			return nil, invalidArgument("to_date", "must be an AD date, YYYY-MM-DD")
+		}
+		to = d
+	}
+	if to.Before(from) || to.Sub(from) > maxHolidayRange*24*time.Hour {
+		return nil, invalidArgument("to_date", fmt.Sprintf("must be within %d days after from_date", maxHolidayRange))
+	}
+
+	calendar, err := s.calendar(ctx, userCtx.TenantID, from, to)
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return &dartav1.GetBusinessCalendarResponse{Calendar: calendar}, nil
+}
+
+// UpdateBusinessCalendar sets the tenant's working week and office hours
+func (s *SLAServer) UpdateBusinessCalendar(ctx context.Context, req *dartav1.UpdateBusinessCalendarRequest) (*dartav1.UpdateBusinessCalendarResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+	if err := requireSLAAdmin(userCtx); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	weeklyOff := make([]int16, 0, len(req.WeeklyOff))
+	seen := map[int32]bool{}
+	for _, d := range req.WeeklyOff {
+		if d < 0 || d > 6 {
+			return nil, invalidArgument("weekly_off", "days are 0 (Sunday) to 6 (Saturday)")
+		}
+		if !seen[d] {
+			seen[d] = true
+			weeklyOff = append(weeklyOff, int16(d))
+		}
+	}
+	if len(weeklyOff) == 7 {
+		return nil, invalidArgument("weekly_off", "at least one day must be a working day")
+	}
+	start, err := parseClock(req.OfficeStart)
+	if err != nil {
+		return nil, invalidArgument("office_start", "must be a time, HH:MM")
+	}
+	end, err := parseClock(req.OfficeEnd)
+	if err != nil {
+		return nil, invalidArgument("office_end", "must be a time, HH:MM")
+	}
+	if end.Microseconds <= start.Microseconds {
+		return nil, invalidArgument("office_end", "must be after office_start")
+	}
+
+	if _, err := s.queries.UpsertBusinessCalendar(ctx, db.UpsertBusinessCalendarParams{
+		TenantID:    userCtx.TenantID,
+		WeeklyOff:   weeklyOff,
+		OfficeStart: start,
+		OfficeEnd:   end,
+	}); err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to update business calendar: %w", err))
+	}
+	changes := map[string]interface{}{
+		"weekly_off":   weeklyOff,
+		"office_start": req.OfficeStart,
+		"office_end":   req.OfficeEnd,
+	}
+	if err := recordActivity(ctx, s.queries, "BUSINESS_CALENDAR", calendarEntityID(userCtx.TenantID), "CALENDAR_UPDATED", changes); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	from := startOfNepalDay(time.Now())
+	calendar, err := s.calendar(ctx, userCtx.TenantID, from, from.AddDate(1, 0, 0))
+	if err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return &dartav1.UpdateBusinessCalendarResponse{Calendar: calendar}, nil
+}
+
+// SetHoliday adds or renames a tenant holiday, given as an AD or a BS date
+func (s *SLAServer) SetHoliday(ctx context.Context, req *dartav1.SetHolidayRequest) (*dartav1.SetHolidayResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+	if err := requireSLAAdmin(userCtx); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, invalidArgument("name", "required")
+	}
+
+	var day time.Time
+	switch {
+	case req.Date != "":
+		d, err := time.ParseInLocation(time.DateOnly, req.Date, bsdate.Nepal)
+		if err != nil {
+			return nil, invalidArgument("date", "must be an AD date, YYYY-MM-DD")
+		}
+		day = d
+	case req.DateBs != "":
+		bs, err := bsdate.Parse(req.DateBs)
+		if err != nil {
+			return nil, invalidArgument("date_bs", "must be a BS date, YYYY-MM-DD")
+		}
+		if day, err = bs.Time(); err != nil {
+			return nil, invalidArgument("date_bs", "outside the supported BS range")
+		}
+	default:
+		return nil, invalidArgument("date", "date or date_bs is required")
+	}
+
+	holiday, err := s.queries.UpsertCalendarHoliday(ctx, db.UpsertCalendarHolidayParams{
+		TenantID:    userCtx.TenantID,
+		HolidayDate: pgDate(day),
+		Name:        name,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to set holiday: %w", err))
+	}
+	changes := map[string]interface{}{"date": day.Format(time.DateOnly), "name": name}
+	if err := recordActivity(ctx, s.queries, "BUSINESS_CALENDAR", calendarEntityID(userCtx.TenantID), "HOLIDAY_SET", changes); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return &dartav1.SetHolidayResponse{Holiday: toProtoHoliday(holiday.HolidayDate.Time, holiday.Name, false)}, nil
+}
+
+// RemoveHoliday removes a tenant holiday. National holidays cannot be
+// removed.
+func (s *SLAServer) RemoveHoliday(ctx context.Context, req *dartav1.RemoveHolidayRequest) (*dartav1.RemoveHolidayResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+	if err := requireSLAAdmin(userCtx); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	day, err := time.ParseInLocation(time.DateOnly, req.Date, bsdate.Nepal)
+	if err != nil {
+		return nil, invalidArgument("date", "must be an AD date, YYYY-MM-DD")
+	}
+	if err := s.queries.DeleteCalendarHoliday(ctx, db.DeleteCalendarHolidayParams{
+		TenantID:    userCtx.TenantID,
+		HolidayDate: pgDate(day),
+	}); err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to remove holiday: %w", err))
+	}
+	changes := map[string]interface{}{"date": day.Format(time.DateOnly)}
+	if err := recordActivity(ctx, s.queries, "BUSINESS_CALENDAR", calendarEntityID(userCtx.TenantID), "HOLIDAY_REMOVED", changes); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return &dartav1.RemoveHolidayResponse{}, nil
+}
+
+// ListSLAPolicies lists the tenant's SLA policies. Stages without a policy
+// use the built-in defaults.
+func (s *SLAServer) ListSLAPolicies(ctx context.Context, req *dartav1.ListSLAPoliciesRequest) (*dartav1.ListSLAPoliciesResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+
+	policies, err := s.queries.ListSLAPolicies(ctx, userCtx.TenantID)
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to list SLA policies: %w", err))
+	}
+
+	resp := &dartav1.ListSLAPoliciesResponse{Policies: make([]*dartav1.SLAPolicy, len(policies))}
+	for i, p := range policies {
+		resp.Policies[i] = toProtoSLAPolicy(p)
+	}
+	return resp, nil
+}
+
+// SetSLAPolicy creates the policy for a stage, priority and classification,
+// or replaces its targets. The new targets apply to records as they next
+// change.
+func (s *SLAServer) SetSLAPolicy(ctx context.Context, req *dartav1.SetSLAPolicyRequest) (*dartav1.SetSLAPolicyResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+	if err := requireSLAAdmin(userCtx); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	p := req.Policy
+	if p == nil {
+		return nil, invalidArgument("policy", "required")
+	}
+	if !sla.IsStage(p.Stage) {
+		return nil, invalidArgument("policy.stage", "must be one of "+strings.Join(sla.Stages, ", "))
+	}
+	if p.TargetMinutes <= 0 {
+		return nil, invalidArgument("policy.target_minutes", "must be positive")
+	}
+	if p.EscalateAfterMinutes < 0 {
+		return nil, invalidArgument("policy.escalate_after_minutes", "must not be negative")
+	}
+	if p.Stage == sla.StageChalaniApproval && (p.Priority != dartav1.Priority_PRIORITY_UNSPECIFIED || p.ClassificationCode != "") {
+		return nil, invalidArgument("policy.stage", "chalani approval policies cannot depend on priority or classification")
+	}
+
+	policy, err := s.queries.UpsertSLAPolicy(ctx, db.UpsertSLAPolicyParams{
+		TenantID:             userCtx.TenantID,
+		Stage:                p.Stage,
+		Priority:             protoToPriority(p.Priority),
+		ClassificationCode:   stringPtr(strings.TrimSpace(p.ClassificationCode)),
+		TargetMinutes:        p.TargetMinutes,
+		EscalateAfterMinutes: p.EscalateAfterMinutes,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to set SLA policy: %w", err))
+	}
+	changes := map[string]interface{}{
+		"stage":                  policy.Stage,
+		"priority":               policy.Priority,
+		"classification_code":    policy.ClassificationCode,
+		"target_minutes":         policy.TargetMinutes,
+		"escalate_after_minutes": policy.EscalateAfterMinutes,
+	}
+	if err := recordActivity(ctx, s.queries, "SLA_POLICY", policy.ID, "POLICY_SET", changes); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return &dartav1.SetSLAPolicyResponse{Policy: toProtoSLAPolicy(policy)}, nil
+}
+
+// DeleteSLAPolicy removes a policy; its stage falls back to a less specific
+// policy or the default
+func (s *SLAServer) DeleteSLAPolicy(ctx context.Context, req *dartav1.DeleteSLAPolicyRequest) (*dartav1.DeleteSLAPolicyResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+	if err := requireSLAAdmin(userCtx); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, invalidArgument("id", "invalid policy ID")
+	}
+	if err := s.queries.DeleteSLAPolicy(ctx, db.DeleteSLAPolicyParams{ID: id, TenantID: userCtx.TenantID}); err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to delete SLA policy: %w", err))
+	}
+	if err := recordActivity(ctx, s.queries, "SLA_POLICY", id, "POLICY_DELETED", nil); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return &dartav1.DeleteSLAPolicyResponse{}, nil
+}
+
+// ListUnitHeads lists who each unit's SLA breaches are escalated to
+func (s *SLAServer) ListUnitHeads(ctx context.Context, req *dartav1.ListUnitHeadsRequest) (*dartav1.ListUnitHeadsResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+
+	heads, err := s.queries.ListUnitHeads(ctx, userCtx.TenantID)
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to list unit heads: %w", err))
+	}
+
+	resp := &dartav1.ListUnitHeadsResponse{UnitHeads: make([]*dartav1.UnitHead, len(heads))}
+	for i, h := range heads {
+		resp.UnitHeads[i] = &dartav1.UnitHead{UnitId: h.UnitID, HeadUserId: h.HeadUserID}
+	}
+	return resp, nil
+}
+
+// SetUnitHead sets or removes the head of a unit
+func (s *SLAServer) SetUnitHead(ctx context.Context, req *dartav1.SetUnitHeadRequest) (*dartav1.SetUnitHeadResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+	if err := requireSLAAdmin(userCtx); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+
+	h := req.UnitHead
+	if h == nil || strings.TrimSpace(h.UnitId) == "" {
+		return nil, invalidArgument("unit_head.unit_id", "required")
+	}
+	unitID := strings.TrimSpace(h.UnitId)
+
+	if strings.TrimSpace(h.HeadUserId) == "" {
+		if err := s.queries.DeleteUnitHead(ctx, db.DeleteUnitHeadParams{TenantID: userCtx.TenantID, UnitID: unitID}); err != nil {
+			return nil, mapDomainError(ctx, fmt.Errorf("failed to remove unit head: %w", err))
+		}
+		changes := map[string]interface{}{"unit_id": unitID}
+		if err := recordActivity(ctx, s.queries, "UNIT_HEAD", unitHeadEntityID(userCtx.TenantID, unitID), "UNIT_HEAD_REMOVED", changes); err != nil {
+			return nil, mapDomainError(ctx, err)
+		}
+		return &dartav1.SetUnitHeadResponse{UnitHead: &dartav1.UnitHead{UnitId: unitID}}, nil
+	}
+
+	head, err := s.queries.UpsertUnitHead(ctx, db.UpsertUnitHeadParams{
+		TenantID:   userCtx.TenantID,
+		UnitID:     unitID,
+		HeadUserID: strings.TrimSpace(h.HeadUserId),
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to set unit head: %w", err))
+	}
+	changes := map[string]interface{}{"unit_id": unitID, "head_user_id": head.HeadUserID}
+	if err := recordActivity(ctx, s.queries, "UNIT_HEAD", unitHeadEntityID(userCtx.TenantID, unitID), "UNIT_HEAD_SET", changes); err != nil {
+		return nil, mapDomainError(ctx, err)
+	}
+	return &dartav1.SetUnitHeadResponse{UnitHead: &dartav1.UnitHead{UnitId: head.UnitID, HeadUserId: head.HeadUserID}}, nil
+}
+
+// ListSLAClocks returns every SLA clock a darta or chalani has had
+func (s *SLAServer) ListSLAClocks(ctx context.Context, req *dartav1.ListSLAClocksRequest) (*dartav1.ListSLAClocksResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+
+	if req.EntityType != sla.EntityDarta && req.EntityType != sla.EntityChalani {
+		return nil, invalidArgument("entity_type", "must be DARTA or CHALANI")
+	}
+	id, err := uuid.Parse(req.EntityId)
+	if err != nil {
+		return nil, invalidArgument("entity_id", "invalid ID")
+	}
+
+	clocks, err := s.queries.ListSLAClocks(ctx, db.ListSLAClocksParams{
+		EntityType: req.EntityType,
+		EntityID:   pgtype.UUID{Bytes: id, Valid: true},
+		TenantID:   userCtx.TenantID,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to list SLA clocks: %w", err))
+	}
+
+	resp := &dartav1.ListSLAClocksResponse{Clocks: make([]*dartav1.SLAClock, len(clocks))}
+	for i, c := range clocks {
+		resp.Clocks[i] = toProtoSLAClock(c)
+	}
+	return resp, nil
+}
+
+// calendar builds the tenant's calendar with its holidays between from and
+// to, tenant holidays taking precedence over national ones on the same day
+func (s *SLAServer) calendar(ctx context.Context, tenantID string, from, to time.Time) (*dartav1.BusinessCalendar, error) {
+	out := &dartav1.BusinessCalendar{}
+
+	row, err := s.queries.GetBusinessCalendar(ctx, tenantID)
+	switch {
+	case err == nil:
+		for _, d := range row.WeeklyOff {
+			out.WeeklyOff = append(out.WeeklyOff, int32(d))
+		}
+		out.OfficeStart = formatClock(row.OfficeStart)
+		out.OfficeEnd = formatClock(row.OfficeEnd)
+	case errors.Is(err, pgx.ErrNoRows):
+		out.WeeklyOff = []int32{int32(time.Saturday)}
+		out.OfficeStart, out.OfficeEnd = "10:00", "17:00"
+	default:
+		return nil, fmt.Errorf("failed to get business calendar: %w", err)
+	}
+
+	holidays, err := s.queries.ListCalendarHolidays(ctx, db.ListCalendarHolidaysParams{
+		TenantID: tenantID,
+		FromDate: pgDate(from),
+		ToDate:   pgDate(to),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list holidays: %w", err)
+	}
+
+	tenant := map[string]bool{}
+	for _, h := range holidays {
+		tenant[h.HolidayDate.Time.Format(time.DateOnly)] = true
+		out.Holidays = append(out.Holidays, toProtoHoliday(h.HolidayDate.Time, h.Name, false))
+	}
+	national := sla.NewCalendar(nil, nil)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		if tenant[day.Format(time.DateOnly)] {
+			continue
+		}
+		if name, ok := national.Holiday(day); ok {
+			out.Holidays = append(out.Holidays, toProtoHoliday(day, name, true))
+		}
+	}
+	sort.Slice(out.Holidays, func(i, j int) bool {
+		return out.Holidays[i].Date < out.Holidays[j].Date
+	})
+	return out, nil
+}
+
+// UnarySLAInterceptor asks the SLA engine to reconcile after every
+// successful mutation, so clocks start, pause and stop promptly
+func UnarySLAInterceptor(engine *sla.Engine) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err == nil && isMutation(info.FullMethod) {
+			engine.Notify()
+		}
+		return resp, err
+	}
+}
+
+// SLAAlertPublisher returns the SLA engine's alert callback, which publishes
+// breaches and escalations to the Watch streams with the record's current
+// state
+func SLAAlertPublisher(hub *EventHub, queries db.Querier) func(context.Context, sla.Alert) {
+	return func(ctx context.Context, alert sla.Alert) {
+		c := alert.Clock
+		escalatedTo := nullStringToPtr(c.EscalatedTo)
+		ev := event{tenantID: c.TenantID}
+
+		switch c.EntityType {
+		case sla.EntityDarta:
+			d, err := queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: c.EntityID.Bytes, TenantID: c.TenantID})
+			if err != nil {
+				return
+			}
+			ev.darta = &dartav1.DartaEvent{
+				Action:        alert.Kind,
+				Darta:         toProtoDarta(&d),
+				OccurredAt:    timestamppb.Now(),
+				SlaStage:      c.Stage,
+				EscalatedToId: escalatedTo,
+			}
+		case sla.EntityChalani:
+			ch, err := queries.GetChalaniSimple(ctx, db.GetChalaniSimpleParams{ID: c.EntityID.Bytes, TenantID: c.TenantID})
+			if err != nil {
+				return
+			}
+			ev.chalani = &dartav1.ChalaniEvent{
+				Action:        alert.Kind,
+				Chalani:       toProtoChalani(&ch),
+				OccurredAt:    timestamppb.Now(),
+				SlaStage:      c.Stage,
+				EscalatedToId: escalatedTo,
+			}
+		default:
+			return
+		}
+		hub.publish(ev)
+	}
+}
+
+// requireSLAAdmin allows SLA configuration changes to admins only
+// Settings without an ID of their own are audited under one derived from
+// their tenant, so each has a stable audit history
+func calendarEntityID(tenantID string) uuid.UUID {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte("business-calendar:"+tenantID))
+}
+
+func unitHeadEntityID(tenantID, unitID string) uuid.UUID {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte("unit-head:"+tenantID+"/"+unitID))
+}
+
+func requireSLAAdmin(userCtx *domain.UserContext) error {
+	if !hasAnyRole(userCtx, "admin") {
+		return domain.NewDomainError(domain.ErrForbidden, "changing SLA settings requires the admin role", "")
+	}
+	return nil
+}
+
+func toProtoSLAPolicy(p db.SlaPolicy) *dartav1.SLAPolicy {
+	out := &dartav1.SLAPolicy{
+		Id:                   p.ID.String(),
+		Stage:                p.Stage,
+		ClassificationCode:   nullStringToPtr(p.ClassificationCode),
+		TargetMinutes:        p.TargetMinutes,
+		EscalateAfterMinutes: p.EscalateAfterMinutes,
+	}
+	if p.Priority != nil {
+		out.Priority = stringToPriority(*p.Priority)
+	}
+	return out
+}
+
+func toProtoSLAClock(c db.SlaClock) *dartav1.SLAClock {
+	out := &dartav1.SLAClock{
+		Id:             c.ID.String(),
+		EntityType:     c.EntityType,
+		EntityId:       uuid.UUID(c.EntityID.Bytes).String(),
+		Stage:          c.Stage,
+		TargetMinutes:  c.TargetMinutes,
+		ElapsedMinutes: c.ElapsedMinutes,
+		Paused:         c.PausedAt.Valid && !c.StoppedAt.Valid,
+		StartedAt:      pgTimestamptzToProto(c.StartedAt),
+		StoppedAt:      pgTimestamptzToProto(c.StoppedAt),
+		Deadline:       pgTimestamptzToProto(c.Deadline),
+		BreachedAt:     pgTimestamptzToProto(c.BreachedAt),
+		EscalatedAt:    pgTimestamptzToProto(c.EscalatedAt),
+		EscalatedToId:  nullStringToPtr(c.EscalatedTo),
+	}
+	if c.PolicyID.Valid {
+		out.PolicyId = uuid.UUID(c.PolicyID.Bytes).String()
+	}
+	return out
+}
+
+func toProtoHoliday(day time.Time, name string, national bool) *dartav1.Holiday {
+	h := &dartav1.Holiday{
+		Date:     day.Format(time.DateOnly),
+		Name:     name,
+		National: national,
+	}
+	civil := time.Date(day.Year(), day.Month(), day.Day(), 12, 0, 0, 0, bsdate.Nepal)
+	if bs, err := bsdate.FromTime(civil); err == nil {
+		h.DateBs = bs.String()
+	}
+	return h
+}
+
+// parseClock parses an "HH:MM" time of day
+func parseClock(s string) (pgtype.Time, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return pgtype.Time{}, err
+	}
+	return pgtype.Time{Microseconds: int64(t.Hour()*60+t.Minute()) * 60 * 1e6, Valid: true}, nil
+}
+
+func formatClock(t pgtype.Time) string {
+	minutes := t.Microseconds / (60 * 1e6)
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// pgDate is the calendar date of t, as written in t's location
+func pgDate(t time.Time) pgtype.Date {
+	return pgtype.Date{Time: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), Valid: true}
+}
+
+func startOfNepalDay(t time.Time) time.Time {
+	y, m, d := t.In(bsdate.Nepal).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, bsdate.Nepal)
+}
@@ -0,0 +1,286 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"git.ninjainfosys.com/ePalika/pkg/bsdate"
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/domain"
+)
+
+// maxStatsBuckets bounds the length of a stats series
+const maxStatsBuckets = 400
+
+// defaultStatsRange is how far back a series reaches when the request sets
+// no dates, by interval
+var defaultStatsRange = map[dartav1.StatsInterval]int{
+	dartav1.StatsInterval_STATS_INTERVAL_DAY:   30,
+	dartav1.StatsInterval_STATS_INTERVAL_WEEK:  12 * 7,
+	dartav1.StatsInterval_STATS_INTERVAL_MONTH: 365,
+}
+
+// GetDartaStats gets darta statistics: counts, times in state from the
+// audit trail, breakdowns and a series for dashboards
+func (s *DartaServer) GetDartaStats(ctx context.Context, req *dartav1.GetDartaStatsRequest) (*dartav1.GetDartaStatsResponse, error) {
+	userCtx := domain.GetUserContext(ctx)
+
+	interval := req.Interval
+	if interval == dartav1.StatsInterval_STATS_INTERVAL_UNSPECIFIED {
+		interval = dartav1.StatsInterval_STATS_INTERVAL_DAY
+	}
+	if req.FromDate != nil && req.ToDate != nil && !req.ToDate.AsTime().After(req.FromDate.AsTime()) {
+		return nil, invalidArgument("to_date", "must be after from_date")
+	}
+
+	fiscalYearID := sqlNullString(domain.NormalizeFiscalYearID(req.FiscalYearId))
+	scope := enumFilter(req.Scope.String(), "SCOPE_")
+	wardID := sqlNullString(req.WardId)
+	receivedFrom := protoToPgTimestamptz(req.FromDate)
+	receivedTo := protoToPgTimestamptz(req.ToDate)
+
+	statusCounts, err := s.queries.GetDartaStatsByStatus(ctx, db.GetDartaStatsByStatusParams{
+		TenantID:     userCtx.TenantID,
+		FiscalYearID: fiscalYearID,
+		Scope:        scope,
+		WardID:       wardID,
+		ReceivedFrom: receivedFrom,
+		ReceivedTo:   receivedTo,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to count dartas by status: %w", err))
+	}
+
+	channelCounts, err := s.queries.GetDartaStatsByChannel(ctx, db.GetDartaStatsByChannelParams{
+		TenantID:     userCtx.TenantID,
+		FiscalYearID: fiscalYearID,
+		Scope:        scope,
+		WardID:       wardID,
+		ReceivedFrom: receivedFrom,
+		ReceivedTo:   receivedTo,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to count dartas by channel: %w", err))
+	}
+
+	summary, err := s.queries.GetDartaStatsSummary(ctx, db.GetDartaStatsSummaryParams{
+		TenantID:     userCtx.TenantID,
+		FiscalYearID: fiscalYearID,
+		Scope:        scope,
+		WardID:       wardID,
+		ReceivedFrom: receivedFrom,
+		ReceivedTo:   receivedTo,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to summarise dartas: %w", err))
+	}
+
+	turnaround, err := s.queries.GetSectionTurnaround(ctx, db.GetSectionTurnaroundParams{
+		TenantID:     userCtx.TenantID,
+		FiscalYearID: fiscalYearID,
+		Scope:        scope,
+		WardID:       wardID,
+		ReceivedFrom: receivedFrom,
+		ReceivedTo:   receivedTo,
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to measure section turnaround: %w", err))
+	}
+
+	buckets, err := statsBuckets(interval, req.FromDate, req.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	activity, err := s.queries.ListDartaDailyActivity(ctx, db.ListDartaDailyActivityParams{
+		TenantID:     userCtx.TenantID,
+		FiscalYearID: fiscalYearID,
+		Scope:        scope,
+		WardID:       wardID,
+		FromTime:     pgtype.Timestamptz{Time: buckets[0].Start.AsTime(), Valid: true},
+		ToTime:       pgtype.Timestamptz{Time: buckets[len(buckets)-1].Start.AsTime(), Valid: true},
+	})
+	if err != nil {
+		return nil, mapDomainError(ctx, fmt.Errorf("failed to list darta activity: %w", err))
+	}
+
+	stats := &dartav1.DartaStats{
+		Series: fillStatsBuckets(buckets, activity),
+	}
+	for _, sc := range statusCounts {
+		stats.ByStatus = append(stats.ByStatus, &dartav1.DartaStatusCount{
+			Status: stringToDartaStatus(sc.Status),
+			Count:  int32(sc.Count),
+		})
+	}
+	for _, cc := range channelCounts {
+		stats.ByChannel = append(stats.ByChannel, &dartav1.ChannelCount{
+			Channel: stringToIntakeChannel(cc.IntakeChannel),
+			Count:   int32(cc.Count),
+		})
+	}
+
+	sections := map[string]*dartav1.DartaStatsBreakdown{}
+	for _, row := range summary {
+		breakdown := toProtoStatsBreakdown(row)
+		switch row.Dimension {
+		case "":
+			stats.Total = row.Total
+			stats.OverdueCount = row.OverdueCount
+			stats.AvgProcessingTimeHours = row.ProcessingAvgHours
+			stats.ReceivedToRegistered = breakdown.ReceivedToRegistered
+			stats.RegisteredToClosed = breakdown.RegisteredToClosed
+		case "WARD":
+			stats.ByWard = append(stats.ByWard, breakdown)
+		case "SECTION":
+			stats.BySection = append(stats.BySection, breakdown)
+			sections[row.Key] = breakdown
+		case "CHANNEL":
+			stats.ByIntakeChannel = append(stats.ByIntakeChannel, breakdown)
+		case "PRIORITY":
+			stats.ByPriority = append(stats.ByPriority, breakdown)
+		}
+	}
+	// A section dartas have passed through may hold none of them now
+	for _, row := range turnaround {
+		breakdown, ok := sections[row.SectionID]
+		if !ok {
+			breakdown = &dartav1.DartaStatsBreakdown{Key: row.SectionID}
+			stats.BySection = append(stats.BySection, breakdown)
+		}
+		breakdown.SectionTurnaround = &dartav1.DurationStats{
+			Count:    row.SpanCount,
+			AvgHours: row.AvgHours,
+			P90Hours: row.P90Hours,
+		}
+	}
+
+	return &dartav1.GetDartaStatsResponse{
+		Stats: stats,
+	}, nil
+}
+
+func toProtoStatsBreakdown(row db.GetDartaStatsSummaryRow) *dartav1.DartaStatsBreakdown {
+	return &dartav1.DartaStatsBreakdown{
+		Key:          row.Key,
+		Total:        row.Total,
+		OverdueCount: row.OverdueCount,
+		ReceivedToRegistered: &dartav1.DurationStats{
+			Count:    row.RegisteredCount,
+			AvgHours: row.RegistrationAvgHours,
+			P90Hours: row.RegistrationP90Hours,
+		},
+		RegisteredToClosed: &dartav1.DurationStats{
+			Count:    row.ClosureCount,
+			AvgHours: row.ClosureAvgHours,
+			P90Hours: row.ClosureP90Hours,
+		},
+	}
+}
+
+// statsBuckets returns the empty buckets of a series covering from to to,
+// followed by one extra bucket whose start ends the series. Unset dates
+// default to a range ending today.
+func statsBuckets(interval dartav1.StatsInterval, from, to *timestamppb.Timestamp) ([]*dartav1.DartaStatsBucket, error) {
+	end := startOfNepalDay(time.Now()).AddDate(0, 0, 1)
+	if to != nil {
+		end = to.AsTime()
+	}
+	start := end.AddDate(0, 0, -defaultStatsRange[interval])
+	if from != nil {
+		start = from.AsTime()
+	}
+
+	t, err := bucketStart(interval, start)
+	if err != nil {
+		return nil, err
+	}
+	var buckets []*dartav1.DartaStatsBucket
+	for {
+		d, err := bsdate.FromTime(t)
+		if err != nil {
+			return nil, invalidArgument("from_date", "outside the supported BS calendar")
+		}
+		buckets = append(buckets, &dartav1.DartaStatsBucket{
+			Start:   timestamppb.New(t),
+			StartBs: d.String(),
+		})
+		if !t.Before(end) {
+			return buckets, nil
+		}
+		if len(buckets) > maxStatsBuckets {
+			return nil, invalidArgument("interval", fmt.Sprintf("the range spans more than %d buckets; use a longer interval or a shorter range", maxStatsBuckets))
+		}
+		if t, err = nextBucket(interval, t); err != nil {
+			return nil, invalidArgument("to_date", "outside the supported BS calendar")
+		}
+	}
+}
+
+// fillStatsBuckets adds each day's activity to the bucket holding it and
+// drops the closing bucket statsBuckets appends
+func fillStatsBuckets(buckets []*dartav1.DartaStatsBucket, activity []db.ListDartaDailyActivityRow) []*dartav1.DartaStatsBucket {
+	series := buckets[:len(buckets)-1]
+	i := 0
+	for _, row := range activity {
+		y, m, d := row.Day.Time.Date()
+		day := time.Date(y, m, d, 0, 0, 0, 0, bsdate.Nepal)
+		for i+1 < len(buckets) && !day.Before(buckets[i+1].Start.AsTime()) {
+			i++
+		}
+		if i >= len(series) {
+			break
+		}
+		series[i].Received += row.Received
+		series[i].Registered += row.Registered
+		series[i].Closed += row.Closed
+	}
+	return series
+}
+
+// bucketStart returns the start of the bucket holding t: its day, the
+// Sunday starting its week or the first of its BS month
+func bucketStart(interval dartav1.StatsInterval, t time.Time) (time.Time, error) {
+	day := startOfNepalDay(t)
+	switch interval {
+	case dartav1.StatsInterval_STATS_INTERVAL_WEEK:
+		return day.AddDate(0, 0, -int(day.Weekday())), nil
+	case dartav1.StatsInterval_STATS_INTERVAL_MONTH:
+		d, err := bsdate.FromTime(day)
+		if err != nil {
+			return time.Time{}, invalidArgument("from_date", "outside the supported BS calendar")
+		}
+		d.Day = 1
+		return d.Time()
+	default:
+		return day, nil
+	}
+}
+
+// nextBucket returns the start of the bucket after the one starting at t
+func nextBucket(interval dartav1.StatsInterval, t time.Time) (time.Time, error) {
+	switch interval {
+	case dartav1.StatsInterval_STATS_INTERVAL_WEEK:
+		return t.AddDate(0, 0, 7), nil
+	case dartav1.StatsInterval_STATS_INTERVAL_MONTH:
+		d, err := bsdate.FromTime(t)
+		if err != nil {
+			return time.Time{}, err
+		}
+		last, err := d.EndOfMonth()
+		if err != nil {
+			return time.Time{}, err
+		}
+		next, err := last.AddDays(1)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return next.Time()
+	default:
+		return t.AddDate(0, 0, 1), nil
+	}
+}
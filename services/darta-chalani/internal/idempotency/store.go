@@ -0,0 +1,172 @@
+// Package idempotency remembers the responses of mutations sent with an
+// idempotency key, so that a retry replays the response instead of applying
+// the mutation twice.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+)
+
+// abandonAfter is how long a claim may stay without a response before a
+// retry of the same request takes it over, e.g. after a crash
+const abandonAfter = 5 * time.Minute
+
+var (
+	// ErrKeyReused is returned when a key is sent with a different request
+	// than the one it was first used for
+	ErrKeyReused = errors.New("idempotency key was used for a different request")
+	// ErrInProgress is returned while the first request with a key has not
+	// finished
+	ErrInProgress = errors.New("a request with this idempotency key is still in progress")
+)
+
+// Request identifies a mutation sent with an idempotency key
+type Request struct {
+	TenantID string
+	Key      string
+	Method   string
+	Hash     string
+}
+
+// NewRequest identifies req, a call of the full gRPC method, by its tenant,
+// key and a hash of its content
+func NewRequest(tenantID, key, method string, req proto.Message) (Request, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return Request{}, fmt.Errorf("failed to hash request: %w", err)
+	}
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write(b)
+	return Request{TenantID: tenantID, Key: key, Method: method, Hash: hex.EncodeToString(h.Sum(nil))}, nil
+}
+
+// Store keeps responses for ttl, removing expired ones every interval
+type Store struct {
+	queries  db.Querier
+	ttl      time.Duration
+	interval time.Duration
+}
+
+// NewStore creates a Store
+func NewStore(queries db.Querier, ttl, interval time.Duration) *Store {
+	return &Store{queries: queries, ttl: ttl, interval: interval}
+}
+
+// Claim reserves the key for r. It returns nil when the caller should go
+// ahead and apply the mutation, the stored response when r was already
+// applied, ErrKeyReused when the key belongs to another request and
+// ErrInProgress while the first request is still running.
+func (s *Store) Claim(ctx context.Context, r Request) (proto.Message, error) {
+	now := time.Now()
+	claimed, err := s.queries.ClaimIdempotencyKey(ctx, db.ClaimIdempotencyKeyParams{
+		TenantID:        r.TenantID,
+		IdempotencyKey:  r.Key,
+		Method:          r.Method,
+		RequestHash:     r.Hash,
+		ExpiresAt:       pgtype.Timestamptz{Time: now.Add(s.ttl), Valid: true},
+		AbandonedBefore: pgtype.Timestamptz{Time: now.Add(-abandonAfter), Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim idempotency key: %w", err)
+	}
+	if claimed > 0 {
+		return nil, nil
+	}
+
+	existing, err := s.queries.GetIdempotencyKey(ctx, db.GetIdempotencyKeyParams{
+		TenantID:       r.TenantID,
+		IdempotencyKey: r.Key,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Removed by cleanup in between; the next attempt claims it
+		return nil, ErrInProgress
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+	if existing.RequestHash != r.Hash {
+		return nil, ErrKeyReused
+	}
+	if existing.Response == nil {
+		return nil, ErrInProgress
+	}
+
+	var stored anypb.Any
+	if err := proto.Unmarshal(existing.Response, &stored); err != nil {
+		return nil, fmt.Errorf("failed to decode stored response: %w", err)
+	}
+	resp, err := stored.UnmarshalNew()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode stored response: %w", err)
+	}
+	return resp, nil
+}
+
+// Complete stores the response of the request r claimed
+func (s *Store) Complete(ctx context.Context, r Request, resp proto.Message) error {
+	stored, err := anypb.New(resp)
+	if err != nil {
+		return fmt.Errorf("failed to encode response: %w", err)
+	}
+	b, err := proto.Marshal(stored)
+	if err != nil {
+		return fmt.Errorf("failed to encode response: %w", err)
+	}
+	if err := s.queries.CompleteIdempotencyKey(ctx, db.CompleteIdempotencyKeyParams{
+		TenantID:       r.TenantID,
+		IdempotencyKey: r.Key,
+		RequestHash:    r.Hash,
+		Response:       b,
+	}); err != nil {
+		return fmt.Errorf("failed to store response: %w", err)
+	}
+	return nil
+}
+
+// Release gives up the claim of a request that failed, so that a retry
+// applies it again rather than replaying the failure
+func (s *Store) Release(ctx context.Context, r Request) error {
+	if err := s.queries.ReleaseIdempotencyKey(ctx, db.ReleaseIdempotencyKeyParams{
+		TenantID:       r.TenantID,
+		IdempotencyKey: r.Key,
+		RequestHash:    r.Hash,
+	}); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Run removes expired keys until ctx is done
+func (s *Store) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := s.queries.DeleteExpiredIdempotencyKeys(ctx); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("idempotency key cleanup failed: %v", err)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package kitab
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// WriteCSV writes the register as UTF-8 CSV: the column headings, one row
+// per entry with the mark in its own column, then the footer after a blank
+// row. A byte order mark lets spreadsheet programs detect the encoding of
+// Devanagari text.
+func WriteCSV(w io.Writer, r *Register) error {
+	if _, err := io.WriteString(w, "\uFEFF"); err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	cw.Write(append(r.Columns(), "mark"))
+	for _, e := range r.Entries {
+		cw.Write(append(e.Cells(), e.Mark))
+	}
+	cw.Write([]string{})
+	for _, line := range r.Footer() {
+		cw.Write([]string{line})
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,180 @@
+package kitab
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/language"
+	"github.com/go-text/typesetting/shaping"
+	"golang.org/x/image/math/fixed"
+)
+
+// Fonts are the typefaces PDF registers are set in. Devanagari needs a
+// shaping engine to order vowel signs and form conjuncts, so text is shaped
+// with HarfBuzz's rules and the fonts are embedded whole. Runes the
+// Devanagari font lacks, typically Latin letters, fall back to the Latin
+// font when one is loaded.
+type Fonts struct {
+	files []*fontFile
+}
+
+type fontFile struct {
+	data []byte
+	font *font.Font
+	name string // PostScript name, without spaces
+}
+
+// LoadFonts reads the Devanagari font and, unless latinPath is empty, the
+// Latin fallback font
+func LoadFonts(devanagariPath, latinPath string) (*Fonts, error) {
+	f := &Fonts{}
+	for _, path := range []string{devanagariPath, latinPath} {
+		if path == "" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read font: %w", err)
+		}
+		face, err := font.ParseTTF(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse font %s: %w", path, err)
+		}
+		name := strings.Map(func(r rune) rune {
+			if r > unicode.MaxASCII || unicode.IsSpace(r) || strings.ContainsRune("()<>[]{}/%#", r) {
+				return -1
+			}
+			return r
+		}, face.Describe().Family)
+		if name == "" {
+			name = fmt.Sprintf("Font%d", len(f.files)+1)
+		}
+		f.files = append(f.files, &fontFile{data: data, font: face.Font, name: name})
+	}
+	if len(f.files) == 0 {
+		return nil, fmt.Errorf("a Devanagari font is required")
+	}
+	return f, nil
+}
+
+// placedGlyph is a glyph positioned relative to the start of its line, in
+// points
+type placedGlyph struct {
+	font int
+	gid  font.GID
+	x, y float64
+}
+
+// line is a shaped line of text
+type line struct {
+	text   string
+	glyphs []placedGlyph
+	width  float64
+}
+
+// typesetter shapes the text of one document. Font faces and the shaper
+// keep caches, so they are not shared between documents.
+type typesetter struct {
+	fonts  *Fonts
+	faces  []*font.Face
+	shaper shaping.HarfbuzzShaper
+	seg    shaping.Segmenter
+	// used records the glyphs set in each font, with the text of glyphs
+	// that stand for their whole cluster
+	used []map[font.GID]string
+}
+
+func newTypesetter(fonts *Fonts) *typesetter {
+	t := &typesetter{fonts: fonts}
+	for _, f := range fonts.files {
+		t.faces = append(t.faces, font.NewFace(f.font))
+		t.used = append(t.used, map[font.GID]string{})
+	}
+	return t
+}
+
+// ResolveFace picks the first font with a glyph for r
+func (t *typesetter) ResolveFace(r rune) *font.Face {
+	for _, face := range t.faces {
+		if _, ok := face.NominalGlyph(r); ok {
+			return face
+		}
+	}
+	return t.faces[0]
+}
+
+// shape lays text out on a single line at size points
+func (t *typesetter) shape(text string, size float64) line {
+	runes := []rune(text)
+	l := line{text: text}
+	if len(runes) == 0 {
+		return l
+	}
+	input := shaping.Input{
+		Text:      runes,
+		RunEnd:    len(runes),
+		Direction: di.DirectionLTR,
+		Face:      t.faces[0],
+		Size:      fixed.Int26_6(size * 64),
+		Script:    language.Devanagari,
+		Language:  language.NewLanguage("ne"),
+	}
+	pen := 0.0
+	for _, run := range t.seg.Split(input, t) {
+		out := t.shaper.Shape(run)
+		index := 0
+		for i, face := range t.faces {
+			if face == out.Face {
+				index = i
+			}
+		}
+		for _, g := range out.Glyphs {
+			l.glyphs = append(l.glyphs, placedGlyph{
+				font: index,
+				gid:  g.GlyphID,
+				x:    pen + fromFixed(g.XOffset),
+				y:    fromFixed(g.YOffset),
+			})
+			if g.GlyphCount == 1 && g.RuneCount > 0 {
+				if _, seen := t.used[index][g.GlyphID]; !seen {
+					t.used[index][g.GlyphID] = string(runes[g.ClusterIndex : g.ClusterIndex+g.RuneCount])
+				}
+			} else if _, seen := t.used[index][g.GlyphID]; !seen {
+				t.used[index][g.GlyphID] = ""
+			}
+			pen += fromFixed(g.Advance)
+		}
+	}
+	l.width = pen
+	return l
+}
+
+// wrap breaks text into lines no wider than width, at spaces. A word wider
+// than width gets a line of its own.
+func (t *typesetter) wrap(text string, size, width float64) []line {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return []line{{}}
+	}
+	var lines []line
+	current := t.shape(words[0], size)
+	for _, word := range words[1:] {
+		candidate := t.shape(current.text+" "+word, size)
+		if candidate.width <= width {
+			current = candidate
+			continue
+		}
+		lines = append(lines, current)
+		current = t.shape(word, size)
+	}
+	return append(lines, current)
+}
+
+func fromFixed(v fixed.Int26_6) float64 {
+	return float64(v) / 64
+}
@@ -0,0 +1,281 @@
+package kitab
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/go-text/typesetting/font"
+)
+
+// A4 landscape, in points
+const (
+	pageWidth  = 842.0
+	pageHeight = 595.0
+	margin     = 36.0
+	cellPad    = 3.0
+)
+
+// Type sizes, in points
+const (
+	titleSize  = 14.0
+	headerSize = 9.0
+	bodySize   = 8.0
+	footerSize = 7.0
+)
+
+// pdfColumnWidths are the widths of the register's columns, in points. They
+// fill the page between the margins.
+var pdfColumnWidths = []float64{110, 62, 130, 250, 70, 80, 68}
+
+// lineHeight leaves room for Devanagari vowel signs above and below the line
+func lineHeight(size float64) float64 {
+	return size * 1.5
+}
+
+// pdfPage is the content of one page
+type pdfPage struct {
+	content strings.Builder
+}
+
+// pdfDocument lays a register out on pages
+type pdfDocument struct {
+	ts    *typesetter
+	pages []*pdfPage
+	page  *pdfPage
+	y     float64 // Top of the free space on the current page
+}
+
+// WritePDF writes the register as an A4 landscape PDF with Devanagari text.
+// The title and column headings repeat on every page, gaps and voids are
+// shaded, and the footer follows the last entry.
+func (f *Fonts) WritePDF(w io.Writer, r *Register) error {
+	doc := &pdfDocument{ts: newTypesetter(f)}
+	doc.newPage(r)
+
+	for _, e := range r.Entries {
+		cells := make([][]line, len(pdfColumnWidths))
+		rows := 1
+		for i, v := range e.Cells() {
+			cells[i] = doc.ts.wrap(v, bodySize, pdfColumnWidths[i]-2*cellPad)
+			if len(cells[i]) > rows {
+				rows = len(cells[i])
+			}
+		}
+		height := float64(rows)*lineHeight(bodySize) + 2*cellPad
+		if doc.y-height < margin+lineHeight(footerSize) {
+			doc.newPage(r)
+		}
+		doc.row(cells, height, e.Mark != "")
+	}
+
+	footer := r.Footer()
+	if doc.y-float64(len(footer)+1)*lineHeight(footerSize) < margin+lineHeight(footerSize) {
+		doc.newPage(r)
+	}
+	doc.y -= lineHeight(footerSize)
+	for _, text := range footer {
+		doc.y -= lineHeight(footerSize)
+		doc.text(doc.ts.shape(text, footerSize), footerSize, margin, doc.y)
+	}
+
+	for i, p := range doc.pages {
+		doc.page = p
+		number := doc.ts.shape(fmt.Sprintf("%d / %d", i+1, len(doc.pages)), footerSize)
+		doc.text(number, footerSize, pageWidth-margin-number.width, margin-lineHeight(footerSize))
+	}
+	return doc.write(w)
+}
+
+// newPage starts a page with the register's title and column headings
+func (d *pdfDocument) newPage(r *Register) {
+	d.page = &pdfPage{}
+	d.pages = append(d.pages, d.page)
+	d.y = pageHeight - margin
+
+	d.y -= lineHeight(titleSize)
+	d.text(d.ts.shape(r.Title(), titleSize), titleSize, margin, d.y)
+	d.y -= lineHeight(headerSize)
+	d.text(d.ts.shape(r.Subtitle(), headerSize), headerSize, margin, d.y)
+	d.y -= cellPad
+
+	cells := make([][]line, len(pdfColumnWidths))
+	for i, v := range r.Columns() {
+		cells[i] = []line{d.ts.shape(v, headerSize)}
+	}
+	fmt.Fprintf(&d.page.content, "0.85 g %.2f %.2f %.2f %.2f re f 0 g\n", margin, d.y-lineHeight(headerSize)-2*cellPad, pageWidth-2*margin, lineHeight(headerSize)+2*cellPad)
+	d.rowAt(cells, lineHeight(headerSize)+2*cellPad, headerSize)
+}
+
+// row draws an entry, shaded when it is a gap or void
+func (d *pdfDocument) row(cells [][]line, height float64, shaded bool) {
+	if shaded {
+		fmt.Fprintf(&d.page.content, "0.93 g %.2f %.2f %.2f %.2f re f 0 g\n", margin, d.y-height, pageWidth-2*margin, height)
+	}
+	d.rowAt(cells, height, bodySize)
+}
+
+// rowAt draws cells in a bordered row of the given height below d.y
+func (d *pdfDocument) rowAt(cells [][]line, height, size float64) {
+	top := d.y
+	x := margin
+	for i, lines := range cells {
+		baseline := top - cellPad - size*1.1
+		for _, l := range lines {
+			d.text(l, size, x+cellPad, baseline)
+			baseline -= lineHeight(size)
+		}
+		fmt.Fprintf(&d.page.content, "0.4 w %.2f %.2f %.2f %.2f re S\n", x, top-height, pdfColumnWidths[i], height)
+		x += pdfColumnWidths[i]
+	}
+	d.y = top - height
+}
+
+// text sets a shaped line with its baseline starting at x, y. The line's
+// text is attached as ActualText, so copying and searching see the words
+// rather than the reordered glyphs.
+func (d *pdfDocument) text(l line, size, x, y float64) {
+	if len(l.glyphs) == 0 {
+		return
+	}
+	c := &d.page.content
+	fmt.Fprintf(c, "/Span <</ActualText <%s>>> BDC BT\n", utf16Hex(l.text, true))
+	current := -1
+	for _, g := range l.glyphs {
+		if g.font != current {
+			fmt.Fprintf(c, "/F%d %.1f Tf\n", g.font+1, size)
+			current = g.font
+		}
+		fmt.Fprintf(c, "1 0 0 1 %.2f %.2f Tm <%04X> Tj\n", x+g.x, y+g.y, uint16(g.gid))
+	}
+	c.WriteString("ET EMC\n")
+}
+
+// write serialises the document: the catalog and page tree, each font used
+// as an embedded TrueType CID font, then the pages
+func (d *pdfDocument) write(w io.Writer) error {
+	var out bytes.Buffer
+	var offsets []int
+	// Objects are numbered in the order they are written; references to
+	// later objects are computed ahead
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+	stream := func(dict string, data []byte) {
+		var z bytes.Buffer
+		zw := zlib.NewWriter(&z)
+		zw.Write(data)
+		zw.Close()
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n<<%s /Filter /FlateDecode /Length %d>>\nstream\n", len(offsets), dict, z.Len())
+		out.Write(z.Bytes())
+		out.WriteString("\nendstream\nendobj\n")
+	}
+
+	out.WriteString("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n")
+
+	const fontObjects = 5 // Type0, CIDFont, descriptor, font file, ToUnicode
+	var used []int
+	for i := range d.ts.faces {
+		if len(d.ts.used[i]) > 0 {
+			used = append(used, i)
+		}
+	}
+	firstPage := 3 + len(used)*fontObjects
+
+	var kids, fonts strings.Builder
+	for i := range d.pages {
+		fmt.Fprintf(&kids, "%d 0 R ", firstPage+2*i)
+	}
+	for n, i := range used {
+		fmt.Fprintf(&fonts, "/F%d %d 0 R ", i+1, 3+n*fontObjects)
+	}
+
+	object("<</Type /Catalog /Pages 2 0 R>>")
+	object(fmt.Sprintf("<</Type /Pages /Kids [%s] /Count %d>>", kids.String(), len(d.pages)))
+
+	for _, i := range used {
+		base := len(offsets) + 1
+		file := d.ts.fonts.files[i]
+		face := d.ts.faces[i]
+		scale := 1000 / float64(face.Upem())
+		extents, _ := face.FontHExtents()
+
+		gids := make([]int, 0, len(d.ts.used[i]))
+		for gid := range d.ts.used[i] {
+			gids = append(gids, int(gid))
+		}
+		sort.Ints(gids)
+		var widths strings.Builder
+		for _, gid := range gids {
+			fmt.Fprintf(&widths, "%d [%.0f] ", gid, float64(face.HorizontalAdvance(font.GID(gid)))*scale)
+		}
+
+		object(fmt.Sprintf("<</Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R>>",
+			file.name, base+1, base+4))
+		object(fmt.Sprintf("<</Type /Font /Subtype /CIDFontType2 /BaseFont /%s /CIDSystemInfo <</Registry (Adobe) /Ordering (Identity) /Supplement 0>> /FontDescriptor %d 0 R /CIDToGIDMap /Identity /DW 1000 /W [%s]>>",
+			file.name, base+2, widths.String()))
+		ascent, descent := float64(extents.Ascender)*scale, float64(extents.Descender)*scale
+		object(fmt.Sprintf("<</Type /FontDescriptor /FontName /%s /Flags 32 /FontBBox [-1000 %.0f 2000 %.0f] /ItalicAngle 0 /Ascent %.0f /Descent %.0f /CapHeight %.0f /StemV 80 /FontFile2 %d 0 R>>",
+			file.name, descent, ascent, ascent, descent, ascent, base+3))
+		stream(fmt.Sprintf(" /Length1 %d", len(file.data)), file.data)
+		stream("", toUnicodeCMap(d.ts.used[i]))
+	}
+
+	for i, p := range d.pages {
+		object(fmt.Sprintf("<</Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources <</Font <<%s>>>> /Contents %d 0 R>>",
+			pageWidth, pageHeight, fonts.String(), firstPage+2*i+1))
+		stream("", []byte(p.content.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<</Size %d /Root 1 0 R>>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err := w.Write(out.Bytes())
+	return err
+}
+
+// toUnicodeCMap maps glyphs that stand for a whole cluster back to its text
+func toUnicodeCMap(glyphs map[font.GID]string) []byte {
+	var entries []string
+	for gid, text := range glyphs {
+		if text != "" {
+			entries = append(entries, fmt.Sprintf("<%04X> <%s>", uint16(gid), utf16Hex(text, false)))
+		}
+	}
+	sort.Strings(entries)
+
+	var b bytes.Buffer
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n")
+	b.WriteString("/CIDSystemInfo <</Registry (Adobe) /Ordering (UCS) /Supplement 0>> def\n")
+	b.WriteString("/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n")
+	b.WriteString("1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	for len(entries) > 0 {
+		n := min(len(entries), 100)
+		fmt.Fprintf(&b, "%d beginbfchar\n%s\nendbfchar\n", n, strings.Join(entries[:n], "\n"))
+		entries = entries[n:]
+	}
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n")
+	return b.Bytes()
+}
+
+// utf16Hex encodes s as UTF-16BE hex, with a byte order mark when bom is set
+func utf16Hex(s string, bom bool) string {
+	var b strings.Builder
+	if bom {
+		b.WriteString("FEFF")
+	}
+	for _, u := range utf16.Encode([]rune(s)) {
+		fmt.Fprintf(&b, "%04X", u)
+	}
+	return b.String()
+}
@@ -0,0 +1,171 @@
+// Package kitab produces the statutory registers a palika keeps per fiscal
+// year: the darta kitab of incoming and the chalani kitab of outgoing
+// correspondence.
+//
+// A register lists every number from 1 to the last one issued. Numbers no
+// record holds are marked as gaps and voided records stay in place, so a
+// printed register accounts for every number. Each export is sealed with a
+// SHA-256 digest of its contents and an Ed25519 signature of the digest,
+// printed in its footer.
+package kitab
+
+import (
+	"fmt"
+	"time"
+
+	"git.ninjainfosys.com/ePalika/pkg/bsdate"
+)
+
+// Book is a kind of register
+type Book string
+
+// Registers kept per fiscal year
+const (
+	BookDarta   Book = "DARTA"
+	BookChalani Book = "CHALANI"
+)
+
+// Marks flag register lines that are not ordinary records
+const (
+	MarkGap  = "GAP"
+	MarkVoid = "VOID"
+)
+
+// Record is a numbered darta or chalani as read from the database
+type Record struct {
+	Number          int
+	FormattedNumber string
+	RecordedAt      time.Time // Received for dartas, dispatched or created for chalanis
+	Party           string    // Sender of a darta, recipient of a chalani
+	Subject         string
+	Section         string
+	Status          string
+}
+
+// Entry is one line of a register
+type Entry struct {
+	Number          int
+	FormattedNumber string
+	Date            string // BS date, YYYY-MM-DD
+	Party           string
+	Subject         string
+	Section         string
+	Status          string
+	Mark            string // MarkGap, MarkVoid or empty
+}
+
+// Register is a fiscal year's register for the municipality or one ward
+type Register struct {
+	Book        Book
+	FiscalYear  bsdate.FiscalYear
+	Scope       string // MUNICIPALITY or WARD
+	WardID      string
+	Entries     []Entry
+	GeneratedAt time.Time
+	GeneratedBy string
+
+	// Seal is set by Signer.Seal before the register is written
+	Seal Seal
+}
+
+// Build lays records out in number order, adding a gap entry for every
+// number from 1 to the last one that no record holds. format gives the
+// formatted number of a gap.
+func Build(records []Record, format func(number int) string) []Entry {
+	var entries []Entry
+	next := 1
+	for _, rec := range records {
+		for ; next < rec.Number; next++ {
+			entries = append(entries, Entry{Number: next, FormattedNumber: format(next), Mark: MarkGap})
+		}
+		entry := Entry{
+			Number:          rec.Number,
+			FormattedNumber: rec.FormattedNumber,
+			Party:           rec.Party,
+			Subject:         rec.Subject,
+			Section:         rec.Section,
+			Status:          rec.Status,
+		}
+		if entry.FormattedNumber == "" {
+			entry.FormattedNumber = format(rec.Number)
+		}
+		if d, err := bsdate.FromTime(rec.RecordedAt); err == nil {
+			entry.Date = d.String()
+		}
+		if rec.Status == "VOIDED" {
+			entry.Mark = MarkVoid
+		}
+		entries = append(entries, entry)
+		next = rec.Number + 1
+	}
+	return entries
+}
+
+// Counts returns how many entries the register has, and how many of them
+// are gaps and voids
+func (r *Register) Counts() (entries, gaps, voids int) {
+	for _, e := range r.Entries {
+		switch e.Mark {
+		case MarkGap:
+			gaps++
+		case MarkVoid:
+			voids++
+		}
+	}
+	return len(r.Entries), gaps, voids
+}
+
+// Title is the register's heading, in Nepali
+func (r *Register) Title() string {
+	if r.Book == BookChalani {
+		return "चलानी किताब"
+	}
+	return "दर्ता किताब"
+}
+
+// Subtitle names the fiscal year and the office the register is kept for
+func (r *Register) Subtitle() string {
+	office := "नगरपालिका"
+	if r.Scope == "WARD" {
+		office = "वडा नं. " + bsdate.ToNepaliDigits(r.WardID)
+	}
+	return fmt.Sprintf("आर्थिक वर्ष %s, %s", r.FiscalYear.NepaliCode(), office)
+}
+
+// Columns are the register's column headings
+func (r *Register) Columns() []string {
+	if r.Book == BookChalani {
+		return []string{"चलानी नं.", "मिति", "पाउने", "विषय", "शाखा", "स्थिति", "कैफियत"}
+	}
+	return []string{"दर्ता नं.", "मिति", "पठाउने", "विषय", "शाखा", "स्थिति", "कैफियत"}
+}
+
+// Cells are an entry's values, in the order of Columns
+func (e Entry) Cells() []string {
+	return []string{e.FormattedNumber, e.Date, e.Party, e.Subject, e.Section, e.Status, e.Remark()}
+}
+
+// Remark explains an entry's mark
+func (e Entry) Remark() string {
+	switch e.Mark {
+	case MarkGap:
+		return "रिक्त नम्बर (GAP)"
+	case MarkVoid:
+		return "रद्द (VOID)"
+	default:
+		return ""
+	}
+}
+
+// Filename is the name an export of the register is saved under
+func (r *Register) Filename(ext string) string {
+	office := "municipality"
+	if r.Scope == "WARD" {
+		office = "ward-" + r.WardID
+	}
+	book := "darta-kitab"
+	if r.Book == BookChalani {
+		book = "chalani-kitab"
+	}
+	return fmt.Sprintf("%s-%s-%s.%s", book, r.FiscalYear.Code(), office, ext)
+}
@@ -0,0 +1,145 @@
+package kitab
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"git.ninjainfosys.com/ePalika/pkg/bsdate"
+)
+
+func formatNumber(n int) string {
+	return fmt.Sprintf("2081-82/MUN/D-%05d", n)
+}
+
+// testRegister is an unsealed darta register of five numbers: two gaps,
+// a voided darta and two ordinary ones
+func testRegister() *Register {
+	records := []Record{
+		{Number: 1, FormattedNumber: "2081-82/MUN/D-00001", RecordedAt: time.Date(2025, 4, 14, 10, 0, 0, 0, bsdate.Nepal), Party: "राम बहादुर", Subject: "नागरिकता सिफारिस", Section: "प्रशासन", Status: "REGISTERED"},
+		// Late evening in UTC is already the next day in Nepal
+		{Number: 3, FormattedNumber: "2081-82/MUN/D-00003", RecordedAt: time.Date(2025, 4, 14, 19, 0, 0, 0, time.UTC), Party: "सीता कुमारी", Subject: "जग्गा नामसारी", Section: "मालपोत", Status: "VOIDED"},
+		{Number: 5, RecordedAt: time.Date(2025, 4, 16, 11, 0, 0, 0, bsdate.Nepal), Party: "हरि प्रसाद", Subject: "घर नक्सा", Section: "प्राविधिक", Status: "CLOSED"},
+	}
+	return &Register{
+		Book:        BookDarta,
+		FiscalYear:  bsdate.FiscalYear{StartYear: 2081},
+		Scope:       "MUNICIPALITY",
+		Entries:     Build(records, formatNumber),
+		GeneratedAt: time.Date(2025, 7, 1, 5, 0, 0, 0, time.UTC),
+		GeneratedBy: "registrar",
+	}
+}
+
+func TestBuildAccountsForEveryNumber(t *testing.T) {
+	want := []Entry{
+		{Number: 1, FormattedNumber: "2081-82/MUN/D-00001", Date: "2082-01-01", Party: "राम बहादुर", Subject: "नागरिकता सिफारिस", Section: "प्रशासन", Status: "REGISTERED"},
+		{Number: 2, FormattedNumber: "2081-82/MUN/D-00002", Mark: MarkGap},
+		{Number: 3, FormattedNumber: "2081-82/MUN/D-00003", Date: "2082-01-02", Party: "सीता कुमारी", Subject: "जग्गा नामसारी", Section: "मालपोत", Status: "VOIDED", Mark: MarkVoid},
+		{Number: 4, FormattedNumber: "2081-82/MUN/D-00004", Mark: MarkGap},
+		{Number: 5, FormattedNumber: "2081-82/MUN/D-00005", Date: "2082-01-03", Party: "हरि प्रसाद", Subject: "घर नक्सा", Section: "प्राविधिक", Status: "CLOSED"},
+	}
+	r := testRegister()
+	if !reflect.DeepEqual(r.Entries, want) {
+		t.Errorf("entries =\n%+v\nwant\n%+v", r.Entries, want)
+	}
+	if entries, gaps, voids := r.Counts(); entries != 5 || gaps != 2 || voids != 1 {
+		t.Errorf("counts = %d, %d, %d; want 5, 2, 1", entries, gaps, voids)
+	}
+	if got := Build(nil, formatNumber); len(got) != 0 {
+		t.Errorf("empty register has %d entries", len(got))
+	}
+}
+
+func TestSealVerifies(t *testing.T) {
+	signer, err := NewSigner(bytes.Repeat([]byte{7}, ed25519.SeedSize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := testRegister()
+	seal := signer.Seal(r)
+	if r.Seal != seal {
+		t.Error("the seal was not stored on the register")
+	}
+
+	pub, err := base64.StdEncoding.DecodeString(seal.PublicKey)
+	if err != nil || len(pub) != ed25519.PublicKeySize {
+		t.Fatalf("public key %q: %v", seal.PublicKey, err)
+	}
+	sig, err := base64.StdEncoding.DecodeString(seal.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := r.Digest()
+	if seal.SHA256 != hex.EncodeToString(digest[:]) {
+		t.Errorf("sealed digest %s, want %x", seal.SHA256, digest)
+	}
+	if !ed25519.Verify(pub, digest[:], sig) {
+		t.Fatal("the seal does not verify")
+	}
+
+	// The same key and register seal the same way, whatever zone the
+	// generation time is held in
+	again := testRegister()
+	again.GeneratedAt = again.GeneratedAt.In(bsdate.Nepal)
+	if signer.Seal(again) != seal {
+		t.Error("sealing the same register again gave another seal")
+	}
+
+	tampered := map[string]func(r *Register){
+		"subject":      func(r *Register) { r.Entries[0].Subject = "नागरिकता प्रमाणपत्र" },
+		"gap filled":   func(r *Register) { r.Entries[1].Mark, r.Entries[1].Status = "", "REGISTERED" },
+		"void removed": func(r *Register) { r.Entries[2].Mark = "" },
+		"entry dropped": func(r *Register) {
+			r.Entries = append(r.Entries[:3:3], r.Entries[4:]...)
+		},
+		"generated by": func(r *Register) { r.GeneratedBy = "someone-else" },
+		"ward":         func(r *Register) { r.Scope, r.WardID = "WARD", "5" },
+	}
+	for name, tamper := range tampered {
+		t.Run(name, func(t *testing.T) {
+			r := testRegister()
+			tamper(r)
+			d := r.Digest()
+			if ed25519.Verify(pub, d[:], sig) {
+				t.Error("the seal verifies a tampered register")
+			}
+		})
+	}
+
+	flipped := append([]byte(nil), sig...)
+	flipped[0] ^= 1
+	if ed25519.Verify(pub, digest[:], flipped) {
+		t.Error("a tampered signature verifies")
+	}
+	other := digest
+	other[31] ^= 1
+	if ed25519.Verify(pub, other[:], sig) {
+		t.Error("the seal verifies a tampered digest")
+	}
+}
+
+func TestNewSigner(t *testing.T) {
+	for _, n := range []int{0, 16, 64} {
+		if _, err := NewSigner(make([]byte, n)); err == nil {
+			t.Errorf("NewSigner accepted a %d-byte seed", n)
+		}
+	}
+
+	a, err := NewEphemeralSigner()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewEphemeralSigner()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.keyID == b.keyID {
+		t.Error("two ephemeral signers share a key")
+	}
+}
@@ -0,0 +1,105 @@
+package kitab
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"git.ninjainfosys.com/ePalika/pkg/bsdate"
+)
+
+// Seal makes an exported register tamper evident. Anyone holding the
+// records can rebuild the register, recompute Digest and check the printed
+// signature against the public key.
+type Seal struct {
+	SHA256    string // Hex digest of the register's canonical form
+	Signature string // Base64 Ed25519 signature of the digest
+	KeyID     string
+	PublicKey string // Base64
+}
+
+// Digest returns the SHA-256 of the register's canonical form: a JSON
+// header naming the register and when and by whom it was generated, then a
+// JSON array per entry, each on its own line. Layout and format play no
+// part, so every format of one export carries the same digest.
+func (r *Register) Digest() [32]byte {
+	h := sha256.New()
+	header, _ := json.Marshal(map[string]string{
+		"book":         string(r.Book),
+		"fiscal_year":  r.FiscalYear.Code(),
+		"scope":        r.Scope,
+		"ward_id":      r.WardID,
+		"generated_at": r.GeneratedAt.UTC().Format(time.RFC3339Nano),
+		"generated_by": r.GeneratedBy,
+	})
+	h.Write(header)
+	h.Write([]byte{'\n'})
+	for _, e := range r.Entries {
+		line, _ := json.Marshal([]interface{}{e.Number, e.FormattedNumber, e.Date, e.Party, e.Subject, e.Section, e.Status, e.Mark})
+		h.Write(line)
+		h.Write([]byte{'\n'})
+	}
+	var sum [32]byte
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
+
+// Signer seals registers with an Ed25519 key
+type Signer struct {
+	key   ed25519.PrivateKey
+	keyID string
+}
+
+// NewSigner returns a signer for the given Ed25519 seed
+func NewSigner(seed []byte) (*Signer, error) {
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("register signing key must be a %d-byte Ed25519 seed", ed25519.SeedSize)
+	}
+	key := ed25519.NewKeyFromSeed(seed)
+	fingerprint := sha256.Sum256(key.Public().(ed25519.PublicKey))
+	return &Signer{key: key, keyID: hex.EncodeToString(fingerprint[:8])}, nil
+}
+
+// NewEphemeralSigner returns a signer with a key that lasts only as long as
+// the process. That is enough for development, but registers it seals
+// cannot be verified after a restart.
+func NewEphemeralSigner() (*Signer, error) {
+	seed := make([]byte, ed25519.SeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, fmt.Errorf("failed to generate register signing key: %w", err)
+	}
+	return NewSigner(seed)
+}
+
+// Seal digests and signs the register and stores the seal on it
+func (s *Signer) Seal(r *Register) Seal {
+	digest := r.Digest()
+	r.Seal = Seal{
+		SHA256:    hex.EncodeToString(digest[:]),
+		Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(s.key, digest[:])),
+		KeyID:     s.keyID,
+		PublicKey: base64.StdEncoding.EncodeToString(s.key.Public().(ed25519.PublicKey)),
+	}
+	return r.Seal
+}
+
+// Footer is the seal and provenance printed at the end of every format
+func (r *Register) Footer() []string {
+	generated := r.GeneratedAt.In(bsdate.Nepal)
+	date := generated.Format(time.DateOnly)
+	if d, err := bsdate.FromTime(generated); err == nil {
+		date = d.String()
+	}
+	entries, gaps, voids := r.Counts()
+	return []string{
+		fmt.Sprintf("जम्मा %d, रिक्त %d, रद्द %d", entries, gaps, voids),
+		fmt.Sprintf("तयार मिति %s %s, %s", date, generated.Format("15:04"), r.GeneratedBy),
+		"SHA-256: " + r.Seal.SHA256,
+		fmt.Sprintf("Ed25519 (%s): %s", r.Seal.KeyID, r.Seal.Signature),
+	}
+}
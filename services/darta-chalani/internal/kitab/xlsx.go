@@ -0,0 +1,141 @@
+package kitab
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Cell styles of the workbook's style sheet
+const (
+	xlsxPlain  = 0
+	xlsxBold   = 1
+	xlsxMarked = 2
+)
+
+// xlsxColumnWidths are the widths of the register's columns, in characters
+var xlsxColumnWidths = []int{22, 12, 28, 60, 16, 20, 20}
+
+// xlsxParts are the workbook's fixed parts. The sheet is written separately.
+var xlsxParts = []struct{ name, body string }{
+	{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>
+<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>
+</Types>`},
+	{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`},
+	{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+</Relationships>`},
+	{"xl/styles.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<fonts count="3"><font><sz val="11"/><name val="Noto Sans Devanagari"/></font><font><b/><sz val="11"/><name val="Noto Sans Devanagari"/></font><font><i/><sz val="11"/><color rgb="FF7F7F7F"/><name val="Noto Sans Devanagari"/></font></fonts>
+<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>
+<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>
+<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>
+<cellXfs count="3"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/><xf numFmtId="0" fontId="2" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>
+</styleSheet>`},
+}
+
+// WriteXLSX writes the register as an Excel workbook with one sheet: the
+// title, the column headings, one row per entry with gaps and voids set in
+// grey italics, then the footer
+func WriteXLSX(w io.Writer, r *Register) error {
+	zw := zip.NewWriter(w)
+	for _, part := range xlsxParts {
+		f, err := zw.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, part.body); err != nil {
+			return err
+		}
+	}
+
+	f, err := zw.Create("xl/workbook.xml")
+	if err != nil {
+		return err
+	}
+	sheetName := "दर्ता किताब"
+	if r.Book == BookChalani {
+		sheetName = "चलानी किताब"
+	}
+	fmt.Fprintf(f, `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>
+</workbook>`, xmlEscape(sheetName))
+
+	f, err = zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	sheet := &xlsxSheet{}
+	sheet.row(xlsxBold, r.Title())
+	sheet.row(xlsxPlain, r.Subtitle())
+	sheet.row(xlsxPlain)
+	sheet.row(xlsxBold, r.Columns()...)
+	for _, e := range r.Entries {
+		style := xlsxPlain
+		if e.Mark != "" {
+			style = xlsxMarked
+		}
+		sheet.row(style, e.Cells()...)
+	}
+	sheet.row(xlsxPlain)
+	for _, line := range r.Footer() {
+		sheet.row(xlsxPlain, line)
+	}
+
+	var cols strings.Builder
+	for i, width := range xlsxColumnWidths {
+		fmt.Fprintf(&cols, `<col min="%d" max="%d" width="%d" customWidth="1"/>`, i+1, i+1, width)
+	}
+	fmt.Fprintf(f, `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<sheetViews><sheetView workbookViewId="0"><pane ySplit="4" topLeftCell="A5" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>
+<cols>%s</cols>
+<sheetData>%s</sheetData>
+</worksheet>`, cols.String(), sheet.String())
+
+	return zw.Close()
+}
+
+// xlsxSheet accumulates the rows of a worksheet as inline strings
+type xlsxSheet struct {
+	strings.Builder
+	rows int
+}
+
+func (s *xlsxSheet) row(style int, values ...string) {
+	s.rows++
+	fmt.Fprintf(s, `<row r="%d">`, s.rows)
+	for i, v := range values {
+		fmt.Fprintf(s, `<c r="%s%d" t="inlineStr" s="%d"><is><t xml:space="preserve">%s</t></is></c>`, xlsxColumn(i), s.rows, style, xmlEscape(v))
+	}
+	s.WriteString("</row>")
+}
+
+// xlsxColumn returns the letters of the zero-based column i
+func xlsxColumn(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,61 @@
+// Package metadata applies changes to the free-form metadata of dartas and
+// chalanis and checks it against the JSON Schemas tenants define for it.
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MergePatch applies an RFC 7396 JSON merge patch to target and returns the
+// result. Object members of the patch are merged into target recursively, a
+// null member removes the key, and any other patch value replaces the
+// target outright. An empty target is treated as null.
+func MergePatch(target, patch json.RawMessage) (json.RawMessage, error) {
+	p, err := decode(patch)
+	if err != nil {
+		return nil, fmt.Errorf("invalid merge patch: %w", err)
+	}
+	var t interface{}
+	if len(bytes.TrimSpace(target)) > 0 {
+		if t, err = decode(target); err != nil {
+			return nil, fmt.Errorf("invalid merge target: %w", err)
+		}
+	}
+	return json.Marshal(mergeValue(t, p))
+}
+
+func mergeValue(target, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObj, ok := target.(map[string]interface{})
+	if !ok {
+		targetObj = make(map[string]interface{}, len(patchObj))
+	}
+	for key, value := range patchObj {
+		if value == nil {
+			delete(targetObj, key)
+			continue
+		}
+		targetObj[key] = mergeValue(targetObj[key], value)
+	}
+	return targetObj
+}
+
+// decode parses a JSON document keeping numbers as written, so merging
+// never rounds large integers through float64
+func decode(doc json.RawMessage) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("unexpected data after top-level value")
+	}
+	return v, nil
+}
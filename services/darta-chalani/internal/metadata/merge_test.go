@@ -0,0 +1,129 @@
+package metadata
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		patch  string
+		want   string
+	}{
+		{
+			name:   "adds and replaces members",
+			target: `{"ward":5,"office":"राजस्व"}`,
+			patch:  `{"ward":6,"file":"F-12"}`,
+			want:   `{"file":"F-12","office":"राजस्व","ward":6}`,
+		},
+		{
+			name:   "null removes a member",
+			target: `{"ward":5,"office":"राजस्व"}`,
+			patch:  `{"office":null}`,
+			want:   `{"ward":5}`,
+		},
+		{
+			name:   "null for a missing member",
+			target: `{"ward":5}`,
+			patch:  `{"office":null}`,
+			want:   `{"ward":5}`,
+		},
+		{
+			name:   "merges nested objects",
+			target: `{"scan":{"pages":3,"dpi":300,"by":"clerk"},"ward":5}`,
+			patch:  `{"scan":{"pages":4,"by":null,"color":true}}`,
+			want:   `{"scan":{"color":true,"dpi":300,"pages":4},"ward":5}`,
+		},
+		{
+			name:   "object replaces a scalar",
+			target: `{"scan":"pending"}`,
+			patch:  `{"scan":{"pages":3}}`,
+			want:   `{"scan":{"pages":3}}`,
+		},
+		{
+			name:   "nulls inside a new object are dropped",
+			target: `{}`,
+			patch:  `{"scan":{"pages":3,"by":null}}`,
+			want:   `{"scan":{"pages":3}}`,
+		},
+		{
+			name:   "replaces arrays whole",
+			target: `{"tags":["a","b","c"],"refs":[{"id":1,"kind":"x"}]}`,
+			patch:  `{"tags":["d"],"refs":[{"id":2}]}`,
+			want:   `{"refs":[{"id":2}],"tags":["d"]}`,
+		},
+		{
+			name:   "non-object patch replaces the document",
+			target: `{"ward":5}`,
+			patch:  `["a","b"]`,
+			want:   `["a","b"]`,
+		},
+		{
+			name:   "scalar patch replaces the document",
+			target: `{"ward":5}`,
+			patch:  `"note"`,
+			want:   `"note"`,
+		},
+		{
+			name:   "null patch clears the document",
+			target: `{"ward":5}`,
+			patch:  `null`,
+			want:   `null`,
+		},
+		{
+			name:   "object patch over a non-object target",
+			target: `[1,2]`,
+			patch:  `{"ward":5}`,
+			want:   `{"ward":5}`,
+		},
+		{
+			name:   "empty target",
+			target: ``,
+			patch:  `{"ward":5,"office":null}`,
+			want:   `{"ward":5}`,
+		},
+		{
+			name:   "empty patch object",
+			target: `{"ward":5}`,
+			patch:  `{}`,
+			want:   `{"ward":5}`,
+		},
+		{
+			name:   "keeps large integers exact",
+			target: `{"receipt":9007199254740993}`,
+			patch:  `{"amount":12345678901234567890}`,
+			want:   `{"amount":12345678901234567890,"receipt":9007199254740993}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MergePatch(json.RawMessage(tt.target), json.RawMessage(tt.patch))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MergePatch(%s, %s) = %s, want %s", tt.target, tt.patch, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergePatchRejectsInvalidJSON(t *testing.T) {
+	tests := []struct {
+		name, target, patch string
+	}{
+		{"empty patch", `{}`, ``},
+		{"malformed patch", `{}`, `{"ward":`},
+		{"trailing data in patch", `{}`, `{"ward":5} {"ward":6}`},
+		{"malformed target", `{"ward":`, `{"ward":5}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := MergePatch(json.RawMessage(tt.target), json.RawMessage(tt.patch)); err == nil {
+				t.Errorf("MergePatch(%q, %q) = %s, want an error", tt.target, tt.patch, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// schemaURL is the location schemas are compiled under. Nothing is ever
+// loaded from it; references outside the schema itself are refused.
+const schemaURL = "urn:epalika:metadata-schema"
+
+// Violation is the first place a document fails its schema. Path is the
+// dotted location of the offending value, empty for the document itself.
+type Violation struct {
+	Path    string
+	Message string
+}
+
+func (v *Violation) Error() string {
+	if v.Path == "" {
+		return v.Message
+	}
+	return fmt.Sprintf("%s: %s", v.Path, v.Message)
+}
+
+// Schema is a compiled tenant metadata schema
+type Schema struct {
+	schema *jsonschema.Schema
+}
+
+// CompileSchema compiles a JSON Schema document. Schemas must be
+// self-contained: a $ref to any other document fails to compile.
+func CompileSchema(doc json.RawMessage) (*Schema, error) {
+	c := jsonschema.NewCompiler()
+	c.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("external schema references are not allowed: %s", url)
+	}
+	if err := c.AddResource(schemaURL, bytes.NewReader(doc)); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	schema, err := c.Compile(schemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	return &Schema{schema: schema}, nil
+}
+
+// Validate checks a metadata document against the schema, returning a
+// *Violation when it does not conform
+func (s *Schema) Validate(doc json.RawMessage) error {
+	v, err := decode(doc)
+	if err != nil {
+		return &Violation{Message: "metadata is not valid JSON"}
+	}
+	err = s.schema.Validate(v)
+	var verr *jsonschema.ValidationError
+	if errors.As(err, &verr) {
+		leaf := verr
+		for len(leaf.Causes) > 0 {
+			leaf = leaf.Causes[0]
+		}
+		return &Violation{Path: dottedPath(leaf.InstanceLocation), Message: leaf.Message}
+	}
+	return err
+}
+
+// dottedPath turns a JSON pointer such as /address/ward into address.ward
+func dottedPath(pointer string) string {
+	if pointer == "" {
+		return ""
+	}
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+	return strings.Join(tokens, ".")
+}
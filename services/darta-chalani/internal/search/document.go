@@ -0,0 +1,131 @@
+// Package search maintains the search documents of dartas and chalanis and
+// turns free-text queries into lookups against them.
+//
+// Text is indexed as phonetic keys (see package nepali), so a query typed in
+// Devanagari or in romanized Nepali matches records written in either
+// script. Display text is stored as written, for results and snippets.
+package search
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"git.ninjainfosys.com/ePalika/pkg/nepali"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+)
+
+// Entity types of search documents
+const (
+	EntityDarta   = "DARTA"
+	EntityChalani = "CHALANI"
+)
+
+// maxContentBytes bounds the body and OCR text kept for snippets. Terms are
+// still built from the whole text.
+const maxContentBytes = 64 << 10
+
+// dartaDocument builds the search document of a darta
+func dartaDocument(row db.ListStaleDartaSearchSourcesRow) db.UpsertSearchDocumentParams {
+	party := partyName(row.FullName, row.Organization)
+	number := deref(row.FormattedDartaNumber)
+	numberText := joinText(number, intText(row.DartaNumber), deref(row.ClassificationCode))
+	content := joinText(row.OcrText, row.AttachmentText)
+
+	return db.UpsertSearchDocumentParams{
+		EntityType:      EntityDarta,
+		EntityID:        pgtype.UUID{Bytes: row.ID, Valid: true},
+		TenantID:        row.TenantID,
+		Title:           row.Subject,
+		Number:          row.FormattedDartaNumber,
+		Party:           party,
+		Content:         truncate(content, maxContentBytes),
+		Status:          row.Status,
+		FiscalYearID:    row.FiscalYearID,
+		WardID:          row.WardID,
+		Channel:         &row.IntakeChannel,
+		NumberTerms:     terms(numberText),
+		TitleTerms:      terms(joinText(row.Subject, party)),
+		BodyTerms:       terms(content),
+		FoldKey:         terms(joinText(row.Subject, party, numberText)),
+		SourceUpdatedAt: row.SourceUpdatedAt,
+	}
+}
+
+// chalaniDocument builds the search document of a chalani
+func chalaniDocument(row db.ListStaleChalaniSearchSourcesRow) db.UpsertSearchDocumentParams {
+	party := partyName(row.Name, row.Organization)
+	number := deref(row.FormattedChalaniNumber)
+	numberText := joinText(number, intText(row.ChalaniNumber))
+	content := joinText(row.Body, row.OcrText, row.AttachmentText)
+
+	return db.UpsertSearchDocumentParams{
+		EntityType:      EntityChalani,
+		EntityID:        pgtype.UUID{Bytes: row.ID, Valid: true},
+		TenantID:        row.TenantID,
+		Title:           row.Subject,
+		Number:          row.FormattedChalaniNumber,
+		Party:           party,
+		Content:         truncate(content, maxContentBytes),
+		Status:          row.Status,
+		FiscalYearID:    row.FiscalYearID,
+		WardID:          row.WardID,
+		Channel:         row.DispatchChannel,
+		NumberTerms:     terms(numberText),
+		TitleTerms:      terms(joinText(row.Subject, party)),
+		BodyTerms:       terms(content),
+		FoldKey:         terms(joinText(row.Subject, party, numberText)),
+		SourceUpdatedAt: row.SourceUpdatedAt,
+	}
+}
+
+// terms returns the phonetic keys of text as space-separated ASCII words,
+// ready for to_tsvector('simple', ...)
+func terms(text string) string {
+	return strings.Join(nepali.Keys(text), " ")
+}
+
+// partyName is an applicant's or recipient's name with their organization
+func partyName(name string, organization *string) string {
+	if organization == nil || *organization == "" || *organization == name {
+		return name
+	}
+	return name + ", " + *organization
+}
+
+func joinText(parts ...string) string {
+	kept := parts[:0:0]
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			kept = append(kept, p)
+		}
+	}
+	return strings.Join(kept, "\n")
+}
+
+func intText(n *int32) string {
+	if n == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*n))
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// truncate cuts s to at most n bytes without splitting a character
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,96 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+)
+
+// syncBatchSize is how many stale documents are rebuilt per query
+const syncBatchSize = 200
+
+// Indexer keeps search_documents in step with dartas and chalanis. It
+// rebuilds stale documents when notified of a mutation and on a timer, so
+// changes made by other replicas or missed notifications are picked up
+// too.
+type Indexer struct {
+	queries  db.Querier
+	interval time.Duration
+	kick     chan struct{}
+}
+
+// NewIndexer creates an Indexer that syncs at least every interval
+func NewIndexer(queries db.Querier, interval time.Duration) *Indexer {
+	return &Indexer{
+		queries:  queries,
+		interval: interval,
+		kick:     make(chan struct{}, 1),
+	}
+}
+
+// Notify asks the indexer to sync soon. It never blocks.
+func (ix *Indexer) Notify() {
+	select {
+	case ix.kick <- struct{}{}:
+	default:
+	}
+}
+
+// Run syncs until ctx is done
+func (ix *Indexer) Run(ctx context.Context) {
+	ticker := time.NewTicker(ix.interval)
+	defer ticker.Stop()
+
+	for {
+		if n, err := ix.Sync(ctx); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("search index sync failed: %v", err)
+		} else if n > 0 {
+			log.Printf("search index: %d documents updated", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-ix.kick:
+		}
+	}
+}
+
+// Sync rebuilds every stale document and returns how many it wrote
+func (ix *Indexer) Sync(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		dartas, err := ix.queries.ListStaleDartaSearchSources(ctx, syncBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("failed to list stale darta documents: %w", err)
+		}
+		for _, row := range dartas {
+			if err := ix.queries.UpsertSearchDocument(ctx, dartaDocument(row)); err != nil {
+				return total, fmt.Errorf("failed to index darta %s: %w", row.ID, err)
+			}
+			total++
+		}
+
+		chalanis, err := ix.queries.ListStaleChalaniSearchSources(ctx, syncBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("failed to list stale chalani documents: %w", err)
+		}
+		for _, row := range chalanis {
+			if err := ix.queries.UpsertSearchDocument(ctx, chalaniDocument(row)); err != nil {
+				return total, fmt.Errorf("failed to index chalani %s: %w", row.ID, err)
+			}
+			total++
+		}
+
+		if len(dartas) < syncBatchSize && len(chalanis) < syncBatchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package search
+
+import (
+	"strings"
+
+	"git.ninjainfosys.com/ePalika/pkg/nepali"
+)
+
+// maxQueryWords bounds the words of a query that are searched for
+const maxQueryWords = 16
+
+// snippetWords is how many words of content a snippet shows, and
+// snippetLead how many of them come before the first match
+const (
+	snippetWords = 24
+	snippetLead  = 8
+)
+
+// Query is a parsed free-text query
+type Query struct {
+	// TSQuery requires every word, matching any of its phonetic keys as a
+	// prefix
+	TSQuery string
+	// FoldKey is the query folded like SearchDocument.fold_key, for fuzzy
+	// trigram matching
+	FoldKey string
+
+	words [][]string
+}
+
+// ParseQuery parses text. ok is false when text has no searchable words.
+func ParseQuery(text string) (q Query, ok bool) {
+	words := nepali.Words(text)
+	if len(words) > maxQueryWords {
+		words = words[:maxQueryWords]
+	}
+
+	var clauses, folded []string
+	for _, w := range words {
+		keys := nepali.Keys(w)
+		if len(keys) == 0 {
+			continue
+		}
+		alternatives := make([]string, len(keys))
+		for i, k := range keys {
+			// keys are ASCII letters and digits, so quoting is enough
+			alternatives[i] = "'" + k + "'"
+			if len(k) > 1 {
+				alternatives[i] += ":*"
+			}
+		}
+		clauses = append(clauses, "("+strings.Join(alternatives, " | ")+")")
+		folded = append(folded, keys[0])
+		q.words = append(q.words, keys)
+	}
+	if len(clauses) == 0 {
+		return Query{}, false
+	}
+
+	q.TSQuery = strings.Join(clauses, " & ")
+	q.FoldKey = strings.Join(folded, " ")
+	return q, true
+}
+
+// matches reports whether a word of a document matches any query word, the
+// same way the tsquery does
+func (q Query) matches(word string) bool {
+	for _, dk := range nepali.Keys(word) {
+		for _, keys := range q.words {
+			for _, k := range keys {
+				if dk == k || len(k) > 1 && strings.HasPrefix(dk, k) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Field is a named piece of a document's display text
+type Field struct {
+	Name string
+	Text string
+}
+
+// Range is a highlighted part of a snippet, in characters
+type Range struct {
+	Start, End int
+}
+
+// Snippet returns the text to show for a result: a window of the first
+// field that contains a match, with the matching words highlighted. Fields
+// are tried in order. A result found only by fuzzy matching shows the first
+// field whole, without highlights.
+func (q Query) Snippet(fields ...Field) (field, text string, highlights []Range) {
+	for _, f := range fields {
+		runes := []rune(f.Text)
+		spans := nepali.WordSpans(f.Text)
+
+		var matched []int
+		for i, sp := range spans {
+			if q.matches(string(runes[sp.Start:sp.End])) {
+				matched = append(matched, i)
+			}
+		}
+		if len(matched) == 0 {
+			continue
+		}
+
+		first, last := 0, len(spans)
+		if len(spans) > snippetWords {
+			first = max(0, matched[0]-snippetLead)
+			last = min(len(spans), first+snippetWords)
+			first = max(0, last-snippetWords)
+		}
+
+		start, end := spans[first].Start, spans[last-1].End
+		if first == 0 {
+			start = 0
+		}
+		if last == len(spans) {
+			end = len(runes)
+		}
+
+		var b strings.Builder
+		offset := -start
+		if start > 0 {
+			b.WriteString("…")
+			offset++
+		}
+		b.WriteString(string(runes[start:end]))
+		if end < len(runes) {
+			b.WriteString("…")
+		}
+
+		for _, i := range matched {
+			if i >= first && i < last {
+				highlights = append(highlights, Range{spans[i].Start + offset, spans[i].End + offset})
+			}
+		}
+		return f.Name, b.String(), highlights
+	}
+
+	if len(fields) == 0 {
+		return "", "", nil
+	}
+	return fields[0].Name, fields[0].Text, nil
+}
@@ -0,0 +1,163 @@
+package sla
+
+import (
+	"time"
+
+	"git.ninjainfosys.com/ePalika/pkg/bsdate"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+)
+
+// Default working week and office hours of tenants without a calendar
+const (
+	defaultOfficeOpen  = 10 * time.Hour
+	defaultOfficeClose = 17 * time.Hour
+)
+
+// maxCalendarDays bounds how far calendar arithmetic walks, so a calendar
+// with no working days cannot loop forever
+const maxCalendarDays = 3660
+
+type bsMonthDay struct {
+	month bsdate.Month
+	day   int
+}
+
+// nationalHolidays are the public holidays fixed to a BS date. Festivals
+// that follow the lunar calendar, such as Dashain and Tihar, move every year
+// and are added per tenant as calendar holidays.
+var nationalHolidays = map[bsMonthDay]string{
+	{bsdate.Baisakh, 1}: "नयाँ वर्ष",
+	{bsdate.Jestha, 15}: "गणतन्त्र दिवस",
+	{bsdate.Ashwin, 3}:  "संविधान दिवस",
+	{bsdate.Poush, 27}:  "पृथ्वी जयन्ती",
+	{bsdate.Magh, 16}:   "शहीद दिवस",
+	{bsdate.Falgun, 7}:  "प्रजातन्त्र दिवस",
+}
+
+// Calendar is a tenant's business calendar: the working week, office hours
+// and holidays, all in Nepal time
+type Calendar struct {
+	weeklyOff   [7]bool
+	open, close time.Duration // since midnight
+	holidays    map[string]string
+}
+
+// DefaultCalendar has Saturday off, office hours of 10:00 to 17:00 and only
+// the national holidays
+func DefaultCalendar() *Calendar {
+	c := &Calendar{open: defaultOfficeOpen, close: defaultOfficeClose, holidays: map[string]string{}}
+	c.weeklyOff[time.Saturday] = true
+	return c
+}
+
+// NewCalendar builds a tenant's calendar from its stored settings. A nil
+// row means the defaults.
+func NewCalendar(row *db.BusinessCalendar, holidays []db.CalendarHoliday) *Calendar {
+	c := DefaultCalendar()
+	if row != nil {
+		c.weeklyOff = [7]bool{}
+		for _, d := range row.WeeklyOff {
+			if d >= 0 && d < 7 {
+				c.weeklyOff[d] = true
+			}
+		}
+		c.open = time.Duration(row.OfficeStart.Microseconds) * time.Microsecond
+		c.close = time.Duration(row.OfficeEnd.Microseconds) * time.Microsecond
+	}
+	for _, h := range holidays {
+		c.holidays[h.HolidayDate.Time.Format(time.DateOnly)] = h.Name
+	}
+	return c
+}
+
+// Holiday returns the name of the holiday on t's day in Nepal, if any
+func (c *Calendar) Holiday(t time.Time) (string, bool) {
+	t = t.In(bsdate.Nepal)
+	if name, ok := c.holidays[t.Format(time.DateOnly)]; ok {
+		return name, true
+	}
+	d, err := bsdate.FromTime(t)
+	if err != nil {
+		return "", false
+	}
+	name, ok := nationalHolidays[bsMonthDay{d.Month, d.Day}]
+	return name, ok
+}
+
+// window returns the office hours of day, or ok false when it is not a
+// working day
+func (c *Calendar) window(day time.Time) (open, close time.Time, ok bool) {
+	if c.weeklyOff[day.Weekday()] {
+		return time.Time{}, time.Time{}, false
+	}
+	if _, holiday := c.Holiday(day); holiday {
+		return time.Time{}, time.Time{}, false
+	}
+	midnight := startOfDay(day)
+	return midnight.Add(c.open), midnight.Add(c.close), true
+}
+
+// Add returns the instant minutes business minutes after from. Time outside
+// office hours does not count, so a deadline always falls within them.
+func (c *Calendar) Add(from time.Time, minutes int) time.Time {
+	t := from.In(bsdate.Nepal)
+	if minutes <= 0 {
+		return t
+	}
+	left := time.Duration(minutes) * time.Minute
+
+	for i := 0; i < maxCalendarDays; i++ {
+		if open, close, ok := c.window(t); ok {
+			if t.Before(open) {
+				t = open
+			}
+			if t.Before(close) {
+				avail := close.Sub(t)
+				if left <= avail {
+					return t.Add(left)
+				}
+				left -= avail
+			}
+		}
+		t = startOfDay(t).AddDate(0, 0, 1)
+	}
+	return t
+}
+
+// Between returns the business minutes from from to to
+func (c *Calendar) Between(from, to time.Time) int {
+	if !to.After(from) {
+		return 0
+	}
+	var total time.Duration
+	day := startOfDay(from.In(bsdate.Nepal))
+	for i := 0; i < maxCalendarDays && day.Before(to); i++ {
+		if open, close, ok := c.window(day); ok {
+			start, end := later(open, from), earlier(close, to)
+			if end.After(start) {
+				total += end.Sub(start)
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return int(total / time.Minute)
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.In(bsdate.Nepal).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, bsdate.Nepal)
+}
+
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earlier(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
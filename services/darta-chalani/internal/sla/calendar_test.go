@@ -0,0 +1,146 @@
+package sla
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"git.ninjainfosys.com/ePalika/pkg/bsdate"
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+)
+
+// at returns a time in Nepal on the given AD date
+func at(day string, hour, minute int) time.Time {
+	d, err := time.ParseInLocation(time.DateOnly, day, bsdate.Nepal)
+	if err != nil {
+		panic(err)
+	}
+	return d.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+}
+
+func TestCalendarHoliday(t *testing.T) {
+	cal := NewCalendar(nil, []db.CalendarHoliday{{
+		HolidayDate: pgtype.Date{Time: time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC), Valid: true},
+		Name:        "विजया दशमी",
+	}})
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{"national, Baisakh 1", at("2025-04-14", 12, 0), "नयाँ वर्ष"},
+		{"national, in UTC the evening before", time.Date(2025, 4, 13, 19, 0, 0, 0, time.UTC), "नयाँ वर्ष"},
+		{"tenant holiday", at("2025-10-02", 9, 0), "विजया दशमी"},
+		{"working day", at("2025-04-15", 12, 0), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, ok := cal.Holiday(tt.t)
+			if ok != (tt.want != "") || name != tt.want {
+				t.Errorf("Holiday = %q, %v; want %q", name, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendarAdd(t *testing.T) {
+	cal := DefaultCalendar()
+
+	tests := []struct {
+		name    string
+		from    time.Time
+		minutes int
+		want    time.Time
+	}{
+		{"within office hours", at("2025-04-15", 11, 0), 90, at("2025-04-15", 12, 30)},
+		{"before opening", at("2025-04-15", 7, 0), 60, at("2025-04-15", 11, 0)},
+		{"after closing", at("2025-04-15", 18, 0), 60, at("2025-04-16", 11, 0)},
+		{"ending at closing", at("2025-04-15", 16, 0), 60, at("2025-04-15", 17, 0)},
+		{"over Saturday", at("2025-04-18", 16, 0), 120, at("2025-04-20", 11, 0)},
+		{"from Saturday", at("2025-04-19", 12, 0), 30, at("2025-04-20", 10, 30)},
+		{"over Baisakh 1", at("2025-04-13", 16, 30), 60, at("2025-04-15", 10, 30)},
+		{"several days", at("2025-04-15", 10, 0), 3 * businessDay, at("2025-04-17", 17, 0)},
+		{"nothing", at("2025-04-19", 12, 0), 0, at("2025-04-19", 12, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cal.Add(tt.from, tt.minutes); !got.Equal(tt.want) {
+				t.Errorf("Add = %v, want %v", got, tt.want)
+			}
+			if tt.minutes > 0 {
+				if got := cal.Between(tt.from, tt.want); got != tt.minutes {
+					t.Errorf("Between = %d, want %d", got, tt.minutes)
+				}
+			}
+		})
+	}
+}
+
+func TestCalendarTenantSettings(t *testing.T) {
+	// A tenant working Sunday to Thursday, 09:00 to 16:00
+	cal := NewCalendar(&db.BusinessCalendar{
+		WeeklyOff:   []int16{int16(time.Friday), int16(time.Saturday)},
+		OfficeStart: pgtype.Time{Microseconds: int64(9 * time.Hour / time.Microsecond), Valid: true},
+		OfficeEnd:   pgtype.Time{Microseconds: int64(16 * time.Hour / time.Microsecond), Valid: true},
+	}, nil)
+
+	if got, want := cal.Add(at("2025-04-17", 15, 0), 120), at("2025-04-20", 10, 0); !got.Equal(want) {
+		t.Errorf("Add over Friday and Saturday = %v, want %v", got, want)
+	}
+	if got := cal.Between(at("2025-04-18", 0, 0), at("2025-04-20", 0, 0)); got != 0 {
+		t.Errorf("Between over the weekend = %d, want 0", got)
+	}
+}
+
+func TestResponseClockPausesForClarification(t *testing.T) {
+	cal := DefaultCalendar()
+	if got := dartaStages[StageResponse]["NEEDS_CLARIFICATION"]; got != paused {
+		t.Fatalf("response clock in NEEDS_CLARIFICATION is %v, want paused", got)
+	}
+	if got := dartaStages[StageClarification]["NEEDS_CLARIFICATION"]; got != running {
+		t.Fatalf("clarification clock in NEEDS_CLARIFICATION is %v, want running", got)
+	}
+
+	target := Target{Minutes: businessDay}
+	started := at("2025-04-15", 10, 0)
+	clock := db.SlaClock{
+		TargetMinutes: target.Minutes,
+		StartedAt:     timestamptz(started),
+		ResumedAt:     timestamptz(started),
+		Deadline:      timestamptz(cal.Add(started, businessDay)),
+	}
+
+	// Asked for clarification after three business hours
+	asked := at("2025-04-15", 13, 0)
+	p, ok := advance(cal, clock, paused, asked, target)
+	if !ok {
+		t.Fatal("clock did not pause")
+	}
+	if p.ElapsedMinutes != 180 {
+		t.Errorf("elapsed = %d, want 180", p.ElapsedMinutes)
+	}
+	if p.ResumedAt.Valid || !p.PausedAt.Time.Equal(asked) {
+		t.Errorf("resumed %v, paused %v; want paused at %v", p.ResumedAt, p.PausedAt, asked)
+	}
+	if p.Deadline.Valid || p.EscalateAt.Valid {
+		t.Error("a paused clock kept its deadline")
+	}
+
+	// Clarified on the following Sunday, after a Saturday off; the four
+	// hours left run from then
+	clock.ElapsedMinutes, clock.ResumedAt, clock.PausedAt = p.ElapsedMinutes, p.ResumedAt, p.PausedAt
+	clock.Deadline, clock.EscalateAt = p.Deadline, p.EscalateAt
+	clarified := at("2025-04-20", 15, 0)
+	p, ok = advance(cal, clock, running, clarified, target)
+	if !ok {
+		t.Fatal("clock did not resume")
+	}
+	if p.ElapsedMinutes != 180 || !p.ResumedAt.Time.Equal(clarified) || p.PausedAt.Valid {
+		t.Errorf("elapsed %d, resumed %v, paused %v; want 180, %v, none", p.ElapsedMinutes, p.ResumedAt.Time, p.PausedAt, clarified)
+	}
+	if want := at("2025-04-21", 12, 0); !p.Deadline.Time.Equal(want) {
+		t.Errorf("deadline = %v, want %v", p.Deadline.Time, want)
+	}
+}
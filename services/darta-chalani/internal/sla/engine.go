@@ -0,0 +1,421 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+)
+
+// batchSize is how many records or clocks are handled per query
+const batchSize = 200
+
+// holidayWindow is how far either side of now a calendar's holidays are
+// loaded
+const holidayWindow = 366 * 24 * time.Hour
+
+// Kinds of alerts the evaluator raises
+const (
+	AlertBreached  = "SLA_BREACHED"
+	AlertEscalated = "SLA_ESCALATED"
+)
+
+// Alert reports a clock that breached or was escalated
+type Alert struct {
+	Kind  string
+	Clock db.SlaClock
+}
+
+// Engine keeps SLA clocks in step with the status of dartas and chalanis
+// and evaluates them against their deadlines. Like the search indexer it
+// reconciles records that changed since it last saw them, when notified of
+// a mutation and on a timer, so every replica can run one.
+type Engine struct {
+	queries  db.Querier
+	interval time.Duration
+	alert    func(context.Context, Alert)
+	kick     chan struct{}
+}
+
+// NewEngine creates an Engine that evaluates at least every interval and
+// passes breaches and escalations to alert
+func NewEngine(queries db.Querier, interval time.Duration, alert func(context.Context, Alert)) *Engine {
+	return &Engine{
+		queries:  queries,
+		interval: interval,
+		alert:    alert,
+		kick:     make(chan struct{}, 1),
+	}
+}
+
+// Notify asks the engine to reconcile soon. It never blocks.
+func (e *Engine) Notify() {
+	select {
+	case e.kick <- struct{}{}:
+	default:
+	}
+}
+
+// Run reconciles and evaluates until ctx is done
+func (e *Engine) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.Sync(ctx); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("SLA reconcile failed: %v", err)
+		}
+		if n, err := e.Evaluate(ctx, time.Now()); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("SLA evaluation failed: %v", err)
+		} else if n > 0 {
+			log.Printf("SLA: %d breaches and escalations raised", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-e.kick:
+		}
+	}
+}
+
+// Sync reconciles the clocks of every record changed since it was last
+// reconciled. The change is taken to have happened at the record's
+// updated_at.
+func (e *Engine) Sync(ctx context.Context) error {
+	calendars := map[string]*Calendar{}
+	for {
+		dartas, err := e.queries.ListUnsyncedDartaSLASources(ctx, batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list changed dartas: %w", err)
+		}
+		for _, d := range dartas {
+			if err := e.reconcileDarta(ctx, calendars, d); err != nil {
+				return fmt.Errorf("failed to reconcile darta %s: %w", d.ID, err)
+			}
+		}
+
+		chalanis, err := e.queries.ListUnsyncedChalaniSLASources(ctx, batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list changed chalanis: %w", err)
+		}
+		for _, c := range chalanis {
+			if err := e.reconcileChalani(ctx, calendars, c); err != nil {
+				return fmt.Errorf("failed to reconcile chalani %s: %w", c.ID, err)
+			}
+		}
+
+		if len(dartas) < batchSize && len(chalanis) < batchSize {
+			return nil
+		}
+	}
+}
+
+func (e *Engine) reconcileDarta(ctx context.Context, calendars map[string]*Calendar, d db.ListUnsyncedDartaSLASourcesRow) error {
+	cal, err := e.calendar(ctx, calendars, d.TenantID)
+	if err != nil {
+		return err
+	}
+
+	priority := d.Priority
+	target := func(stage string) (Target, error) {
+		t, err := resolveTarget(ctx, e.queries, d.TenantID, stage, &priority, d.ClassificationCode)
+		// A target set when routing replaces the policy's response target
+		if err == nil && stage == StageResponse && d.SlaTargetMinutes != nil && *d.SlaTargetMinutes > 0 {
+			t.Minutes = *d.SlaTargetMinutes
+		}
+		return t, err
+	}
+
+	if err := e.reconcile(ctx, cal, d.TenantID, EntityDarta, d.ID, dartaStages, d.Status, d.UpdatedAt.Time, target); err != nil {
+		return err
+	}
+	return e.queries.RefreshDartaSLAState(ctx, db.RefreshDartaSLAStateParams{ID: d.ID, SyncedAt: d.UpdatedAt})
+}
+
+func (e *Engine) reconcileChalani(ctx context.Context, calendars map[string]*Calendar, c db.ListUnsyncedChalaniSLASourcesRow) error {
+	cal, err := e.calendar(ctx, calendars, c.TenantID)
+	if err != nil {
+		return err
+	}
+
+	target := func(stage string) (Target, error) {
+		return resolveTarget(ctx, e.queries, c.TenantID, stage, nil, nil)
+	}
+
+	if err := e.reconcile(ctx, cal, c.TenantID, EntityChalani, c.ID, chalaniStages, c.Status, c.UpdatedAt.Time, target); err != nil {
+		return err
+	}
+	return e.queries.MarkChalaniSLASynced(ctx, db.MarkChalaniSLASyncedParams{ID: c.ID, SlaSyncedAt: c.UpdatedAt})
+}
+
+// reconcile starts, pauses, resumes, retargets and stops a record's clocks
+// to match its status as of at
+func (e *Engine) reconcile(
+	ctx context.Context,
+	cal *Calendar,
+	tenantID, entityType string,
+	id uuid.UUID,
+	stages map[string]map[string]clockState,
+	status string,
+	at time.Time,
+	target func(stage string) (Target, error),
+) error {
+	entityID := pgtype.UUID{Bytes: id, Valid: true}
+	open, err := e.queries.ListOpenSLAClocks(ctx, db.ListOpenSLAClocksParams{EntityType: entityType, EntityID: entityID})
+	if err != nil {
+		return fmt.Errorf("failed to list open clocks: %w", err)
+	}
+	byStage := make(map[string]db.SlaClock, len(open))
+	for _, c := range open {
+		byStage[c.Stage] = c
+	}
+
+	for stage, states := range stages {
+		want := states[status]
+		clock, exists := byStage[stage]
+		if !exists && want != running {
+			continue
+		}
+
+		var t Target
+		if want != stopped {
+			if t, err = target(stage); err != nil {
+				return err
+			}
+		}
+
+		if !exists {
+			deadline := cal.Add(at, int(t.Minutes))
+			_, err := e.queries.CreateSLAClock(ctx, db.CreateSLAClockParams{
+				TenantID:             tenantID,
+				EntityType:           entityType,
+				EntityID:             entityID,
+				Stage:                stage,
+				PolicyID:             t.PolicyID,
+				TargetMinutes:        t.Minutes,
+				EscalateAfterMinutes: t.EscalateAfter,
+				StartedAt:            timestamptz(at),
+				Deadline:             timestamptz(deadline),
+				EscalateAt:           timestamptz(cal.Add(deadline, int(t.EscalateAfter))),
+			})
+			// No rows means another replica started the clock first
+			if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("failed to start %s clock: %w", stage, err)
+			}
+			continue
+		}
+
+		if params, ok := advance(cal, clock, want, at, t); ok {
+			if _, err := e.queries.UpdateSLAClock(ctx, params); err != nil {
+				return fmt.Errorf("failed to update %s clock: %w", stage, err)
+			}
+		}
+	}
+	return nil
+}
+
+// advance moves an open clock to the wanted state at the given time. ok is
+// false when nothing changes.
+func advance(cal *Calendar, c db.SlaClock, want clockState, at time.Time, t Target) (db.UpdateSLAClockParams, bool) {
+	isRunning := c.ResumedAt.Valid
+	sameTarget := c.TargetMinutes == t.Minutes && c.EscalateAfterMinutes == t.EscalateAfter
+	if want != stopped && (want == running) == isRunning && sameTarget {
+		return db.UpdateSLAClockParams{}, false
+	}
+
+	p := db.UpdateSLAClockParams{
+		ID:                   c.ID,
+		PolicyID:             c.PolicyID,
+		TargetMinutes:        c.TargetMinutes,
+		EscalateAfterMinutes: c.EscalateAfterMinutes,
+		ElapsedMinutes:       c.ElapsedMinutes,
+		ResumedAt:            c.ResumedAt,
+		PausedAt:             c.PausedAt,
+		StoppedAt:            c.StoppedAt,
+		Deadline:             c.Deadline,
+		EscalateAt:           c.EscalateAt,
+	}
+	if want != stopped {
+		p.PolicyID, p.TargetMinutes, p.EscalateAfterMinutes = t.PolicyID, t.Minutes, t.EscalateAfter
+	}
+
+	// Bank the time run so far when the clock leaves the running state
+	if isRunning && want != running {
+		p.ElapsedMinutes += int32(cal.Between(c.ResumedAt.Time, at))
+		p.ResumedAt = pgtype.Timestamptz{}
+	}
+
+	switch want {
+	case running:
+		if !isRunning {
+			p.ResumedAt = timestamptz(at)
+			p.PausedAt = pgtype.Timestamptz{}
+		}
+		// The deadline is what is left of the target from when the clock
+		// last resumed
+		deadline := cal.Add(p.ResumedAt.Time, int(max(p.TargetMinutes-p.ElapsedMinutes, 0)))
+		p.Deadline = timestamptz(deadline)
+		p.EscalateAt = timestamptz(cal.Add(deadline, int(p.EscalateAfterMinutes)))
+	case paused:
+		if isRunning {
+			p.PausedAt = timestamptz(at)
+		}
+		p.Deadline = pgtype.Timestamptz{}
+		p.EscalateAt = pgtype.Timestamptz{}
+	case stopped:
+		// A stopped clock keeps its last deadline, to report whether the
+		// stage was met
+		p.StoppedAt = timestamptz(at)
+		p.EscalateAt = pgtype.Timestamptz{}
+	}
+	return p, true
+}
+
+// Evaluate marks running clocks past their deadline as breached, escalates
+// those past their escalation time to the unit head, and raises an alert for
+// each. It returns how many alerts it raised.
+func (e *Engine) Evaluate(ctx context.Context, now time.Time) (int, error) {
+	raised := 0
+	for {
+		clocks, err := e.queries.ListDueSLAClocks(ctx, db.ListDueSLAClocksParams{Now: timestamptz(now), Limit: batchSize})
+		if err != nil {
+			return raised, fmt.Errorf("failed to list due clocks: %w", err)
+		}
+
+		for _, c := range clocks {
+			if !c.BreachedAt.Valid && c.Deadline.Valid && !c.Deadline.Time.After(now) {
+				breached, err := e.queries.MarkSLAClockBreached(ctx, db.MarkSLAClockBreachedParams{ID: c.ID, BreachedAt: c.Deadline})
+				switch {
+				case err == nil:
+					c = breached
+					if err := e.refresh(ctx, c); err != nil {
+						return raised, err
+					}
+					e.alert(ctx, Alert{Kind: AlertBreached, Clock: c})
+					raised++
+				case !errors.Is(err, pgx.ErrNoRows): // no rows: another replica marked it
+					return raised, fmt.Errorf("failed to mark clock %s breached: %w", c.ID, err)
+				}
+			}
+
+			if !c.EscalatedAt.Valid && c.EscalateAt.Valid && !c.EscalateAt.Time.After(now) {
+				head, err := e.unitHead(ctx, c)
+				if err != nil {
+					return raised, err
+				}
+				if head == nil {
+					log.Printf("SLA: no unit head to escalate %s %s to", c.EntityType, uuid.UUID(c.EntityID.Bytes))
+				}
+				escalated, err := e.queries.MarkSLAClockEscalated(ctx, db.MarkSLAClockEscalatedParams{
+					ID:          c.ID,
+					EscalatedAt: timestamptz(now),
+					EscalatedTo: head,
+				})
+				switch {
+				case err == nil:
+					e.alert(ctx, Alert{Kind: AlertEscalated, Clock: escalated})
+					raised++
+				case !errors.Is(err, pgx.ErrNoRows):
+					return raised, fmt.Errorf("failed to mark clock %s escalated: %w", c.ID, err)
+				}
+			}
+		}
+
+		if len(clocks) < batchSize {
+			return raised, nil
+		}
+	}
+}
+
+// refresh updates the SLA columns a breach changes on the clock's record
+func (e *Engine) refresh(ctx context.Context, c db.SlaClock) error {
+	if c.EntityType != EntityDarta {
+		return nil
+	}
+	if err := e.queries.RefreshDartaSLAState(ctx, db.RefreshDartaSLAStateParams{ID: c.EntityID.Bytes}); err != nil {
+		return fmt.Errorf("failed to refresh darta %s: %w", uuid.UUID(c.EntityID.Bytes), err)
+	}
+	return nil
+}
+
+// unitHead returns who a clock's breach is escalated to: the head of the
+// darta's assigned unit, or the tenant's fallback head. It returns nil when
+// the tenant has neither.
+func (e *Engine) unitHead(ctx context.Context, c db.SlaClock) (*string, error) {
+	unitID := "*"
+	if c.EntityType == EntityDarta {
+		d, err := e.queries.GetDartaSimple(ctx, db.GetDartaSimpleParams{ID: c.EntityID.Bytes, TenantID: c.TenantID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get darta %s: %w", uuid.UUID(c.EntityID.Bytes), err)
+		}
+		if d.AssignedToUnitID != nil {
+			unitID = *d.AssignedToUnitID
+		}
+	}
+
+	head, err := e.queries.GetUnitHead(ctx, db.GetUnitHeadParams{TenantID: c.TenantID, UnitID: unitID})
+	switch {
+	case err == nil:
+		return &head, nil
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("failed to get unit head: %w", err)
+	}
+}
+
+// calendar returns a tenant's calendar, caching it in calendars for the
+// rest of the pass
+func (e *Engine) calendar(ctx context.Context, calendars map[string]*Calendar, tenantID string) (*Calendar, error) {
+	if cal, ok := calendars[tenantID]; ok {
+		return cal, nil
+	}
+	cal, err := LoadCalendar(ctx, e.queries, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	calendars[tenantID] = cal
+	return cal, nil
+}
+
+// LoadCalendar loads a tenant's calendar with its holidays around now
+func LoadCalendar(ctx context.Context, queries db.Querier, tenantID string) (*Calendar, error) {
+	var settings *db.BusinessCalendar
+	row, err := queries.GetBusinessCalendar(ctx, tenantID)
+	switch {
+	case err == nil:
+		settings = &row
+	case !errors.Is(err, pgx.ErrNoRows):
+		return nil, fmt.Errorf("failed to get business calendar: %w", err)
+	}
+
+	now := time.Now()
+	holidays, err := queries.ListCalendarHolidays(ctx, db.ListCalendarHolidaysParams{
+		TenantID: tenantID,
+		FromDate: pgtype.Date{Time: now.Add(-holidayWindow), Valid: true},
+		ToDate:   pgtype.Date{Time: now.Add(holidayWindow), Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list holidays: %w", err)
+	}
+	return NewCalendar(settings, holidays), nil
+}
+
+func timestamptz(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: true}
+}
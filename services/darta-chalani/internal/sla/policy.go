@@ -0,0 +1,148 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"git.ninjainfosys.com/ePalika/services/darta-chalani/internal/db"
+)
+
+// Stages an SLA clock measures
+const (
+	StageReview          = "REVIEW"
+	StageClarification   = "CLARIFICATION"
+	StageResponse        = "RESPONSE"
+	StageChalaniApproval = "CHALANI_APPROVAL"
+)
+
+// Stages lists every stage, in the order of a record's life
+var Stages = []string{StageReview, StageClarification, StageResponse, StageChalaniApproval}
+
+// Entity types clocks are kept for
+const (
+	EntityDarta   = "DARTA"
+	EntityChalani = "CHALANI"
+)
+
+// businessDay is a working day of the default office hours, in minutes
+const businessDay = 7 * 60
+
+// clockState is what a stage's clock should be doing in a given status
+type clockState int
+
+const (
+	stopped clockState = iota
+	running
+	paused
+)
+
+// dartaStages maps each darta stage to the statuses its clock runs or is
+// paused in. In any other status the clock is stopped. The response clock
+// waits while the applicant is asked for clarification, which has a clock
+// of its own.
+var dartaStages = map[string]map[string]clockState{
+	StageReview: {
+		"PENDING_REVIEW": running,
+		"CLASSIFICATION": running,
+	},
+	StageResponse: {
+		"ASSIGNED":             running,
+		"IN_REVIEW_BY_SECTION": running,
+		"ACCEPTED":             running,
+		"ACTION_TAKEN":         running,
+		"NEEDS_CLARIFICATION":  paused,
+	},
+	StageClarification: {
+		"NEEDS_CLARIFICATION": running,
+	},
+}
+
+// chalaniStages is dartaStages for chalanis
+var chalaniStages = map[string]map[string]clockState{
+	StageChalaniApproval: {
+		"PENDING_APPROVAL": running,
+	},
+}
+
+// defaultTargets are the targets of stages a tenant has no policy for, in
+// business minutes by priority. The empty priority applies to the rest.
+var defaultTargets = map[string]map[string]int32{
+	StageReview: {
+		"URGENT": 2 * 60,
+		"HIGH":   4 * 60,
+		"":       businessDay,
+	},
+	StageClarification: {
+		"": 7 * businessDay,
+	},
+	StageResponse: {
+		"URGENT": businessDay,
+		"HIGH":   3 * businessDay,
+		"MEDIUM": 7 * businessDay,
+		"LOW":    15 * businessDay,
+		"":       7 * businessDay,
+	},
+	StageChalaniApproval: {
+		"URGENT": 4 * 60,
+		"":       2 * businessDay,
+	},
+}
+
+// Target is how long a stage may take and when its breach is escalated
+type Target struct {
+	// PolicyID is the policy the target came from; invalid for defaults
+	PolicyID pgtype.UUID
+	// Minutes is the stage's target in business minutes
+	Minutes int32
+	// EscalateAfter is how many business minutes after a breach it is
+	// escalated to the unit head; zero escalates at the breach
+	EscalateAfter int32
+}
+
+// resolveTarget returns the tenant's target for a stage, using the most
+// specific policy that matches priority and classification, or the built-in
+// default when none does
+func resolveTarget(ctx context.Context, queries db.Querier, tenantID, stage string, priority, classification *string) (Target, error) {
+	policy, err := queries.MatchSLAPolicy(ctx, db.MatchSLAPolicyParams{
+		TenantID:           tenantID,
+		Stage:              stage,
+		Priority:           priority,
+		ClassificationCode: classification,
+	})
+	switch {
+	case err == nil:
+		return Target{
+			PolicyID:      pgtype.UUID{Bytes: policy.ID, Valid: true},
+			Minutes:       policy.TargetMinutes,
+			EscalateAfter: policy.EscalateAfterMinutes,
+		}, nil
+	case errors.Is(err, pgx.ErrNoRows):
+		defaults := defaultTargets[stage]
+		minutes, ok := defaults[""]
+		if priority != nil {
+			if m, found := defaults[*priority]; found {
+				minutes, ok = m, true
+			}
+		}
+		if !ok {
+			return Target{}, fmt.Errorf("no SLA target for stage %s", stage)
+		}
+		return Target{Minutes: minutes}, nil
+	default:
+		return Target{}, fmt.Errorf("failed to match SLA policy: %w", err)
+	}
+}
+
+// IsStage reports whether s names an SLA stage
+func IsStage(s string) bool {
+	for _, stage := range Stages {
+		if s == stage {
+			return true
+		}
+	}
+	return false
+}
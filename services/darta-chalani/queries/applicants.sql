@@ -64,3 +64,13 @@ WHERE
 
 -- name: DeleteApplicant :exec
 DELETE FROM applicants WHERE id = $1;
+
+-- name: GetApplicantsByIDs :many
+-- Applicants are shared across tenants, so only those on a darta in the
+-- caller's tenant are returned
+SELECT a.* FROM applicants a
+WHERE a.id = ANY(sqlc.arg('ids')::uuid[])
+  AND EXISTS (
+      SELECT 1 FROM dartas d
+      WHERE d.applicant_id = a.id AND d.tenant_id = sqlc.arg('tenant_id')
+  );
@@ -49,3 +49,8 @@ DELETE FROM attachments WHERE id = $1;
 -- name: GetAttachmentsByIDs :many
 SELECT * FROM attachments
 WHERE id = ANY($1::uuid[]);
+
+-- name: GetTenantAttachmentsByIDs :many
+SELECT * FROM attachments
+WHERE id = ANY(sqlc.arg('ids')::uuid[])
+  AND tenant_id = sqlc.arg('tenant_id');
@@ -3,18 +3,29 @@
 -- ============================================================================
 
 -- name: CreateAuditEntry :one
+-- The ID and time are part of the entry's hash, so the caller sets them
 INSERT INTO audit_trail (
+    id,
     entity_type,
     entity_id,
     action,
     performed_by,
+    performed_at,
     changes,
     ip_address,
     user_agent,
     notes,
-    tenant_id
+    tenant_id,
+    category,
+    chain_seq,
+    prev_hash,
+    entry_hash,
+    request_id,
+    trace_id,
+    acting_role,
+    decision_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19
 ) RETURNING *;
 
 -- name: GetAuditTrail :many
@@ -41,3 +52,128 @@ LIMIT $3 OFFSET $4;
 -- name: CountAuditEntries :one
 SELECT COUNT(*) FROM audit_trail
 WHERE entity_type = $1 AND entity_id = $2;
+
+-- name: HasPerformedAuditAction :one
+SELECT EXISTS (
+    SELECT 1 FROM audit_trail
+    WHERE entity_type = $1
+      AND entity_id = $2
+      AND performed_by = $3
+      AND category = 'ACTIVITY'
+      AND action = ANY(sqlc.arg('actions')::TEXT[])
+);
+
+-- name: ListAuditEntriesByCategory :many
+SELECT * FROM audit_trail
+WHERE tenant_id = $1 AND category = $2
+ORDER BY performed_at DESC
+LIMIT $3 OFFSET $4;
+
+-- name: ListRecentAuditEntriesForEntities :many
+SELECT id, entity_type, entity_id, action, performed_by, performed_at, changes,
+       ip_address, user_agent, notes, tenant_id, category, chain_seq, prev_hash, entry_hash,
+       request_id, trace_id, acting_role, decision_id
+FROM (
+    SELECT at.*,
+           ROW_NUMBER() OVER (PARTITION BY at.entity_id ORDER BY at.performed_at DESC) AS rn
+    FROM audit_trail at
+    WHERE at.entity_type = sqlc.arg('entity_type')
+      AND at.tenant_id = sqlc.arg('tenant_id')
+      AND at.category = 'ACTIVITY'
+      AND at.entity_id = ANY(sqlc.arg('entity_ids')::uuid[])
+) ranked
+WHERE rn <= sqlc.arg('per_entity')::INT
+ORDER BY entity_id, performed_at DESC;
+
+-- name: ListAuditEntriesDesc :many
+SELECT * FROM audit_trail
+WHERE tenant_id = sqlc.arg('tenant_id')
+  AND (sqlc.narg('entity_type')::VARCHAR IS NULL OR entity_type = sqlc.narg('entity_type'))
+  AND (sqlc.narg('entity_id')::UUID IS NULL OR entity_id = sqlc.narg('entity_id'))
+  AND (sqlc.narg('performed_by')::VARCHAR IS NULL OR performed_by = sqlc.narg('performed_by'))
+  AND (sqlc.narg('actions')::TEXT[] IS NULL OR action = ANY(sqlc.narg('actions')::TEXT[]))
+  AND (sqlc.narg('category')::VARCHAR IS NULL OR category = sqlc.narg('category'))
+  AND (sqlc.narg('from_time')::TIMESTAMPTZ IS NULL OR performed_at >= sqlc.narg('from_time'))
+  AND (sqlc.narg('to_time')::TIMESTAMPTZ IS NULL OR performed_at < sqlc.narg('to_time'))
+  AND (performed_at, id) < (sqlc.arg('cursor_key')::TIMESTAMPTZ, sqlc.arg('cursor_id')::UUID)
+ORDER BY performed_at DESC, id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: ListAuditEntriesAsc :many
+SELECT * FROM audit_trail
+WHERE tenant_id = sqlc.arg('tenant_id')
+  AND (sqlc.narg('entity_type')::VARCHAR IS NULL OR entity_type = sqlc.narg('entity_type'))
+  AND (sqlc.narg('entity_id')::UUID IS NULL OR entity_id = sqlc.narg('entity_id'))
+  AND (sqlc.narg('performed_by')::VARCHAR IS NULL OR performed_by = sqlc.narg('performed_by'))
+  AND (sqlc.narg('actions')::TEXT[] IS NULL OR action = ANY(sqlc.narg('actions')::TEXT[]))
+  AND (sqlc.narg('category')::VARCHAR IS NULL OR category = sqlc.narg('category'))
+  AND (sqlc.narg('from_time')::TIMESTAMPTZ IS NULL OR performed_at >= sqlc.narg('from_time'))
+  AND (sqlc.narg('to_time')::TIMESTAMPTZ IS NULL OR performed_at < sqlc.narg('to_time'))
+  AND (performed_at, id) > (sqlc.arg('cursor_key')::TIMESTAMPTZ, sqlc.arg('cursor_id')::UUID)
+ORDER BY performed_at ASC, id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: CountAuditEntriesMatching :one
+SELECT COUNT(*) FROM audit_trail
+WHERE tenant_id = sqlc.arg('tenant_id')
+  AND (sqlc.narg('entity_type')::VARCHAR IS NULL OR entity_type = sqlc.narg('entity_type'))
+  AND (sqlc.narg('entity_id')::UUID IS NULL OR entity_id = sqlc.narg('entity_id'))
+  AND (sqlc.narg('performed_by')::VARCHAR IS NULL OR performed_by = sqlc.narg('performed_by'))
+  AND (sqlc.narg('actions')::TEXT[] IS NULL OR action = ANY(sqlc.narg('actions')::TEXT[]))
+  AND (sqlc.narg('category')::VARCHAR IS NULL OR category = sqlc.narg('category'))
+  AND (sqlc.narg('from_time')::TIMESTAMPTZ IS NULL OR performed_at >= sqlc.narg('from_time'))
+  AND (sqlc.narg('to_time')::TIMESTAMPTZ IS NULL OR performed_at < sqlc.narg('to_time'));
+
+-- name: ListEntityTimeline :many
+-- The newest limit_count activity entries of an entity, returned oldest first
+SELECT * FROM (
+    SELECT * FROM audit_trail
+    WHERE tenant_id = sqlc.arg('tenant_id')
+      AND entity_type = sqlc.arg('entity_type')
+      AND entity_id = sqlc.arg('entity_id')
+      AND category = 'ACTIVITY'
+    ORDER BY performed_at DESC, id DESC
+    LIMIT sqlc.arg('limit_count')
+) recent
+ORDER BY performed_at, id;
+
+-- ============================================================================
+-- AUDIT CHAIN
+-- ============================================================================
+
+-- name: GetAuditChainHead :one
+SELECT chain_seq::BIGINT AS chain_seq, entry_hash::TEXT AS entry_hash
+FROM audit_trail
+WHERE tenant_id = $1 AND chain_seq IS NOT NULL
+ORDER BY chain_seq DESC
+LIMIT 1;
+
+-- name: ListAuditChain :many
+SELECT * FROM audit_trail
+WHERE tenant_id = sqlc.arg('tenant_id') AND chain_seq > sqlc.arg('after_seq')::BIGINT
+ORDER BY chain_seq
+LIMIT sqlc.arg('limit_count');
+
+-- name: CountUnchainedAuditEntries :one
+-- Entries without a chain position written since the chain began can only
+-- have been inserted around the database's checks
+SELECT COUNT(*) FROM audit_trail
+WHERE tenant_id = $1 AND chain_seq IS NULL AND performed_at >= sqlc.arg('since');
+
+-- name: ListAuditChainHeads :many
+SELECT DISTINCT ON (tenant_id) tenant_id, chain_seq::BIGINT AS chain_seq, entry_hash::TEXT AS entry_hash
+FROM audit_trail
+WHERE chain_seq IS NOT NULL
+ORDER BY tenant_id, chain_seq DESC;
+
+-- name: CreateAuditAnchor :exec
+INSERT INTO audit_anchors (tenant_id, chain_seq, entry_hash)
+VALUES ($1, $2, $3)
+ON CONFLICT (tenant_id, chain_seq) DO NOTHING;
+
+-- name: ListAuditAnchors :many
+SELECT * FROM audit_anchors
+WHERE tenant_id = sqlc.arg('tenant_id')
+  AND (sqlc.narg('from_time')::TIMESTAMPTZ IS NULL OR anchored_at >= sqlc.narg('from_time'))
+  AND (sqlc.narg('to_time')::TIMESTAMPTZ IS NULL OR anchored_at < sqlc.narg('to_time'))
+ORDER BY chain_seq;
@@ -25,10 +25,10 @@ INSERT INTO chalanis (
 SELECT c.*, r.*
 FROM chalanis c
 JOIN recipients r ON c.recipient_id = r.id
-WHERE c.id = $1;
+WHERE c.id = $1 AND c.tenant_id = sqlc.arg('tenant_id');
 
 -- name: GetChalaniSimple :one
-SELECT * FROM chalanis WHERE id = $1;
+SELECT * FROM chalanis WHERE id = $1 AND tenant_id = sqlc.arg('tenant_id');
 
 -- name: GetChalaniByNumber :one
 SELECT c.*, r.*
@@ -37,6 +37,7 @@ JOIN recipients r ON c.recipient_id = r.id
 WHERE c.chalani_number = $1 
   AND c.fiscal_year_id = $2
   AND c.scope = $3
+  AND c.tenant_id = sqlc.arg('tenant_id')
   AND (c.ward_id = sqlc.narg('ward_id') OR (c.ward_id IS NULL AND sqlc.narg('ward_id')::VARCHAR IS NULL));
 
 -- name: GetChalaniByIdempotencyKey :one
@@ -45,8 +46,8 @@ WHERE idempotency_key = $1 AND tenant_id = $2;
 
 -- name: UpdateChalaniStatus :one
 UPDATE chalanis
-SET status = $2, updated_at = NOW()
-WHERE id = $1
+SET status = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND tenant_id = sqlc.arg('tenant_id') AND version = sqlc.arg('version')
 RETURNING *;
 
 -- name: UpdateChalaniNumber :one
@@ -54,16 +55,18 @@ UPDATE chalanis
 SET 
     chalani_number = $2,
     formatted_chalani_number = $3,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND version = sqlc.arg('version')
 RETURNING *;
 
 -- name: UpdateChalaniApprovalStatus :one
 UPDATE chalanis
 SET 
     is_fully_approved = $2,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND version = sqlc.arg('version')
 RETURNING *;
 
 -- name: UpdateChalaniDispatch :one
@@ -75,8 +78,9 @@ SET
     tracking_id = sqlc.narg('tracking_id'),
     courier_name = sqlc.narg('courier_name'),
     status = 'DISPATCHED',
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND version = sqlc.arg('version')
 RETURNING *;
 
 -- name: UpdateChalaniAcknowledgement :one
@@ -87,8 +91,9 @@ SET
     acknowledged_by = $3,
     acknowledgement_proof_id = sqlc.narg('acknowledgement_proof_id'),
     status = 'ACKNOWLEDGED',
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND version = sqlc.arg('version')
 RETURNING *;
 
 -- name: MarkChalaniDelivered :one
@@ -97,31 +102,35 @@ SET
     delivered_at = $2,
     delivered_proof_id = sqlc.narg('delivered_proof_id'),
     status = 'DELIVERED',
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND version = sqlc.arg('version')
 RETURNING *;
 
 -- name: VoidChalani :one
 UPDATE chalanis
 SET 
     status = 'VOIDED',
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND tenant_id = sqlc.arg('tenant_id') AND version = sqlc.arg('version')
 RETURNING *;
 
 -- name: CloseChalani :one
 UPDATE chalanis
 SET 
     status = 'CLOSED',
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND tenant_id = sqlc.arg('tenant_id') AND version = sqlc.arg('version')
 RETURNING *;
 
 -- List with filtering
--- name: ListChalanis :many
-SELECT c.*, r.*
+-- Keyset pages, as for dartas: rows strictly after the cursor (sort key, id)
+-- in the order of an idx_chalanis_tenant_* index
+-- name: ListChalanisByCreatedAtDesc :many
+SELECT c.*
 FROM chalanis c
-JOIN recipients r ON c.recipient_id = r.id
 WHERE
     (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR c.fiscal_year_id = sqlc.narg('fiscal_year_id'))
     AND (sqlc.narg('scope')::VARCHAR IS NULL OR c.scope = sqlc.narg('scope'))
@@ -134,31 +143,83 @@ WHERE
     AND (sqlc.narg('to_date')::TIMESTAMPTZ IS NULL OR c.created_at <= sqlc.narg('to_date'))
     AND (sqlc.narg('search')::TEXT IS NULL OR c.subject ILIKE '%' || sqlc.narg('search') || '%')
     AND c.tenant_id = sqlc.arg('tenant_id')
-ORDER BY c.created_at DESC
-LIMIT sqlc.arg('limit')
-OFFSET sqlc.arg('offset');
+    AND (c.created_at, c.id) < (sqlc.arg('cursor_key')::TIMESTAMPTZ, sqlc.arg('cursor_id')::UUID)
+ORDER BY c.created_at DESC, c.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: ListChalanisByCreatedAtAsc :many
+SELECT c.*
+FROM chalanis c
+WHERE
+    (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR c.fiscal_year_id = sqlc.narg('fiscal_year_id'))
+    AND (sqlc.narg('scope')::VARCHAR IS NULL OR c.scope = sqlc.narg('scope'))
+    AND (sqlc.narg('ward_id')::VARCHAR IS NULL OR c.ward_id = sqlc.narg('ward_id'))
+    AND (sqlc.narg('status')::VARCHAR IS NULL OR c.status = sqlc.narg('status'))
+    AND (sqlc.narg('dispatch_channel')::VARCHAR IS NULL OR c.dispatch_channel = sqlc.narg('dispatch_channel'))
+    AND (sqlc.narg('linked_darta_id')::UUID IS NULL OR c.linked_darta_id = sqlc.narg('linked_darta_id'))
+    AND (sqlc.narg('created_by')::VARCHAR IS NULL OR c.created_by = sqlc.narg('created_by'))
+    AND (sqlc.narg('from_date')::TIMESTAMPTZ IS NULL OR c.created_at >= sqlc.narg('from_date'))
+    AND (sqlc.narg('to_date')::TIMESTAMPTZ IS NULL OR c.created_at <= sqlc.narg('to_date'))
+    AND (sqlc.narg('search')::TEXT IS NULL OR c.subject ILIKE '%' || sqlc.narg('search') || '%')
+    AND c.tenant_id = sqlc.arg('tenant_id')
+    AND (c.created_at, c.id) > (sqlc.arg('cursor_key')::TIMESTAMPTZ, sqlc.arg('cursor_id')::UUID)
+ORDER BY c.created_at ASC, c.id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: ListChalanisByChalaniNumberDesc :many
+SELECT c.*
+FROM chalanis c
+WHERE
+    (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR c.fiscal_year_id = sqlc.narg('fiscal_year_id'))
+    AND (sqlc.narg('scope')::VARCHAR IS NULL OR c.scope = sqlc.narg('scope'))
+    AND (sqlc.narg('ward_id')::VARCHAR IS NULL OR c.ward_id = sqlc.narg('ward_id'))
+    AND (sqlc.narg('status')::VARCHAR IS NULL OR c.status = sqlc.narg('status'))
+    AND (sqlc.narg('dispatch_channel')::VARCHAR IS NULL OR c.dispatch_channel = sqlc.narg('dispatch_channel'))
+    AND (sqlc.narg('linked_darta_id')::UUID IS NULL OR c.linked_darta_id = sqlc.narg('linked_darta_id'))
+    AND (sqlc.narg('created_by')::VARCHAR IS NULL OR c.created_by = sqlc.narg('created_by'))
+    AND (sqlc.narg('from_date')::TIMESTAMPTZ IS NULL OR c.created_at >= sqlc.narg('from_date'))
+    AND (sqlc.narg('to_date')::TIMESTAMPTZ IS NULL OR c.created_at <= sqlc.narg('to_date'))
+    AND (sqlc.narg('search')::TEXT IS NULL OR c.subject ILIKE '%' || sqlc.narg('search') || '%')
+    AND c.tenant_id = sqlc.arg('tenant_id')
+    AND (COALESCE(c.chalani_number, 0), c.id) < (sqlc.arg('cursor_key')::INT, sqlc.arg('cursor_id')::UUID)
+ORDER BY COALESCE(c.chalani_number, 0) DESC, c.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: ListChalanisByChalaniNumberAsc :many
+SELECT c.*
+FROM chalanis c
+WHERE
+    (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR c.fiscal_year_id = sqlc.narg('fiscal_year_id'))
+    AND (sqlc.narg('scope')::VARCHAR IS NULL OR c.scope = sqlc.narg('scope'))
+    AND (sqlc.narg('ward_id')::VARCHAR IS NULL OR c.ward_id = sqlc.narg('ward_id'))
+    AND (sqlc.narg('status')::VARCHAR IS NULL OR c.status = sqlc.narg('status'))
+    AND (sqlc.narg('dispatch_channel')::VARCHAR IS NULL OR c.dispatch_channel = sqlc.narg('dispatch_channel'))
+    AND (sqlc.narg('linked_darta_id')::UUID IS NULL OR c.linked_darta_id = sqlc.narg('linked_darta_id'))
+    AND (sqlc.narg('created_by')::VARCHAR IS NULL OR c.created_by = sqlc.narg('created_by'))
+    AND (sqlc.narg('from_date')::TIMESTAMPTZ IS NULL OR c.created_at >= sqlc.narg('from_date'))
+    AND (sqlc.narg('to_date')::TIMESTAMPTZ IS NULL OR c.created_at <= sqlc.narg('to_date'))
+    AND (sqlc.narg('search')::TEXT IS NULL OR c.subject ILIKE '%' || sqlc.narg('search') || '%')
+    AND c.tenant_id = sqlc.arg('tenant_id')
+    AND (COALESCE(c.chalani_number, 0), c.id) > (sqlc.arg('cursor_key')::INT, sqlc.arg('cursor_id')::UUID)
+ORDER BY COALESCE(c.chalani_number, 0) ASC, c.id ASC
+LIMIT sqlc.arg('limit');
 
 -- name: CountChalanis :one
 SELECT COUNT(*)
 FROM chalanis c
 WHERE
     (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR c.fiscal_year_id = sqlc.narg('fiscal_year_id'))
+    AND (sqlc.narg('scope')::VARCHAR IS NULL OR c.scope = sqlc.narg('scope'))
+    AND (sqlc.narg('ward_id')::VARCHAR IS NULL OR c.ward_id = sqlc.narg('ward_id'))
     AND (sqlc.narg('status')::VARCHAR IS NULL OR c.status = sqlc.narg('status'))
+    AND (sqlc.narg('dispatch_channel')::VARCHAR IS NULL OR c.dispatch_channel = sqlc.narg('dispatch_channel'))
     AND (sqlc.narg('linked_darta_id')::UUID IS NULL OR c.linked_darta_id = sqlc.narg('linked_darta_id'))
+    AND (sqlc.narg('created_by')::VARCHAR IS NULL OR c.created_by = sqlc.narg('created_by'))
     AND (sqlc.narg('from_date')::TIMESTAMPTZ IS NULL OR c.created_at >= sqlc.narg('from_date'))
     AND (sqlc.narg('to_date')::TIMESTAMPTZ IS NULL OR c.created_at <= sqlc.narg('to_date'))
+    AND (sqlc.narg('search')::TEXT IS NULL OR c.subject ILIKE '%' || sqlc.narg('search') || '%')
     AND c.tenant_id = sqlc.arg('tenant_id');
 
--- name: GetMyChalani :many
-SELECT c.*, r.*
-FROM chalanis c
-JOIN recipients r ON c.recipient_id = r.id
-WHERE c.created_by = $1
-  AND (sqlc.narg('status')::VARCHAR IS NULL OR c.status = sqlc.narg('status'))
-  AND c.tenant_id = $2
-ORDER BY c.created_at DESC
-LIMIT $3 OFFSET $4;
-
 -- Statistics
 -- name: GetChalaniStatsByStatus :many
 SELECT status, COUNT(*) as count
@@ -44,3 +44,19 @@ WHERE darta_id = $1 AND related_darta_id = $2;
 
 -- name: RemoveAllDartaRelationships :exec
 DELETE FROM darta_relationships WHERE darta_id = $1;
+
+-- name: ListDartaAnnexIDs :many
+SELECT da.darta_id, da.attachment_id
+FROM darta_annexes da
+JOIN dartas d ON d.id = da.darta_id
+WHERE da.darta_id = ANY(sqlc.arg('darta_ids')::uuid[])
+  AND d.tenant_id = sqlc.arg('tenant_id')
+ORDER BY da.added_at ASC;
+
+-- name: ListDartaRelationships :many
+SELECT dr.darta_id, dr.related_darta_id, dr.relationship_type
+FROM darta_relationships dr
+JOIN dartas d ON d.id = dr.darta_id
+WHERE dr.darta_id = ANY(sqlc.arg('darta_ids')::uuid[])
+  AND d.tenant_id = sqlc.arg('tenant_id')
+ORDER BY dr.created_at DESC;
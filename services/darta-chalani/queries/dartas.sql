@@ -30,10 +30,10 @@ INSERT INTO dartas (
 SELECT d.*, a.* 
 FROM dartas d
 JOIN applicants a ON d.applicant_id = a.id
-WHERE d.id = $1;
+WHERE d.id = $1 AND d.tenant_id = sqlc.arg('tenant_id');
 
 -- name: GetDartaSimple :one
-SELECT * FROM dartas WHERE id = $1;
+SELECT * FROM dartas WHERE id = $1 AND tenant_id = sqlc.arg('tenant_id');
 
 -- name: GetDartaByNumber :one
 SELECT d.*, a.*
@@ -42,6 +42,7 @@ JOIN applicants a ON d.applicant_id = a.id
 WHERE d.darta_number = $1 
   AND d.fiscal_year_id = $2
   AND d.scope = $3
+  AND d.tenant_id = sqlc.arg('tenant_id')
   AND (d.ward_id = sqlc.narg('ward_id') OR (d.ward_id IS NULL AND sqlc.narg('ward_id')::VARCHAR IS NULL));
 
 -- name: GetDartaByIdempotencyKey :one
@@ -50,25 +51,30 @@ WHERE idempotency_key = $1 AND tenant_id = $2;
 
 -- name: UpdateDartaStatus :one
 UPDATE dartas
-SET status = $2, updated_at = NOW()
-WHERE id = $1
+SET status = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND tenant_id = sqlc.arg('tenant_id') AND version = sqlc.arg('version')
 RETURNING *;
 
 -- name: UpdateDartaNumber :one
+-- Assigns the number and moves the darta to NUMBER_RESERVED in one versioned
+-- update, so a concurrent change cannot leave a number without its status
 UPDATE dartas
 SET 
     darta_number = $2,
     formatted_darta_number = $3,
+    status = 'NUMBER_RESERVED',
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND version = sqlc.arg('version')
 RETURNING *;
 
 -- name: UpdateDartaClassification :one
 UPDATE dartas
 SET 
     classification_code = $2,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND version = sqlc.arg('version')
 RETURNING *;
 
 -- name: UpdateDartaAssignment :one
@@ -76,41 +82,51 @@ UPDATE dartas
 SET 
     assigned_to_unit_id = sqlc.narg('assigned_to_unit_id'),
     current_assignee_id = sqlc.narg('current_assignee_id'),
-    sla_deadline = sqlc.narg('sla_deadline'),
+    sla_target_minutes = sqlc.narg('sla_target_minutes'),
     priority = COALESCE(sqlc.narg('priority'), priority),
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND version = sqlc.arg('version')
 RETURNING *;
 
 -- name: UpdateDartaMetadata :one
+-- Replaces a darta's metadata if it is still at version, bumping the version
 UPDATE dartas
 SET 
     metadata = $2,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND version = sqlc.arg('version')
 RETURNING *;
 
 -- name: VoidDarta :one
 UPDATE dartas
 SET 
     status = 'VOIDED',
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND tenant_id = sqlc.arg('tenant_id') AND version = sqlc.arg('version')
 RETURNING *;
 
 -- name: CloseDarta :one
 UPDATE dartas
 SET 
     status = 'CLOSED',
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND tenant_id = sqlc.arg('tenant_id') AND version = sqlc.arg('version')
 RETURNING *;
 
 -- Complex queries with filtering
--- name: ListDartas :many
-SELECT d.*, a.*
+--
+-- Lists page by keyset: each sort order has an ascending and a descending
+-- query that returns the rows strictly after the cursor (sort key, id). The
+-- first page passes a sentinel cursor before every row. The ORDER BY matches
+-- an idx_dartas_tenant_* index, so a page costs an index range scan however
+-- deep it is.
+-- name: ListDartasByCreatedAtDesc :many
+SELECT d.*
 FROM dartas d
-JOIN applicants a ON d.applicant_id = a.id
 WHERE
     (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR d.fiscal_year_id = sqlc.narg('fiscal_year_id'))
     AND (sqlc.narg('scope')::VARCHAR IS NULL OR d.scope = sqlc.narg('scope'))
@@ -126,15 +142,12 @@ WHERE
     AND (sqlc.narg('to_date')::TIMESTAMPTZ IS NULL OR d.received_date <= sqlc.narg('to_date'))
     AND (sqlc.narg('search')::TEXT IS NULL OR d.subject ILIKE '%' || sqlc.narg('search') || '%')
     AND d.tenant_id = sqlc.arg('tenant_id')
-ORDER BY 
-    CASE WHEN sqlc.arg('sort_by')::TEXT = 'received_date' THEN d.received_date END DESC,
-    CASE WHEN sqlc.arg('sort_by')::TEXT = 'darta_number' THEN d.darta_number END DESC,
-    d.created_at DESC
-LIMIT sqlc.arg('limit')
-OFFSET sqlc.arg('offset');
+    AND (d.created_at, d.id) < (sqlc.arg('cursor_key')::TIMESTAMPTZ, sqlc.arg('cursor_id')::UUID)
+ORDER BY d.created_at DESC, d.id DESC
+LIMIT sqlc.arg('limit');
 
--- name: CountDartas :one
-SELECT COUNT(*)
+-- name: ListDartasByCreatedAtAsc :many
+SELECT d.*
 FROM dartas d
 WHERE
     (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR d.fiscal_year_id = sqlc.narg('fiscal_year_id'))
@@ -142,33 +155,125 @@ WHERE
     AND (sqlc.narg('ward_id')::VARCHAR IS NULL OR d.ward_id = sqlc.narg('ward_id'))
     AND (sqlc.narg('status')::VARCHAR IS NULL OR d.status = sqlc.narg('status'))
     AND (sqlc.narg('priority')::VARCHAR IS NULL OR d.priority = sqlc.narg('priority'))
+    AND (sqlc.narg('intake_channel')::VARCHAR IS NULL OR d.intake_channel = sqlc.narg('intake_channel'))
     AND (sqlc.narg('assigned_to_unit_id')::VARCHAR IS NULL OR d.assigned_to_unit_id = sqlc.narg('assigned_to_unit_id'))
     AND (sqlc.narg('current_assignee_id')::VARCHAR IS NULL OR d.current_assignee_id = sqlc.narg('current_assignee_id'))
+    AND (sqlc.narg('created_by')::VARCHAR IS NULL OR d.created_by = sqlc.narg('created_by'))
     AND (sqlc.narg('is_overdue')::BOOLEAN IS NULL OR d.is_overdue = sqlc.narg('is_overdue'))
     AND (sqlc.narg('from_date')::TIMESTAMPTZ IS NULL OR d.received_date >= sqlc.narg('from_date'))
     AND (sqlc.narg('to_date')::TIMESTAMPTZ IS NULL OR d.received_date <= sqlc.narg('to_date'))
     AND (sqlc.narg('search')::TEXT IS NULL OR d.subject ILIKE '%' || sqlc.narg('search') || '%')
-    AND d.tenant_id = sqlc.arg('tenant_id');
+    AND d.tenant_id = sqlc.arg('tenant_id')
+    AND (d.created_at, d.id) > (sqlc.arg('cursor_key')::TIMESTAMPTZ, sqlc.arg('cursor_id')::UUID)
+ORDER BY d.created_at ASC, d.id ASC
+LIMIT sqlc.arg('limit');
 
--- name: GetMyDartas :many
-SELECT d.*, a.*
+-- name: ListDartasByReceivedDateDesc :many
+SELECT d.*
 FROM dartas d
-JOIN applicants a ON d.applicant_id = a.id
-WHERE d.current_assignee_id = $1
-  AND (sqlc.narg('status')::VARCHAR IS NULL OR d.status = sqlc.narg('status'))
-  AND d.tenant_id = $2
-ORDER BY 
-    CASE WHEN d.is_overdue THEN 0 ELSE 1 END,
-    d.priority DESC,
-    d.received_date DESC
-LIMIT $3 OFFSET $4;
-
--- name: CountMyDartas :one
+WHERE
+    (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR d.fiscal_year_id = sqlc.narg('fiscal_year_id'))
+    AND (sqlc.narg('scope')::VARCHAR IS NULL OR d.scope = sqlc.narg('scope'))
+    AND (sqlc.narg('ward_id')::VARCHAR IS NULL OR d.ward_id = sqlc.narg('ward_id'))
+    AND (sqlc.narg('status')::VARCHAR IS NULL OR d.status = sqlc.narg('status'))
+    AND (sqlc.narg('priority')::VARCHAR IS NULL OR d.priority = sqlc.narg('priority'))
+    AND (sqlc.narg('intake_channel')::VARCHAR IS NULL OR d.intake_channel = sqlc.narg('intake_channel'))
+    AND (sqlc.narg('assigned_to_unit_id')::VARCHAR IS NULL OR d.assigned_to_unit_id = sqlc.narg('assigned_to_unit_id'))
+    AND (sqlc.narg('current_assignee_id')::VARCHAR IS NULL OR d.current_assignee_id = sqlc.narg('current_assignee_id'))
+    AND (sqlc.narg('created_by')::VARCHAR IS NULL OR d.created_by = sqlc.narg('created_by'))
+    AND (sqlc.narg('is_overdue')::BOOLEAN IS NULL OR d.is_overdue = sqlc.narg('is_overdue'))
+    AND (sqlc.narg('from_date')::TIMESTAMPTZ IS NULL OR d.received_date >= sqlc.narg('from_date'))
+    AND (sqlc.narg('to_date')::TIMESTAMPTZ IS NULL OR d.received_date <= sqlc.narg('to_date'))
+    AND (sqlc.narg('search')::TEXT IS NULL OR d.subject ILIKE '%' || sqlc.narg('search') || '%')
+    AND d.tenant_id = sqlc.arg('tenant_id')
+    AND (d.received_date, d.id) < (sqlc.arg('cursor_key')::TIMESTAMPTZ, sqlc.arg('cursor_id')::UUID)
+ORDER BY d.received_date DESC, d.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: ListDartasByReceivedDateAsc :many
+SELECT d.*
+FROM dartas d
+WHERE
+    (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR d.fiscal_year_id = sqlc.narg('fiscal_year_id'))
+    AND (sqlc.narg('scope')::VARCHAR IS NULL OR d.scope = sqlc.narg('scope'))
+    AND (sqlc.narg('ward_id')::VARCHAR IS NULL OR d.ward_id = sqlc.narg('ward_id'))
+    AND (sqlc.narg('status')::VARCHAR IS NULL OR d.status = sqlc.narg('status'))
+    AND (sqlc.narg('priority')::VARCHAR IS NULL OR d.priority = sqlc.narg('priority'))
+    AND (sqlc.narg('intake_channel')::VARCHAR IS NULL OR d.intake_channel = sqlc.narg('intake_channel'))
+    AND (sqlc.narg('assigned_to_unit_id')::VARCHAR IS NULL OR d.assigned_to_unit_id = sqlc.narg('assigned_to_unit_id'))
+    AND (sqlc.narg('current_assignee_id')::VARCHAR IS NULL OR d.current_assignee_id = sqlc.narg('current_assignee_id'))
+    AND (sqlc.narg('created_by')::VARCHAR IS NULL OR d.created_by = sqlc.narg('created_by'))
+    AND (sqlc.narg('is_overdue')::BOOLEAN IS NULL OR d.is_overdue = sqlc.narg('is_overdue'))
+    AND (sqlc.narg('from_date')::TIMESTAMPTZ IS NULL OR d.received_date >= sqlc.narg('from_date'))
+    AND (sqlc.narg('to_date')::TIMESTAMPTZ IS NULL OR d.received_date <= sqlc.narg('to_date'))
+    AND (sqlc.narg('search')::TEXT IS NULL OR d.subject ILIKE '%' || sqlc.narg('search') || '%')
+    AND d.tenant_id = sqlc.arg('tenant_id')
+    AND (d.received_date, d.id) > (sqlc.arg('cursor_key')::TIMESTAMPTZ, sqlc.arg('cursor_id')::UUID)
+ORDER BY d.received_date ASC, d.id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: ListDartasByDartaNumberDesc :many
+SELECT d.*
+FROM dartas d
+WHERE
+    (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR d.fiscal_year_id = sqlc.narg('fiscal_year_id'))
+    AND (sqlc.narg('scope')::VARCHAR IS NULL OR d.scope = sqlc.narg('scope'))
+    AND (sqlc.narg('ward_id')::VARCHAR IS NULL OR d.ward_id = sqlc.narg('ward_id'))
+    AND (sqlc.narg('status')::VARCHAR IS NULL OR d.status = sqlc.narg('status'))
+    AND (sqlc.narg('priority')::VARCHAR IS NULL OR d.priority = sqlc.narg('priority'))
+    AND (sqlc.narg('intake_channel')::VARCHAR IS NULL OR d.intake_channel = sqlc.narg('intake_channel'))
+    AND (sqlc.narg('assigned_to_unit_id')::VARCHAR IS NULL OR d.assigned_to_unit_id = sqlc.narg('assigned_to_unit_id'))
+    AND (sqlc.narg('current_assignee_id')::VARCHAR IS NULL OR d.current_assignee_id = sqlc.narg('current_assignee_id'))
+    AND (sqlc.narg('created_by')::VARCHAR IS NULL OR d.created_by = sqlc.narg('created_by'))
+    AND (sqlc.narg('is_overdue')::BOOLEAN IS NULL OR d.is_overdue = sqlc.narg('is_overdue'))
+    AND (sqlc.narg('from_date')::TIMESTAMPTZ IS NULL OR d.received_date >= sqlc.narg('from_date'))
+    AND (sqlc.narg('to_date')::TIMESTAMPTZ IS NULL OR d.received_date <= sqlc.narg('to_date'))
+    AND (sqlc.narg('search')::TEXT IS NULL OR d.subject ILIKE '%' || sqlc.narg('search') || '%')
+    AND d.tenant_id = sqlc.arg('tenant_id')
+    AND (COALESCE(d.darta_number, 0), d.id) < (sqlc.arg('cursor_key')::INT, sqlc.arg('cursor_id')::UUID)
+ORDER BY COALESCE(d.darta_number, 0) DESC, d.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: ListDartasByDartaNumberAsc :many
+SELECT d.*
+FROM dartas d
+WHERE
+    (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR d.fiscal_year_id = sqlc.narg('fiscal_year_id'))
+    AND (sqlc.narg('scope')::VARCHAR IS NULL OR d.scope = sqlc.narg('scope'))
+    AND (sqlc.narg('ward_id')::VARCHAR IS NULL OR d.ward_id = sqlc.narg('ward_id'))
+    AND (sqlc.narg('status')::VARCHAR IS NULL OR d.status = sqlc.narg('status'))
+    AND (sqlc.narg('priority')::VARCHAR IS NULL OR d.priority = sqlc.narg('priority'))
+    AND (sqlc.narg('intake_channel')::VARCHAR IS NULL OR d.intake_channel = sqlc.narg('intake_channel'))
+    AND (sqlc.narg('assigned_to_unit_id')::VARCHAR IS NULL OR d.assigned_to_unit_id = sqlc.narg('assigned_to_unit_id'))
+    AND (sqlc.narg('current_assignee_id')::VARCHAR IS NULL OR d.current_assignee_id = sqlc.narg('current_assignee_id'))
+    AND (sqlc.narg('created_by')::VARCHAR IS NULL OR d.created_by = sqlc.narg('created_by'))
+    AND (sqlc.narg('is_overdue')::BOOLEAN IS NULL OR d.is_overdue = sqlc.narg('is_overdue'))
+    AND (sqlc.narg('from_date')::TIMESTAMPTZ IS NULL OR d.received_date >= sqlc.narg('from_date'))
+    AND (sqlc.narg('to_date')::TIMESTAMPTZ IS NULL OR d.received_date <= sqlc.narg('to_date'))
+    AND (sqlc.narg('search')::TEXT IS NULL OR d.subject ILIKE '%' || sqlc.narg('search') || '%')
+    AND d.tenant_id = sqlc.arg('tenant_id')
+    AND (COALESCE(d.darta_number, 0), d.id) > (sqlc.arg('cursor_key')::INT, sqlc.arg('cursor_id')::UUID)
+ORDER BY COALESCE(d.darta_number, 0) ASC, d.id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: CountDartas :one
 SELECT COUNT(*)
-FROM dartas
-WHERE current_assignee_id = $1
-  AND (sqlc.narg('status')::VARCHAR IS NULL OR status = sqlc.narg('status'))
-  AND tenant_id = $2;
+FROM dartas d
+WHERE
+    (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR d.fiscal_year_id = sqlc.narg('fiscal_year_id'))
+    AND (sqlc.narg('scope')::VARCHAR IS NULL OR d.scope = sqlc.narg('scope'))
+    AND (sqlc.narg('ward_id')::VARCHAR IS NULL OR d.ward_id = sqlc.narg('ward_id'))
+    AND (sqlc.narg('status')::VARCHAR IS NULL OR d.status = sqlc.narg('status'))
+    AND (sqlc.narg('priority')::VARCHAR IS NULL OR d.priority = sqlc.narg('priority'))
+    AND (sqlc.narg('intake_channel')::VARCHAR IS NULL OR d.intake_channel = sqlc.narg('intake_channel'))
+    AND (sqlc.narg('assigned_to_unit_id')::VARCHAR IS NULL OR d.assigned_to_unit_id = sqlc.narg('assigned_to_unit_id'))
+    AND (sqlc.narg('current_assignee_id')::VARCHAR IS NULL OR d.current_assignee_id = sqlc.narg('current_assignee_id'))
+    AND (sqlc.narg('created_by')::VARCHAR IS NULL OR d.created_by = sqlc.narg('created_by'))
+    AND (sqlc.narg('is_overdue')::BOOLEAN IS NULL OR d.is_overdue = sqlc.narg('is_overdue'))
+    AND (sqlc.narg('from_date')::TIMESTAMPTZ IS NULL OR d.received_date >= sqlc.narg('from_date'))
+    AND (sqlc.narg('to_date')::TIMESTAMPTZ IS NULL OR d.received_date <= sqlc.narg('to_date'))
+    AND (sqlc.narg('search')::TEXT IS NULL OR d.subject ILIKE '%' || sqlc.narg('search') || '%')
+    AND d.tenant_id = sqlc.arg('tenant_id');
 
 -- Statistics queries
 -- name: GetDartaStatsByStatus :many
@@ -178,6 +283,8 @@ WHERE tenant_id = $1
   AND (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR fiscal_year_id = sqlc.narg('fiscal_year_id'))
   AND (sqlc.narg('scope')::VARCHAR IS NULL OR scope = sqlc.narg('scope'))
   AND (sqlc.narg('ward_id')::VARCHAR IS NULL OR ward_id = sqlc.narg('ward_id'))
+  AND (sqlc.narg('received_from')::TIMESTAMPTZ IS NULL OR received_date >= sqlc.narg('received_from'))
+  AND (sqlc.narg('received_to')::TIMESTAMPTZ IS NULL OR received_date < sqlc.narg('received_to'))
 GROUP BY status;
 
 -- name: GetDartaStatsByChannel :many
@@ -187,6 +294,8 @@ WHERE tenant_id = $1
   AND (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR fiscal_year_id = sqlc.narg('fiscal_year_id'))
   AND (sqlc.narg('scope')::VARCHAR IS NULL OR scope = sqlc.narg('scope'))
   AND (sqlc.narg('ward_id')::VARCHAR IS NULL OR ward_id = sqlc.narg('ward_id'))
+  AND (sqlc.narg('received_from')::TIMESTAMPTZ IS NULL OR received_date >= sqlc.narg('received_from'))
+  AND (sqlc.narg('received_to')::TIMESTAMPTZ IS NULL OR received_date < sqlc.narg('received_to'))
 GROUP BY intake_channel;
 
 -- name: GetOverdueCount :one
@@ -205,3 +314,68 @@ WHERE fiscal_year_id = $1
   AND scope = $2
   AND (ward_id = sqlc.narg('ward_id') OR (ward_id IS NULL AND sqlc.narg('ward_id')::VARCHAR IS NULL))
   AND tenant_id = $3;
+
+-- name: GetDartasByIDs :many
+SELECT * FROM dartas
+WHERE id = ANY(sqlc.arg('ids')::uuid[])
+  AND tenant_id = sqlc.arg('tenant_id');
+
+-- name: SupersedeDarta :one
+-- Marks a darta superseded by its replacement and links the replacement
+-- back, in one statement. Neither row changes unless both are still
+-- unlinked and the darta is still at version.
+WITH replacement AS (
+    UPDATE dartas r
+    SET supersedes_id = o.id,
+        supersedes_number = o.formatted_darta_number,
+        version = r.version + 1,
+        updated_at = NOW()
+    FROM dartas o
+    WHERE r.id = sqlc.arg('replacement_id')
+      AND o.id = sqlc.arg('id')
+      AND r.tenant_id = sqlc.arg('tenant_id')
+      AND o.tenant_id = r.tenant_id
+      AND r.supersedes_id IS NULL
+      AND r.superseded_by_id IS NULL
+      AND o.superseded_by_id IS NULL
+      AND o.version = sqlc.arg('version')
+    RETURNING r.id
+)
+UPDATE dartas
+SET status = 'SUPERSEDED',
+    superseded_by_id = (SELECT id FROM replacement),
+    version = version + 1,
+    updated_at = NOW()
+WHERE dartas.id = sqlc.arg('id')
+  AND EXISTS (SELECT 1 FROM replacement)
+RETURNING *;
+
+-- name: GetDartaSupersessionChain :many
+-- Every darta in the supersession chain through a darta, oldest first
+WITH RECURSIVE earlier AS (
+    SELECT d.id, d.supersedes_id, 0 AS depth
+    FROM dartas d
+    WHERE d.id = sqlc.arg('id') AND d.tenant_id = sqlc.arg('tenant_id')
+    UNION ALL
+    SELECT p.id, p.supersedes_id, e.depth - 1
+    FROM dartas p
+    JOIN earlier e ON p.id = e.supersedes_id
+    WHERE e.depth > -sqlc.arg('max_depth')::INT
+), later AS (
+    SELECT d.id, d.superseded_by_id, 0 AS depth
+    FROM dartas d
+    WHERE d.id = sqlc.arg('id') AND d.tenant_id = sqlc.arg('tenant_id')
+    UNION ALL
+    SELECT n.id, n.superseded_by_id, l.depth + 1
+    FROM dartas n
+    JOIN later l ON n.id = l.superseded_by_id
+    WHERE l.depth < sqlc.arg('max_depth')::INT
+), chain AS (
+    SELECT id, depth FROM earlier
+    UNION
+    SELECT id, depth FROM later
+)
+SELECT d.*
+FROM dartas d
+JOIN chain c ON c.id = d.id
+ORDER BY c.depth;
@@ -0,0 +1,41 @@
+-- ============================================================================
+-- DUPLICATE DETECTION
+-- ============================================================================
+
+-- name: ListDuplicateCandidates :many
+-- Live dartas in the tenant, in any ward, received within the window that
+-- share an applicant identifier or the primary document with the probe or
+-- have a similar subject (pg_trgm's % operator). Phones are compared by
+-- their last ten digits.
+SELECT
+    d.id,
+    d.subject,
+    d.formatted_darta_number,
+    d.status,
+    d.ward_id,
+    d.received_date,
+    a.full_name,
+    (d.applicant_id = sqlc.arg('applicant_id')::UUID)::BOOLEAN AS same_applicant,
+    COALESCE(a.identification_number = sqlc.narg('identification_number')::TEXT, false)::BOOLEAN AS same_identification,
+    COALESCE(right(regexp_replace(a.phone, '[^0-9]', '', 'g'), 10) = sqlc.narg('phone_digits')::TEXT, false)::BOOLEAN AS same_phone,
+    COALESCE(lower(a.email) = sqlc.narg('email')::TEXT, false)::BOOLEAN AS same_email,
+    (att.checksum = sqlc.arg('checksum')::TEXT)::BOOLEAN AS same_document,
+    similarity(d.subject, sqlc.arg('subject')::TEXT)::FLOAT8 AS subject_similarity
+FROM dartas d
+JOIN applicants a ON a.id = d.applicant_id
+JOIN attachments att ON att.id = d.primary_document_id
+WHERE d.tenant_id = sqlc.arg('tenant_id')
+    AND d.id <> sqlc.arg('exclude_id')::UUID
+    AND d.status NOT IN ('VOIDED', 'SUPERSEDED')
+    AND d.received_date BETWEEN sqlc.arg('received_from')::TIMESTAMPTZ AND sqlc.arg('received_to')::TIMESTAMPTZ
+    AND (
+        d.applicant_id = sqlc.arg('applicant_id')::UUID
+        OR a.identification_number = sqlc.narg('identification_number')::TEXT
+        OR right(regexp_replace(a.phone, '[^0-9]', '', 'g'), 10) = sqlc.narg('phone_digits')::TEXT
+        OR lower(a.email) = sqlc.narg('email')::TEXT
+        OR att.checksum = sqlc.arg('checksum')::TEXT
+        OR d.subject % sqlc.arg('subject')::TEXT
+    )
+ORDER BY d.received_date DESC
+LIMIT sqlc.arg('limit');
+
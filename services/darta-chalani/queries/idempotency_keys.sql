@@ -0,0 +1,38 @@
+-- ============================================================================
+-- IDEMPOTENCY KEYS
+-- ============================================================================
+
+-- name: ClaimIdempotencyKey :execrows
+-- Claims a key for a request. An expired key is claimed afresh, as is an
+-- abandoned claim of the same request; otherwise no row is claimed.
+INSERT INTO idempotency_keys (tenant_id, idempotency_key, method, request_hash, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (tenant_id, idempotency_key) DO UPDATE SET
+    method = EXCLUDED.method,
+    request_hash = EXCLUDED.request_hash,
+    response = NULL,
+    created_at = NOW(),
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at < NOW()
+    OR (idempotency_keys.response IS NULL
+        AND idempotency_keys.request_hash = EXCLUDED.request_hash
+        AND idempotency_keys.created_at < sqlc.arg('abandoned_before')::TIMESTAMPTZ);
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM idempotency_keys
+WHERE tenant_id = $1 AND idempotency_key = $2;
+
+-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys
+SET response = $4
+WHERE tenant_id = $1 AND idempotency_key = $2 AND request_hash = $3;
+
+-- name: ReleaseIdempotencyKey :exec
+-- Frees a claim whose request failed, so it can be retried
+DELETE FROM idempotency_keys
+WHERE tenant_id = $1 AND idempotency_key = $2 AND request_hash = $3
+    AND response IS NULL;
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys
+WHERE expires_at < NOW();
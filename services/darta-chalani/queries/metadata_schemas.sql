@@ -0,0 +1,29 @@
+-- ============================================================================
+-- METADATA SCHEMAS
+-- ============================================================================
+
+-- name: ListMetadataSchemas :many
+SELECT * FROM metadata_schemas
+WHERE tenant_id = $1
+ORDER BY classification_code;
+
+-- name: GetApplicableMetadataSchema :one
+-- The schema for a classification code, else the tenant's default schema
+SELECT * FROM metadata_schemas
+WHERE tenant_id = $1
+    AND classification_code IN (sqlc.arg('classification_code')::VARCHAR, '')
+ORDER BY classification_code DESC
+LIMIT 1;
+
+-- name: UpsertMetadataSchema :one
+INSERT INTO metadata_schemas (tenant_id, classification_code, schema, updated_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (tenant_id, classification_code) DO UPDATE SET
+    schema = EXCLUDED.schema,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteMetadataSchema :execrows
+DELETE FROM metadata_schemas
+WHERE tenant_id = $1 AND classification_code = $2;
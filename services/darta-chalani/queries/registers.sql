@@ -0,0 +1,44 @@
+-- ============================================================================
+-- STATUTORY REGISTERS
+-- ============================================================================
+--
+-- Every numbered record of a fiscal year's register, in number order. The
+-- municipality register is the one without a ward.
+
+-- name: ListDartaRegister :many
+SELECT
+    d.darta_number::INT AS number,
+    COALESCE(d.formatted_darta_number, '')::TEXT AS formatted_number,
+    d.received_date AS recorded_at,
+    COALESCE(NULLIF(a.organization, ''), a.full_name)::TEXT AS party,
+    d.subject,
+    COALESCE(d.assigned_to_unit_id, '')::TEXT AS section,
+    d.status
+FROM dartas d
+JOIN applicants a ON a.id = d.applicant_id
+WHERE d.tenant_id = sqlc.arg('tenant_id')
+  AND d.fiscal_year_id = sqlc.arg('fiscal_year_id')
+  AND d.scope = sqlc.arg('scope')
+  AND (d.ward_id = sqlc.narg('ward_id') OR (d.ward_id IS NULL AND sqlc.narg('ward_id')::VARCHAR IS NULL))
+  AND d.darta_number IS NOT NULL
+ORDER BY d.darta_number;
+
+-- name: ListChalaniRegister :many
+-- A chalani's section is that of the darta it answers
+SELECT
+    c.chalani_number::INT AS number,
+    COALESCE(c.formatted_chalani_number, '')::TEXT AS formatted_number,
+    COALESCE(c.dispatched_at, c.created_at)::TIMESTAMPTZ AS recorded_at,
+    COALESCE(NULLIF(r.organization, ''), r.name)::TEXT AS party,
+    c.subject,
+    COALESCE(d.assigned_to_unit_id, '')::TEXT AS section,
+    c.status
+FROM chalanis c
+JOIN recipients r ON r.id = c.recipient_id
+LEFT JOIN dartas d ON d.id = c.linked_darta_id
+WHERE c.tenant_id = sqlc.arg('tenant_id')
+  AND c.fiscal_year_id = sqlc.arg('fiscal_year_id')
+  AND c.scope = sqlc.arg('scope')
+  AND (c.ward_id = sqlc.narg('ward_id') OR (c.ward_id IS NULL AND sqlc.narg('ward_id')::VARCHAR IS NULL))
+  AND c.chalani_number IS NOT NULL
+ORDER BY c.chalani_number;
@@ -0,0 +1,162 @@
+-- ============================================================================
+-- SEARCH DOCUMENTS
+-- ============================================================================
+
+-- name: ListStaleDartaSearchSources :many
+-- Dartas with no search document, or whose darta or applicant changed since
+-- it was built, with everything the document is built from
+SELECT
+    d.id,
+    d.tenant_id,
+    d.subject,
+    d.darta_number,
+    d.formatted_darta_number,
+    d.classification_code,
+    d.status,
+    d.fiscal_year_id,
+    d.ward_id,
+    d.intake_channel,
+    a.full_name,
+    a.organization,
+    COALESCE(d.metadata->>'ocr_text', '')::TEXT AS ocr_text,
+    COALESCE((
+        SELECT string_agg(att.metadata->>'ocr_text', E'\n')
+        FROM attachments att
+        WHERE att.id = d.primary_document_id
+           OR att.id IN (SELECT da.attachment_id FROM darta_annexes da WHERE da.darta_id = d.id)
+    ), '')::TEXT AS attachment_text,
+    GREATEST(d.updated_at, a.updated_at)::TIMESTAMPTZ AS source_updated_at
+FROM dartas d
+JOIN applicants a ON a.id = d.applicant_id
+LEFT JOIN search_documents s ON s.entity_type = 'DARTA' AND s.entity_id = d.id
+WHERE s.entity_id IS NULL OR s.source_updated_at < GREATEST(d.updated_at, a.updated_at)
+ORDER BY d.updated_at
+LIMIT sqlc.arg('limit');
+
+-- name: ListStaleChalaniSearchSources :many
+SELECT
+    c.id,
+    c.tenant_id,
+    c.subject,
+    c.body,
+    c.chalani_number,
+    c.formatted_chalani_number,
+    c.status,
+    c.fiscal_year_id,
+    c.ward_id,
+    c.dispatch_channel,
+    r.name,
+    r.organization,
+    COALESCE(c.metadata->>'ocr_text', '')::TEXT AS ocr_text,
+    COALESCE((
+        SELECT string_agg(att.metadata->>'ocr_text', E'\n')
+        FROM attachments att
+        JOIN chalani_attachments ca ON ca.attachment_id = att.id
+        WHERE ca.chalani_id = c.id
+    ), '')::TEXT AS attachment_text,
+    GREATEST(c.updated_at, r.updated_at)::TIMESTAMPTZ AS source_updated_at
+FROM chalanis c
+JOIN recipients r ON r.id = c.recipient_id
+LEFT JOIN search_documents s ON s.entity_type = 'CHALANI' AND s.entity_id = c.id
+WHERE s.entity_id IS NULL OR s.source_updated_at < GREATEST(c.updated_at, r.updated_at)
+ORDER BY c.updated_at
+LIMIT sqlc.arg('limit');
+
+-- name: UpsertSearchDocument :exec
+INSERT INTO search_documents (
+    entity_type,
+    entity_id,
+    tenant_id,
+    title,
+    number,
+    party,
+    content,
+    status,
+    fiscal_year_id,
+    ward_id,
+    channel,
+    number_terms,
+    title_terms,
+    body_terms,
+    fold_key,
+    source_updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+)
+ON CONFLICT (entity_type, entity_id) DO UPDATE SET
+    tenant_id = EXCLUDED.tenant_id,
+    title = EXCLUDED.title,
+    number = EXCLUDED.number,
+    party = EXCLUDED.party,
+    content = EXCLUDED.content,
+    status = EXCLUDED.status,
+    fiscal_year_id = EXCLUDED.fiscal_year_id,
+    ward_id = EXCLUDED.ward_id,
+    channel = EXCLUDED.channel,
+    number_terms = EXCLUDED.number_terms,
+    title_terms = EXCLUDED.title_terms,
+    body_terms = EXCLUDED.body_terms,
+    fold_key = EXCLUDED.fold_key,
+    source_updated_at = EXCLUDED.source_updated_at,
+    indexed_at = NOW();
+
+-- Searches match the tsquery built from the query's phonetic keys, or are
+-- close enough to fold_key by trigram word similarity (pg_trgm's <%
+-- operator, threshold pg_trgm.word_similarity_threshold). Facet filters are
+-- ANY-of lists; NULL applies no filter.
+
+-- name: SearchDocuments :many
+SELECT
+    s.entity_type,
+    s.entity_id,
+    s.title,
+    s.number,
+    s.party,
+    s.content,
+    s.status,
+    s.fiscal_year_id,
+    s.ward_id,
+    s.channel,
+    (ts_rank_cd(s.document, to_tsquery('simple', sqlc.arg('query')::TEXT))
+        + 0.5 * word_similarity(sqlc.arg('fold_key')::TEXT, s.fold_key))::FLOAT8 AS score
+FROM search_documents s
+WHERE s.tenant_id = sqlc.arg('tenant_id')
+    AND (s.document @@ to_tsquery('simple', sqlc.arg('query')::TEXT) OR sqlc.arg('fold_key')::TEXT <% s.fold_key)
+    AND (sqlc.narg('entity_types')::TEXT[] IS NULL OR s.entity_type = ANY(sqlc.narg('entity_types')::TEXT[]))
+    AND (sqlc.narg('statuses')::TEXT[] IS NULL OR s.status = ANY(sqlc.narg('statuses')::TEXT[]))
+    AND (sqlc.narg('fiscal_year_ids')::TEXT[] IS NULL OR s.fiscal_year_id = ANY(sqlc.narg('fiscal_year_ids')::TEXT[]))
+    AND (sqlc.narg('ward_ids')::TEXT[] IS NULL OR s.ward_id = ANY(sqlc.narg('ward_ids')::TEXT[]))
+    AND (sqlc.narg('channels')::TEXT[] IS NULL OR s.channel = ANY(sqlc.narg('channels')::TEXT[]))
+ORDER BY score DESC, s.entity_id
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountSearchDocuments :one
+SELECT COUNT(*)
+FROM search_documents s
+WHERE s.tenant_id = sqlc.arg('tenant_id')
+    AND (s.document @@ to_tsquery('simple', sqlc.arg('query')::TEXT) OR sqlc.arg('fold_key')::TEXT <% s.fold_key)
+    AND (sqlc.narg('entity_types')::TEXT[] IS NULL OR s.entity_type = ANY(sqlc.narg('entity_types')::TEXT[]))
+    AND (sqlc.narg('statuses')::TEXT[] IS NULL OR s.status = ANY(sqlc.narg('statuses')::TEXT[]))
+    AND (sqlc.narg('fiscal_year_ids')::TEXT[] IS NULL OR s.fiscal_year_id = ANY(sqlc.narg('fiscal_year_ids')::TEXT[]))
+    AND (sqlc.narg('ward_ids')::TEXT[] IS NULL OR s.ward_id = ANY(sqlc.narg('ward_ids')::TEXT[]))
+    AND (sqlc.narg('channels')::TEXT[] IS NULL OR s.channel = ANY(sqlc.narg('channels')::TEXT[]));
+
+-- name: SearchFacets :many
+-- Counts per facet value over every match of the query, ignoring the facet
+-- filters so that other values stay visible
+WITH matches AS (
+    SELECT s.status, s.fiscal_year_id, s.ward_id, s.channel
+    FROM search_documents s
+    WHERE s.tenant_id = sqlc.arg('tenant_id')
+        AND (s.document @@ to_tsquery('simple', sqlc.arg('query')::TEXT) OR sqlc.arg('fold_key')::TEXT <% s.fold_key)
+        AND (sqlc.narg('entity_types')::TEXT[] IS NULL OR s.entity_type = ANY(sqlc.narg('entity_types')::TEXT[]))
+)
+SELECT 'status'::TEXT AS facet, m.status::TEXT AS value, COUNT(*) AS count FROM matches m GROUP BY m.status
+UNION ALL
+SELECT 'fiscal_year', m.fiscal_year_id, COUNT(*) FROM matches m GROUP BY m.fiscal_year_id
+UNION ALL
+SELECT 'ward', m.ward_id, COUNT(*) FROM matches m WHERE m.ward_id IS NOT NULL GROUP BY m.ward_id
+UNION ALL
+SELECT 'channel', m.channel, COUNT(*) FROM matches m WHERE m.channel IS NOT NULL GROUP BY m.channel
+ORDER BY facet, count DESC, value;
@@ -0,0 +1,176 @@
+-- ============================================================================
+-- SLA ENGINE
+-- ============================================================================
+
+-- name: GetBusinessCalendar :one
+SELECT * FROM business_calendars WHERE tenant_id = $1;
+
+-- name: UpsertBusinessCalendar :one
+INSERT INTO business_calendars (tenant_id, weekly_off, office_start, office_end)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (tenant_id) DO UPDATE SET
+    weekly_off = EXCLUDED.weekly_off,
+    office_start = EXCLUDED.office_start,
+    office_end = EXCLUDED.office_end,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: ListCalendarHolidays :many
+SELECT * FROM calendar_holidays
+WHERE tenant_id = $1
+    AND holiday_date >= sqlc.arg('from_date')::DATE
+    AND holiday_date <= sqlc.arg('to_date')::DATE
+ORDER BY holiday_date;
+
+-- name: UpsertCalendarHoliday :one
+INSERT INTO calendar_holidays (tenant_id, holiday_date, name)
+VALUES ($1, $2, $3)
+ON CONFLICT (tenant_id, holiday_date) DO UPDATE SET name = EXCLUDED.name
+RETURNING *;
+
+-- name: DeleteCalendarHoliday :exec
+DELETE FROM calendar_holidays WHERE tenant_id = $1 AND holiday_date = $2;
+
+-- name: ListSLAPolicies :many
+SELECT * FROM sla_policies
+WHERE tenant_id = $1
+ORDER BY stage, priority NULLS FIRST, classification_code NULLS FIRST;
+
+-- name: UpsertSLAPolicy :one
+INSERT INTO sla_policies (tenant_id, stage, priority, classification_code, target_minutes, escalate_after_minutes)
+VALUES ($1, $2, sqlc.narg('priority'), sqlc.narg('classification_code'), $3, $4)
+ON CONFLICT (tenant_id, stage, (COALESCE(priority, '')), (COALESCE(classification_code, ''))) DO UPDATE SET
+    target_minutes = EXCLUDED.target_minutes,
+    escalate_after_minutes = EXCLUDED.escalate_after_minutes,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteSLAPolicy :exec
+DELETE FROM sla_policies WHERE id = $1 AND tenant_id = $2;
+
+-- name: MatchSLAPolicy :one
+-- The most specific policy for a stage: classification outranks priority,
+-- and either outranks a catch-all
+SELECT * FROM sla_policies
+WHERE tenant_id = $1
+    AND stage = $2
+    AND (priority IS NULL OR priority = sqlc.narg('priority'))
+    AND (classification_code IS NULL OR classification_code = sqlc.narg('classification_code'))
+ORDER BY (classification_code IS NOT NULL) DESC, (priority IS NOT NULL) DESC
+LIMIT 1;
+
+-- name: GetUnitHead :one
+-- The head of a unit, falling back to the tenant's '*' head
+SELECT head_user_id FROM unit_heads
+WHERE tenant_id = $1 AND unit_id IN (sqlc.arg('unit_id')::VARCHAR, '*')
+ORDER BY (unit_id = '*')
+LIMIT 1;
+
+-- name: ListUnitHeads :many
+SELECT * FROM unit_heads WHERE tenant_id = $1 ORDER BY unit_id;
+
+-- name: UpsertUnitHead :one
+INSERT INTO unit_heads (tenant_id, unit_id, head_user_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (tenant_id, unit_id) DO UPDATE SET
+    head_user_id = EXCLUDED.head_user_id,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteUnitHead :exec
+DELETE FROM unit_heads WHERE tenant_id = $1 AND unit_id = $2;
+
+-- name: ListUnsyncedDartaSLASources :many
+-- Dartas changed since the SLA engine last reconciled their clocks
+SELECT id, tenant_id, status, priority, classification_code, assigned_to_unit_id, sla_target_minutes, updated_at
+FROM dartas
+WHERE sla_synced_at IS DISTINCT FROM updated_at
+ORDER BY updated_at
+LIMIT sqlc.arg('limit');
+
+-- name: ListUnsyncedChalaniSLASources :many
+SELECT id, tenant_id, status, updated_at
+FROM chalanis
+WHERE sla_synced_at IS DISTINCT FROM updated_at
+ORDER BY updated_at
+LIMIT sqlc.arg('limit');
+
+-- name: RefreshDartaSLAState :exec
+-- Derives sla_deadline and is_overdue from the darta's open clocks. Leaves
+-- updated_at alone so the refresh does not look like a change.
+UPDATE dartas d
+SET
+    sla_deadline = (
+        SELECT MIN(c.deadline) FROM sla_clocks c
+        WHERE c.entity_type = 'DARTA' AND c.entity_id = d.id AND c.stopped_at IS NULL
+    ),
+    is_overdue = EXISTS (
+        SELECT 1 FROM sla_clocks c
+        WHERE c.entity_type = 'DARTA' AND c.entity_id = d.id
+            AND c.stopped_at IS NULL AND c.breached_at IS NOT NULL
+    ),
+    sla_synced_at = COALESCE(sqlc.narg('synced_at'), d.sla_synced_at)
+WHERE d.id = $1;
+
+-- name: MarkChalaniSLASynced :exec
+UPDATE chalanis SET sla_synced_at = $2 WHERE id = $1;
+
+-- name: ListOpenSLAClocks :many
+SELECT * FROM sla_clocks
+WHERE entity_type = $1 AND entity_id = $2 AND stopped_at IS NULL;
+
+-- name: ListSLAClocks :many
+SELECT * FROM sla_clocks
+WHERE entity_type = $1 AND entity_id = $2 AND tenant_id = $3
+ORDER BY started_at, stage;
+
+-- name: CreateSLAClock :one
+INSERT INTO sla_clocks (
+    tenant_id, entity_type, entity_id, stage, policy_id,
+    target_minutes, escalate_after_minutes, started_at, resumed_at,
+    deadline, escalate_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $8, $9, $10
+)
+ON CONFLICT (entity_type, entity_id, stage) WHERE stopped_at IS NULL DO NOTHING
+RETURNING *;
+
+-- name: UpdateSLAClock :one
+UPDATE sla_clocks
+SET
+    policy_id = $2,
+    target_minutes = $3,
+    escalate_after_minutes = $4,
+    elapsed_minutes = $5,
+    resumed_at = $6,
+    paused_at = $7,
+    stopped_at = $8,
+    deadline = $9,
+    escalate_at = $10,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: ListDueSLAClocks :many
+-- Running clocks past their deadline and not yet marked breached, or past
+-- escalate_at and not yet escalated
+SELECT * FROM sla_clocks
+WHERE stopped_at IS NULL
+    AND (
+        (breached_at IS NULL AND deadline <= sqlc.arg('now')::TIMESTAMPTZ)
+        OR (escalated_at IS NULL AND escalate_at <= sqlc.arg('now')::TIMESTAMPTZ)
+    )
+ORDER BY deadline
+LIMIT sqlc.arg('limit');
+
+-- name: MarkSLAClockBreached :one
+UPDATE sla_clocks
+SET breached_at = $2, updated_at = NOW()
+WHERE id = $1 AND breached_at IS NULL
+RETURNING *;
+
+-- name: MarkSLAClockEscalated :one
+UPDATE sla_clocks
+SET escalated_at = $2, escalated_to = sqlc.narg('escalated_to'), updated_at = NOW()
+WHERE id = $1 AND escalated_at IS NULL
+RETURNING *;
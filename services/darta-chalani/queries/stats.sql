@@ -0,0 +1,115 @@
+-- ============================================================================
+-- DARTA STATISTICS
+-- ============================================================================
+--
+-- Times in state come from the audit trail: a darta is registered and closed
+-- when a STATUS_CHANGED entry moves it there, and it is with a section from
+-- an ASSIGNED entry until the next assignment, response or close.
+
+-- name: GetDartaStatsSummary :many
+-- One row for all matching dartas, then one per ward, section, channel and
+-- priority. Durations are elapsed hours.
+WITH lifecycle AS (
+    SELECT
+        d.ward_id,
+        d.assigned_to_unit_id,
+        d.intake_channel,
+        d.priority,
+        d.is_overdue,
+        d.received_date,
+        (SELECT MIN(a.performed_at) FROM audit_trail a
+         WHERE a.entity_type = 'DARTA' AND a.entity_id = d.id AND a.action = 'STATUS_CHANGED'
+           AND a.changes->'status'->>'to' = 'REGISTERED') AS registered_at,
+        (SELECT MIN(a.performed_at) FROM audit_trail a
+         WHERE a.entity_type = 'DARTA' AND a.entity_id = d.id AND a.action = 'STATUS_CHANGED'
+           AND a.changes->'status'->>'to' = 'CLOSED') AS closed_at
+    FROM dartas d
+    WHERE d.tenant_id = sqlc.arg('tenant_id')
+      AND (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR d.fiscal_year_id = sqlc.narg('fiscal_year_id'))
+      AND (sqlc.narg('scope')::VARCHAR IS NULL OR d.scope = sqlc.narg('scope'))
+      AND (sqlc.narg('ward_id')::VARCHAR IS NULL OR d.ward_id = sqlc.narg('ward_id'))
+      AND (sqlc.narg('received_from')::TIMESTAMPTZ IS NULL OR d.received_date >= sqlc.narg('received_from'))
+      AND (sqlc.narg('received_to')::TIMESTAMPTZ IS NULL OR d.received_date < sqlc.narg('received_to'))
+)
+SELECT
+    (CASE
+        WHEN GROUPING(ward_id) = 0 THEN 'WARD'
+        WHEN GROUPING(assigned_to_unit_id) = 0 THEN 'SECTION'
+        WHEN GROUPING(intake_channel) = 0 THEN 'CHANNEL'
+        WHEN GROUPING(priority) = 0 THEN 'PRIORITY'
+        ELSE ''
+    END)::TEXT AS dimension,
+    COALESCE(ward_id, assigned_to_unit_id, intake_channel, priority, '')::TEXT AS key,
+    COUNT(*)::INT AS total,
+    (COUNT(*) FILTER (WHERE is_overdue))::INT AS overdue_count,
+    COUNT(registered_at)::INT AS registered_count,
+    COALESCE(AVG(EXTRACT(EPOCH FROM registered_at - received_date)) / 3600, 0)::FLOAT8 AS registration_avg_hours,
+    COALESCE(percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM registered_at - received_date)) / 3600, 0)::FLOAT8 AS registration_p90_hours,
+    COUNT(closed_at - registered_at)::INT AS closure_count,
+    COALESCE(AVG(EXTRACT(EPOCH FROM closed_at - registered_at)) / 3600, 0)::FLOAT8 AS closure_avg_hours,
+    COALESCE(percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM closed_at - registered_at)) / 3600, 0)::FLOAT8 AS closure_p90_hours,
+    COALESCE(AVG(EXTRACT(EPOCH FROM closed_at - received_date)) / 3600, 0)::FLOAT8 AS processing_avg_hours
+FROM lifecycle
+GROUP BY GROUPING SETS ((), (ward_id), (assigned_to_unit_id), (intake_channel), (priority));
+
+-- name: GetSectionTurnaround :many
+-- How long dartas stay with each section they are assigned to. Spans still
+-- open are left out.
+WITH scoped AS (
+    SELECT d.id FROM dartas d
+    WHERE d.tenant_id = sqlc.arg('tenant_id')
+      AND (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR d.fiscal_year_id = sqlc.narg('fiscal_year_id'))
+      AND (sqlc.narg('scope')::VARCHAR IS NULL OR d.scope = sqlc.narg('scope'))
+      AND (sqlc.narg('ward_id')::VARCHAR IS NULL OR d.ward_id = sqlc.narg('ward_id'))
+      AND (sqlc.narg('received_from')::TIMESTAMPTZ IS NULL OR d.received_date >= sqlc.narg('received_from'))
+      AND (sqlc.narg('received_to')::TIMESTAMPTZ IS NULL OR d.received_date < sqlc.narg('received_to'))
+), spans AS (
+    SELECT
+        CASE WHEN a.action = 'ASSIGNED' THEN a.changes->>'assigned_to_unit' END AS section_id,
+        a.performed_at AS started_at,
+        LEAD(a.performed_at) OVER (PARTITION BY a.entity_id ORDER BY a.performed_at) AS ended_at
+    FROM audit_trail a
+    JOIN scoped s ON s.id = a.entity_id
+    WHERE a.entity_type = 'DARTA'
+      AND a.category = 'ACTIVITY'
+      AND (a.action = 'ASSIGNED'
+           OR (a.action = 'STATUS_CHANGED' AND a.changes->'status'->>'to' IN ('RESPONSE_ISSUED', 'CLOSED', 'VOIDED')))
+)
+SELECT
+    section_id::TEXT AS section_id,
+    COUNT(*)::INT AS span_count,
+    AVG(EXTRACT(EPOCH FROM ended_at - started_at) / 3600)::FLOAT8 AS avg_hours,
+    (percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM ended_at - started_at)) / 3600)::FLOAT8 AS p90_hours
+FROM spans
+WHERE section_id IS NOT NULL AND ended_at IS NOT NULL
+GROUP BY section_id
+ORDER BY section_id;
+
+-- name: ListDartaDailyActivity :many
+-- Dartas received, registered and closed per Nepal day in [from, to)
+WITH scoped AS (
+    SELECT d.id, d.received_date FROM dartas d
+    WHERE d.tenant_id = sqlc.arg('tenant_id')
+      AND (sqlc.narg('fiscal_year_id')::VARCHAR IS NULL OR d.fiscal_year_id = sqlc.narg('fiscal_year_id'))
+      AND (sqlc.narg('scope')::VARCHAR IS NULL OR d.scope = sqlc.narg('scope'))
+      AND (sqlc.narg('ward_id')::VARCHAR IS NULL OR d.ward_id = sqlc.narg('ward_id'))
+), events AS (
+    SELECT s.received_date AS event_at, 'RECEIVED'::TEXT AS kind FROM scoped s
+    WHERE s.received_date >= sqlc.arg('from_time')::TIMESTAMPTZ AND s.received_date < sqlc.arg('to_time')::TIMESTAMPTZ
+    UNION ALL
+    SELECT a.performed_at, a.changes->'status'->>'to'
+    FROM audit_trail a
+    JOIN scoped s ON s.id = a.entity_id
+    WHERE a.entity_type = 'DARTA'
+      AND a.action = 'STATUS_CHANGED'
+      AND a.changes->'status'->>'to' IN ('REGISTERED', 'CLOSED')
+      AND a.performed_at >= sqlc.arg('from_time')::TIMESTAMPTZ AND a.performed_at < sqlc.arg('to_time')::TIMESTAMPTZ
+)
+SELECT
+    (event_at AT TIME ZONE 'Asia/Kathmandu')::DATE AS day,
+    (COUNT(*) FILTER (WHERE kind = 'RECEIVED'))::INT AS received,
+    (COUNT(*) FILTER (WHERE kind = 'REGISTERED'))::INT AS registered,
+    (COUNT(*) FILTER (WHERE kind = 'CLOSED'))::INT AS closed
+FROM events
+GROUP BY 1
+ORDER BY 1;
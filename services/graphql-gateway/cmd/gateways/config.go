@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// graphQLConfig holds the GraphQL server settings read from the environment
+type graphQLConfig struct {
+	ComplexityLimit int
+	DepthLimit      int
+
+	// Introspection and Playground are development aids, off unless enabled
+	Introspection bool
+	Playground    bool
+
+	// APQRedisURL selects a Redis-compatible APQ cache shared by all
+	// replicas; empty uses an in-memory cache per replica
+	APQRedisURL string
+	APQCacheTTL time.Duration
+
+	// PersistedOnly rejects every query that is not in PersistedManifest
+	PersistedOnly     bool
+	PersistedManifest string
+}
+
+func loadGraphQLConfig() (graphQLConfig, error) {
+	var cfg graphQLConfig
+	var err error
+
+	if cfg.ComplexityLimit, err = envInt("GRAPHQL_COMPLEXITY_LIMIT", 10000); err != nil {
+		return cfg, err
+	}
+	if cfg.DepthLimit, err = envInt("GRAPHQL_DEPTH_LIMIT", 12); err != nil {
+		return cfg, err
+	}
+	if cfg.Introspection, err = envBool("GRAPHQL_INTROSPECTION", false); err != nil {
+		return cfg, err
+	}
+	if cfg.Playground, err = envBool("GRAPHQL_PLAYGROUND", false); err != nil {
+		return cfg, err
+	}
+	if cfg.PersistedOnly, err = envBool("GRAPHQL_PERSISTED_ONLY", false); err != nil {
+		return cfg, err
+	}
+
+	cfg.APQRedisURL = os.Getenv("GRAPHQL_APQ_REDIS_URL")
+	cfg.APQCacheTTL = 24 * time.Hour
+	if v := os.Getenv("GRAPHQL_APQ_CACHE_TTL"); v != "" {
+		if cfg.APQCacheTTL, err = time.ParseDuration(v); err != nil {
+			return cfg, fmt.Errorf("GRAPHQL_APQ_CACHE_TTL: %w", err)
+		}
+	}
+
+	cfg.PersistedManifest = os.Getenv("GRAPHQL_PERSISTED_MANIFEST")
+	if cfg.PersistedOnly && cfg.PersistedManifest == "" {
+		return cfg, fmt.Errorf("GRAPHQL_PERSISTED_ONLY requires GRAPHQL_PERSISTED_MANIFEST")
+	}
+
+	return cfg, nil
+}
+
+func envInt(key string, fallback int) (int, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", key, err)
+	}
+	return n, nil
+}
+
+func envBool(key string, fallback bool) (bool, error) {
+	v := strings.TrimSpace(os.Getenv(key))
+	if v == "" {
+		return fallback, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", key, err)
+	}
+	return b, nil
+}
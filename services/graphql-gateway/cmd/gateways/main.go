@@ -10,8 +10,8 @@ import (
 	"time"
 
 	"git.ninjainfosys.com/ePalika/graphql-gateway/graph"
+	"git.ninjainfosys.com/ePalika/graphql-gateway/internal/auth"
 	"git.ninjainfosys.com/ePalika/graphql-gateway/internal/clients"
-	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
 )
 
@@ -41,12 +41,23 @@ func main() {
 		pdpAddr = "localhost:9100"
 	}
 
+	gqlConfig, err := loadGraphQLConfig()
+	if err != nil {
+		log.Fatalf("invalid graphql configuration: %v", err)
+	}
+
 	dartaClient, err := clients.NewDartaClient(ctx, dartaAddr)
 	if err != nil {
 		log.Fatalf("failed to create darta client: %v", err)
 	}
 	defer dartaClient.Close()
 
+	chalaniClient, err := clients.NewChalaniClient(ctx, dartaAddr)
+	if err != nil {
+		log.Fatalf("failed to create chalani client: %v", err)
+	}
+	defer chalaniClient.Close()
+
 	identityClient, err := clients.NewIdentityClient(ctx, identityAddr)
 	if err != nil {
 		log.Fatalf("failed to create identity client: %v", err)
@@ -59,12 +70,18 @@ func main() {
 	}
 	defer pdpClient.Close()
 
-	resolver := graph.NewResolver(dartaClient, identityClient, pdpClient)
+	resolver := graph.NewResolver(dartaClient, chalaniClient, identityClient, pdpClient)
 
-	srv := handler.NewDefaultServer(graph.NewExecutableSchema(graph.Config{Resolvers: resolver}))
+	srv, closeCache, err := newGraphQLServer(ctx, resolver, gqlConfig)
+	if err != nil {
+		log.Fatalf("failed to create graphql server: %v", err)
+	}
+	defer closeCache()
 
-	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
-	http.Handle("/query", srv)
+	if gqlConfig.Playground {
+		http.Handle("/", playground.Handler("GraphQL playground", "/query"))
+	}
+	http.Handle("/query", auth.Middleware(srv))
 
 	http.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -90,7 +107,9 @@ func main() {
 	}()
 
 	log.Printf("GraphQL Gateway listening on :%s", port)
-	log.Printf("GraphQL Playground available at http://localhost:%s/", port)
+	if gqlConfig.Playground {
+		log.Printf("GraphQL Playground available at http://localhost:%s/", port)
+	}
 	log.Printf("GraphQL endpoint at http://localhost:%s/query", port)
 	if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 		log.Fatalf("failed to start server: %v", err)
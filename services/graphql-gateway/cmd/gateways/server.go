@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"git.ninjainfosys.com/ePalika/graphql-gateway/graph"
+	"git.ninjainfosys.com/ePalika/graphql-gateway/internal/limits"
+	"git.ninjainfosys.com/ePalika/graphql-gateway/internal/persisted"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gorilla/websocket"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// newGraphQLServer builds the GraphQL handler. Subscriptions are served over
+// WebSocket on the same endpoint using the graphql-ws protocols. The returned
+// function releases the APQ cache connection.
+func newGraphQLServer(ctx context.Context, resolver *graph.Resolver, cfg graphQLConfig) (*handler.Server, func(), error) {
+	srv := handler.New(graph.NewExecutableSchema(graph.Config{
+		Resolvers:  resolver,
+		Directives: graph.NewDirectives(resolver),
+		Complexity: graph.NewComplexity(),
+	}))
+
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: originChecker(os.Getenv("GRAPHQL_WS_ALLOWED_ORIGINS")),
+		},
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+
+	if cfg.Introspection {
+		srv.Use(extension.Introspection{})
+	}
+	if cfg.ComplexityLimit > 0 {
+		srv.Use(extension.FixedComplexityLimit(cfg.ComplexityLimit))
+	}
+	srv.Use(limits.DepthLimit{MaxDepth: cfg.DepthLimit})
+
+	var manifest *persisted.Manifest
+	if cfg.PersistedManifest != "" {
+		var err error
+		if manifest, err = persisted.LoadManifest(cfg.PersistedManifest); err != nil {
+			return nil, nil, err
+		}
+		log.Printf("loaded %d persisted queries", manifest.Len())
+	}
+
+	closeCache := func() {}
+	if cfg.PersistedOnly {
+		srv.Use(persisted.Allowlist{Manifest: manifest})
+	} else {
+		var cache graphql.Cache[string] = lru.New[string](1000)
+		if cfg.APQRedisURL != "" {
+			redisCache, err := persisted.NewRedisCache(ctx, cfg.APQRedisURL, "graphql:apq:", cfg.APQCacheTTL)
+			if err != nil {
+				return nil, nil, err
+			}
+			cache = redisCache
+			closeCache = func() { redisCache.Close() }
+		}
+		if manifest != nil {
+			cache = persisted.ManifestCache{Manifest: manifest, Next: cache}
+		}
+		srv.Use(extension.AutomaticPersistedQuery{Cache: cache})
+	}
+
+	srv.SetErrorPresenter(graph.ErrorPresenter)
+
+	// Fresh DataLoaders and authorization decisions for every response,
+	// including each subscription event
+	srv.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		return next(graph.WithAuthzCache(graph.WithLoaders(ctx, resolver)))
+	})
+
+	return srv, closeCache, nil
+}
+
+// originChecker accepts WebSocket upgrades from the comma-separated origins
+// in allowed. "*" accepts any origin; an empty list accepts same-origin only.
+func originChecker(allowed string) func(r *http.Request) bool {
+	origins := map[string]bool{}
+	for _, o := range strings.Split(allowed, ",") {
+		if o = strings.TrimSpace(o); o != "" {
+			origins[strings.TrimSuffix(o, "/")] = true
+		}
+	}
+
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" || origins["*"] || origins[origin] {
+			return true
+		}
+		u, err := url.Parse(origin)
+		return err == nil && strings.EqualFold(u.Host, r.Host)
+	}
+}
@@ -0,0 +1,110 @@
+package graph
+
+import (
+	"git.ninjainfosys.com/ePalika/graphql-gateway/graph/model"
+)
+
+// Field cost weights used by the complexity limit. Fields not listed cost 1
+// plus their children. Lists are charged per expected element, and fields that
+// need a backend call are charged backendCallCost on top.
+const (
+	backendCallCost       = 2
+	defaultPageSize       = 10
+	defaultSearchPageSize = 20
+	expectedAttachments   = 5
+	expectedRelatedDartas = 5
+	expectedDuplicates    = 3
+	expectedTimeline      = 30
+	expectedSupersessions = 3
+	subscriptionCost      = 10
+)
+
+// NewComplexity returns the per-field cost functions for the schema
+func NewComplexity() ComplexityRoot {
+	var c ComplexityRoot
+
+	c.Query.Darta = func(childComplexity int, id string) int {
+		return backendCallCost + childComplexity
+	}
+	c.Query.DartaByNumber = func(childComplexity int, dartaNumber int, fiscalYearID string, scope model.Scope, wardID *string) int {
+		return backendCallCost + childComplexity
+	}
+	c.Query.Dartas = func(childComplexity int, filter *model.DartaFilterInput, pagination *model.PaginationInput) int {
+		return backendCallCost + pageSize(pagination)*childComplexity
+	}
+	c.Query.MyDartas = func(childComplexity int, status *model.DartaStatus, pagination *model.PaginationInput) int {
+		return backendCallCost + pageSize(pagination)*childComplexity
+	}
+	c.Query.Chalani = func(childComplexity int, id string) int {
+		return backendCallCost + childComplexity
+	}
+	c.Query.AuditEntries = func(childComplexity int, filter *model.AuditEntryFilterInput, pagination *model.PaginationInput) int {
+		return backendCallCost + pageSize(pagination)*childComplexity
+	}
+	c.Query.Search = func(childComplexity int, query string, entityTypes []model.SearchEntityType, filter *model.SearchFilterInput, first *int, after *string) int {
+		if first == nil || *first <= 0 {
+			return backendCallCost + defaultSearchPageSize*childComplexity
+		}
+		return backendCallCost + *first*childComplexity
+	}
+	c.SearchHit.Darta = func(childComplexity int) int {
+		return backendCallCost + childComplexity
+	}
+
+	c.Darta.Applicant = func(childComplexity int) int {
+		return backendCallCost + childComplexity
+	}
+	c.Darta.CreatedBy = func(childComplexity int) int {
+		return backendCallCost + childComplexity
+	}
+	c.Darta.Assignee = func(childComplexity int) int {
+		return backendCallCost + childComplexity
+	}
+	c.Darta.Attachments = func(childComplexity int) int {
+		return backendCallCost + expectedAttachments*childComplexity
+	}
+	c.Darta.RelatedDartas = func(childComplexity int) int {
+		return backendCallCost + expectedRelatedDartas*childComplexity
+	}
+	c.Darta.SuspectedDuplicates = func(childComplexity int) int {
+		return backendCallCost + expectedDuplicates*childComplexity
+	}
+	c.Darta.AuditTrail = func(childComplexity int) int {
+		return backendCallCost + auditEntriesPerDarta*childComplexity
+	}
+	c.Darta.Supersedes = func(childComplexity int) int {
+		return backendCallCost + childComplexity
+	}
+	c.Darta.SupersededBy = func(childComplexity int) int {
+		return backendCallCost + childComplexity
+	}
+	c.Darta.SupersessionChain = func(childComplexity int) int {
+		return backendCallCost + expectedSupersessions*childComplexity
+	}
+	c.Darta.Timeline = func(childComplexity int) int {
+		return backendCallCost + expectedTimeline*childComplexity
+	}
+	c.Chalani.Timeline = func(childComplexity int) int {
+		return backendCallCost + expectedTimeline*childComplexity
+	}
+
+	c.Subscription.DartaUpdated = func(childComplexity int, id string) int {
+		return subscriptionCost + childComplexity
+	}
+	c.Subscription.MyQueueChanged = func(childComplexity int) int {
+		return subscriptionCost + childComplexity
+	}
+	c.Subscription.ChalaniDispatchUpdated = func(childComplexity int) int {
+		return subscriptionCost + childComplexity
+	}
+
+	return c
+}
+
+// pageSize is the number of rows a paginated field will return
+func pageSize(p *model.PaginationInput) int {
+	if p == nil || p.Limit == nil || *p.Limit <= 0 {
+		return defaultPageSize
+	}
+	return *p.Limit
+}
@@ -1,8 +1,15 @@
 package graph
 
 import (
-	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
 	"git.ninjainfosys.com/ePalika/graphql-gateway/graph/model"
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	identityv1 "git.ninjainfosys.com/ePalika/proto/gen/identity/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // GraphQL to Proto enum converters
@@ -75,7 +82,7 @@ func dartaStatusPtrToProto(ds *model.DartaStatus) dartav1.DartaStatus {
 	if ds == nil {
 		return dartav1.DartaStatus_DARTA_STATUS_UNSPECIFIED
 	}
-	
+
 	switch *ds {
 	case model.DartaStatusDraft:
 		return dartav1.DartaStatus_DARTA_STATUS_DRAFT
@@ -91,6 +98,28 @@ func dartaStatusPtrToProto(ds *model.DartaStatus) dartav1.DartaStatus {
 		return dartav1.DartaStatus_DARTA_STATUS_VOIDED
 	case model.DartaStatusAssigned:
 		return dartav1.DartaStatus_DARTA_STATUS_ASSIGNED
+	case model.DartaStatusScanned:
+		return dartav1.DartaStatus_DARTA_STATUS_SCANNED
+	case model.DartaStatusMetadataEnriched:
+		return dartav1.DartaStatus_DARTA_STATUS_METADATA_ENRICHED
+	case model.DartaStatusDigitallyArchived:
+		return dartav1.DartaStatus_DARTA_STATUS_DIGITALLY_ARCHIVED
+	case model.DartaStatusInReviewBySection:
+		return dartav1.DartaStatus_DARTA_STATUS_IN_REVIEW_BY_SECTION
+	case model.DartaStatusNeedsClarification:
+		return dartav1.DartaStatus_DARTA_STATUS_NEEDS_CLARIFICATION
+	case model.DartaStatusAccepted:
+		return dartav1.DartaStatus_DARTA_STATUS_ACCEPTED
+	case model.DartaStatusActionTaken:
+		return dartav1.DartaStatus_DARTA_STATUS_ACTION_TAKEN
+	case model.DartaStatusResponseIssued:
+		return dartav1.DartaStatus_DARTA_STATUS_RESPONSE_ISSUED
+	case model.DartaStatusAckRequested:
+		return dartav1.DartaStatus_DARTA_STATUS_ACK_REQUESTED
+	case model.DartaStatusAckReceived:
+		return dartav1.DartaStatus_DARTA_STATUS_ACK_RECEIVED
+	case model.DartaStatusSuperseded:
+		return dartav1.DartaStatus_DARTA_STATUS_SUPERSEDED
 	case model.DartaStatusClosed:
 		return dartav1.DartaStatus_DARTA_STATUS_CLOSED
 	default:
@@ -98,6 +127,17 @@ func dartaStatusPtrToProto(ds *model.DartaStatus) dartav1.DartaStatus {
 	}
 }
 
+func reviewDecisionToProto(d model.DartaReviewDecision) dartav1.DartaReviewDecision {
+	switch d {
+	case model.DartaReviewDecisionApproveReview:
+		return dartav1.DartaReviewDecision_DARTA_REVIEW_DECISION_APPROVE_REVIEW
+	case model.DartaReviewDecisionEditRequired:
+		return dartav1.DartaReviewDecision_DARTA_REVIEW_DECISION_EDIT_REQUIRED
+	default:
+		return dartav1.DartaReviewDecision_DARTA_REVIEW_DECISION_UNSPECIFIED
+	}
+}
+
 func applicantTypeToProto(at model.ApplicantType) dartav1.ApplicantType {
 	switch at {
 	case model.ApplicantTypeCitizen:
@@ -174,6 +214,28 @@ func protoToDartaStatus(ds dartav1.DartaStatus) model.DartaStatus {
 		return model.DartaStatusVoided
 	case dartav1.DartaStatus_DARTA_STATUS_ASSIGNED:
 		return model.DartaStatusAssigned
+	case dartav1.DartaStatus_DARTA_STATUS_SCANNED:
+		return model.DartaStatusScanned
+	case dartav1.DartaStatus_DARTA_STATUS_METADATA_ENRICHED:
+		return model.DartaStatusMetadataEnriched
+	case dartav1.DartaStatus_DARTA_STATUS_DIGITALLY_ARCHIVED:
+		return model.DartaStatusDigitallyArchived
+	case dartav1.DartaStatus_DARTA_STATUS_IN_REVIEW_BY_SECTION:
+		return model.DartaStatusInReviewBySection
+	case dartav1.DartaStatus_DARTA_STATUS_NEEDS_CLARIFICATION:
+		return model.DartaStatusNeedsClarification
+	case dartav1.DartaStatus_DARTA_STATUS_ACCEPTED:
+		return model.DartaStatusAccepted
+	case dartav1.DartaStatus_DARTA_STATUS_ACTION_TAKEN:
+		return model.DartaStatusActionTaken
+	case dartav1.DartaStatus_DARTA_STATUS_RESPONSE_ISSUED:
+		return model.DartaStatusResponseIssued
+	case dartav1.DartaStatus_DARTA_STATUS_ACK_REQUESTED:
+		return model.DartaStatusAckRequested
+	case dartav1.DartaStatus_DARTA_STATUS_ACK_RECEIVED:
+		return model.DartaStatusAckReceived
+	case dartav1.DartaStatus_DARTA_STATUS_SUPERSEDED:
+		return model.DartaStatusSuperseded
 	case dartav1.DartaStatus_DARTA_STATUS_CLOSED:
 		return model.DartaStatusClosed
 	default:
@@ -195,3 +257,526 @@ func protoToApplicantType(at dartav1.ApplicantType) model.ApplicantType {
 		return model.ApplicantTypeCitizen
 	}
 }
+
+// Helper functions for conversion
+func protoToDarta(d *dartav1.Darta) *model.Darta {
+	if d == nil {
+		return nil
+	}
+
+	darta := &model.Darta{
+		ID:            d.Id,
+		FiscalYearID:  d.FiscalYear.Id,
+		Scope:         protoToScope(d.Scope),
+		Subject:       d.Subject,
+		IntakeChannel: protoToIntakeChannel(d.IntakeChannel),
+		ReceivedDate:  d.ReceivedDate.AsTime().Format("2006-01-02T15:04:05Z07:00"),
+		EntryDate:     d.EntryDate.AsTime().Format("2006-01-02T15:04:05Z07:00"),
+		Status:        protoToDartaStatus(d.Status),
+		Priority:      protoToPriority(d.Priority),
+		CreatedByID:   d.CreatedBy.GetId(),
+		AssigneeID:    d.CurrentAssignee.GetId(),
+		CreatedAt:     d.CreatedAt.AsTime().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:     d.UpdatedAt.AsTime().Format("2006-01-02T15:04:05Z07:00"),
+		TenantID:      d.TenantId,
+	}
+
+	if d.DartaNumber > 0 {
+		num := int(d.DartaNumber)
+		darta.DartaNumber = &num
+	}
+	if d.FormattedDartaNumber != "" {
+		darta.FormattedDartaNumber = &d.FormattedDartaNumber
+	}
+	if d.Ward != nil {
+		darta.WardID = &d.Ward.Id
+	}
+	if d.SupersedesDartaNumber != "" {
+		darta.SupersedesDartaNumber = &d.SupersedesDartaNumber
+	}
+	darta.SupersedesID = d.SupersedesId
+	darta.SupersededByID = d.SupersededById
+	darta.Version = int(d.Version)
+	if d.Metadata != nil {
+		darta.Metadata = d.Metadata.AsMap()
+	}
+	if d.PrimaryDocument != nil {
+		darta.PrimaryDocumentID = d.PrimaryDocument.Id
+	}
+	if d.Applicant != nil {
+		darta.ApplicantID = d.Applicant.Id
+		// Some responses carry only the applicant ID; the rest is loaded on demand
+		if d.Applicant.FullName != "" {
+			darta.LoadedApplicant = protoToApplicant(d.Applicant)
+		}
+	}
+
+	return darta
+}
+
+func protoToApplicant(a *dartav1.Applicant) *model.Applicant {
+	return &model.Applicant{
+		ID:           a.Id,
+		Type:         protoToApplicantType(a.Type),
+		FullName:     a.FullName,
+		Organization: optionalString(a.Organization),
+		Email:        optionalString(a.Email),
+		Phone:        optionalString(a.Phone),
+		Address:      optionalString(a.Address),
+	}
+}
+
+func protoToAttachment(a *dartav1.Attachment, isPrimary bool) *model.Attachment {
+	return &model.Attachment{
+		ID:               a.Id,
+		Filename:         a.Filename,
+		OriginalFilename: a.OriginalFilename,
+		MimeType:         a.MimeType,
+		SizeBytes:        int(a.SizeBytes),
+		UploadedBy:       a.UploadedBy,
+		UploadedAt:       a.UploadedAt.AsTime().Format("2006-01-02T15:04:05Z07:00"),
+		IsPrimary:        isPrimary,
+	}
+}
+
+func protoToAuditEntry(e *dartav1.AuditEntry, performedBy *model.User) *model.AuditEntry {
+	entry := &model.AuditEntry{
+		ID:          e.Id,
+		EntityType:  e.EntityType,
+		EntityID:    e.EntityId,
+		Action:      e.Action,
+		PerformedBy: performedBy,
+		PerformedAt: e.PerformedAt.AsTime().Format("2006-01-02T15:04:05Z07:00"),
+		Notes:       optionalString(e.Notes),
+		Diff:        make([]*model.AuditFieldChange, len(e.Diff)),
+		RequestID:   optionalString(e.RequestId),
+		TraceID:     optionalString(e.TraceId),
+		ActingRole:  optionalString(e.ActingRole),
+		DecisionID:  optionalString(e.DecisionId),
+	}
+	if e.Changes != nil {
+		entry.Changes = e.Changes.AsMap()
+	}
+	for i, c := range e.Diff {
+		change := &model.AuditFieldChange{Field: c.Field}
+		if c.Before != nil {
+			change.Before = c.Before.AsInterface()
+		}
+		if c.After != nil {
+			change.After = c.After.AsInterface()
+		}
+		entry.Diff[i] = change
+	}
+	return entry
+}
+
+// identityToUser converts an identity user, falling back to a bare ID when
+// the user could not be resolved
+func identityToUser(id string, u *identityv1.User) *model.User {
+	if u == nil {
+		return &model.User{ID: id}
+	}
+	return &model.User{
+		ID:       id,
+		Username: optionalString(u.Username),
+		FullName: optionalString(u.FullName),
+		Email:    optionalString(u.Email),
+	}
+}
+
+// optionalString returns nil for an empty string
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func protoToDartaEvent(ev *dartav1.DartaEvent) *model.DartaEvent {
+	event := &model.DartaEvent{
+		Action:     ev.Action,
+		Darta:      protoToDarta(ev.Darta),
+		ActorID:    ev.ActorId,
+		OccurredAt: ev.OccurredAt.AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if ev.PreviousAssigneeId != "" {
+		event.PreviousAssigneeID = &ev.PreviousAssigneeId
+	}
+	event.SLAStage = optionalString(ev.SlaStage)
+	event.EscalatedToID = optionalString(ev.EscalatedToId)
+	return event
+}
+
+func protoToChalaniStatus(cs dartav1.ChalaniStatus) model.ChalaniStatus {
+	status := model.ChalaniStatus(strings.TrimPrefix(cs.String(), "CHALANI_STATUS_"))
+	if !status.IsValid() {
+		return model.ChalaniStatusDraft
+	}
+	return status
+}
+
+func protoToChalani(c *dartav1.Chalani) *model.Chalani {
+	if c == nil {
+		return nil
+	}
+	chalani := &model.Chalani{
+		ID:                     c.Id,
+		FormattedChalaniNumber: optionalString(c.FormattedChalaniNumber),
+		FiscalYearID:           c.GetFiscalYear().GetId(),
+		Scope:                  protoToScope(c.Scope),
+		WardID:                 optionalString(c.GetWard().GetId()),
+		Subject:                c.Subject,
+		Body:                   c.Body,
+		Status:                 protoToChalaniStatus(c.Status),
+		CreatedAt:              c.GetCreatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:              c.GetUpdatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if c.ChalaniNumber > 0 {
+		n := int(c.ChalaniNumber)
+		chalani.ChalaniNumber = &n
+	}
+	if c.DispatchChannel != dartav1.DispatchChannel_DISPATCH_CHANNEL_UNSPECIFIED {
+		channel := strings.TrimPrefix(c.DispatchChannel.String(), "DISPATCH_CHANNEL_")
+		chalani.DispatchChannel = &channel
+	}
+	if c.DispatchedAt != nil {
+		t := c.DispatchedAt.AsTime().Format("2006-01-02T15:04:05Z07:00")
+		chalani.DispatchedAt = &t
+	}
+	if c.DeliveredAt != nil {
+		t := c.DeliveredAt.AsTime().Format("2006-01-02T15:04:05Z07:00")
+		chalani.DeliveredAt = &t
+	}
+	return chalani
+}
+
+func protoToChalaniDispatchEvent(ev *dartav1.ChalaniEvent) *model.ChalaniDispatchEvent {
+	c := ev.Chalani
+	event := &model.ChalaniDispatchEvent{
+		Action:     ev.Action,
+		ChalaniID:  c.GetId(),
+		Subject:    c.GetSubject(),
+		Status:     protoToChalaniStatus(c.GetStatus()),
+		ActorID:    ev.ActorId,
+		OccurredAt: ev.OccurredAt.AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if c.GetFormattedChalaniNumber() != "" {
+		n := c.GetFormattedChalaniNumber()
+		event.FormattedChalaniNumber = &n
+	}
+	if c.GetTrackingId() != "" {
+		id := c.GetTrackingId()
+		event.TrackingID = &id
+	}
+	if c.GetDispatchedAt() != nil {
+		t := c.GetDispatchedAt().AsTime().Format("2006-01-02T15:04:05Z07:00")
+		event.DispatchedAt = &t
+	}
+	if c.GetDeliveredAt() != nil {
+		t := c.GetDeliveredAt().AsTime().Format("2006-01-02T15:04:05Z07:00")
+		event.DeliveredAt = &t
+	}
+	return event
+}
+
+// parseTimestamp accepts an RFC 3339 timestamp or a plain date. An empty
+// string means now.
+func parseTimestamp(s string) (*timestamppb.Timestamp, error) {
+	if s == "" {
+		return timestamppb.Now(), nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return timestamppb.New(t), nil
+		}
+	}
+	return nil, fmt.Errorf("must be an RFC 3339 timestamp or YYYY-MM-DD date")
+}
+
+// nepalTime is Nepal Standard Time, which has no daylight saving
+var nepalTime = time.FixedZone("NPT", 5*60*60+45*60)
+
+// parseStatsDate parses a bound of a stats range: an RFC 3339 timestamp, or
+// a date meaning midnight in Nepal. Nil means no bound.
+func parseStatsDate(s *string) (*timestamppb.Timestamp, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, *s); err == nil {
+		return timestamppb.New(t), nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", *s, nepalTime); err == nil {
+		return timestamppb.New(t), nil
+	}
+	return nil, fmt.Errorf("must be an RFC 3339 timestamp or YYYY-MM-DD date")
+}
+
+func statsIntervalToProto(i *model.StatsInterval) dartav1.StatsInterval {
+	if i == nil {
+		return dartav1.StatsInterval_STATS_INTERVAL_UNSPECIFIED
+	}
+	switch *i {
+	case model.StatsIntervalWeek:
+		return dartav1.StatsInterval_STATS_INTERVAL_WEEK
+	case model.StatsIntervalMonth:
+		return dartav1.StatsInterval_STATS_INTERVAL_MONTH
+	default:
+		return dartav1.StatsInterval_STATS_INTERVAL_DAY
+	}
+}
+
+func protoToDurationStats(d *dartav1.DurationStats) *model.DurationStats {
+	return &model.DurationStats{
+		Count:    int(d.GetCount()),
+		AvgHours: d.GetAvgHours(),
+		P90Hours: d.GetP90Hours(),
+	}
+}
+
+func protoToStatsBreakdowns(rows []*dartav1.DartaStatsBreakdown) []*model.DartaStatsBreakdown {
+	out := make([]*model.DartaStatsBreakdown, len(rows))
+	for i, b := range rows {
+		out[i] = &model.DartaStatsBreakdown{
+			Key:                  b.Key,
+			Total:                int(b.Total),
+			OverdueCount:         int(b.OverdueCount),
+			ReceivedToRegistered: protoToDurationStats(b.ReceivedToRegistered),
+			RegisteredToClosed:   protoToDurationStats(b.RegisteredToClosed),
+		}
+		if b.SectionTurnaround != nil {
+			out[i].SectionTurnaround = protoToDurationStats(b.SectionTurnaround)
+		}
+	}
+	return out
+}
+
+func protoToStatsBuckets(rows []*dartav1.DartaStatsBucket) []*model.DartaStatsBucket {
+	out := make([]*model.DartaStatsBucket, len(rows))
+	for i, b := range rows {
+		out[i] = &model.DartaStatsBucket{
+			Start:      b.Start.AsTime().In(nepalTime).Format(time.RFC3339),
+			StartBs:    b.StartBs,
+			Received:   int(b.Received),
+			Registered: int(b.Registered),
+			Closed:     int(b.Closed),
+		}
+	}
+	return out
+}
+
+func stringPtrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func stringSliceValue(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+func boolPtrValue(b *bool) bool {
+	return b != nil && *b
+}
+
+func int32PtrValue(i *int) int32 {
+	if i == nil {
+		return 0
+	}
+	return int32(*i)
+}
+
+func buildDartaFilter(filter *model.DartaFilterInput) *dartav1.DartaFilterInput {
+	if filter == nil {
+		return &dartav1.DartaFilterInput{}
+	}
+
+	return &dartav1.DartaFilterInput{
+		FiscalYearId:         stringPtrValue(filter.FiscalYearID),
+		Scope:                scopePtrToProto(filter.Scope),
+		WardId:               stringPtrValue(filter.WardID),
+		Status:               dartaStatusPtrToProto(filter.Status),
+		Priority:             priorityPtrToProto(filter.Priority),
+		OrganizationalUnitId: stringPtrValue(filter.OrganizationalUnitID),
+		AssigneeId:           stringPtrValue(filter.AssigneeID),
+		IntakeChannel:        intakeChannelPtrToProto(filter.IntakeChannel),
+		Search:               stringPtrValue(filter.Search),
+	}
+}
+
+func buildPagination(p *model.PaginationInput) *dartav1.PaginationInput {
+	if p == nil {
+		return &dartav1.PaginationInput{
+			Limit: 10,
+		}
+	}
+
+	pagination := &dartav1.PaginationInput{
+		Limit:  int32PtrValue(p.Limit),
+		Offset: int32PtrValue(p.Offset),
+		After:  stringPtrValue(p.After),
+		Before: stringPtrValue(p.Before),
+		SortBy: stringPtrValue(p.SortBy),
+	}
+	if p.SortDesc != nil {
+		pagination.SortDesc = *p.SortDesc
+	}
+	return pagination
+}
+
+func protoToDuplicateCandidates(candidates []*dartav1.DuplicateCandidate) []*model.DuplicateCandidate {
+	out := make([]*model.DuplicateCandidate, len(candidates))
+	for i, c := range candidates {
+		out[i] = &model.DuplicateCandidate{
+			DartaID:              c.DartaId,
+			FormattedDartaNumber: optionalString(c.FormattedDartaNumber),
+			Subject:              c.Subject,
+			Status:               protoToDartaStatus(c.Status),
+			WardID:               optionalString(c.WardId),
+			ReceivedDate:         c.ReceivedDate.AsTime().Format("2006-01-02T15:04:05Z07:00"),
+			ApplicantName:        c.ApplicantName,
+			Score:                c.Score,
+			Reasons:              stringSliceValue(c.Reasons),
+		}
+	}
+	return out
+}
+
+func buildSearchFilter(f *model.SearchFilterInput) *dartav1.SearchFilter {
+	if f == nil {
+		return &dartav1.SearchFilter{}
+	}
+	return &dartav1.SearchFilter{
+		Statuses:      f.Statuses,
+		FiscalYearIds: f.FiscalYearIds,
+		WardIds:       f.WardIds,
+		Channels:      f.Channels,
+	}
+}
+
+func protoToSearchResult(r *dartav1.SearchRecordsResponse) *model.SearchResult {
+	result := &model.SearchResult{
+		Hits:        make([]*model.SearchHit, len(r.Hits)),
+		Facets:      make([]*model.SearchFacet, len(r.Facets)),
+		TotalCount:  int(r.TotalCount),
+		HasNextPage: r.HasNextPage,
+		EndCursor:   optionalString(r.EndCursor),
+	}
+	for i, h := range r.Hits {
+		hit := &model.SearchHit{
+			EntityType:   model.SearchEntityType(h.EntityType),
+			ID:           h.Id,
+			Title:        h.Title,
+			Number:       optionalString(h.Number),
+			Party:        h.Party,
+			Status:       h.Status,
+			FiscalYearID: h.FiscalYearId,
+			WardID:       optionalString(h.WardId),
+			Channel:      optionalString(h.Channel),
+			Score:        h.Score,
+			Snippet:      h.Snippet,
+			SnippetField: model.SearchField(strings.ToUpper(h.SnippetField)),
+			Highlights:   make([]*model.TextRange, len(h.Highlights)),
+		}
+		for j, hl := range h.Highlights {
+			hit.Highlights[j] = &model.TextRange{Start: int(hl.Start), End: int(hl.End)}
+		}
+		result.Hits[i] = hit
+	}
+	for i, f := range r.Facets {
+		facet := &model.SearchFacet{Field: f.Field, Values: make([]*model.SearchFacetValue, len(f.Values))}
+		for j, v := range f.Values {
+			facet.Values[j] = &model.SearchFacetValue{Value: v.Value, Count: int(v.Count)}
+		}
+		result.Facets[i] = facet
+	}
+	return result
+}
+
+func protoToPageInfo(p *dartav1.PageInfo) *model.PageInfo {
+	return &model.PageInfo{
+		HasNextPage:     p.GetHasNextPage(),
+		HasPreviousPage: p.GetHasPreviousPage(),
+		StartCursor:     optionalString(p.GetStartCursor()),
+		EndCursor:       optionalString(p.GetEndCursor()),
+		TotalCount:      int(p.GetTotalCount()),
+	}
+}
+
+func registerBookToProto(b model.RegisterBook) dartav1.RegisterBook {
+	switch b {
+	case model.RegisterBookDarta:
+		return dartav1.RegisterBook_REGISTER_BOOK_DARTA
+	case model.RegisterBookChalani:
+		return dartav1.RegisterBook_REGISTER_BOOK_CHALANI
+	default:
+		return dartav1.RegisterBook_REGISTER_BOOK_UNSPECIFIED
+	}
+}
+
+func registerFormatToProto(f model.RegisterFormat) dartav1.RegisterFormat {
+	switch f {
+	case model.RegisterFormatCSV:
+		return dartav1.RegisterFormat_REGISTER_FORMAT_CSV
+	case model.RegisterFormatXlsx:
+		return dartav1.RegisterFormat_REGISTER_FORMAT_XLSX
+	case model.RegisterFormatPDF:
+		return dartav1.RegisterFormat_REGISTER_FORMAT_PDF
+	default:
+		return dartav1.RegisterFormat_REGISTER_FORMAT_UNSPECIFIED
+	}
+}
+
+func protoToRegisterExport(r *dartav1.ExportRegisterResponse) *model.RegisterExport {
+	seal := r.GetSeal()
+	return &model.RegisterExport{
+		Filename:      r.Filename,
+		ContentType:   r.ContentType,
+		ContentBase64: base64.StdEncoding.EncodeToString(r.Content),
+		EntryCount:    int(r.EntryCount),
+		GapCount:      int(r.GapCount),
+		VoidCount:     int(r.VoidCount),
+		Seal: &model.RegisterSeal{
+			Sha256:      seal.GetSha256(),
+			Signature:   seal.GetSignature(),
+			KeyID:       seal.GetKeyId(),
+			PublicKey:   seal.GetPublicKey(),
+			GeneratedAt: seal.GetGeneratedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+			GeneratedBy: seal.GetGeneratedBy(),
+		},
+	}
+}
+
+func protoToAuditChainVerification(r *dartav1.VerifyAuditChainResponse) *model.AuditChainVerification {
+	v := &model.AuditChainVerification{
+		Intact:            r.Intact,
+		EntriesVerified:   int(r.EntriesVerified),
+		HeadSeq:           int(r.HeadSeq),
+		HeadHash:          r.HeadHash,
+		AnchorsVerified:   int(r.AnchorsVerified),
+		Problems:          make([]*model.AuditChainProblem, len(r.Problems)),
+		ProblemsTruncated: r.ProblemsTruncated,
+	}
+	for i, p := range r.Problems {
+		v.Problems[i] = &model.AuditChainProblem{
+			Kind:     p.Kind,
+			ChainSeq: int(p.ChainSeq),
+			EntryID:  optionalString(p.EntryId),
+			Detail:   p.Detail,
+		}
+	}
+	return v
+}
+
+func protoToAuditAnchor(a *dartav1.AuditAnchor) *model.AuditAnchor {
+	return &model.AuditAnchor{
+		ID:         a.Id,
+		ChainSeq:   int(a.ChainSeq),
+		EntryHash:  a.EntryHash,
+		AnchoredAt: a.GetAnchoredAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+		Statement:  a.Statement,
+	}
+}
@@ -0,0 +1,218 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"git.ninjainfosys.com/ePalika/graphql-gateway/internal/auth"
+	pdpv1 "git.ninjainfosys.com/ePalika/proto/gen/pdp/v1"
+)
+
+// objectVariable matches $name and $input.field references in an object
+// template
+var objectVariable = regexp.MustCompile(`\$([A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z_][A-Za-z0-9_]*)*)`)
+
+// NewDirectives returns the implementations of the schema's authorization
+// directives, backed by the resolver's PDP client
+func NewDirectives(r *Resolver) DirectiveRoot {
+	return DirectiveRoot{
+		RequiresPermission: r.requiresPermission,
+		RequiresRole:       r.requiresRole,
+	}
+}
+
+// requiresPermission checks relation on the object built from the field's
+// arguments before resolving the field
+func (r *Resolver) requiresPermission(ctx context.Context, obj interface{}, next graphql.Resolver, relation string, object string) (interface{}, error) {
+	rc, err := requireCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := resolveObject(ctx, rc, object)
+	if err != nil {
+		return nil, err
+	}
+
+	decision, err := r.authorize(ctx, rc, relation, resolved)
+	if err != nil {
+		return nil, err
+	}
+	if !decision.GetAllowed() {
+		return nil, forbiddenError(ctx, fmt.Sprintf("%s on %s is not permitted", relation, resolved), decision.GetReason())
+	}
+	return next(auth.WithDecision(ctx, &auth.Decision{ID: decision.GetDecisionId()}))
+}
+
+// requiresRole checks that the caller holds one of roles in their tenant
+// before resolving the field
+func (r *Resolver) requiresRole(ctx context.Context, obj interface{}, next graphql.Resolver, roles []string) (interface{}, error) {
+	rc, err := requireCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range actingRoleFirst(rc, roles) {
+		decision, err := r.authorize(ctx, rc, role, "tenant:"+rc.Tenant)
+		if err != nil {
+			return nil, err
+		}
+		if decision.GetAllowed() {
+			return next(auth.WithDecision(ctx, &auth.Decision{ID: decision.GetDecisionId(), Role: role}))
+		}
+	}
+	return nil, forbiddenError(ctx, "requires one of the roles "+strings.Join(roles, ", "), "missing_role")
+}
+
+// actingRoleFirst moves the role the caller chose to act in to the front of
+// roles, so it is the one recorded when it suffices
+func actingRoleFirst(rc *auth.RequestContext, roles []string) []string {
+	for i, role := range roles {
+		if role == rc.ActingRole && i > 0 {
+			ordered := append([]string{role}, roles[:i]...)
+			return append(ordered, roles[i+1:]...)
+		}
+	}
+	return roles
+}
+
+// authorize asks the PDP whether the caller has relation on object, reusing
+// earlier answers within the same response. Records are not written to the
+// PDP, so a record object is placed in the caller's tenant with a contextual
+// tuple. The PDP cannot tell whether that is true: darta-chalani looks every
+// record up within the caller's tenant and reports another tenant's as not
+// found.
+func (r *Resolver) authorize(ctx context.Context, rc *auth.RequestContext, relation, object string) (*pdpv1.CheckAuthorizationResponse, error) {
+	req := &pdpv1.CheckAuthorizationRequest{
+		User:     "user:" + rc.UserID,
+		Relation: relation,
+		Object:   object,
+		Context:  map[string]string{"tenant": rc.Tenant},
+	}
+	if objectType, _, _ := strings.Cut(object, ":"); objectType != "tenant" {
+		req.ContextualTuples = []*pdpv1.TupleKey{{
+			User:     "tenant:" + rc.Tenant,
+			Relation: "tenant",
+			Object:   object,
+		}}
+	}
+
+	decision, err := authzCacheFor(ctx).check(req.User+"|"+relation+"|"+object, func() (*pdpv1.CheckAuthorizationResponse, error) {
+		return r.PDPClient.CheckAuthorization(ctx, req)
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	return decision, nil
+}
+
+// requireCaller returns the caller identity forwarded by Oathkeeper
+func requireCaller(ctx context.Context) (*auth.RequestContext, error) {
+	rc := auth.FromContext(ctx)
+	if rc == nil || rc.UserID == "" || rc.Tenant == "" {
+		err := gqlerror.Errorf("authentication required")
+		err.Path = graphql.GetPath(ctx)
+		err.Extensions = map[string]interface{}{"code": ErrCodeUnauthenticated}
+		return nil, err
+	}
+	return rc, nil
+}
+
+// resolveObject substitutes $tenant and argument references in an object
+// template, e.g. "darta:$input.dartaId"
+func resolveObject(ctx context.Context, rc *auth.RequestContext, template string) (string, error) {
+	fc := graphql.GetFieldContext(ctx)
+	var args map[string]interface{}
+	if fc != nil && fc.Field.Field != nil {
+		args = fc.Field.ArgumentMap(graphql.GetOperationContext(ctx).Variables)
+	}
+
+	var missing string
+	resolved := objectVariable.ReplaceAllStringFunc(template, func(ref string) string {
+		path := ref[1:]
+		if path == "tenant" {
+			return rc.Tenant
+		}
+
+		var value interface{} = args
+		for _, key := range strings.Split(path, ".") {
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				value = nil
+				break
+			}
+			value = m[key]
+		}
+		s, ok := value.(string)
+		if !ok || s == "" {
+			missing = path
+			return ""
+		}
+		return s
+	})
+	if missing != "" {
+		return "", validationError(ctx, missing, "is required")
+	}
+	return resolved, nil
+}
+
+// forbiddenError reports a denied authorization check
+func forbiddenError(ctx context.Context, message, reason string) *gqlerror.Error {
+	err := gqlerror.Errorf("%s", message)
+	err.Path = graphql.GetPath(ctx)
+	err.Extensions = map[string]interface{}{"code": ErrCodeForbidden}
+	if reason != "" {
+		err.Extensions["reason"] = reason
+	}
+	return err
+}
+
+// authzCache remembers PDP decisions for one GraphQL response, so a check
+// repeated across fields or list elements reaches the PDP once
+type authzCache struct {
+	mu      sync.Mutex
+	entries map[string]*authzEntry
+}
+
+type authzEntry struct {
+	once     sync.Once
+	decision *pdpv1.CheckAuthorizationResponse
+	err      error
+}
+
+func (c *authzCache) check(key string, ask func() (*pdpv1.CheckAuthorizationResponse, error)) (*pdpv1.CheckAuthorizationResponse, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &authzEntry{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.decision, entry.err = ask()
+	})
+	return entry.decision, entry.err
+}
+
+type authzCacheKey struct{}
+
+// WithAuthzCache attaches an empty decision cache to ctx. Like WithLoaders it
+// runs once per GraphQL response.
+func WithAuthzCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, authzCacheKey{}, &authzCache{entries: map[string]*authzEntry{}})
+}
+
+// authzCacheFor returns the cache attached to ctx, or an unshared one
+func authzCacheFor(ctx context.Context) *authzCache {
+	if c, ok := ctx.Value(authzCacheKey{}).(*authzCache); ok {
+		return c
+	}
+	return &authzCache{entries: map[string]*authzEntry{}}
+}
@@ -0,0 +1,189 @@
+package graph
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+)
+
+// Error codes returned in GraphQL error extensions
+const (
+	ErrCodeValidationFailed  = "VALIDATION_FAILED"
+	ErrCodeNotFound          = "NOT_FOUND"
+	ErrCodeInvalidTransition = "INVALID_TRANSITION"
+	ErrCodeForbidden         = "FORBIDDEN"
+	ErrCodeUnauthenticated   = "UNAUTHENTICATED"
+	ErrCodeConflict          = "CONFLICT"
+	ErrCodeUnavailable       = "UNAVAILABLE"
+	ErrCodeInternal          = "INTERNAL"
+)
+
+// validationError reports an invalid argument on the given input field
+func validationError(ctx context.Context, field, message string) *gqlerror.Error {
+	err := gqlerror.Errorf("%s: %s", field, message)
+	err.Path = graphql.GetPath(ctx)
+	err.Extensions = map[string]interface{}{
+		"code":  ErrCodeValidationFailed,
+		"field": field,
+	}
+	return err
+}
+
+// notFoundError reports a record that the backend did not return
+func notFoundError(ctx context.Context, message string) *gqlerror.Error {
+	err := gqlerror.Errorf("%s", message)
+	err.Path = graphql.GetPath(ctx)
+	err.Extensions = map[string]interface{}{"code": ErrCodeNotFound}
+	return err
+}
+
+// requireID checks that an ID argument is a UUID
+func requireID(ctx context.Context, field, id string) error {
+	if strings.TrimSpace(id) == "" {
+		return validationError(ctx, field, "is required")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return validationError(ctx, field, "must be a valid ID")
+	}
+	return nil
+}
+
+// optionalID checks that an optional ID argument, when present, is a UUID
+func optionalID(ctx context.Context, field string, id *string) error {
+	if id == nil || *id == "" {
+		return nil
+	}
+	return requireID(ctx, field, *id)
+}
+
+// requireText checks that a string argument is not blank
+func requireText(ctx context.Context, field, value string) error {
+	if strings.TrimSpace(value) == "" {
+		return validationError(ctx, field, "is required")
+	}
+	return nil
+}
+
+// expectedVersionArg checks an optional expectedVersion argument, returning 0
+// when it is absent
+func expectedVersionArg(ctx context.Context, field string, version *int) (int64, error) {
+	if version == nil {
+		return 0, nil
+	}
+	if *version < 1 {
+		return 0, validationError(ctx, field, "must be positive")
+	}
+	return int64(*version), nil
+}
+
+// mapGRPCError converts an error from a backend service into a GraphQL error
+// carrying a stable extensions.code. An ErrorDetail attached by the backend
+// supplies the code, the offending input field and any metadata; otherwise the
+// code is derived from the gRPC status code.
+func mapGRPCError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	gqlErr := gqlerror.Errorf("%s", st.Message())
+	gqlErr.Path = graphql.GetPath(ctx)
+	gqlErr.Extensions = map[string]interface{}{
+		"code": codeForStatus(st),
+	}
+
+	for _, d := range st.Details() {
+		detail, ok := d.(*dartav1.ErrorDetail)
+		if !ok {
+			continue
+		}
+		if detail.Code != "" {
+			gqlErr.Extensions["code"] = detail.Code
+		}
+		if detail.Message != "" {
+			gqlErr.Message = detail.Message
+		}
+		if detail.Field != "" {
+			gqlErr.Extensions["field"] = fieldPath(detail.Field)
+		}
+		for k, v := range detail.Metadata.AsMap() {
+			if _, taken := gqlErr.Extensions[k]; !taken {
+				gqlErr.Extensions[k] = v
+			}
+		}
+		break
+	}
+	if st.Code() == codes.Internal && gqlErr.Extensions["code"] == ErrCodeInternal && len(st.Details()) == 0 {
+		gqlErr.Message = "internal error"
+	}
+	return gqlErr
+}
+
+// codeForStatus derives an extensions.code from a status without details
+func codeForStatus(st *status.Status) string {
+	switch st.Code() {
+	case codes.InvalidArgument, codes.OutOfRange:
+		if strings.Contains(st.Message(), "status transition") {
+			return ErrCodeInvalidTransition
+		}
+		return ErrCodeValidationFailed
+	case codes.FailedPrecondition:
+		return ErrCodeInvalidTransition
+	case codes.NotFound:
+		return ErrCodeNotFound
+	case codes.PermissionDenied:
+		return ErrCodeForbidden
+	case codes.Unauthenticated:
+		return ErrCodeUnauthenticated
+	case codes.AlreadyExists, codes.Aborted:
+		return ErrCodeConflict
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return ErrCodeUnavailable
+	default:
+		return ErrCodeInternal
+	}
+}
+
+// fieldPath converts a backend field path such as "applicant.full_name" into
+// the GraphQL input path "applicant.fullName". Keys below metadata are the
+// caller's own and are kept as they are.
+func fieldPath(field string) string {
+	segments := strings.Split(field, ".")
+	for i, seg := range segments {
+		if i > 0 && segments[i-1] == "metadata" {
+			break
+		}
+		parts := strings.Split(seg, "_")
+		for j := 1; j < len(parts); j++ {
+			if parts[j] != "" {
+				parts[j] = strings.ToUpper(parts[j][:1]) + parts[j][1:]
+			}
+		}
+		segments[i] = strings.Join(parts, "")
+	}
+	return strings.Join(segments, ".")
+}
+
+// ErrorPresenter gives every error leaving the gateway an extensions.code, so
+// clients can rely on it even for errors that did not pass through
+// mapGRPCError
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = map[string]interface{}{}
+	}
+	if _, ok := gqlErr.Extensions["code"]; !ok {
+		gqlErr.Extensions["code"] = ErrCodeInternal
+	}
+	return gqlErr
+}
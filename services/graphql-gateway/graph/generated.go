@@ -38,11 +38,17 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	Chalani() ChalaniResolver
+	Darta() DartaResolver
 	Mutation() MutationResolver
 	Query() QueryResolver
+	SearchHit() SearchHitResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
+	RequiresPermission func(ctx context.Context, obj any, next graphql.Resolver, relation string, object string) (res any, err error)
+	RequiresRole       func(ctx context.Context, obj any, next graphql.Resolver, roles []string) (res any, err error)
 }
 
 type ComplexityRoot struct {
@@ -56,29 +62,140 @@ type ComplexityRoot struct {
 		Type         func(childComplexity int) int
 	}
 
+	Attachment struct {
+		Filename         func(childComplexity int) int
+		ID               func(childComplexity int) int
+		IsPrimary        func(childComplexity int) int
+		MimeType         func(childComplexity int) int
+		OriginalFilename func(childComplexity int) int
+		SizeBytes        func(childComplexity int) int
+		UploadedAt       func(childComplexity int) int
+		UploadedBy       func(childComplexity int) int
+	}
+
+	AuditAnchor struct {
+		AnchoredAt func(childComplexity int) int
+		ChainSeq   func(childComplexity int) int
+		EntryHash  func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Statement  func(childComplexity int) int
+	}
+
+	AuditChainProblem struct {
+		ChainSeq func(childComplexity int) int
+		Detail   func(childComplexity int) int
+		EntryID  func(childComplexity int) int
+		Kind     func(childComplexity int) int
+	}
+
+	AuditChainVerification struct {
+		AnchorsVerified   func(childComplexity int) int
+		EntriesVerified   func(childComplexity int) int
+		HeadHash          func(childComplexity int) int
+		HeadSeq           func(childComplexity int) int
+		Intact            func(childComplexity int) int
+		Problems          func(childComplexity int) int
+		ProblemsTruncated func(childComplexity int) int
+	}
+
+	AuditEntry struct {
+		ActingRole  func(childComplexity int) int
+		Action      func(childComplexity int) int
+		Changes     func(childComplexity int) int
+		DecisionID  func(childComplexity int) int
+		Diff        func(childComplexity int) int
+		EntityID    func(childComplexity int) int
+		EntityType  func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Notes       func(childComplexity int) int
+		PerformedAt func(childComplexity int) int
+		PerformedBy func(childComplexity int) int
+		RequestID   func(childComplexity int) int
+		TraceID     func(childComplexity int) int
+	}
+
+	AuditEntryConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	AuditEntryEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	AuditFieldChange struct {
+		After  func(childComplexity int) int
+		Before func(childComplexity int) int
+		Field  func(childComplexity int) int
+	}
+
+	Chalani struct {
+		Body                   func(childComplexity int) int
+		ChalaniNumber          func(childComplexity int) int
+		CreatedAt              func(childComplexity int) int
+		DeliveredAt            func(childComplexity int) int
+		DispatchChannel        func(childComplexity int) int
+		DispatchedAt           func(childComplexity int) int
+		FiscalYearID           func(childComplexity int) int
+		FormattedChalaniNumber func(childComplexity int) int
+		ID                     func(childComplexity int) int
+		Scope                  func(childComplexity int) int
+		Status                 func(childComplexity int) int
+		Subject                func(childComplexity int) int
+		Timeline               func(childComplexity int) int
+		UpdatedAt              func(childComplexity int) int
+		WardID                 func(childComplexity int) int
+	}
+
+	ChalaniDispatchEvent struct {
+		Action                 func(childComplexity int) int
+		ActorID                func(childComplexity int) int
+		ChalaniID              func(childComplexity int) int
+		DeliveredAt            func(childComplexity int) int
+		DispatchedAt           func(childComplexity int) int
+		FormattedChalaniNumber func(childComplexity int) int
+		OccurredAt             func(childComplexity int) int
+		Status                 func(childComplexity int) int
+		Subject                func(childComplexity int) int
+		TrackingID             func(childComplexity int) int
+	}
+
 	ChannelCount struct {
 		Channel func(childComplexity int) int
 		Count   func(childComplexity int) int
 	}
 
 	Darta struct {
-		Applicant            func(childComplexity int) int
-		CreatedAt            func(childComplexity int) int
-		CreatedBy            func(childComplexity int) int
-		DartaNumber          func(childComplexity int) int
-		EntryDate            func(childComplexity int) int
-		FiscalYearID         func(childComplexity int) int
-		FormattedDartaNumber func(childComplexity int) int
-		ID                   func(childComplexity int) int
-		IntakeChannel        func(childComplexity int) int
-		Priority             func(childComplexity int) int
-		ReceivedDate         func(childComplexity int) int
-		Scope                func(childComplexity int) int
-		Status               func(childComplexity int) int
-		Subject              func(childComplexity int) int
-		TenantID             func(childComplexity int) int
-		UpdatedAt            func(childComplexity int) int
-		WardID               func(childComplexity int) int
+		Applicant             func(childComplexity int) int
+		Assignee              func(childComplexity int) int
+		Attachments           func(childComplexity int) int
+		AuditTrail            func(childComplexity int) int
+		CreatedAt             func(childComplexity int) int
+		CreatedBy             func(childComplexity int) int
+		DartaNumber           func(childComplexity int) int
+		EntryDate             func(childComplexity int) int
+		FiscalYearID          func(childComplexity int) int
+		FormattedDartaNumber  func(childComplexity int) int
+		ID                    func(childComplexity int) int
+		IntakeChannel         func(childComplexity int) int
+		Metadata              func(childComplexity int) int
+		Priority              func(childComplexity int) int
+		ReceivedDate          func(childComplexity int) int
+		RelatedDartas         func(childComplexity int) int
+		Scope                 func(childComplexity int) int
+		Status                func(childComplexity int) int
+		Subject               func(childComplexity int) int
+		SupersededBy          func(childComplexity int) int
+		Supersedes            func(childComplexity int) int
+		SupersedesDartaNumber func(childComplexity int) int
+		SupersessionChain     func(childComplexity int) int
+		SuspectedDuplicates   func(childComplexity int) int
+		TenantID              func(childComplexity int) int
+		Timeline              func(childComplexity int) int
+		UpdatedAt             func(childComplexity int) int
+		Version               func(childComplexity int) int
+		WardID                func(childComplexity int) int
 	}
 
 	DartaConnection struct {
@@ -91,11 +208,46 @@ type ComplexityRoot struct {
 		Node   func(childComplexity int) int
 	}
 
+	DartaEvent struct {
+		Action             func(childComplexity int) int
+		ActorID            func(childComplexity int) int
+		Darta              func(childComplexity int) int
+		EscalatedToID      func(childComplexity int) int
+		OccurredAt         func(childComplexity int) int
+		PreviousAssigneeID func(childComplexity int) int
+		SLAStage           func(childComplexity int) int
+	}
+
 	DartaStats struct {
-		ByChannel    func(childComplexity int) int
-		ByStatus     func(childComplexity int) int
-		OverdueCount func(childComplexity int) int
-		Total        func(childComplexity int) int
+		AvgProcessingTimeHours func(childComplexity int) int
+		ByChannel              func(childComplexity int) int
+		ByIntakeChannel        func(childComplexity int) int
+		ByPriority             func(childComplexity int) int
+		BySection              func(childComplexity int) int
+		ByStatus               func(childComplexity int) int
+		ByWard                 func(childComplexity int) int
+		OverdueCount           func(childComplexity int) int
+		ReceivedToRegistered   func(childComplexity int) int
+		RegisteredToClosed     func(childComplexity int) int
+		Series                 func(childComplexity int) int
+		Total                  func(childComplexity int) int
+	}
+
+	DartaStatsBreakdown struct {
+		Key                  func(childComplexity int) int
+		OverdueCount         func(childComplexity int) int
+		ReceivedToRegistered func(childComplexity int) int
+		RegisteredToClosed   func(childComplexity int) int
+		SectionTurnaround    func(childComplexity int) int
+		Total                func(childComplexity int) int
+	}
+
+	DartaStatsBucket struct {
+		Closed     func(childComplexity int) int
+		Received   func(childComplexity int) int
+		Registered func(childComplexity int) int
+		Start      func(childComplexity int) int
+		StartBs    func(childComplexity int) int
 	}
 
 	DartaStatusCount struct {
@@ -103,6 +255,24 @@ type ComplexityRoot struct {
 		Status func(childComplexity int) int
 	}
 
+	DuplicateCandidate struct {
+		ApplicantName        func(childComplexity int) int
+		DartaID              func(childComplexity int) int
+		FormattedDartaNumber func(childComplexity int) int
+		Reasons              func(childComplexity int) int
+		ReceivedDate         func(childComplexity int) int
+		Score                func(childComplexity int) int
+		Status               func(childComplexity int) int
+		Subject              func(childComplexity int) int
+		WardID               func(childComplexity int) int
+	}
+
+	DurationStats struct {
+		AvgHours func(childComplexity int) int
+		Count    func(childComplexity int) int
+		P90Hours func(childComplexity int) int
+	}
+
 	HealthStatus struct {
 		Service   func(childComplexity int) int
 		Status    func(childComplexity int) int
@@ -110,47 +280,206 @@ type ComplexityRoot struct {
 	}
 
 	Mutation struct {
-		ClassifyDarta             func(childComplexity int, dartaID string, classificationCode string) int
-		CloseDarta                func(childComplexity int, dartaID string) int
+		AcceptDarta               func(childComplexity int, dartaID string, expectedVersion *int) int
+		ApproveDartaReview        func(childComplexity int, dartaID string, notes *string, expectedVersion *int) int
+		ArchiveDartaDigital       func(childComplexity int, dartaID string, expectedVersion *int) int
+		AssignDartaSection        func(childComplexity int, input model.AssignDartaSectionInput) int
+		ClassifyDarta             func(childComplexity int, dartaID string, classificationCode string, expectedVersion *int) int
+		CloseDarta                func(childComplexity int, dartaID string, expectedVersion *int) int
 		CreateDarta               func(childComplexity int, input model.CreateDartaInput) int
-		FinalizeDartaRegistration func(childComplexity int, dartaID string) int
-		ReserveDartaNumber        func(childComplexity int, dartaID string) int
+		DirectRegisterDarta       func(childComplexity int, dartaID string, acknowledgeDuplicates *bool, expectedVersion *int) int
+		EnrichDartaMetadata       func(childComplexity int, dartaID string, metadata map[string]any, expectedVersion *int) int
+		FinalizeDartaRegistration func(childComplexity int, dartaID string, expectedVersion *int) int
+		IssueDartaResponse        func(childComplexity int, input model.IssueDartaResponseInput) int
+		LinkDuplicateDarta        func(childComplexity int, dartaID string, duplicateOfID string, notes *string, expectedVersion *int) int
+		MarkDartaAction           func(childComplexity int, dartaID string, actionNote string, expectedVersion *int) int
+		ProvideDartaClarification func(childComplexity int, dartaID string, note string, expectedVersion *int) int
+		ReceiveDartaAck           func(childComplexity int, dartaID string, expectedVersion *int) int
+		RequestDartaAck           func(childComplexity int, dartaID string, expectedVersion *int) int
+		RequestDartaClarification func(childComplexity int, dartaID string, note string, expectedVersion *int) int
+		ReserveDartaNumber        func(childComplexity int, dartaID string, acknowledgeDuplicates *bool, expectedVersion *int) int
+		ReviewDarta               func(childComplexity int, input model.ReviewDartaInput) int
 		RouteDarta                func(childComplexity int, input model.RouteDartaInput) int
-		SubmitDartaForReview      func(childComplexity int, dartaID string) int
-		VoidDarta                 func(childComplexity int, dartaID string, reason string) int
+		ScanDarta                 func(childComplexity int, dartaID string, scanAttachmentID string, expectedVersion *int) int
+		SectionReviewDarta        func(childComplexity int, dartaID string, expectedVersion *int) int
+		SubmitDartaForReview      func(childComplexity int, dartaID string, expectedVersion *int) int
+		SupersedeDartaRecord      func(childComplexity int, dartaID string, newDartaID string, reason string, expectedVersion *int) int
+		VoidDarta                 func(childComplexity int, dartaID string, reason string, expectedVersion *int) int
 	}
 
 	PageInfo struct {
+		EndCursor       func(childComplexity int) int
 		HasNextPage     func(childComplexity int) int
 		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
 		TotalCount      func(childComplexity int) int
 	}
 
 	Query struct {
-		Darta      func(childComplexity int, id string) int
-		DartaStats func(childComplexity int, scope *model.Scope, fiscalYearID *string, wardID *string) int
-		Dartas     func(childComplexity int, filter *model.DartaFilterInput, pagination *model.PaginationInput) int
-		Health     func(childComplexity int) int
-		MyDartas   func(childComplexity int, status *model.DartaStatus, pagination *model.PaginationInput) int
+		AuditAnchors           func(childComplexity int, from *string, to *string) int
+		AuditChainVerification func(childComplexity int) int
+		AuditEntries           func(childComplexity int, filter *model.AuditEntryFilterInput, pagination *model.PaginationInput) int
+		Chalani                func(childComplexity int, id string) int
+		Darta                  func(childComplexity int, id string) int
+		DartaByNumber          func(childComplexity int, dartaNumber int, fiscalYearID string, scope model.Scope, wardID *string) int
+		DartaStats             func(childComplexity int, scope *model.Scope, fiscalYearID *string, wardID *string, fromDate *string, toDate *string, interval *model.StatsInterval) int
+		Dartas                 func(childComplexity int, filter *model.DartaFilterInput, pagination *model.PaginationInput) int
+		Health                 func(childComplexity int) int
+		MyDartas               func(childComplexity int, status *model.DartaStatus, pagination *model.PaginationInput) int
+		RegisterExport         func(childComplexity int, book model.RegisterBook, fiscalYearID string, scope model.Scope, wardID *string, format model.RegisterFormat) int
+		Search                 func(childComplexity int, query string, entityTypes []model.SearchEntityType, filter *model.SearchFilterInput, first *int, after *string) int
+	}
+
+	RegisterExport struct {
+		ContentBase64 func(childComplexity int) int
+		ContentType   func(childComplexity int) int
+		EntryCount    func(childComplexity int) int
+		Filename      func(childComplexity int) int
+		GapCount      func(childComplexity int) int
+		Seal          func(childComplexity int) int
+		VoidCount     func(childComplexity int) int
+	}
+
+	RegisterSeal struct {
+		GeneratedAt func(childComplexity int) int
+		GeneratedBy func(childComplexity int) int
+		KeyID       func(childComplexity int) int
+		PublicKey   func(childComplexity int) int
+		Sha256      func(childComplexity int) int
+		Signature   func(childComplexity int) int
+	}
+
+	RelatedDarta struct {
+		Darta            func(childComplexity int) int
+		RelationshipType func(childComplexity int) int
+	}
+
+	SearchFacet struct {
+		Field  func(childComplexity int) int
+		Values func(childComplexity int) int
+	}
+
+	SearchFacetValue struct {
+		Count func(childComplexity int) int
+		Value func(childComplexity int) int
+	}
+
+	SearchHit struct {
+		Channel      func(childComplexity int) int
+		Darta        func(childComplexity int) int
+		EntityType   func(childComplexity int) int
+		FiscalYearID func(childComplexity int) int
+		Highlights   func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Number       func(childComplexity int) int
+		Party        func(childComplexity int) int
+		Score        func(childComplexity int) int
+		Snippet      func(childComplexity int) int
+		SnippetField func(childComplexity int) int
+		Status       func(childComplexity int) int
+		Title        func(childComplexity int) int
+		WardID       func(childComplexity int) int
+	}
+
+	SearchResult struct {
+		EndCursor   func(childComplexity int) int
+		Facets      func(childComplexity int) int
+		HasNextPage func(childComplexity int) int
+		Hits        func(childComplexity int) int
+		TotalCount  func(childComplexity int) int
+	}
+
+	Subscription struct {
+		ChalaniDispatchUpdated func(childComplexity int) int
+		DartaUpdated           func(childComplexity int, id string) int
+		MyQueueChanged         func(childComplexity int) int
+	}
+
+	TextRange struct {
+		End   func(childComplexity int) int
+		Start func(childComplexity int) int
+	}
+
+	Timeline struct {
+		Entries   func(childComplexity int) int
+		Truncated func(childComplexity int) int
+	}
+
+	User struct {
+		Email    func(childComplexity int) int
+		FullName func(childComplexity int) int
+		ID       func(childComplexity int) int
+		Username func(childComplexity int) int
 	}
 }
 
+type ChalaniResolver interface {
+	Timeline(ctx context.Context, obj *model.Chalani) (*model.Timeline, error)
+}
+type DartaResolver interface {
+	Applicant(ctx context.Context, obj *model.Darta) (*model.Applicant, error)
+
+	CreatedBy(ctx context.Context, obj *model.Darta) (*model.User, error)
+
+	Assignee(ctx context.Context, obj *model.Darta) (*model.User, error)
+	Attachments(ctx context.Context, obj *model.Darta) ([]*model.Attachment, error)
+	RelatedDartas(ctx context.Context, obj *model.Darta) ([]*model.RelatedDarta, error)
+	AuditTrail(ctx context.Context, obj *model.Darta) ([]*model.AuditEntry, error)
+	Timeline(ctx context.Context, obj *model.Darta) (*model.Timeline, error)
+	SuspectedDuplicates(ctx context.Context, obj *model.Darta) ([]*model.DuplicateCandidate, error)
+
+	Supersedes(ctx context.Context, obj *model.Darta) (*model.Darta, error)
+	SupersededBy(ctx context.Context, obj *model.Darta) (*model.Darta, error)
+	SupersessionChain(ctx context.Context, obj *model.Darta) ([]*model.Darta, error)
+}
 type MutationResolver interface {
 	CreateDarta(ctx context.Context, input model.CreateDartaInput) (*model.Darta, error)
-	SubmitDartaForReview(ctx context.Context, dartaID string) (*model.Darta, error)
-	ClassifyDarta(ctx context.Context, dartaID string, classificationCode string) (*model.Darta, error)
-	ReserveDartaNumber(ctx context.Context, dartaID string) (*model.Darta, error)
-	FinalizeDartaRegistration(ctx context.Context, dartaID string) (*model.Darta, error)
+	SubmitDartaForReview(ctx context.Context, dartaID string, expectedVersion *int) (*model.Darta, error)
+	ReviewDarta(ctx context.Context, input model.ReviewDartaInput) (*model.Darta, error)
+	ApproveDartaReview(ctx context.Context, dartaID string, notes *string, expectedVersion *int) (*model.Darta, error)
+	ClassifyDarta(ctx context.Context, dartaID string, classificationCode string, expectedVersion *int) (*model.Darta, error)
+	ReserveDartaNumber(ctx context.Context, dartaID string, acknowledgeDuplicates *bool, expectedVersion *int) (*model.Darta, error)
+	FinalizeDartaRegistration(ctx context.Context, dartaID string, expectedVersion *int) (*model.Darta, error)
+	DirectRegisterDarta(ctx context.Context, dartaID string, acknowledgeDuplicates *bool, expectedVersion *int) (*model.Darta, error)
+	VoidDarta(ctx context.Context, dartaID string, reason string, expectedVersion *int) (*model.Darta, error)
+	LinkDuplicateDarta(ctx context.Context, dartaID string, duplicateOfID string, notes *string, expectedVersion *int) (*model.Darta, error)
+	ScanDarta(ctx context.Context, dartaID string, scanAttachmentID string, expectedVersion *int) (*model.Darta, error)
+	EnrichDartaMetadata(ctx context.Context, dartaID string, metadata map[string]any, expectedVersion *int) (*model.Darta, error)
+	ArchiveDartaDigital(ctx context.Context, dartaID string, expectedVersion *int) (*model.Darta, error)
 	RouteDarta(ctx context.Context, input model.RouteDartaInput) (*model.Darta, error)
-	CloseDarta(ctx context.Context, dartaID string) (*model.Darta, error)
-	VoidDarta(ctx context.Context, dartaID string, reason string) (*model.Darta, error)
+	AssignDartaSection(ctx context.Context, input model.AssignDartaSectionInput) (*model.Darta, error)
+	SectionReviewDarta(ctx context.Context, dartaID string, expectedVersion *int) (*model.Darta, error)
+	RequestDartaClarification(ctx context.Context, dartaID string, note string, expectedVersion *int) (*model.Darta, error)
+	ProvideDartaClarification(ctx context.Context, dartaID string, note string, expectedVersion *int) (*model.Darta, error)
+	AcceptDarta(ctx context.Context, dartaID string, expectedVersion *int) (*model.Darta, error)
+	MarkDartaAction(ctx context.Context, dartaID string, actionNote string, expectedVersion *int) (*model.Darta, error)
+	IssueDartaResponse(ctx context.Context, input model.IssueDartaResponseInput) (*model.Darta, error)
+	RequestDartaAck(ctx context.Context, dartaID string, expectedVersion *int) (*model.Darta, error)
+	ReceiveDartaAck(ctx context.Context, dartaID string, expectedVersion *int) (*model.Darta, error)
+	SupersedeDartaRecord(ctx context.Context, dartaID string, newDartaID string, reason string, expectedVersion *int) (*model.Darta, error)
+	CloseDarta(ctx context.Context, dartaID string, expectedVersion *int) (*model.Darta, error)
 }
 type QueryResolver interface {
 	Health(ctx context.Context) (*model.HealthStatus, error)
 	Darta(ctx context.Context, id string) (*model.Darta, error)
+	DartaByNumber(ctx context.Context, dartaNumber int, fiscalYearID string, scope model.Scope, wardID *string) (*model.Darta, error)
 	Dartas(ctx context.Context, filter *model.DartaFilterInput, pagination *model.PaginationInput) (*model.DartaConnection, error)
 	MyDartas(ctx context.Context, status *model.DartaStatus, pagination *model.PaginationInput) (*model.DartaConnection, error)
-	DartaStats(ctx context.Context, scope *model.Scope, fiscalYearID *string, wardID *string) (*model.DartaStats, error)
+	Chalani(ctx context.Context, id string) (*model.Chalani, error)
+	DartaStats(ctx context.Context, scope *model.Scope, fiscalYearID *string, wardID *string, fromDate *string, toDate *string, interval *model.StatsInterval) (*model.DartaStats, error)
+	Search(ctx context.Context, query string, entityTypes []model.SearchEntityType, filter *model.SearchFilterInput, first *int, after *string) (*model.SearchResult, error)
+	RegisterExport(ctx context.Context, book model.RegisterBook, fiscalYearID string, scope model.Scope, wardID *string, format model.RegisterFormat) (*model.RegisterExport, error)
+	AuditEntries(ctx context.Context, filter *model.AuditEntryFilterInput, pagination *model.PaginationInput) (*model.AuditEntryConnection, error)
+	AuditChainVerification(ctx context.Context) (*model.AuditChainVerification, error)
+	AuditAnchors(ctx context.Context, from *string, to *string) ([]*model.AuditAnchor, error)
+}
+type SearchHitResolver interface {
+	Darta(ctx context.Context, obj *model.SearchHit) (*model.Darta, error)
+}
+type SubscriptionResolver interface {
+	DartaUpdated(ctx context.Context, id string) (<-chan *model.DartaEvent, error)
+	MyQueueChanged(ctx context.Context) (<-chan *model.DartaEvent, error)
+	ChalaniDispatchUpdated(ctx context.Context) (<-chan *model.ChalaniDispatchEvent, error)
 }
 
 type executableSchema struct {
@@ -215,2687 +544,13777 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Applicant.Type(childComplexity), true
 
-	case "ChannelCount.channel":
-		if e.complexity.ChannelCount.Channel == nil {
+	case "Attachment.filename":
+		if e.complexity.Attachment.Filename == nil {
 			break
 		}
 
-		return e.complexity.ChannelCount.Channel(childComplexity), true
-	case "ChannelCount.count":
-		if e.complexity.ChannelCount.Count == nil {
+		return e.complexity.Attachment.Filename(childComplexity), true
+	case "Attachment.id":
+		if e.complexity.Attachment.ID == nil {
 			break
 		}
 
-		return e.complexity.ChannelCount.Count(childComplexity), true
+		return e.complexity.Attachment.ID(childComplexity), true
+	case "Attachment.isPrimary":
+		if e.complexity.Attachment.IsPrimary == nil {
+			break
+		}
 
-	case "Darta.applicant":
-		if e.complexity.Darta.Applicant == nil {
+		return e.complexity.Attachment.IsPrimary(childComplexity), true
+	case "Attachment.mimeType":
+		if e.complexity.Attachment.MimeType == nil {
 			break
 		}
 
-		return e.complexity.Darta.Applicant(childComplexity), true
-	case "Darta.createdAt":
-		if e.complexity.Darta.CreatedAt == nil {
+		return e.complexity.Attachment.MimeType(childComplexity), true
+	case "Attachment.originalFilename":
+		if e.complexity.Attachment.OriginalFilename == nil {
 			break
 		}
 
-		return e.complexity.Darta.CreatedAt(childComplexity), true
-	case "Darta.createdBy":
-		if e.complexity.Darta.CreatedBy == nil {
+		return e.complexity.Attachment.OriginalFilename(childComplexity), true
+	case "Attachment.sizeBytes":
+		if e.complexity.Attachment.SizeBytes == nil {
 			break
 		}
 
-		return e.complexity.Darta.CreatedBy(childComplexity), true
-	case "Darta.dartaNumber":
-		if e.complexity.Darta.DartaNumber == nil {
+		return e.complexity.Attachment.SizeBytes(childComplexity), true
+	case "Attachment.uploadedAt":
+		if e.complexity.Attachment.UploadedAt == nil {
 			break
 		}
 
-		return e.complexity.Darta.DartaNumber(childComplexity), true
-	case "Darta.entryDate":
-		if e.complexity.Darta.EntryDate == nil {
+		return e.complexity.Attachment.UploadedAt(childComplexity), true
+	case "Attachment.uploadedBy":
+		if e.complexity.Attachment.UploadedBy == nil {
 			break
 		}
 
-		return e.complexity.Darta.EntryDate(childComplexity), true
-	case "Darta.fiscalYearId":
-		if e.complexity.Darta.FiscalYearID == nil {
+		return e.complexity.Attachment.UploadedBy(childComplexity), true
+
+	case "AuditAnchor.anchoredAt":
+		if e.complexity.AuditAnchor.AnchoredAt == nil {
 			break
 		}
 
-		return e.complexity.Darta.FiscalYearID(childComplexity), true
-	case "Darta.formattedDartaNumber":
-		if e.complexity.Darta.FormattedDartaNumber == nil {
+		return e.complexity.AuditAnchor.AnchoredAt(childComplexity), true
+	case "AuditAnchor.chainSeq":
+		if e.complexity.AuditAnchor.ChainSeq == nil {
 			break
 		}
 
-		return e.complexity.Darta.FormattedDartaNumber(childComplexity), true
-	case "Darta.id":
-		if e.complexity.Darta.ID == nil {
+		return e.complexity.AuditAnchor.ChainSeq(childComplexity), true
+	case "AuditAnchor.entryHash":
+		if e.complexity.AuditAnchor.EntryHash == nil {
 			break
 		}
 
-		return e.complexity.Darta.ID(childComplexity), true
-	case "Darta.intakeChannel":
-		if e.complexity.Darta.IntakeChannel == nil {
+		return e.complexity.AuditAnchor.EntryHash(childComplexity), true
+	case "AuditAnchor.id":
+		if e.complexity.AuditAnchor.ID == nil {
 			break
 		}
 
-		return e.complexity.Darta.IntakeChannel(childComplexity), true
-	case "Darta.priority":
-		if e.complexity.Darta.Priority == nil {
+		return e.complexity.AuditAnchor.ID(childComplexity), true
+	case "AuditAnchor.statement":
+		if e.complexity.AuditAnchor.Statement == nil {
 			break
 		}
 
-		return e.complexity.Darta.Priority(childComplexity), true
-	case "Darta.receivedDate":
-		if e.complexity.Darta.ReceivedDate == nil {
+		return e.complexity.AuditAnchor.Statement(childComplexity), true
+
+	case "AuditChainProblem.chainSeq":
+		if e.complexity.AuditChainProblem.ChainSeq == nil {
 			break
 		}
 
-		return e.complexity.Darta.ReceivedDate(childComplexity), true
-	case "Darta.scope":
-		if e.complexity.Darta.Scope == nil {
+		return e.complexity.AuditChainProblem.ChainSeq(childComplexity), true
+	case "AuditChainProblem.detail":
+		if e.complexity.AuditChainProblem.Detail == nil {
 			break
 		}
 
-		return e.complexity.Darta.Scope(childComplexity), true
-	case "Darta.status":
-		if e.complexity.Darta.Status == nil {
+		return e.complexity.AuditChainProblem.Detail(childComplexity), true
+	case "AuditChainProblem.entryId":
+		if e.complexity.AuditChainProblem.EntryID == nil {
 			break
 		}
 
-		return e.complexity.Darta.Status(childComplexity), true
-	case "Darta.subject":
-		if e.complexity.Darta.Subject == nil {
+		return e.complexity.AuditChainProblem.EntryID(childComplexity), true
+	case "AuditChainProblem.kind":
+		if e.complexity.AuditChainProblem.Kind == nil {
 			break
 		}
 
-		return e.complexity.Darta.Subject(childComplexity), true
-	case "Darta.tenantId":
-		if e.complexity.Darta.TenantID == nil {
+		return e.complexity.AuditChainProblem.Kind(childComplexity), true
+
+	case "AuditChainVerification.anchorsVerified":
+		if e.complexity.AuditChainVerification.AnchorsVerified == nil {
 			break
 		}
 
-		return e.complexity.Darta.TenantID(childComplexity), true
-	case "Darta.updatedAt":
-		if e.complexity.Darta.UpdatedAt == nil {
+		return e.complexity.AuditChainVerification.AnchorsVerified(childComplexity), true
+	case "AuditChainVerification.entriesVerified":
+		if e.complexity.AuditChainVerification.EntriesVerified == nil {
 			break
 		}
 
-		return e.complexity.Darta.UpdatedAt(childComplexity), true
-	case "Darta.wardId":
-		if e.complexity.Darta.WardID == nil {
+		return e.complexity.AuditChainVerification.EntriesVerified(childComplexity), true
+	case "AuditChainVerification.headHash":
+		if e.complexity.AuditChainVerification.HeadHash == nil {
 			break
 		}
 
-		return e.complexity.Darta.WardID(childComplexity), true
+		return e.complexity.AuditChainVerification.HeadHash(childComplexity), true
+	case "AuditChainVerification.headSeq":
+		if e.complexity.AuditChainVerification.HeadSeq == nil {
+			break
+		}
 
-	case "DartaConnection.edges":
-		if e.complexity.DartaConnection.Edges == nil {
+		return e.complexity.AuditChainVerification.HeadSeq(childComplexity), true
+	case "AuditChainVerification.intact":
+		if e.complexity.AuditChainVerification.Intact == nil {
 			break
 		}
 
-		return e.complexity.DartaConnection.Edges(childComplexity), true
-	case "DartaConnection.pageInfo":
-		if e.complexity.DartaConnection.PageInfo == nil {
+		return e.complexity.AuditChainVerification.Intact(childComplexity), true
+	case "AuditChainVerification.problems":
+		if e.complexity.AuditChainVerification.Problems == nil {
 			break
 		}
 
-		return e.complexity.DartaConnection.PageInfo(childComplexity), true
+		return e.complexity.AuditChainVerification.Problems(childComplexity), true
+	case "AuditChainVerification.problemsTruncated":
+		if e.complexity.AuditChainVerification.ProblemsTruncated == nil {
+			break
+		}
 
-	case "DartaEdge.cursor":
-		if e.complexity.DartaEdge.Cursor == nil {
+		return e.complexity.AuditChainVerification.ProblemsTruncated(childComplexity), true
+
+	case "AuditEntry.actingRole":
+		if e.complexity.AuditEntry.ActingRole == nil {
 			break
 		}
 
-		return e.complexity.DartaEdge.Cursor(childComplexity), true
-	case "DartaEdge.node":
-		if e.complexity.DartaEdge.Node == nil {
+		return e.complexity.AuditEntry.ActingRole(childComplexity), true
+	case "AuditEntry.action":
+		if e.complexity.AuditEntry.Action == nil {
 			break
 		}
 
-		return e.complexity.DartaEdge.Node(childComplexity), true
+		return e.complexity.AuditEntry.Action(childComplexity), true
+	case "AuditEntry.changes":
+		if e.complexity.AuditEntry.Changes == nil {
+			break
+		}
 
-	case "DartaStats.byChannel":
-		if e.complexity.DartaStats.ByChannel == nil {
+		return e.complexity.AuditEntry.Changes(childComplexity), true
+	case "AuditEntry.decisionId":
+		if e.complexity.AuditEntry.DecisionID == nil {
 			break
 		}
 
-		return e.complexity.DartaStats.ByChannel(childComplexity), true
-	case "DartaStats.byStatus":
-		if e.complexity.DartaStats.ByStatus == nil {
+		return e.complexity.AuditEntry.DecisionID(childComplexity), true
+	case "AuditEntry.diff":
+		if e.complexity.AuditEntry.Diff == nil {
 			break
 		}
 
-		return e.complexity.DartaStats.ByStatus(childComplexity), true
-	case "DartaStats.overdueCount":
-		if e.complexity.DartaStats.OverdueCount == nil {
+		return e.complexity.AuditEntry.Diff(childComplexity), true
+	case "AuditEntry.entityId":
+		if e.complexity.AuditEntry.EntityID == nil {
 			break
 		}
 
-		return e.complexity.DartaStats.OverdueCount(childComplexity), true
-	case "DartaStats.total":
-		if e.complexity.DartaStats.Total == nil {
+		return e.complexity.AuditEntry.EntityID(childComplexity), true
+	case "AuditEntry.entityType":
+		if e.complexity.AuditEntry.EntityType == nil {
 			break
 		}
 
-		return e.complexity.DartaStats.Total(childComplexity), true
+		return e.complexity.AuditEntry.EntityType(childComplexity), true
+	case "AuditEntry.id":
+		if e.complexity.AuditEntry.ID == nil {
+			break
+		}
 
-	case "DartaStatusCount.count":
-		if e.complexity.DartaStatusCount.Count == nil {
+		return e.complexity.AuditEntry.ID(childComplexity), true
+	case "AuditEntry.notes":
+		if e.complexity.AuditEntry.Notes == nil {
 			break
 		}
 
-		return e.complexity.DartaStatusCount.Count(childComplexity), true
-	case "DartaStatusCount.status":
-		if e.complexity.DartaStatusCount.Status == nil {
+		return e.complexity.AuditEntry.Notes(childComplexity), true
+	case "AuditEntry.performedAt":
+		if e.complexity.AuditEntry.PerformedAt == nil {
 			break
 		}
 
-		return e.complexity.DartaStatusCount.Status(childComplexity), true
+		return e.complexity.AuditEntry.PerformedAt(childComplexity), true
+	case "AuditEntry.performedBy":
+		if e.complexity.AuditEntry.PerformedBy == nil {
+			break
+		}
 
-	case "HealthStatus.service":
-		if e.complexity.HealthStatus.Service == nil {
+		return e.complexity.AuditEntry.PerformedBy(childComplexity), true
+	case "AuditEntry.requestId":
+		if e.complexity.AuditEntry.RequestID == nil {
 			break
 		}
 
-		return e.complexity.HealthStatus.Service(childComplexity), true
-	case "HealthStatus.status":
-		if e.complexity.HealthStatus.Status == nil {
+		return e.complexity.AuditEntry.RequestID(childComplexity), true
+	case "AuditEntry.traceId":
+		if e.complexity.AuditEntry.TraceID == nil {
 			break
 		}
 
-		return e.complexity.HealthStatus.Status(childComplexity), true
-	case "HealthStatus.timestamp":
-		if e.complexity.HealthStatus.Timestamp == nil {
+		return e.complexity.AuditEntry.TraceID(childComplexity), true
+
+	case "AuditEntryConnection.edges":
+		if e.complexity.AuditEntryConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.HealthStatus.Timestamp(childComplexity), true
+		return e.complexity.AuditEntryConnection.Edges(childComplexity), true
+	case "AuditEntryConnection.pageInfo":
+		if e.complexity.AuditEntryConnection.PageInfo == nil {
+			break
+		}
 
-	case "Mutation.classifyDarta":
-		if e.complexity.Mutation.ClassifyDarta == nil {
+		return e.complexity.AuditEntryConnection.PageInfo(childComplexity), true
+
+	case "AuditEntryEdge.cursor":
+		if e.complexity.AuditEntryEdge.Cursor == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_classifyDarta_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AuditEntryEdge.Cursor(childComplexity), true
+	case "AuditEntryEdge.node":
+		if e.complexity.AuditEntryEdge.Node == nil {
+			break
 		}
 
-		return e.complexity.Mutation.ClassifyDarta(childComplexity, args["dartaId"].(string), args["classificationCode"].(string)), true
-	case "Mutation.closeDarta":
-		if e.complexity.Mutation.CloseDarta == nil {
+		return e.complexity.AuditEntryEdge.Node(childComplexity), true
+
+	case "AuditFieldChange.after":
+		if e.complexity.AuditFieldChange.After == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_closeDarta_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AuditFieldChange.After(childComplexity), true
+	case "AuditFieldChange.before":
+		if e.complexity.AuditFieldChange.Before == nil {
+			break
 		}
 
-		return e.complexity.Mutation.CloseDarta(childComplexity, args["dartaId"].(string)), true
-	case "Mutation.createDarta":
-		if e.complexity.Mutation.CreateDarta == nil {
+		return e.complexity.AuditFieldChange.Before(childComplexity), true
+	case "AuditFieldChange.field":
+		if e.complexity.AuditFieldChange.Field == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createDarta_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AuditFieldChange.Field(childComplexity), true
+
+	case "Chalani.body":
+		if e.complexity.Chalani.Body == nil {
+			break
 		}
 
-		return e.complexity.Mutation.CreateDarta(childComplexity, args["input"].(model.CreateDartaInput)), true
-	case "Mutation.finalizeDartaRegistration":
-		if e.complexity.Mutation.FinalizeDartaRegistration == nil {
+		return e.complexity.Chalani.Body(childComplexity), true
+	case "Chalani.chalaniNumber":
+		if e.complexity.Chalani.ChalaniNumber == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_finalizeDartaRegistration_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Chalani.ChalaniNumber(childComplexity), true
+	case "Chalani.createdAt":
+		if e.complexity.Chalani.CreatedAt == nil {
+			break
 		}
 
-		return e.complexity.Mutation.FinalizeDartaRegistration(childComplexity, args["dartaId"].(string)), true
-	case "Mutation.reserveDartaNumber":
-		if e.complexity.Mutation.ReserveDartaNumber == nil {
+		return e.complexity.Chalani.CreatedAt(childComplexity), true
+	case "Chalani.deliveredAt":
+		if e.complexity.Chalani.DeliveredAt == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_reserveDartaNumber_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Chalani.DeliveredAt(childComplexity), true
+	case "Chalani.dispatchChannel":
+		if e.complexity.Chalani.DispatchChannel == nil {
+			break
 		}
 
-		return e.complexity.Mutation.ReserveDartaNumber(childComplexity, args["dartaId"].(string)), true
-	case "Mutation.routeDarta":
-		if e.complexity.Mutation.RouteDarta == nil {
+		return e.complexity.Chalani.DispatchChannel(childComplexity), true
+	case "Chalani.dispatchedAt":
+		if e.complexity.Chalani.DispatchedAt == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_routeDarta_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Chalani.DispatchedAt(childComplexity), true
+	case "Chalani.fiscalYearId":
+		if e.complexity.Chalani.FiscalYearID == nil {
+			break
 		}
 
-		return e.complexity.Mutation.RouteDarta(childComplexity, args["input"].(model.RouteDartaInput)), true
-	case "Mutation.submitDartaForReview":
-		if e.complexity.Mutation.SubmitDartaForReview == nil {
+		return e.complexity.Chalani.FiscalYearID(childComplexity), true
+	case "Chalani.formattedChalaniNumber":
+		if e.complexity.Chalani.FormattedChalaniNumber == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_submitDartaForReview_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Chalani.FormattedChalaniNumber(childComplexity), true
+	case "Chalani.id":
+		if e.complexity.Chalani.ID == nil {
+			break
 		}
 
-		return e.complexity.Mutation.SubmitDartaForReview(childComplexity, args["dartaId"].(string)), true
-	case "Mutation.voidDarta":
-		if e.complexity.Mutation.VoidDarta == nil {
+		return e.complexity.Chalani.ID(childComplexity), true
+	case "Chalani.scope":
+		if e.complexity.Chalani.Scope == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_voidDarta_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Chalani.Scope(childComplexity), true
+	case "Chalani.status":
+		if e.complexity.Chalani.Status == nil {
+			break
 		}
 
-		return e.complexity.Mutation.VoidDarta(childComplexity, args["dartaId"].(string), args["reason"].(string)), true
+		return e.complexity.Chalani.Status(childComplexity), true
+	case "Chalani.subject":
+		if e.complexity.Chalani.Subject == nil {
+			break
+		}
 
-	case "PageInfo.hasNextPage":
-		if e.complexity.PageInfo.HasNextPage == nil {
+		return e.complexity.Chalani.Subject(childComplexity), true
+	case "Chalani.timeline":
+		if e.complexity.Chalani.Timeline == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.HasNextPage(childComplexity), true
-	case "PageInfo.hasPreviousPage":
-		if e.complexity.PageInfo.HasPreviousPage == nil {
+		return e.complexity.Chalani.Timeline(childComplexity), true
+	case "Chalani.updatedAt":
+		if e.complexity.Chalani.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
-	case "PageInfo.totalCount":
-		if e.complexity.PageInfo.TotalCount == nil {
+		return e.complexity.Chalani.UpdatedAt(childComplexity), true
+	case "Chalani.wardId":
+		if e.complexity.Chalani.WardID == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.TotalCount(childComplexity), true
+		return e.complexity.Chalani.WardID(childComplexity), true
 
-	case "Query.darta":
-		if e.complexity.Query.Darta == nil {
+	case "ChalaniDispatchEvent.action":
+		if e.complexity.ChalaniDispatchEvent.Action == nil {
 			break
 		}
 
-		args, err := ec.field_Query_darta_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ChalaniDispatchEvent.Action(childComplexity), true
+	case "ChalaniDispatchEvent.actorId":
+		if e.complexity.ChalaniDispatchEvent.ActorID == nil {
+			break
 		}
 
-		return e.complexity.Query.Darta(childComplexity, args["id"].(string)), true
-	case "Query.dartaStats":
-		if e.complexity.Query.DartaStats == nil {
+		return e.complexity.ChalaniDispatchEvent.ActorID(childComplexity), true
+	case "ChalaniDispatchEvent.chalaniId":
+		if e.complexity.ChalaniDispatchEvent.ChalaniID == nil {
 			break
 		}
 
-		args, err := ec.field_Query_dartaStats_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ChalaniDispatchEvent.ChalaniID(childComplexity), true
+	case "ChalaniDispatchEvent.deliveredAt":
+		if e.complexity.ChalaniDispatchEvent.DeliveredAt == nil {
+			break
 		}
 
-		return e.complexity.Query.DartaStats(childComplexity, args["scope"].(*model.Scope), args["fiscalYearId"].(*string), args["wardId"].(*string)), true
-	case "Query.dartas":
-		if e.complexity.Query.Dartas == nil {
+		return e.complexity.ChalaniDispatchEvent.DeliveredAt(childComplexity), true
+	case "ChalaniDispatchEvent.dispatchedAt":
+		if e.complexity.ChalaniDispatchEvent.DispatchedAt == nil {
 			break
 		}
 
-		args, err := ec.field_Query_dartas_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ChalaniDispatchEvent.DispatchedAt(childComplexity), true
+	case "ChalaniDispatchEvent.formattedChalaniNumber":
+		if e.complexity.ChalaniDispatchEvent.FormattedChalaniNumber == nil {
+			break
 		}
 
-		return e.complexity.Query.Dartas(childComplexity, args["filter"].(*model.DartaFilterInput), args["pagination"].(*model.PaginationInput)), true
-	case "Query.health":
-		if e.complexity.Query.Health == nil {
+		return e.complexity.ChalaniDispatchEvent.FormattedChalaniNumber(childComplexity), true
+	case "ChalaniDispatchEvent.occurredAt":
+		if e.complexity.ChalaniDispatchEvent.OccurredAt == nil {
 			break
 		}
 
-		return e.complexity.Query.Health(childComplexity), true
-	case "Query.myDartas":
-		if e.complexity.Query.MyDartas == nil {
+		return e.complexity.ChalaniDispatchEvent.OccurredAt(childComplexity), true
+	case "ChalaniDispatchEvent.status":
+		if e.complexity.ChalaniDispatchEvent.Status == nil {
 			break
 		}
 
-		args, err := ec.field_Query_myDartas_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ChalaniDispatchEvent.Status(childComplexity), true
+	case "ChalaniDispatchEvent.subject":
+		if e.complexity.ChalaniDispatchEvent.Subject == nil {
+			break
 		}
 
-		return e.complexity.Query.MyDartas(childComplexity, args["status"].(*model.DartaStatus), args["pagination"].(*model.PaginationInput)), true
+		return e.complexity.ChalaniDispatchEvent.Subject(childComplexity), true
+	case "ChalaniDispatchEvent.trackingId":
+		if e.complexity.ChalaniDispatchEvent.TrackingID == nil {
+			break
+		}
 
-	}
-	return 0, false
-}
+		return e.complexity.ChalaniDispatchEvent.TrackingID(childComplexity), true
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	opCtx := graphql.GetOperationContext(ctx)
-	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
-		ec.unmarshalInputApplicantInput,
-		ec.unmarshalInputCreateDartaInput,
-		ec.unmarshalInputDartaFilterInput,
-		ec.unmarshalInputPaginationInput,
-		ec.unmarshalInputRouteDartaInput,
-	)
-	first := true
+	case "ChannelCount.channel":
+		if e.complexity.ChannelCount.Channel == nil {
+			break
+		}
 
-	switch opCtx.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			var response graphql.Response
-			var data graphql.Marshaler
-			if first {
-				first = false
-				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
-			} else {
-				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
-					result := <-ec.deferredResults
-					atomic.AddInt32(&ec.pendingDeferred, -1)
-					data = result.Result
-					response.Path = result.Path
-					response.Label = result.Label
-					response.Errors = result.Errors
-				} else {
-					return nil
-				}
-			}
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
-			response.Data = buf.Bytes()
-			if atomic.LoadInt32(&ec.deferred) > 0 {
-				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
-				response.HasNext = &hasNext
-			}
+		return e.complexity.ChannelCount.Channel(childComplexity), true
+	case "ChannelCount.count":
+		if e.complexity.ChannelCount.Count == nil {
+			break
+		}
 
-			return &response
+		return e.complexity.ChannelCount.Count(childComplexity), true
+
+	case "Darta.applicant":
+		if e.complexity.Darta.Applicant == nil {
+			break
 		}
-	case ast.Mutation:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		return e.complexity.Darta.Applicant(childComplexity), true
+	case "Darta.assignee":
+		if e.complexity.Darta.Assignee == nil {
+			break
 		}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
-	}
-}
+		return e.complexity.Darta.Assignee(childComplexity), true
+	case "Darta.attachments":
+		if e.complexity.Darta.Attachments == nil {
+			break
+		}
 
-type executionContext struct {
-	*graphql.OperationContext
-	*executableSchema
-	deferred        int32
-	pendingDeferred int32
-	deferredResults chan graphql.DeferredResult
-}
+		return e.complexity.Darta.Attachments(childComplexity), true
+	case "Darta.auditTrail":
+		if e.complexity.Darta.AuditTrail == nil {
+			break
+		}
 
-func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
-	atomic.AddInt32(&ec.pendingDeferred, 1)
-	go func() {
-		ctx := graphql.WithFreshResponseContext(dg.Context)
-		dg.FieldSet.Dispatch(ctx)
-		ds := graphql.DeferredResult{
-			Path:   dg.Path,
-			Label:  dg.Label,
-			Result: dg.FieldSet,
-			Errors: graphql.GetErrors(ctx),
+		return e.complexity.Darta.AuditTrail(childComplexity), true
+	case "Darta.createdAt":
+		if e.complexity.Darta.CreatedAt == nil {
+			break
 		}
-		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
-			ds.Result = graphql.Null
+
+		return e.complexity.Darta.CreatedAt(childComplexity), true
+	case "Darta.createdBy":
+		if e.complexity.Darta.CreatedBy == nil {
+			break
 		}
-		ec.deferredResults <- ds
-	}()
-}
 
-func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapSchema(ec.Schema()), nil
-}
+		return e.complexity.Darta.CreatedBy(childComplexity), true
+	case "Darta.dartaNumber":
+		if e.complexity.Darta.DartaNumber == nil {
+			break
+		}
 
-func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
-}
+		return e.complexity.Darta.DartaNumber(childComplexity), true
+	case "Darta.entryDate":
+		if e.complexity.Darta.EntryDate == nil {
+			break
+		}
 
-var sources = []*ast.Source{
-	{Name: "../schema/schema.graphql", Input: `# Import types from api-schema package
-# This will be the main schema file that combines everything
+		return e.complexity.Darta.EntryDate(childComplexity), true
+	case "Darta.fiscalYearId":
+		if e.complexity.Darta.FiscalYearID == nil {
+			break
+		}
 
-type Query {
-  # Health check
-  health: HealthStatus!
-  
-  # Darta queries
-  darta(id: ID!): Darta
-  dartas(filter: DartaFilterInput, pagination: PaginationInput): DartaConnection!
-  myDartas(status: DartaStatus, pagination: PaginationInput): DartaConnection!
-  dartaStats(scope: Scope, fiscalYearId: String, wardId: String): DartaStats!
-}
+		return e.complexity.Darta.FiscalYearID(childComplexity), true
+	case "Darta.formattedDartaNumber":
+		if e.complexity.Darta.FormattedDartaNumber == nil {
+			break
+		}
 
-type Mutation {
-  # Darta mutations
-  createDarta(input: CreateDartaInput!): Darta!
-  submitDartaForReview(dartaId: ID!): Darta!
-  classifyDarta(dartaId: ID!, classificationCode: String!): Darta!
-  reserveDartaNumber(dartaId: ID!): Darta!
-  finalizeDartaRegistration(dartaId: ID!): Darta!
-  routeDarta(input: RouteDartaInput!): Darta!
-  closeDarta(dartaId: ID!): Darta!
-  voidDarta(dartaId: ID!, reason: String!): Darta!
-}
+		return e.complexity.Darta.FormattedDartaNumber(childComplexity), true
+	case "Darta.id":
+		if e.complexity.Darta.ID == nil {
+			break
+		}
 
-# Types
-type HealthStatus {
-  status: String!
-  service: String!
-  timestamp: String!
-}
+		return e.complexity.Darta.ID(childComplexity), true
+	case "Darta.intakeChannel":
+		if e.complexity.Darta.IntakeChannel == nil {
+			break
+		}
 
-type Darta {
-  id: ID!
-  dartaNumber: Int
-  formattedDartaNumber: String
-  fiscalYearId: String!
-  scope: Scope!
-  wardId: String
-  subject: String!
-  applicant: Applicant!
-  intakeChannel: IntakeChannel!
-  receivedDate: String!
-  entryDate: String!
-  status: DartaStatus!
-  priority: Priority!
-  createdBy: String!
-  createdAt: String!
-  updatedAt: String!
-  tenantId: String!
-}
+		return e.complexity.Darta.IntakeChannel(childComplexity), true
+	case "Darta.metadata":
+		if e.complexity.Darta.Metadata == nil {
+			break
+		}
 
-type Applicant {
-  id: ID!
-  type: ApplicantType!
-  fullName: String!
-  organization: String
-  email: String
-  phone: String
-  address: String
-}
+		return e.complexity.Darta.Metadata(childComplexity), true
+	case "Darta.priority":
+		if e.complexity.Darta.Priority == nil {
+			break
+		}
 
-type DartaConnection {
-  edges: [DartaEdge!]!
-  pageInfo: PageInfo!
-}
+		return e.complexity.Darta.Priority(childComplexity), true
+	case "Darta.receivedDate":
+		if e.complexity.Darta.ReceivedDate == nil {
+			break
+		}
 
-type DartaEdge {
-  cursor: String!
-  node: Darta!
-}
+		return e.complexity.Darta.ReceivedDate(childComplexity), true
+	case "Darta.relatedDartas":
+		if e.complexity.Darta.RelatedDartas == nil {
+			break
+		}
 
-type PageInfo {
-  hasNextPage: Boolean!
-  hasPreviousPage: Boolean!
-  totalCount: Int!
-}
+		return e.complexity.Darta.RelatedDartas(childComplexity), true
+	case "Darta.scope":
+		if e.complexity.Darta.Scope == nil {
+			break
+		}
 
-type DartaStats {
-  total: Int!
-  byStatus: [DartaStatusCount!]!
-  byChannel: [ChannelCount!]!
-  overdueCount: Int!
-}
+		return e.complexity.Darta.Scope(childComplexity), true
+	case "Darta.status":
+		if e.complexity.Darta.Status == nil {
+			break
+		}
 
-type DartaStatusCount {
-  status: DartaStatus!
-  count: Int!
-}
+		return e.complexity.Darta.Status(childComplexity), true
+	case "Darta.subject":
+		if e.complexity.Darta.Subject == nil {
+			break
+		}
 
-type ChannelCount {
-  channel: IntakeChannel!
-  count: Int!
-}
+		return e.complexity.Darta.Subject(childComplexity), true
+	case "Darta.supersededBy":
+		if e.complexity.Darta.SupersededBy == nil {
+			break
+		}
 
-# Enums
-enum Scope {
-  MUNICIPALITY
-  WARD
-}
+		return e.complexity.Darta.SupersededBy(childComplexity), true
+	case "Darta.supersedes":
+		if e.complexity.Darta.Supersedes == nil {
+			break
+		}
 
-enum Priority {
-  LOW
-  MEDIUM
-  HIGH
-  URGENT
-}
+		return e.complexity.Darta.Supersedes(childComplexity), true
+	case "Darta.supersedesDartaNumber":
+		if e.complexity.Darta.SupersedesDartaNumber == nil {
+			break
+		}
 
-enum IntakeChannel {
-  COUNTER
-  POSTAL
-  EMAIL
-  EDARTA_PORTAL
-  COURIER
-}
+		return e.complexity.Darta.SupersedesDartaNumber(childComplexity), true
+	case "Darta.supersessionChain":
+		if e.complexity.Darta.SupersessionChain == nil {
+			break
+		}
 
-enum DartaStatus {
-  DRAFT
-  PENDING_REVIEW
-  CLASSIFICATION
-  NUMBER_RESERVED
-  REGISTERED
-  VOIDED
-  SCANNED
-  METADATA_ENRICHED
-  DIGITALLY_ARCHIVED
-  ASSIGNED
-  IN_REVIEW_BY_SECTION
-  NEEDS_CLARIFICATION
-  ACCEPTED
-  ACTION_TAKEN
-  RESPONSE_ISSUED
-  ACK_REQUESTED
-  ACK_RECEIVED
-  SUPERSEDED
-  CLOSED
-}
+		return e.complexity.Darta.SupersessionChain(childComplexity), true
+	case "Darta.suspectedDuplicates":
+		if e.complexity.Darta.SuspectedDuplicates == nil {
+			break
+		}
 
-enum ApplicantType {
-  CITIZEN
-  ORGANIZATION
-  GOVERNMENT_OFFICE
-  OTHER
-}
+		return e.complexity.Darta.SuspectedDuplicates(childComplexity), true
+	case "Darta.tenantId":
+		if e.complexity.Darta.TenantID == nil {
+			break
+		}
 
-# Inputs
-input CreateDartaInput {
-  fiscalYearId: String!
-  scope: Scope!
-  wardId: String
-  subject: String!
-  applicant: ApplicantInput!
-  intakeChannel: IntakeChannel!
-  receivedDate: String!
-  primaryDocumentId: ID!
-  annexIds: [ID!]
-  priority: Priority!
-  idempotencyKey: String!
-}
+		return e.complexity.Darta.TenantID(childComplexity), true
+	case "Darta.timeline":
+		if e.complexity.Darta.Timeline == nil {
+			break
+		}
 
-input ApplicantInput {
-  type: ApplicantType!
-  fullName: String!
-  organization: String
-  email: String
-  phone: String
-  address: String
-  identificationNumber: String
-}
+		return e.complexity.Darta.Timeline(childComplexity), true
+	case "Darta.updatedAt":
+		if e.complexity.Darta.UpdatedAt == nil {
+			break
+		}
 
-input RouteDartaInput {
-  dartaId: ID!
-  organizationalUnitId: String
-  assigneeId: String
-  priority: Priority
-  slaHours: Int
-  notes: String
-}
+		return e.complexity.Darta.UpdatedAt(childComplexity), true
+	case "Darta.version":
+		if e.complexity.Darta.Version == nil {
+			break
+		}
 
-input DartaFilterInput {
-  fiscalYearId: String
-  scope: Scope
-  wardId: String
-  status: DartaStatus
-  priority: Priority
-  organizationalUnitId: String
-  assigneeId: String
-  intakeChannel: IntakeChannel
-  fromDate: String
-  toDate: String
-  search: String
-  isOverdue: Boolean
-}
+		return e.complexity.Darta.Version(childComplexity), true
+	case "Darta.wardId":
+		if e.complexity.Darta.WardID == nil {
+			break
+		}
 
-input PaginationInput {
-  limit: Int
-  offset: Int
-  after: String
-  before: String
-  sortBy: String
-  sortDesc: Boolean
-}
-`, BuiltIn: false},
-}
-var parsedSchema = gqlparser.MustLoadSchema(sources...)
+		return e.complexity.Darta.WardID(childComplexity), true
 
-// endregion ************************** generated!.gotpl **************************
+	case "DartaConnection.edges":
+		if e.complexity.DartaConnection.Edges == nil {
+			break
+		}
 
-// region    ***************************** args.gotpl *****************************
+		return e.complexity.DartaConnection.Edges(childComplexity), true
+	case "DartaConnection.pageInfo":
+		if e.complexity.DartaConnection.PageInfo == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_classifyDarta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["dartaId"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "classificationCode", ec.unmarshalNString2string)
-	if err != nil {
-		return nil, err
-	}
-	args["classificationCode"] = arg1
-	return args, nil
-}
+		return e.complexity.DartaConnection.PageInfo(childComplexity), true
 
-func (ec *executionContext) field_Mutation_closeDarta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["dartaId"] = arg0
-	return args, nil
-}
+	case "DartaEdge.cursor":
+		if e.complexity.DartaEdge.Cursor == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_createDarta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateDartaInput2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐCreateDartaInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		return e.complexity.DartaEdge.Cursor(childComplexity), true
+	case "DartaEdge.node":
+		if e.complexity.DartaEdge.Node == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_finalizeDartaRegistration_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["dartaId"] = arg0
-	return args, nil
-}
+		return e.complexity.DartaEdge.Node(childComplexity), true
 
-func (ec *executionContext) field_Mutation_reserveDartaNumber_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["dartaId"] = arg0
-	return args, nil
-}
+	case "DartaEvent.action":
+		if e.complexity.DartaEvent.Action == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_routeDarta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNRouteDartaInput2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRouteDartaInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		return e.complexity.DartaEvent.Action(childComplexity), true
+	case "DartaEvent.actorId":
+		if e.complexity.DartaEvent.ActorID == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_submitDartaForReview_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["dartaId"] = arg0
-	return args, nil
-}
+		return e.complexity.DartaEvent.ActorID(childComplexity), true
+	case "DartaEvent.darta":
+		if e.complexity.DartaEvent.Darta == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_voidDarta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["dartaId"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "reason", ec.unmarshalNString2string)
-	if err != nil {
-		return nil, err
-	}
-	args["reason"] = arg1
-	return args, nil
-}
+		return e.complexity.DartaEvent.Darta(childComplexity), true
+	case "DartaEvent.escalatedToId":
+		if e.complexity.DartaEvent.EscalatedToID == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
-	if err != nil {
-		return nil, err
-	}
-	args["name"] = arg0
-	return args, nil
-}
+		return e.complexity.DartaEvent.EscalatedToID(childComplexity), true
+	case "DartaEvent.occurredAt":
+		if e.complexity.DartaEvent.OccurredAt == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_dartaStats_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "scope", ec.unmarshalOScope2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐScope)
-	if err != nil {
-		return nil, err
-	}
-	args["scope"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "fiscalYearId", ec.unmarshalOString2ᚖstring)
-	if err != nil {
-		return nil, err
-	}
-	args["fiscalYearId"] = arg1
-	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "wardId", ec.unmarshalOString2ᚖstring)
-	if err != nil {
-		return nil, err
-	}
-	args["wardId"] = arg2
-	return args, nil
-}
+		return e.complexity.DartaEvent.OccurredAt(childComplexity), true
+	case "DartaEvent.previousAssigneeId":
+		if e.complexity.DartaEvent.PreviousAssigneeID == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_darta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		return e.complexity.DartaEvent.PreviousAssigneeID(childComplexity), true
+	case "DartaEvent.slaStage":
+		if e.complexity.DartaEvent.SLAStage == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_dartas_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalODartaFilterInput2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaFilterInput)
-	if err != nil {
-		return nil, err
-	}
-	args["filter"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "pagination", ec.unmarshalOPaginationInput2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPaginationInput)
-	if err != nil {
-		return nil, err
-	}
-	args["pagination"] = arg1
-	return args, nil
-}
+		return e.complexity.DartaEvent.SLAStage(childComplexity), true
 
-func (ec *executionContext) field_Query_myDartas_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "status", ec.unmarshalODartaStatus2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatus)
-	if err != nil {
-		return nil, err
-	}
-	args["status"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "pagination", ec.unmarshalOPaginationInput2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPaginationInput)
-	if err != nil {
-		return nil, err
-	}
-	args["pagination"] = arg1
-	return args, nil
-}
+	case "DartaStats.avgProcessingTimeHours":
+		if e.complexity.DartaStats.AvgProcessingTimeHours == nil {
+			break
+		}
 
-func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.DartaStats.AvgProcessingTimeHours(childComplexity), true
+	case "DartaStats.byChannel":
+		if e.complexity.DartaStats.ByChannel == nil {
+			break
+		}
 
-func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.DartaStats.ByChannel(childComplexity), true
+	case "DartaStats.byIntakeChannel":
+		if e.complexity.DartaStats.ByIntakeChannel == nil {
+			break
+		}
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.DartaStats.ByIntakeChannel(childComplexity), true
+	case "DartaStats.byPriority":
+		if e.complexity.DartaStats.ByPriority == nil {
+			break
+		}
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.DartaStats.ByPriority(childComplexity), true
+	case "DartaStats.bySection":
+		if e.complexity.DartaStats.BySection == nil {
+			break
+		}
 
-// endregion ***************************** args.gotpl *****************************
+		return e.complexity.DartaStats.BySection(childComplexity), true
+	case "DartaStats.byStatus":
+		if e.complexity.DartaStats.ByStatus == nil {
+			break
+		}
 
-// region    ************************** directives.gotpl **************************
+		return e.complexity.DartaStats.ByStatus(childComplexity), true
+	case "DartaStats.byWard":
+		if e.complexity.DartaStats.ByWard == nil {
+			break
+		}
 
-// endregion ************************** directives.gotpl **************************
+		return e.complexity.DartaStats.ByWard(childComplexity), true
+	case "DartaStats.overdueCount":
+		if e.complexity.DartaStats.OverdueCount == nil {
+			break
+		}
 
-// region    **************************** field.gotpl *****************************
+		return e.complexity.DartaStats.OverdueCount(childComplexity), true
+	case "DartaStats.receivedToRegistered":
+		if e.complexity.DartaStats.ReceivedToRegistered == nil {
+			break
+		}
 
-func (ec *executionContext) _Applicant_id(ctx context.Context, field graphql.CollectedField, obj *model.Applicant) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Applicant_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.DartaStats.ReceivedToRegistered(childComplexity), true
+	case "DartaStats.registeredToClosed":
+		if e.complexity.DartaStats.RegisteredToClosed == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Applicant_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Applicant",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.DartaStats.RegisteredToClosed(childComplexity), true
+	case "DartaStats.series":
+		if e.complexity.DartaStats.Series == nil {
+			break
+		}
 
-func (ec *executionContext) _Applicant_type(ctx context.Context, field graphql.CollectedField, obj *model.Applicant) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Applicant_type,
-		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
-		},
-		nil,
-		ec.marshalNApplicantType2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicantType,
-		true,
-		true,
-	)
-}
+		return e.complexity.DartaStats.Series(childComplexity), true
+	case "DartaStats.total":
+		if e.complexity.DartaStats.Total == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Applicant_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Applicant",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ApplicantType does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.DartaStats.Total(childComplexity), true
 
-func (ec *executionContext) _Applicant_fullName(ctx context.Context, field graphql.CollectedField, obj *model.Applicant) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Applicant_fullName,
-		func(ctx context.Context) (any, error) {
-			return obj.FullName, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	case "DartaStatsBreakdown.key":
+		if e.complexity.DartaStatsBreakdown.Key == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Applicant_fullName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Applicant",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.DartaStatsBreakdown.Key(childComplexity), true
+	case "DartaStatsBreakdown.overdueCount":
+		if e.complexity.DartaStatsBreakdown.OverdueCount == nil {
+			break
+		}
 
-func (ec *executionContext) _Applicant_organization(ctx context.Context, field graphql.CollectedField, obj *model.Applicant) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Applicant_organization,
-		func(ctx context.Context) (any, error) {
-			return obj.Organization, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+		return e.complexity.DartaStatsBreakdown.OverdueCount(childComplexity), true
+	case "DartaStatsBreakdown.receivedToRegistered":
+		if e.complexity.DartaStatsBreakdown.ReceivedToRegistered == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Applicant_organization(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Applicant",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.DartaStatsBreakdown.ReceivedToRegistered(childComplexity), true
+	case "DartaStatsBreakdown.registeredToClosed":
+		if e.complexity.DartaStatsBreakdown.RegisteredToClosed == nil {
+			break
+		}
 
-func (ec *executionContext) _Applicant_email(ctx context.Context, field graphql.CollectedField, obj *model.Applicant) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Applicant_email,
-		func(ctx context.Context) (any, error) {
-			return obj.Email, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+		return e.complexity.DartaStatsBreakdown.RegisteredToClosed(childComplexity), true
+	case "DartaStatsBreakdown.sectionTurnaround":
+		if e.complexity.DartaStatsBreakdown.SectionTurnaround == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Applicant_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Applicant",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.DartaStatsBreakdown.SectionTurnaround(childComplexity), true
+	case "DartaStatsBreakdown.total":
+		if e.complexity.DartaStatsBreakdown.Total == nil {
+			break
+		}
 
-func (ec *executionContext) _Applicant_phone(ctx context.Context, field graphql.CollectedField, obj *model.Applicant) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Applicant_phone,
-		func(ctx context.Context) (any, error) {
-			return obj.Phone, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+		return e.complexity.DartaStatsBreakdown.Total(childComplexity), true
 
-func (ec *executionContext) fieldContext_Applicant_phone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Applicant",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	case "DartaStatsBucket.closed":
+		if e.complexity.DartaStatsBucket.Closed == nil {
+			break
+		}
 
-func (ec *executionContext) _Applicant_address(ctx context.Context, field graphql.CollectedField, obj *model.Applicant) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Applicant_address,
-		func(ctx context.Context) (any, error) {
-			return obj.Address, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+		return e.complexity.DartaStatsBucket.Closed(childComplexity), true
+	case "DartaStatsBucket.received":
+		if e.complexity.DartaStatsBucket.Received == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Applicant_address(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Applicant",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.DartaStatsBucket.Received(childComplexity), true
+	case "DartaStatsBucket.registered":
+		if e.complexity.DartaStatsBucket.Registered == nil {
+			break
+		}
 
-func (ec *executionContext) _ChannelCount_channel(ctx context.Context, field graphql.CollectedField, obj *model.ChannelCount) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_ChannelCount_channel,
-		func(ctx context.Context) (any, error) {
-			return obj.Channel, nil
-		},
-		nil,
-		ec.marshalNIntakeChannel2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐIntakeChannel,
-		true,
-		true,
-	)
-}
+		return e.complexity.DartaStatsBucket.Registered(childComplexity), true
+	case "DartaStatsBucket.start":
+		if e.complexity.DartaStatsBucket.Start == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_ChannelCount_channel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ChannelCount",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type IntakeChannel does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.DartaStatsBucket.Start(childComplexity), true
+	case "DartaStatsBucket.startBs":
+		if e.complexity.DartaStatsBucket.StartBs == nil {
+			break
+		}
 
-func (ec *executionContext) _ChannelCount_count(ctx context.Context, field graphql.CollectedField, obj *model.ChannelCount) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_ChannelCount_count,
-		func(ctx context.Context) (any, error) {
-			return obj.Count, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.DartaStatsBucket.StartBs(childComplexity), true
 
-func (ec *executionContext) fieldContext_ChannelCount_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ChannelCount",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	case "DartaStatusCount.count":
+		if e.complexity.DartaStatusCount.Count == nil {
+			break
+		}
 
-func (ec *executionContext) _Darta_id(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.DartaStatusCount.Count(childComplexity), true
+	case "DartaStatusCount.status":
+		if e.complexity.DartaStatusCount.Status == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.DartaStatusCount.Status(childComplexity), true
 
-func (ec *executionContext) _Darta_dartaNumber(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_dartaNumber,
-		func(ctx context.Context) (any, error) {
-			return obj.DartaNumber, nil
-		},
-		nil,
-		ec.marshalOInt2ᚖint,
-		true,
-		false,
-	)
-}
+	case "DuplicateCandidate.applicantName":
+		if e.complexity.DuplicateCandidate.ApplicantName == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_dartaNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.DuplicateCandidate.ApplicantName(childComplexity), true
+	case "DuplicateCandidate.dartaId":
+		if e.complexity.DuplicateCandidate.DartaID == nil {
+			break
+		}
 
-func (ec *executionContext) _Darta_formattedDartaNumber(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_formattedDartaNumber,
-		func(ctx context.Context) (any, error) {
-			return obj.FormattedDartaNumber, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+		return e.complexity.DuplicateCandidate.DartaID(childComplexity), true
+	case "DuplicateCandidate.formattedDartaNumber":
+		if e.complexity.DuplicateCandidate.FormattedDartaNumber == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_formattedDartaNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.DuplicateCandidate.FormattedDartaNumber(childComplexity), true
+	case "DuplicateCandidate.reasons":
+		if e.complexity.DuplicateCandidate.Reasons == nil {
+			break
+		}
 
-func (ec *executionContext) _Darta_fiscalYearId(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_fiscalYearId,
-		func(ctx context.Context) (any, error) {
-			return obj.FiscalYearID, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.DuplicateCandidate.Reasons(childComplexity), true
+	case "DuplicateCandidate.receivedDate":
+		if e.complexity.DuplicateCandidate.ReceivedDate == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_fiscalYearId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.DuplicateCandidate.ReceivedDate(childComplexity), true
+	case "DuplicateCandidate.score":
+		if e.complexity.DuplicateCandidate.Score == nil {
+			break
+		}
 
-func (ec *executionContext) _Darta_scope(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_scope,
-		func(ctx context.Context) (any, error) {
-			return obj.Scope, nil
-		},
-		nil,
-		ec.marshalNScope2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐScope,
-		true,
-		true,
-	)
-}
+		return e.complexity.DuplicateCandidate.Score(childComplexity), true
+	case "DuplicateCandidate.status":
+		if e.complexity.DuplicateCandidate.Status == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_scope(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Scope does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.DuplicateCandidate.Status(childComplexity), true
+	case "DuplicateCandidate.subject":
+		if e.complexity.DuplicateCandidate.Subject == nil {
+			break
+		}
 
-func (ec *executionContext) _Darta_wardId(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_wardId,
-		func(ctx context.Context) (any, error) {
-			return obj.WardID, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+		return e.complexity.DuplicateCandidate.Subject(childComplexity), true
+	case "DuplicateCandidate.wardId":
+		if e.complexity.DuplicateCandidate.WardID == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_wardId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.DuplicateCandidate.WardID(childComplexity), true
 
-func (ec *executionContext) _Darta_subject(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_subject,
-		func(ctx context.Context) (any, error) {
-			return obj.Subject, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	case "DurationStats.avgHours":
+		if e.complexity.DurationStats.AvgHours == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_subject(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.DurationStats.AvgHours(childComplexity), true
+	case "DurationStats.count":
+		if e.complexity.DurationStats.Count == nil {
+			break
+		}
 
-func (ec *executionContext) _Darta_applicant(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_applicant,
-		func(ctx context.Context) (any, error) {
-			return obj.Applicant, nil
-		},
-		nil,
-		ec.marshalNApplicant2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicant,
-		true,
-		true,
-	)
-}
+		return e.complexity.DurationStats.Count(childComplexity), true
+	case "DurationStats.p90Hours":
+		if e.complexity.DurationStats.P90Hours == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_applicant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Applicant_id(ctx, field)
-			case "type":
-				return ec.fieldContext_Applicant_type(ctx, field)
-			case "fullName":
-				return ec.fieldContext_Applicant_fullName(ctx, field)
-			case "organization":
-				return ec.fieldContext_Applicant_organization(ctx, field)
-			case "email":
-				return ec.fieldContext_Applicant_email(ctx, field)
-			case "phone":
-				return ec.fieldContext_Applicant_phone(ctx, field)
-			case "address":
-				return ec.fieldContext_Applicant_address(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Applicant", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.DurationStats.P90Hours(childComplexity), true
 
-func (ec *executionContext) _Darta_intakeChannel(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_intakeChannel,
-		func(ctx context.Context) (any, error) {
-			return obj.IntakeChannel, nil
-		},
-		nil,
-		ec.marshalNIntakeChannel2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐIntakeChannel,
-		true,
-		true,
-	)
-}
+	case "HealthStatus.service":
+		if e.complexity.HealthStatus.Service == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_intakeChannel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type IntakeChannel does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.HealthStatus.Service(childComplexity), true
+	case "HealthStatus.status":
+		if e.complexity.HealthStatus.Status == nil {
+			break
+		}
 
-func (ec *executionContext) _Darta_receivedDate(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_receivedDate,
-		func(ctx context.Context) (any, error) {
-			return obj.ReceivedDate, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.HealthStatus.Status(childComplexity), true
+	case "HealthStatus.timestamp":
+		if e.complexity.HealthStatus.Timestamp == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_receivedDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.HealthStatus.Timestamp(childComplexity), true
 
-func (ec *executionContext) _Darta_entryDate(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_entryDate,
-		func(ctx context.Context) (any, error) {
-			return obj.EntryDate, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	case "Mutation.acceptDarta":
+		if e.complexity.Mutation.AcceptDarta == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_entryDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Mutation_acceptDarta_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Darta_status(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_status,
-		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
-		},
-		nil,
-		ec.marshalNDartaStatus2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatus,
-		true,
-		true,
-	)
-}
+		return e.complexity.Mutation.AcceptDarta(childComplexity, args["dartaId"].(string), args["expectedVersion"].(*int)), true
+	case "Mutation.approveDartaReview":
+		if e.complexity.Mutation.ApproveDartaReview == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DartaStatus does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Mutation_approveDartaReview_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Darta_priority(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_priority,
-		func(ctx context.Context) (any, error) {
-			return obj.Priority, nil
-		},
-		nil,
-		ec.marshalNPriority2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPriority,
-		true,
-		true,
-	)
-}
+		return e.complexity.Mutation.ApproveDartaReview(childComplexity, args["dartaId"].(string), args["notes"].(*string), args["expectedVersion"].(*int)), true
+	case "Mutation.archiveDartaDigital":
+		if e.complexity.Mutation.ArchiveDartaDigital == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_priority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Priority does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Mutation_archiveDartaDigital_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Darta_createdBy(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_createdBy,
-		func(ctx context.Context) (any, error) {
-			return obj.CreatedBy, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Mutation.ArchiveDartaDigital(childComplexity, args["dartaId"].(string), args["expectedVersion"].(*int)), true
+	case "Mutation.assignDartaSection":
+		if e.complexity.Mutation.AssignDartaSection == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_createdBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Mutation_assignDartaSection_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Darta_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_createdAt,
-		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Mutation.AssignDartaSection(childComplexity, args["input"].(model.AssignDartaSectionInput)), true
+	case "Mutation.classifyDarta":
+		if e.complexity.Mutation.ClassifyDarta == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Mutation_classifyDarta_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Darta_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_updatedAt,
-		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Mutation.ClassifyDarta(childComplexity, args["dartaId"].(string), args["classificationCode"].(string), args["expectedVersion"].(*int)), true
+	case "Mutation.closeDarta":
+		if e.complexity.Mutation.CloseDarta == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Mutation_closeDarta_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Darta_tenantId(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Darta_tenantId,
-		func(ctx context.Context) (any, error) {
-			return obj.TenantID, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Mutation.CloseDarta(childComplexity, args["dartaId"].(string), args["expectedVersion"].(*int)), true
+	case "Mutation.createDarta":
+		if e.complexity.Mutation.CreateDarta == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Darta_tenantId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Darta",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Mutation_createDarta_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _DartaConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.DartaConnection) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_DartaConnection_edges,
-		func(ctx context.Context) (any, error) {
-			return obj.Edges, nil
-		},
-		nil,
-		ec.marshalNDartaEdge2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaEdgeᚄ,
-		true,
-		true,
-	)
-}
+		return e.complexity.Mutation.CreateDarta(childComplexity, args["input"].(model.CreateDartaInput)), true
+	case "Mutation.directRegisterDarta":
+		if e.complexity.Mutation.DirectRegisterDarta == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_DartaConnection_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DartaConnection",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "cursor":
-				return ec.fieldContext_DartaEdge_cursor(ctx, field)
-			case "node":
-				return ec.fieldContext_DartaEdge_node(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DartaEdge", field.Name)
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Mutation_directRegisterDarta_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _DartaConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.DartaConnection) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_DartaConnection_pageInfo,
-		func(ctx context.Context) (any, error) {
-			return obj.PageInfo, nil
-		},
-		nil,
-		ec.marshalNPageInfo2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPageInfo,
-		true,
-		true,
-	)
-}
+		return e.complexity.Mutation.DirectRegisterDarta(childComplexity, args["dartaId"].(string), args["acknowledgeDuplicates"].(*bool), args["expectedVersion"].(*int)), true
+	case "Mutation.enrichDartaMetadata":
+		if e.complexity.Mutation.EnrichDartaMetadata == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_DartaConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DartaConnection",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPreviousPage":
-				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
-			case "totalCount":
-				return ec.fieldContext_PageInfo_totalCount(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Mutation_enrichDartaMetadata_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _DartaEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.DartaEdge) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_DartaEdge_cursor,
-		func(ctx context.Context) (any, error) {
-			return obj.Cursor, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Mutation.EnrichDartaMetadata(childComplexity, args["dartaId"].(string), args["metadata"].(map[string]any), args["expectedVersion"].(*int)), true
+	case "Mutation.finalizeDartaRegistration":
+		if e.complexity.Mutation.FinalizeDartaRegistration == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_DartaEdge_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DartaEdge",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Mutation_finalizeDartaRegistration_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _DartaEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.DartaEdge) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_DartaEdge_node,
-		func(ctx context.Context) (any, error) {
-			return obj.Node, nil
-		},
-		nil,
-		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
-		true,
-		true,
-	)
-}
+		return e.complexity.Mutation.FinalizeDartaRegistration(childComplexity, args["dartaId"].(string), args["expectedVersion"].(*int)), true
+	case "Mutation.issueDartaResponse":
+		if e.complexity.Mutation.IssueDartaResponse == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_DartaEdge_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DartaEdge",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Darta_id(ctx, field)
-			case "dartaNumber":
-				return ec.fieldContext_Darta_dartaNumber(ctx, field)
-			case "formattedDartaNumber":
-				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
-			case "fiscalYearId":
-				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
-			case "scope":
-				return ec.fieldContext_Darta_scope(ctx, field)
-			case "wardId":
-				return ec.fieldContext_Darta_wardId(ctx, field)
-			case "subject":
-				return ec.fieldContext_Darta_subject(ctx, field)
-			case "applicant":
-				return ec.fieldContext_Darta_applicant(ctx, field)
-			case "intakeChannel":
-				return ec.fieldContext_Darta_intakeChannel(ctx, field)
-			case "receivedDate":
-				return ec.fieldContext_Darta_receivedDate(ctx, field)
-			case "entryDate":
-				return ec.fieldContext_Darta_entryDate(ctx, field)
-			case "status":
-				return ec.fieldContext_Darta_status(ctx, field)
-			case "priority":
-				return ec.fieldContext_Darta_priority(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Darta_createdBy(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Darta_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Darta_updatedAt(ctx, field)
-			case "tenantId":
-				return ec.fieldContext_Darta_tenantId(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Mutation_issueDartaResponse_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _DartaStats_total(ctx context.Context, field graphql.CollectedField, obj *model.DartaStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_DartaStats_total,
-		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.Mutation.IssueDartaResponse(childComplexity, args["input"].(model.IssueDartaResponseInput)), true
+	case "Mutation.linkDuplicateDarta":
+		if e.complexity.Mutation.LinkDuplicateDarta == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_DartaStats_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DartaStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Mutation_linkDuplicateDarta_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _DartaStats_byStatus(ctx context.Context, field graphql.CollectedField, obj *model.DartaStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_DartaStats_byStatus,
-		func(ctx context.Context) (any, error) {
-			return obj.ByStatus, nil
-		},
-		nil,
-		ec.marshalNDartaStatusCount2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatusCountᚄ,
-		true,
-		true,
-	)
-}
+		return e.complexity.Mutation.LinkDuplicateDarta(childComplexity, args["dartaId"].(string), args["duplicateOfId"].(string), args["notes"].(*string), args["expectedVersion"].(*int)), true
+	case "Mutation.markDartaAction":
+		if e.complexity.Mutation.MarkDartaAction == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_DartaStats_byStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DartaStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "status":
-				return ec.fieldContext_DartaStatusCount_status(ctx, field)
-			case "count":
-				return ec.fieldContext_DartaStatusCount_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DartaStatusCount", field.Name)
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Mutation_markDartaAction_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _DartaStats_byChannel(ctx context.Context, field graphql.CollectedField, obj *model.DartaStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_DartaStats_byChannel,
-		func(ctx context.Context) (any, error) {
-			return obj.ByChannel, nil
-		},
-		nil,
-		ec.marshalNChannelCount2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChannelCountᚄ,
-		true,
-		true,
-	)
-}
+		return e.complexity.Mutation.MarkDartaAction(childComplexity, args["dartaId"].(string), args["actionNote"].(string), args["expectedVersion"].(*int)), true
+	case "Mutation.provideDartaClarification":
+		if e.complexity.Mutation.ProvideDartaClarification == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_DartaStats_byChannel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DartaStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "channel":
-				return ec.fieldContext_ChannelCount_channel(ctx, field)
-			case "count":
-				return ec.fieldContext_ChannelCount_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ChannelCount", field.Name)
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Mutation_provideDartaClarification_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _DartaStats_overdueCount(ctx context.Context, field graphql.CollectedField, obj *model.DartaStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_DartaStats_overdueCount,
-		func(ctx context.Context) (any, error) {
-			return obj.OverdueCount, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
-
-func (ec *executionContext) fieldContext_DartaStats_overdueCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DartaStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.ProvideDartaClarification(childComplexity, args["dartaId"].(string), args["note"].(string), args["expectedVersion"].(*int)), true
+	case "Mutation.receiveDartaAck":
+		if e.complexity.Mutation.ReceiveDartaAck == nil {
+			break
+		}
 
-func (ec *executionContext) _DartaStatusCount_status(ctx context.Context, field graphql.CollectedField, obj *model.DartaStatusCount) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_DartaStatusCount_status,
-		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
-		},
-		nil,
-		ec.marshalNDartaStatus2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatus,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_receiveDartaAck_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_DartaStatusCount_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DartaStatusCount",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DartaStatus does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.ReceiveDartaAck(childComplexity, args["dartaId"].(string), args["expectedVersion"].(*int)), true
+	case "Mutation.requestDartaAck":
+		if e.complexity.Mutation.RequestDartaAck == nil {
+			break
+		}
 
-func (ec *executionContext) _DartaStatusCount_count(ctx context.Context, field graphql.CollectedField, obj *model.DartaStatusCount) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_DartaStatusCount_count,
-		func(ctx context.Context) (any, error) {
-			return obj.Count, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_requestDartaAck_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_DartaStatusCount_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DartaStatusCount",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.RequestDartaAck(childComplexity, args["dartaId"].(string), args["expectedVersion"].(*int)), true
+	case "Mutation.requestDartaClarification":
+		if e.complexity.Mutation.RequestDartaClarification == nil {
+			break
+		}
 
-func (ec *executionContext) _HealthStatus_status(ctx context.Context, field graphql.CollectedField, obj *model.HealthStatus) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_HealthStatus_status,
-		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_requestDartaClarification_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_HealthStatus_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "HealthStatus",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.RequestDartaClarification(childComplexity, args["dartaId"].(string), args["note"].(string), args["expectedVersion"].(*int)), true
+	case "Mutation.reserveDartaNumber":
+		if e.complexity.Mutation.ReserveDartaNumber == nil {
+			break
+		}
 
-func (ec *executionContext) _HealthStatus_service(ctx context.Context, field graphql.CollectedField, obj *model.HealthStatus) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_HealthStatus_service,
-		func(ctx context.Context) (any, error) {
-			return obj.Service, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_reserveDartaNumber_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_HealthStatus_service(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "HealthStatus",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.ReserveDartaNumber(childComplexity, args["dartaId"].(string), args["acknowledgeDuplicates"].(*bool), args["expectedVersion"].(*int)), true
+	case "Mutation.reviewDarta":
+		if e.complexity.Mutation.ReviewDarta == nil {
+			break
+		}
 
-func (ec *executionContext) _HealthStatus_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.HealthStatus) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_HealthStatus_timestamp,
-		func(ctx context.Context) (any, error) {
-			return obj.Timestamp, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_reviewDarta_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_HealthStatus_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "HealthStatus",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.ReviewDarta(childComplexity, args["input"].(model.ReviewDartaInput)), true
+	case "Mutation.routeDarta":
+		if e.complexity.Mutation.RouteDarta == nil {
+			break
+		}
 
-func (ec *executionContext) _Mutation_createDarta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_createDarta,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateDarta(ctx, fc.Args["input"].(model.CreateDartaInput))
-		},
-		nil,
-		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_routeDarta_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Mutation_createDarta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Darta_id(ctx, field)
-			case "dartaNumber":
-				return ec.fieldContext_Darta_dartaNumber(ctx, field)
-			case "formattedDartaNumber":
-				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
-			case "fiscalYearId":
-				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
-			case "scope":
-				return ec.fieldContext_Darta_scope(ctx, field)
-			case "wardId":
-				return ec.fieldContext_Darta_wardId(ctx, field)
-			case "subject":
-				return ec.fieldContext_Darta_subject(ctx, field)
-			case "applicant":
-				return ec.fieldContext_Darta_applicant(ctx, field)
-			case "intakeChannel":
-				return ec.fieldContext_Darta_intakeChannel(ctx, field)
-			case "receivedDate":
-				return ec.fieldContext_Darta_receivedDate(ctx, field)
-			case "entryDate":
-				return ec.fieldContext_Darta_entryDate(ctx, field)
-			case "status":
-				return ec.fieldContext_Darta_status(ctx, field)
-			case "priority":
-				return ec.fieldContext_Darta_priority(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Darta_createdBy(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Darta_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Darta_updatedAt(ctx, field)
-			case "tenantId":
-				return ec.fieldContext_Darta_tenantId(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.Mutation.RouteDarta(childComplexity, args["input"].(model.RouteDartaInput)), true
+	case "Mutation.scanDarta":
+		if e.complexity.Mutation.ScanDarta == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createDarta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_submitDartaForReview(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_submitDartaForReview,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().SubmitDartaForReview(ctx, fc.Args["dartaId"].(string))
-		},
-		nil,
-		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_scanDarta_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Mutation_submitDartaForReview(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Darta_id(ctx, field)
-			case "dartaNumber":
-				return ec.fieldContext_Darta_dartaNumber(ctx, field)
-			case "formattedDartaNumber":
-				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
-			case "fiscalYearId":
-				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
-			case "scope":
-				return ec.fieldContext_Darta_scope(ctx, field)
-			case "wardId":
-				return ec.fieldContext_Darta_wardId(ctx, field)
-			case "subject":
-				return ec.fieldContext_Darta_subject(ctx, field)
-			case "applicant":
-				return ec.fieldContext_Darta_applicant(ctx, field)
-			case "intakeChannel":
-				return ec.fieldContext_Darta_intakeChannel(ctx, field)
-			case "receivedDate":
-				return ec.fieldContext_Darta_receivedDate(ctx, field)
-			case "entryDate":
-				return ec.fieldContext_Darta_entryDate(ctx, field)
-			case "status":
-				return ec.fieldContext_Darta_status(ctx, field)
-			case "priority":
-				return ec.fieldContext_Darta_priority(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Darta_createdBy(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Darta_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Darta_updatedAt(ctx, field)
-			case "tenantId":
-				return ec.fieldContext_Darta_tenantId(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.Mutation.ScanDarta(childComplexity, args["dartaId"].(string), args["scanAttachmentId"].(string), args["expectedVersion"].(*int)), true
+	case "Mutation.sectionReviewDarta":
+		if e.complexity.Mutation.SectionReviewDarta == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_submitDartaForReview_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_classifyDarta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_classifyDarta,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().ClassifyDarta(ctx, fc.Args["dartaId"].(string), fc.Args["classificationCode"].(string))
-		},
-		nil,
-		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_sectionReviewDarta_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Mutation_classifyDarta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Darta_id(ctx, field)
-			case "dartaNumber":
-				return ec.fieldContext_Darta_dartaNumber(ctx, field)
-			case "formattedDartaNumber":
-				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
-			case "fiscalYearId":
-				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
-			case "scope":
-				return ec.fieldContext_Darta_scope(ctx, field)
-			case "wardId":
-				return ec.fieldContext_Darta_wardId(ctx, field)
-			case "subject":
-				return ec.fieldContext_Darta_subject(ctx, field)
-			case "applicant":
-				return ec.fieldContext_Darta_applicant(ctx, field)
-			case "intakeChannel":
-				return ec.fieldContext_Darta_intakeChannel(ctx, field)
-			case "receivedDate":
-				return ec.fieldContext_Darta_receivedDate(ctx, field)
-			case "entryDate":
-				return ec.fieldContext_Darta_entryDate(ctx, field)
-			case "status":
-				return ec.fieldContext_Darta_status(ctx, field)
-			case "priority":
-				return ec.fieldContext_Darta_priority(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Darta_createdBy(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Darta_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Darta_updatedAt(ctx, field)
-			case "tenantId":
-				return ec.fieldContext_Darta_tenantId(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.Mutation.SectionReviewDarta(childComplexity, args["dartaId"].(string), args["expectedVersion"].(*int)), true
+	case "Mutation.submitDartaForReview":
+		if e.complexity.Mutation.SubmitDartaForReview == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_classifyDarta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_reserveDartaNumber(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_reserveDartaNumber,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().ReserveDartaNumber(ctx, fc.Args["dartaId"].(string))
-		},
-		nil,
-		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_submitDartaForReview_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Mutation_reserveDartaNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Darta_id(ctx, field)
-			case "dartaNumber":
-				return ec.fieldContext_Darta_dartaNumber(ctx, field)
-			case "formattedDartaNumber":
-				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
-			case "fiscalYearId":
-				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
-			case "scope":
-				return ec.fieldContext_Darta_scope(ctx, field)
-			case "wardId":
-				return ec.fieldContext_Darta_wardId(ctx, field)
-			case "subject":
-				return ec.fieldContext_Darta_subject(ctx, field)
-			case "applicant":
-				return ec.fieldContext_Darta_applicant(ctx, field)
-			case "intakeChannel":
-				return ec.fieldContext_Darta_intakeChannel(ctx, field)
-			case "receivedDate":
-				return ec.fieldContext_Darta_receivedDate(ctx, field)
-			case "entryDate":
-				return ec.fieldContext_Darta_entryDate(ctx, field)
-			case "status":
-				return ec.fieldContext_Darta_status(ctx, field)
-			case "priority":
-				return ec.fieldContext_Darta_priority(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Darta_createdBy(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Darta_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Darta_updatedAt(ctx, field)
-			case "tenantId":
-				return ec.fieldContext_Darta_tenantId(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.Mutation.SubmitDartaForReview(childComplexity, args["dartaId"].(string), args["expectedVersion"].(*int)), true
+	case "Mutation.supersedeDartaRecord":
+		if e.complexity.Mutation.SupersedeDartaRecord == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_reserveDartaNumber_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_finalizeDartaRegistration(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_finalizeDartaRegistration,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().FinalizeDartaRegistration(ctx, fc.Args["dartaId"].(string))
-		},
-		nil,
-		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_supersedeDartaRecord_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Mutation_finalizeDartaRegistration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Darta_id(ctx, field)
-			case "dartaNumber":
-				return ec.fieldContext_Darta_dartaNumber(ctx, field)
-			case "formattedDartaNumber":
-				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
-			case "fiscalYearId":
-				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
-			case "scope":
-				return ec.fieldContext_Darta_scope(ctx, field)
-			case "wardId":
-				return ec.fieldContext_Darta_wardId(ctx, field)
-			case "subject":
-				return ec.fieldContext_Darta_subject(ctx, field)
-			case "applicant":
-				return ec.fieldContext_Darta_applicant(ctx, field)
-			case "intakeChannel":
-				return ec.fieldContext_Darta_intakeChannel(ctx, field)
-			case "receivedDate":
-				return ec.fieldContext_Darta_receivedDate(ctx, field)
-			case "entryDate":
-				return ec.fieldContext_Darta_entryDate(ctx, field)
-			case "status":
-				return ec.fieldContext_Darta_status(ctx, field)
-			case "priority":
-				return ec.fieldContext_Darta_priority(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Darta_createdBy(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Darta_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Darta_updatedAt(ctx, field)
-			case "tenantId":
-				return ec.fieldContext_Darta_tenantId(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.Mutation.SupersedeDartaRecord(childComplexity, args["dartaId"].(string), args["newDartaId"].(string), args["reason"].(string), args["expectedVersion"].(*int)), true
+	case "Mutation.voidDarta":
+		if e.complexity.Mutation.VoidDarta == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_finalizeDartaRegistration_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_routeDarta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_routeDarta,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().RouteDarta(ctx, fc.Args["input"].(model.RouteDartaInput))
-		},
-		nil,
-		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_voidDarta_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Mutation_routeDarta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Darta_id(ctx, field)
-			case "dartaNumber":
-				return ec.fieldContext_Darta_dartaNumber(ctx, field)
-			case "formattedDartaNumber":
-				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
-			case "fiscalYearId":
-				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
-			case "scope":
-				return ec.fieldContext_Darta_scope(ctx, field)
-			case "wardId":
-				return ec.fieldContext_Darta_wardId(ctx, field)
-			case "subject":
-				return ec.fieldContext_Darta_subject(ctx, field)
-			case "applicant":
-				return ec.fieldContext_Darta_applicant(ctx, field)
-			case "intakeChannel":
-				return ec.fieldContext_Darta_intakeChannel(ctx, field)
-			case "receivedDate":
-				return ec.fieldContext_Darta_receivedDate(ctx, field)
-			case "entryDate":
-				return ec.fieldContext_Darta_entryDate(ctx, field)
-			case "status":
-				return ec.fieldContext_Darta_status(ctx, field)
-			case "priority":
-				return ec.fieldContext_Darta_priority(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Darta_createdBy(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Darta_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Darta_updatedAt(ctx, field)
-			case "tenantId":
-				return ec.fieldContext_Darta_tenantId(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.Mutation.VoidDarta(childComplexity, args["dartaId"].(string), args["reason"].(string), args["expectedVersion"].(*int)), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_routeDarta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_closeDarta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_closeDarta,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CloseDarta(ctx, fc.Args["dartaId"].(string))
-		},
-		nil,
-		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
-		true,
-		true,
-	)
-}
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Mutation_closeDarta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Darta_id(ctx, field)
-			case "dartaNumber":
-				return ec.fieldContext_Darta_dartaNumber(ctx, field)
-			case "formattedDartaNumber":
-				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
-			case "fiscalYearId":
-				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
-			case "scope":
-				return ec.fieldContext_Darta_scope(ctx, field)
-			case "wardId":
-				return ec.fieldContext_Darta_wardId(ctx, field)
-			case "subject":
-				return ec.fieldContext_Darta_subject(ctx, field)
-			case "applicant":
-				return ec.fieldContext_Darta_applicant(ctx, field)
-			case "intakeChannel":
-				return ec.fieldContext_Darta_intakeChannel(ctx, field)
-			case "receivedDate":
-				return ec.fieldContext_Darta_receivedDate(ctx, field)
-			case "entryDate":
-				return ec.fieldContext_Darta_entryDate(ctx, field)
-			case "status":
-				return ec.fieldContext_Darta_status(ctx, field)
-			case "priority":
-				return ec.fieldContext_Darta_priority(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Darta_createdBy(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Darta_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Darta_updatedAt(ctx, field)
-			case "tenantId":
-				return ec.fieldContext_Darta_tenantId(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_closeDarta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_voidDarta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_voidDarta,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().VoidDarta(ctx, fc.Args["dartaId"].(string), fc.Args["reason"].(string))
-		},
-		nil,
-		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
-		true,
-		true,
-	)
-}
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Mutation_voidDarta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Darta_id(ctx, field)
-			case "dartaNumber":
-				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+	case "PageInfo.totalCount":
+		if e.complexity.PageInfo.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.TotalCount(childComplexity), true
+
+	case "Query.auditAnchors":
+		if e.complexity.Query.AuditAnchors == nil {
+			break
+		}
+
+		args, err := ec.field_Query_auditAnchors_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AuditAnchors(childComplexity, args["from"].(*string), args["to"].(*string)), true
+	case "Query.auditChainVerification":
+		if e.complexity.Query.AuditChainVerification == nil {
+			break
+		}
+
+		return e.complexity.Query.AuditChainVerification(childComplexity), true
+	case "Query.auditEntries":
+		if e.complexity.Query.AuditEntries == nil {
+			break
+		}
+
+		args, err := ec.field_Query_auditEntries_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AuditEntries(childComplexity, args["filter"].(*model.AuditEntryFilterInput), args["pagination"].(*model.PaginationInput)), true
+	case "Query.chalani":
+		if e.complexity.Query.Chalani == nil {
+			break
+		}
+
+		args, err := ec.field_Query_chalani_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Chalani(childComplexity, args["id"].(string)), true
+	case "Query.darta":
+		if e.complexity.Query.Darta == nil {
+			break
+		}
+
+		args, err := ec.field_Query_darta_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Darta(childComplexity, args["id"].(string)), true
+	case "Query.dartaByNumber":
+		if e.complexity.Query.DartaByNumber == nil {
+			break
+		}
+
+		args, err := ec.field_Query_dartaByNumber_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DartaByNumber(childComplexity, args["dartaNumber"].(int), args["fiscalYearId"].(string), args["scope"].(model.Scope), args["wardId"].(*string)), true
+	case "Query.dartaStats":
+		if e.complexity.Query.DartaStats == nil {
+			break
+		}
+
+		args, err := ec.field_Query_dartaStats_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DartaStats(childComplexity, args["scope"].(*model.Scope), args["fiscalYearId"].(*string), args["wardId"].(*string), args["fromDate"].(*string), args["toDate"].(*string), args["interval"].(*model.StatsInterval)), true
+	case "Query.dartas":
+		if e.complexity.Query.Dartas == nil {
+			break
+		}
+
+		args, err := ec.field_Query_dartas_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Dartas(childComplexity, args["filter"].(*model.DartaFilterInput), args["pagination"].(*model.PaginationInput)), true
+	case "Query.health":
+		if e.complexity.Query.Health == nil {
+			break
+		}
+
+		return e.complexity.Query.Health(childComplexity), true
+	case "Query.myDartas":
+		if e.complexity.Query.MyDartas == nil {
+			break
+		}
+
+		args, err := ec.field_Query_myDartas_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MyDartas(childComplexity, args["status"].(*model.DartaStatus), args["pagination"].(*model.PaginationInput)), true
+	case "Query.registerExport":
+		if e.complexity.Query.RegisterExport == nil {
+			break
+		}
+
+		args, err := ec.field_Query_registerExport_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.RegisterExport(childComplexity, args["book"].(model.RegisterBook), args["fiscalYearId"].(string), args["scope"].(model.Scope), args["wardId"].(*string), args["format"].(model.RegisterFormat)), true
+	case "Query.search":
+		if e.complexity.Query.Search == nil {
+			break
+		}
+
+		args, err := ec.field_Query_search_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Search(childComplexity, args["query"].(string), args["entityTypes"].([]model.SearchEntityType), args["filter"].(*model.SearchFilterInput), args["first"].(*int), args["after"].(*string)), true
+
+	case "RegisterExport.contentBase64":
+		if e.complexity.RegisterExport.ContentBase64 == nil {
+			break
+		}
+
+		return e.complexity.RegisterExport.ContentBase64(childComplexity), true
+	case "RegisterExport.contentType":
+		if e.complexity.RegisterExport.ContentType == nil {
+			break
+		}
+
+		return e.complexity.RegisterExport.ContentType(childComplexity), true
+	case "RegisterExport.entryCount":
+		if e.complexity.RegisterExport.EntryCount == nil {
+			break
+		}
+
+		return e.complexity.RegisterExport.EntryCount(childComplexity), true
+	case "RegisterExport.filename":
+		if e.complexity.RegisterExport.Filename == nil {
+			break
+		}
+
+		return e.complexity.RegisterExport.Filename(childComplexity), true
+	case "RegisterExport.gapCount":
+		if e.complexity.RegisterExport.GapCount == nil {
+			break
+		}
+
+		return e.complexity.RegisterExport.GapCount(childComplexity), true
+	case "RegisterExport.seal":
+		if e.complexity.RegisterExport.Seal == nil {
+			break
+		}
+
+		return e.complexity.RegisterExport.Seal(childComplexity), true
+	case "RegisterExport.voidCount":
+		if e.complexity.RegisterExport.VoidCount == nil {
+			break
+		}
+
+		return e.complexity.RegisterExport.VoidCount(childComplexity), true
+
+	case "RegisterSeal.generatedAt":
+		if e.complexity.RegisterSeal.GeneratedAt == nil {
+			break
+		}
+
+		return e.complexity.RegisterSeal.GeneratedAt(childComplexity), true
+	case "RegisterSeal.generatedBy":
+		if e.complexity.RegisterSeal.GeneratedBy == nil {
+			break
+		}
+
+		return e.complexity.RegisterSeal.GeneratedBy(childComplexity), true
+	case "RegisterSeal.keyId":
+		if e.complexity.RegisterSeal.KeyID == nil {
+			break
+		}
+
+		return e.complexity.RegisterSeal.KeyID(childComplexity), true
+	case "RegisterSeal.publicKey":
+		if e.complexity.RegisterSeal.PublicKey == nil {
+			break
+		}
+
+		return e.complexity.RegisterSeal.PublicKey(childComplexity), true
+	case "RegisterSeal.sha256":
+		if e.complexity.RegisterSeal.Sha256 == nil {
+			break
+		}
+
+		return e.complexity.RegisterSeal.Sha256(childComplexity), true
+	case "RegisterSeal.signature":
+		if e.complexity.RegisterSeal.Signature == nil {
+			break
+		}
+
+		return e.complexity.RegisterSeal.Signature(childComplexity), true
+
+	case "RelatedDarta.darta":
+		if e.complexity.RelatedDarta.Darta == nil {
+			break
+		}
+
+		return e.complexity.RelatedDarta.Darta(childComplexity), true
+	case "RelatedDarta.relationshipType":
+		if e.complexity.RelatedDarta.RelationshipType == nil {
+			break
+		}
+
+		return e.complexity.RelatedDarta.RelationshipType(childComplexity), true
+
+	case "SearchFacet.field":
+		if e.complexity.SearchFacet.Field == nil {
+			break
+		}
+
+		return e.complexity.SearchFacet.Field(childComplexity), true
+	case "SearchFacet.values":
+		if e.complexity.SearchFacet.Values == nil {
+			break
+		}
+
+		return e.complexity.SearchFacet.Values(childComplexity), true
+
+	case "SearchFacetValue.count":
+		if e.complexity.SearchFacetValue.Count == nil {
+			break
+		}
+
+		return e.complexity.SearchFacetValue.Count(childComplexity), true
+	case "SearchFacetValue.value":
+		if e.complexity.SearchFacetValue.Value == nil {
+			break
+		}
+
+		return e.complexity.SearchFacetValue.Value(childComplexity), true
+
+	case "SearchHit.channel":
+		if e.complexity.SearchHit.Channel == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.Channel(childComplexity), true
+	case "SearchHit.darta":
+		if e.complexity.SearchHit.Darta == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.Darta(childComplexity), true
+	case "SearchHit.entityType":
+		if e.complexity.SearchHit.EntityType == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.EntityType(childComplexity), true
+	case "SearchHit.fiscalYearId":
+		if e.complexity.SearchHit.FiscalYearID == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.FiscalYearID(childComplexity), true
+	case "SearchHit.highlights":
+		if e.complexity.SearchHit.Highlights == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.Highlights(childComplexity), true
+	case "SearchHit.id":
+		if e.complexity.SearchHit.ID == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.ID(childComplexity), true
+	case "SearchHit.number":
+		if e.complexity.SearchHit.Number == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.Number(childComplexity), true
+	case "SearchHit.party":
+		if e.complexity.SearchHit.Party == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.Party(childComplexity), true
+	case "SearchHit.score":
+		if e.complexity.SearchHit.Score == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.Score(childComplexity), true
+	case "SearchHit.snippet":
+		if e.complexity.SearchHit.Snippet == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.Snippet(childComplexity), true
+	case "SearchHit.snippetField":
+		if e.complexity.SearchHit.SnippetField == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.SnippetField(childComplexity), true
+	case "SearchHit.status":
+		if e.complexity.SearchHit.Status == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.Status(childComplexity), true
+	case "SearchHit.title":
+		if e.complexity.SearchHit.Title == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.Title(childComplexity), true
+	case "SearchHit.wardId":
+		if e.complexity.SearchHit.WardID == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.WardID(childComplexity), true
+
+	case "SearchResult.endCursor":
+		if e.complexity.SearchResult.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.SearchResult.EndCursor(childComplexity), true
+	case "SearchResult.facets":
+		if e.complexity.SearchResult.Facets == nil {
+			break
+		}
+
+		return e.complexity.SearchResult.Facets(childComplexity), true
+	case "SearchResult.hasNextPage":
+		if e.complexity.SearchResult.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.SearchResult.HasNextPage(childComplexity), true
+	case "SearchResult.hits":
+		if e.complexity.SearchResult.Hits == nil {
+			break
+		}
+
+		return e.complexity.SearchResult.Hits(childComplexity), true
+	case "SearchResult.totalCount":
+		if e.complexity.SearchResult.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SearchResult.TotalCount(childComplexity), true
+
+	case "Subscription.chalaniDispatchUpdated":
+		if e.complexity.Subscription.ChalaniDispatchUpdated == nil {
+			break
+		}
+
+		return e.complexity.Subscription.ChalaniDispatchUpdated(childComplexity), true
+	case "Subscription.dartaUpdated":
+		if e.complexity.Subscription.DartaUpdated == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_dartaUpdated_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.DartaUpdated(childComplexity, args["id"].(string)), true
+	case "Subscription.myQueueChanged":
+		if e.complexity.Subscription.MyQueueChanged == nil {
+			break
+		}
+
+		return e.complexity.Subscription.MyQueueChanged(childComplexity), true
+
+	case "TextRange.end":
+		if e.complexity.TextRange.End == nil {
+			break
+		}
+
+		return e.complexity.TextRange.End(childComplexity), true
+	case "TextRange.start":
+		if e.complexity.TextRange.Start == nil {
+			break
+		}
+
+		return e.complexity.TextRange.Start(childComplexity), true
+
+	case "Timeline.entries":
+		if e.complexity.Timeline.Entries == nil {
+			break
+		}
+
+		return e.complexity.Timeline.Entries(childComplexity), true
+	case "Timeline.truncated":
+		if e.complexity.Timeline.Truncated == nil {
+			break
+		}
+
+		return e.complexity.Timeline.Truncated(childComplexity), true
+
+	case "User.email":
+		if e.complexity.User.Email == nil {
+			break
+		}
+
+		return e.complexity.User.Email(childComplexity), true
+	case "User.fullName":
+		if e.complexity.User.FullName == nil {
+			break
+		}
+
+		return e.complexity.User.FullName(childComplexity), true
+	case "User.id":
+		if e.complexity.User.ID == nil {
+			break
+		}
+
+		return e.complexity.User.ID(childComplexity), true
+	case "User.username":
+		if e.complexity.User.Username == nil {
+			break
+		}
+
+		return e.complexity.User.Username(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputApplicantInput,
+		ec.unmarshalInputAssignDartaSectionInput,
+		ec.unmarshalInputAuditEntryFilterInput,
+		ec.unmarshalInputCreateDartaInput,
+		ec.unmarshalInputDartaFilterInput,
+		ec.unmarshalInputIssueDartaResponseInput,
+		ec.unmarshalInputPaginationInput,
+		ec.unmarshalInputReviewDartaInput,
+		ec.unmarshalInputRouteDartaInput,
+		ec.unmarshalInputSearchFilterInput,
+	)
+	first := true
+
+	switch opCtx.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			var response graphql.Response
+			var data graphql.Marshaler
+			if first {
+				first = false
+				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
+			} else {
+				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
+					result := <-ec.deferredResults
+					atomic.AddInt32(&ec.pendingDeferred, -1)
+					data = result.Result
+					response.Path = result.Path
+					response.Label = result.Label
+					response.Errors = result.Errors
+				} else {
+					return nil
+				}
+			}
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+			response.Data = buf.Bytes()
+			if atomic.LoadInt32(&ec.deferred) > 0 {
+				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
+				response.HasNext = &hasNext
+			}
+
+			return &response
+		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
+	}
+}
+
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
+	deferred        int32
+	pendingDeferred int32
+	deferredResults chan graphql.DeferredResult
+}
+
+func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
+	atomic.AddInt32(&ec.pendingDeferred, 1)
+	go func() {
+		ctx := graphql.WithFreshResponseContext(dg.Context)
+		dg.FieldSet.Dispatch(ctx)
+		ds := graphql.DeferredResult{
+			Path:   dg.Path,
+			Label:  dg.Label,
+			Result: dg.FieldSet,
+			Errors: graphql.GetErrors(ctx),
+		}
+		// null fields should bubble up
+		if dg.FieldSet.Invalids > 0 {
+			ds.Result = graphql.Null
+		}
+		ec.deferredResults <- ds
+	}()
+}
+
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapSchema(ec.Schema()), nil
+}
+
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+}
+
+var sources = []*ast.Source{
+	{Name: "../schema/schema.graphql", Input: `# Import types from api-schema package
+# This will be the main schema file that combines everything
+
+type Query {
+  # Health check
+  health: HealthStatus!
+  
+  # Darta queries
+  darta(id: ID!): Darta @requiresPermission(relation: "can_read", object: "darta:$id")
+  dartaByNumber(dartaNumber: Int!, fiscalYearId: String!, scope: Scope!, wardId: String): Darta @requiresRole(roles: ["darta_clerk", "darta_reviewer", "darta_registrar", "numbering_officer"])
+  dartas(filter: DartaFilterInput, pagination: PaginationInput): DartaConnection! @requiresRole(roles: ["darta_clerk", "darta_reviewer", "darta_registrar", "numbering_officer"])
+  myDartas(status: DartaStatus, pagination: PaginationInput): DartaConnection!
+
+  # Chalani queries
+  chalani(id: ID!): Chalani @requiresRole(roles: ["chalani_dispatcher", "chalani_approver"])
+  # Statistics of dartas received between fromDate and toDate (RFC 3339 or
+  # YYYY-MM-DD in Nepal time), with a series over the same range; without
+  # dates the series covers the recent past
+  dartaStats(scope: Scope, fiscalYearId: String, wardId: String, fromDate: String, toDate: String, interval: StatsInterval): DartaStats! @requiresRole(roles: ["darta_reviewer", "darta_registrar"])
+
+  # Search over dartas and chalanis, in Devanagari or romanized Nepali
+  search(query: String!, entityTypes: [SearchEntityType!], filter: SearchFilterInput, first: Int, after: String): SearchResult! @requiresRole(roles: ["darta_clerk", "darta_reviewer", "darta_registrar", "numbering_officer", "chalani_dispatcher", "chalani_approver"])
+
+  # A fiscal year's darta or chalani kitab in number order, with gaps and
+  # voids marked. The darta kitab needs a darta role and the chalani kitab a
+  # chalani one; the darta service checks which.
+  registerExport(book: RegisterBook!, fiscalYearId: String!, scope: Scope!, wardId: String, format: RegisterFormat!): RegisterExport! @requiresRole(roles: ["darta_reviewer", "darta_registrar", "chalani_dispatcher", "chalani_approver"])
+
+  # Audit entries of the caller's tenant, newest first unless sorted by
+  # performed_at ascending
+  auditEntries(filter: AuditEntryFilterInput, pagination: PaginationInput): AuditEntryConnection! @requiresRole(roles: ["admin", "auditor"])
+  # Recompute the caller's tenant audit chain and report any tampering
+  auditChainVerification: AuditChainVerification! @requiresRole(roles: ["admin", "auditor"])
+  # Anchored heads of the caller's tenant audit chain, for notarization.
+  # Bounds are RFC 3339 timestamps or YYYY-MM-DD dates in Nepal time.
+  auditAnchors(from: String, to: String): [AuditAnchor!]! @requiresRole(roles: ["admin", "auditor"])
+}
+
+type Mutation {
+  # Mutations of an existing darta apply only to the version given as
+  # expectedVersion, if any, and fail with CONFLICT once it has changed
+  #
+  # Darta mutations - registration (darta-lifecycle.md §9)
+  createDarta(input: CreateDartaInput!): Darta! @requiresRole(roles: ["darta_clerk", "darta_registrar"])
+  submitDartaForReview(dartaId: ID!, expectedVersion: Int): Darta! @requiresPermission(relation: "can_write", object: "darta:$dartaId")
+  reviewDarta(input: ReviewDartaInput!): Darta! @requiresPermission(relation: "can_review", object: "darta:$input.dartaId")
+  approveDartaReview(dartaId: ID!, notes: String, expectedVersion: Int): Darta! @requiresPermission(relation: "can_review", object: "darta:$dartaId")
+  classifyDarta(dartaId: ID!, classificationCode: String!, expectedVersion: Int): Darta! @requiresPermission(relation: "can_register", object: "darta:$dartaId")
+  reserveDartaNumber(dartaId: ID!, acknowledgeDuplicates: Boolean, expectedVersion: Int): Darta! @requiresPermission(relation: "can_register", object: "darta:$dartaId")
+  finalizeDartaRegistration(dartaId: ID!, expectedVersion: Int): Darta! @requiresPermission(relation: "can_register", object: "darta:$dartaId")
+  directRegisterDarta(dartaId: ID!, acknowledgeDuplicates: Boolean, expectedVersion: Int): Darta! @requiresPermission(relation: "can_register", object: "darta:$dartaId")
+  voidDarta(dartaId: ID!, reason: String!, expectedVersion: Int): Darta! @requiresPermission(relation: "can_void", object: "darta:$dartaId")
+  linkDuplicateDarta(dartaId: ID!, duplicateOfId: ID!, notes: String, expectedVersion: Int): Darta! @requiresPermission(relation: "can_register", object: "darta:$dartaId")
+
+  # Darta mutations - digitization
+  scanDarta(dartaId: ID!, scanAttachmentId: ID!, expectedVersion: Int): Darta! @requiresPermission(relation: "can_write", object: "darta:$dartaId")
+  # metadata is merged as a JSON merge patch: null removes a key
+  enrichDartaMetadata(dartaId: ID!, metadata: JSON!, expectedVersion: Int): Darta! @requiresPermission(relation: "can_write", object: "darta:$dartaId")
+  archiveDartaDigital(dartaId: ID!, expectedVersion: Int): Darta! @requiresPermission(relation: "can_register", object: "darta:$dartaId")
+
+  # Darta mutations - assignment
+  routeDarta(input: RouteDartaInput!): Darta! @requiresPermission(relation: "can_assign", object: "darta:$input.dartaId")
+  assignDartaSection(input: AssignDartaSectionInput!): Darta! @requiresPermission(relation: "can_assign", object: "darta:$input.dartaId")
+  sectionReviewDarta(dartaId: ID!, expectedVersion: Int): Darta! @requiresPermission(relation: "can_act", object: "darta:$dartaId")
+  requestDartaClarification(dartaId: ID!, note: String!, expectedVersion: Int): Darta! @requiresPermission(relation: "can_act", object: "darta:$dartaId")
+  provideDartaClarification(dartaId: ID!, note: String!, expectedVersion: Int): Darta! @requiresPermission(relation: "can_write", object: "darta:$dartaId")
+  acceptDarta(dartaId: ID!, expectedVersion: Int): Darta! @requiresPermission(relation: "can_act", object: "darta:$dartaId")
+
+  # Darta mutations - action and closure
+  markDartaAction(dartaId: ID!, actionNote: String!, expectedVersion: Int): Darta! @requiresPermission(relation: "can_act", object: "darta:$dartaId")
+  issueDartaResponse(input: IssueDartaResponseInput!): Darta! @requiresPermission(relation: "can_act", object: "darta:$input.dartaId")
+  requestDartaAck(dartaId: ID!, expectedVersion: Int): Darta! @requiresPermission(relation: "can_register", object: "darta:$dartaId")
+  receiveDartaAck(dartaId: ID!, expectedVersion: Int): Darta! @requiresPermission(relation: "can_register", object: "darta:$dartaId")
+  supersedeDartaRecord(dartaId: ID!, newDartaId: ID!, reason: String!, expectedVersion: Int): Darta! @requiresPermission(relation: "can_void", object: "darta:$dartaId")
+  closeDarta(dartaId: ID!, expectedVersion: Int): Darta! @requiresPermission(relation: "can_act", object: "darta:$dartaId")
+}
+
+# Arbitrary JSON object
+scalar JSON
+
+# Any JSON value, including strings, numbers and lists
+scalar Any
+
+# The caller must hold one of roles in their tenant, as recorded in the PDP
+directive @requiresRole(roles: [String!]!) on FIELD_DEFINITION
+
+# The caller must have relation on object in the PDP. object may use field
+# arguments as $name or $input.field, and the caller's tenant as $tenant.
+directive @requiresPermission(relation: String!, object: String!) on FIELD_DEFINITION
+
+# Types
+type HealthStatus {
+  status: String!
+  service: String!
+  timestamp: String!
+}
+
+type Subscription {
+  # Live updates over WebSocket (graphql-ws)
+  dartaUpdated(id: ID!): DartaEvent! @requiresPermission(relation: "can_read", object: "darta:$id")
+  myQueueChanged: DartaEvent!
+  chalaniDispatchUpdated: ChalaniDispatchEvent! @requiresRole(roles: ["chalani_dispatcher", "chalani_approver"])
+}
+
+# DartaEvent is sent after a darta mutation, and when an SLA is breached
+# (action SLA_BREACHED) or escalated to a unit head (SLA_ESCALATED)
+type DartaEvent {
+  action: String!
+  darta: Darta!
+  actorId: String!
+  previousAssigneeId: String
+  occurredAt: String!
+  # SLA stage of an SLA event: REVIEW, CLARIFICATION or RESPONSE
+  slaStage: String
+  # Unit head an SLA_ESCALATED event went to
+  escalatedToId: String
+}
+
+# ChalaniDispatchEvent is sent when a chalani moves through dispatch and delivery
+type ChalaniDispatchEvent {
+  action: String!
+  chalaniId: ID!
+  formattedChalaniNumber: String
+  subject: String!
+  status: ChalaniStatus!
+  trackingId: String
+  dispatchedAt: String
+  deliveredAt: String
+  actorId: String!
+  occurredAt: String!
+}
+
+type Chalani {
+  id: ID!
+  chalaniNumber: Int
+  formattedChalaniNumber: String
+  fiscalYearId: String!
+  scope: Scope!
+  wardId: String
+  subject: String!
+  body: String!
+  status: ChalaniStatus!
+  dispatchChannel: String
+  dispatchedAt: String
+  deliveredAt: String
+  createdAt: String!
+  updatedAt: String!
+
+  # Everything done to the chalani, oldest first
+  timeline: Timeline!
+}
+
+type Darta {
+  id: ID!
+  dartaNumber: Int
+  formattedDartaNumber: String
+  fiscalYearId: String!
+  scope: Scope!
+  wardId: String
+  subject: String!
+  applicant: Applicant!
+  intakeChannel: IntakeChannel!
+  receivedDate: String!
+  entryDate: String!
+  status: DartaStatus!
+  priority: Priority!
+  createdBy: User!
+  createdAt: String!
+  updatedAt: String!
+  tenantId: String!
+  # Free-form metadata, checked against the tenant's schema for the
+  # classification code
+  metadata: JSON
+  # Bumped by every change; pass it as expectedVersion to refuse a change
+  # if the darta changed meanwhile
+  version: Int!
+
+  # Nested fields are batched per request, so a list costs a fixed number of
+  # backend calls however many rows it has
+  assignee: User
+  attachments: [Attachment!]!
+  relatedDartas: [RelatedDarta!]!
+  auditTrail: [AuditEntry!]!
+  # Everything done to the darta, oldest first
+  timeline: Timeline!
+  # Existing dartas this one may duplicate, best match first
+  suspectedDuplicates: [DuplicateCandidate!]!
+  # Number of the darta this one replaced; the replacement keeps its own
+  supersedesDartaNumber: String
+  # The darta this one replaced, and the darta that replaced it
+  supersedes: Darta
+  supersededBy: Darta
+  # Every darta in the supersession chain through this one, the first
+  # superseded first
+  supersessionChain: [Darta!]!
+}
+
+# DuplicateCandidate is a darta suspected to duplicate another, found by
+# applicant identifiers, primary document and subject among dartas received
+# near the same date in any ward. Above the configured score, createDarta and
+# reserveDartaNumber fail with CONFLICT unless acknowledgeDuplicates is set.
+type DuplicateCandidate {
+  dartaId: ID!
+  formattedDartaNumber: String
+  subject: String!
+  status: DartaStatus!
+  wardId: String
+  receivedDate: String!
+  applicantName: String!
+  # 0 to 1
+  score: Float!
+  # SAME_APPLICANT, SAME_IDENTIFICATION_NUMBER, SAME_PHONE, SAME_EMAIL,
+  # SAME_PRIMARY_DOCUMENT, SIMILAR_SUBJECT or RECEIVED_SAME_DAY
+  reasons: [String!]!
+}
+
+# User is a staff member known to the identity service. Only id is set when
+# the user cannot be resolved.
+type User {
+  id: ID!
+  username: String
+  fullName: String
+  email: String
+}
+
+type Attachment {
+  id: ID!
+  filename: String!
+  originalFilename: String!
+  mimeType: String!
+  sizeBytes: Int!
+  uploadedBy: String!
+  uploadedAt: String!
+  isPrimary: Boolean!
+}
+
+type RelatedDarta {
+  relationshipType: String!
+  darta: Darta!
+}
+
+# AuditEntry is a recorded activity on a darta, chalani or setting
+type AuditEntry {
+  id: ID!
+  entityType: String!
+  entityId: ID!
+  action: String!
+  performedBy: User!
+  performedAt: String!
+  notes: String
+  changes: JSON
+  # changes field by field, as before and after values
+  diff: [AuditFieldChange!]!
+  requestId: String
+  traceId: String
+  # role the action was authorized under
+  actingRole: String
+  # authorization decision the action was allowed by
+  decisionId: String
+}
+
+# before is null when the entry recorded only the new value
+type AuditFieldChange {
+  field: String!
+  before: Any
+  after: Any
+}
+
+# Timeline holds the most recent 500 entries of a record; truncated is set
+# when older ones were left out
+type Timeline {
+  entries: [AuditEntry!]!
+  truncated: Boolean!
+}
+
+type AuditEntryConnection {
+  edges: [AuditEntryEdge!]!
+  pageInfo: PageInfo!
+}
+
+type AuditEntryEdge {
+  cursor: String!
+  node: AuditEntry!
+}
+
+type Applicant {
+  id: ID!
+  type: ApplicantType!
+  fullName: String!
+  organization: String
+  email: String
+  phone: String
+  address: String
+}
+
+type DartaConnection {
+  edges: [DartaEdge!]!
+  pageInfo: PageInfo!
+}
+
+type DartaEdge {
+  cursor: String!
+  node: Darta!
+}
+
+type PageInfo {
+  hasNextPage: Boolean!
+  hasPreviousPage: Boolean!
+  startCursor: String
+  endCursor: String
+  totalCount: Int!
+}
+
+# SearchResult is one page of search hits, best match first. Facet counts
+# cover every match of the query regardless of the filter, so the other
+# values of a facet stay selectable.
+type SearchResult {
+  hits: [SearchHit!]!
+  facets: [SearchFacet!]!
+  totalCount: Int!
+  hasNextPage: Boolean!
+  endCursor: String
+}
+
+type SearchHit {
+  entityType: SearchEntityType!
+  id: ID!
+  title: String!
+  number: String
+  party: String!
+  status: String!
+  fiscalYearId: String!
+  wardId: String
+  channel: String
+  score: Float!
+  # Best matching text, with highlights as character ranges within it
+  snippet: String!
+  snippetField: SearchField!
+  highlights: [TextRange!]!
+  # The matched darta; null for chalani hits
+  darta: Darta
+}
+
+enum SearchEntityType {
+  DARTA
+  CHALANI
+}
+
+enum SearchField {
+  CONTENT
+  TITLE
+  PARTY
+  NUMBER
+}
+
+type TextRange {
+  start: Int!
+  end: Int!
+}
+
+type SearchFacet {
+  # status, fiscal_year, ward or channel
+  field: String!
+  values: [SearchFacetValue!]!
+}
+
+type SearchFacetValue {
+  value: String!
+  count: Int!
+}
+
+# Times in state are elapsed hours read from the audit trail
+type DartaStats {
+  total: Int!
+  byStatus: [DartaStatusCount!]!
+  byChannel: [ChannelCount!]!
+  overdueCount: Int!
+  # Received to closed
+  avgProcessingTimeHours: Float!
+  receivedToRegistered: DurationStats!
+  registeredToClosed: DurationStats!
+  byWard: [DartaStatsBreakdown!]!
+  # By the section a darta is with now; sectionTurnaround covers every
+  # section dartas passed through
+  bySection: [DartaStatsBreakdown!]!
+  byIntakeChannel: [DartaStatsBreakdown!]!
+  byPriority: [DartaStatsBreakdown!]!
+  series: [DartaStatsBucket!]!
+}
+
+type DurationStats {
+  # Dartas that completed the step
+  count: Int!
+  avgHours: Float!
+  p90Hours: Float!
+}
+
+type DartaStatsBreakdown {
+  # Ward or unit ID, or the channel or priority name; empty when unset
+  key: String!
+  total: Int!
+  overdueCount: Int!
+  receivedToRegistered: DurationStats!
+  registeredToClosed: DurationStats!
+  # bySection only: assignment to the section until it is handed on,
+  # answered or closed
+  sectionTurnaround: DurationStats
+}
+
+type DartaStatsBucket {
+  start: String!
+  startBs: String!
+  received: Int!
+  registered: Int!
+  closed: Int!
+}
+
+type DartaStatusCount {
+  status: DartaStatus!
+  count: Int!
+}
+
+type ChannelCount {
+  channel: IntakeChannel!
+  count: Int!
+}
+
+# Enums
+enum RegisterBook {
+  DARTA
+  CHALANI
+}
+
+enum RegisterFormat {
+  CSV
+  XLSX
+  PDF
+}
+
+type RegisterExport {
+  filename: String!
+  contentType: String!
+  # The file, base64 encoded
+  contentBase64: String!
+  # Numbers from 1 to the last one issued, gaps included
+  entryCount: Int!
+  gapCount: Int!
+  voidCount: Int!
+  seal: RegisterSeal!
+}
+
+# The seal printed in a register's footer. sha256 digests the register's
+# canonical form; signature is an Ed25519 signature of the digest, checked
+# against publicKey.
+type RegisterSeal {
+  sha256: String!
+  signature: String!
+  keyId: String!
+  publicKey: String!
+  generatedAt: String!
+  generatedBy: String!
+}
+
+type AuditChainVerification {
+  intact: Boolean!
+  entriesVerified: Int!
+  headSeq: Int!
+  headHash: String!
+  anchorsVerified: Int!
+  problems: [AuditChainProblem!]!
+  # More problems were found than listed
+  problemsTruncated: Boolean!
+}
+
+# kind is HASH_MISMATCH, BROKEN_LINK, MISSING_ENTRIES, ANCHOR_MISMATCH or
+# UNCHAINED_ENTRIES
+type AuditChainProblem {
+  kind: String!
+  chainSeq: Int!
+  entryId: String
+  detail: String!
+}
+
+# statement is the canonical JSON of the anchor, the text to notarize
+type AuditAnchor {
+  id: ID!
+  chainSeq: Int!
+  entryHash: String!
+  anchoredAt: String!
+  statement: String!
+}
+
+# Weeks run Sunday to Saturday; months are BS months
+enum StatsInterval {
+  DAY
+  WEEK
+  MONTH
+}
+
+enum Scope {
+  MUNICIPALITY
+  WARD
+}
+
+enum Priority {
+  LOW
+  MEDIUM
+  HIGH
+  URGENT
+}
+
+enum IntakeChannel {
+  COUNTER
+  POSTAL
+  EMAIL
+  EDARTA_PORTAL
+  COURIER
+}
+
+enum DartaStatus {
+  DRAFT
+  PENDING_REVIEW
+  CLASSIFICATION
+  NUMBER_RESERVED
+  REGISTERED
+  VOIDED
+  SCANNED
+  METADATA_ENRICHED
+  DIGITALLY_ARCHIVED
+  ASSIGNED
+  IN_REVIEW_BY_SECTION
+  NEEDS_CLARIFICATION
+  ACCEPTED
+  ACTION_TAKEN
+  RESPONSE_ISSUED
+  ACK_REQUESTED
+  ACK_RECEIVED
+  SUPERSEDED
+  CLOSED
+}
+
+enum ChalaniStatus {
+  DRAFT
+  PENDING_REVIEW
+  PENDING_APPROVAL
+  APPROVED
+  NUMBER_RESERVED
+  REGISTERED
+  SIGNED
+  SEALED
+  DISPATCHED
+  IN_TRANSIT
+  ACKNOWLEDGED
+  RETURNED_UNDELIVERED
+  DELIVERED
+  VOIDED
+  SUPERSEDED
+  CLOSED
+}
+
+enum DartaReviewDecision {
+  APPROVE_REVIEW
+  EDIT_REQUIRED
+}
+
+enum ApplicantType {
+  CITIZEN
+  ORGANIZATION
+  GOVERNMENT_OFFICE
+  OTHER
+}
+
+# Inputs
+input CreateDartaInput {
+  fiscalYearId: String!
+  scope: Scope!
+  wardId: String
+  subject: String!
+  applicant: ApplicantInput!
+  intakeChannel: IntakeChannel!
+  receivedDate: String!
+  primaryDocumentId: ID!
+  annexIds: [ID!]
+  priority: Priority!
+  idempotencyKey: String!
+  # Create even if blocked as a suspected duplicate
+  acknowledgeDuplicates: Boolean
+  # Required when receivedDate falls before the day the darta is entered
+  backdateReason: String
+}
+
+input ApplicantInput {
+  type: ApplicantType!
+  fullName: String!
+  organization: String
+  email: String
+  phone: String
+  address: String
+  identificationNumber: String
+}
+
+input RouteDartaInput {
+  dartaId: ID!
+  organizationalUnitId: String
+  assigneeId: String
+  priority: Priority
+  # Response target in business hours; the tenant's SLA policy when unset
+  slaHours: Int
+  notes: String
+  expectedVersion: Int
+}
+
+input ReviewDartaInput {
+  dartaId: ID!
+  decision: DartaReviewDecision!
+  notes: String
+  requestedInfo: String
+  expectedVersion: Int
+}
+
+input AssignDartaSectionInput {
+  dartaId: ID!
+  sectionId: String!
+  assigneeId: String
+  priority: Priority
+  # Response target in business hours; the tenant's SLA policy when unset
+  slaHours: Int
+  notes: String
+  expectedVersion: Int
+}
+
+input IssueDartaResponseInput {
+  dartaId: ID!
+  responseChalaniId: ID
+  docAttachmentId: ID
+  expectedVersion: Int
+}
+
+input DartaFilterInput {
+  fiscalYearId: String
+  scope: Scope
+  wardId: String
+  status: DartaStatus
+  priority: Priority
+  organizationalUnitId: String
+  assigneeId: String
+  intakeChannel: IntakeChannel
+  fromDate: String
+  toDate: String
+  search: String
+  isOverdue: Boolean
+}
+
+# Unset fields match every entry. Times are RFC 3339 timestamps or
+# YYYY-MM-DD dates in Nepal time; tenantId must be the caller's tenant.
+input AuditEntryFilterInput {
+  entityType: String
+  entityId: ID
+  performedBy: String
+  actions: [String!]
+  category: String
+  from: String
+  to: String
+  tenantId: String
+}
+
+# Each list matches any of its values
+input SearchFilterInput {
+  statuses: [String!]
+  fiscalYearIds: [String!]
+  wardIds: [String!]
+  # Intake channel of dartas, dispatch channel of chalanis
+  channels: [String!]
+}
+
+# Pages are addressed by opaque cursors: pass a page's endCursor as after for
+# the next page, or its startCursor as before for the previous one. A cursor
+# is only valid with the sortBy and sortDesc it was issued for.
+input PaginationInput {
+  # Page size, at most 100
+  limit: Int
+  # Not supported; use after or before
+  offset: Int
+  after: String
+  before: String
+  # created_at, received_date or darta_number. Unset sorts newest first, by
+  # created_at for dartas and by received_date for myDartas.
+  sortBy: String
+  sortDesc: Boolean
+}
+`, BuiltIn: false},
+}
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) dir_requiresPermission_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "relation", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["relation"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "object", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["object"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) dir_requiresRole_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "roles", ec.unmarshalNString2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["roles"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_acceptDarta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_approveDartaReview_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "notes", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["notes"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_archiveDartaDigital_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_assignDartaSection_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAssignDartaSectionInput2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAssignDartaSectionInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_classifyDarta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "classificationCode", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["classificationCode"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_closeDarta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createDarta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateDartaInput2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐCreateDartaInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_directRegisterDarta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "acknowledgeDuplicates", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["acknowledgeDuplicates"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_enrichDartaMetadata_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "metadata", ec.unmarshalNJSON2map)
+	if err != nil {
+		return nil, err
+	}
+	args["metadata"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_finalizeDartaRegistration_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_issueDartaResponse_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNIssueDartaResponseInput2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐIssueDartaResponseInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_linkDuplicateDarta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "duplicateOfId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["duplicateOfId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "notes", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["notes"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_markDartaAction_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "actionNote", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["actionNote"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_provideDartaClarification_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "note", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["note"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_receiveDartaAck_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_requestDartaAck_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_requestDartaClarification_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "note", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["note"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_reserveDartaNumber_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "acknowledgeDuplicates", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["acknowledgeDuplicates"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_reviewDarta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNReviewDartaInput2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐReviewDartaInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_routeDarta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNRouteDartaInput2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRouteDartaInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_scanDarta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "scanAttachmentId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["scanAttachmentId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_sectionReviewDarta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_submitDartaForReview_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_supersedeDartaRecord_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "newDartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["newDartaId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "reason", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["reason"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_voidDarta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "reason", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["reason"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "expectedVersion", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_auditAnchors_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_auditEntries_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOAuditEntryFilterInput2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditEntryFilterInput)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "pagination", ec.unmarshalOPaginationInput2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPaginationInput)
+	if err != nil {
+		return nil, err
+	}
+	args["pagination"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_chalani_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_dartaByNumber_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "dartaNumber", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["dartaNumber"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "fiscalYearId", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["fiscalYearId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "scope", ec.unmarshalNScope2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐScope)
+	if err != nil {
+		return nil, err
+	}
+	args["scope"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "wardId", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["wardId"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_dartaStats_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "scope", ec.unmarshalOScope2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐScope)
+	if err != nil {
+		return nil, err
+	}
+	args["scope"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "fiscalYearId", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["fiscalYearId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "wardId", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["wardId"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "fromDate", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["fromDate"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "toDate", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["toDate"] = arg4
+	arg5, err := graphql.ProcessArgField(ctx, rawArgs, "interval", ec.unmarshalOStatsInterval2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐStatsInterval)
+	if err != nil {
+		return nil, err
+	}
+	args["interval"] = arg5
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_darta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_dartas_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalODartaFilterInput2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaFilterInput)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "pagination", ec.unmarshalOPaginationInput2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPaginationInput)
+	if err != nil {
+		return nil, err
+	}
+	args["pagination"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_myDartas_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "status", ec.unmarshalODartaStatus2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatus)
+	if err != nil {
+		return nil, err
+	}
+	args["status"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "pagination", ec.unmarshalOPaginationInput2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPaginationInput)
+	if err != nil {
+		return nil, err
+	}
+	args["pagination"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_registerExport_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "book", ec.unmarshalNRegisterBook2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRegisterBook)
+	if err != nil {
+		return nil, err
+	}
+	args["book"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "fiscalYearId", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["fiscalYearId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "scope", ec.unmarshalNScope2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐScope)
+	if err != nil {
+		return nil, err
+	}
+	args["scope"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "wardId", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["wardId"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "format", ec.unmarshalNRegisterFormat2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRegisterFormat)
+	if err != nil {
+		return nil, err
+	}
+	args["format"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_search_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "query", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["query"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "entityTypes", ec.unmarshalOSearchEntityType2ᚕgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchEntityTypeᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["entityTypes"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOSearchFilterInput2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchFilterInput)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "first", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "after", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_dartaUpdated_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _Applicant_id(ctx context.Context, field graphql.CollectedField, obj *model.Applicant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Applicant_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Applicant_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Applicant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Applicant_type(ctx context.Context, field graphql.CollectedField, obj *model.Applicant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Applicant_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalNApplicantType2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicantType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Applicant_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Applicant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ApplicantType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Applicant_fullName(ctx context.Context, field graphql.CollectedField, obj *model.Applicant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Applicant_fullName,
+		func(ctx context.Context) (any, error) {
+			return obj.FullName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Applicant_fullName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Applicant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Applicant_organization(ctx context.Context, field graphql.CollectedField, obj *model.Applicant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Applicant_organization,
+		func(ctx context.Context) (any, error) {
+			return obj.Organization, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Applicant_organization(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Applicant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Applicant_email(ctx context.Context, field graphql.CollectedField, obj *model.Applicant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Applicant_email,
+		func(ctx context.Context) (any, error) {
+			return obj.Email, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Applicant_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Applicant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Applicant_phone(ctx context.Context, field graphql.CollectedField, obj *model.Applicant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Applicant_phone,
+		func(ctx context.Context) (any, error) {
+			return obj.Phone, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Applicant_phone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Applicant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Applicant_address(ctx context.Context, field graphql.CollectedField, obj *model.Applicant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Applicant_address,
+		func(ctx context.Context) (any, error) {
+			return obj.Address, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Applicant_address(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Applicant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Attachment_id(ctx context.Context, field graphql.CollectedField, obj *model.Attachment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Attachment_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Attachment_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Attachment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Attachment_filename(ctx context.Context, field graphql.CollectedField, obj *model.Attachment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Attachment_filename,
+		func(ctx context.Context) (any, error) {
+			return obj.Filename, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Attachment_filename(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Attachment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Attachment_originalFilename(ctx context.Context, field graphql.CollectedField, obj *model.Attachment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Attachment_originalFilename,
+		func(ctx context.Context) (any, error) {
+			return obj.OriginalFilename, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Attachment_originalFilename(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Attachment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Attachment_mimeType(ctx context.Context, field graphql.CollectedField, obj *model.Attachment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Attachment_mimeType,
+		func(ctx context.Context) (any, error) {
+			return obj.MimeType, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Attachment_mimeType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Attachment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Attachment_sizeBytes(ctx context.Context, field graphql.CollectedField, obj *model.Attachment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Attachment_sizeBytes,
+		func(ctx context.Context) (any, error) {
+			return obj.SizeBytes, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Attachment_sizeBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Attachment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Attachment_uploadedBy(ctx context.Context, field graphql.CollectedField, obj *model.Attachment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Attachment_uploadedBy,
+		func(ctx context.Context) (any, error) {
+			return obj.UploadedBy, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Attachment_uploadedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Attachment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Attachment_uploadedAt(ctx context.Context, field graphql.CollectedField, obj *model.Attachment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Attachment_uploadedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UploadedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Attachment_uploadedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Attachment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Attachment_isPrimary(ctx context.Context, field graphql.CollectedField, obj *model.Attachment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Attachment_isPrimary,
+		func(ctx context.Context) (any, error) {
+			return obj.IsPrimary, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Attachment_isPrimary(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Attachment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditAnchor_id(ctx context.Context, field graphql.CollectedField, obj *model.AuditAnchor) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditAnchor_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditAnchor_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditAnchor",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditAnchor_chainSeq(ctx context.Context, field graphql.CollectedField, obj *model.AuditAnchor) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditAnchor_chainSeq,
+		func(ctx context.Context) (any, error) {
+			return obj.ChainSeq, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditAnchor_chainSeq(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditAnchor",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditAnchor_entryHash(ctx context.Context, field graphql.CollectedField, obj *model.AuditAnchor) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditAnchor_entryHash,
+		func(ctx context.Context) (any, error) {
+			return obj.EntryHash, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditAnchor_entryHash(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditAnchor",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditAnchor_anchoredAt(ctx context.Context, field graphql.CollectedField, obj *model.AuditAnchor) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditAnchor_anchoredAt,
+		func(ctx context.Context) (any, error) {
+			return obj.AnchoredAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditAnchor_anchoredAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditAnchor",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditAnchor_statement(ctx context.Context, field graphql.CollectedField, obj *model.AuditAnchor) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditAnchor_statement,
+		func(ctx context.Context) (any, error) {
+			return obj.Statement, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditAnchor_statement(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditAnchor",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditChainProblem_kind(ctx context.Context, field graphql.CollectedField, obj *model.AuditChainProblem) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditChainProblem_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditChainProblem_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditChainProblem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditChainProblem_chainSeq(ctx context.Context, field graphql.CollectedField, obj *model.AuditChainProblem) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditChainProblem_chainSeq,
+		func(ctx context.Context) (any, error) {
+			return obj.ChainSeq, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditChainProblem_chainSeq(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditChainProblem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditChainProblem_entryId(ctx context.Context, field graphql.CollectedField, obj *model.AuditChainProblem) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditChainProblem_entryId,
+		func(ctx context.Context) (any, error) {
+			return obj.EntryID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditChainProblem_entryId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditChainProblem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditChainProblem_detail(ctx context.Context, field graphql.CollectedField, obj *model.AuditChainProblem) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditChainProblem_detail,
+		func(ctx context.Context) (any, error) {
+			return obj.Detail, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditChainProblem_detail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditChainProblem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditChainVerification_intact(ctx context.Context, field graphql.CollectedField, obj *model.AuditChainVerification) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditChainVerification_intact,
+		func(ctx context.Context) (any, error) {
+			return obj.Intact, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditChainVerification_intact(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditChainVerification",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditChainVerification_entriesVerified(ctx context.Context, field graphql.CollectedField, obj *model.AuditChainVerification) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditChainVerification_entriesVerified,
+		func(ctx context.Context) (any, error) {
+			return obj.EntriesVerified, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditChainVerification_entriesVerified(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditChainVerification",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditChainVerification_headSeq(ctx context.Context, field graphql.CollectedField, obj *model.AuditChainVerification) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditChainVerification_headSeq,
+		func(ctx context.Context) (any, error) {
+			return obj.HeadSeq, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditChainVerification_headSeq(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditChainVerification",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditChainVerification_headHash(ctx context.Context, field graphql.CollectedField, obj *model.AuditChainVerification) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditChainVerification_headHash,
+		func(ctx context.Context) (any, error) {
+			return obj.HeadHash, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditChainVerification_headHash(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditChainVerification",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditChainVerification_anchorsVerified(ctx context.Context, field graphql.CollectedField, obj *model.AuditChainVerification) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditChainVerification_anchorsVerified,
+		func(ctx context.Context) (any, error) {
+			return obj.AnchorsVerified, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditChainVerification_anchorsVerified(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditChainVerification",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditChainVerification_problems(ctx context.Context, field graphql.CollectedField, obj *model.AuditChainVerification) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditChainVerification_problems,
+		func(ctx context.Context) (any, error) {
+			return obj.Problems, nil
+		},
+		nil,
+		ec.marshalNAuditChainProblem2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditChainProblemᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditChainVerification_problems(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditChainVerification",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext_AuditChainProblem_kind(ctx, field)
+			case "chainSeq":
+				return ec.fieldContext_AuditChainProblem_chainSeq(ctx, field)
+			case "entryId":
+				return ec.fieldContext_AuditChainProblem_entryId(ctx, field)
+			case "detail":
+				return ec.fieldContext_AuditChainProblem_detail(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditChainProblem", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditChainVerification_problemsTruncated(ctx context.Context, field graphql.CollectedField, obj *model.AuditChainVerification) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditChainVerification_problemsTruncated,
+		func(ctx context.Context) (any, error) {
+			return obj.ProblemsTruncated, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditChainVerification_problemsTruncated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditChainVerification",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntry_id(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntry_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntry_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntry_entityType(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntry_entityType,
+		func(ctx context.Context) (any, error) {
+			return obj.EntityType, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntry_entityType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntry_entityId(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntry_entityId,
+		func(ctx context.Context) (any, error) {
+			return obj.EntityID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntry_entityId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntry_action(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntry_action,
+		func(ctx context.Context) (any, error) {
+			return obj.Action, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntry_action(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntry_performedBy(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntry_performedBy,
+		func(ctx context.Context) (any, error) {
+			return obj.PerformedBy, nil
+		},
+		nil,
+		ec.marshalNUser2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐUser,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntry_performedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "username":
+				return ec.fieldContext_User_username(ctx, field)
+			case "fullName":
+				return ec.fieldContext_User_fullName(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntry_performedAt(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntry_performedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.PerformedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntry_performedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntry_notes(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntry_notes,
+		func(ctx context.Context) (any, error) {
+			return obj.Notes, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntry_notes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntry_changes(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntry_changes,
+		func(ctx context.Context) (any, error) {
+			return obj.Changes, nil
+		},
+		nil,
+		ec.marshalOJSON2map,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntry_changes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type JSON does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntry_diff(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntry_diff,
+		func(ctx context.Context) (any, error) {
+			return obj.Diff, nil
+		},
+		nil,
+		ec.marshalNAuditFieldChange2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditFieldChangeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntry_diff(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_AuditFieldChange_field(ctx, field)
+			case "before":
+				return ec.fieldContext_AuditFieldChange_before(ctx, field)
+			case "after":
+				return ec.fieldContext_AuditFieldChange_after(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditFieldChange", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntry_requestId(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntry_requestId,
+		func(ctx context.Context) (any, error) {
+			return obj.RequestID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntry_requestId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntry_traceId(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntry_traceId,
+		func(ctx context.Context) (any, error) {
+			return obj.TraceID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntry_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntry_actingRole(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntry_actingRole,
+		func(ctx context.Context) (any, error) {
+			return obj.ActingRole, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntry_actingRole(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntry_decisionId(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntry_decisionId,
+		func(ctx context.Context) (any, error) {
+			return obj.DecisionID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntry_decisionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntryConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntryConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntryConnection_edges,
+		func(ctx context.Context) (any, error) {
+			return obj.Edges, nil
+		},
+		nil,
+		ec.marshalNAuditEntryEdge2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditEntryEdgeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntryConnection_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntryConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_AuditEntryEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_AuditEntryEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditEntryEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntryConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntryConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntryConnection_pageInfo,
+		func(ctx context.Context) (any, error) {
+			return obj.PageInfo, nil
+		},
+		nil,
+		ec.marshalNPageInfo2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPageInfo,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntryConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntryConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_PageInfo_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntryEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntryEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntryEdge_cursor,
+		func(ctx context.Context) (any, error) {
+			return obj.Cursor, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntryEdge_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntryEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEntryEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.AuditEntryEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditEntryEdge_node,
+		func(ctx context.Context) (any, error) {
+			return obj.Node, nil
+		},
+		nil,
+		ec.marshalNAuditEntry2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditEntry,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditEntryEdge_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEntryEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AuditEntry_id(ctx, field)
+			case "entityType":
+				return ec.fieldContext_AuditEntry_entityType(ctx, field)
+			case "entityId":
+				return ec.fieldContext_AuditEntry_entityId(ctx, field)
+			case "action":
+				return ec.fieldContext_AuditEntry_action(ctx, field)
+			case "performedBy":
+				return ec.fieldContext_AuditEntry_performedBy(ctx, field)
+			case "performedAt":
+				return ec.fieldContext_AuditEntry_performedAt(ctx, field)
+			case "notes":
+				return ec.fieldContext_AuditEntry_notes(ctx, field)
+			case "changes":
+				return ec.fieldContext_AuditEntry_changes(ctx, field)
+			case "diff":
+				return ec.fieldContext_AuditEntry_diff(ctx, field)
+			case "requestId":
+				return ec.fieldContext_AuditEntry_requestId(ctx, field)
+			case "traceId":
+				return ec.fieldContext_AuditEntry_traceId(ctx, field)
+			case "actingRole":
+				return ec.fieldContext_AuditEntry_actingRole(ctx, field)
+			case "decisionId":
+				return ec.fieldContext_AuditEntry_decisionId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditEntry", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditFieldChange_field(ctx context.Context, field graphql.CollectedField, obj *model.AuditFieldChange) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditFieldChange_field,
+		func(ctx context.Context) (any, error) {
+			return obj.Field, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditFieldChange_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditFieldChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditFieldChange_before(ctx context.Context, field graphql.CollectedField, obj *model.AuditFieldChange) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditFieldChange_before,
+		func(ctx context.Context) (any, error) {
+			return obj.Before, nil
+		},
+		nil,
+		ec.marshalOAny2interface,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditFieldChange_before(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditFieldChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Any does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditFieldChange_after(ctx context.Context, field graphql.CollectedField, obj *model.AuditFieldChange) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuditFieldChange_after,
+		func(ctx context.Context) (any, error) {
+			return obj.After, nil
+		},
+		nil,
+		ec.marshalOAny2interface,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuditFieldChange_after(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditFieldChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Any does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Chalani_id(ctx context.Context, field graphql.CollectedField, obj *model.Chalani) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Chalani_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Chalani_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Chalani",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Chalani_chalaniNumber(ctx context.Context, field graphql.CollectedField, obj *model.Chalani) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Chalani_chalaniNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.ChalaniNumber, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Chalani_chalaniNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Chalani",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Chalani_formattedChalaniNumber(ctx context.Context, field graphql.CollectedField, obj *model.Chalani) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Chalani_formattedChalaniNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.FormattedChalaniNumber, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Chalani_formattedChalaniNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Chalani",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Chalani_fiscalYearId(ctx context.Context, field graphql.CollectedField, obj *model.Chalani) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Chalani_fiscalYearId,
+		func(ctx context.Context) (any, error) {
+			return obj.FiscalYearID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Chalani_fiscalYearId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Chalani",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Chalani_scope(ctx context.Context, field graphql.CollectedField, obj *model.Chalani) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Chalani_scope,
+		func(ctx context.Context) (any, error) {
+			return obj.Scope, nil
+		},
+		nil,
+		ec.marshalNScope2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐScope,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Chalani_scope(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Chalani",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Scope does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Chalani_wardId(ctx context.Context, field graphql.CollectedField, obj *model.Chalani) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Chalani_wardId,
+		func(ctx context.Context) (any, error) {
+			return obj.WardID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Chalani_wardId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Chalani",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Chalani_subject(ctx context.Context, field graphql.CollectedField, obj *model.Chalani) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Chalani_subject,
+		func(ctx context.Context) (any, error) {
+			return obj.Subject, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Chalani_subject(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Chalani",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Chalani_body(ctx context.Context, field graphql.CollectedField, obj *model.Chalani) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Chalani_body,
+		func(ctx context.Context) (any, error) {
+			return obj.Body, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Chalani_body(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Chalani",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Chalani_status(ctx context.Context, field graphql.CollectedField, obj *model.Chalani) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Chalani_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNChalaniStatus2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChalaniStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Chalani_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Chalani",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ChalaniStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Chalani_dispatchChannel(ctx context.Context, field graphql.CollectedField, obj *model.Chalani) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Chalani_dispatchChannel,
+		func(ctx context.Context) (any, error) {
+			return obj.DispatchChannel, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Chalani_dispatchChannel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Chalani",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Chalani_dispatchedAt(ctx context.Context, field graphql.CollectedField, obj *model.Chalani) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Chalani_dispatchedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.DispatchedAt, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Chalani_dispatchedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Chalani",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Chalani_deliveredAt(ctx context.Context, field graphql.CollectedField, obj *model.Chalani) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Chalani_deliveredAt,
+		func(ctx context.Context) (any, error) {
+			return obj.DeliveredAt, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Chalani_deliveredAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Chalani",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Chalani_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Chalani) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Chalani_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Chalani_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Chalani",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Chalani_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Chalani) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Chalani_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Chalani_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Chalani",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Chalani_timeline(ctx context.Context, field graphql.CollectedField, obj *model.Chalani) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Chalani_timeline,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Chalani().Timeline(ctx, obj)
+		},
+		nil,
+		ec.marshalNTimeline2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐTimeline,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Chalani_timeline(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Chalani",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "entries":
+				return ec.fieldContext_Timeline_entries(ctx, field)
+			case "truncated":
+				return ec.fieldContext_Timeline_truncated(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Timeline", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChalaniDispatchEvent_action(ctx context.Context, field graphql.CollectedField, obj *model.ChalaniDispatchEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ChalaniDispatchEvent_action,
+		func(ctx context.Context) (any, error) {
+			return obj.Action, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ChalaniDispatchEvent_action(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChalaniDispatchEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChalaniDispatchEvent_chalaniId(ctx context.Context, field graphql.CollectedField, obj *model.ChalaniDispatchEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ChalaniDispatchEvent_chalaniId,
+		func(ctx context.Context) (any, error) {
+			return obj.ChalaniID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ChalaniDispatchEvent_chalaniId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChalaniDispatchEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChalaniDispatchEvent_formattedChalaniNumber(ctx context.Context, field graphql.CollectedField, obj *model.ChalaniDispatchEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ChalaniDispatchEvent_formattedChalaniNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.FormattedChalaniNumber, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ChalaniDispatchEvent_formattedChalaniNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChalaniDispatchEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChalaniDispatchEvent_subject(ctx context.Context, field graphql.CollectedField, obj *model.ChalaniDispatchEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ChalaniDispatchEvent_subject,
+		func(ctx context.Context) (any, error) {
+			return obj.Subject, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ChalaniDispatchEvent_subject(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChalaniDispatchEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChalaniDispatchEvent_status(ctx context.Context, field graphql.CollectedField, obj *model.ChalaniDispatchEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ChalaniDispatchEvent_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNChalaniStatus2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChalaniStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ChalaniDispatchEvent_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChalaniDispatchEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ChalaniStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChalaniDispatchEvent_trackingId(ctx context.Context, field graphql.CollectedField, obj *model.ChalaniDispatchEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ChalaniDispatchEvent_trackingId,
+		func(ctx context.Context) (any, error) {
+			return obj.TrackingID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ChalaniDispatchEvent_trackingId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChalaniDispatchEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChalaniDispatchEvent_dispatchedAt(ctx context.Context, field graphql.CollectedField, obj *model.ChalaniDispatchEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ChalaniDispatchEvent_dispatchedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.DispatchedAt, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ChalaniDispatchEvent_dispatchedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChalaniDispatchEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChalaniDispatchEvent_deliveredAt(ctx context.Context, field graphql.CollectedField, obj *model.ChalaniDispatchEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ChalaniDispatchEvent_deliveredAt,
+		func(ctx context.Context) (any, error) {
+			return obj.DeliveredAt, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ChalaniDispatchEvent_deliveredAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChalaniDispatchEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChalaniDispatchEvent_actorId(ctx context.Context, field graphql.CollectedField, obj *model.ChalaniDispatchEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ChalaniDispatchEvent_actorId,
+		func(ctx context.Context) (any, error) {
+			return obj.ActorID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ChalaniDispatchEvent_actorId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChalaniDispatchEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChalaniDispatchEvent_occurredAt(ctx context.Context, field graphql.CollectedField, obj *model.ChalaniDispatchEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ChalaniDispatchEvent_occurredAt,
+		func(ctx context.Context) (any, error) {
+			return obj.OccurredAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ChalaniDispatchEvent_occurredAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChalaniDispatchEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChannelCount_channel(ctx context.Context, field graphql.CollectedField, obj *model.ChannelCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ChannelCount_channel,
+		func(ctx context.Context) (any, error) {
+			return obj.Channel, nil
+		},
+		nil,
+		ec.marshalNIntakeChannel2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐIntakeChannel,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ChannelCount_channel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChannelCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type IntakeChannel does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChannelCount_count(ctx context.Context, field graphql.CollectedField, obj *model.ChannelCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ChannelCount_count,
+		func(ctx context.Context) (any, error) {
+			return obj.Count, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ChannelCount_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChannelCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_id(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_dartaNumber(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_dartaNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.DartaNumber, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_dartaNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_formattedDartaNumber(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_formattedDartaNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.FormattedDartaNumber, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_formattedDartaNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_fiscalYearId(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_fiscalYearId,
+		func(ctx context.Context) (any, error) {
+			return obj.FiscalYearID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_fiscalYearId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_scope(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_scope,
+		func(ctx context.Context) (any, error) {
+			return obj.Scope, nil
+		},
+		nil,
+		ec.marshalNScope2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐScope,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_scope(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Scope does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_wardId(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_wardId,
+		func(ctx context.Context) (any, error) {
+			return obj.WardID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_wardId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_subject(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_subject,
+		func(ctx context.Context) (any, error) {
+			return obj.Subject, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_subject(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_applicant(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_applicant,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Darta().Applicant(ctx, obj)
+		},
+		nil,
+		ec.marshalNApplicant2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicant,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_applicant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Applicant_id(ctx, field)
+			case "type":
+				return ec.fieldContext_Applicant_type(ctx, field)
+			case "fullName":
+				return ec.fieldContext_Applicant_fullName(ctx, field)
+			case "organization":
+				return ec.fieldContext_Applicant_organization(ctx, field)
+			case "email":
+				return ec.fieldContext_Applicant_email(ctx, field)
+			case "phone":
+				return ec.fieldContext_Applicant_phone(ctx, field)
+			case "address":
+				return ec.fieldContext_Applicant_address(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Applicant", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_intakeChannel(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_intakeChannel,
+		func(ctx context.Context) (any, error) {
+			return obj.IntakeChannel, nil
+		},
+		nil,
+		ec.marshalNIntakeChannel2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐIntakeChannel,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_intakeChannel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type IntakeChannel does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_receivedDate(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_receivedDate,
+		func(ctx context.Context) (any, error) {
+			return obj.ReceivedDate, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_receivedDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_entryDate(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_entryDate,
+		func(ctx context.Context) (any, error) {
+			return obj.EntryDate, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_entryDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_status(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNDartaStatus2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DartaStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_priority(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_priority,
+		func(ctx context.Context) (any, error) {
+			return obj.Priority, nil
+		},
+		nil,
+		ec.marshalNPriority2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPriority,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_priority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Priority does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_createdBy(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_createdBy,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Darta().CreatedBy(ctx, obj)
+		},
+		nil,
+		ec.marshalNUser2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐUser,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_createdBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "username":
+				return ec.fieldContext_User_username(ctx, field)
+			case "fullName":
+				return ec.fieldContext_User_fullName(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_tenantId(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_tenantId,
+		func(ctx context.Context) (any, error) {
+			return obj.TenantID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_tenantId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_metadata(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_metadata,
+		func(ctx context.Context) (any, error) {
+			return obj.Metadata, nil
+		},
+		nil,
+		ec.marshalOJSON2map,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type JSON does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_version(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_version,
+		func(ctx context.Context) (any, error) {
+			return obj.Version, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_assignee(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_assignee,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Darta().Assignee(ctx, obj)
+		},
+		nil,
+		ec.marshalOUser2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐUser,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_assignee(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "username":
+				return ec.fieldContext_User_username(ctx, field)
+			case "fullName":
+				return ec.fieldContext_User_fullName(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_attachments(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_attachments,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Darta().Attachments(ctx, obj)
+		},
+		nil,
+		ec.marshalNAttachment2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAttachmentᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_attachments(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Attachment_id(ctx, field)
+			case "filename":
+				return ec.fieldContext_Attachment_filename(ctx, field)
+			case "originalFilename":
+				return ec.fieldContext_Attachment_originalFilename(ctx, field)
+			case "mimeType":
+				return ec.fieldContext_Attachment_mimeType(ctx, field)
+			case "sizeBytes":
+				return ec.fieldContext_Attachment_sizeBytes(ctx, field)
+			case "uploadedBy":
+				return ec.fieldContext_Attachment_uploadedBy(ctx, field)
+			case "uploadedAt":
+				return ec.fieldContext_Attachment_uploadedAt(ctx, field)
+			case "isPrimary":
+				return ec.fieldContext_Attachment_isPrimary(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Attachment", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_relatedDartas(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_relatedDartas,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Darta().RelatedDartas(ctx, obj)
+		},
+		nil,
+		ec.marshalNRelatedDarta2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRelatedDartaᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_relatedDartas(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "relationshipType":
+				return ec.fieldContext_RelatedDarta_relationshipType(ctx, field)
+			case "darta":
+				return ec.fieldContext_RelatedDarta_darta(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelatedDarta", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_auditTrail(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_auditTrail,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Darta().AuditTrail(ctx, obj)
+		},
+		nil,
+		ec.marshalNAuditEntry2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditEntryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_auditTrail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AuditEntry_id(ctx, field)
+			case "entityType":
+				return ec.fieldContext_AuditEntry_entityType(ctx, field)
+			case "entityId":
+				return ec.fieldContext_AuditEntry_entityId(ctx, field)
+			case "action":
+				return ec.fieldContext_AuditEntry_action(ctx, field)
+			case "performedBy":
+				return ec.fieldContext_AuditEntry_performedBy(ctx, field)
+			case "performedAt":
+				return ec.fieldContext_AuditEntry_performedAt(ctx, field)
+			case "notes":
+				return ec.fieldContext_AuditEntry_notes(ctx, field)
+			case "changes":
+				return ec.fieldContext_AuditEntry_changes(ctx, field)
+			case "diff":
+				return ec.fieldContext_AuditEntry_diff(ctx, field)
+			case "requestId":
+				return ec.fieldContext_AuditEntry_requestId(ctx, field)
+			case "traceId":
+				return ec.fieldContext_AuditEntry_traceId(ctx, field)
+			case "actingRole":
+				return ec.fieldContext_AuditEntry_actingRole(ctx, field)
+			case "decisionId":
+				return ec.fieldContext_AuditEntry_decisionId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditEntry", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_timeline(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_timeline,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Darta().Timeline(ctx, obj)
+		},
+		nil,
+		ec.marshalNTimeline2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐTimeline,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_timeline(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "entries":
+				return ec.fieldContext_Timeline_entries(ctx, field)
+			case "truncated":
+				return ec.fieldContext_Timeline_truncated(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Timeline", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_suspectedDuplicates(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_suspectedDuplicates,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Darta().SuspectedDuplicates(ctx, obj)
+		},
+		nil,
+		ec.marshalNDuplicateCandidate2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDuplicateCandidateᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_suspectedDuplicates(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "dartaId":
+				return ec.fieldContext_DuplicateCandidate_dartaId(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_DuplicateCandidate_formattedDartaNumber(ctx, field)
+			case "subject":
+				return ec.fieldContext_DuplicateCandidate_subject(ctx, field)
+			case "status":
+				return ec.fieldContext_DuplicateCandidate_status(ctx, field)
+			case "wardId":
+				return ec.fieldContext_DuplicateCandidate_wardId(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_DuplicateCandidate_receivedDate(ctx, field)
+			case "applicantName":
+				return ec.fieldContext_DuplicateCandidate_applicantName(ctx, field)
+			case "score":
+				return ec.fieldContext_DuplicateCandidate_score(ctx, field)
+			case "reasons":
+				return ec.fieldContext_DuplicateCandidate_reasons(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DuplicateCandidate", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_supersedesDartaNumber(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_supersedesDartaNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.SupersedesDartaNumber, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_supersedesDartaNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_supersedes(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_supersedes,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Darta().Supersedes(ctx, obj)
+		},
+		nil,
+		ec.marshalODarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_supersedes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_supersededBy(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_supersededBy,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Darta().SupersededBy(ctx, obj)
+		},
+		nil,
+		ec.marshalODarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_supersededBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Darta_supersessionChain(ctx context.Context, field graphql.CollectedField, obj *model.Darta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Darta_supersessionChain,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Darta().SupersessionChain(ctx, obj)
+		},
+		nil,
+		ec.marshalNDarta2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Darta_supersessionChain(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Darta",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.DartaConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaConnection_edges,
+		func(ctx context.Context) (any, error) {
+			return obj.Edges, nil
+		},
+		nil,
+		ec.marshalNDartaEdge2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaEdgeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaConnection_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_DartaEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_DartaEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DartaEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.DartaConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaConnection_pageInfo,
+		func(ctx context.Context) (any, error) {
+			return obj.PageInfo, nil
+		},
+		nil,
+		ec.marshalNPageInfo2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPageInfo,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_PageInfo_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.DartaEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaEdge_cursor,
+		func(ctx context.Context) (any, error) {
+			return obj.Cursor, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaEdge_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.DartaEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaEdge_node,
+		func(ctx context.Context) (any, error) {
+			return obj.Node, nil
+		},
+		nil,
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaEdge_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaEvent_action(ctx context.Context, field graphql.CollectedField, obj *model.DartaEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaEvent_action,
+		func(ctx context.Context) (any, error) {
+			return obj.Action, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaEvent_action(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaEvent_darta(ctx context.Context, field graphql.CollectedField, obj *model.DartaEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaEvent_darta,
+		func(ctx context.Context) (any, error) {
+			return obj.Darta, nil
+		},
+		nil,
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaEvent_darta(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaEvent_actorId(ctx context.Context, field graphql.CollectedField, obj *model.DartaEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaEvent_actorId,
+		func(ctx context.Context) (any, error) {
+			return obj.ActorID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaEvent_actorId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaEvent_previousAssigneeId(ctx context.Context, field graphql.CollectedField, obj *model.DartaEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaEvent_previousAssigneeId,
+		func(ctx context.Context) (any, error) {
+			return obj.PreviousAssigneeID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaEvent_previousAssigneeId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaEvent_occurredAt(ctx context.Context, field graphql.CollectedField, obj *model.DartaEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaEvent_occurredAt,
+		func(ctx context.Context) (any, error) {
+			return obj.OccurredAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaEvent_occurredAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaEvent_slaStage(ctx context.Context, field graphql.CollectedField, obj *model.DartaEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaEvent_slaStage,
+		func(ctx context.Context) (any, error) {
+			return obj.SLAStage, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaEvent_slaStage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaEvent_escalatedToId(ctx context.Context, field graphql.CollectedField, obj *model.DartaEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaEvent_escalatedToId,
+		func(ctx context.Context) (any, error) {
+			return obj.EscalatedToID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaEvent_escalatedToId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStats_total(ctx context.Context, field graphql.CollectedField, obj *model.DartaStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStats_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStats_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStats_byStatus(ctx context.Context, field graphql.CollectedField, obj *model.DartaStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStats_byStatus,
+		func(ctx context.Context) (any, error) {
+			return obj.ByStatus, nil
+		},
+		nil,
+		ec.marshalNDartaStatusCount2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatusCountᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStats_byStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "status":
+				return ec.fieldContext_DartaStatusCount_status(ctx, field)
+			case "count":
+				return ec.fieldContext_DartaStatusCount_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DartaStatusCount", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStats_byChannel(ctx context.Context, field graphql.CollectedField, obj *model.DartaStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStats_byChannel,
+		func(ctx context.Context) (any, error) {
+			return obj.ByChannel, nil
+		},
+		nil,
+		ec.marshalNChannelCount2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChannelCountᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStats_byChannel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channel":
+				return ec.fieldContext_ChannelCount_channel(ctx, field)
+			case "count":
+				return ec.fieldContext_ChannelCount_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ChannelCount", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStats_overdueCount(ctx context.Context, field graphql.CollectedField, obj *model.DartaStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStats_overdueCount,
+		func(ctx context.Context) (any, error) {
+			return obj.OverdueCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStats_overdueCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStats_avgProcessingTimeHours(ctx context.Context, field graphql.CollectedField, obj *model.DartaStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStats_avgProcessingTimeHours,
+		func(ctx context.Context) (any, error) {
+			return obj.AvgProcessingTimeHours, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStats_avgProcessingTimeHours(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStats_receivedToRegistered(ctx context.Context, field graphql.CollectedField, obj *model.DartaStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStats_receivedToRegistered,
+		func(ctx context.Context) (any, error) {
+			return obj.ReceivedToRegistered, nil
+		},
+		nil,
+		ec.marshalNDurationStats2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDurationStats,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStats_receivedToRegistered(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "count":
+				return ec.fieldContext_DurationStats_count(ctx, field)
+			case "avgHours":
+				return ec.fieldContext_DurationStats_avgHours(ctx, field)
+			case "p90Hours":
+				return ec.fieldContext_DurationStats_p90Hours(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DurationStats", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStats_registeredToClosed(ctx context.Context, field graphql.CollectedField, obj *model.DartaStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStats_registeredToClosed,
+		func(ctx context.Context) (any, error) {
+			return obj.RegisteredToClosed, nil
+		},
+		nil,
+		ec.marshalNDurationStats2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDurationStats,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStats_registeredToClosed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "count":
+				return ec.fieldContext_DurationStats_count(ctx, field)
+			case "avgHours":
+				return ec.fieldContext_DurationStats_avgHours(ctx, field)
+			case "p90Hours":
+				return ec.fieldContext_DurationStats_p90Hours(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DurationStats", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStats_byWard(ctx context.Context, field graphql.CollectedField, obj *model.DartaStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStats_byWard,
+		func(ctx context.Context) (any, error) {
+			return obj.ByWard, nil
+		},
+		nil,
+		ec.marshalNDartaStatsBreakdown2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatsBreakdownᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStats_byWard(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "key":
+				return ec.fieldContext_DartaStatsBreakdown_key(ctx, field)
+			case "total":
+				return ec.fieldContext_DartaStatsBreakdown_total(ctx, field)
+			case "overdueCount":
+				return ec.fieldContext_DartaStatsBreakdown_overdueCount(ctx, field)
+			case "receivedToRegistered":
+				return ec.fieldContext_DartaStatsBreakdown_receivedToRegistered(ctx, field)
+			case "registeredToClosed":
+				return ec.fieldContext_DartaStatsBreakdown_registeredToClosed(ctx, field)
+			case "sectionTurnaround":
+				return ec.fieldContext_DartaStatsBreakdown_sectionTurnaround(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DartaStatsBreakdown", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStats_bySection(ctx context.Context, field graphql.CollectedField, obj *model.DartaStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStats_bySection,
+		func(ctx context.Context) (any, error) {
+			return obj.BySection, nil
+		},
+		nil,
+		ec.marshalNDartaStatsBreakdown2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatsBreakdownᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStats_bySection(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "key":
+				return ec.fieldContext_DartaStatsBreakdown_key(ctx, field)
+			case "total":
+				return ec.fieldContext_DartaStatsBreakdown_total(ctx, field)
+			case "overdueCount":
+				return ec.fieldContext_DartaStatsBreakdown_overdueCount(ctx, field)
+			case "receivedToRegistered":
+				return ec.fieldContext_DartaStatsBreakdown_receivedToRegistered(ctx, field)
+			case "registeredToClosed":
+				return ec.fieldContext_DartaStatsBreakdown_registeredToClosed(ctx, field)
+			case "sectionTurnaround":
+				return ec.fieldContext_DartaStatsBreakdown_sectionTurnaround(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DartaStatsBreakdown", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStats_byIntakeChannel(ctx context.Context, field graphql.CollectedField, obj *model.DartaStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStats_byIntakeChannel,
+		func(ctx context.Context) (any, error) {
+			return obj.ByIntakeChannel, nil
+		},
+		nil,
+		ec.marshalNDartaStatsBreakdown2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatsBreakdownᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStats_byIntakeChannel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "key":
+				return ec.fieldContext_DartaStatsBreakdown_key(ctx, field)
+			case "total":
+				return ec.fieldContext_DartaStatsBreakdown_total(ctx, field)
+			case "overdueCount":
+				return ec.fieldContext_DartaStatsBreakdown_overdueCount(ctx, field)
+			case "receivedToRegistered":
+				return ec.fieldContext_DartaStatsBreakdown_receivedToRegistered(ctx, field)
+			case "registeredToClosed":
+				return ec.fieldContext_DartaStatsBreakdown_registeredToClosed(ctx, field)
+			case "sectionTurnaround":
+				return ec.fieldContext_DartaStatsBreakdown_sectionTurnaround(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DartaStatsBreakdown", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStats_byPriority(ctx context.Context, field graphql.CollectedField, obj *model.DartaStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStats_byPriority,
+		func(ctx context.Context) (any, error) {
+			return obj.ByPriority, nil
+		},
+		nil,
+		ec.marshalNDartaStatsBreakdown2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatsBreakdownᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStats_byPriority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "key":
+				return ec.fieldContext_DartaStatsBreakdown_key(ctx, field)
+			case "total":
+				return ec.fieldContext_DartaStatsBreakdown_total(ctx, field)
+			case "overdueCount":
+				return ec.fieldContext_DartaStatsBreakdown_overdueCount(ctx, field)
+			case "receivedToRegistered":
+				return ec.fieldContext_DartaStatsBreakdown_receivedToRegistered(ctx, field)
+			case "registeredToClosed":
+				return ec.fieldContext_DartaStatsBreakdown_registeredToClosed(ctx, field)
+			case "sectionTurnaround":
+				return ec.fieldContext_DartaStatsBreakdown_sectionTurnaround(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DartaStatsBreakdown", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStats_series(ctx context.Context, field graphql.CollectedField, obj *model.DartaStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStats_series,
+		func(ctx context.Context) (any, error) {
+			return obj.Series, nil
+		},
+		nil,
+		ec.marshalNDartaStatsBucket2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatsBucketᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStats_series(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "start":
+				return ec.fieldContext_DartaStatsBucket_start(ctx, field)
+			case "startBs":
+				return ec.fieldContext_DartaStatsBucket_startBs(ctx, field)
+			case "received":
+				return ec.fieldContext_DartaStatsBucket_received(ctx, field)
+			case "registered":
+				return ec.fieldContext_DartaStatsBucket_registered(ctx, field)
+			case "closed":
+				return ec.fieldContext_DartaStatsBucket_closed(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DartaStatsBucket", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStatsBreakdown_key(ctx context.Context, field graphql.CollectedField, obj *model.DartaStatsBreakdown) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStatsBreakdown_key,
+		func(ctx context.Context) (any, error) {
+			return obj.Key, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStatsBreakdown_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStatsBreakdown",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStatsBreakdown_total(ctx context.Context, field graphql.CollectedField, obj *model.DartaStatsBreakdown) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStatsBreakdown_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStatsBreakdown_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStatsBreakdown",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStatsBreakdown_overdueCount(ctx context.Context, field graphql.CollectedField, obj *model.DartaStatsBreakdown) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStatsBreakdown_overdueCount,
+		func(ctx context.Context) (any, error) {
+			return obj.OverdueCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStatsBreakdown_overdueCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStatsBreakdown",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStatsBreakdown_receivedToRegistered(ctx context.Context, field graphql.CollectedField, obj *model.DartaStatsBreakdown) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStatsBreakdown_receivedToRegistered,
+		func(ctx context.Context) (any, error) {
+			return obj.ReceivedToRegistered, nil
+		},
+		nil,
+		ec.marshalNDurationStats2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDurationStats,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStatsBreakdown_receivedToRegistered(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStatsBreakdown",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "count":
+				return ec.fieldContext_DurationStats_count(ctx, field)
+			case "avgHours":
+				return ec.fieldContext_DurationStats_avgHours(ctx, field)
+			case "p90Hours":
+				return ec.fieldContext_DurationStats_p90Hours(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DurationStats", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStatsBreakdown_registeredToClosed(ctx context.Context, field graphql.CollectedField, obj *model.DartaStatsBreakdown) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStatsBreakdown_registeredToClosed,
+		func(ctx context.Context) (any, error) {
+			return obj.RegisteredToClosed, nil
+		},
+		nil,
+		ec.marshalNDurationStats2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDurationStats,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStatsBreakdown_registeredToClosed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStatsBreakdown",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "count":
+				return ec.fieldContext_DurationStats_count(ctx, field)
+			case "avgHours":
+				return ec.fieldContext_DurationStats_avgHours(ctx, field)
+			case "p90Hours":
+				return ec.fieldContext_DurationStats_p90Hours(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DurationStats", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStatsBreakdown_sectionTurnaround(ctx context.Context, field graphql.CollectedField, obj *model.DartaStatsBreakdown) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStatsBreakdown_sectionTurnaround,
+		func(ctx context.Context) (any, error) {
+			return obj.SectionTurnaround, nil
+		},
+		nil,
+		ec.marshalODurationStats2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDurationStats,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStatsBreakdown_sectionTurnaround(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStatsBreakdown",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "count":
+				return ec.fieldContext_DurationStats_count(ctx, field)
+			case "avgHours":
+				return ec.fieldContext_DurationStats_avgHours(ctx, field)
+			case "p90Hours":
+				return ec.fieldContext_DurationStats_p90Hours(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DurationStats", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStatsBucket_start(ctx context.Context, field graphql.CollectedField, obj *model.DartaStatsBucket) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStatsBucket_start,
+		func(ctx context.Context) (any, error) {
+			return obj.Start, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStatsBucket_start(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStatsBucket",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStatsBucket_startBs(ctx context.Context, field graphql.CollectedField, obj *model.DartaStatsBucket) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStatsBucket_startBs,
+		func(ctx context.Context) (any, error) {
+			return obj.StartBs, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStatsBucket_startBs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStatsBucket",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStatsBucket_received(ctx context.Context, field graphql.CollectedField, obj *model.DartaStatsBucket) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStatsBucket_received,
+		func(ctx context.Context) (any, error) {
+			return obj.Received, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStatsBucket_received(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStatsBucket",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStatsBucket_registered(ctx context.Context, field graphql.CollectedField, obj *model.DartaStatsBucket) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStatsBucket_registered,
+		func(ctx context.Context) (any, error) {
+			return obj.Registered, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStatsBucket_registered(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStatsBucket",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStatsBucket_closed(ctx context.Context, field graphql.CollectedField, obj *model.DartaStatsBucket) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStatsBucket_closed,
+		func(ctx context.Context) (any, error) {
+			return obj.Closed, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStatsBucket_closed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStatsBucket",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStatusCount_status(ctx context.Context, field graphql.CollectedField, obj *model.DartaStatusCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStatusCount_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNDartaStatus2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStatusCount_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStatusCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DartaStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DartaStatusCount_count(ctx context.Context, field graphql.CollectedField, obj *model.DartaStatusCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DartaStatusCount_count,
+		func(ctx context.Context) (any, error) {
+			return obj.Count, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DartaStatusCount_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DartaStatusCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DuplicateCandidate_dartaId(ctx context.Context, field graphql.CollectedField, obj *model.DuplicateCandidate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DuplicateCandidate_dartaId,
+		func(ctx context.Context) (any, error) {
+			return obj.DartaID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DuplicateCandidate_dartaId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DuplicateCandidate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DuplicateCandidate_formattedDartaNumber(ctx context.Context, field graphql.CollectedField, obj *model.DuplicateCandidate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DuplicateCandidate_formattedDartaNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.FormattedDartaNumber, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_DuplicateCandidate_formattedDartaNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DuplicateCandidate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DuplicateCandidate_subject(ctx context.Context, field graphql.CollectedField, obj *model.DuplicateCandidate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DuplicateCandidate_subject,
+		func(ctx context.Context) (any, error) {
+			return obj.Subject, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DuplicateCandidate_subject(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DuplicateCandidate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DuplicateCandidate_status(ctx context.Context, field graphql.CollectedField, obj *model.DuplicateCandidate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DuplicateCandidate_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNDartaStatus2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DuplicateCandidate_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DuplicateCandidate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DartaStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DuplicateCandidate_wardId(ctx context.Context, field graphql.CollectedField, obj *model.DuplicateCandidate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DuplicateCandidate_wardId,
+		func(ctx context.Context) (any, error) {
+			return obj.WardID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_DuplicateCandidate_wardId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DuplicateCandidate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DuplicateCandidate_receivedDate(ctx context.Context, field graphql.CollectedField, obj *model.DuplicateCandidate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DuplicateCandidate_receivedDate,
+		func(ctx context.Context) (any, error) {
+			return obj.ReceivedDate, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DuplicateCandidate_receivedDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DuplicateCandidate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DuplicateCandidate_applicantName(ctx context.Context, field graphql.CollectedField, obj *model.DuplicateCandidate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DuplicateCandidate_applicantName,
+		func(ctx context.Context) (any, error) {
+			return obj.ApplicantName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DuplicateCandidate_applicantName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DuplicateCandidate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DuplicateCandidate_score(ctx context.Context, field graphql.CollectedField, obj *model.DuplicateCandidate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DuplicateCandidate_score,
+		func(ctx context.Context) (any, error) {
+			return obj.Score, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DuplicateCandidate_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DuplicateCandidate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DuplicateCandidate_reasons(ctx context.Context, field graphql.CollectedField, obj *model.DuplicateCandidate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DuplicateCandidate_reasons,
+		func(ctx context.Context) (any, error) {
+			return obj.Reasons, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DuplicateCandidate_reasons(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DuplicateCandidate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DurationStats_count(ctx context.Context, field graphql.CollectedField, obj *model.DurationStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DurationStats_count,
+		func(ctx context.Context) (any, error) {
+			return obj.Count, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DurationStats_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DurationStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DurationStats_avgHours(ctx context.Context, field graphql.CollectedField, obj *model.DurationStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DurationStats_avgHours,
+		func(ctx context.Context) (any, error) {
+			return obj.AvgHours, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DurationStats_avgHours(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DurationStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DurationStats_p90Hours(ctx context.Context, field graphql.CollectedField, obj *model.DurationStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DurationStats_p90Hours,
+		func(ctx context.Context) (any, error) {
+			return obj.P90Hours, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DurationStats_p90Hours(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DurationStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HealthStatus_status(ctx context.Context, field graphql.CollectedField, obj *model.HealthStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HealthStatus_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HealthStatus_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HealthStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HealthStatus_service(ctx context.Context, field graphql.CollectedField, obj *model.HealthStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HealthStatus_service,
+		func(ctx context.Context) (any, error) {
+			return obj.Service, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HealthStatus_service(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HealthStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HealthStatus_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.HealthStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HealthStatus_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HealthStatus_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HealthStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createDarta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_createDarta,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreateDarta(ctx, fc.Args["input"].(model.CreateDartaInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"darta_clerk", "darta_registrar"})
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresRole == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresRole is not implemented")
+				}
+				return ec.directives.RequiresRole(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createDarta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createDarta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_submitDartaForReview(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_submitDartaForReview,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().SubmitDartaForReview(ctx, fc.Args["dartaId"].(string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_write")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_submitDartaForReview(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_submitDartaForReview_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_reviewDarta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_reviewDarta,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ReviewDarta(ctx, fc.Args["input"].(model.ReviewDartaInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_review")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$input.dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_reviewDarta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_reviewDarta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_approveDartaReview(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_approveDartaReview,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ApproveDartaReview(ctx, fc.Args["dartaId"].(string), fc.Args["notes"].(*string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_review")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_approveDartaReview(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_approveDartaReview_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_classifyDarta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_classifyDarta,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ClassifyDarta(ctx, fc.Args["dartaId"].(string), fc.Args["classificationCode"].(string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_register")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_classifyDarta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_classifyDarta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_reserveDartaNumber(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_reserveDartaNumber,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ReserveDartaNumber(ctx, fc.Args["dartaId"].(string), fc.Args["acknowledgeDuplicates"].(*bool), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_register")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_reserveDartaNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_reserveDartaNumber_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_finalizeDartaRegistration(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_finalizeDartaRegistration,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().FinalizeDartaRegistration(ctx, fc.Args["dartaId"].(string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_register")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_finalizeDartaRegistration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_finalizeDartaRegistration_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_directRegisterDarta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_directRegisterDarta,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DirectRegisterDarta(ctx, fc.Args["dartaId"].(string), fc.Args["acknowledgeDuplicates"].(*bool), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_register")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_directRegisterDarta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_directRegisterDarta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_voidDarta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_voidDarta,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().VoidDarta(ctx, fc.Args["dartaId"].(string), fc.Args["reason"].(string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_void")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_voidDarta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_voidDarta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_linkDuplicateDarta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_linkDuplicateDarta,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().LinkDuplicateDarta(ctx, fc.Args["dartaId"].(string), fc.Args["duplicateOfId"].(string), fc.Args["notes"].(*string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_register")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_linkDuplicateDarta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_linkDuplicateDarta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_scanDarta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_scanDarta,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ScanDarta(ctx, fc.Args["dartaId"].(string), fc.Args["scanAttachmentId"].(string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_write")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_scanDarta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_scanDarta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_enrichDartaMetadata(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_enrichDartaMetadata,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().EnrichDartaMetadata(ctx, fc.Args["dartaId"].(string), fc.Args["metadata"].(map[string]any), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_write")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_enrichDartaMetadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_enrichDartaMetadata_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_archiveDartaDigital(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_archiveDartaDigital,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ArchiveDartaDigital(ctx, fc.Args["dartaId"].(string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_register")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_archiveDartaDigital(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_archiveDartaDigital_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_routeDarta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_routeDarta,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RouteDarta(ctx, fc.Args["input"].(model.RouteDartaInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_assign")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$input.dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_routeDarta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_routeDarta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_assignDartaSection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_assignDartaSection,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AssignDartaSection(ctx, fc.Args["input"].(model.AssignDartaSectionInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_assign")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$input.dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_assignDartaSection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_assignDartaSection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_sectionReviewDarta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_sectionReviewDarta,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().SectionReviewDarta(ctx, fc.Args["dartaId"].(string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_act")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_sectionReviewDarta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_sectionReviewDarta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_requestDartaClarification(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_requestDartaClarification,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RequestDartaClarification(ctx, fc.Args["dartaId"].(string), fc.Args["note"].(string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_act")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_requestDartaClarification(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_requestDartaClarification_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_provideDartaClarification(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_provideDartaClarification,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ProvideDartaClarification(ctx, fc.Args["dartaId"].(string), fc.Args["note"].(string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_write")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_provideDartaClarification(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_provideDartaClarification_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_acceptDarta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_acceptDarta,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AcceptDarta(ctx, fc.Args["dartaId"].(string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_act")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_acceptDarta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_acceptDarta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_markDartaAction(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_markDartaAction,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().MarkDartaAction(ctx, fc.Args["dartaId"].(string), fc.Args["actionNote"].(string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_act")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_markDartaAction(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_markDartaAction_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_issueDartaResponse(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_issueDartaResponse,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().IssueDartaResponse(ctx, fc.Args["input"].(model.IssueDartaResponseInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_act")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$input.dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_issueDartaResponse(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_issueDartaResponse_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_requestDartaAck(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_requestDartaAck,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RequestDartaAck(ctx, fc.Args["dartaId"].(string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_register")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_requestDartaAck(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_requestDartaAck_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_receiveDartaAck(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_receiveDartaAck,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ReceiveDartaAck(ctx, fc.Args["dartaId"].(string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_register")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_receiveDartaAck(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_receiveDartaAck_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_supersedeDartaRecord(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_supersedeDartaRecord,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().SupersedeDartaRecord(ctx, fc.Args["dartaId"].(string), fc.Args["newDartaId"].(string), fc.Args["reason"].(string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_void")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_supersedeDartaRecord(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_supersedeDartaRecord_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_closeDarta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_closeDarta,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CloseDarta(ctx, fc.Args["dartaId"].(string), fc.Args["expectedVersion"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_act")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$dartaId")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_closeDarta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_closeDarta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_hasNextPage,
+		func(ctx context.Context) (any, error) {
+			return obj.HasNextPage, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_hasPreviousPage,
+		func(ctx context.Context) (any, error) {
+			return obj.HasPreviousPage, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_hasPreviousPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_startCursor,
+		func(ctx context.Context) (any, error) {
+			return obj.StartCursor, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_startCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_endCursor,
+		func(ctx context.Context) (any, error) {
+			return obj.EndCursor, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_endCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_totalCount,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_health(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_health,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().Health(ctx)
+		},
+		nil,
+		ec.marshalNHealthStatus2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐHealthStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_health(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "status":
+				return ec.fieldContext_HealthStatus_status(ctx, field)
+			case "service":
+				return ec.fieldContext_HealthStatus_service(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_HealthStatus_timestamp(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type HealthStatus", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_darta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_darta,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Darta(ctx, fc.Args["id"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_read")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$id")
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalODarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_darta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_darta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_dartaByNumber(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_dartaByNumber,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().DartaByNumber(ctx, fc.Args["dartaNumber"].(int), fc.Args["fiscalYearId"].(string), fc.Args["scope"].(model.Scope), fc.Args["wardId"].(*string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"darta_clerk", "darta_reviewer", "darta_registrar", "numbering_officer"})
+				if err != nil {
+					var zeroVal *model.Darta
+					return zeroVal, err
+				}
+				if ec.directives.RequiresRole == nil {
+					var zeroVal *model.Darta
+					return zeroVal, errors.New("directive requiresRole is not implemented")
+				}
+				return ec.directives.RequiresRole(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalODarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_dartaByNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_dartaByNumber_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_dartas(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_dartas,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Dartas(ctx, fc.Args["filter"].(*model.DartaFilterInput), fc.Args["pagination"].(*model.PaginationInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"darta_clerk", "darta_reviewer", "darta_registrar", "numbering_officer"})
+				if err != nil {
+					var zeroVal *model.DartaConnection
+					return zeroVal, err
+				}
+				if ec.directives.RequiresRole == nil {
+					var zeroVal *model.DartaConnection
+					return zeroVal, errors.New("directive requiresRole is not implemented")
+				}
+				return ec.directives.RequiresRole(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDartaConnection2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaConnection,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_dartas(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_DartaConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_DartaConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DartaConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_dartas_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_myDartas(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_myDartas,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().MyDartas(ctx, fc.Args["status"].(*model.DartaStatus), fc.Args["pagination"].(*model.PaginationInput))
+		},
+		nil,
+		ec.marshalNDartaConnection2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaConnection,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_myDartas(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_DartaConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_DartaConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DartaConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_myDartas_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_chalani(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_chalani,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Chalani(ctx, fc.Args["id"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"chalani_dispatcher", "chalani_approver"})
+				if err != nil {
+					var zeroVal *model.Chalani
+					return zeroVal, err
+				}
+				if ec.directives.RequiresRole == nil {
+					var zeroVal *model.Chalani
+					return zeroVal, errors.New("directive requiresRole is not implemented")
+				}
+				return ec.directives.RequiresRole(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalOChalani2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChalani,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_chalani(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Chalani_id(ctx, field)
+			case "chalaniNumber":
+				return ec.fieldContext_Chalani_chalaniNumber(ctx, field)
+			case "formattedChalaniNumber":
+				return ec.fieldContext_Chalani_formattedChalaniNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Chalani_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Chalani_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Chalani_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Chalani_subject(ctx, field)
+			case "body":
+				return ec.fieldContext_Chalani_body(ctx, field)
+			case "status":
+				return ec.fieldContext_Chalani_status(ctx, field)
+			case "dispatchChannel":
+				return ec.fieldContext_Chalani_dispatchChannel(ctx, field)
+			case "dispatchedAt":
+				return ec.fieldContext_Chalani_dispatchedAt(ctx, field)
+			case "deliveredAt":
+				return ec.fieldContext_Chalani_deliveredAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Chalani_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Chalani_updatedAt(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Chalani_timeline(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Chalani", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_chalani_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_dartaStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_dartaStats,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().DartaStats(ctx, fc.Args["scope"].(*model.Scope), fc.Args["fiscalYearId"].(*string), fc.Args["wardId"].(*string), fc.Args["fromDate"].(*string), fc.Args["toDate"].(*string), fc.Args["interval"].(*model.StatsInterval))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"darta_reviewer", "darta_registrar"})
+				if err != nil {
+					var zeroVal *model.DartaStats
+					return zeroVal, err
+				}
+				if ec.directives.RequiresRole == nil {
+					var zeroVal *model.DartaStats
+					return zeroVal, errors.New("directive requiresRole is not implemented")
+				}
+				return ec.directives.RequiresRole(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDartaStats2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStats,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_dartaStats(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_DartaStats_total(ctx, field)
+			case "byStatus":
+				return ec.fieldContext_DartaStats_byStatus(ctx, field)
+			case "byChannel":
+				return ec.fieldContext_DartaStats_byChannel(ctx, field)
+			case "overdueCount":
+				return ec.fieldContext_DartaStats_overdueCount(ctx, field)
+			case "avgProcessingTimeHours":
+				return ec.fieldContext_DartaStats_avgProcessingTimeHours(ctx, field)
+			case "receivedToRegistered":
+				return ec.fieldContext_DartaStats_receivedToRegistered(ctx, field)
+			case "registeredToClosed":
+				return ec.fieldContext_DartaStats_registeredToClosed(ctx, field)
+			case "byWard":
+				return ec.fieldContext_DartaStats_byWard(ctx, field)
+			case "bySection":
+				return ec.fieldContext_DartaStats_bySection(ctx, field)
+			case "byIntakeChannel":
+				return ec.fieldContext_DartaStats_byIntakeChannel(ctx, field)
+			case "byPriority":
+				return ec.fieldContext_DartaStats_byPriority(ctx, field)
+			case "series":
+				return ec.fieldContext_DartaStats_series(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DartaStats", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_dartaStats_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_search(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_search,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Search(ctx, fc.Args["query"].(string), fc.Args["entityTypes"].([]model.SearchEntityType), fc.Args["filter"].(*model.SearchFilterInput), fc.Args["first"].(*int), fc.Args["after"].(*string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"darta_clerk", "darta_reviewer", "darta_registrar", "numbering_officer", "chalani_dispatcher", "chalani_approver"})
+				if err != nil {
+					var zeroVal *model.SearchResult
+					return zeroVal, err
+				}
+				if ec.directives.RequiresRole == nil {
+					var zeroVal *model.SearchResult
+					return zeroVal, errors.New("directive requiresRole is not implemented")
+				}
+				return ec.directives.RequiresRole(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNSearchResult2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_search(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hits":
+				return ec.fieldContext_SearchResult_hits(ctx, field)
+			case "facets":
+				return ec.fieldContext_SearchResult_facets(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_SearchResult_totalCount(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_SearchResult_hasNextPage(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_SearchResult_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SearchResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_search_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_registerExport(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_registerExport,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().RegisterExport(ctx, fc.Args["book"].(model.RegisterBook), fc.Args["fiscalYearId"].(string), fc.Args["scope"].(model.Scope), fc.Args["wardId"].(*string), fc.Args["format"].(model.RegisterFormat))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"darta_reviewer", "darta_registrar", "chalani_dispatcher", "chalani_approver"})
+				if err != nil {
+					var zeroVal *model.RegisterExport
+					return zeroVal, err
+				}
+				if ec.directives.RequiresRole == nil {
+					var zeroVal *model.RegisterExport
+					return zeroVal, errors.New("directive requiresRole is not implemented")
+				}
+				return ec.directives.RequiresRole(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNRegisterExport2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRegisterExport,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_registerExport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "filename":
+				return ec.fieldContext_RegisterExport_filename(ctx, field)
+			case "contentType":
+				return ec.fieldContext_RegisterExport_contentType(ctx, field)
+			case "contentBase64":
+				return ec.fieldContext_RegisterExport_contentBase64(ctx, field)
+			case "entryCount":
+				return ec.fieldContext_RegisterExport_entryCount(ctx, field)
+			case "gapCount":
+				return ec.fieldContext_RegisterExport_gapCount(ctx, field)
+			case "voidCount":
+				return ec.fieldContext_RegisterExport_voidCount(ctx, field)
+			case "seal":
+				return ec.fieldContext_RegisterExport_seal(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RegisterExport", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_registerExport_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_auditEntries(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_auditEntries,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().AuditEntries(ctx, fc.Args["filter"].(*model.AuditEntryFilterInput), fc.Args["pagination"].(*model.PaginationInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"admin", "auditor"})
+				if err != nil {
+					var zeroVal *model.AuditEntryConnection
+					return zeroVal, err
+				}
+				if ec.directives.RequiresRole == nil {
+					var zeroVal *model.AuditEntryConnection
+					return zeroVal, errors.New("directive requiresRole is not implemented")
+				}
+				return ec.directives.RequiresRole(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNAuditEntryConnection2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditEntryConnection,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_auditEntries(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_AuditEntryConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_AuditEntryConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditEntryConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_auditEntries_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_auditChainVerification(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_auditChainVerification,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().AuditChainVerification(ctx)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"admin", "auditor"})
+				if err != nil {
+					var zeroVal *model.AuditChainVerification
+					return zeroVal, err
+				}
+				if ec.directives.RequiresRole == nil {
+					var zeroVal *model.AuditChainVerification
+					return zeroVal, errors.New("directive requiresRole is not implemented")
+				}
+				return ec.directives.RequiresRole(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNAuditChainVerification2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditChainVerification,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_auditChainVerification(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "intact":
+				return ec.fieldContext_AuditChainVerification_intact(ctx, field)
+			case "entriesVerified":
+				return ec.fieldContext_AuditChainVerification_entriesVerified(ctx, field)
+			case "headSeq":
+				return ec.fieldContext_AuditChainVerification_headSeq(ctx, field)
+			case "headHash":
+				return ec.fieldContext_AuditChainVerification_headHash(ctx, field)
+			case "anchorsVerified":
+				return ec.fieldContext_AuditChainVerification_anchorsVerified(ctx, field)
+			case "problems":
+				return ec.fieldContext_AuditChainVerification_problems(ctx, field)
+			case "problemsTruncated":
+				return ec.fieldContext_AuditChainVerification_problemsTruncated(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditChainVerification", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_auditAnchors(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_auditAnchors,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().AuditAnchors(ctx, fc.Args["from"].(*string), fc.Args["to"].(*string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"admin", "auditor"})
+				if err != nil {
+					var zeroVal []*model.AuditAnchor
+					return zeroVal, err
+				}
+				if ec.directives.RequiresRole == nil {
+					var zeroVal []*model.AuditAnchor
+					return zeroVal, errors.New("directive requiresRole is not implemented")
+				}
+				return ec.directives.RequiresRole(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNAuditAnchor2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditAnchorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_auditAnchors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AuditAnchor_id(ctx, field)
+			case "chainSeq":
+				return ec.fieldContext_AuditAnchor_chainSeq(ctx, field)
+			case "entryHash":
+				return ec.fieldContext_AuditAnchor_entryHash(ctx, field)
+			case "anchoredAt":
+				return ec.fieldContext_AuditAnchor_anchoredAt(ctx, field)
+			case "statement":
+				return ec.fieldContext_AuditAnchor_statement(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditAnchor", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_auditAnchors_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___type,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.introspectType(fc.Args["name"].(string))
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___schema,
+		func(ctx context.Context) (any, error) {
+			return ec.introspectSchema()
+		},
+		nil,
+		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisterExport_filename(ctx context.Context, field graphql.CollectedField, obj *model.RegisterExport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RegisterExport_filename,
+		func(ctx context.Context) (any, error) {
+			return obj.Filename, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RegisterExport_filename(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisterExport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisterExport_contentType(ctx context.Context, field graphql.CollectedField, obj *model.RegisterExport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RegisterExport_contentType,
+		func(ctx context.Context) (any, error) {
+			return obj.ContentType, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RegisterExport_contentType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisterExport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisterExport_contentBase64(ctx context.Context, field graphql.CollectedField, obj *model.RegisterExport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RegisterExport_contentBase64,
+		func(ctx context.Context) (any, error) {
+			return obj.ContentBase64, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RegisterExport_contentBase64(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisterExport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisterExport_entryCount(ctx context.Context, field graphql.CollectedField, obj *model.RegisterExport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RegisterExport_entryCount,
+		func(ctx context.Context) (any, error) {
+			return obj.EntryCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RegisterExport_entryCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisterExport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisterExport_gapCount(ctx context.Context, field graphql.CollectedField, obj *model.RegisterExport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RegisterExport_gapCount,
+		func(ctx context.Context) (any, error) {
+			return obj.GapCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RegisterExport_gapCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisterExport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisterExport_voidCount(ctx context.Context, field graphql.CollectedField, obj *model.RegisterExport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RegisterExport_voidCount,
+		func(ctx context.Context) (any, error) {
+			return obj.VoidCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RegisterExport_voidCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisterExport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisterExport_seal(ctx context.Context, field graphql.CollectedField, obj *model.RegisterExport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RegisterExport_seal,
+		func(ctx context.Context) (any, error) {
+			return obj.Seal, nil
+		},
+		nil,
+		ec.marshalNRegisterSeal2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRegisterSeal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RegisterExport_seal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisterExport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "sha256":
+				return ec.fieldContext_RegisterSeal_sha256(ctx, field)
+			case "signature":
+				return ec.fieldContext_RegisterSeal_signature(ctx, field)
+			case "keyId":
+				return ec.fieldContext_RegisterSeal_keyId(ctx, field)
+			case "publicKey":
+				return ec.fieldContext_RegisterSeal_publicKey(ctx, field)
+			case "generatedAt":
+				return ec.fieldContext_RegisterSeal_generatedAt(ctx, field)
+			case "generatedBy":
+				return ec.fieldContext_RegisterSeal_generatedBy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RegisterSeal", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisterSeal_sha256(ctx context.Context, field graphql.CollectedField, obj *model.RegisterSeal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RegisterSeal_sha256,
+		func(ctx context.Context) (any, error) {
+			return obj.Sha256, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RegisterSeal_sha256(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisterSeal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisterSeal_signature(ctx context.Context, field graphql.CollectedField, obj *model.RegisterSeal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RegisterSeal_signature,
+		func(ctx context.Context) (any, error) {
+			return obj.Signature, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RegisterSeal_signature(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisterSeal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisterSeal_keyId(ctx context.Context, field graphql.CollectedField, obj *model.RegisterSeal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RegisterSeal_keyId,
+		func(ctx context.Context) (any, error) {
+			return obj.KeyID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RegisterSeal_keyId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisterSeal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisterSeal_publicKey(ctx context.Context, field graphql.CollectedField, obj *model.RegisterSeal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RegisterSeal_publicKey,
+		func(ctx context.Context) (any, error) {
+			return obj.PublicKey, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RegisterSeal_publicKey(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisterSeal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisterSeal_generatedAt(ctx context.Context, field graphql.CollectedField, obj *model.RegisterSeal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RegisterSeal_generatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.GeneratedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RegisterSeal_generatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisterSeal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisterSeal_generatedBy(ctx context.Context, field graphql.CollectedField, obj *model.RegisterSeal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RegisterSeal_generatedBy,
+		func(ctx context.Context) (any, error) {
+			return obj.GeneratedBy, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RegisterSeal_generatedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisterSeal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelatedDarta_relationshipType(ctx context.Context, field graphql.CollectedField, obj *model.RelatedDarta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelatedDarta_relationshipType,
+		func(ctx context.Context) (any, error) {
+			return obj.RelationshipType, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelatedDarta_relationshipType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelatedDarta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelatedDarta_darta(ctx context.Context, field graphql.CollectedField, obj *model.RelatedDarta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelatedDarta_darta,
+		func(ctx context.Context) (any, error) {
+			return obj.Darta, nil
+		},
+		nil,
+		ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelatedDarta_darta(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelatedDarta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
+			case "formattedDartaNumber":
+				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
+			case "scope":
+				return ec.fieldContext_Darta_scope(ctx, field)
+			case "wardId":
+				return ec.fieldContext_Darta_wardId(ctx, field)
+			case "subject":
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchFacet_field(ctx context.Context, field graphql.CollectedField, obj *model.SearchFacet) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchFacet_field,
+		func(ctx context.Context) (any, error) {
+			return obj.Field, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchFacet_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchFacet",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchFacet_values(ctx context.Context, field graphql.CollectedField, obj *model.SearchFacet) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchFacet_values,
+		func(ctx context.Context) (any, error) {
+			return obj.Values, nil
+		},
+		nil,
+		ec.marshalNSearchFacetValue2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchFacetValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchFacet_values(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchFacet",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "value":
+				return ec.fieldContext_SearchFacetValue_value(ctx, field)
+			case "count":
+				return ec.fieldContext_SearchFacetValue_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SearchFacetValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchFacetValue_value(ctx context.Context, field graphql.CollectedField, obj *model.SearchFacetValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchFacetValue_value,
+		func(ctx context.Context) (any, error) {
+			return obj.Value, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchFacetValue_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchFacetValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchFacetValue_count(ctx context.Context, field graphql.CollectedField, obj *model.SearchFacetValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchFacetValue_count,
+		func(ctx context.Context) (any, error) {
+			return obj.Count, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchFacetValue_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchFacetValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_entityType(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_entityType,
+		func(ctx context.Context) (any, error) {
+			return obj.EntityType, nil
+		},
+		nil,
+		ec.marshalNSearchEntityType2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchEntityType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_entityType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type SearchEntityType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_id(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_title(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_title,
+		func(ctx context.Context) (any, error) {
+			return obj.Title, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_number(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_number,
+		func(ctx context.Context) (any, error) {
+			return obj.Number, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_number(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_party(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_party,
+		func(ctx context.Context) (any, error) {
+			return obj.Party, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_party(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_status(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_fiscalYearId(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_fiscalYearId,
+		func(ctx context.Context) (any, error) {
+			return obj.FiscalYearID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_fiscalYearId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_wardId(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_wardId,
+		func(ctx context.Context) (any, error) {
+			return obj.WardID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_wardId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_channel(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_channel,
+		func(ctx context.Context) (any, error) {
+			return obj.Channel, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_channel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_score(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_score,
+		func(ctx context.Context) (any, error) {
+			return obj.Score, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_snippet(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_snippet,
+		func(ctx context.Context) (any, error) {
+			return obj.Snippet, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_snippet(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_snippetField(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_snippetField,
+		func(ctx context.Context) (any, error) {
+			return obj.SnippetField, nil
+		},
+		nil,
+		ec.marshalNSearchField2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchField,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_snippetField(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type SearchField does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_highlights(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_highlights,
+		func(ctx context.Context) (any, error) {
+			return obj.Highlights, nil
+		},
+		nil,
+		ec.marshalNTextRange2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐTextRangeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_highlights(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "start":
+				return ec.fieldContext_TextRange_start(ctx, field)
+			case "end":
+				return ec.fieldContext_TextRange_end(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TextRange", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_darta(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_darta,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.SearchHit().Darta(ctx, obj)
+		},
+		nil,
+		ec.marshalODarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_darta(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Darta_id(ctx, field)
+			case "dartaNumber":
+				return ec.fieldContext_Darta_dartaNumber(ctx, field)
 			case "formattedDartaNumber":
 				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
 			case "fiscalYearId":
@@ -2905,2381 +14324,6061 @@ func (ec *executionContext) fieldContext_Mutation_voidDarta(ctx context.Context,
 			case "wardId":
 				return ec.fieldContext_Darta_wardId(ctx, field)
 			case "subject":
-				return ec.fieldContext_Darta_subject(ctx, field)
-			case "applicant":
-				return ec.fieldContext_Darta_applicant(ctx, field)
-			case "intakeChannel":
-				return ec.fieldContext_Darta_intakeChannel(ctx, field)
-			case "receivedDate":
-				return ec.fieldContext_Darta_receivedDate(ctx, field)
-			case "entryDate":
-				return ec.fieldContext_Darta_entryDate(ctx, field)
+				return ec.fieldContext_Darta_subject(ctx, field)
+			case "applicant":
+				return ec.fieldContext_Darta_applicant(ctx, field)
+			case "intakeChannel":
+				return ec.fieldContext_Darta_intakeChannel(ctx, field)
+			case "receivedDate":
+				return ec.fieldContext_Darta_receivedDate(ctx, field)
+			case "entryDate":
+				return ec.fieldContext_Darta_entryDate(ctx, field)
+			case "status":
+				return ec.fieldContext_Darta_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_Darta_priority(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Darta_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Darta_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Darta_updatedAt(ctx, field)
+			case "tenantId":
+				return ec.fieldContext_Darta_tenantId(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Darta_metadata(ctx, field)
+			case "version":
+				return ec.fieldContext_Darta_version(ctx, field)
+			case "assignee":
+				return ec.fieldContext_Darta_assignee(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Darta_attachments(ctx, field)
+			case "relatedDartas":
+				return ec.fieldContext_Darta_relatedDartas(ctx, field)
+			case "auditTrail":
+				return ec.fieldContext_Darta_auditTrail(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Darta_timeline(ctx, field)
+			case "suspectedDuplicates":
+				return ec.fieldContext_Darta_suspectedDuplicates(ctx, field)
+			case "supersedesDartaNumber":
+				return ec.fieldContext_Darta_supersedesDartaNumber(ctx, field)
+			case "supersedes":
+				return ec.fieldContext_Darta_supersedes(ctx, field)
+			case "supersededBy":
+				return ec.fieldContext_Darta_supersededBy(ctx, field)
+			case "supersessionChain":
+				return ec.fieldContext_Darta_supersessionChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchResult_hits(ctx context.Context, field graphql.CollectedField, obj *model.SearchResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchResult_hits,
+		func(ctx context.Context) (any, error) {
+			return obj.Hits, nil
+		},
+		nil,
+		ec.marshalNSearchHit2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchHitᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchResult_hits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "entityType":
+				return ec.fieldContext_SearchHit_entityType(ctx, field)
+			case "id":
+				return ec.fieldContext_SearchHit_id(ctx, field)
+			case "title":
+				return ec.fieldContext_SearchHit_title(ctx, field)
+			case "number":
+				return ec.fieldContext_SearchHit_number(ctx, field)
+			case "party":
+				return ec.fieldContext_SearchHit_party(ctx, field)
+			case "status":
+				return ec.fieldContext_SearchHit_status(ctx, field)
+			case "fiscalYearId":
+				return ec.fieldContext_SearchHit_fiscalYearId(ctx, field)
+			case "wardId":
+				return ec.fieldContext_SearchHit_wardId(ctx, field)
+			case "channel":
+				return ec.fieldContext_SearchHit_channel(ctx, field)
+			case "score":
+				return ec.fieldContext_SearchHit_score(ctx, field)
+			case "snippet":
+				return ec.fieldContext_SearchHit_snippet(ctx, field)
+			case "snippetField":
+				return ec.fieldContext_SearchHit_snippetField(ctx, field)
+			case "highlights":
+				return ec.fieldContext_SearchHit_highlights(ctx, field)
+			case "darta":
+				return ec.fieldContext_SearchHit_darta(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SearchHit", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchResult_facets(ctx context.Context, field graphql.CollectedField, obj *model.SearchResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchResult_facets,
+		func(ctx context.Context) (any, error) {
+			return obj.Facets, nil
+		},
+		nil,
+		ec.marshalNSearchFacet2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchFacetᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchResult_facets(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_SearchFacet_field(ctx, field)
+			case "values":
+				return ec.fieldContext_SearchFacet_values(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SearchFacet", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchResult_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.SearchResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchResult_totalCount,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchResult_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchResult_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.SearchResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchResult_hasNextPage,
+		func(ctx context.Context) (any, error) {
+			return obj.HasNextPage, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchResult_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchResult_endCursor(ctx context.Context, field graphql.CollectedField, obj *model.SearchResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchResult_endCursor,
+		func(ctx context.Context) (any, error) {
+			return obj.EndCursor, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchResult_endCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_dartaUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription_dartaUpdated,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Subscription().DartaUpdated(ctx, fc.Args["id"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				relation, err := ec.unmarshalNString2string(ctx, "can_read")
+				if err != nil {
+					var zeroVal *model.DartaEvent
+					return zeroVal, err
+				}
+				object, err := ec.unmarshalNString2string(ctx, "darta:$id")
+				if err != nil {
+					var zeroVal *model.DartaEvent
+					return zeroVal, err
+				}
+				if ec.directives.RequiresPermission == nil {
+					var zeroVal *model.DartaEvent
+					return zeroVal, errors.New("directive requiresPermission is not implemented")
+				}
+				return ec.directives.RequiresPermission(ctx, nil, directive0, relation, object)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDartaEvent2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaEvent,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription_dartaUpdated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "action":
+				return ec.fieldContext_DartaEvent_action(ctx, field)
+			case "darta":
+				return ec.fieldContext_DartaEvent_darta(ctx, field)
+			case "actorId":
+				return ec.fieldContext_DartaEvent_actorId(ctx, field)
+			case "previousAssigneeId":
+				return ec.fieldContext_DartaEvent_previousAssigneeId(ctx, field)
+			case "occurredAt":
+				return ec.fieldContext_DartaEvent_occurredAt(ctx, field)
+			case "slaStage":
+				return ec.fieldContext_DartaEvent_slaStage(ctx, field)
+			case "escalatedToId":
+				return ec.fieldContext_DartaEvent_escalatedToId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DartaEvent", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_dartaUpdated_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_myQueueChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription_myQueueChanged,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Subscription().MyQueueChanged(ctx)
+		},
+		nil,
+		ec.marshalNDartaEvent2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaEvent,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription_myQueueChanged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "action":
+				return ec.fieldContext_DartaEvent_action(ctx, field)
+			case "darta":
+				return ec.fieldContext_DartaEvent_darta(ctx, field)
+			case "actorId":
+				return ec.fieldContext_DartaEvent_actorId(ctx, field)
+			case "previousAssigneeId":
+				return ec.fieldContext_DartaEvent_previousAssigneeId(ctx, field)
+			case "occurredAt":
+				return ec.fieldContext_DartaEvent_occurredAt(ctx, field)
+			case "slaStage":
+				return ec.fieldContext_DartaEvent_slaStage(ctx, field)
+			case "escalatedToId":
+				return ec.fieldContext_DartaEvent_escalatedToId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DartaEvent", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_chalaniDispatchUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription_chalaniDispatchUpdated,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Subscription().ChalaniDispatchUpdated(ctx)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"chalani_dispatcher", "chalani_approver"})
+				if err != nil {
+					var zeroVal *model.ChalaniDispatchEvent
+					return zeroVal, err
+				}
+				if ec.directives.RequiresRole == nil {
+					var zeroVal *model.ChalaniDispatchEvent
+					return zeroVal, errors.New("directive requiresRole is not implemented")
+				}
+				return ec.directives.RequiresRole(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNChalaniDispatchEvent2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChalaniDispatchEvent,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription_chalaniDispatchUpdated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "action":
+				return ec.fieldContext_ChalaniDispatchEvent_action(ctx, field)
+			case "chalaniId":
+				return ec.fieldContext_ChalaniDispatchEvent_chalaniId(ctx, field)
+			case "formattedChalaniNumber":
+				return ec.fieldContext_ChalaniDispatchEvent_formattedChalaniNumber(ctx, field)
+			case "subject":
+				return ec.fieldContext_ChalaniDispatchEvent_subject(ctx, field)
 			case "status":
-				return ec.fieldContext_Darta_status(ctx, field)
-			case "priority":
-				return ec.fieldContext_Darta_priority(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Darta_createdBy(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Darta_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Darta_updatedAt(ctx, field)
-			case "tenantId":
-				return ec.fieldContext_Darta_tenantId(ctx, field)
+				return ec.fieldContext_ChalaniDispatchEvent_status(ctx, field)
+			case "trackingId":
+				return ec.fieldContext_ChalaniDispatchEvent_trackingId(ctx, field)
+			case "dispatchedAt":
+				return ec.fieldContext_ChalaniDispatchEvent_dispatchedAt(ctx, field)
+			case "deliveredAt":
+				return ec.fieldContext_ChalaniDispatchEvent_deliveredAt(ctx, field)
+			case "actorId":
+				return ec.fieldContext_ChalaniDispatchEvent_actorId(ctx, field)
+			case "occurredAt":
+				return ec.fieldContext_ChalaniDispatchEvent_occurredAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ChalaniDispatchEvent", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TextRange_start(ctx context.Context, field graphql.CollectedField, obj *model.TextRange) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TextRange_start,
+		func(ctx context.Context) (any, error) {
+			return obj.Start, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TextRange_start(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TextRange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TextRange_end(ctx context.Context, field graphql.CollectedField, obj *model.TextRange) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TextRange_end,
+		func(ctx context.Context) (any, error) {
+			return obj.End, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TextRange_end(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TextRange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Timeline_entries(ctx context.Context, field graphql.CollectedField, obj *model.Timeline) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Timeline_entries,
+		func(ctx context.Context) (any, error) {
+			return obj.Entries, nil
+		},
+		nil,
+		ec.marshalNAuditEntry2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditEntryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Timeline_entries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Timeline",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AuditEntry_id(ctx, field)
+			case "entityType":
+				return ec.fieldContext_AuditEntry_entityType(ctx, field)
+			case "entityId":
+				return ec.fieldContext_AuditEntry_entityId(ctx, field)
+			case "action":
+				return ec.fieldContext_AuditEntry_action(ctx, field)
+			case "performedBy":
+				return ec.fieldContext_AuditEntry_performedBy(ctx, field)
+			case "performedAt":
+				return ec.fieldContext_AuditEntry_performedAt(ctx, field)
+			case "notes":
+				return ec.fieldContext_AuditEntry_notes(ctx, field)
+			case "changes":
+				return ec.fieldContext_AuditEntry_changes(ctx, field)
+			case "diff":
+				return ec.fieldContext_AuditEntry_diff(ctx, field)
+			case "requestId":
+				return ec.fieldContext_AuditEntry_requestId(ctx, field)
+			case "traceId":
+				return ec.fieldContext_AuditEntry_traceId(ctx, field)
+			case "actingRole":
+				return ec.fieldContext_AuditEntry_actingRole(ctx, field)
+			case "decisionId":
+				return ec.fieldContext_AuditEntry_decisionId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditEntry", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Timeline_truncated(ctx context.Context, field graphql.CollectedField, obj *model.Timeline) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Timeline_truncated,
+		func(ctx context.Context) (any, error) {
+			return obj.Truncated, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Timeline_truncated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Timeline",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_username(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_username,
+		func(ctx context.Context) (any, error) {
+			return obj.Username, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_username(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_fullName(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_fullName,
+		func(ctx context.Context) (any, error) {
+			return obj.FullName, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_fullName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_email(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_email,
+		func(ctx context.Context) (any, error) {
+			return obj.Email, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_isRepeatable,
+		func(ctx context.Context) (any, error) {
+			return obj.IsRepeatable, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_locations,
+		func(ctx context.Context) (any, error) {
+			return obj.Locations, nil
+		},
+		nil,
+		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_defaultValue,
+		func(ctx context.Context) (any, error) {
+			return obj.DefaultValue, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_types,
+		func(ctx context.Context) (any, error) {
+			return obj.Types(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_queryType,
+		func(ctx context.Context) (any, error) {
+			return obj.QueryType(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_mutationType,
+		func(ctx context.Context) (any, error) {
+			return obj.MutationType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_subscriptionType,
+		func(ctx context.Context) (any, error) {
+			return obj.SubscriptionType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_directives,
+		func(ctx context.Context) (any, error) {
+			return obj.Directives(), nil
+		},
+		nil,
+		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind(), nil
+		},
+		nil,
+		ec.marshalN__TypeKind2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_specifiedByURL,
+		func(ctx context.Context) (any, error) {
+			return obj.SpecifiedByURL(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_fields,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_interfaces,
+		func(ctx context.Context) (any, error) {
+			return obj.Interfaces(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_possibleTypes,
+		func(ctx context.Context) (any, error) {
+			return obj.PossibleTypes(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_enumValues,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_inputFields,
+		func(ctx context.Context) (any, error) {
+			return obj.InputFields(), nil
+		},
+		nil,
+		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_ofType,
+		func(ctx context.Context) (any, error) {
+			return obj.OfType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_isOneOf,
+		func(ctx context.Context) (any, error) {
+			return obj.IsOneOf(), nil
+		},
+		nil,
+		ec.marshalOBoolean2bool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputApplicantInput(ctx context.Context, obj any) (model.ApplicantInput, error) {
+	var it model.ApplicantInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"type", "fullName", "organization", "email", "phone", "address", "identificationNumber"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalNApplicantType2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicantType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		case "fullName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fullName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FullName = data
+		case "organization":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("organization"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Organization = data
+		case "email":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Email = data
+		case "phone":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("phone"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Phone = data
+		case "address":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Address = data
+		case "identificationNumber":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("identificationNumber"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IdentificationNumber = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAssignDartaSectionInput(ctx context.Context, obj any) (model.AssignDartaSectionInput, error) {
+	var it model.AssignDartaSectionInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"dartaId", "sectionId", "assigneeId", "priority", "slaHours", "notes", "expectedVersion"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "dartaId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dartaId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DartaID = data
+		case "sectionId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sectionId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SectionID = data
+		case "assigneeId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("assigneeId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AssigneeID = data
+		case "priority":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("priority"))
+			data, err := ec.unmarshalOPriority2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPriority(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Priority = data
+		case "slaHours":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slaHours"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SLAHours = data
+		case "notes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("notes"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Notes = data
+		case "expectedVersion":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExpectedVersion = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAuditEntryFilterInput(ctx context.Context, obj any) (model.AuditEntryFilterInput, error) {
+	var it model.AuditEntryFilterInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"entityType", "entityId", "performedBy", "actions", "category", "from", "to", "tenantId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "entityType":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("entityType"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.EntityType = data
+		case "entityId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("entityId"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.EntityID = data
+		case "performedBy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("performedBy"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PerformedBy = data
+		case "actions":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("actions"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Actions = data
+		case "category":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("category"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Category = data
+		case "from":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.From = data
+		case "to":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.To = data
+		case "tenantId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tenantId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TenantID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateDartaInput(ctx context.Context, obj any) (model.CreateDartaInput, error) {
+	var it model.CreateDartaInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"fiscalYearId", "scope", "wardId", "subject", "applicant", "intakeChannel", "receivedDate", "primaryDocumentId", "annexIds", "priority", "idempotencyKey", "acknowledgeDuplicates", "backdateReason"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "fiscalYearId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fiscalYearId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FiscalYearID = data
+		case "scope":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("scope"))
+			data, err := ec.unmarshalNScope2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐScope(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Scope = data
+		case "wardId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("wardId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.WardID = data
+		case "subject":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subject"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Subject = data
+		case "applicant":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("applicant"))
+			data, err := ec.unmarshalNApplicantInput2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicantInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Applicant = data
+		case "intakeChannel":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("intakeChannel"))
+			data, err := ec.unmarshalNIntakeChannel2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐIntakeChannel(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IntakeChannel = data
+		case "receivedDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("receivedDate"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ReceivedDate = data
+		case "primaryDocumentId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("primaryDocumentId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PrimaryDocumentID = data
+		case "annexIds":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("annexIds"))
+			data, err := ec.unmarshalOID2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AnnexIds = data
+		case "priority":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("priority"))
+			data, err := ec.unmarshalNPriority2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPriority(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Priority = data
+		case "idempotencyKey":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("idempotencyKey"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IdempotencyKey = data
+		case "acknowledgeDuplicates":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("acknowledgeDuplicates"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AcknowledgeDuplicates = data
+		case "backdateReason":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("backdateReason"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.BackdateReason = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDartaFilterInput(ctx context.Context, obj any) (model.DartaFilterInput, error) {
+	var it model.DartaFilterInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"fiscalYearId", "scope", "wardId", "status", "priority", "organizationalUnitId", "assigneeId", "intakeChannel", "fromDate", "toDate", "search", "isOverdue"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "fiscalYearId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fiscalYearId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FiscalYearID = data
+		case "scope":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("scope"))
+			data, err := ec.unmarshalOScope2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐScope(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Scope = data
+		case "wardId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("wardId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.WardID = data
+		case "status":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+			data, err := ec.unmarshalODartaStatus2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatus(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Status = data
+		case "priority":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("priority"))
+			data, err := ec.unmarshalOPriority2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPriority(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Priority = data
+		case "organizationalUnitId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("organizationalUnitId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OrganizationalUnitID = data
+		case "assigneeId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("assigneeId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AssigneeID = data
+		case "intakeChannel":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("intakeChannel"))
+			data, err := ec.unmarshalOIntakeChannel2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐIntakeChannel(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IntakeChannel = data
+		case "fromDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromDate"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FromDate = data
+		case "toDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("toDate"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ToDate = data
+		case "search":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("search"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Search = data
+		case "isOverdue":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isOverdue"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsOverdue = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputIssueDartaResponseInput(ctx context.Context, obj any) (model.IssueDartaResponseInput, error) {
+	var it model.IssueDartaResponseInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"dartaId", "responseChalaniId", "docAttachmentId", "expectedVersion"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "dartaId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dartaId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DartaID = data
+		case "responseChalaniId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseChalaniId"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ResponseChalaniID = data
+		case "docAttachmentId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("docAttachmentId"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DocAttachmentID = data
+		case "expectedVersion":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExpectedVersion = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPaginationInput(ctx context.Context, obj any) (model.PaginationInput, error) {
+	var it model.PaginationInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"limit", "offset", "after", "before", "sortBy", "sortDesc"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "limit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Limit = data
+		case "offset":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Offset = data
+		case "after":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.After = data
+		case "before":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Before = data
+		case "sortBy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sortBy"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SortBy = data
+		case "sortDesc":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sortDesc"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SortDesc = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputReviewDartaInput(ctx context.Context, obj any) (model.ReviewDartaInput, error) {
+	var it model.ReviewDartaInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"dartaId", "decision", "notes", "requestedInfo", "expectedVersion"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "dartaId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dartaId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DartaID = data
+		case "decision":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("decision"))
+			data, err := ec.unmarshalNDartaReviewDecision2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaReviewDecision(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Decision = data
+		case "notes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("notes"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Notes = data
+		case "requestedInfo":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requestedInfo"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RequestedInfo = data
+		case "expectedVersion":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExpectedVersion = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRouteDartaInput(ctx context.Context, obj any) (model.RouteDartaInput, error) {
+	var it model.RouteDartaInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"dartaId", "organizationalUnitId", "assigneeId", "priority", "slaHours", "notes", "expectedVersion"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "dartaId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dartaId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DartaID = data
+		case "organizationalUnitId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("organizationalUnitId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OrganizationalUnitID = data
+		case "assigneeId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("assigneeId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AssigneeID = data
+		case "priority":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("priority"))
+			data, err := ec.unmarshalOPriority2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPriority(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Priority = data
+		case "slaHours":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slaHours"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SLAHours = data
+		case "notes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("notes"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Notes = data
+		case "expectedVersion":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExpectedVersion = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSearchFilterInput(ctx context.Context, obj any) (model.SearchFilterInput, error) {
+	var it model.SearchFilterInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"statuses", "fiscalYearIds", "wardIds", "channels"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "statuses":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("statuses"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Statuses = data
+		case "fiscalYearIds":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fiscalYearIds"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FiscalYearIds = data
+		case "wardIds":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("wardIds"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.WardIds = data
+		case "channels":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channels"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Channels = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var applicantImplementors = []string{"Applicant"}
+
+func (ec *executionContext) _Applicant(ctx context.Context, sel ast.SelectionSet, obj *model.Applicant) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, applicantImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Applicant")
+		case "id":
+			out.Values[i] = ec._Applicant_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec._Applicant_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fullName":
+			out.Values[i] = ec._Applicant_fullName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "organization":
+			out.Values[i] = ec._Applicant_organization(ctx, field, obj)
+		case "email":
+			out.Values[i] = ec._Applicant_email(ctx, field, obj)
+		case "phone":
+			out.Values[i] = ec._Applicant_phone(ctx, field, obj)
+		case "address":
+			out.Values[i] = ec._Applicant_address(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var attachmentImplementors = []string{"Attachment"}
+
+func (ec *executionContext) _Attachment(ctx context.Context, sel ast.SelectionSet, obj *model.Attachment) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, attachmentImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Attachment")
+		case "id":
+			out.Values[i] = ec._Attachment_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "filename":
+			out.Values[i] = ec._Attachment_filename(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "originalFilename":
+			out.Values[i] = ec._Attachment_originalFilename(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mimeType":
+			out.Values[i] = ec._Attachment_mimeType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sizeBytes":
+			out.Values[i] = ec._Attachment_sizeBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "uploadedBy":
+			out.Values[i] = ec._Attachment_uploadedBy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "uploadedAt":
+			out.Values[i] = ec._Attachment_uploadedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "isPrimary":
+			out.Values[i] = ec._Attachment_isPrimary(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var auditAnchorImplementors = []string{"AuditAnchor"}
+
+func (ec *executionContext) _AuditAnchor(ctx context.Context, sel ast.SelectionSet, obj *model.AuditAnchor) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditAnchorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditAnchor")
+		case "id":
+			out.Values[i] = ec._AuditAnchor_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "chainSeq":
+			out.Values[i] = ec._AuditAnchor_chainSeq(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "entryHash":
+			out.Values[i] = ec._AuditAnchor_entryHash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "anchoredAt":
+			out.Values[i] = ec._AuditAnchor_anchoredAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "statement":
+			out.Values[i] = ec._AuditAnchor_statement(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var auditChainProblemImplementors = []string{"AuditChainProblem"}
+
+func (ec *executionContext) _AuditChainProblem(ctx context.Context, sel ast.SelectionSet, obj *model.AuditChainProblem) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditChainProblemImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditChainProblem")
+		case "kind":
+			out.Values[i] = ec._AuditChainProblem_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "chainSeq":
+			out.Values[i] = ec._AuditChainProblem_chainSeq(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "entryId":
+			out.Values[i] = ec._AuditChainProblem_entryId(ctx, field, obj)
+		case "detail":
+			out.Values[i] = ec._AuditChainProblem_detail(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var auditChainVerificationImplementors = []string{"AuditChainVerification"}
+
+func (ec *executionContext) _AuditChainVerification(ctx context.Context, sel ast.SelectionSet, obj *model.AuditChainVerification) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditChainVerificationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditChainVerification")
+		case "intact":
+			out.Values[i] = ec._AuditChainVerification_intact(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "entriesVerified":
+			out.Values[i] = ec._AuditChainVerification_entriesVerified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "headSeq":
+			out.Values[i] = ec._AuditChainVerification_headSeq(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "headHash":
+			out.Values[i] = ec._AuditChainVerification_headHash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "anchorsVerified":
+			out.Values[i] = ec._AuditChainVerification_anchorsVerified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "problems":
+			out.Values[i] = ec._AuditChainVerification_problems(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "problemsTruncated":
+			out.Values[i] = ec._AuditChainVerification_problemsTruncated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var auditEntryImplementors = []string{"AuditEntry"}
+
+func (ec *executionContext) _AuditEntry(ctx context.Context, sel ast.SelectionSet, obj *model.AuditEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditEntryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditEntry")
+		case "id":
+			out.Values[i] = ec._AuditEntry_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "entityType":
+			out.Values[i] = ec._AuditEntry_entityType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "entityId":
+			out.Values[i] = ec._AuditEntry_entityId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "action":
+			out.Values[i] = ec._AuditEntry_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "performedBy":
+			out.Values[i] = ec._AuditEntry_performedBy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "performedAt":
+			out.Values[i] = ec._AuditEntry_performedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "notes":
+			out.Values[i] = ec._AuditEntry_notes(ctx, field, obj)
+		case "changes":
+			out.Values[i] = ec._AuditEntry_changes(ctx, field, obj)
+		case "diff":
+			out.Values[i] = ec._AuditEntry_diff(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "requestId":
+			out.Values[i] = ec._AuditEntry_requestId(ctx, field, obj)
+		case "traceId":
+			out.Values[i] = ec._AuditEntry_traceId(ctx, field, obj)
+		case "actingRole":
+			out.Values[i] = ec._AuditEntry_actingRole(ctx, field, obj)
+		case "decisionId":
+			out.Values[i] = ec._AuditEntry_decisionId(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var auditEntryConnectionImplementors = []string{"AuditEntryConnection"}
+
+func (ec *executionContext) _AuditEntryConnection(ctx context.Context, sel ast.SelectionSet, obj *model.AuditEntryConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditEntryConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditEntryConnection")
+		case "edges":
+			out.Values[i] = ec._AuditEntryConnection_edges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pageInfo":
+			out.Values[i] = ec._AuditEntryConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var auditEntryEdgeImplementors = []string{"AuditEntryEdge"}
+
+func (ec *executionContext) _AuditEntryEdge(ctx context.Context, sel ast.SelectionSet, obj *model.AuditEntryEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditEntryEdgeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditEntryEdge")
+		case "cursor":
+			out.Values[i] = ec._AuditEntryEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "node":
+			out.Values[i] = ec._AuditEntryEdge_node(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var auditFieldChangeImplementors = []string{"AuditFieldChange"}
+
+func (ec *executionContext) _AuditFieldChange(ctx context.Context, sel ast.SelectionSet, obj *model.AuditFieldChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditFieldChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditFieldChange")
+		case "field":
+			out.Values[i] = ec._AuditFieldChange_field(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "before":
+			out.Values[i] = ec._AuditFieldChange_before(ctx, field, obj)
+		case "after":
+			out.Values[i] = ec._AuditFieldChange_after(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var chalaniImplementors = []string{"Chalani"}
+
+func (ec *executionContext) _Chalani(ctx context.Context, sel ast.SelectionSet, obj *model.Chalani) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, chalaniImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Chalani")
+		case "id":
+			out.Values[i] = ec._Chalani_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "chalaniNumber":
+			out.Values[i] = ec._Chalani_chalaniNumber(ctx, field, obj)
+		case "formattedChalaniNumber":
+			out.Values[i] = ec._Chalani_formattedChalaniNumber(ctx, field, obj)
+		case "fiscalYearId":
+			out.Values[i] = ec._Chalani_fiscalYearId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "scope":
+			out.Values[i] = ec._Chalani_scope(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "wardId":
+			out.Values[i] = ec._Chalani_wardId(ctx, field, obj)
+		case "subject":
+			out.Values[i] = ec._Chalani_subject(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "body":
+			out.Values[i] = ec._Chalani_body(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "status":
+			out.Values[i] = ec._Chalani_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "dispatchChannel":
+			out.Values[i] = ec._Chalani_dispatchChannel(ctx, field, obj)
+		case "dispatchedAt":
+			out.Values[i] = ec._Chalani_dispatchedAt(ctx, field, obj)
+		case "deliveredAt":
+			out.Values[i] = ec._Chalani_deliveredAt(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._Chalani_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "updatedAt":
+			out.Values[i] = ec._Chalani_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "timeline":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Chalani_timeline(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var chalaniDispatchEventImplementors = []string{"ChalaniDispatchEvent"}
+
+func (ec *executionContext) _ChalaniDispatchEvent(ctx context.Context, sel ast.SelectionSet, obj *model.ChalaniDispatchEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, chalaniDispatchEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ChalaniDispatchEvent")
+		case "action":
+			out.Values[i] = ec._ChalaniDispatchEvent_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "chalaniId":
+			out.Values[i] = ec._ChalaniDispatchEvent_chalaniId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "formattedChalaniNumber":
+			out.Values[i] = ec._ChalaniDispatchEvent_formattedChalaniNumber(ctx, field, obj)
+		case "subject":
+			out.Values[i] = ec._ChalaniDispatchEvent_subject(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._ChalaniDispatchEvent_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "trackingId":
+			out.Values[i] = ec._ChalaniDispatchEvent_trackingId(ctx, field, obj)
+		case "dispatchedAt":
+			out.Values[i] = ec._ChalaniDispatchEvent_dispatchedAt(ctx, field, obj)
+		case "deliveredAt":
+			out.Values[i] = ec._ChalaniDispatchEvent_deliveredAt(ctx, field, obj)
+		case "actorId":
+			out.Values[i] = ec._ChalaniDispatchEvent_actorId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "occurredAt":
+			out.Values[i] = ec._ChalaniDispatchEvent_occurredAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var channelCountImplementors = []string{"ChannelCount"}
+
+func (ec *executionContext) _ChannelCount(ctx context.Context, sel ast.SelectionSet, obj *model.ChannelCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, channelCountImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ChannelCount")
+		case "channel":
+			out.Values[i] = ec._ChannelCount_channel(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "count":
+			out.Values[i] = ec._ChannelCount_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var dartaImplementors = []string{"Darta"}
+
+func (ec *executionContext) _Darta(ctx context.Context, sel ast.SelectionSet, obj *model.Darta) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dartaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Darta")
+		case "id":
+			out.Values[i] = ec._Darta_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "dartaNumber":
+			out.Values[i] = ec._Darta_dartaNumber(ctx, field, obj)
+		case "formattedDartaNumber":
+			out.Values[i] = ec._Darta_formattedDartaNumber(ctx, field, obj)
+		case "fiscalYearId":
+			out.Values[i] = ec._Darta_fiscalYearId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "scope":
+			out.Values[i] = ec._Darta_scope(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "wardId":
+			out.Values[i] = ec._Darta_wardId(ctx, field, obj)
+		case "subject":
+			out.Values[i] = ec._Darta_subject(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "applicant":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Darta_applicant(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_voidDarta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_PageInfo_hasNextPage,
-		func(ctx context.Context) (any, error) {
-			return obj.HasNextPage, nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "intakeChannel":
+			out.Values[i] = ec._Darta_intakeChannel(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "receivedDate":
+			out.Values[i] = ec._Darta_receivedDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "entryDate":
+			out.Values[i] = ec._Darta_entryDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "status":
+			out.Values[i] = ec._Darta_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "priority":
+			out.Values[i] = ec._Darta_priority(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "createdBy":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Darta_createdBy(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "createdAt":
+			out.Values[i] = ec._Darta_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "updatedAt":
+			out.Values[i] = ec._Darta_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "tenantId":
+			out.Values[i] = ec._Darta_tenantId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "metadata":
+			out.Values[i] = ec._Darta_metadata(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._Darta_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "assignee":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Darta_assignee(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "attachments":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Darta_attachments(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "relatedDartas":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Darta_relatedDartas(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "auditTrail":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Darta_auditTrail(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "timeline":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Darta_timeline(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "suspectedDuplicates":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Darta_suspectedDuplicates(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "supersedesDartaNumber":
+			out.Values[i] = ec._Darta_supersedesDartaNumber(ctx, field, obj)
+		case "supersedes":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Darta_supersedes(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "supersededBy":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Darta_supersededBy(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "supersessionChain":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Darta_supersessionChain(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) fieldContext_PageInfo_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_PageInfo_hasPreviousPage,
-		func(ctx context.Context) (any, error) {
-			return obj.HasPreviousPage, nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_PageInfo_hasPreviousPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _PageInfo_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_PageInfo_totalCount,
-		func(ctx context.Context) (any, error) {
-			return obj.TotalCount, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_PageInfo_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var dartaConnectionImplementors = []string{"DartaConnection"}
 
-func (ec *executionContext) _Query_health(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_health,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().Health(ctx)
-		},
-		nil,
-		ec.marshalNHealthStatus2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐHealthStatus,
-		true,
-		true,
-	)
-}
+func (ec *executionContext) _DartaConnection(ctx context.Context, sel ast.SelectionSet, obj *model.DartaConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dartaConnectionImplementors)
 
-func (ec *executionContext) fieldContext_Query_health(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "status":
-				return ec.fieldContext_HealthStatus_status(ctx, field)
-			case "service":
-				return ec.fieldContext_HealthStatus_service(ctx, field)
-			case "timestamp":
-				return ec.fieldContext_HealthStatus_timestamp(ctx, field)
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DartaConnection")
+		case "edges":
+			out.Values[i] = ec._DartaConnection_edges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type HealthStatus", field.Name)
-		},
+		case "pageInfo":
+			out.Values[i] = ec._DartaConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query_darta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_darta,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Darta(ctx, fc.Args["id"].(string))
-		},
-		nil,
-		ec.marshalODarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta,
-		true,
-		false,
-	)
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext_Query_darta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Darta_id(ctx, field)
-			case "dartaNumber":
-				return ec.fieldContext_Darta_dartaNumber(ctx, field)
-			case "formattedDartaNumber":
-				return ec.fieldContext_Darta_formattedDartaNumber(ctx, field)
-			case "fiscalYearId":
-				return ec.fieldContext_Darta_fiscalYearId(ctx, field)
-			case "scope":
-				return ec.fieldContext_Darta_scope(ctx, field)
-			case "wardId":
-				return ec.fieldContext_Darta_wardId(ctx, field)
-			case "subject":
-				return ec.fieldContext_Darta_subject(ctx, field)
-			case "applicant":
-				return ec.fieldContext_Darta_applicant(ctx, field)
-			case "intakeChannel":
-				return ec.fieldContext_Darta_intakeChannel(ctx, field)
-			case "receivedDate":
-				return ec.fieldContext_Darta_receivedDate(ctx, field)
-			case "entryDate":
-				return ec.fieldContext_Darta_entryDate(ctx, field)
-			case "status":
-				return ec.fieldContext_Darta_status(ctx, field)
-			case "priority":
-				return ec.fieldContext_Darta_priority(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Darta_createdBy(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Darta_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Darta_updatedAt(ctx, field)
-			case "tenantId":
-				return ec.fieldContext_Darta_tenantId(ctx, field)
+var dartaEdgeImplementors = []string{"DartaEdge"}
+
+func (ec *executionContext) _DartaEdge(ctx context.Context, sel ast.SelectionSet, obj *model.DartaEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dartaEdgeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DartaEdge")
+		case "cursor":
+			out.Values[i] = ec._DartaEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Darta", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "node":
+			out.Values[i] = ec._DartaEdge_node(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_darta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-func (ec *executionContext) _Query_dartas(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_dartas,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Dartas(ctx, fc.Args["filter"].(*model.DartaFilterInput), fc.Args["pagination"].(*model.PaginationInput))
-		},
-		nil,
-		ec.marshalNDartaConnection2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaConnection,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_Query_dartas(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "edges":
-				return ec.fieldContext_DartaConnection_edges(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_DartaConnection_pageInfo(ctx, field)
+var dartaEventImplementors = []string{"DartaEvent"}
+
+func (ec *executionContext) _DartaEvent(ctx context.Context, sel ast.SelectionSet, obj *model.DartaEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dartaEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DartaEvent")
+		case "action":
+			out.Values[i] = ec._DartaEvent_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type DartaConnection", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "darta":
+			out.Values[i] = ec._DartaEvent_darta(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "actorId":
+			out.Values[i] = ec._DartaEvent_actorId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "previousAssigneeId":
+			out.Values[i] = ec._DartaEvent_previousAssigneeId(ctx, field, obj)
+		case "occurredAt":
+			out.Values[i] = ec._DartaEvent_occurredAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "slaStage":
+			out.Values[i] = ec._DartaEvent_slaStage(ctx, field, obj)
+		case "escalatedToId":
+			out.Values[i] = ec._DartaEvent_escalatedToId(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_dartas_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) _Query_myDartas(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_myDartas,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().MyDartas(ctx, fc.Args["status"].(*model.DartaStatus), fc.Args["pagination"].(*model.PaginationInput))
-		},
-		nil,
-		ec.marshalNDartaConnection2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaConnection,
-		true,
-		true,
-	)
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext_Query_myDartas(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "edges":
-				return ec.fieldContext_DartaConnection_edges(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_DartaConnection_pageInfo(ctx, field)
+var dartaStatsImplementors = []string{"DartaStats"}
+
+func (ec *executionContext) _DartaStats(ctx context.Context, sel ast.SelectionSet, obj *model.DartaStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dartaStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DartaStats")
+		case "total":
+			out.Values[i] = ec._DartaStats_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type DartaConnection", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "byStatus":
+			out.Values[i] = ec._DartaStats_byStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "byChannel":
+			out.Values[i] = ec._DartaStats_byChannel(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "overdueCount":
+			out.Values[i] = ec._DartaStats_overdueCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "avgProcessingTimeHours":
+			out.Values[i] = ec._DartaStats_avgProcessingTimeHours(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "receivedToRegistered":
+			out.Values[i] = ec._DartaStats_receivedToRegistered(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "registeredToClosed":
+			out.Values[i] = ec._DartaStats_registeredToClosed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "byWard":
+			out.Values[i] = ec._DartaStats_byWard(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "bySection":
+			out.Values[i] = ec._DartaStats_bySection(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "byIntakeChannel":
+			out.Values[i] = ec._DartaStats_byIntakeChannel(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "byPriority":
+			out.Values[i] = ec._DartaStats_byPriority(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "series":
+			out.Values[i] = ec._DartaStats_series(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_myDartas_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) _Query_dartaStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_dartaStats,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().DartaStats(ctx, fc.Args["scope"].(*model.Scope), fc.Args["fiscalYearId"].(*string), fc.Args["wardId"].(*string))
-		},
-		nil,
-		ec.marshalNDartaStats2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStats,
-		true,
-		true,
-	)
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext_Query_dartaStats(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "total":
-				return ec.fieldContext_DartaStats_total(ctx, field)
-			case "byStatus":
-				return ec.fieldContext_DartaStats_byStatus(ctx, field)
-			case "byChannel":
-				return ec.fieldContext_DartaStats_byChannel(ctx, field)
-			case "overdueCount":
-				return ec.fieldContext_DartaStats_overdueCount(ctx, field)
+var dartaStatsBreakdownImplementors = []string{"DartaStatsBreakdown"}
+
+func (ec *executionContext) _DartaStatsBreakdown(ctx context.Context, sel ast.SelectionSet, obj *model.DartaStatsBreakdown) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dartaStatsBreakdownImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DartaStatsBreakdown")
+		case "key":
+			out.Values[i] = ec._DartaStatsBreakdown_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type DartaStats", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "total":
+			out.Values[i] = ec._DartaStatsBreakdown_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "overdueCount":
+			out.Values[i] = ec._DartaStatsBreakdown_overdueCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "receivedToRegistered":
+			out.Values[i] = ec._DartaStatsBreakdown_receivedToRegistered(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "registeredToClosed":
+			out.Values[i] = ec._DartaStatsBreakdown_registeredToClosed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sectionTurnaround":
+			out.Values[i] = ec._DartaStatsBreakdown_sectionTurnaround(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_dartaStats_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query___type,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.introspectType(fc.Args["name"].(string))
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+var dartaStatsBucketImplementors = []string{"DartaStatsBucket"}
+
+func (ec *executionContext) _DartaStatsBucket(ctx context.Context, sel ast.SelectionSet, obj *model.DartaStatsBucket) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dartaStatsBucketImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DartaStatsBucket")
+		case "start":
+			out.Values[i] = ec._DartaStatsBucket_start(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "startBs":
+			out.Values[i] = ec._DartaStatsBucket_startBs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "received":
+			out.Values[i] = ec._DartaStatsBucket_received(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "registered":
+			out.Values[i] = ec._DartaStatsBucket_registered(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "closed":
+			out.Values[i] = ec._DartaStatsBucket_closed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query___schema,
-		func(ctx context.Context) (any, error) {
-			return ec.introspectSchema()
-		},
-		nil,
-		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
-		true,
-		false,
-	)
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
+var dartaStatusCountImplementors = []string{"DartaStatusCount"}
+
+func (ec *executionContext) _DartaStatusCount(ctx context.Context, sel ast.SelectionSet, obj *model.DartaStatusCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dartaStatusCountImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DartaStatusCount")
+		case "status":
+			out.Values[i] = ec._DartaStatusCount_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
-		},
+		case "count":
+			out.Values[i] = ec._DartaStatusCount_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+var duplicateCandidateImplementors = []string{"DuplicateCandidate"}
+
+func (ec *executionContext) _DuplicateCandidate(ctx context.Context, sel ast.SelectionSet, obj *model.DuplicateCandidate) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, duplicateCandidateImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DuplicateCandidate")
+		case "dartaId":
+			out.Values[i] = ec._DuplicateCandidate_dartaId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "formattedDartaNumber":
+			out.Values[i] = ec._DuplicateCandidate_formattedDartaNumber(ctx, field, obj)
+		case "subject":
+			out.Values[i] = ec._DuplicateCandidate_subject(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._DuplicateCandidate_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "wardId":
+			out.Values[i] = ec._DuplicateCandidate_wardId(ctx, field, obj)
+		case "receivedDate":
+			out.Values[i] = ec._DuplicateCandidate_receivedDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "applicantName":
+			out.Values[i] = ec._DuplicateCandidate_applicantName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "score":
+			out.Values[i] = ec._DuplicateCandidate_score(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reasons":
+			out.Values[i] = ec._DuplicateCandidate_reasons(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
-
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_isRepeatable,
-		func(ctx context.Context) (any, error) {
-			return obj.IsRepeatable, nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_locations,
-		func(ctx context.Context) (any, error) {
-			return obj.Locations, nil
-		},
-		nil,
-		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var durationStatsImplementors = []string{"DurationStats"}
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_args,
-		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
-		},
-		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		true,
-	)
-}
+func (ec *executionContext) _DurationStats(ctx context.Context, sel ast.SelectionSet, obj *model.DurationStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, durationStatsImplementors)
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DurationStats")
+		case "count":
+			out.Values[i] = ec._DurationStats_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "avgHours":
+			out.Values[i] = ec._DurationStats_avgHours(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "p90Hours":
+			out.Values[i] = ec._DurationStats_p90Hours(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+var healthStatusImplementors = []string{"HealthStatus"}
+
+func (ec *executionContext) _HealthStatus(ctx context.Context, sel ast.SelectionSet, obj *model.HealthStatus) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, healthStatusImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("HealthStatus")
+		case "status":
+			out.Values[i] = ec._HealthStatus_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "service":
+			out.Values[i] = ec._HealthStatus_service(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._HealthStatus_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_isDeprecated,
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_deprecationReason,
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "createDarta":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createDarta(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "submitDartaForReview":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_submitDartaForReview(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reviewDarta":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_reviewDarta(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "approveDartaReview":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_approveDartaReview(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "classifyDarta":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_classifyDarta(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reserveDartaNumber":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_reserveDartaNumber(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "finalizeDartaRegistration":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_finalizeDartaRegistration(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "directRegisterDarta":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_directRegisterDarta(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "voidDarta":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_voidDarta(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "linkDuplicateDarta":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_linkDuplicateDarta(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "scanDarta":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_scanDarta(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "enrichDartaMetadata":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_enrichDartaMetadata(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "archiveDartaDigital":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_archiveDartaDigital(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "routeDarta":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_routeDarta(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "assignDartaSection":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_assignDartaSection(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sectionReviewDarta":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_sectionReviewDarta(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "requestDartaClarification":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_requestDartaClarification(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "provideDartaClarification":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_provideDartaClarification(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "acceptDarta":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_acceptDarta(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "markDartaAction":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_markDartaAction(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issueDartaResponse":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_issueDartaResponse(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "requestDartaAck":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_requestDartaAck(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "receiveDartaAck":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_receiveDartaAck(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "supersedeDartaRecord":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_supersedeDartaRecord(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "closeDarta":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_closeDarta(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var pageInfoImplementors = []string{"PageInfo"}
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_args,
-		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
-		},
-		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		true,
-	)
-}
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *model.PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "hasNextPage":
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "hasPreviousPage":
+			out.Values[i] = ec._PageInfo_hasPreviousPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "startCursor":
+			out.Values[i] = ec._PageInfo_startCursor(ctx, field, obj)
+		case "endCursor":
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+		case "totalCount":
+			out.Values[i] = ec._PageInfo_totalCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_type,
-		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
-		},
-		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_isDeprecated,
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var queryImplementors = []string{"Query"}
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_deprecationReason,
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "health":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_health(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "darta":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_darta(ctx, field)
+				return res
+			}
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "dartaByNumber":
+			field := field
 
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_dartaByNumber(ctx, field)
+				return res
+			}
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "dartas":
+			field := field
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_type,
-		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
-		},
-		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		true,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_dartas(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_defaultValue,
-		func(ctx context.Context) (any, error) {
-			return obj.DefaultValue, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "myDartas":
+			field := field
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_myDartas(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_isDeprecated,
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "chalani":
+			field := field
 
-func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_deprecationReason,
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_chalani(ctx, field)
+				return res
+			}
 
-func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "dartaStats":
+			field := field
 
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_dartaStats(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_types,
-		func(ctx context.Context) (any, error) {
-			return obj.Types(), nil
-		},
-		nil,
-		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "search":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_search(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_queryType,
-		func(ctx context.Context) (any, error) {
-			return obj.QueryType(), nil
-		},
-		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "registerExport":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_registerExport(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_mutationType,
-		func(ctx context.Context) (any, error) {
-			return obj.MutationType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "auditEntries":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_auditEntries(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_subscriptionType,
-		func(ctx context.Context) (any, error) {
-			return obj.SubscriptionType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "auditChainVerification":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_auditChainVerification(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_directives,
-		func(ctx context.Context) (any, error) {
-			return obj.Directives(), nil
-		},
-		nil,
-		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "auditAnchors":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_auditAnchors(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_kind,
-		func(ctx context.Context) (any, error) {
-			return obj.Kind(), nil
-		},
-		nil,
-		ec.marshalN__TypeKind2string,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
-		},
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "__type":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
+		case "__schema":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+var registerExportImplementors = []string{"RegisterExport"}
+
+func (ec *executionContext) _RegisterExport(ctx context.Context, sel ast.SelectionSet, obj *model.RegisterExport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, registerExportImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RegisterExport")
+		case "filename":
+			out.Values[i] = ec._RegisterExport_filename(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "contentType":
+			out.Values[i] = ec._RegisterExport_contentType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "contentBase64":
+			out.Values[i] = ec._RegisterExport_contentBase64(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "entryCount":
+			out.Values[i] = ec._RegisterExport_entryCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "gapCount":
+			out.Values[i] = ec._RegisterExport_gapCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "voidCount":
+			out.Values[i] = ec._RegisterExport_voidCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "seal":
+			out.Values[i] = ec._RegisterExport_seal(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_specifiedByURL,
-		func(ctx context.Context) (any, error) {
-			return obj.SpecifiedByURL(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_fields,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
-		},
-		nil,
-		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
+var registerSealImplementors = []string{"RegisterSeal"}
+
+func (ec *executionContext) _RegisterSeal(ctx context.Context, sel ast.SelectionSet, obj *model.RegisterSeal) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, registerSealImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RegisterSeal")
+		case "sha256":
+			out.Values[i] = ec._RegisterSeal_sha256(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "signature":
+			out.Values[i] = ec._RegisterSeal_signature(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "keyId":
+			out.Values[i] = ec._RegisterSeal_keyId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "publicKey":
+			out.Values[i] = ec._RegisterSeal_publicKey(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "generatedAt":
+			out.Values[i] = ec._RegisterSeal_generatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "generatedBy":
+			out.Values[i] = ec._RegisterSeal_generatedBy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_interfaces,
-		func(ctx context.Context) (any, error) {
-			return obj.Interfaces(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_possibleTypes,
-		func(ctx context.Context) (any, error) {
-			return obj.PossibleTypes(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+var relatedDartaImplementors = []string{"RelatedDarta"}
+
+func (ec *executionContext) _RelatedDarta(ctx context.Context, sel ast.SelectionSet, obj *model.RelatedDarta) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, relatedDartaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RelatedDarta")
+		case "relationshipType":
+			out.Values[i] = ec._RelatedDarta_relationshipType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+		case "darta":
+			out.Values[i] = ec._RelatedDarta_darta(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_enumValues,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
-		},
-		nil,
-		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+var searchFacetImplementors = []string{"SearchFacet"}
+
+func (ec *executionContext) _SearchFacet(ctx context.Context, sel ast.SelectionSet, obj *model.SearchFacet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, searchFacetImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SearchFacet")
+		case "field":
+			out.Values[i] = ec._SearchFacet_field(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "values":
+			out.Values[i] = ec._SearchFacet_values(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_inputFields,
-		func(ctx context.Context) (any, error) {
-			return obj.InputFields(), nil
-		},
-		nil,
-		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		false,
-	)
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+var searchFacetValueImplementors = []string{"SearchFacetValue"}
+
+func (ec *executionContext) _SearchFacetValue(ctx context.Context, sel ast.SelectionSet, obj *model.SearchFacetValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, searchFacetValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SearchFacetValue")
+		case "value":
+			out.Values[i] = ec._SearchFacetValue_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
+		case "count":
+			out.Values[i] = ec._SearchFacetValue_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_ofType,
-		func(ctx context.Context) (any, error) {
-			return obj.OfType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+var searchHitImplementors = []string{"SearchHit"}
+
+func (ec *executionContext) _SearchHit(ctx context.Context, sel ast.SelectionSet, obj *model.SearchHit) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, searchHitImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SearchHit")
+		case "entityType":
+			out.Values[i] = ec._SearchHit_entityType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+		case "id":
+			out.Values[i] = ec._SearchHit_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "title":
+			out.Values[i] = ec._SearchHit_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "number":
+			out.Values[i] = ec._SearchHit_number(ctx, field, obj)
+		case "party":
+			out.Values[i] = ec._SearchHit_party(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "status":
+			out.Values[i] = ec._SearchHit_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "fiscalYearId":
+			out.Values[i] = ec._SearchHit_fiscalYearId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "wardId":
+			out.Values[i] = ec._SearchHit_wardId(ctx, field, obj)
+		case "channel":
+			out.Values[i] = ec._SearchHit_channel(ctx, field, obj)
+		case "score":
+			out.Values[i] = ec._SearchHit_score(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "snippet":
+			out.Values[i] = ec._SearchHit_snippet(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "snippetField":
+			out.Values[i] = ec._SearchHit_snippetField(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "highlights":
+			out.Values[i] = ec._SearchHit_highlights(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "darta":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SearchHit_darta(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_isOneOf,
-		func(ctx context.Context) (any, error) {
-			return obj.IsOneOf(), nil
-		},
-		nil,
-		ec.marshalOBoolean2bool,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-// endregion **************************** field.gotpl *****************************
+	return out
+}
 
-// region    **************************** input.gotpl *****************************
+var searchResultImplementors = []string{"SearchResult"}
 
-func (ec *executionContext) unmarshalInputApplicantInput(ctx context.Context, obj any) (model.ApplicantInput, error) {
-	var it model.ApplicantInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+func (ec *executionContext) _SearchResult(ctx context.Context, sel ast.SelectionSet, obj *model.SearchResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, searchResultImplementors)
 
-	fieldsInOrder := [...]string{"type", "fullName", "organization", "email", "phone", "address", "identificationNumber"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "type":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
-			data, err := ec.unmarshalNApplicantType2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicantType(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Type = data
-		case "fullName":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fullName"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.FullName = data
-		case "organization":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("organization"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Organization = data
-		case "email":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SearchResult")
+		case "hits":
+			out.Values[i] = ec._SearchResult_hits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Email = data
-		case "phone":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("phone"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "facets":
+			out.Values[i] = ec._SearchResult_facets(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Phone = data
-		case "address":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "totalCount":
+			out.Values[i] = ec._SearchResult_totalCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Address = data
-		case "identificationNumber":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("identificationNumber"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "hasNextPage":
+			out.Values[i] = ec._SearchResult_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.IdentificationNumber = data
+		case "endCursor":
+			out.Values[i] = ec._SearchResult_endCursor(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) unmarshalInputCreateDartaInput(ctx context.Context, obj any) (model.CreateDartaInput, error) {
-	var it model.CreateDartaInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "dartaUpdated":
+		return ec._Subscription_dartaUpdated(ctx, fields[0])
+	case "myQueueChanged":
+		return ec._Subscription_myQueueChanged(ctx, fields[0])
+	case "chalaniDispatchUpdated":
+		return ec._Subscription_chalaniDispatchUpdated(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
 	}
+}
 
-	fieldsInOrder := [...]string{"fiscalYearId", "scope", "wardId", "subject", "applicant", "intakeChannel", "receivedDate", "primaryDocumentId", "annexIds", "priority", "idempotencyKey"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "fiscalYearId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fiscalYearId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.FiscalYearID = data
-		case "scope":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("scope"))
-			data, err := ec.unmarshalNScope2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐScope(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Scope = data
-		case "wardId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("wardId"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.WardID = data
-		case "subject":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subject"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Subject = data
-		case "applicant":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("applicant"))
-			data, err := ec.unmarshalNApplicantInput2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicantInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Applicant = data
-		case "intakeChannel":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("intakeChannel"))
-			data, err := ec.unmarshalNIntakeChannel2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐIntakeChannel(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.IntakeChannel = data
-		case "receivedDate":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("receivedDate"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ReceivedDate = data
-		case "primaryDocumentId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("primaryDocumentId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+var textRangeImplementors = []string{"TextRange"}
+
+func (ec *executionContext) _TextRange(ctx context.Context, sel ast.SelectionSet, obj *model.TextRange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, textRangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TextRange")
+		case "start":
+			out.Values[i] = ec._TextRange_start(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.PrimaryDocumentID = data
-		case "annexIds":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("annexIds"))
-			data, err := ec.unmarshalOID2ᚕstringᚄ(ctx, v)
-			if err != nil {
-				return it, err
+		case "end":
+			out.Values[i] = ec._TextRange_end(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.AnnexIds = data
-		case "priority":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("priority"))
-			data, err := ec.unmarshalNPriority2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPriority(ctx, v)
-			if err != nil {
-				return it, err
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var timelineImplementors = []string{"Timeline"}
+
+func (ec *executionContext) _Timeline(ctx context.Context, sel ast.SelectionSet, obj *model.Timeline) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, timelineImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Timeline")
+		case "entries":
+			out.Values[i] = ec._Timeline_entries(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Priority = data
-		case "idempotencyKey":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("idempotencyKey"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "truncated":
+			out.Values[i] = ec._Timeline_truncated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.IdempotencyKey = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputDartaFilterInput(ctx context.Context, obj any) (model.DartaFilterInput, error) {
-	var it model.DartaFilterInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"fiscalYearId", "scope", "wardId", "status", "priority", "organizationalUnitId", "assigneeId", "intakeChannel", "fromDate", "toDate", "search", "isOverdue"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "fiscalYearId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fiscalYearId"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.FiscalYearID = data
-		case "scope":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("scope"))
-			data, err := ec.unmarshalOScope2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐScope(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Scope = data
-		case "wardId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("wardId"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.WardID = data
-		case "status":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
-			data, err := ec.unmarshalODartaStatus2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatus(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Status = data
-		case "priority":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("priority"))
-			data, err := ec.unmarshalOPriority2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPriority(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Priority = data
-		case "organizationalUnitId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("organizationalUnitId"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.OrganizationalUnitID = data
-		case "assigneeId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("assigneeId"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.AssigneeID = data
-		case "intakeChannel":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("intakeChannel"))
-			data, err := ec.unmarshalOIntakeChannel2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐIntakeChannel(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.IntakeChannel = data
-		case "fromDate":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromDate"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.FromDate = data
-		case "toDate":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("toDate"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ToDate = data
-		case "search":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("search"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Search = data
-		case "isOverdue":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isOverdue"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var userImplementors = []string{"User"}
+
+func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *model.User) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, userImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("User")
+		case "id":
+			out.Values[i] = ec._User_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.IsOverdue = data
+		case "username":
+			out.Values[i] = ec._User_username(ctx, field, obj)
+		case "fullName":
+			out.Values[i] = ec._User_fullName(ctx, field, obj)
+		case "email":
+			out.Values[i] = ec._User_email(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputPaginationInput(ctx context.Context, obj any) (model.PaginationInput, error) {
-	var it model.PaginationInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"limit", "offset", "after", "before", "sortBy", "sortDesc"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "limit":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
-			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var __DirectiveImplementors = []string{"__Directive"}
+
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Limit = data
-		case "offset":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
-			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "isRepeatable":
+			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Offset = data
-		case "after":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.After = data
-		case "before":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Before = data
-		case "sortBy":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sortBy"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __EnumValueImplementors = []string{"__EnumValue"}
+
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.SortBy = data
-		case "sortDesc":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sortDesc"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.SortDesc = data
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputRouteDartaInput(ctx context.Context, obj any) (model.RouteDartaInput, error) {
-	var it model.RouteDartaInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"dartaId", "organizationalUnitId", "assigneeId", "priority", "slaHours", "notes"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "dartaId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dartaId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.DartaID = data
-		case "organizationalUnitId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("organizationalUnitId"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.OrganizationalUnitID = data
-		case "assigneeId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("assigneeId"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var __FieldImplementors = []string{"__Field"}
+
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.AssigneeID = data
-		case "priority":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("priority"))
-			data, err := ec.unmarshalOPriority2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPriority(ctx, v)
-			if err != nil {
-				return it, err
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Priority = data
-		case "slaHours":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slaHours"))
-			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.SLAHours = data
-		case "notes":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("notes"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Notes = data
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
-
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-// endregion ************************** interface.gotpl ***************************
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-// region    **************************** object.gotpl ****************************
+	return out
+}
 
-var applicantImplementors = []string{"Applicant"}
+var __InputValueImplementors = []string{"__InputValue"}
 
-func (ec *executionContext) _Applicant(ctx context.Context, sel ast.SelectionSet, obj *model.Applicant) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, applicantImplementors)
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Applicant")
-		case "id":
-			out.Values[i] = ec._Applicant_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
 		case "type":
-			out.Values[i] = ec._Applicant_type(ctx, field, obj)
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "fullName":
-			out.Values[i] = ec._Applicant_fullName(ctx, field, obj)
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "organization":
-			out.Values[i] = ec._Applicant_organization(ctx, field, obj)
-		case "email":
-			out.Values[i] = ec._Applicant_email(ctx, field, obj)
-		case "phone":
-			out.Values[i] = ec._Applicant_phone(ctx, field, obj)
-		case "address":
-			out.Values[i] = ec._Applicant_address(ctx, field, obj)
+		case "deprecationReason":
+			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5303,27 +20402,97 @@ func (ec *executionContext) _Applicant(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var channelCountImplementors = []string{"ChannelCount"}
+var __SchemaImplementors = []string{"__Schema"}
 
-func (ec *executionContext) _ChannelCount(ctx context.Context, sel ast.SelectionSet, obj *model.ChannelCount) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, channelCountImplementors)
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ChannelCount")
-		case "channel":
-			out.Values[i] = ec._ChannelCount_channel(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "description":
+			out.Values[i] = ec.___Schema_description(ctx, field, obj)
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "count":
-			out.Values[i] = ec._ChannelCount_count(ctx, field, obj)
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __TypeImplementors = []string{"__Type"}
+
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "specifiedByURL":
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		case "isOneOf":
+			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5347,1032 +20516,1059 @@ func (ec *executionContext) _ChannelCount(ctx context.Context, sel ast.Selection
 	return out
 }
 
-var dartaImplementors = []string{"Darta"}
+// endregion **************************** object.gotpl ****************************
 
-func (ec *executionContext) _Darta(ctx context.Context, sel ast.SelectionSet, obj *model.Darta) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, dartaImplementors)
+// region    ***************************** type.gotpl *****************************
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Darta")
-		case "id":
-			out.Values[i] = ec._Darta_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "dartaNumber":
-			out.Values[i] = ec._Darta_dartaNumber(ctx, field, obj)
-		case "formattedDartaNumber":
-			out.Values[i] = ec._Darta_formattedDartaNumber(ctx, field, obj)
-		case "fiscalYearId":
-			out.Values[i] = ec._Darta_fiscalYearId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "scope":
-			out.Values[i] = ec._Darta_scope(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "wardId":
-			out.Values[i] = ec._Darta_wardId(ctx, field, obj)
-		case "subject":
-			out.Values[i] = ec._Darta_subject(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "applicant":
-			out.Values[i] = ec._Darta_applicant(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "intakeChannel":
-			out.Values[i] = ec._Darta_intakeChannel(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "receivedDate":
-			out.Values[i] = ec._Darta_receivedDate(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "entryDate":
-			out.Values[i] = ec._Darta_entryDate(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "status":
-			out.Values[i] = ec._Darta_status(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "priority":
-			out.Values[i] = ec._Darta_priority(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "createdBy":
-			out.Values[i] = ec._Darta_createdBy(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "createdAt":
-			out.Values[i] = ec._Darta_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNApplicant2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicant(ctx context.Context, sel ast.SelectionSet, v model.Applicant) graphql.Marshaler {
+	return ec._Applicant(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNApplicant2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicant(ctx context.Context, sel ast.SelectionSet, v *model.Applicant) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Applicant(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNApplicantInput2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicantInput(ctx context.Context, v any) (*model.ApplicantInput, error) {
+	res, err := ec.unmarshalInputApplicantInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNApplicantType2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicantType(ctx context.Context, v any) (model.ApplicantType, error) {
+	var res model.ApplicantType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNApplicantType2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicantType(ctx context.Context, sel ast.SelectionSet, v model.ApplicantType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNAssignDartaSectionInput2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAssignDartaSectionInput(ctx context.Context, v any) (model.AssignDartaSectionInput, error) {
+	res, err := ec.unmarshalInputAssignDartaSectionInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAttachment2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAttachmentᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Attachment) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "updatedAt":
-			out.Values[i] = ec._Darta_updatedAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			ret[i] = ec.marshalNAttachment2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAttachment(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAttachment2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAttachment(ctx context.Context, sel ast.SelectionSet, v *model.Attachment) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Attachment(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAuditAnchor2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditAnchorᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AuditAnchor) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "tenantId":
-			out.Values[i] = ec._Darta_tenantId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			ret[i] = ec.marshalNAuditAnchor2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditAnchor(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAuditAnchor2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditAnchor(ctx context.Context, sel ast.SelectionSet, v *model.AuditAnchor) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AuditAnchor(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAuditChainProblem2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditChainProblemᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AuditChainProblem) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNAuditChainProblem2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditChainProblem(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNAuditChainProblem2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditChainProblem(ctx context.Context, sel ast.SelectionSet, v *model.AuditChainProblem) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._AuditChainProblem(ctx, sel, v)
 }
 
-var dartaConnectionImplementors = []string{"DartaConnection"}
-
-func (ec *executionContext) _DartaConnection(ctx context.Context, sel ast.SelectionSet, obj *model.DartaConnection) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, dartaConnectionImplementors)
+func (ec *executionContext) marshalNAuditChainVerification2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditChainVerification(ctx context.Context, sel ast.SelectionSet, v model.AuditChainVerification) graphql.Marshaler {
+	return ec._AuditChainVerification(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("DartaConnection")
-		case "edges":
-			out.Values[i] = ec._DartaConnection_edges(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "pageInfo":
-			out.Values[i] = ec._DartaConnection_pageInfo(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNAuditChainVerification2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditChainVerification(ctx context.Context, sel ast.SelectionSet, v *model.AuditChainVerification) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._AuditChainVerification(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNAuditEntry2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AuditEntry) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAuditEntry2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditEntry(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	return out
-}
+	}
+	wg.Wait()
 
-var dartaEdgeImplementors = []string{"DartaEdge"}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-func (ec *executionContext) _DartaEdge(ctx context.Context, sel ast.SelectionSet, obj *model.DartaEdge) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, dartaEdgeImplementors)
+	return ret
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("DartaEdge")
-		case "cursor":
-			out.Values[i] = ec._DartaEdge_cursor(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "node":
-			out.Values[i] = ec._DartaEdge_node(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNAuditEntry2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditEntry(ctx context.Context, sel ast.SelectionSet, v *model.AuditEntry) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._AuditEntry(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNAuditEntryConnection2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditEntryConnection(ctx context.Context, sel ast.SelectionSet, v model.AuditEntryConnection) graphql.Marshaler {
+	return ec._AuditEntryConnection(ctx, sel, &v)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNAuditEntryConnection2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditEntryConnection(ctx context.Context, sel ast.SelectionSet, v *model.AuditEntryConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._AuditEntryConnection(ctx, sel, v)
 }
 
-var dartaStatsImplementors = []string{"DartaStats"}
-
-func (ec *executionContext) _DartaStats(ctx context.Context, sel ast.SelectionSet, obj *model.DartaStats) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, dartaStatsImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("DartaStats")
-		case "total":
-			out.Values[i] = ec._DartaStats_total(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "byStatus":
-			out.Values[i] = ec._DartaStats_byStatus(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "byChannel":
-			out.Values[i] = ec._DartaStats_byChannel(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "overdueCount":
-			out.Values[i] = ec._DartaStats_overdueCount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNAuditEntryEdge2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditEntryEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AuditEntryEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNAuditEntryEdge2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditEntryEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var dartaStatusCountImplementors = []string{"DartaStatusCount"}
-
-func (ec *executionContext) _DartaStatusCount(ctx context.Context, sel ast.SelectionSet, obj *model.DartaStatusCount) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, dartaStatusCountImplementors)
+func (ec *executionContext) marshalNAuditEntryEdge2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditEntryEdge(ctx context.Context, sel ast.SelectionSet, v *model.AuditEntryEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AuditEntryEdge(ctx, sel, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("DartaStatusCount")
-		case "status":
-			out.Values[i] = ec._DartaStatusCount_status(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "count":
-			out.Values[i] = ec._DartaStatusCount_count(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNAuditFieldChange2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditFieldChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AuditFieldChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNAuditFieldChange2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditFieldChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var healthStatusImplementors = []string{"HealthStatus"}
-
-func (ec *executionContext) _HealthStatus(ctx context.Context, sel ast.SelectionSet, obj *model.HealthStatus) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, healthStatusImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("HealthStatus")
-		case "status":
-			out.Values[i] = ec._HealthStatus_status(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "service":
-			out.Values[i] = ec._HealthStatus_service(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "timestamp":
-			out.Values[i] = ec._HealthStatus_timestamp(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNAuditFieldChange2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditFieldChange(ctx context.Context, sel ast.SelectionSet, v *model.AuditFieldChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._AuditFieldChange(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 	}
-
-	return out
+	return res
 }
 
-var mutationImplementors = []string{"Mutation"}
-
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
-	})
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
+func (ec *executionContext) marshalNChalaniDispatchEvent2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChalaniDispatchEvent(ctx context.Context, sel ast.SelectionSet, v model.ChalaniDispatchEvent) graphql.Marshaler {
+	return ec._ChalaniDispatchEvent(ctx, sel, &v)
+}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "createDarta":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createDarta(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "submitDartaForReview":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_submitDartaForReview(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "classifyDarta":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_classifyDarta(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "reserveDartaNumber":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_reserveDartaNumber(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "finalizeDartaRegistration":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_finalizeDartaRegistration(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "routeDarta":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_routeDarta(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "closeDarta":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_closeDarta(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "voidDarta":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_voidDarta(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNChalaniDispatchEvent2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChalaniDispatchEvent(ctx context.Context, sel ast.SelectionSet, v *model.ChalaniDispatchEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
+	return ec._ChalaniDispatchEvent(ctx, sel, v)
 }
 
-var pageInfoImplementors = []string{"PageInfo"}
+func (ec *executionContext) unmarshalNChalaniStatus2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChalaniStatus(ctx context.Context, v any) (model.ChalaniStatus, error) {
+	var res model.ChalaniStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *model.PageInfo) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
+func (ec *executionContext) marshalNChalaniStatus2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChalaniStatus(ctx context.Context, sel ast.SelectionSet, v model.ChalaniStatus) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("PageInfo")
-		case "hasNextPage":
-			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "hasPreviousPage":
-			out.Values[i] = ec._PageInfo_hasPreviousPage(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalCount":
-			out.Values[i] = ec._PageInfo_totalCount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNChannelCount2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChannelCountᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ChannelCount) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNChannelCount2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChannelCount(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var queryImplementors = []string{"Query"}
-
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+func (ec *executionContext) marshalNChannelCount2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChannelCount(ctx context.Context, sel ast.SelectionSet, v *model.ChannelCount) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ChannelCount(ctx, sel, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
+func (ec *executionContext) unmarshalNCreateDartaInput2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐCreateDartaInput(ctx context.Context, v any) (model.CreateDartaInput, error) {
+	res, err := ec.unmarshalInputCreateDartaInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "health":
-			field := field
+func (ec *executionContext) marshalNDarta2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta(ctx context.Context, sel ast.SelectionSet, v model.Darta) graphql.Marshaler {
+	return ec._Darta(ctx, sel, &v)
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_health(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+func (ec *executionContext) marshalNDarta2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Darta) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "darta":
-			field := field
-
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_darta(ctx, field)
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "dartas":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_dartas(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	}
+	wg.Wait()
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "myDartas":
-			field := field
+	return ret
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_myDartas(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+func (ec *executionContext) marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta(ctx context.Context, sel ast.SelectionSet, v *model.Darta) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Darta(ctx, sel, v)
+}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+func (ec *executionContext) marshalNDartaConnection2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaConnection(ctx context.Context, sel ast.SelectionSet, v model.DartaConnection) graphql.Marshaler {
+	return ec._DartaConnection(ctx, sel, &v)
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "dartaStats":
-			field := field
+func (ec *executionContext) marshalNDartaConnection2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaConnection(ctx context.Context, sel ast.SelectionSet, v *model.DartaConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DartaConnection(ctx, sel, v)
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_dartaStats(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+func (ec *executionContext) marshalNDartaEdge2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DartaEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNDartaEdge2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	}
+	wg.Wait()
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "__type":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
-		case "__schema":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNDartaEdge2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaEdge(ctx context.Context, sel ast.SelectionSet, v *model.DartaEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._DartaEdge(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNDartaEvent2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaEvent(ctx context.Context, sel ast.SelectionSet, v model.DartaEvent) graphql.Marshaler {
+	return ec._DartaEvent(ctx, sel, &v)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNDartaEvent2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaEvent(ctx context.Context, sel ast.SelectionSet, v *model.DartaEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._DartaEvent(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNDartaReviewDecision2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaReviewDecision(ctx context.Context, v any) (model.DartaReviewDecision, error) {
+	var res model.DartaReviewDecision
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
+func (ec *executionContext) marshalNDartaReviewDecision2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaReviewDecision(ctx context.Context, sel ast.SelectionSet, v model.DartaReviewDecision) graphql.Marshaler {
+	return v
+}
 
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+func (ec *executionContext) marshalNDartaStats2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStats(ctx context.Context, sel ast.SelectionSet, v model.DartaStats) graphql.Marshaler {
+	return ec._DartaStats(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "isRepeatable":
-			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNDartaStats2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStats(ctx context.Context, sel ast.SelectionSet, v *model.DartaStats) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._DartaStats(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNDartaStatsBreakdown2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatsBreakdownᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DartaStatsBreakdown) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNDartaStatsBreakdown2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatsBreakdown(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
 	}
+	wg.Wait()
 
-	return out
-}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-var __EnumValueImplementors = []string{"__EnumValue"}
+	return ret
+}
 
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+func (ec *executionContext) marshalNDartaStatsBreakdown2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatsBreakdown(ctx context.Context, sel ast.SelectionSet, v *model.DartaStatsBreakdown) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DartaStatsBreakdown(ctx, sel, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNDartaStatsBucket2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatsBucketᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DartaStatsBucket) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNDartaStatsBucket2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatsBucket(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNDartaStatsBucket2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatsBucket(ctx context.Context, sel ast.SelectionSet, v *model.DartaStatsBucket) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._DartaStatsBucket(ctx, sel, v)
 }
 
-var __FieldImplementors = []string{"__Field"}
+func (ec *executionContext) unmarshalNDartaStatus2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatus(ctx context.Context, v any) (model.DartaStatus, error) {
+	var res model.DartaStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) marshalNDartaStatus2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatus(ctx context.Context, sel ast.SelectionSet, v model.DartaStatus) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNDartaStatusCount2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatusCountᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DartaStatusCount) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNDartaStatusCount2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatusCount(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNDartaStatusCount2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatusCount(ctx context.Context, sel ast.SelectionSet, v *model.DartaStatusCount) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._DartaStatusCount(ctx, sel, v)
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
+func (ec *executionContext) marshalNDuplicateCandidate2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDuplicateCandidateᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DuplicateCandidate) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNDuplicateCandidate2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDuplicateCandidate(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+	}
+	wg.Wait()
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNDuplicateCandidate2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDuplicateCandidate(ctx context.Context, sel ast.SelectionSet, v *model.DuplicateCandidate) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._DuplicateCandidate(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNDurationStats2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDurationStats(ctx context.Context, sel ast.SelectionSet, v *model.DurationStats) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._DurationStats(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __SchemaImplementors = []string{"__Schema"}
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return graphql.WrapContextMarshaler(ctx, res)
+}
 
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+func (ec *executionContext) marshalNHealthStatus2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐHealthStatus(ctx context.Context, sel ast.SelectionSet, v model.HealthStatus) graphql.Marshaler {
+	return ec._HealthStatus(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "description":
-			out.Values[i] = ec.___Schema_description(ctx, field, obj)
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNHealthStatus2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐHealthStatus(ctx context.Context, sel ast.SelectionSet, v *model.HealthStatus) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._HealthStatus(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 	}
-
-	return out
+	return res
 }
 
-var __TypeImplementors = []string{"__Type"}
-
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "specifiedByURL":
-			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		case "isOneOf":
-			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
+	return res
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNIntakeChannel2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐIntakeChannel(ctx context.Context, v any) (model.IntakeChannel, error) {
+	var res model.IntakeChannel
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+func (ec *executionContext) marshalNIntakeChannel2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐIntakeChannel(ctx context.Context, sel ast.SelectionSet, v model.IntakeChannel) graphql.Marshaler {
+	return v
+}
 
-	return out
+func (ec *executionContext) unmarshalNIssueDartaResponseInput2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐIssueDartaResponseInput(ctx context.Context, v any) (model.IssueDartaResponseInput, error) {
+	res, err := ec.unmarshalInputIssueDartaResponseInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-// endregion **************************** object.gotpl ****************************
+func (ec *executionContext) unmarshalNJSON2map(ctx context.Context, v any) (map[string]any, error) {
+	res, err := graphql.UnmarshalMap(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) marshalNJSON2map(ctx context.Context, sel ast.SelectionSet, v map[string]any) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	_ = sel
+	res := graphql.MarshalMap(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-func (ec *executionContext) marshalNApplicant2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicant(ctx context.Context, sel ast.SelectionSet, v *model.Applicant) graphql.Marshaler {
+func (ec *executionContext) marshalNPageInfo2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.PageInfo) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Applicant(ctx, sel, v)
+	return ec._PageInfo(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNApplicantInput2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicantInput(ctx context.Context, v any) (*model.ApplicantInput, error) {
-	res, err := ec.unmarshalInputApplicantInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) unmarshalNPriority2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPriority(ctx context.Context, v any) (model.Priority, error) {
+	var res model.Priority
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNApplicantType2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicantType(ctx context.Context, v any) (model.ApplicantType, error) {
-	var res model.ApplicantType
+func (ec *executionContext) marshalNPriority2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPriority(ctx context.Context, sel ast.SelectionSet, v model.Priority) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNRegisterBook2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRegisterBook(ctx context.Context, v any) (model.RegisterBook, error) {
+	var res model.RegisterBook
 	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNApplicantType2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐApplicantType(ctx context.Context, sel ast.SelectionSet, v model.ApplicantType) graphql.Marshaler {
+func (ec *executionContext) marshalNRegisterBook2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRegisterBook(ctx context.Context, sel ast.SelectionSet, v model.RegisterBook) graphql.Marshaler {
 	return v
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
+func (ec *executionContext) marshalNRegisterExport2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRegisterExport(ctx context.Context, sel ast.SelectionSet, v model.RegisterExport) graphql.Marshaler {
+	return ec._RegisterExport(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRegisterExport2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRegisterExport(ctx context.Context, sel ast.SelectionSet, v *model.RegisterExport) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RegisterExport(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRegisterFormat2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRegisterFormat(ctx context.Context, v any) (model.RegisterFormat, error) {
+	var res model.RegisterFormat
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNRegisterFormat2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRegisterFormat(ctx context.Context, sel ast.SelectionSet, v model.RegisterFormat) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNRegisterSeal2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRegisterSeal(ctx context.Context, sel ast.SelectionSet, v *model.RegisterSeal) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._RegisterSeal(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNChannelCount2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChannelCountᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ChannelCount) graphql.Marshaler {
+func (ec *executionContext) marshalNRelatedDarta2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRelatedDartaᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RelatedDarta) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -6396,7 +21592,7 @@ func (ec *executionContext) marshalNChannelCount2ᚕᚖgitᚗninjainfosysᚗcom
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNChannelCount2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChannelCount(ctx, sel, v[i])
+			ret[i] = ec.marshalNRelatedDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRelatedDarta(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -6416,50 +21612,47 @@ func (ec *executionContext) marshalNChannelCount2ᚕᚖgitᚗninjainfosysᚗcom
 	return ret
 }
 
-func (ec *executionContext) marshalNChannelCount2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChannelCount(ctx context.Context, sel ast.SelectionSet, v *model.ChannelCount) graphql.Marshaler {
+func (ec *executionContext) marshalNRelatedDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRelatedDarta(ctx context.Context, sel ast.SelectionSet, v *model.RelatedDarta) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ChannelCount(ctx, sel, v)
+	return ec._RelatedDarta(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNCreateDartaInput2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐCreateDartaInput(ctx context.Context, v any) (model.CreateDartaInput, error) {
-	res, err := ec.unmarshalInputCreateDartaInput(ctx, v)
+func (ec *executionContext) unmarshalNReviewDartaInput2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐReviewDartaInput(ctx context.Context, v any) (model.ReviewDartaInput, error) {
+	res, err := ec.unmarshalInputReviewDartaInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNDarta2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta(ctx context.Context, sel ast.SelectionSet, v model.Darta) graphql.Marshaler {
-	return ec._Darta(ctx, sel, &v)
+func (ec *executionContext) unmarshalNRouteDartaInput2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRouteDartaInput(ctx context.Context, v any) (model.RouteDartaInput, error) {
+	res, err := ec.unmarshalInputRouteDartaInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNDarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta(ctx context.Context, sel ast.SelectionSet, v *model.Darta) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._Darta(ctx, sel, v)
+func (ec *executionContext) unmarshalNScope2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐScope(ctx context.Context, v any) (model.Scope, error) {
+	var res model.Scope
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNDartaConnection2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaConnection(ctx context.Context, sel ast.SelectionSet, v model.DartaConnection) graphql.Marshaler {
-	return ec._DartaConnection(ctx, sel, &v)
+func (ec *executionContext) marshalNScope2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐScope(ctx context.Context, sel ast.SelectionSet, v model.Scope) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) marshalNDartaConnection2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaConnection(ctx context.Context, sel ast.SelectionSet, v *model.DartaConnection) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._DartaConnection(ctx, sel, v)
+func (ec *executionContext) unmarshalNSearchEntityType2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchEntityType(ctx context.Context, v any) (model.SearchEntityType, error) {
+	var res model.SearchEntityType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNDartaEdge2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DartaEdge) graphql.Marshaler {
+func (ec *executionContext) marshalNSearchEntityType2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchEntityType(ctx context.Context, sel ast.SelectionSet, v model.SearchEntityType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNSearchFacet2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchFacetᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SearchFacet) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -6483,7 +21676,7 @@ func (ec *executionContext) marshalNDartaEdge2ᚕᚖgitᚗninjainfosysᚗcomᚋe
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNDartaEdge2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaEdge(ctx, sel, v[i])
+			ret[i] = ec.marshalNSearchFacet2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchFacet(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -6503,41 +21696,81 @@ func (ec *executionContext) marshalNDartaEdge2ᚕᚖgitᚗninjainfosysᚗcomᚋe
 	return ret
 }
 
-func (ec *executionContext) marshalNDartaEdge2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaEdge(ctx context.Context, sel ast.SelectionSet, v *model.DartaEdge) graphql.Marshaler {
+func (ec *executionContext) marshalNSearchFacet2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchFacet(ctx context.Context, sel ast.SelectionSet, v *model.SearchFacet) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._DartaEdge(ctx, sel, v)
+	return ec._SearchFacet(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNDartaStats2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStats(ctx context.Context, sel ast.SelectionSet, v model.DartaStats) graphql.Marshaler {
-	return ec._DartaStats(ctx, sel, &v)
+func (ec *executionContext) marshalNSearchFacetValue2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchFacetValueᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SearchFacetValue) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSearchFacetValue2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchFacetValue(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNDartaStats2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStats(ctx context.Context, sel ast.SelectionSet, v *model.DartaStats) graphql.Marshaler {
+func (ec *executionContext) marshalNSearchFacetValue2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchFacetValue(ctx context.Context, sel ast.SelectionSet, v *model.SearchFacetValue) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._DartaStats(ctx, sel, v)
+	return ec._SearchFacetValue(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNDartaStatus2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatus(ctx context.Context, v any) (model.DartaStatus, error) {
-	var res model.DartaStatus
+func (ec *executionContext) unmarshalNSearchField2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchField(ctx context.Context, v any) (model.SearchField, error) {
+	var res model.SearchField
 	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNDartaStatus2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatus(ctx context.Context, sel ast.SelectionSet, v model.DartaStatus) graphql.Marshaler {
+func (ec *executionContext) marshalNSearchField2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchField(ctx context.Context, sel ast.SelectionSet, v model.SearchField) graphql.Marshaler {
 	return v
 }
 
-func (ec *executionContext) marshalNDartaStatusCount2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatusCountᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DartaStatusCount) graphql.Marshaler {
+func (ec *executionContext) marshalNSearchHit2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchHitᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SearchHit) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -6561,7 +21794,7 @@ func (ec *executionContext) marshalNDartaStatusCount2ᚕᚖgitᚗninjainfosysᚗ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNDartaStatusCount2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatusCount(ctx, sel, v[i])
+			ret[i] = ec.marshalNSearchHit2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchHit(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -6581,121 +21814,156 @@ func (ec *executionContext) marshalNDartaStatusCount2ᚕᚖgitᚗninjainfosysᚗ
 	return ret
 }
 
-func (ec *executionContext) marshalNDartaStatusCount2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDartaStatusCount(ctx context.Context, sel ast.SelectionSet, v *model.DartaStatusCount) graphql.Marshaler {
+func (ec *executionContext) marshalNSearchHit2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchHit(ctx context.Context, sel ast.SelectionSet, v *model.SearchHit) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._DartaStatusCount(ctx, sel, v)
+	return ec._SearchHit(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNHealthStatus2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐHealthStatus(ctx context.Context, sel ast.SelectionSet, v model.HealthStatus) graphql.Marshaler {
-	return ec._HealthStatus(ctx, sel, &v)
+func (ec *executionContext) marshalNSearchResult2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchResult(ctx context.Context, sel ast.SelectionSet, v model.SearchResult) graphql.Marshaler {
+	return ec._SearchResult(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNHealthStatus2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐHealthStatus(ctx context.Context, sel ast.SelectionSet, v *model.HealthStatus) graphql.Marshaler {
+func (ec *executionContext) marshalNSearchResult2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchResult(ctx context.Context, sel ast.SelectionSet, v *model.SearchResult) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-		return graphql.Null
-	}
-	return ec._HealthStatus(ctx, sel, v)
-}
+		return graphql.Null
+	}
+	return ec._SearchResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNTextRange2ᚕᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐTextRangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TextRange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTextRange2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐTextRange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalID(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+	}
+	wg.Wait()
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalID(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	return res
-}
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ret
 }
 
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNTextRange2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐTextRange(ctx context.Context, sel ast.SelectionSet, v *model.TextRange) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNIntakeChannel2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐIntakeChannel(ctx context.Context, v any) (model.IntakeChannel, error) {
-	var res model.IntakeChannel
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ec._TextRange(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNIntakeChannel2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐIntakeChannel(ctx context.Context, sel ast.SelectionSet, v model.IntakeChannel) graphql.Marshaler {
-	return v
+func (ec *executionContext) marshalNTimeline2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐTimeline(ctx context.Context, sel ast.SelectionSet, v model.Timeline) graphql.Marshaler {
+	return ec._Timeline(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNPageInfo2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.PageInfo) graphql.Marshaler {
+func (ec *executionContext) marshalNTimeline2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐTimeline(ctx context.Context, sel ast.SelectionSet, v *model.Timeline) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._PageInfo(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNPriority2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPriority(ctx context.Context, v any) (model.Priority, error) {
-	var res model.Priority
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNPriority2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPriority(ctx context.Context, sel ast.SelectionSet, v model.Priority) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) unmarshalNRouteDartaInput2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐRouteDartaInput(ctx context.Context, v any) (model.RouteDartaInput, error) {
-	res, err := ec.unmarshalInputRouteDartaInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNScope2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐScope(ctx context.Context, v any) (model.Scope, error) {
-	var res model.Scope
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNScope2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐScope(ctx context.Context, sel ast.SelectionSet, v model.Scope) graphql.Marshaler {
-	return v
+	return ec._Timeline(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNUser2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐUser(ctx context.Context, sel ast.SelectionSet, v model.User) graphql.Marshaler {
+	return ec._User(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNUser2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐUser(ctx context.Context, sel ast.SelectionSet, v *model.User) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._User(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
@@ -6951,6 +22219,32 @@ func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel a
 	return res
 }
 
+func (ec *executionContext) unmarshalOAny2interface(ctx context.Context, v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalAny(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOAny2interface(ctx context.Context, sel ast.SelectionSet, v any) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalAny(v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOAuditEntryFilterInput2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐAuditEntryFilterInput(ctx context.Context, v any) (*model.AuditEntryFilterInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputAuditEntryFilterInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v any) (bool, error) {
 	res, err := graphql.UnmarshalBoolean(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -6981,6 +22275,13 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return res
 }
 
+func (ec *executionContext) marshalOChalani2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐChalani(ctx context.Context, sel ast.SelectionSet, v *model.Chalani) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Chalani(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalODarta2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDarta(ctx context.Context, sel ast.SelectionSet, v *model.Darta) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -7012,6 +22313,13 @@ func (ec *executionContext) marshalODartaStatus2ᚖgitᚗninjainfosysᚗcomᚋeP
 	return v
 }
 
+func (ec *executionContext) marshalODurationStats2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐDurationStats(ctx context.Context, sel ast.SelectionSet, v *model.DurationStats) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._DurationStats(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOID2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
 	if v == nil {
 		return nil, nil
@@ -7048,6 +22356,24 @@ func (ec *executionContext) marshalOID2ᚕstringᚄ(ctx context.Context, sel ast
 	return ret
 }
 
+func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v any) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalID(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalID(*v)
+	return res
+}
+
 func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v any) (*int, error) {
 	if v == nil {
 		return nil, nil
@@ -7082,6 +22408,24 @@ func (ec *executionContext) marshalOIntakeChannel2ᚖgitᚗninjainfosysᚗcomᚋ
 	return v
 }
 
+func (ec *executionContext) unmarshalOJSON2map(ctx context.Context, v any) (map[string]any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalMap(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOJSON2map(ctx context.Context, sel ast.SelectionSet, v map[string]any) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalMap(v)
+	return res
+}
+
 func (ec *executionContext) unmarshalOPaginationInput2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐPaginationInput(ctx context.Context, v any) (*model.PaginationInput, error) {
 	if v == nil {
 		return nil, nil
@@ -7122,6 +22466,131 @@ func (ec *executionContext) marshalOScope2ᚖgitᚗninjainfosysᚗcomᚋePalika
 	return v
 }
 
+func (ec *executionContext) unmarshalOSearchEntityType2ᚕgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchEntityTypeᚄ(ctx context.Context, v any) ([]model.SearchEntityType, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]model.SearchEntityType, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNSearchEntityType2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchEntityType(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOSearchEntityType2ᚕgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchEntityTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []model.SearchEntityType) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSearchEntityType2gitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchEntityType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalOSearchFilterInput2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐSearchFilterInput(ctx context.Context, v any) (*model.SearchFilterInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputSearchFilterInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOStatsInterval2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐStatsInterval(ctx context.Context, v any) (*model.StatsInterval, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.StatsInterval)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOStatsInterval2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐStatsInterval(ctx context.Context, sel ast.SelectionSet, v *model.StatsInterval) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v any) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -7140,6 +22609,13 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) marshalOUser2ᚖgitᚗninjainfosysᚗcomᚋePalikaᚋgraphqlᚑgatewayᚋgraphᚋmodelᚐUser(ctx context.Context, sel ast.SelectionSet, v *model.User) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._User(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
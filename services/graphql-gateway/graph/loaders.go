@@ -0,0 +1,279 @@
+package graph
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"git.ninjainfosys.com/ePalika/graphql-gateway/graph/model"
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	identityv1 "git.ninjainfosys.com/ePalika/proto/gen/identity/v1"
+)
+
+// loaderWait is how long a loader collects keys before calling the backend.
+// gqlgen resolves the fields of list elements concurrently, so the keys of a
+// whole page arrive within this window.
+const loaderWait = 2 * time.Millisecond
+
+// loaderMaxBatch matches the batch size limit of the darta-chalani batch RPCs
+const loaderMaxBatch = 500
+
+// auditEntriesPerDarta is how many recent entries Darta.auditTrail returns
+const auditEntriesPerDarta = 20
+
+// batchFunc fetches the values for keys in one backend call. Keys missing
+// from the result resolve to the zero value.
+type batchFunc[V any] func(ctx context.Context, keys []string) (map[string]V, error)
+
+// loader batches and caches lookups by string key for one GraphQL response
+type loader[V any] struct {
+	fetch batchFunc[V]
+
+	mu    sync.Mutex
+	cache map[string]*loaderResult[V]
+	batch *loaderBatch[V]
+}
+
+type loaderResult[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+type loaderBatch[V any] struct {
+	keys    []string
+	results map[string]*loaderResult[V]
+	timer   *time.Timer
+}
+
+func newLoader[V any](fetch batchFunc[V]) *loader[V] {
+	return &loader[V]{fetch: fetch, cache: map[string]*loaderResult[V]{}}
+}
+
+// Load returns the value for key, waiting for the batch it joins
+func (l *loader[V]) Load(ctx context.Context, key string) (V, error) {
+	res := l.enqueue(ctx, key)
+	select {
+	case <-res.done:
+		return res.value, res.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// LoadMany returns the values for keys in order, sharing one batch
+func (l *loader[V]) LoadMany(ctx context.Context, keys []string) ([]V, error) {
+	results := make([]*loaderResult[V], len(keys))
+	for i, key := range keys {
+		results[i] = l.enqueue(ctx, key)
+	}
+
+	values := make([]V, len(keys))
+	for i, res := range results {
+		select {
+		case <-res.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if res.err != nil {
+			return nil, res.err
+		}
+		values[i] = res.value
+	}
+	return values, nil
+}
+
+func (l *loader[V]) enqueue(ctx context.Context, key string) *loaderResult[V] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if res, ok := l.cache[key]; ok {
+		return res
+	}
+
+	res := &loaderResult[V]{done: make(chan struct{})}
+	l.cache[key] = res
+
+	if l.batch == nil {
+		b := &loaderBatch[V]{results: map[string]*loaderResult[V]{}}
+		b.timer = time.AfterFunc(loaderWait, func() { l.dispatch(ctx, b) })
+		l.batch = b
+	}
+	b := l.batch
+	b.keys = append(b.keys, key)
+	b.results[key] = res
+
+	if len(b.keys) >= loaderMaxBatch {
+		l.batch = nil
+		if b.timer.Stop() {
+			go l.run(ctx, b)
+		}
+	}
+	return res
+}
+
+// dispatch runs b when its wait expires, unless it was already run for being
+// full
+func (l *loader[V]) dispatch(ctx context.Context, b *loaderBatch[V]) {
+	l.mu.Lock()
+	if l.batch == b {
+		l.batch = nil
+	}
+	l.mu.Unlock()
+	l.run(ctx, b)
+}
+
+func (l *loader[V]) run(ctx context.Context, b *loaderBatch[V]) {
+	values, err := l.fetch(ctx, b.keys)
+	for key, res := range b.results {
+		if err != nil {
+			res.err = err
+		} else {
+			res.value = values[key]
+		}
+		close(res.done)
+	}
+}
+
+// Loaders holds the per-response loaders used by nested Darta fields
+type Loaders struct {
+	Dartas      *loader[*dartav1.Darta]
+	Applicants  *loader[*dartav1.Applicant]
+	Attachments *loader[*dartav1.Attachment]
+	DartaLinks  *loader[*dartav1.DartaLinks]
+	AuditTrails *loader[[]*dartav1.AuditEntry]
+	Users       *loader[*identityv1.User]
+}
+
+// NewLoaders creates empty loaders backed by the resolver's clients
+func NewLoaders(r *Resolver) *Loaders {
+	return &Loaders{
+		Dartas: newLoader(func(ctx context.Context, ids []string) (map[string]*dartav1.Darta, error) {
+			resp, err := r.DartaClient.BatchGetDartas(ctx, &dartav1.BatchGetDartasRequest{Ids: ids})
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[string]*dartav1.Darta, len(resp.Dartas))
+			for _, d := range resp.Dartas {
+				byID[d.Id] = d
+			}
+			return byID, nil
+		}),
+		Applicants: newLoader(func(ctx context.Context, ids []string) (map[string]*dartav1.Applicant, error) {
+			resp, err := r.DartaClient.BatchGetApplicants(ctx, &dartav1.BatchGetApplicantsRequest{Ids: ids})
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[string]*dartav1.Applicant, len(resp.Applicants))
+			for _, a := range resp.Applicants {
+				byID[a.Id] = a
+			}
+			return byID, nil
+		}),
+		Attachments: newLoader(func(ctx context.Context, ids []string) (map[string]*dartav1.Attachment, error) {
+			resp, err := r.DartaClient.BatchGetAttachments(ctx, &dartav1.BatchGetAttachmentsRequest{Ids: ids})
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[string]*dartav1.Attachment, len(resp.Attachments))
+			for _, a := range resp.Attachments {
+				byID[a.Id] = a
+			}
+			return byID, nil
+		}),
+		DartaLinks: newLoader(func(ctx context.Context, ids []string) (map[string]*dartav1.DartaLinks, error) {
+			resp, err := r.DartaClient.BatchGetDartaLinks(ctx, &dartav1.BatchGetDartaLinksRequest{DartaIds: ids})
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[string]*dartav1.DartaLinks, len(resp.Links))
+			for _, l := range resp.Links {
+				byID[l.DartaId] = l
+			}
+			return byID, nil
+		}),
+		AuditTrails: newLoader(func(ctx context.Context, ids []string) (map[string][]*dartav1.AuditEntry, error) {
+			resp, err := r.DartaClient.BatchGetAuditTrails(ctx, &dartav1.BatchGetAuditTrailsRequest{
+				EntityType:     "DARTA",
+				EntityIds:      ids,
+				LimitPerEntity: auditEntriesPerDarta,
+			})
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[string][]*dartav1.AuditEntry, len(ids))
+			for _, e := range resp.Entries {
+				byID[e.EntityId] = append(byID[e.EntityId], e)
+			}
+			return byID, nil
+		}),
+		Users: newLoader(func(ctx context.Context, ids []string) (map[string]*identityv1.User, error) {
+			return r.IdentityClient.GetUsersByIDs(ctx, ids)
+		}),
+	}
+}
+
+type loadersKey struct{}
+
+// WithLoaders attaches fresh loaders to ctx. It runs once per GraphQL
+// response, so subscription events do not share a stale cache.
+func WithLoaders(ctx context.Context, r *Resolver) context.Context {
+	return context.WithValue(ctx, loadersKey{}, NewLoaders(r))
+}
+
+// loadersFor returns the loaders attached to ctx. Without them, lookups are
+// still correct but not shared between fields.
+func (r *Resolver) loadersFor(ctx context.Context) *Loaders {
+	if l, ok := ctx.Value(loadersKey{}).(*Loaders); ok {
+		return l
+	}
+	return NewLoaders(r)
+}
+
+// auditEntriesWithPerformers converts audit entries, resolving who performed
+// each through the shared Users loader
+func (r *Resolver) auditEntriesWithPerformers(ctx context.Context, entries []*dartav1.AuditEntry) ([]*model.AuditEntry, error) {
+	userIDs := make([]string, len(entries))
+	for i, e := range entries {
+		userIDs[i] = e.PerformedBy
+	}
+	users, err := r.loadersFor(ctx).Users.LoadMany(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := make([]*model.AuditEntry, len(entries))
+	for i, e := range entries {
+		converted[i] = protoToAuditEntry(e, identityToUser(e.PerformedBy, users[i]))
+	}
+	return converted, nil
+}
+
+// entityTimeline fetches the activity on a darta or chalani, oldest first
+func (r *Resolver) entityTimeline(ctx context.Context, entityType, id string) (*model.Timeline, error) {
+	resp, err := r.DartaClient.GetEntityTimeline(ctx, &dartav1.GetEntityTimelineRequest{
+		EntityType: entityType,
+		EntityId:   id,
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	entries, err := r.auditEntriesWithPerformers(ctx, resp.Entries)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	return &model.Timeline{Entries: entries, Truncated: resp.Truncated}, nil
+}
+
+// loadDarta loads a darta the caller may see by ID, or nil for an empty ID
+func (r *Resolver) loadDarta(ctx context.Context, id string) (*model.Darta, error) {
+	if id == "" {
+		return nil, nil
+	}
+	d, err := r.loadersFor(ctx).Dartas.Load(ctx, id)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	return protoToDarta(d), nil
+}
@@ -0,0 +1,41 @@
+package model
+
+// Darta is bound in gqlgen.yml instead of generated so that it can carry the
+// IDs of its nested records. applicant, createdBy, assignee, attachments,
+// relatedDartas, auditTrail, supersedes and supersededBy have field resolvers
+// that load them in batches. suspectedDuplicates asks darta-chalani unless the mutation that
+// returned the darta already did.
+type Darta struct {
+	ID                    string         `json:"id"`
+	DartaNumber           *int           `json:"dartaNumber,omitempty"`
+	FormattedDartaNumber  *string        `json:"formattedDartaNumber,omitempty"`
+	FiscalYearID          string         `json:"fiscalYearId"`
+	Scope                 Scope          `json:"scope"`
+	WardID                *string        `json:"wardId,omitempty"`
+	Subject               string         `json:"subject"`
+	IntakeChannel         IntakeChannel  `json:"intakeChannel"`
+	ReceivedDate          string         `json:"receivedDate"`
+	EntryDate             string         `json:"entryDate"`
+	Status                DartaStatus    `json:"status"`
+	Priority              Priority       `json:"priority"`
+	CreatedAt             string         `json:"createdAt"`
+	UpdatedAt             string         `json:"updatedAt"`
+	TenantID              string         `json:"tenantId"`
+	SupersedesDartaNumber *string        `json:"supersedesDartaNumber,omitempty"`
+	Metadata              map[string]any `json:"metadata,omitempty"`
+	Version               int            `json:"version"`
+
+	ApplicantID       string `json:"-"`
+	CreatedByID       string `json:"-"`
+	AssigneeID        string `json:"-"`
+	PrimaryDocumentID string `json:"-"`
+	SupersedesID      string `json:"-"`
+	SupersededByID    string `json:"-"`
+
+	// LoadedApplicant is set when the backend response already held the full
+	// applicant, saving a lookup
+	LoadedApplicant *Applicant `json:"-"`
+	// LoadedDuplicates is set by createDarta and reserveDartaNumber, whose
+	// responses list the suspected duplicates found at intake
+	LoadedDuplicates []*DuplicateCandidate `json:"-"`
+}
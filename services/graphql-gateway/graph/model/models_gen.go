@@ -29,43 +29,145 @@ type ApplicantInput struct {
 	IdentificationNumber *string       `json:"identificationNumber,omitempty"`
 }
 
+type AssignDartaSectionInput struct {
+	DartaID         string    `json:"dartaId"`
+	SectionID       string    `json:"sectionId"`
+	AssigneeID      *string   `json:"assigneeId,omitempty"`
+	Priority        *Priority `json:"priority,omitempty"`
+	SLAHours        *int      `json:"slaHours,omitempty"`
+	Notes           *string   `json:"notes,omitempty"`
+	ExpectedVersion *int      `json:"expectedVersion,omitempty"`
+}
+
+type Attachment struct {
+	ID               string `json:"id"`
+	Filename         string `json:"filename"`
+	OriginalFilename string `json:"originalFilename"`
+	MimeType         string `json:"mimeType"`
+	SizeBytes        int    `json:"sizeBytes"`
+	UploadedBy       string `json:"uploadedBy"`
+	UploadedAt       string `json:"uploadedAt"`
+	IsPrimary        bool   `json:"isPrimary"`
+}
+
+type AuditAnchor struct {
+	ID         string `json:"id"`
+	ChainSeq   int    `json:"chainSeq"`
+	EntryHash  string `json:"entryHash"`
+	AnchoredAt string `json:"anchoredAt"`
+	Statement  string `json:"statement"`
+}
+
+type AuditChainProblem struct {
+	Kind     string  `json:"kind"`
+	ChainSeq int     `json:"chainSeq"`
+	EntryID  *string `json:"entryId,omitempty"`
+	Detail   string  `json:"detail"`
+}
+
+type AuditChainVerification struct {
+	Intact            bool                 `json:"intact"`
+	EntriesVerified   int                  `json:"entriesVerified"`
+	HeadSeq           int                  `json:"headSeq"`
+	HeadHash          string               `json:"headHash"`
+	AnchorsVerified   int                  `json:"anchorsVerified"`
+	Problems          []*AuditChainProblem `json:"problems"`
+	ProblemsTruncated bool                 `json:"problemsTruncated"`
+}
+
+type AuditEntry struct {
+	ID          string              `json:"id"`
+	EntityType  string              `json:"entityType"`
+	EntityID    string              `json:"entityId"`
+	Action      string              `json:"action"`
+	PerformedBy *User               `json:"performedBy"`
+	PerformedAt string              `json:"performedAt"`
+	Notes       *string             `json:"notes,omitempty"`
+	Changes     map[string]any      `json:"changes,omitempty"`
+	Diff        []*AuditFieldChange `json:"diff"`
+	RequestID   *string             `json:"requestId,omitempty"`
+	TraceID     *string             `json:"traceId,omitempty"`
+	ActingRole  *string             `json:"actingRole,omitempty"`
+	DecisionID  *string             `json:"decisionId,omitempty"`
+}
+
+type AuditEntryConnection struct {
+	Edges    []*AuditEntryEdge `json:"edges"`
+	PageInfo *PageInfo         `json:"pageInfo"`
+}
+
+type AuditEntryEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   *AuditEntry `json:"node"`
+}
+
+type AuditEntryFilterInput struct {
+	EntityType  *string  `json:"entityType,omitempty"`
+	EntityID    *string  `json:"entityId,omitempty"`
+	PerformedBy *string  `json:"performedBy,omitempty"`
+	Actions     []string `json:"actions,omitempty"`
+	Category    *string  `json:"category,omitempty"`
+	From        *string  `json:"from,omitempty"`
+	To          *string  `json:"to,omitempty"`
+	TenantID    *string  `json:"tenantId,omitempty"`
+}
+
+type AuditFieldChange struct {
+	Field  string `json:"field"`
+	Before any    `json:"before,omitempty"`
+	After  any    `json:"after,omitempty"`
+}
+
+type Chalani struct {
+	ID                     string        `json:"id"`
+	ChalaniNumber          *int          `json:"chalaniNumber,omitempty"`
+	FormattedChalaniNumber *string       `json:"formattedChalaniNumber,omitempty"`
+	FiscalYearID           string        `json:"fiscalYearId"`
+	Scope                  Scope         `json:"scope"`
+	WardID                 *string       `json:"wardId,omitempty"`
+	Subject                string        `json:"subject"`
+	Body                   string        `json:"body"`
+	Status                 ChalaniStatus `json:"status"`
+	DispatchChannel        *string       `json:"dispatchChannel,omitempty"`
+	DispatchedAt           *string       `json:"dispatchedAt,omitempty"`
+	DeliveredAt            *string       `json:"deliveredAt,omitempty"`
+	CreatedAt              string        `json:"createdAt"`
+	UpdatedAt              string        `json:"updatedAt"`
+	Timeline               *Timeline     `json:"timeline"`
+}
+
+type ChalaniDispatchEvent struct {
+	Action                 string        `json:"action"`
+	ChalaniID              string        `json:"chalaniId"`
+	FormattedChalaniNumber *string       `json:"formattedChalaniNumber,omitempty"`
+	Subject                string        `json:"subject"`
+	Status                 ChalaniStatus `json:"status"`
+	TrackingID             *string       `json:"trackingId,omitempty"`
+	DispatchedAt           *string       `json:"dispatchedAt,omitempty"`
+	DeliveredAt            *string       `json:"deliveredAt,omitempty"`
+	ActorID                string        `json:"actorId"`
+	OccurredAt             string        `json:"occurredAt"`
+}
+
 type ChannelCount struct {
 	Channel IntakeChannel `json:"channel"`
 	Count   int           `json:"count"`
 }
 
 type CreateDartaInput struct {
-	FiscalYearID      string          `json:"fiscalYearId"`
-	Scope             Scope           `json:"scope"`
-	WardID            *string         `json:"wardId,omitempty"`
-	Subject           string          `json:"subject"`
-	Applicant         *ApplicantInput `json:"applicant"`
-	IntakeChannel     IntakeChannel   `json:"intakeChannel"`
-	ReceivedDate      string          `json:"receivedDate"`
-	PrimaryDocumentID string          `json:"primaryDocumentId"`
-	AnnexIds          []string        `json:"annexIds,omitempty"`
-	Priority          Priority        `json:"priority"`
-	IdempotencyKey    string          `json:"idempotencyKey"`
-}
-
-type Darta struct {
-	ID                   string        `json:"id"`
-	DartaNumber          *int          `json:"dartaNumber,omitempty"`
-	FormattedDartaNumber *string       `json:"formattedDartaNumber,omitempty"`
-	FiscalYearID         string        `json:"fiscalYearId"`
-	Scope                Scope         `json:"scope"`
-	WardID               *string       `json:"wardId,omitempty"`
-	Subject              string        `json:"subject"`
-	Applicant            *Applicant    `json:"applicant"`
-	IntakeChannel        IntakeChannel `json:"intakeChannel"`
-	ReceivedDate         string        `json:"receivedDate"`
-	EntryDate            string        `json:"entryDate"`
-	Status               DartaStatus   `json:"status"`
-	Priority             Priority      `json:"priority"`
-	CreatedBy            string        `json:"createdBy"`
-	CreatedAt            string        `json:"createdAt"`
-	UpdatedAt            string        `json:"updatedAt"`
-	TenantID             string        `json:"tenantId"`
+	FiscalYearID          string          `json:"fiscalYearId"`
+	Scope                 Scope           `json:"scope"`
+	WardID                *string         `json:"wardId,omitempty"`
+	Subject               string          `json:"subject"`
+	Applicant             *ApplicantInput `json:"applicant"`
+	IntakeChannel         IntakeChannel   `json:"intakeChannel"`
+	ReceivedDate          string          `json:"receivedDate"`
+	PrimaryDocumentID     string          `json:"primaryDocumentId"`
+	AnnexIds              []string        `json:"annexIds,omitempty"`
+	Priority              Priority        `json:"priority"`
+	IdempotencyKey        string          `json:"idempotencyKey"`
+	AcknowledgeDuplicates *bool           `json:"acknowledgeDuplicates,omitempty"`
+	BackdateReason        *string         `json:"backdateReason,omitempty"`
 }
 
 type DartaConnection struct {
@@ -78,6 +180,16 @@ type DartaEdge struct {
 	Node   *Darta `json:"node"`
 }
 
+type DartaEvent struct {
+	Action             string  `json:"action"`
+	Darta              *Darta  `json:"darta"`
+	ActorID            string  `json:"actorId"`
+	PreviousAssigneeID *string `json:"previousAssigneeId,omitempty"`
+	OccurredAt         string  `json:"occurredAt"`
+	SLAStage           *string `json:"slaStage,omitempty"`
+	EscalatedToID      *string `json:"escalatedToId,omitempty"`
+}
+
 type DartaFilterInput struct {
 	FiscalYearID         *string        `json:"fiscalYearId,omitempty"`
 	Scope                *Scope         `json:"scope,omitempty"`
@@ -94,10 +206,35 @@ type DartaFilterInput struct {
 }
 
 type DartaStats struct {
-	Total        int                 `json:"total"`
-	ByStatus     []*DartaStatusCount `json:"byStatus"`
-	ByChannel    []*ChannelCount     `json:"byChannel"`
-	OverdueCount int                 `json:"overdueCount"`
+	Total                  int                    `json:"total"`
+	ByStatus               []*DartaStatusCount    `json:"byStatus"`
+	ByChannel              []*ChannelCount        `json:"byChannel"`
+	OverdueCount           int                    `json:"overdueCount"`
+	AvgProcessingTimeHours float64                `json:"avgProcessingTimeHours"`
+	ReceivedToRegistered   *DurationStats         `json:"receivedToRegistered"`
+	RegisteredToClosed     *DurationStats         `json:"registeredToClosed"`
+	ByWard                 []*DartaStatsBreakdown `json:"byWard"`
+	BySection              []*DartaStatsBreakdown `json:"bySection"`
+	ByIntakeChannel        []*DartaStatsBreakdown `json:"byIntakeChannel"`
+	ByPriority             []*DartaStatsBreakdown `json:"byPriority"`
+	Series                 []*DartaStatsBucket    `json:"series"`
+}
+
+type DartaStatsBreakdown struct {
+	Key                  string         `json:"key"`
+	Total                int            `json:"total"`
+	OverdueCount         int            `json:"overdueCount"`
+	ReceivedToRegistered *DurationStats `json:"receivedToRegistered"`
+	RegisteredToClosed   *DurationStats `json:"registeredToClosed"`
+	SectionTurnaround    *DurationStats `json:"sectionTurnaround,omitempty"`
+}
+
+type DartaStatsBucket struct {
+	Start      string `json:"start"`
+	StartBs    string `json:"startBs"`
+	Received   int    `json:"received"`
+	Registered int    `json:"registered"`
+	Closed     int    `json:"closed"`
 }
 
 type DartaStatusCount struct {
@@ -105,19 +242,46 @@ type DartaStatusCount struct {
 	Count  int         `json:"count"`
 }
 
+type DuplicateCandidate struct {
+	DartaID              string      `json:"dartaId"`
+	FormattedDartaNumber *string     `json:"formattedDartaNumber,omitempty"`
+	Subject              string      `json:"subject"`
+	Status               DartaStatus `json:"status"`
+	WardID               *string     `json:"wardId,omitempty"`
+	ReceivedDate         string      `json:"receivedDate"`
+	ApplicantName        string      `json:"applicantName"`
+	Score                float64     `json:"score"`
+	Reasons              []string    `json:"reasons"`
+}
+
+type DurationStats struct {
+	Count    int     `json:"count"`
+	AvgHours float64 `json:"avgHours"`
+	P90Hours float64 `json:"p90Hours"`
+}
+
 type HealthStatus struct {
 	Status    string `json:"status"`
 	Service   string `json:"service"`
 	Timestamp string `json:"timestamp"`
 }
 
+type IssueDartaResponseInput struct {
+	DartaID           string  `json:"dartaId"`
+	ResponseChalaniID *string `json:"responseChalaniId,omitempty"`
+	DocAttachmentID   *string `json:"docAttachmentId,omitempty"`
+	ExpectedVersion   *int    `json:"expectedVersion,omitempty"`
+}
+
 type Mutation struct {
 }
 
 type PageInfo struct {
-	HasNextPage     bool `json:"hasNextPage"`
-	HasPreviousPage bool `json:"hasPreviousPage"`
-	TotalCount      int  `json:"totalCount"`
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+	TotalCount      int     `json:"totalCount"`
 }
 
 type PaginationInput struct {
@@ -132,6 +296,38 @@ type PaginationInput struct {
 type Query struct {
 }
 
+type RegisterExport struct {
+	Filename      string        `json:"filename"`
+	ContentType   string        `json:"contentType"`
+	ContentBase64 string        `json:"contentBase64"`
+	EntryCount    int           `json:"entryCount"`
+	GapCount      int           `json:"gapCount"`
+	VoidCount     int           `json:"voidCount"`
+	Seal          *RegisterSeal `json:"seal"`
+}
+
+type RegisterSeal struct {
+	Sha256      string `json:"sha256"`
+	Signature   string `json:"signature"`
+	KeyID       string `json:"keyId"`
+	PublicKey   string `json:"publicKey"`
+	GeneratedAt string `json:"generatedAt"`
+	GeneratedBy string `json:"generatedBy"`
+}
+
+type RelatedDarta struct {
+	RelationshipType string `json:"relationshipType"`
+	Darta            *Darta `json:"darta"`
+}
+
+type ReviewDartaInput struct {
+	DartaID         string              `json:"dartaId"`
+	Decision        DartaReviewDecision `json:"decision"`
+	Notes           *string             `json:"notes,omitempty"`
+	RequestedInfo   *string             `json:"requestedInfo,omitempty"`
+	ExpectedVersion *int                `json:"expectedVersion,omitempty"`
+}
+
 type RouteDartaInput struct {
 	DartaID              string    `json:"dartaId"`
 	OrganizationalUnitID *string   `json:"organizationalUnitId,omitempty"`
@@ -139,6 +335,69 @@ type RouteDartaInput struct {
 	Priority             *Priority `json:"priority,omitempty"`
 	SLAHours             *int      `json:"slaHours,omitempty"`
 	Notes                *string   `json:"notes,omitempty"`
+	ExpectedVersion      *int      `json:"expectedVersion,omitempty"`
+}
+
+type SearchFacet struct {
+	Field  string              `json:"field"`
+	Values []*SearchFacetValue `json:"values"`
+}
+
+type SearchFacetValue struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+type SearchFilterInput struct {
+	Statuses      []string `json:"statuses,omitempty"`
+	FiscalYearIds []string `json:"fiscalYearIds,omitempty"`
+	WardIds       []string `json:"wardIds,omitempty"`
+	Channels      []string `json:"channels,omitempty"`
+}
+
+type SearchHit struct {
+	EntityType   SearchEntityType `json:"entityType"`
+	ID           string           `json:"id"`
+	Title        string           `json:"title"`
+	Number       *string          `json:"number,omitempty"`
+	Party        string           `json:"party"`
+	Status       string           `json:"status"`
+	FiscalYearID string           `json:"fiscalYearId"`
+	WardID       *string          `json:"wardId,omitempty"`
+	Channel      *string          `json:"channel,omitempty"`
+	Score        float64          `json:"score"`
+	Snippet      string           `json:"snippet"`
+	SnippetField SearchField      `json:"snippetField"`
+	Highlights   []*TextRange     `json:"highlights"`
+	Darta        *Darta           `json:"darta,omitempty"`
+}
+
+type SearchResult struct {
+	Hits        []*SearchHit   `json:"hits"`
+	Facets      []*SearchFacet `json:"facets"`
+	TotalCount  int            `json:"totalCount"`
+	HasNextPage bool           `json:"hasNextPage"`
+	EndCursor   *string        `json:"endCursor,omitempty"`
+}
+
+type Subscription struct {
+}
+
+type TextRange struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+type Timeline struct {
+	Entries   []*AuditEntry `json:"entries"`
+	Truncated bool          `json:"truncated"`
+}
+
+type User struct {
+	ID       string  `json:"id"`
+	Username *string `json:"username,omitempty"`
+	FullName *string `json:"fullName,omitempty"`
+	Email    *string `json:"email,omitempty"`
 }
 
 type ApplicantType string
@@ -200,6 +459,144 @@ func (e ApplicantType) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type ChalaniStatus string
+
+const (
+	ChalaniStatusDraft               ChalaniStatus = "DRAFT"
+	ChalaniStatusPendingReview       ChalaniStatus = "PENDING_REVIEW"
+	ChalaniStatusPendingApproval     ChalaniStatus = "PENDING_APPROVAL"
+	ChalaniStatusApproved            ChalaniStatus = "APPROVED"
+	ChalaniStatusNumberReserved      ChalaniStatus = "NUMBER_RESERVED"
+	ChalaniStatusRegistered          ChalaniStatus = "REGISTERED"
+	ChalaniStatusSigned              ChalaniStatus = "SIGNED"
+	ChalaniStatusSealed              ChalaniStatus = "SEALED"
+	ChalaniStatusDispatched          ChalaniStatus = "DISPATCHED"
+	ChalaniStatusInTransit           ChalaniStatus = "IN_TRANSIT"
+	ChalaniStatusAcknowledged        ChalaniStatus = "ACKNOWLEDGED"
+	ChalaniStatusReturnedUndelivered ChalaniStatus = "RETURNED_UNDELIVERED"
+	ChalaniStatusDelivered           ChalaniStatus = "DELIVERED"
+	ChalaniStatusVoided              ChalaniStatus = "VOIDED"
+	ChalaniStatusSuperseded          ChalaniStatus = "SUPERSEDED"
+	ChalaniStatusClosed              ChalaniStatus = "CLOSED"
+)
+
+var AllChalaniStatus = []ChalaniStatus{
+	ChalaniStatusDraft,
+	ChalaniStatusPendingReview,
+	ChalaniStatusPendingApproval,
+	ChalaniStatusApproved,
+	ChalaniStatusNumberReserved,
+	ChalaniStatusRegistered,
+	ChalaniStatusSigned,
+	ChalaniStatusSealed,
+	ChalaniStatusDispatched,
+	ChalaniStatusInTransit,
+	ChalaniStatusAcknowledged,
+	ChalaniStatusReturnedUndelivered,
+	ChalaniStatusDelivered,
+	ChalaniStatusVoided,
+	ChalaniStatusSuperseded,
+	ChalaniStatusClosed,
+}
+
+func (e ChalaniStatus) IsValid() bool {
+	switch e {
+	case ChalaniStatusDraft, ChalaniStatusPendingReview, ChalaniStatusPendingApproval, ChalaniStatusApproved, ChalaniStatusNumberReserved, ChalaniStatusRegistered, ChalaniStatusSigned, ChalaniStatusSealed, ChalaniStatusDispatched, ChalaniStatusInTransit, ChalaniStatusAcknowledged, ChalaniStatusReturnedUndelivered, ChalaniStatusDelivered, ChalaniStatusVoided, ChalaniStatusSuperseded, ChalaniStatusClosed:
+		return true
+	}
+	return false
+}
+
+func (e ChalaniStatus) String() string {
+	return string(e)
+}
+
+func (e *ChalaniStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChalaniStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChalaniStatus", str)
+	}
+	return nil
+}
+
+func (e ChalaniStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ChalaniStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ChalaniStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type DartaReviewDecision string
+
+const (
+	DartaReviewDecisionApproveReview DartaReviewDecision = "APPROVE_REVIEW"
+	DartaReviewDecisionEditRequired  DartaReviewDecision = "EDIT_REQUIRED"
+)
+
+var AllDartaReviewDecision = []DartaReviewDecision{
+	DartaReviewDecisionApproveReview,
+	DartaReviewDecisionEditRequired,
+}
+
+func (e DartaReviewDecision) IsValid() bool {
+	switch e {
+	case DartaReviewDecisionApproveReview, DartaReviewDecisionEditRequired:
+		return true
+	}
+	return false
+}
+
+func (e DartaReviewDecision) String() string {
+	return string(e)
+}
+
+func (e *DartaReviewDecision) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DartaReviewDecision(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DartaReviewDecision", str)
+	}
+	return nil
+}
+
+func (e DartaReviewDecision) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DartaReviewDecision) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DartaReviewDecision) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type DartaStatus string
 
 const (
@@ -409,6 +806,118 @@ func (e Priority) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type RegisterBook string
+
+const (
+	RegisterBookDarta   RegisterBook = "DARTA"
+	RegisterBookChalani RegisterBook = "CHALANI"
+)
+
+var AllRegisterBook = []RegisterBook{
+	RegisterBookDarta,
+	RegisterBookChalani,
+}
+
+func (e RegisterBook) IsValid() bool {
+	switch e {
+	case RegisterBookDarta, RegisterBookChalani:
+		return true
+	}
+	return false
+}
+
+func (e RegisterBook) String() string {
+	return string(e)
+}
+
+func (e *RegisterBook) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RegisterBook(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RegisterBook", str)
+	}
+	return nil
+}
+
+func (e RegisterBook) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RegisterBook) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RegisterBook) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type RegisterFormat string
+
+const (
+	RegisterFormatCSV  RegisterFormat = "CSV"
+	RegisterFormatXlsx RegisterFormat = "XLSX"
+	RegisterFormatPDF  RegisterFormat = "PDF"
+)
+
+var AllRegisterFormat = []RegisterFormat{
+	RegisterFormatCSV,
+	RegisterFormatXlsx,
+	RegisterFormatPDF,
+}
+
+func (e RegisterFormat) IsValid() bool {
+	switch e {
+	case RegisterFormatCSV, RegisterFormatXlsx, RegisterFormatPDF:
+		return true
+	}
+	return false
+}
+
+func (e RegisterFormat) String() string {
+	return string(e)
+}
+
+func (e *RegisterFormat) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RegisterFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RegisterFormat", str)
+	}
+	return nil
+}
+
+func (e RegisterFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RegisterFormat) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RegisterFormat) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type Scope string
 
 const (
@@ -463,3 +972,174 @@ func (e Scope) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type SearchEntityType string
+
+const (
+	SearchEntityTypeDarta   SearchEntityType = "DARTA"
+	SearchEntityTypeChalani SearchEntityType = "CHALANI"
+)
+
+var AllSearchEntityType = []SearchEntityType{
+	SearchEntityTypeDarta,
+	SearchEntityTypeChalani,
+}
+
+func (e SearchEntityType) IsValid() bool {
+	switch e {
+	case SearchEntityTypeDarta, SearchEntityTypeChalani:
+		return true
+	}
+	return false
+}
+
+func (e SearchEntityType) String() string {
+	return string(e)
+}
+
+func (e *SearchEntityType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SearchEntityType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SearchEntityType", str)
+	}
+	return nil
+}
+
+func (e SearchEntityType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SearchEntityType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SearchEntityType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SearchField string
+
+const (
+	SearchFieldContent SearchField = "CONTENT"
+	SearchFieldTitle   SearchField = "TITLE"
+	SearchFieldParty   SearchField = "PARTY"
+	SearchFieldNumber  SearchField = "NUMBER"
+)
+
+var AllSearchField = []SearchField{
+	SearchFieldContent,
+	SearchFieldTitle,
+	SearchFieldParty,
+	SearchFieldNumber,
+}
+
+func (e SearchField) IsValid() bool {
+	switch e {
+	case SearchFieldContent, SearchFieldTitle, SearchFieldParty, SearchFieldNumber:
+		return true
+	}
+	return false
+}
+
+func (e SearchField) String() string {
+	return string(e)
+}
+
+func (e *SearchField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SearchField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SearchField", str)
+	}
+	return nil
+}
+
+func (e SearchField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SearchField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SearchField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type StatsInterval string
+
+const (
+	StatsIntervalDay   StatsInterval = "DAY"
+	StatsIntervalWeek  StatsInterval = "WEEK"
+	StatsIntervalMonth StatsInterval = "MONTH"
+)
+
+var AllStatsInterval = []StatsInterval{
+	StatsIntervalDay,
+	StatsIntervalWeek,
+	StatsIntervalMonth,
+}
+
+func (e StatsInterval) IsValid() bool {
+	switch e {
+	case StatsIntervalDay, StatsIntervalWeek, StatsIntervalMonth:
+		return true
+	}
+	return false
+}
+
+func (e StatsInterval) String() string {
+	return string(e)
+}
+
+func (e *StatsInterval) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StatsInterval(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StatsInterval", str)
+	}
+	return nil
+}
+
+func (e StatsInterval) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *StatsInterval) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e StatsInterval) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -10,13 +10,15 @@ import (
 
 type Resolver struct {
 	DartaClient    clients.DartaService
+	ChalaniClient  clients.ChalaniService
 	IdentityClient clients.IdentityService
 	PDPClient      clients.PDPService
 }
 
-func NewResolver(dartaClient clients.DartaService, identityClient clients.IdentityService, pdpClient clients.PDPService) *Resolver {
+func NewResolver(dartaClient clients.DartaService, chalaniClient clients.ChalaniService, identityClient clients.IdentityService, pdpClient clients.PDPService) *Resolver {
 	return &Resolver{
 		DartaClient:    dartaClient,
+		ChalaniClient:  chalaniClient,
 		IdentityClient: identityClient,
 		PDPClient:      pdpClient,
 	}
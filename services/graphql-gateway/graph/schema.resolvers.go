@@ -6,107 +6,527 @@ package graph
 
 import (
 	"context"
+	"strings"
 
-	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
 	"git.ninjainfosys.com/ePalika/graphql-gateway/graph/model"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
+// Timeline is the resolver for the timeline field.
+func (r *chalaniResolver) Timeline(ctx context.Context, obj *model.Chalani) (*model.Timeline, error) {
+	return r.entityTimeline(ctx, "CHALANI", obj.ID)
+}
+
+// Applicant is the resolver for the applicant field.
+func (r *dartaResolver) Applicant(ctx context.Context, obj *model.Darta) (*model.Applicant, error) {
+	if obj.LoadedApplicant != nil {
+		return obj.LoadedApplicant, nil
+	}
+	a, err := r.loadersFor(ctx).Applicants.Load(ctx, obj.ApplicantID)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	if a == nil {
+		return nil, notFoundError(ctx, "applicant not found")
+	}
+	return protoToApplicant(a), nil
+}
+
+// CreatedBy is the resolver for the createdBy field.
+func (r *dartaResolver) CreatedBy(ctx context.Context, obj *model.Darta) (*model.User, error) {
+	u, err := r.loadersFor(ctx).Users.Load(ctx, obj.CreatedByID)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	return identityToUser(obj.CreatedByID, u), nil
+}
+
+// Assignee is the resolver for the assignee field.
+func (r *dartaResolver) Assignee(ctx context.Context, obj *model.Darta) (*model.User, error) {
+	if obj.AssigneeID == "" {
+		return nil, nil
+	}
+	u, err := r.loadersFor(ctx).Users.Load(ctx, obj.AssigneeID)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	return identityToUser(obj.AssigneeID, u), nil
+}
+
+// Attachments is the resolver for the attachments field.
+func (r *dartaResolver) Attachments(ctx context.Context, obj *model.Darta) ([]*model.Attachment, error) {
+	loaders := r.loadersFor(ctx)
+	links, err := loaders.DartaLinks.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	ids := make([]string, 0, 1+len(links.GetAnnexIds()))
+	if obj.PrimaryDocumentID != "" {
+		ids = append(ids, obj.PrimaryDocumentID)
+	}
+	ids = append(ids, links.GetAnnexIds()...)
+
+	found, err := loaders.Attachments.LoadMany(ctx, ids)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	attachments := make([]*model.Attachment, 0, len(found))
+	for i, a := range found {
+		if a != nil {
+			attachments = append(attachments, protoToAttachment(a, ids[i] == obj.PrimaryDocumentID))
+		}
+	}
+	return attachments, nil
+}
+
+// RelatedDartas is the resolver for the relatedDartas field.
+func (r *dartaResolver) RelatedDartas(ctx context.Context, obj *model.Darta) ([]*model.RelatedDarta, error) {
+	loaders := r.loadersFor(ctx)
+	links, err := loaders.DartaLinks.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	ids := make([]string, len(links.GetRelations()))
+	for i, rel := range links.GetRelations() {
+		ids[i] = rel.RelatedDartaId
+	}
+	found, err := loaders.Dartas.LoadMany(ctx, ids)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	related := make([]*model.RelatedDarta, 0, len(found))
+	for i, d := range found {
+		if d != nil {
+			related = append(related, &model.RelatedDarta{
+				RelationshipType: links.Relations[i].RelationshipType,
+				Darta:            protoToDarta(d),
+			})
+		}
+	}
+	return related, nil
+}
+
+// AuditTrail is the resolver for the auditTrail field.
+func (r *dartaResolver) AuditTrail(ctx context.Context, obj *model.Darta) ([]*model.AuditEntry, error) {
+	entries, err := r.loadersFor(ctx).AuditTrails.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	trail, err := r.auditEntriesWithPerformers(ctx, entries)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	return trail, nil
+}
+
+// Timeline is the resolver for the timeline field.
+func (r *dartaResolver) Timeline(ctx context.Context, obj *model.Darta) (*model.Timeline, error) {
+	return r.entityTimeline(ctx, "DARTA", obj.ID)
+}
+
+// SuspectedDuplicates is the resolver for the suspectedDuplicates field.
+func (r *dartaResolver) SuspectedDuplicates(ctx context.Context, obj *model.Darta) ([]*model.DuplicateCandidate, error) {
+	if obj.LoadedDuplicates != nil {
+		return obj.LoadedDuplicates, nil
+	}
+	resp, err := r.DartaClient.ListDuplicateCandidates(ctx, &dartav1.ListDuplicateCandidatesRequest{DartaId: obj.ID})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	return protoToDuplicateCandidates(resp.Candidates), nil
+}
+
+// Supersedes is the resolver for the supersedes field.
+func (r *dartaResolver) Supersedes(ctx context.Context, obj *model.Darta) (*model.Darta, error) {
+	return r.loadDarta(ctx, obj.SupersedesID)
+}
+
+// SupersededBy is the resolver for the supersededBy field.
+func (r *dartaResolver) SupersededBy(ctx context.Context, obj *model.Darta) (*model.Darta, error) {
+	return r.loadDarta(ctx, obj.SupersededByID)
+}
+
+// SupersessionChain is the resolver for the supersessionChain field.
+func (r *dartaResolver) SupersessionChain(ctx context.Context, obj *model.Darta) ([]*model.Darta, error) {
+	resp, err := r.DartaClient.GetDartaSupersessionChain(ctx, &dartav1.GetDartaSupersessionChainRequest{DartaId: obj.ID})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	chain := make([]*model.Darta, len(resp.Dartas))
+	for i, d := range resp.Dartas {
+		chain[i] = protoToDarta(d)
+	}
+	return chain, nil
+}
+
 // CreateDarta is the resolver for the createDarta field.
 func (r *mutationResolver) CreateDarta(ctx context.Context, input model.CreateDartaInput) (*model.Darta, error) {
+	if err := requireText(ctx, "subject", input.Subject); err != nil {
+		return nil, err
+	}
+	if err := requireText(ctx, "applicant.fullName", input.Applicant.FullName); err != nil {
+		return nil, err
+	}
+	if input.Scope == model.ScopeWard && stringPtrValue(input.WardID) == "" {
+		return nil, validationError(ctx, "wardId", "is required when scope is WARD")
+	}
+	if err := requireID(ctx, "primaryDocumentId", input.PrimaryDocumentID); err != nil {
+		return nil, err
+	}
+	receivedDate, err := parseTimestamp(input.ReceivedDate)
+	if err != nil {
+		return nil, validationError(ctx, "receivedDate", err.Error())
+	}
+
 	// Convert GraphQL input to proto
 	req := &dartav1.CreateDartaRequest{
 		Input: &dartav1.CreateDartaInput{
-			Scope:        scopeToProto(input.Scope),
-			WardId:       stringPtrValue(input.WardID),
-			Subject:      input.Subject,
+			Scope:   scopeToProto(input.Scope),
+			WardId:  stringPtrValue(input.WardID),
+			Subject: input.Subject,
 			Applicant: &dartav1.ApplicantInput{
-				Type:         applicantTypeToProto(input.Applicant.Type),
-				FullName:     input.Applicant.FullName,
-				Organization: stringPtrValue(input.Applicant.Organization),
-				Email:        stringPtrValue(input.Applicant.Email),
-				Phone:        stringPtrValue(input.Applicant.Phone),
-				Address:      stringPtrValue(input.Applicant.Address),
+				Type:                 applicantTypeToProto(input.Applicant.Type),
+				FullName:             input.Applicant.FullName,
+				Organization:         stringPtrValue(input.Applicant.Organization),
+				Email:                stringPtrValue(input.Applicant.Email),
+				Phone:                stringPtrValue(input.Applicant.Phone),
+				Address:              stringPtrValue(input.Applicant.Address),
 				IdentificationNumber: stringPtrValue(input.Applicant.IdentificationNumber),
 			},
 			IntakeChannel:     intakeChannelToProto(input.IntakeChannel),
-			ReceivedDate:      parseTimestamp(input.ReceivedDate),
+			ReceivedDate:      receivedDate,
 			PrimaryDocumentId: input.PrimaryDocumentID,
 			AnnexIds:          stringSliceValue(input.AnnexIds),
 			Priority:          priorityToProto(input.Priority),
 			IdempotencyKey:    input.IdempotencyKey,
+
+			AcknowledgeDuplicates: boolPtrValue(input.AcknowledgeDuplicates),
+			BackdateReason:        stringPtrValue(input.BackdateReason),
 		},
 	}
 
 	// Call gRPC service
 	resp, err := r.DartaClient.CreateDarta(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, mapGRPCError(ctx, err)
 	}
 
 	// Convert proto response to GraphQL
-	return protoToDarta(resp.Darta), nil
+	darta := protoToDarta(resp.Darta)
+	darta.LoadedDuplicates = protoToDuplicateCandidates(resp.SuspectedDuplicates)
+	return darta, nil
 }
 
 // SubmitDartaForReview is the resolver for the submitDartaForReview field.
-func (r *mutationResolver) SubmitDartaForReview(ctx context.Context, dartaID string) (*model.Darta, error) {
+func (r *mutationResolver) SubmitDartaForReview(ctx context.Context, dartaID string, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &dartav1.SubmitDartaForReviewRequest{
-		DartaId: dartaID,
+		DartaId:         dartaID,
+		ExpectedVersion: version,
 	}
 
 	resp, err := r.DartaClient.SubmitDartaForReview(ctx, req)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	return protoToDarta(resp.Darta), nil
+}
+
+// ReviewDarta is the resolver for the reviewDarta field.
+func (r *mutationResolver) ReviewDarta(ctx context.Context, input model.ReviewDartaInput) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", input.DartaID); err != nil {
+		return nil, err
+	}
+	if input.Decision == model.DartaReviewDecisionEditRequired && strings.TrimSpace(stringPtrValue(input.RequestedInfo)) == "" {
+		return nil, validationError(ctx, "requestedInfo", "is required when edits are requested")
+	}
+	version, err := expectedVersionArg(ctx, "input.expectedVersion", input.ExpectedVersion)
 	if err != nil {
 		return nil, err
 	}
 
+	req := &dartav1.ReviewDartaRequest{
+		Input: &dartav1.ReviewDartaInput{
+			DartaId:         input.DartaID,
+			Decision:        reviewDecisionToProto(input.Decision),
+			Notes:           stringPtrValue(input.Notes),
+			RequestedInfo:   stringPtrValue(input.RequestedInfo),
+			ExpectedVersion: version,
+		},
+	}
+
+	resp, err := r.DartaClient.ReviewDarta(ctx, req)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
 	return protoToDarta(resp.Darta), nil
 }
 
+// ApproveDartaReview is the resolver for the approveDartaReview field.
+func (r *mutationResolver) ApproveDartaReview(ctx context.Context, dartaID string, notes *string, expectedVersion *int) (*model.Darta, error) {
+	return r.ReviewDarta(ctx, model.ReviewDartaInput{
+		DartaID:         dartaID,
+		Decision:        model.DartaReviewDecisionApproveReview,
+		Notes:           notes,
+		ExpectedVersion: expectedVersion,
+	})
+}
+
 // ClassifyDarta is the resolver for the classifyDarta field.
-func (r *mutationResolver) ClassifyDarta(ctx context.Context, dartaID string, classificationCode string) (*model.Darta, error) {
+func (r *mutationResolver) ClassifyDarta(ctx context.Context, dartaID string, classificationCode string, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	if err := requireText(ctx, "classificationCode", classificationCode); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &dartav1.ClassifyDartaRequest{
 		DartaId:            dartaID,
 		ClassificationCode: classificationCode,
+		ExpectedVersion:    version,
 	}
 
 	resp, err := r.DartaClient.ClassifyDarta(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, mapGRPCError(ctx, err)
 	}
 
 	return protoToDarta(resp.Darta), nil
 }
 
 // ReserveDartaNumber is the resolver for the reserveDartaNumber field.
-func (r *mutationResolver) ReserveDartaNumber(ctx context.Context, dartaID string) (*model.Darta, error) {
+func (r *mutationResolver) ReserveDartaNumber(ctx context.Context, dartaID string, acknowledgeDuplicates *bool, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &dartav1.ReserveDartaNumberRequest{
-		DartaId: dartaID,
+		DartaId:               dartaID,
+		AcknowledgeDuplicates: boolPtrValue(acknowledgeDuplicates),
+		ExpectedVersion:       version,
 	}
 
 	resp, err := r.DartaClient.ReserveDartaNumber(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, mapGRPCError(ctx, err)
 	}
 
-	return protoToDarta(resp.Darta), nil
+	darta := protoToDarta(resp.Darta)
+	darta.LoadedDuplicates = protoToDuplicateCandidates(resp.SuspectedDuplicates)
+	return darta, nil
 }
 
 // FinalizeDartaRegistration is the resolver for the finalizeDartaRegistration field.
-func (r *mutationResolver) FinalizeDartaRegistration(ctx context.Context, dartaID string) (*model.Darta, error) {
+func (r *mutationResolver) FinalizeDartaRegistration(ctx context.Context, dartaID string, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &dartav1.FinalizeDartaRegistrationRequest{
-		DartaId: dartaID,
+		DartaId:         dartaID,
+		ExpectedVersion: version,
 	}
 
 	resp, err := r.DartaClient.FinalizeDartaRegistration(ctx, req)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	return protoToDarta(resp.Darta), nil
+}
+
+// DirectRegisterDarta is the resolver for the directRegisterDarta field.
+func (r *mutationResolver) DirectRegisterDarta(ctx context.Context, dartaID string, acknowledgeDuplicates *bool, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.DartaClient.DirectRegisterDarta(ctx, &dartav1.DirectRegisterDartaRequest{
+		DartaId:               dartaID,
+		AcknowledgeDuplicates: boolPtrValue(acknowledgeDuplicates),
+		ExpectedVersion:       version,
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	return protoToDarta(resp.Darta), nil
+}
+
+// VoidDarta is the resolver for the voidDarta field.
+func (r *mutationResolver) VoidDarta(ctx context.Context, dartaID string, reason string, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	if err := requireText(ctx, "reason", reason); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &dartav1.VoidDartaRequest{
+		DartaId:         dartaID,
+		Reason:          reason,
+		ExpectedVersion: version,
+	}
+
+	resp, err := r.DartaClient.VoidDarta(ctx, req)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	return protoToDarta(resp.Darta), nil
+}
+
+// LinkDuplicateDarta is the resolver for the linkDuplicateDarta field.
+func (r *mutationResolver) LinkDuplicateDarta(ctx context.Context, dartaID string, duplicateOfID string, notes *string, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	if err := requireID(ctx, "duplicateOfId", duplicateOfID); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
 	if err != nil {
 		return nil, err
 	}
 
+	resp, err := r.DartaClient.LinkDuplicateDarta(ctx, &dartav1.LinkDuplicateDartaRequest{
+		DartaId:         dartaID,
+		DuplicateOfId:   duplicateOfID,
+		Notes:           stringPtrValue(notes),
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	return protoToDarta(resp.Darta), nil
+}
+
+// ScanDarta is the resolver for the scanDarta field.
+func (r *mutationResolver) ScanDarta(ctx context.Context, dartaID string, scanAttachmentID string, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	if err := requireID(ctx, "scanAttachmentId", scanAttachmentID); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.DartaClient.ScanDarta(ctx, &dartav1.ScanDartaRequest{
+		DartaId:          dartaID,
+		ScanAttachmentId: scanAttachmentID,
+		ExpectedVersion:  version,
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	return protoToDarta(resp.Darta), nil
+}
+
+// EnrichDartaMetadata is the resolver for the enrichDartaMetadata field.
+func (r *mutationResolver) EnrichDartaMetadata(ctx context.Context, dartaID string, metadata map[string]any, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	if len(metadata) == 0 {
+		return nil, validationError(ctx, "metadata", "must not be empty")
+	}
+	fields, err := structpb.NewStruct(metadata)
+	if err != nil {
+		return nil, validationError(ctx, "metadata", err.Error())
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.DartaClient.EnrichDartaMetadata(ctx, &dartav1.EnrichDartaMetadataRequest{
+		DartaId:         dartaID,
+		Metadata:        fields,
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	return protoToDarta(resp.Darta), nil
+}
+
+// ArchiveDartaDigital is the resolver for the archiveDartaDigital field.
+func (r *mutationResolver) ArchiveDartaDigital(ctx context.Context, dartaID string, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.DartaClient.FinalizeDartaArchive(ctx, &dartav1.FinalizeDartaArchiveRequest{
+		DartaId:         dartaID,
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
 	return protoToDarta(resp.Darta), nil
 }
 
 // RouteDarta is the resolver for the routeDarta field.
 func (r *mutationResolver) RouteDarta(ctx context.Context, input model.RouteDartaInput) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", input.DartaID); err != nil {
+		return nil, err
+	}
+	if stringPtrValue(input.OrganizationalUnitID) == "" && stringPtrValue(input.AssigneeID) == "" {
+		return nil, validationError(ctx, "organizationalUnitId", "an organizational unit or assignee is required")
+	}
+	if input.SLAHours != nil && *input.SLAHours < 0 {
+		return nil, validationError(ctx, "slaHours", "must not be negative")
+	}
+	version, err := expectedVersionArg(ctx, "input.expectedVersion", input.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &dartav1.RouteDartaRequest{
 		Input: &dartav1.RouteDartaInput{
 			DartaId:              input.DartaID,
@@ -115,43 +535,278 @@ func (r *mutationResolver) RouteDarta(ctx context.Context, input model.RouteDart
 			Priority:             priorityPtrToProto(input.Priority),
 			SlaHours:             int32PtrValue(input.SLAHours),
 			Notes:                stringPtrValue(input.Notes),
+			ExpectedVersion:      version,
 		},
 	}
 
 	resp, err := r.DartaClient.RouteDarta(ctx, req)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	return protoToDarta(resp.Darta), nil
+}
+
+// AssignDartaSection is the resolver for the assignDartaSection field.
+func (r *mutationResolver) AssignDartaSection(ctx context.Context, input model.AssignDartaSectionInput) (*model.Darta, error) {
+	if err := requireText(ctx, "sectionId", input.SectionID); err != nil {
+		return nil, err
+	}
+
+	return r.RouteDarta(ctx, model.RouteDartaInput{
+		DartaID:              input.DartaID,
+		OrganizationalUnitID: &input.SectionID,
+		AssigneeID:           input.AssigneeID,
+		Priority:             input.Priority,
+		SLAHours:             input.SLAHours,
+		Notes:                input.Notes,
+		ExpectedVersion:      input.ExpectedVersion,
+	})
+}
+
+// SectionReviewDarta is the resolver for the sectionReviewDarta field.
+func (r *mutationResolver) SectionReviewDarta(ctx context.Context, dartaID string, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
 	if err != nil {
 		return nil, err
 	}
 
+	resp, err := r.DartaClient.SectionReviewDarta(ctx, &dartav1.SectionReviewDartaRequest{
+		DartaId:         dartaID,
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
 	return protoToDarta(resp.Darta), nil
 }
 
-// CloseDarta is the resolver for the closeDarta field.
-func (r *mutationResolver) CloseDarta(ctx context.Context, dartaID string) (*model.Darta, error) {
-	req := &dartav1.CloseDartaRequest{
-		DartaId: dartaID,
+// RequestDartaClarification is the resolver for the requestDartaClarification field.
+func (r *mutationResolver) RequestDartaClarification(ctx context.Context, dartaID string, note string, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	if err := requireText(ctx, "note", note); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := r.DartaClient.CloseDarta(ctx, req)
+	resp, err := r.DartaClient.RequestDartaClarification(ctx, &dartav1.RequestDartaClarificationRequest{
+		DartaId:         dartaID,
+		Note:            note,
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	return protoToDarta(resp.Darta), nil
+}
+
+// ProvideDartaClarification is the resolver for the provideDartaClarification field.
+func (r *mutationResolver) ProvideDartaClarification(ctx context.Context, dartaID string, note string, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	if err := requireText(ctx, "note", note); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
 	if err != nil {
 		return nil, err
 	}
 
+	resp, err := r.DartaClient.ProvideDartaClarification(ctx, &dartav1.ProvideDartaClarificationRequest{
+		DartaId:         dartaID,
+		Note:            note,
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
 	return protoToDarta(resp.Darta), nil
 }
 
-// VoidDarta is the resolver for the voidDarta field.
-func (r *mutationResolver) VoidDarta(ctx context.Context, dartaID string, reason string) (*model.Darta, error) {
-	req := &dartav1.VoidDartaRequest{
-		DartaId: dartaID,
-		Reason:  reason,
+// AcceptDarta is the resolver for the acceptDarta field.
+func (r *mutationResolver) AcceptDarta(ctx context.Context, dartaID string, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := r.DartaClient.VoidDarta(ctx, req)
+	resp, err := r.DartaClient.AcceptDarta(ctx, &dartav1.AcceptDartaRequest{
+		DartaId:         dartaID,
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	return protoToDarta(resp.Darta), nil
+}
+
+// MarkDartaAction is the resolver for the markDartaAction field.
+func (r *mutationResolver) MarkDartaAction(ctx context.Context, dartaID string, actionNote string, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	if err := requireText(ctx, "actionNote", actionNote); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.DartaClient.MarkDartaAction(ctx, &dartav1.MarkDartaActionRequest{
+		DartaId:         dartaID,
+		ActionNote:      actionNote,
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	return protoToDarta(resp.Darta), nil
+}
+
+// IssueDartaResponse is the resolver for the issueDartaResponse field.
+func (r *mutationResolver) IssueDartaResponse(ctx context.Context, input model.IssueDartaResponseInput) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", input.DartaID); err != nil {
+		return nil, err
+	}
+	if err := optionalID(ctx, "responseChalaniId", input.ResponseChalaniID); err != nil {
+		return nil, err
+	}
+	if err := optionalID(ctx, "docAttachmentId", input.DocAttachmentID); err != nil {
+		return nil, err
+	}
+	if stringPtrValue(input.ResponseChalaniID) == "" && stringPtrValue(input.DocAttachmentID) == "" {
+		return nil, validationError(ctx, "responseChalaniId", "a response chalani or document is required")
+	}
+	version, err := expectedVersionArg(ctx, "input.expectedVersion", input.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.DartaClient.IssueDartaResponse(ctx, &dartav1.IssueDartaResponseRequest{
+		DartaId:           input.DartaID,
+		ResponseChalaniId: stringPtrValue(input.ResponseChalaniID),
+		DocAttachmentId:   stringPtrValue(input.DocAttachmentID),
+		ExpectedVersion:   version,
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	return protoToDarta(resp.Darta), nil
+}
+
+// RequestDartaAck is the resolver for the requestDartaAck field.
+func (r *mutationResolver) RequestDartaAck(ctx context.Context, dartaID string, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.DartaClient.RequestDartaAck(ctx, &dartav1.RequestDartaAckRequest{
+		DartaId:         dartaID,
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	return protoToDarta(resp.Darta), nil
+}
+
+// ReceiveDartaAck is the resolver for the receiveDartaAck field.
+func (r *mutationResolver) ReceiveDartaAck(ctx context.Context, dartaID string, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.DartaClient.ReceiveDartaAck(ctx, &dartav1.ReceiveDartaAckRequest{
+		DartaId:         dartaID,
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	return protoToDarta(resp.Darta), nil
+}
+
+// SupersedeDartaRecord is the resolver for the supersedeDartaRecord field.
+func (r *mutationResolver) SupersedeDartaRecord(ctx context.Context, dartaID string, newDartaID string, reason string, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	if err := requireID(ctx, "newDartaId", newDartaID); err != nil {
+		return nil, err
+	}
+	if newDartaID == dartaID {
+		return nil, validationError(ctx, "newDartaId", "must differ from dartaId")
+	}
+	if err := requireText(ctx, "reason", reason); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.DartaClient.SupersedeDartaRecord(ctx, &dartav1.SupersedeDartaRecordRequest{
+		DartaId:         dartaID,
+		NewDartaId:      newDartaID,
+		Reason:          reason,
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	return protoToDarta(resp.Darta), nil
+}
+
+// CloseDarta is the resolver for the closeDarta field.
+func (r *mutationResolver) CloseDarta(ctx context.Context, dartaID string, expectedVersion *int) (*model.Darta, error) {
+	if err := requireID(ctx, "dartaId", dartaID); err != nil {
+		return nil, err
+	}
+	version, err := expectedVersionArg(ctx, "expectedVersion", expectedVersion)
 	if err != nil {
 		return nil, err
 	}
 
+	req := &dartav1.CloseDartaRequest{
+		DartaId:         dartaID,
+		ExpectedVersion: version,
+	}
+
+	resp, err := r.DartaClient.CloseDarta(ctx, req)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
 	return protoToDarta(resp.Darta), nil
 }
 
@@ -161,7 +816,7 @@ func (r *queryResolver) Health(ctx context.Context) (*model.HealthStatus, error)
 
 	resp, err := r.DartaClient.HealthCheck(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, mapGRPCError(ctx, err)
 	}
 
 	return &model.HealthStatus{
@@ -173,14 +828,43 @@ func (r *queryResolver) Health(ctx context.Context) (*model.HealthStatus, error)
 
 // Darta is the resolver for the darta field.
 func (r *queryResolver) Darta(ctx context.Context, id string) (*model.Darta, error) {
+	if err := requireID(ctx, "id", id); err != nil {
+		return nil, err
+	}
+
 	req := &dartav1.GetDartaRequest{
 		Id: id,
 	}
 
 	resp, err := r.DartaClient.GetDarta(ctx, req)
 	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+
+	return protoToDarta(resp.Darta), nil
+}
+
+// DartaByNumber is the resolver for the dartaByNumber field.
+func (r *queryResolver) DartaByNumber(ctx context.Context, dartaNumber int, fiscalYearID string, scope model.Scope, wardID *string) (*model.Darta, error) {
+	if dartaNumber <= 0 {
+		return nil, validationError(ctx, "dartaNumber", "must be positive")
+	}
+	if err := requireText(ctx, "fiscalYearId", fiscalYearID); err != nil {
 		return nil, err
 	}
+	if scope == model.ScopeWard && stringPtrValue(wardID) == "" {
+		return nil, validationError(ctx, "wardId", "is required when scope is WARD")
+	}
+
+	resp, err := r.DartaClient.GetDartaByNumber(ctx, &dartav1.GetDartaByNumberRequest{
+		DartaNumber:  int32(dartaNumber),
+		FiscalYearId: fiscalYearID,
+		Scope:        scopeToProto(scope),
+		WardId:       stringPtrValue(wardID),
+	})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
 
 	return protoToDarta(resp.Darta), nil
 }
@@ -194,7 +878,7 @@ func (r *queryResolver) Dartas(ctx context.Context, filter *model.DartaFilterInp
 
 	resp, err := r.DartaClient.ListDartas(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, mapGRPCError(ctx, err)
 	}
 
 	// Convert response
@@ -207,12 +891,8 @@ func (r *queryResolver) Dartas(ctx context.Context, filter *model.DartaFilterInp
 	}
 
 	return &model.DartaConnection{
-		Edges: edges,
-		PageInfo: &model.PageInfo{
-			HasNextPage:     resp.Connection.PageInfo.HasNextPage,
-			HasPreviousPage: resp.Connection.PageInfo.HasPreviousPage,
-			TotalCount:      int(resp.Connection.PageInfo.TotalCount),
-		},
+		Edges:    edges,
+		PageInfo: protoToPageInfo(resp.Connection.PageInfo),
 	}, nil
 }
 
@@ -225,7 +905,7 @@ func (r *queryResolver) MyDartas(ctx context.Context, status *model.DartaStatus,
 
 	resp, err := r.DartaClient.GetMyDartas(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, mapGRPCError(ctx, err)
 	}
 
 	edges := make([]*model.DartaEdge, len(resp.Connection.Edges))
@@ -237,26 +917,46 @@ func (r *queryResolver) MyDartas(ctx context.Context, status *model.DartaStatus,
 	}
 
 	return &model.DartaConnection{
-		Edges: edges,
-		PageInfo: &model.PageInfo{
-			HasNextPage:     resp.Connection.PageInfo.HasNextPage,
-			HasPreviousPage: resp.Connection.PageInfo.HasPreviousPage,
-			TotalCount:      int(resp.Connection.PageInfo.TotalCount),
-		},
+		Edges:    edges,
+		PageInfo: protoToPageInfo(resp.Connection.PageInfo),
 	}, nil
 }
 
+// Chalani is the resolver for the chalani field.
+func (r *queryResolver) Chalani(ctx context.Context, id string) (*model.Chalani, error) {
+	if err := requireID(ctx, "id", id); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.ChalaniClient.GetChalani(ctx, &dartav1.GetChalaniRequest{Id: id})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	return protoToChalani(resp.Chalani), nil
+}
+
 // DartaStats is the resolver for the dartaStats field.
-func (r *queryResolver) DartaStats(ctx context.Context, scope *model.Scope, fiscalYearID *string, wardID *string) (*model.DartaStats, error) {
+func (r *queryResolver) DartaStats(ctx context.Context, scope *model.Scope, fiscalYearID *string, wardID *string, fromDate *string, toDate *string, interval *model.StatsInterval) (*model.DartaStats, error) {
+	from, err := parseStatsDate(fromDate)
+	if err != nil {
+		return nil, validationError(ctx, "fromDate", err.Error())
+	}
+	to, err := parseStatsDate(toDate)
+	if err != nil {
+		return nil, validationError(ctx, "toDate", err.Error())
+	}
 	req := &dartav1.GetDartaStatsRequest{
 		Scope:        scopePtrToProto(scope),
 		FiscalYearId: stringPtrValue(fiscalYearID),
 		WardId:       stringPtrValue(wardID),
+		FromDate:     from,
+		ToDate:       to,
+		Interval:     statsIntervalToProto(interval),
 	}
 
 	resp, err := r.DartaClient.GetDartaStats(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, mapGRPCError(ctx, err)
 	}
 
 	byStatus := make([]*model.DartaStatusCount, len(resp.Stats.ByStatus))
@@ -276,122 +976,205 @@ func (r *queryResolver) DartaStats(ctx context.Context, scope *model.Scope, fisc
 	}
 
 	return &model.DartaStats{
-		Total:        int(resp.Stats.Total),
-		ByStatus:     byStatus,
-		ByChannel:    byChannel,
-		OverdueCount: int(resp.Stats.OverdueCount),
+		Total:                  int(resp.Stats.Total),
+		ByStatus:               byStatus,
+		ByChannel:              byChannel,
+		OverdueCount:           int(resp.Stats.OverdueCount),
+		AvgProcessingTimeHours: resp.Stats.AvgProcessingTimeHours,
+		ReceivedToRegistered:   protoToDurationStats(resp.Stats.ReceivedToRegistered),
+		RegisteredToClosed:     protoToDurationStats(resp.Stats.RegisteredToClosed),
+		ByWard:                 protoToStatsBreakdowns(resp.Stats.ByWard),
+		BySection:              protoToStatsBreakdowns(resp.Stats.BySection),
+		ByIntakeChannel:        protoToStatsBreakdowns(resp.Stats.ByIntakeChannel),
+		ByPriority:             protoToStatsBreakdowns(resp.Stats.ByPriority),
+		Series:                 protoToStatsBuckets(resp.Stats.Series),
 	}, nil
 }
 
-// Mutation returns MutationResolver implementation.
-func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+// Search is the resolver for the search field.
+func (r *queryResolver) Search(ctx context.Context, query string, entityTypes []model.SearchEntityType, filter *model.SearchFilterInput, first *int, after *string) (*model.SearchResult, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, validationError(ctx, "query", "query is required")
+	}
+	if first != nil && *first < 0 {
+		return nil, validationError(ctx, "first", "first must not be negative")
+	}
 
-// Query returns QueryResolver implementation.
-func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+	req := &dartav1.SearchRecordsRequest{
+		Query:       query,
+		EntityTypes: make([]string, len(entityTypes)),
+		Filter:      buildSearchFilter(filter),
+		Limit:       int32PtrValue(first),
+		After:       stringPtrValue(after),
+	}
+	for i, t := range entityTypes {
+		req.EntityTypes[i] = string(t)
+	}
 
-type mutationResolver struct{ *Resolver }
-type queryResolver struct{ *Resolver }
+	resp, err := r.DartaClient.SearchRecords(ctx, req)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	return protoToSearchResult(resp), nil
+}
 
-// Helper functions for conversion
-func protoToDarta(d *dartav1.Darta) *model.Darta {
-	if d == nil {
-		return nil
-	}
-
-	darta := &model.Darta{
-		ID:             d.Id,
-		FiscalYearID:   d.FiscalYear.Id,
-		Scope:          protoToScope(d.Scope),
-		Subject:        d.Subject,
-		IntakeChannel:  protoToIntakeChannel(d.IntakeChannel),
-		ReceivedDate:   d.ReceivedDate.AsTime().Format("2006-01-02T15:04:05Z07:00"),
-		EntryDate:      d.EntryDate.AsTime().Format("2006-01-02T15:04:05Z07:00"),
-		Status:         protoToDartaStatus(d.Status),
-		Priority:       protoToPriority(d.Priority),
-		CreatedBy:      d.CreatedBy.Id,
-		CreatedAt:      d.CreatedAt.AsTime().Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:      d.UpdatedAt.AsTime().Format("2006-01-02T15:04:05Z07:00"),
-		TenantID:       d.TenantId,
-	}
-
-	if d.DartaNumber > 0 {
-		num := int(d.DartaNumber)
-		darta.DartaNumber = &num
-	}
-	if d.FormattedDartaNumber != "" {
-		darta.FormattedDartaNumber = &d.FormattedDartaNumber
-	}
-	if d.Ward != nil {
-		darta.WardID = &d.Ward.Id
-	}
-	if d.Applicant != nil {
-		darta.Applicant = &model.Applicant{
-			ID:       d.Applicant.Id,
-			Type:     protoToApplicantType(d.Applicant.Type),
-			FullName: d.Applicant.FullName,
-		}
-		if d.Applicant.Organization != "" {
-			darta.Applicant.Organization = &d.Applicant.Organization
-		}
+// RegisterExport is the resolver for the registerExport field.
+func (r *queryResolver) RegisterExport(ctx context.Context, book model.RegisterBook, fiscalYearID string, scope model.Scope, wardID *string, format model.RegisterFormat) (*model.RegisterExport, error) {
+	req := &dartav1.ExportRegisterRequest{
+		Book:         registerBookToProto(book),
+		FiscalYearId: fiscalYearID,
+		Scope:        scopeToProto(scope),
+		WardId:       stringPtrValue(wardID),
+		Format:       registerFormatToProto(format),
+	}
+	if req.Scope == dartav1.Scope_SCOPE_WARD && req.WardId == "" {
+		return nil, validationError(ctx, "wardId", "wardId is required for the ward scope")
 	}
 
-	return darta
+	resp, err := r.DartaClient.ExportRegister(ctx, req)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	return protoToRegisterExport(resp), nil
 }
 
-func parseTimestamp(s string) *timestamppb.Timestamp {
-	// For now, return current timestamp
-	// TODO: Parse the string properly
-	return timestamppb.Now()
-}
+// AuditEntries is the resolver for the auditEntries field.
+func (r *queryResolver) AuditEntries(ctx context.Context, filter *model.AuditEntryFilterInput, pagination *model.PaginationInput) (*model.AuditEntryConnection, error) {
+	req := &dartav1.ListAuditEntriesRequest{
+		Filter:     &dartav1.AuditEntryFilter{},
+		Pagination: buildPagination(pagination),
+	}
+	if filter != nil {
+		from, err := parseStatsDate(filter.From)
+		if err != nil {
+			return nil, validationError(ctx, "filter.from", err.Error())
+		}
+		to, err := parseStatsDate(filter.To)
+		if err != nil {
+			return nil, validationError(ctx, "filter.to", err.Error())
+		}
+		req.Filter = &dartav1.AuditEntryFilter{
+			EntityType:  stringPtrValue(filter.EntityType),
+			EntityId:    stringPtrValue(filter.EntityID),
+			PerformedBy: stringPtrValue(filter.PerformedBy),
+			Actions:     filter.Actions,
+			Category:    stringPtrValue(filter.Category),
+			From:        from,
+			To:          to,
+			TenantId:    stringPtrValue(filter.TenantID),
+		}
+	}
 
-func stringPtrValue(s *string) string {
-	if s == nil {
-		return ""
+	resp, err := r.DartaClient.ListAuditEntries(ctx, req)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
 	}
-	return *s
-}
 
-func stringSliceValue(s []string) []string {
-	if s == nil {
-		return []string{}
+	conn := resp.Connection
+	entries := make([]*dartav1.AuditEntry, len(conn.Edges))
+	for i, e := range conn.Edges {
+		entries[i] = e.Node
+	}
+	nodes, err := r.auditEntriesWithPerformers(ctx, entries)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
 	}
-	return s
+	edges := make([]*model.AuditEntryEdge, len(conn.Edges))
+	for i, e := range conn.Edges {
+		edges[i] = &model.AuditEntryEdge{Cursor: e.Cursor, Node: nodes[i]}
+	}
+	return &model.AuditEntryConnection{
+		Edges:    edges,
+		PageInfo: protoToPageInfo(conn.PageInfo),
+	}, nil
 }
 
-func int32PtrValue(i *int) int32 {
-	if i == nil {
-		return 0
+// AuditChainVerification is the resolver for the auditChainVerification field.
+func (r *queryResolver) AuditChainVerification(ctx context.Context) (*model.AuditChainVerification, error) {
+	resp, err := r.DartaClient.VerifyAuditChain(ctx, &dartav1.VerifyAuditChainRequest{})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
 	}
-	return int32(*i)
+	return protoToAuditChainVerification(resp), nil
 }
 
-func buildDartaFilter(filter *model.DartaFilterInput) *dartav1.DartaFilterInput {
-	if filter == nil {
-		return &dartav1.DartaFilterInput{}
+// AuditAnchors is the resolver for the auditAnchors field.
+func (r *queryResolver) AuditAnchors(ctx context.Context, from *string, to *string) ([]*model.AuditAnchor, error) {
+	fromTs, err := parseStatsDate(from)
+	if err != nil {
+		return nil, validationError(ctx, "from", err.Error())
+	}
+	toTs, err := parseStatsDate(to)
+	if err != nil {
+		return nil, validationError(ctx, "to", err.Error())
 	}
 
-	return &dartav1.DartaFilterInput{
-		FiscalYearId:         stringPtrValue(filter.FiscalYearID),
-		Scope:                scopePtrToProto(filter.Scope),
-		WardId:               stringPtrValue(filter.WardID),
-		Status:               dartaStatusPtrToProto(filter.Status),
-		Priority:             priorityPtrToProto(filter.Priority),
-		OrganizationalUnitId: stringPtrValue(filter.OrganizationalUnitID),
-		AssigneeId:           stringPtrValue(filter.AssigneeID),
-		IntakeChannel:        intakeChannelPtrToProto(filter.IntakeChannel),
-		Search:               stringPtrValue(filter.Search),
+	resp, err := r.DartaClient.ListAuditAnchors(ctx, &dartav1.ListAuditAnchorsRequest{From: fromTs, To: toTs})
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
 	}
+	anchors := make([]*model.AuditAnchor, len(resp.Anchors))
+	for i, a := range resp.Anchors {
+		anchors[i] = protoToAuditAnchor(a)
+	}
+	return anchors, nil
 }
 
-func buildPagination(p *model.PaginationInput) *dartav1.PaginationInput {
-	if p == nil {
-		return &dartav1.PaginationInput{
-			Limit: 10,
-		}
+// Darta is the resolver for the darta field.
+func (r *searchHitResolver) Darta(ctx context.Context, obj *model.SearchHit) (*model.Darta, error) {
+	if obj.EntityType != model.SearchEntityTypeDarta {
+		return nil, nil
+	}
+	d, err := r.loadersFor(ctx).Dartas.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	if d == nil {
+		return nil, nil
 	}
+	return protoToDarta(d), nil
+}
 
-	return &dartav1.PaginationInput{
-		Limit:  int32PtrValue(p.Limit),
-		Offset: int32PtrValue(p.Offset),
+// DartaUpdated is the resolver for the dartaUpdated field.
+func (r *subscriptionResolver) DartaUpdated(ctx context.Context, id string) (<-chan *model.DartaEvent, error) {
+	if err := requireID(ctx, "id", id); err != nil {
+		return nil, err
 	}
+
+	return r.watchDartas(ctx, &dartav1.WatchDartasRequest{DartaId: id})
+}
+
+// MyQueueChanged is the resolver for the myQueueChanged field.
+func (r *subscriptionResolver) MyQueueChanged(ctx context.Context) (<-chan *model.DartaEvent, error) {
+	return r.watchDartas(ctx, &dartav1.WatchDartasRequest{AssignedToMe: true})
+}
+
+// ChalaniDispatchUpdated is the resolver for the chalaniDispatchUpdated field.
+func (r *subscriptionResolver) ChalaniDispatchUpdated(ctx context.Context) (<-chan *model.ChalaniDispatchEvent, error) {
+	return r.watchChalanis(ctx, &dartav1.WatchChalanisRequest{DispatchOnly: true})
 }
+
+// Chalani returns ChalaniResolver implementation.
+func (r *Resolver) Chalani() ChalaniResolver { return &chalaniResolver{r} }
+
+// Darta returns DartaResolver implementation.
+func (r *Resolver) Darta() DartaResolver { return &dartaResolver{r} }
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// SearchHit returns SearchHitResolver implementation.
+func (r *Resolver) SearchHit() SearchHitResolver { return &searchHitResolver{r} }
+
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
+type chalaniResolver struct{ *Resolver }
+type dartaResolver struct{ *Resolver }
+type mutationResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type searchHitResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
@@ -0,0 +1,95 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"git.ninjainfosys.com/ePalika/graphql-gateway/graph/model"
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+)
+
+// awaitWatch waits for darta-chalani to accept a watch. The server sends
+// headers once the subscriber is authorised, so a rejected watch surfaces
+// here as an error instead of a silently closed channel.
+func awaitWatch(ctx context.Context, stream grpc.ClientStream) error {
+	md, err := stream.Header()
+	if err == nil && md == nil {
+		err = stream.RecvMsg(new(emptypb.Empty))
+	}
+	if err != nil {
+		return mapGRPCError(ctx, err)
+	}
+	return nil
+}
+
+// watchDartas relays a WatchDartas stream to a subscription channel until the
+// client unsubscribes or the stream ends
+func (r *Resolver) watchDartas(ctx context.Context, req *dartav1.WatchDartasRequest) (<-chan *model.DartaEvent, error) {
+	stream, err := r.DartaClient.WatchDartas(ctx, req)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	if err := awaitWatch(ctx, stream); err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *model.DartaEvent, 1)
+	go func() {
+		defer close(ch)
+		for {
+			ev, err := stream.Recv()
+			if err != nil {
+				logWatchEnd(ctx, "darta", err)
+				return
+			}
+			select {
+			case ch <- protoToDartaEvent(ev):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// watchChalanis relays a WatchChalanis stream to a subscription channel until
+// the client unsubscribes or the stream ends
+func (r *Resolver) watchChalanis(ctx context.Context, req *dartav1.WatchChalanisRequest) (<-chan *model.ChalaniDispatchEvent, error) {
+	stream, err := r.ChalaniClient.WatchChalanis(ctx, req)
+	if err != nil {
+		return nil, mapGRPCError(ctx, err)
+	}
+	if err := awaitWatch(ctx, stream); err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *model.ChalaniDispatchEvent, 1)
+	go func() {
+		defer close(ch)
+		for {
+			ev, err := stream.Recv()
+			if err != nil {
+				logWatchEnd(ctx, "chalani", err)
+				return
+			}
+			select {
+			case ch <- protoToChalaniDispatchEvent(ev):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+func logWatchEnd(ctx context.Context, kind string, err error) {
+	if ctx.Err() != nil || errors.Is(err, io.EOF) {
+		return
+	}
+	log.Printf("%s watch ended: %v", kind, err)
+}
@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Headers injected by Oathkeeper on authenticated requests
+const (
+	HeaderUserID      = "X-User-ID"
+	HeaderUserName    = "X-User-Name"
+	HeaderTenant      = "X-Tenant"
+	HeaderRoles       = "X-Roles"
+	HeaderRequestID   = "X-Request-ID"
+	HeaderTraceParent = "Traceparent"
+	HeaderTraceState  = "Tracestate"
+	HeaderForwarded   = "X-Forwarded-For"
+	HeaderUserAgent   = "User-Agent"
+	// HeaderActingRole names the role the caller is acting in, when they
+	// hold several; roles they do not hold are ignored
+	HeaderActingRole = "X-Acting-Role"
+	// HeaderIdempotencyKey makes the mutations of a request safe to retry:
+	// a retry with the same key returns the first response
+	HeaderIdempotencyKey = "Idempotency-Key"
+)
+
+// RequestContext is the caller identity and tracing information for a single
+// GraphQL request
+type RequestContext struct {
+	UserID      string
+	UserName    string
+	Tenant      string
+	Roles       []string
+	RequestID   string
+	TraceParent string
+	TraceState  string
+	ClientIP    string
+	UserAgent   string
+	ActingRole  string
+
+	IdempotencyKey string
+}
+
+type contextKey struct{}
+
+// WithRequestContext stores rc in ctx
+func WithRequestContext(ctx context.Context, rc *RequestContext) context.Context {
+	return context.WithValue(ctx, contextKey{}, rc)
+}
+
+// FromContext returns the request context stored in ctx, or nil
+func FromContext(ctx context.Context) *RequestContext {
+	rc, _ := ctx.Value(contextKey{}).(*RequestContext)
+	return rc
+}
+
+// Decision is the PDP decision that allowed a field to resolve, recorded by
+// the backend in the audit entries the field writes
+type Decision struct {
+	ID   string
+	Role string // The role checked, when the field requires one
+}
+
+type decisionKey struct{}
+
+// WithDecision stores the decision that allowed the field resolving in ctx
+func WithDecision(ctx context.Context, d *Decision) context.Context {
+	return context.WithValue(ctx, decisionKey{}, d)
+}
+
+// DecisionFromContext returns the decision stored in ctx, or nil
+func DecisionFromContext(ctx context.Context) *Decision {
+	d, _ := ctx.Value(decisionKey{}).(*Decision)
+	return d
+}
+
+// HasRole reports whether the caller holds role
+func (rc *RequestContext) HasRole(role string) bool {
+	if rc == nil {
+		return false
+	}
+	for _, r := range rc.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// unauthenticatedBody is the GraphQL response to a request that did not
+// come through Oathkeeper with a user and tenant
+const unauthenticatedBody = `{"errors":[{"message":"authentication required","extensions":{"code":"UNAUTHENTICATED"}}]}`
+
+// Middleware captures the Oathkeeper headers into the request context so the
+// gRPC clients can forward them. A request ID is generated when none is given.
+// Requests without a user or tenant are rejected, so nothing reaches the
+// backends on behalf of an unknown caller.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rc := FromRequest(r)
+		w.Header().Set(HeaderRequestID, rc.RequestID)
+		if rc.UserID == "" || rc.Tenant == "" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(unauthenticatedBody))
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(WithRequestContext(r.Context(), rc)))
+	})
+}
+
+// FromRequest reads the request context from the headers of r
+func FromRequest(r *http.Request) *RequestContext {
+	rc := &RequestContext{
+		UserID:      strings.TrimSpace(r.Header.Get(HeaderUserID)),
+		UserName:    strings.TrimSpace(r.Header.Get(HeaderUserName)),
+		Tenant:      strings.TrimSpace(r.Header.Get(HeaderTenant)),
+		Roles:       splitRoles(r.Header.Get(HeaderRoles)),
+		RequestID:   strings.TrimSpace(r.Header.Get(HeaderRequestID)),
+		TraceParent: strings.TrimSpace(r.Header.Get(HeaderTraceParent)),
+		TraceState:  strings.TrimSpace(r.Header.Get(HeaderTraceState)),
+		ClientIP:    clientIP(r),
+		UserAgent:   strings.TrimSpace(r.Header.Get(HeaderUserAgent)),
+
+		IdempotencyKey: strings.TrimSpace(r.Header.Get(HeaderIdempotencyKey)),
+	}
+	if role := strings.TrimSpace(r.Header.Get(HeaderActingRole)); rc.HasRole(role) {
+		rc.ActingRole = role
+	}
+	if rc.RequestID == "" {
+		rc.RequestID = uuid.NewString()
+	}
+	return rc
+}
+
+func splitRoles(header string) []string {
+	parts := strings.Split(header, ",")
+	roles := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if role := strings.TrimSpace(part); role != "" {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// clientIP prefers the first hop recorded by the proxy over the socket peer
+func clientIP(r *http.Request) string {
+	if fwd := r.Header.Get(HeaderForwarded); fwd != "" {
+		first, _, _ := strings.Cut(fwd, ",")
+		return strings.TrimSpace(first)
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestMiddlewareRejectsRequestsWithoutCaller(t *testing.T) {
+	for name, headers := range map[string]map[string]string{
+		"no headers": nil,
+		"no user":    {HeaderTenant: "palika-1"},
+		"no tenant":  {HeaderUserID: "user-7"},
+		"blank user": {HeaderUserID: "  ", HeaderTenant: "palika-1"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			reached := false
+			h := Middleware(http.HandlerFunc(func(http.ResponseWriter, *http.Request) { reached = true }))
+
+			r := httptest.NewRequest(http.MethodPost, "/query", nil)
+			for k, v := range headers {
+				r.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if reached {
+				t.Fatal("request reached the GraphQL handler")
+			}
+			if w.Code != http.StatusUnauthorized {
+				t.Errorf("status = %d, want 401", w.Code)
+			}
+			if body := w.Body.String(); body != unauthenticatedBody {
+				t.Errorf("body = %s, want %s", body, unauthenticatedBody)
+			}
+			if w.Header().Get(HeaderRequestID) == "" {
+				t.Error("no request ID on the rejection")
+			}
+		})
+	}
+}
+
+func TestMiddlewareCapturesCaller(t *testing.T) {
+	var got *RequestContext
+	h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = FromContext(r.Context())
+	}))
+
+	r := httptest.NewRequest(http.MethodPost, "/query", nil)
+	r.RemoteAddr = "10.0.0.9:5555"
+	r.Header.Set(HeaderUserID, "user-7")
+	r.Header.Set(HeaderTenant, "palika-1")
+	r.Header.Set(HeaderRoles, "darta_registrar, auditor,")
+	r.Header.Set(HeaderRequestID, "req-1")
+	r.Header.Set(HeaderForwarded, "203.0.113.5, 10.0.0.1")
+	r.Header.Set(HeaderActingRole, "identity_admin")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if got == nil {
+		t.Fatal("request did not reach the handler")
+	}
+	if got.UserID != "user-7" || got.Tenant != "palika-1" || got.RequestID != "req-1" {
+		t.Errorf("caller = %+v", got)
+	}
+	if !slices.Equal(got.Roles, []string{"darta_registrar", "auditor"}) {
+		t.Errorf("roles = %v", got.Roles)
+	}
+	if got.ClientIP != "203.0.113.5" {
+		t.Errorf("client IP = %q, want the first forwarded hop", got.ClientIP)
+	}
+	if got.ActingRole != "" {
+		t.Errorf("acting role = %q; a role the caller does not hold must be ignored", got.ActingRole)
+	}
+	if w.Header().Get(HeaderRequestID) != "req-1" {
+		t.Errorf("response request ID = %q, want req-1", w.Header().Get(HeaderRequestID))
+	}
+}
@@ -0,0 +1,73 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	dartav1 "git.ninjainfosys.com/ePalika/proto/gen/darta/v1"
+)
+
+// ChalaniService defines the chalani gRPC operations required by the gateway resolvers.
+type ChalaniService interface {
+	GetChalani(ctx context.Context, req *dartav1.GetChalaniRequest) (*dartav1.GetChalaniResponse, error)
+	WatchChalanis(ctx context.Context, req *dartav1.WatchChalanisRequest) (grpc.ServerStreamingClient[dartav1.ChalaniEvent], error)
+}
+
+// ChalaniClient wraps the gRPC client for the chalani service.
+type ChalaniClient struct {
+	client dartav1.ChalaniServiceClient
+	conn   *grpc.ClientConn
+}
+
+var _ ChalaniService = (*ChalaniClient)(nil)
+
+// NewChalaniClient creates a new chalani gRPC client. The chalani service is
+// served by darta-chalani alongside the darta service.
+func NewChalaniClient(ctx context.Context, address string) (*ChalaniClient, error) {
+	if address == "" {
+		return nil, fmt.Errorf("address is required")
+	}
+
+	dialCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(
+		dialCtx,
+		address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy":"round_robin"}`),
+		grpc.WithChainUnaryInterceptor(unaryForwardInterceptor()),
+		grpc.WithChainStreamInterceptor(streamForwardInterceptor()),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("connect to chalani service: %w", err)
+	}
+
+	return &ChalaniClient{
+		client: dartav1.NewChalaniServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close closes the gRPC connection.
+func (c *ChalaniClient) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
+
+// GetChalani fetches a chalani by ID.
+func (c *ChalaniClient) GetChalani(ctx context.Context, req *dartav1.GetChalaniRequest) (*dartav1.GetChalaniResponse, error) {
+	return c.client.GetChalani(ctx, req)
+}
+
+// WatchChalanis streams chalani events until ctx is cancelled.
+func (c *ChalaniClient) WatchChalanis(ctx context.Context, req *dartav1.WatchChalanisRequest) (grpc.ServerStreamingClient[dartav1.ChalaniEvent], error) {
+	return c.client.WatchChalanis(ctx, req)
+}
@@ -18,6 +18,9 @@ type DartaService interface {
 	ListDartas(ctx context.Context, req *dartav1.ListDartasRequest) (*dartav1.ListDartasResponse, error)
 	GetMyDartas(ctx context.Context, req *dartav1.GetMyDartasRequest) (*dartav1.GetMyDartasResponse, error)
 	GetDartaStats(ctx context.Context, req *dartav1.GetDartaStatsRequest) (*dartav1.GetDartaStatsResponse, error)
+	ListDuplicateCandidates(ctx context.Context, req *dartav1.ListDuplicateCandidatesRequest) (*dartav1.ListDuplicateCandidatesResponse, error)
+	LinkDuplicateDarta(ctx context.Context, req *dartav1.LinkDuplicateDartaRequest) (*dartav1.LinkDuplicateDartaResponse, error)
+	SearchRecords(ctx context.Context, req *dartav1.SearchRecordsRequest) (*dartav1.SearchRecordsResponse, error)
 	SubmitDartaForReview(ctx context.Context, req *dartav1.SubmitDartaForReviewRequest) (*dartav1.SubmitDartaForReviewResponse, error)
 	ClassifyDarta(ctx context.Context, req *dartav1.ClassifyDartaRequest) (*dartav1.ClassifyDartaResponse, error)
 	ReserveDartaNumber(ctx context.Context, req *dartav1.ReserveDartaNumberRequest) (*dartav1.ReserveDartaNumberResponse, error)
@@ -25,13 +28,42 @@ type DartaService interface {
 	RouteDarta(ctx context.Context, req *dartav1.RouteDartaRequest) (*dartav1.RouteDartaResponse, error)
 	CloseDarta(ctx context.Context, req *dartav1.CloseDartaRequest) (*dartav1.CloseDartaResponse, error)
 	VoidDarta(ctx context.Context, req *dartav1.VoidDartaRequest) (*dartav1.VoidDartaResponse, error)
+	GetDartaByNumber(ctx context.Context, req *dartav1.GetDartaByNumberRequest) (*dartav1.GetDartaByNumberResponse, error)
+	ReviewDarta(ctx context.Context, req *dartav1.ReviewDartaRequest) (*dartav1.ReviewDartaResponse, error)
+	DirectRegisterDarta(ctx context.Context, req *dartav1.DirectRegisterDartaRequest) (*dartav1.DirectRegisterDartaResponse, error)
+	ScanDarta(ctx context.Context, req *dartav1.ScanDartaRequest) (*dartav1.ScanDartaResponse, error)
+	EnrichDartaMetadata(ctx context.Context, req *dartav1.EnrichDartaMetadataRequest) (*dartav1.EnrichDartaMetadataResponse, error)
+	FinalizeDartaArchive(ctx context.Context, req *dartav1.FinalizeDartaArchiveRequest) (*dartav1.FinalizeDartaArchiveResponse, error)
+	SectionReviewDarta(ctx context.Context, req *dartav1.SectionReviewDartaRequest) (*dartav1.SectionReviewDartaResponse, error)
+	RequestDartaClarification(ctx context.Context, req *dartav1.RequestDartaClarificationRequest) (*dartav1.RequestDartaClarificationResponse, error)
+	ProvideDartaClarification(ctx context.Context, req *dartav1.ProvideDartaClarificationRequest) (*dartav1.ProvideDartaClarificationResponse, error)
+	AcceptDarta(ctx context.Context, req *dartav1.AcceptDartaRequest) (*dartav1.AcceptDartaResponse, error)
+	MarkDartaAction(ctx context.Context, req *dartav1.MarkDartaActionRequest) (*dartav1.MarkDartaActionResponse, error)
+	IssueDartaResponse(ctx context.Context, req *dartav1.IssueDartaResponseRequest) (*dartav1.IssueDartaResponseResponse, error)
+	RequestDartaAck(ctx context.Context, req *dartav1.RequestDartaAckRequest) (*dartav1.RequestDartaAckResponse, error)
+	ReceiveDartaAck(ctx context.Context, req *dartav1.ReceiveDartaAckRequest) (*dartav1.ReceiveDartaAckResponse, error)
+	SupersedeDartaRecord(ctx context.Context, req *dartav1.SupersedeDartaRecordRequest) (*dartav1.SupersedeDartaRecordResponse, error)
+	GetDartaSupersessionChain(ctx context.Context, req *dartav1.GetDartaSupersessionChainRequest) (*dartav1.GetDartaSupersessionChainResponse, error)
+	WatchDartas(ctx context.Context, req *dartav1.WatchDartasRequest) (grpc.ServerStreamingClient[dartav1.DartaEvent], error)
+	BatchGetDartas(ctx context.Context, req *dartav1.BatchGetDartasRequest) (*dartav1.BatchGetDartasResponse, error)
+	BatchGetApplicants(ctx context.Context, req *dartav1.BatchGetApplicantsRequest) (*dartav1.BatchGetApplicantsResponse, error)
+	BatchGetAttachments(ctx context.Context, req *dartav1.BatchGetAttachmentsRequest) (*dartav1.BatchGetAttachmentsResponse, error)
+	BatchGetDartaLinks(ctx context.Context, req *dartav1.BatchGetDartaLinksRequest) (*dartav1.BatchGetDartaLinksResponse, error)
+	BatchGetAuditTrails(ctx context.Context, req *dartav1.BatchGetAuditTrailsRequest) (*dartav1.BatchGetAuditTrailsResponse, error)
 	HealthCheck(ctx context.Context, req *dartav1.HealthCheckRequest) (*dartav1.HealthCheckResponse, error)
+	ExportRegister(ctx context.Context, req *dartav1.ExportRegisterRequest) (*dartav1.ExportRegisterResponse, error)
+	ListAuditEntries(ctx context.Context, req *dartav1.ListAuditEntriesRequest) (*dartav1.ListAuditEntriesResponse, error)
+	GetEntityTimeline(ctx context.Context, req *dartav1.GetEntityTimelineRequest) (*dartav1.GetEntityTimelineResponse, error)
+	VerifyAuditChain(ctx context.Context, req *dartav1.VerifyAuditChainRequest) (*dartav1.VerifyAuditChainResponse, error)
+	ListAuditAnchors(ctx context.Context, req *dartav1.ListAuditAnchorsRequest) (*dartav1.ListAuditAnchorsResponse, error)
 }
 
 // DartaClient wraps the gRPC client for the darta service.
 type DartaClient struct {
-	client dartav1.DartaServiceClient
-	conn   *grpc.ClientConn
+	client    dartav1.DartaServiceClient
+	registers dartav1.RegisterServiceClient
+	audit     dartav1.AuditServiceClient
+	conn      *grpc.ClientConn
 }
 
 var _ DartaService = (*DartaClient)(nil)
@@ -50,6 +82,8 @@ func NewDartaClient(ctx context.Context, address string) (*DartaClient, error) {
 		address,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy":"round_robin"}`),
+		grpc.WithChainUnaryInterceptor(unaryForwardInterceptor()),
+		grpc.WithChainStreamInterceptor(streamForwardInterceptor()),
 		grpc.WithBlock(),
 	)
 	if err != nil {
@@ -57,8 +91,10 @@ func NewDartaClient(ctx context.Context, address string) (*DartaClient, error) {
 	}
 
 	return &DartaClient{
-		client: dartav1.NewDartaServiceClient(conn),
-		conn:   conn,
+		client:    dartav1.NewDartaServiceClient(conn),
+		registers: dartav1.NewRegisterServiceClient(conn),
+		audit:     dartav1.NewAuditServiceClient(conn),
+		conn:      conn,
 	}, nil
 }
 
@@ -100,6 +136,21 @@ func (c *DartaClient) GetDartaStats(ctx context.Context, req *dartav1.GetDartaSt
 	return c.client.GetDartaStats(ctx, req)
 }
 
+// ListDuplicateCandidates lists the suspected duplicates of a darta.
+func (c *DartaClient) ListDuplicateCandidates(ctx context.Context, req *dartav1.ListDuplicateCandidatesRequest) (*dartav1.ListDuplicateCandidatesResponse, error) {
+	return c.client.ListDuplicateCandidates(ctx, req)
+}
+
+// LinkDuplicateDarta links a darta to the darta it duplicates.
+func (c *DartaClient) LinkDuplicateDarta(ctx context.Context, req *dartav1.LinkDuplicateDartaRequest) (*dartav1.LinkDuplicateDartaResponse, error) {
+	return c.client.LinkDuplicateDarta(ctx, req)
+}
+
+// SearchRecords searches dartas and chalanis.
+func (c *DartaClient) SearchRecords(ctx context.Context, req *dartav1.SearchRecordsRequest) (*dartav1.SearchRecordsResponse, error) {
+	return c.client.SearchRecords(ctx, req)
+}
+
 // SubmitDartaForReview submits a darta for review.
 func (c *DartaClient) SubmitDartaForReview(ctx context.Context, req *dartav1.SubmitDartaForReviewRequest) (*dartav1.SubmitDartaForReviewResponse, error) {
 	return c.client.SubmitDartaForReview(ctx, req)
@@ -130,7 +181,142 @@ func (c *DartaClient) VoidDarta(ctx context.Context, req *dartav1.VoidDartaReque
 	return c.client.VoidDarta(ctx, req)
 }
 
+// GetDartaByNumber retrieves a darta by its register number.
+func (c *DartaClient) GetDartaByNumber(ctx context.Context, req *dartav1.GetDartaByNumberRequest) (*dartav1.GetDartaByNumberResponse, error) {
+	return c.client.GetDartaByNumber(ctx, req)
+}
+
+// ReviewDarta records a review decision on a darta.
+func (c *DartaClient) ReviewDarta(ctx context.Context, req *dartav1.ReviewDartaRequest) (*dartav1.ReviewDartaResponse, error) {
+	return c.client.ReviewDarta(ctx, req)
+}
+
+// DirectRegisterDarta reserves a number and registers a darta in one step.
+func (c *DartaClient) DirectRegisterDarta(ctx context.Context, req *dartav1.DirectRegisterDartaRequest) (*dartav1.DirectRegisterDartaResponse, error) {
+	return c.client.DirectRegisterDarta(ctx, req)
+}
+
+// ScanDarta records the scanned copy of a darta.
+func (c *DartaClient) ScanDarta(ctx context.Context, req *dartav1.ScanDartaRequest) (*dartav1.ScanDartaResponse, error) {
+	return c.client.ScanDarta(ctx, req)
+}
+
+// EnrichDartaMetadata updates darta metadata.
+func (c *DartaClient) EnrichDartaMetadata(ctx context.Context, req *dartav1.EnrichDartaMetadataRequest) (*dartav1.EnrichDartaMetadataResponse, error) {
+	return c.client.EnrichDartaMetadata(ctx, req)
+}
+
+// FinalizeDartaArchive marks a darta as digitally archived.
+func (c *DartaClient) FinalizeDartaArchive(ctx context.Context, req *dartav1.FinalizeDartaArchiveRequest) (*dartav1.FinalizeDartaArchiveResponse, error) {
+	return c.client.FinalizeDartaArchive(ctx, req)
+}
+
+// SectionReviewDarta records a section review of a darta.
+func (c *DartaClient) SectionReviewDarta(ctx context.Context, req *dartav1.SectionReviewDartaRequest) (*dartav1.SectionReviewDartaResponse, error) {
+	return c.client.SectionReviewDarta(ctx, req)
+}
+
+// RequestDartaClarification requests clarification on a darta.
+func (c *DartaClient) RequestDartaClarification(ctx context.Context, req *dartav1.RequestDartaClarificationRequest) (*dartav1.RequestDartaClarificationResponse, error) {
+	return c.client.RequestDartaClarification(ctx, req)
+}
+
+// ProvideDartaClarification provides requested clarification on a darta.
+func (c *DartaClient) ProvideDartaClarification(ctx context.Context, req *dartav1.ProvideDartaClarificationRequest) (*dartav1.ProvideDartaClarificationResponse, error) {
+	return c.client.ProvideDartaClarification(ctx, req)
+}
+
+// AcceptDarta accepts a darta assigned to a section.
+func (c *DartaClient) AcceptDarta(ctx context.Context, req *dartav1.AcceptDartaRequest) (*dartav1.AcceptDartaResponse, error) {
+	return c.client.AcceptDarta(ctx, req)
+}
+
+// MarkDartaAction records an action taken on a darta.
+func (c *DartaClient) MarkDartaAction(ctx context.Context, req *dartav1.MarkDartaActionRequest) (*dartav1.MarkDartaActionResponse, error) {
+	return c.client.MarkDartaAction(ctx, req)
+}
+
+// IssueDartaResponse issues a response to a darta.
+func (c *DartaClient) IssueDartaResponse(ctx context.Context, req *dartav1.IssueDartaResponseRequest) (*dartav1.IssueDartaResponseResponse, error) {
+	return c.client.IssueDartaResponse(ctx, req)
+}
+
+// RequestDartaAck requests acknowledgement of a darta response.
+func (c *DartaClient) RequestDartaAck(ctx context.Context, req *dartav1.RequestDartaAckRequest) (*dartav1.RequestDartaAckResponse, error) {
+	return c.client.RequestDartaAck(ctx, req)
+}
+
+// ReceiveDartaAck records acknowledgement of a darta response.
+func (c *DartaClient) ReceiveDartaAck(ctx context.Context, req *dartav1.ReceiveDartaAckRequest) (*dartav1.ReceiveDartaAckResponse, error) {
+	return c.client.ReceiveDartaAck(ctx, req)
+}
+
+// SupersedeDartaRecord marks a darta as superseded by another.
+func (c *DartaClient) SupersedeDartaRecord(ctx context.Context, req *dartav1.SupersedeDartaRecordRequest) (*dartav1.SupersedeDartaRecordResponse, error) {
+	return c.client.SupersedeDartaRecord(ctx, req)
+}
+
+// GetDartaSupersessionChain returns the supersession chain through a darta.
+func (c *DartaClient) GetDartaSupersessionChain(ctx context.Context, req *dartav1.GetDartaSupersessionChainRequest) (*dartav1.GetDartaSupersessionChainResponse, error) {
+	return c.client.GetDartaSupersessionChain(ctx, req)
+}
+
+// WatchDartas streams darta events until ctx is cancelled.
+func (c *DartaClient) WatchDartas(ctx context.Context, req *dartav1.WatchDartasRequest) (grpc.ServerStreamingClient[dartav1.DartaEvent], error) {
+	return c.client.WatchDartas(ctx, req)
+}
+
+// BatchGetDartas fetches several dartas in one call.
+func (c *DartaClient) BatchGetDartas(ctx context.Context, req *dartav1.BatchGetDartasRequest) (*dartav1.BatchGetDartasResponse, error) {
+	return c.client.BatchGetDartas(ctx, req)
+}
+
+// BatchGetApplicants fetches several applicants in one call.
+func (c *DartaClient) BatchGetApplicants(ctx context.Context, req *dartav1.BatchGetApplicantsRequest) (*dartav1.BatchGetApplicantsResponse, error) {
+	return c.client.BatchGetApplicants(ctx, req)
+}
+
+// BatchGetAttachments fetches several attachments in one call.
+func (c *DartaClient) BatchGetAttachments(ctx context.Context, req *dartav1.BatchGetAttachmentsRequest) (*dartav1.BatchGetAttachmentsResponse, error) {
+	return c.client.BatchGetAttachments(ctx, req)
+}
+
+// BatchGetDartaLinks fetches the annexes and related dartas of several dartas in one call.
+func (c *DartaClient) BatchGetDartaLinks(ctx context.Context, req *dartav1.BatchGetDartaLinksRequest) (*dartav1.BatchGetDartaLinksResponse, error) {
+	return c.client.BatchGetDartaLinks(ctx, req)
+}
+
+// BatchGetAuditTrails fetches the recent audit entries of several entities in one call.
+func (c *DartaClient) BatchGetAuditTrails(ctx context.Context, req *dartav1.BatchGetAuditTrailsRequest) (*dartav1.BatchGetAuditTrailsResponse, error) {
+	return c.client.BatchGetAuditTrails(ctx, req)
+}
+
 // HealthCheck checks the health of the darta service.
 func (c *DartaClient) HealthCheck(ctx context.Context, req *dartav1.HealthCheckRequest) (*dartav1.HealthCheckResponse, error) {
 	return c.client.HealthCheck(ctx, req)
 }
+
+// ExportRegister exports a fiscal year's darta or chalani kitab.
+func (c *DartaClient) ExportRegister(ctx context.Context, req *dartav1.ExportRegisterRequest) (*dartav1.ExportRegisterResponse, error) {
+	return c.registers.ExportRegister(ctx, req)
+}
+
+// ListAuditEntries lists the audit entries of the caller's tenant.
+func (c *DartaClient) ListAuditEntries(ctx context.Context, req *dartav1.ListAuditEntriesRequest) (*dartav1.ListAuditEntriesResponse, error) {
+	return c.audit.ListAuditEntries(ctx, req)
+}
+
+// GetEntityTimeline fetches the activity on a darta or chalani, oldest first.
+func (c *DartaClient) GetEntityTimeline(ctx context.Context, req *dartav1.GetEntityTimelineRequest) (*dartav1.GetEntityTimelineResponse, error) {
+	return c.audit.GetEntityTimeline(ctx, req)
+}
+
+// VerifyAuditChain recomputes the caller's tenant audit chain.
+func (c *DartaClient) VerifyAuditChain(ctx context.Context, req *dartav1.VerifyAuditChainRequest) (*dartav1.VerifyAuditChainResponse, error) {
+	return c.audit.VerifyAuditChain(ctx, req)
+}
+
+// ListAuditAnchors lists the anchors of the caller's tenant audit chain.
+func (c *DartaClient) ListAuditAnchors(ctx context.Context, req *dartav1.ListAuditAnchorsRequest) (*dartav1.ListAuditAnchorsResponse, error) {
+	return c.audit.ListAuditAnchors(ctx, req)
+}
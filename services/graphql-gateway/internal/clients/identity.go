@@ -11,6 +11,7 @@ import (
 // IdentityService defines the interface for identity operations
 type IdentityService interface {
 	Client() identityv1.IdentityServiceClient
+	GetUsersByIDs(ctx context.Context, ids []string) (map[string]*identityv1.User, error)
 	Close() error
 }
 
@@ -22,7 +23,11 @@ type IdentityClient struct {
 
 // NewIdentityClient creates a new identity client
 func NewIdentityClient(ctx context.Context, addr string) (*IdentityClient, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(unaryForwardInterceptor()),
+		grpc.WithChainStreamInterceptor(streamForwardInterceptor()),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -44,3 +49,22 @@ func (c *IdentityClient) Close() error {
 func (c *IdentityClient) Client() identityv1.IdentityServiceClient {
 	return c.client
 }
+
+// GetUsersByIDs resolves user IDs in a single call, keyed by ID. IDs that do
+// not exist are absent from the result.
+func (c *IdentityClient) GetUsersByIDs(ctx context.Context, ids []string) (map[string]*identityv1.User, error) {
+	users := make(map[string]*identityv1.User, len(ids))
+	if len(ids) == 0 {
+		return users, nil
+	}
+
+	resp, err := c.client.GetUsersByIds(ctx, &identityv1.GetUsersByIdsRequest{Ids: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range resp.Users {
+		users[u.Id] = u
+	}
+	return users, nil
+}
@@ -0,0 +1,80 @@
+package clients
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"git.ninjainfosys.com/ePalika/graphql-gateway/internal/auth"
+)
+
+// forwardedMetadata converts the caller's request context into the metadata
+// keys the backend interceptors read
+func forwardedMetadata(ctx context.Context) context.Context {
+	rc := auth.FromContext(ctx)
+	if rc == nil {
+		return ctx
+	}
+
+	pairs := make([]string, 0, 16)
+	add := func(key, value string) {
+		if value != "" {
+			pairs = append(pairs, key, value)
+		}
+	}
+	add("x-user-id", rc.UserID)
+	add("x-user-name", rc.UserName)
+	add("x-tenant", rc.Tenant)
+	add("x-roles", strings.Join(rc.Roles, ","))
+	add("x-request-id", rc.RequestID)
+	add("traceparent", rc.TraceParent)
+	add("tracestate", rc.TraceState)
+	add("x-forwarded-for", rc.ClientIP)
+	add("x-user-agent", rc.UserAgent)
+
+	actingRole := rc.ActingRole
+	if d := auth.DecisionFromContext(ctx); d != nil {
+		add("x-pdp-decision-id", d.ID)
+		if d.Role != "" {
+			actingRole = d.Role
+		}
+	}
+	add("x-acting-role", actingRole)
+	add("idempotency-key", idempotencyKey(ctx, rc))
+
+	if len(pairs) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
+
+// idempotencyKey scopes the caller's idempotency key to the mutation field
+// being resolved, so that a request may run several mutations under one key.
+// Queries are not scoped and carry no key.
+func idempotencyKey(ctx context.Context, rc *auth.RequestContext) string {
+	if rc.IdempotencyKey == "" {
+		return ""
+	}
+	field := graphql.GetRootFieldContext(ctx)
+	if field == nil || field.Object != "Mutation" {
+		return ""
+	}
+	return rc.IdempotencyKey + ":" + field.Field.Alias
+}
+
+// unaryForwardInterceptor propagates the caller's identity on every unary call
+func unaryForwardInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(forwardedMetadata(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// streamForwardInterceptor propagates the caller's identity on every stream
+func streamForwardInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(forwardedMetadata(ctx), desc, cc, method, opts...)
+	}
+}
@@ -0,0 +1,122 @@
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"git.ninjainfosys.com/ePalika/graphql-gateway/internal/auth"
+)
+
+// sentMetadata runs a unary call through the forwarding interceptor and
+// returns the outgoing metadata the backend would receive
+func sentMetadata(t *testing.T, ctx context.Context) metadata.MD {
+	t.Helper()
+	var md metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	if err := unaryForwardInterceptor()(ctx, "/darta.v1.DartaService/CreateDarta", nil, nil, nil, invoker); err != nil {
+		t.Fatal(err)
+	}
+	return md
+}
+
+func TestForwardedMetadataCarriesCaller(t *testing.T) {
+	ctx := auth.WithRequestContext(context.Background(), &auth.RequestContext{
+		UserID:      "user-7",
+		UserName:    "sita",
+		Tenant:      "palika-1",
+		Roles:       []string{"darta_registrar", "auditor"},
+		RequestID:   "req-1",
+		TraceParent: "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+		ClientIP:    "203.0.113.5",
+		UserAgent:   "Mozilla/5.0",
+		ActingRole:  "auditor",
+	})
+	md := sentMetadata(t, ctx)
+
+	want := map[string]string{
+		"x-user-id":       "user-7",
+		"x-user-name":     "sita",
+		"x-tenant":        "palika-1",
+		"x-roles":         "darta_registrar,auditor",
+		"x-request-id":    "req-1",
+		"traceparent":     "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+		"x-forwarded-for": "203.0.113.5",
+		"x-user-agent":    "Mozilla/5.0",
+		"x-acting-role":   "auditor",
+	}
+	for key, value := range want {
+		if got := md.Get(key); len(got) != 1 || got[0] != value {
+			t.Errorf("%s = %v, want %q", key, got, value)
+		}
+	}
+	for _, key := range []string{"tracestate", "x-pdp-decision-id", "idempotency-key"} {
+		if got := md.Get(key); len(got) != 0 {
+			t.Errorf("%s = %v, want it left out when empty", key, got)
+		}
+	}
+}
+
+func TestForwardedMetadataCarriesDecision(t *testing.T) {
+	ctx := auth.WithRequestContext(context.Background(), &auth.RequestContext{
+		UserID:     "user-7",
+		Tenant:     "palika-1",
+		Roles:      []string{"darta_registrar", "darta_reviewer"},
+		ActingRole: "darta_registrar",
+	})
+	ctx = auth.WithDecision(ctx, &auth.Decision{ID: "dec-1", Role: "darta_reviewer"})
+	md := sentMetadata(t, ctx)
+
+	if got := md.Get("x-pdp-decision-id"); len(got) != 1 || got[0] != "dec-1" {
+		t.Errorf("x-pdp-decision-id = %v, want dec-1", got)
+	}
+	if got := md.Get("x-acting-role"); len(got) != 1 || got[0] != "darta_reviewer" {
+		t.Errorf("x-acting-role = %v, want the role the decision checked", got)
+	}
+}
+
+func TestForwardedMetadataScopesIdempotencyKey(t *testing.T) {
+	base := auth.WithRequestContext(context.Background(), &auth.RequestContext{
+		UserID:         "user-7",
+		Tenant:         "palika-1",
+		IdempotencyKey: "key-1",
+	})
+	field := func(object, alias string) context.Context {
+		return graphql.WithRootFieldContext(base, &graphql.RootFieldContext{
+			Object: object,
+			Field:  graphql.CollectedField{Field: &ast.Field{Alias: alias}},
+		})
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want []string
+	}{
+		{"mutation", field("Mutation", "first"), []string{"key-1:first"}},
+		{"second mutation", field("Mutation", "second"), []string{"key-1:second"}},
+		{"query", field("Query", "darta"), nil},
+		{"outside a field", base, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sentMetadata(t, tt.ctx).Get("idempotency-key")
+			if len(got) != len(tt.want) || (len(got) == 1 && got[0] != tt.want[0]) {
+				t.Errorf("idempotency-key = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForwardedMetadataWithoutCaller(t *testing.T) {
+	if md := sentMetadata(t, context.Background()); len(md) != 0 {
+		t.Errorf("metadata = %v, want none without a request context", md)
+	}
+}
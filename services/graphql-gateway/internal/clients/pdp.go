@@ -42,6 +42,8 @@ func NewPDPClient(ctx context.Context, address string) (*PDPClient, error) {
 		address,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy":"round_robin"}`),
+		grpc.WithChainUnaryInterceptor(unaryForwardInterceptor()),
+		grpc.WithChainStreamInterceptor(streamForwardInterceptor()),
 		grpc.WithBlock(),
 	)
 	if err != nil {
@@ -0,0 +1,87 @@
+// Package limits holds gqlgen extensions that bound the cost of an operation
+// beyond the built-in complexity limit.
+package limits
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrCodeDepthLimit is returned in extensions.code when an operation is too
+// deeply nested
+const ErrCodeDepthLimit = "DEPTH_LIMIT_EXCEEDED"
+
+// DepthLimit rejects operations whose selections nest deeper than MaxDepth.
+// Fragments count at the depth they are spread into. Introspection fields are
+// not counted, since introspection is switched on or off separately.
+type DepthLimit struct {
+	MaxDepth int
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = DepthLimit{}
+
+// ExtensionName implements graphql.HandlerExtension
+func (d DepthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+// Validate implements graphql.HandlerExtension
+func (d DepthLimit) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext implements graphql.OperationContextMutator
+func (d DepthLimit) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if d.MaxDepth <= 0 || opCtx.Operation == nil {
+		return nil
+	}
+
+	depth := selectionDepth(opCtx.Doc, opCtx.Operation.SelectionSet, map[string]bool{})
+	if depth > d.MaxDepth {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.MaxDepth)
+		errcode.Set(err, ErrCodeDepthLimit)
+		return err
+	}
+	return nil
+}
+
+// selectionDepth returns the deepest field nesting under set. visiting guards
+// against fragment cycles.
+func selectionDepth(doc *ast.QueryDocument, set ast.SelectionSet, visiting map[string]bool) int {
+	deepest := 0
+	for _, sel := range set {
+		depth := 0
+		switch s := sel.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			depth = 1 + selectionDepth(doc, s.SelectionSet, visiting)
+		case *ast.InlineFragment:
+			depth = selectionDepth(doc, s.SelectionSet, visiting)
+		case *ast.FragmentSpread:
+			if visiting[s.Name] {
+				continue
+			}
+			frag := doc.Fragments.ForName(s.Name)
+			if frag == nil {
+				continue
+			}
+			visiting[s.Name] = true
+			depth = selectionDepth(doc, frag.SelectionSet, visiting)
+			delete(visiting, s.Name)
+		}
+		if depth > deepest {
+			deepest = depth
+		}
+	}
+	return deepest
+}
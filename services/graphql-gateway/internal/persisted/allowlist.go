@@ -0,0 +1,84 @@
+package persisted
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Error codes returned in extensions.code by the allowlist
+const (
+	ErrCodeNotFound   = "PERSISTED_QUERY_NOT_FOUND"
+	ErrCodeNotAllowed = "PERSISTED_QUERY_NOT_ALLOWED"
+)
+
+// Allowlist only executes queries found in a manifest. Clients send the APQ
+// extension with the query's hash and may omit the query text; a query sent
+// in full is accepted if its hash is in the manifest. It replaces APQ in
+// persisted-only mode, so unknown queries can never be registered.
+type Allowlist struct {
+	Manifest *Manifest
+}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.HandlerExtension
+} = Allowlist{}
+
+// ExtensionName implements graphql.HandlerExtension
+func (a Allowlist) ExtensionName() string {
+	return "PersistedQueryAllowlist"
+}
+
+// Validate implements graphql.HandlerExtension
+func (a Allowlist) Validate(schema graphql.ExecutableSchema) error {
+	if a.Manifest == nil {
+		return errors.New("Allowlist.Manifest can not be nil")
+	}
+	return nil
+}
+
+// MutateOperationParameters implements graphql.OperationParameterMutator
+func (a Allowlist) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	hash := requestedHash(rawParams.Extensions)
+
+	if rawParams.Query == "" {
+		if hash == "" {
+			return codedError(ErrCodeNotAllowed, "persisted query hash is required")
+		}
+		query, ok := a.Manifest.Lookup(hash)
+		if !ok {
+			return codedError(ErrCodeNotFound, "PersistedQueryNotFound")
+		}
+		rawParams.Query = query
+		return nil
+	}
+
+	queryHash := Hash(rawParams.Query)
+	if hash != "" && hash != queryHash {
+		return codedError(ErrCodeNotAllowed, "provided persisted query hash does not match query")
+	}
+	if _, ok := a.Manifest.Lookup(queryHash); !ok {
+		return codedError(ErrCodeNotAllowed, "query is not in the persisted query allowlist")
+	}
+	return nil
+}
+
+// requestedHash returns extensions.persistedQuery.sha256Hash, if sent
+func requestedHash(extensions map[string]interface{}) string {
+	pq, ok := extensions["persistedQuery"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	hash, _ := pq["sha256Hash"].(string)
+	return hash
+}
+
+func codedError(code, message string) *gqlerror.Error {
+	err := gqlerror.Errorf("%s", message)
+	errcode.Set(err, code)
+	return err
+}
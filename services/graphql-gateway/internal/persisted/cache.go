@@ -0,0 +1,99 @@
+package persisted
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/redis/go-redis/v9"
+)
+
+// redisTimeout bounds each cache round trip so that a slow cache degrades to
+// clients resending the full query rather than stalling requests
+const redisTimeout = 200 * time.Millisecond
+
+// RedisCache stores APQ queries in Redis or a Redis-compatible server, so
+// that every gateway replica sees queries registered through any of them
+type RedisCache struct {
+	client *redis.Client
+	prefix string
+	ttl    time.Duration
+}
+
+var _ graphql.Cache[string] = (*RedisCache)(nil)
+
+// NewRedisCache connects to the server at url (redis:// or rediss://). Keys
+// are stored under prefix and expire after ttl; zero keeps them forever.
+func NewRedisCache(ctx context.Context, url, prefix string, ttl time.Duration) (*RedisCache, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("parse redis url: %w", err)
+	}
+	client := redis.NewClient(opts)
+
+	pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := client.Ping(pingCtx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("connect to redis: %w", err)
+	}
+
+	return &RedisCache{client: client, prefix: prefix, ttl: ttl}, nil
+}
+
+// Get implements graphql.Cache
+func (c *RedisCache) Get(ctx context.Context, key string) (string, bool) {
+	ctx, cancel := context.WithTimeout(ctx, redisTimeout)
+	defer cancel()
+
+	query, err := c.client.Get(ctx, c.prefix+key).Result()
+	if err != nil {
+		if err != redis.Nil {
+			log.Printf("apq cache get failed: %v", err)
+		}
+		return "", false
+	}
+	return query, true
+}
+
+// Add implements graphql.Cache
+func (c *RedisCache) Add(ctx context.Context, key, value string) {
+	ctx, cancel := context.WithTimeout(ctx, redisTimeout)
+	defer cancel()
+
+	if err := c.client.Set(ctx, c.prefix+key, value, c.ttl).Err(); err != nil {
+		log.Printf("apq cache add failed: %v", err)
+	}
+}
+
+// Close closes the Redis connection pool
+func (c *RedisCache) Close() error {
+	return c.client.Close()
+}
+
+// ManifestCache serves manifest queries ahead of an APQ cache, so that
+// clients using the manifest never have to register their queries
+type ManifestCache struct {
+	Manifest *Manifest
+	Next     graphql.Cache[string]
+}
+
+var _ graphql.Cache[string] = ManifestCache{}
+
+// Get implements graphql.Cache
+func (c ManifestCache) Get(ctx context.Context, key string) (string, bool) {
+	if query, ok := c.Manifest.Lookup(key); ok {
+		return query, true
+	}
+	return c.Next.Get(ctx, key)
+}
+
+// Add implements graphql.Cache
+func (c ManifestCache) Add(ctx context.Context, key, value string) {
+	if _, ok := c.Manifest.Lookup(key); ok {
+		return
+	}
+	c.Next.Add(ctx, key, value)
+}
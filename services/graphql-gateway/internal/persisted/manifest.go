@@ -0,0 +1,86 @@
+// Package persisted implements persisted queries for the gateway: an allowlist
+// manifest built from the MFEs' operations, and the caches backing automatic
+// persisted queries (APQ).
+package persisted
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Manifest maps the SHA-256 hash of each allowed query to its text
+type Manifest struct {
+	queries map[string]string
+}
+
+// apolloManifest is the persisted-query-manifest format written by Apollo
+// tooling and graphql-codegen
+type apolloManifest struct {
+	Format     string `json:"format"`
+	Version    int    `json:"version"`
+	Operations []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Type string `json:"type"`
+		Body string `json:"body"`
+	} `json:"operations"`
+}
+
+// LoadManifest reads a manifest from path. Both the Apollo
+// persisted-query-manifest format and a plain JSON object of hash to query
+// are accepted. Every hash must be the SHA-256 of its query.
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read persisted query manifest: %w", err)
+	}
+
+	entries := map[string]string{}
+
+	var apollo apolloManifest
+	if err := json.Unmarshal(data, &apollo); err == nil && apollo.Format != "" {
+		if apollo.Format != "apollo-persisted-query-manifest" || apollo.Version != 1 {
+			return nil, fmt.Errorf("unsupported persisted query manifest %s v%d", apollo.Format, apollo.Version)
+		}
+		for _, op := range apollo.Operations {
+			entries[op.ID] = op.Body
+		}
+	} else if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("parse persisted query manifest: %w", err)
+	}
+
+	m := &Manifest{queries: make(map[string]string, len(entries))}
+	for hash, query := range entries {
+		if Hash(query) != hash {
+			return nil, fmt.Errorf("persisted query %s does not match its hash", hash)
+		}
+		m.queries[hash] = query
+	}
+	return m, nil
+}
+
+// Lookup returns the query with the given hash
+func (m *Manifest) Lookup(hash string) (string, bool) {
+	if m == nil {
+		return "", false
+	}
+	q, ok := m.queries[hash]
+	return q, ok
+}
+
+// Len returns the number of queries in the manifest
+func (m *Manifest) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.queries)
+}
+
+// Hash returns the hex SHA-256 of a query, as used by APQ clients
+func Hash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
@@ -8,7 +8,6 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
-	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
@@ -34,27 +33,33 @@ func main() {
 		Realm:        cfg.Keycloak.Realm,
 		ClientID:     cfg.Keycloak.ClientID,
 		ClientSecret: cfg.Keycloak.ClientSecret,
+		AdminRealm:   cfg.Keycloak.AdminRealm,
+		AdminCredentials: keycloak.Credentials{
+			ClientID:     cfg.Keycloak.AdminClientID,
+			ClientSecret: cfg.Keycloak.AdminClientSecret,
+			Username:     cfg.Keycloak.AdminUser,
+			Password:     cfg.Keycloak.AdminPassword,
+		},
 	})
 
-	// Login to Keycloak
+	// Login to the default realm; tokens for every realm are refreshed on demand
 	ctx := context.Background()
 	if err := kcClient.Login(ctx); err != nil {
 		log.Fatalf("failed to login to keycloak: %v", err)
 	}
 	log.Println("successfully logged in to keycloak")
 
-	// Start token refresh goroutine
-	go refreshKeycloakToken(kcClient)
-
 	// Create gRPC server
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(grpcserver.TenantInterceptor(kcClient.DefaultRealm(), kcClient.AdminRealm())),
+	)
 
 	// Register health check
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
 
 	// Register identity service
-	identityServer := grpcserver.NewIdentityServer(kcClient)
+	identityServer := grpcserver.NewIdentityServer(kcClient, cfg.Invitation, cfg.Tenant)
 	identityv1.RegisterIdentityServiceServer(grpcServer, identityServer)
 
 	// Register reflection for grpcurl
@@ -84,18 +89,3 @@ func main() {
 	grpcServer.GracefulStop()
 	log.Println("server stopped")
 }
-
-// refreshKeycloakToken periodically refreshes the Keycloak admin token
-func refreshKeycloakToken(client *keycloak.Client) {
-	ticker := time.NewTicker(5 * time.Minute)
-	defer ticker.Stop()
-
-	for range ticker.C {
-		ctx := context.Background()
-		if err := client.Login(ctx); err != nil {
-			log.Printf("failed to refresh keycloak token: %v", err)
-		} else {
-			log.Println("keycloak token refreshed")
-		}
-	}
-}
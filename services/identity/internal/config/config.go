@@ -3,20 +3,48 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 )
 
 // Config holds application configuration
 type Config struct {
-	Port     string
-	Keycloak KeycloakConfig
+	Port       string
+	Keycloak   KeycloakConfig
+	Invitation InvitationConfig
+	Tenant     TenantConfig
 }
 
 // KeycloakConfig holds Keycloak configuration
 type KeycloakConfig struct {
-	URL          string
-	Realm        string
+	URL   string
+	Realm string // Default realm when a request carries no tenant
+	// Service client of the default realm; without a secret the default
+	// realm is administered with the admin credentials
 	ClientID     string
 	ClientSecret string
+
+	// Admin realm credentials, used for tenant onboarding and for tenant
+	// realms that have no credentials of their own. Either the client
+	// secret or the user and password must be set.
+	AdminRealm        string
+	AdminClientID     string
+	AdminClientSecret string
+	AdminUser         string
+	AdminPassword     string
+}
+
+// TenantConfig holds the names of objects created in each tenant realm
+type TenantConfig struct {
+	ClientID  string // OAuth client used by the gateway and frontends
+	ScopeName string // Client scope carrying ePalika claims
+}
+
+// InvitationConfig holds settings for user invitation emails
+type InvitationConfig struct {
+	ClientID    string        // Client the invite link returns to
+	RedirectURI string        // Default redirect after completing actions
+	Lifespan    time.Duration // How long the invite link stays valid
 }
 
 // Load loads configuration from environment variables
@@ -28,12 +56,28 @@ func Load() (*Config, error) {
 			Realm:        getEnv("KEYCLOAK_REALM", "epalika"),
 			ClientID:     getEnv("KEYCLOAK_CLIENT_ID", "identity-service"),
 			ClientSecret: getEnv("KEYCLOAK_CLIENT_SECRET", ""),
+
+			AdminRealm:        getEnv("KEYCLOAK_ADMIN_REALM", "master"),
+			AdminClientID:     getEnv("KEYCLOAK_ADMIN_CLIENT_ID", "admin-cli"),
+			AdminClientSecret: getEnv("KEYCLOAK_ADMIN_CLIENT_SECRET", ""),
+			AdminUser:         getEnv("KEYCLOAK_ADMIN_USER", ""),
+			AdminPassword:     getEnv("KEYCLOAK_ADMIN_PASSWORD", ""),
+		},
+		Invitation: InvitationConfig{
+			ClientID:    getEnv("INVITE_CLIENT_ID", ""),
+			RedirectURI: getEnv("INVITE_REDIRECT_URI", ""),
+			Lifespan:    time.Duration(getEnvInt("INVITE_LIFESPAN_HOURS", 72)) * time.Hour,
+		},
+		Tenant: TenantConfig{
+			ClientID:  getEnv("TENANT_CLIENT_ID", "palika_api"),
+			ScopeName: getEnv("TENANT_SCOPE_NAME", "epalika-default"),
 		},
 	}
 
 	// Validate required fields
-	if cfg.Keycloak.ClientSecret == "" {
-		return nil, fmt.Errorf("KEYCLOAK_CLIENT_SECRET is required")
+	kc := cfg.Keycloak
+	if kc.AdminClientSecret == "" && (kc.AdminUser == "" || kc.AdminPassword == "") {
+		return nil, fmt.Errorf("KEYCLOAK_ADMIN_CLIENT_SECRET, or KEYCLOAK_ADMIN_USER and KEYCLOAK_ADMIN_PASSWORD, are required")
 	}
 
 	return cfg, nil
@@ -45,3 +89,12 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+func getEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if n, err := strconv.Atoi(value); err == nil {
+			return n
+		}
+	}
+	return defaultValue
+}
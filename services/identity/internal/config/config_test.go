@@ -0,0 +1,37 @@
+package config
+
+import "testing"
+
+func TestLoadRequiresAdminCredentials(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		wantErr bool
+	}{
+		{name: "none", wantErr: true},
+		{name: "user without password", env: map[string]string{"KEYCLOAK_ADMIN_USER": "ops"}, wantErr: true},
+		{name: "password without user", env: map[string]string{"KEYCLOAK_ADMIN_PASSWORD": "s3cret"}, wantErr: true},
+		{name: "user and password", env: map[string]string{"KEYCLOAK_ADMIN_USER": "ops", "KEYCLOAK_ADMIN_PASSWORD": "s3cret"}},
+		{name: "client secret", env: map[string]string{"KEYCLOAK_ADMIN_CLIENT_SECRET": "s3cret"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"KEYCLOAK_ADMIN_USER", "KEYCLOAK_ADMIN_PASSWORD", "KEYCLOAK_ADMIN_CLIENT_SECRET"} {
+				t.Setenv(key, tt.env[key])
+			}
+			cfg, err := Load()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("loaded without admin credentials")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.Keycloak.AdminUser != tt.env["KEYCLOAK_ADMIN_USER"] || cfg.Keycloak.AdminPassword != tt.env["KEYCLOAK_ADMIN_PASSWORD"] {
+				t.Errorf("admin user %q, password %q; want the configured ones", cfg.Keycloak.AdminUser, cfg.Keycloak.AdminPassword)
+			}
+		})
+	}
+}
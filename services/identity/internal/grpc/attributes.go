@@ -0,0 +1,291 @@
+package grpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	identityv1 "git.ninjainfosys.com/ePalika/proto/gen/identity/v1"
+)
+
+// Keycloak user attribute keys. Keycloak attributes are multi-valued
+// strings, so structured values are stored as one JSON document per value.
+const (
+	attrLegalName         = "legalName"
+	attrPreferredName     = "preferredName"
+	attrDateOfBirth       = "dateOfBirth"
+	attrPhone             = "phone"
+	attrContact           = "contact"
+	attrGovIDRef          = "govIdRef"
+	attrAddress           = "address"
+	attrOrgUnitID         = "orgUnitId"
+	attrActorType         = "actorType"
+	attrInvitedBy         = "invitedBy"
+	attrInvitedAt         = "invitedAt"
+	attrInviteExpiresAt   = "invitationExpiresAt"
+	attrInviteStatus      = "invitationStatus"
+	attrDeactivatedBy     = "deactivatedBy"
+	attrDeactivatedAt     = "deactivatedAt"
+	attrDeactivatedReason = "deactivatedReason"
+
+	// attrRoleAssignmentPrefix is followed by the role key
+	attrRoleAssignmentPrefix = "roleAssignment."
+
+	// attrTenant is mapped to the tenant claim of the user's tokens
+	attrTenant = "tenant"
+)
+
+// reservedAttrs are the attribute keys the service maintains itself, which
+// free-form invitation attributes may not set
+var reservedAttrs = map[string]bool{
+	attrLegalName:         true,
+	attrPreferredName:     true,
+	attrDateOfBirth:       true,
+	attrPhone:             true,
+	attrContact:           true,
+	attrGovIDRef:          true,
+	attrAddress:           true,
+	attrOrgUnitID:         true,
+	attrActorType:         true,
+	attrInvitedBy:         true,
+	attrInvitedAt:         true,
+	attrInviteExpiresAt:   true,
+	attrInviteStatus:      true,
+	attrDeactivatedBy:     true,
+	attrDeactivatedAt:     true,
+	attrDeactivatedReason: true,
+	attrTenant:            true,
+}
+
+// isReservedAttr reports whether key is maintained by the service. Keycloak
+// compares attribute names case-insensitively in places, so neither is case
+// significant here.
+func isReservedAttr(key string) bool {
+	for reserved := range reservedAttrs {
+		if strings.EqualFold(key, reserved) {
+			return true
+		}
+	}
+	return len(key) >= len(attrRoleAssignmentPrefix) &&
+		strings.EqualFold(key[:len(attrRoleAssignmentPrefix)], attrRoleAssignmentPrefix)
+}
+
+// Keycloak realm role attribute keys backing RoleConstraints
+const (
+	attrRoleScopeTypes   = "scopeTypes"
+	attrRoleRequiresMFA  = "requiresMfa"
+	attrRoleSoDConflicts = "sodConflicts"
+)
+
+// Invitation statuses stored in attrInviteStatus
+const (
+	invitationStatusSent        = "SENT"
+	invitationStatusEmailFailed = "EMAIL_FAILED"
+)
+
+type contactAttr struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type govIDRefAttr struct {
+	Type      string     `json:"type"`
+	Value     string     `json:"value"`
+	IssuedBy  string     `json:"issuedBy,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+type addressAttr struct {
+	Raw        string         `json:"raw"`
+	Normalized map[string]any `json:"normalized,omitempty"`
+	Lat        float64        `json:"lat,omitempty"`
+	Lng        float64        `json:"lng,omitempty"`
+}
+
+type roleAssignmentAttr struct {
+	OrgUnitID  string    `json:"orgUnitId,omitempty"`
+	WardID     string    `json:"wardId,omitempty"`
+	AssignedBy string    `json:"assignedBy"`
+	AssignedAt time.Time `json:"assignedAt"`
+}
+
+// setAttr sets a single-valued attribute, removing it when value is empty
+func setAttr(attrs map[string][]string, key, value string) {
+	if value == "" {
+		delete(attrs, key)
+		return
+	}
+	attrs[key] = []string{value}
+}
+
+// firstAttr returns the first value of an attribute
+func firstAttr(attrs map[string][]string, key string) string {
+	if values, ok := attrs[key]; ok && len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// appendJSONAttr appends v encoded as JSON to a multi-valued attribute
+func appendJSONAttr(attrs map[string][]string, key string, v any) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+	attrs[key] = append(attrs[key], string(raw))
+	return nil
+}
+
+// personInputToAttributes maps an invitation's person details to Keycloak
+// user attributes
+func personInputToAttributes(input *identityv1.InviteUserInput) (map[string][]string, error) {
+	attrs := make(map[string][]string)
+	setAttr(attrs, attrPhone, input.Phone)
+	setAttr(attrs, attrOrgUnitID, input.OrgUnitId)
+	setAttr(attrs, attrActorType, input.ActorType)
+
+	// Free-form attributes are stored as-is; only scalar values are supported
+	for key, value := range input.GetAttributes().GetFields() {
+		if isReservedAttr(key) {
+			return nil, fmt.Errorf("attribute %q is reserved", key)
+		}
+		if _, isList := value.GetKind().(*structpb.Value_ListValue); isList {
+			for _, v := range value.GetListValue().GetValues() {
+				attrs[key] = append(attrs[key], structValueString(v))
+			}
+			continue
+		}
+		setAttr(attrs, key, structValueString(value))
+	}
+
+	person := input.Person
+	if person == nil {
+		return attrs, nil
+	}
+
+	setAttr(attrs, attrLegalName, person.LegalName)
+	setAttr(attrs, attrPreferredName, person.PreferredName)
+	setAttr(attrs, attrDateOfBirth, person.DateOfBirth)
+
+	for _, c := range person.Contacts {
+		if c.Type == "" || c.Value == "" {
+			return nil, fmt.Errorf("contact type and value are required")
+		}
+		if err := appendJSONAttr(attrs, attrContact, contactAttr{Type: c.Type, Value: c.Value}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, g := range person.GovIdRefs {
+		if g.Type == "" || g.Value == "" {
+			return nil, fmt.Errorf("government ID type and value are required")
+		}
+		ref := govIDRefAttr{Type: g.Type, Value: g.Value, IssuedBy: g.IssuedBy}
+		if g.ExpiresAt != nil {
+			expiresAt := g.ExpiresAt.AsTime()
+			ref.ExpiresAt = &expiresAt
+		}
+		if err := appendJSONAttr(attrs, attrGovIDRef, ref); err != nil {
+			return nil, err
+		}
+	}
+
+	if addr := person.PrimaryAddress; addr != nil && addr.Raw != "" {
+		a := addressAttr{Raw: addr.Raw, Lat: addr.Lat, Lng: addr.Lng}
+		if addr.Normalized != nil {
+			a.Normalized = addr.Normalized.AsMap()
+		}
+		if err := appendJSONAttr(attrs, attrAddress, a); err != nil {
+			return nil, err
+		}
+	}
+
+	return attrs, nil
+}
+
+// attributesToPerson rebuilds a Person from Keycloak user attributes
+func attributesToPerson(id string, attrs map[string][]string) *identityv1.Person {
+	person := &identityv1.Person{
+		Id:            id,
+		LegalName:     firstAttr(attrs, attrLegalName),
+		PreferredName: firstAttr(attrs, attrPreferredName),
+		DateOfBirth:   firstAttr(attrs, attrDateOfBirth),
+	}
+
+	for _, raw := range attrs[attrContact] {
+		var c contactAttr
+		if json.Unmarshal([]byte(raw), &c) == nil {
+			person.Contacts = append(person.Contacts, &identityv1.Contact{Type: c.Type, Value: c.Value})
+		}
+	}
+
+	for _, raw := range attrs[attrGovIDRef] {
+		var g govIDRefAttr
+		if json.Unmarshal([]byte(raw), &g) != nil {
+			continue
+		}
+		ref := &identityv1.GovIdRef{Type: g.Type, Value: g.Value, IssuedBy: g.IssuedBy}
+		if g.ExpiresAt != nil {
+			ref.ExpiresAt = timestamppb.New(*g.ExpiresAt)
+		}
+		person.GovIdRefs = append(person.GovIdRefs, ref)
+	}
+
+	if raw := firstAttr(attrs, attrAddress); raw != "" {
+		var a addressAttr
+		if json.Unmarshal([]byte(raw), &a) == nil {
+			address := &identityv1.Address{
+				Raw:    a.Raw,
+				Status: identityv1.AddressStatus_ADDRESS_STATUS_UNVERIFIED,
+			}
+			if a.Lat != 0 || a.Lng != 0 {
+				address.Geo = &identityv1.GeoPoint{Lat: a.Lat, Lng: a.Lng}
+			}
+			if a.Normalized != nil {
+				address.Normalized, _ = structpb.NewStruct(a.Normalized)
+			}
+			person.PrimaryAddress = address
+		}
+	}
+
+	return person
+}
+
+// roleAssignmentKey returns the attribute key holding a role's scope
+func roleAssignmentKey(roleKey string) string {
+	return attrRoleAssignmentPrefix + roleKey
+}
+
+// userRoleID builds the composite ID used for UserRole
+func userRoleID(userID, roleKey string) string {
+	return userID + ":" + roleKey
+}
+
+// parseUserRoleID splits a composite UserRole ID
+func parseUserRoleID(id string) (userID, roleKey string, ok bool) {
+	userID, roleKey, ok = strings.Cut(id, ":")
+	if !ok || userID == "" || roleKey == "" {
+		return "", "", false
+	}
+	return userID, roleKey, true
+}
+
+func structValueString(v *structpb.Value) string {
+	switch kind := v.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		return kind.StringValue
+	case *structpb.Value_BoolValue:
+		return fmt.Sprintf("%t", kind.BoolValue)
+	case *structpb.Value_NumberValue:
+		return fmt.Sprintf("%v", kind.NumberValue)
+	case *structpb.Value_NullValue, nil:
+		return ""
+	default:
+		raw, _ := json.Marshal(v.AsInterface())
+		return string(raw)
+	}
+}
@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	identityv1 "git.ninjainfosys.com/ePalika/proto/gen/identity/v1"
+)
+
+func TestPersonInputToAttributesRejectsReservedKeys(t *testing.T) {
+	for _, key := range []string{
+		"orgUnitId",
+		"ORGUNITID",
+		"invitationStatus",
+		"deactivatedBy",
+		"deactivatedAt",
+		"tenant",
+		"roleAssignment.darta_registrar",
+		"RoleAssignment.identity_admin",
+	} {
+		t.Run(key, func(t *testing.T) {
+			attributes, err := structpb.NewStruct(map[string]any{key: "forged"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = personInputToAttributes(&identityv1.InviteUserInput{
+				OrgUnitId:  "unit-1",
+				Attributes: attributes,
+			})
+			if err == nil {
+				t.Fatalf("attribute %q was accepted", key)
+			}
+		})
+	}
+}
+
+func TestPersonInputToAttributesKeepsFreeFormKeys(t *testing.T) {
+	attributes, err := structpb.NewStruct(map[string]any{
+		"employeeCode": "E-17",
+		"languages":    []any{"ne", "en"},
+		"roleNotes":    "acting head",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attrs, err := personInputToAttributes(&identityv1.InviteUserInput{
+		OrgUnitId:  "unit-1",
+		Attributes: attributes,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := firstAttr(attrs, attrOrgUnitID); got != "unit-1" {
+		t.Errorf("orgUnitId = %q, want unit-1", got)
+	}
+	if got := firstAttr(attrs, "employeeCode"); got != "E-17" {
+		t.Errorf("employeeCode = %q, want E-17", got)
+	}
+	if got := attrs["languages"]; len(got) != 2 || got[0] != "ne" || got[1] != "en" {
+		t.Errorf("languages = %v, want [ne en]", got)
+	}
+	if got := firstAttr(attrs, "roleNotes"); got != "acting head" {
+		t.Errorf("roleNotes = %q, want acting head", got)
+	}
+}
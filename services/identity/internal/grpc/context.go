@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"git.ninjainfosys.com/ePalika/services/identity/internal/keycloak"
+)
+
+// tenantSlugPattern restricts tenant slugs (and so realm names) to the
+// characters accepted by scripts and Oathkeeper
+var tenantSlugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,62}$`)
+
+// actorFromContext returns the calling user ID forwarded by the gateway,
+// or "system" for internal callers
+func actorFromContext(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("x-user-id"); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return "system"
+}
+
+// TenantInterceptor selects the Keycloak realm for each call from the
+// x-tenant header set by Oathkeeper. Calls without a tenant use defaultRealm.
+// The master and admin realms are never tenants: their tokens administer
+// every realm.
+func TenantInterceptor(defaultRealm, adminRealm string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		realm := defaultRealm
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("x-tenant"); len(values) > 0 && values[0] != "" {
+				realm = values[0]
+				if realm == "master" || realm == adminRealm {
+					return nil, status.Errorf(codes.PermissionDenied, "tenant %q is not allowed", realm)
+				}
+			}
+		}
+		if !tenantSlugPattern.MatchString(realm) && realm != defaultRealm {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tenant %q", realm)
+		}
+
+		return handler(keycloak.WithRealm(ctx, realm), req)
+	}
+}
+
+// platformAdminRole may onboard and offboard tenants
+const platformAdminRole = "platform_admin"
+
+// requirePlatformAdmin allows a call only to platform admins of the default
+// realm. The role is not honoured in tenant realms, whose own admins could
+// grant it to themselves.
+func (s *IdentityServer) requirePlatformAdmin(ctx context.Context) error {
+	if realm := keycloak.RealmFromContext(ctx); realm != s.keycloakClient.DefaultRealm() {
+		return status.Error(codes.PermissionDenied, "tenants are managed from the platform realm only")
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get("x-roles") {
+			for _, role := range strings.Split(value, ",") {
+				if strings.TrimSpace(role) == platformAdminRole {
+					return nil
+				}
+			}
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "requires the %s role", platformAdminRole)
+}
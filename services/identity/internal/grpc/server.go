@@ -2,6 +2,10 @@ package grpc
 
 import (
 	"context"
+	"log"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/Nerzal/gocloak/v13"
@@ -10,6 +14,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	identityv1 "git.ninjainfosys.com/ePalika/proto/gen/identity/v1"
+	"git.ninjainfosys.com/ePalika/services/identity/internal/config"
 	"git.ninjainfosys.com/ePalika/services/identity/internal/keycloak"
 )
 
@@ -17,12 +22,16 @@ import (
 type IdentityServer struct {
 	identityv1.UnimplementedIdentityServiceServer
 	keycloakClient *keycloak.Client
+	invitation     config.InvitationConfig
+	tenant         config.TenantConfig
 }
 
 // NewIdentityServer creates a new IdentityServer
-func NewIdentityServer(keycloakClient *keycloak.Client) *IdentityServer {
+func NewIdentityServer(keycloakClient *keycloak.Client, invitation config.InvitationConfig, tenant config.TenantConfig) *IdentityServer {
 	return &IdentityServer{
 		keycloakClient: keycloakClient,
+		invitation:     invitation,
+		tenant:         tenant,
 	}
 }
 
@@ -51,17 +60,42 @@ func (s *IdentityServer) GetUser(ctx context.Context, req *identityv1.GetUserReq
 	}, nil
 }
 
-// ListUsers retrieves a list of users with filters
+// ListUsers retrieves a page of users matching the filters, with the total
+// number of matches
 func (s *IdentityServer) ListUsers(ctx context.Context, req *identityv1.ListUsersRequest) (*identityv1.ListUsersResponse, error) {
-	params := gocloak.GetUsersParams{
-		Max: gocloak.IntP(int(req.Limit)),
+	if req.AttributeValue != "" && req.AttributeKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "attribute_key is required with attribute_value")
 	}
 
-	if req.Search != "" {
-		params.Search = gocloak.StringP(req.Search)
+	first := int(req.Offset)
+	if first < 0 {
+		first = 0
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultUserPageSize
+	}
+	if limit > maxUserPageSize {
+		limit = maxUserPageSize
+	}
+
+	filter := userFilter{
+		search:         req.Search,
+		enabled:        enabledFilter(req),
+		attributeKey:   req.AttributeKey,
+		attributeValue: req.AttributeValue,
 	}
 
-	kcUsers, err := s.keycloakClient.GetUsers(ctx, params)
+	var (
+		kcUsers []*gocloak.User
+		total   int
+		err     error
+	)
+	if req.OrgUnitId != "" || req.RoleKey != "" {
+		kcUsers, total, err = s.listUsersByMembership(ctx, req.OrgUnitId, req.RoleKey, filter, first, limit)
+	} else {
+		kcUsers, total, err = s.listUsersByQuery(ctx, filter, first, limit)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
 	}
@@ -73,38 +107,121 @@ func (s *IdentityServer) ListUsers(ctx context.Context, req *identityv1.ListUser
 
 	return &identityv1.ListUsersResponse{
 		Users: users,
-		Total: int64(len(users)),
+		Total: int64(total),
 	}, nil
 }
 
-// InviteUser creates a new user
+// GetUsersByIds retrieves several users at once. Unknown IDs are reported in
+// missing_ids rather than failing the request; any other lookup failure fails
+// it.
+func (s *IdentityServer) GetUsersByIds(ctx context.Context, req *identityv1.GetUsersByIdsRequest) (*identityv1.GetUsersByIdsResponse, error) {
+	if len(req.Ids) > maxBulkUserLookup {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d IDs may be requested", maxBulkUserLookup)
+	}
+
+	ids := uniqueNonEmpty(req.Ids)
+	found := make([]*gocloak.User, len(ids))
+	errs := make([]error, len(ids))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, bulkLookupConcurrency)
+	for i, id := range ids {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			kcUser, err := s.keycloakClient.GetUser(ctx, id)
+			switch {
+			case err == nil:
+				found[i] = kcUser
+			case !keycloak.IsNotFound(err):
+				errs[i] = err
+			}
+		}(i, id)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	// Only users Keycloak reports as absent are missing; any other failure
+	// would otherwise hide existing users from the caller
+	for i, err := range errs {
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to look up user %s: %v", ids[i], err)
+		}
+	}
+
+	resp := &identityv1.GetUsersByIdsResponse{}
+	for i, kcUser := range found {
+		if kcUser == nil {
+			resp.MissingIds = append(resp.MissingIds, ids[i])
+			continue
+		}
+		resp.Users = append(resp.Users, convertKeycloakUserToProto(kcUser))
+	}
+
+	return resp, nil
+}
+
+// InviteUser creates a Keycloak user, places them in their org unit with the
+// requested roles and emails them a link to verify their address and set a
+// password
 func (s *IdentityServer) InviteUser(ctx context.Context, req *identityv1.InviteUserRequest) (*identityv1.InviteUserResponse, error) {
 	if req.Input == nil {
 		return nil, status.Error(codes.InvalidArgument, "input is required")
 	}
+	input := req.Input
+	if input.Username == "" {
+		return nil, status.Error(codes.InvalidArgument, "username is required")
+	}
+	if input.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	// Resolve org unit and roles before creating anything
+	if input.OrgUnitId != "" {
+		if _, err := s.keycloakClient.GetGroup(ctx, input.OrgUnitId); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "org unit not found: %v", err)
+		}
+	}
+	roles, err := s.resolveRoles(ctx, input.RoleKeys)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enforceSoD(ctx, "", roles); err != nil {
+		return nil, err
+	}
+
+	attributes, err := personInputToAttributes(input)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user details: %v", err)
+	}
+
+	actor := actorFromContext(ctx)
+	now := time.Now()
+	expiresAt := now.Add(s.invitation.Lifespan)
+	setAttr(attributes, attrInvitedBy, actor)
+	setAttr(attributes, attrInvitedAt, now.UTC().Format(time.RFC3339))
+	setAttr(attributes, attrInviteExpiresAt, expiresAt.UTC().Format(time.RFC3339))
+
+	requiredActions := []string{"VERIFY_EMAIL", "UPDATE_PASSWORD"}
 
 	// Create Keycloak user
 	enabled := true
 	kcUser := gocloak.User{
-		Username:      gocloak.StringP(req.Input.Username),
-		Email:         gocloak.StringP(req.Input.Email),
-		Enabled:       &enabled,
-		EmailVerified: gocloak.BoolP(false),
+		Username:        gocloak.StringP(input.Username),
+		Email:           gocloak.StringP(input.Email),
+		Enabled:         &enabled,
+		EmailVerified:   gocloak.BoolP(false),
+		RequiredActions: &requiredActions,
+		Attributes:      &attributes,
 	}
-
-	// Add custom attributes for person data
-	if req.Input.Person != nil {
-		attributes := make(map[string][]string)
-		if req.Input.Person.LegalName != "" {
-			attributes["legalName"] = []string{req.Input.Person.LegalName}
-		}
-		if req.Input.Person.PreferredName != "" {
-			attributes["preferredName"] = []string{req.Input.Person.PreferredName}
-		}
-		if req.Input.Person.DateOfBirth != "" {
-			attributes["dateOfBirth"] = []string{req.Input.Person.DateOfBirth}
-		}
-		kcUser.Attributes = &attributes
+	if input.Person != nil && input.Person.PreferredName != "" {
+		kcUser.FirstName = gocloak.StringP(input.Person.PreferredName)
+	} else if input.Person != nil && input.Person.LegalName != "" {
+		kcUser.FirstName = gocloak.StringP(input.Person.LegalName)
 	}
 
 	userID, err := s.keycloakClient.CreateUser(ctx, kcUser)
@@ -112,14 +229,95 @@ func (s *IdentityServer) InviteUser(ctx context.Context, req *identityv1.InviteU
 		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
 	}
 
-	// Retrieve the created user
+	// Roles and group membership are part of the invitation; undo the user if
+	// either fails so the invite can be retried cleanly
+	if len(roles) > 0 {
+		if err := s.keycloakClient.AddRealmRoleToUser(ctx, userID, roles); err != nil {
+			s.rollbackInvite(ctx, userID)
+			return nil, status.Errorf(codes.Internal, "failed to assign roles: %v", err)
+		}
+	}
+	if input.OrgUnitId != "" {
+		if err := s.keycloakClient.AddUserToGroup(ctx, userID, input.OrgUnitId); err != nil {
+			s.rollbackInvite(ctx, userID)
+			return nil, status.Errorf(codes.Internal, "failed to add user to org unit: %v", err)
+		}
+	}
+
+	// Send the invitation email. A mail failure does not undo the invite; the
+	// status is recorded so an administrator can resend it.
+	emailSent := true
+	inviteStatus := invitationStatusSent
+	if err := s.sendInvitationEmail(ctx, userID, input.RedirectUri, requiredActions); err != nil {
+		log.Printf("invitation email for user %s failed: %v", userID, err)
+		emailSent = false
+		inviteStatus = invitationStatusEmailFailed
+	}
+
+	// Retrieve the created user and record the invitation outcome
 	createdUser, err := s.keycloakClient.GetUser(ctx, userID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to retrieve created user: %v", err)
 	}
+	if createdUser.Attributes == nil {
+		createdUser.Attributes = &map[string][]string{}
+	}
+	setAttr(*createdUser.Attributes, attrInviteStatus, inviteStatus)
+	if err := s.keycloakClient.UpdateUser(ctx, *createdUser); err != nil {
+		log.Printf("failed to record invitation for user %s: %v", userID, err)
+	}
+
+	user := convertKeycloakUserToProto(createdUser)
+	for _, role := range roles {
+		user.Roles = append(user.Roles, getStringValue(role.Name))
+	}
 
 	return &identityv1.InviteUserResponse{
-		User: convertKeycloakUserToProto(createdUser),
+		User: user,
+		Invitation: &identityv1.Invitation{
+			UserId:          userID,
+			Email:           input.Email,
+			OrgUnitId:       input.OrgUnitId,
+			RoleKeys:        input.RoleKeys,
+			RequiredActions: requiredActions,
+			InvitedBy:       actor,
+			SentAt:          timestamppb.New(now),
+			ExpiresAt:       timestamppb.New(expiresAt),
+			EmailSent:       emailSent,
+		},
+	}, nil
+}
+
+// DeactivateUser disables a user's account and ends their sessions
+func (s *IdentityServer) DeactivateUser(ctx context.Context, req *identityv1.DeactivateUserRequest) (*identityv1.DeactivateUserResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+
+	kcUser, err := s.keycloakClient.GetUser(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
+	}
+
+	if kcUser.Attributes == nil {
+		kcUser.Attributes = &map[string][]string{}
+	}
+	attrs := *kcUser.Attributes
+	setAttr(attrs, attrDeactivatedBy, actorFromContext(ctx))
+	setAttr(attrs, attrDeactivatedAt, time.Now().UTC().Format(time.RFC3339))
+	setAttr(attrs, attrDeactivatedReason, req.Reason)
+	kcUser.Enabled = gocloak.BoolP(false)
+
+	if err := s.keycloakClient.UpdateUser(ctx, *kcUser); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to deactivate user: %v", err)
+	}
+
+	if err := s.keycloakClient.LogoutAllSessions(ctx, req.Id); err != nil {
+		log.Printf("failed to end sessions for deactivated user %s: %v", req.Id, err)
+	}
+
+	return &identityv1.DeactivateUserResponse{
+		User: convertKeycloakUserToProto(kcUser),
 	}, nil
 }
 
@@ -208,6 +406,113 @@ func (s *IdentityServer) ListRoles(ctx context.Context, req *identityv1.ListRole
 	}, nil
 }
 
+// AssignRole grants a realm role to a user, recording its org unit or ward
+// scope on the user
+func (s *IdentityServer) AssignRole(ctx context.Context, req *identityv1.AssignRoleRequest) (*identityv1.AssignRoleResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+	if req.RoleKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "role key is required")
+	}
+
+	kcUser, err := s.keycloakClient.GetUser(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
+	}
+	roles, err := s.resolveRoles(ctx, []string{req.RoleKey})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enforceSoD(ctx, req.UserId, roles); err != nil {
+		return nil, err
+	}
+	if req.OrgUnitId != "" {
+		if _, err := s.keycloakClient.GetGroup(ctx, req.OrgUnitId); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "org unit not found: %v", err)
+		}
+	}
+
+	if err := s.keycloakClient.AddRealmRoleToUser(ctx, req.UserId, roles); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to assign role: %v", err)
+	}
+
+	assignment := roleAssignmentAttr{
+		OrgUnitID:  req.OrgUnitId,
+		WardID:     req.WardId,
+		AssignedBy: actorFromContext(ctx),
+		AssignedAt: time.Now().UTC(),
+	}
+	if kcUser.Attributes == nil {
+		kcUser.Attributes = &map[string][]string{}
+	}
+	attrs := *kcUser.Attributes
+	delete(attrs, roleAssignmentKey(req.RoleKey))
+	if err := appendJSONAttr(attrs, roleAssignmentKey(req.RoleKey), assignment); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record role scope: %v", err)
+	}
+	if err := s.keycloakClient.UpdateUser(ctx, *kcUser); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record role scope: %v", err)
+	}
+
+	return &identityv1.AssignRoleResponse{
+		UserRole: &identityv1.UserRole{
+			Id:         userRoleID(req.UserId, req.RoleKey),
+			UserId:     req.UserId,
+			RoleKey:    req.RoleKey,
+			OrgUnitId:  assignment.OrgUnitID,
+			WardId:     assignment.WardID,
+			AssignedAt: timestamppb.New(assignment.AssignedAt),
+			AssignedBy: assignment.AssignedBy,
+		},
+	}, nil
+}
+
+// RevokeRole removes a realm role from a user
+func (s *IdentityServer) RevokeRole(ctx context.Context, req *identityv1.RevokeRoleRequest) (*identityv1.RevokeRoleResponse, error) {
+	userID, roleKey, ok := parseUserRoleID(req.UserRoleId)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "user role ID must be <user_id>:<role_key>")
+	}
+
+	kcUser, err := s.keycloakClient.GetUser(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
+	}
+
+	assigned, err := s.keycloakClient.GetUserRealmRoles(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user roles: %v", err)
+	}
+	var role *gocloak.Role
+	for _, r := range assigned {
+		if getStringValue(r.Name) == roleKey {
+			role = r
+			break
+		}
+	}
+	if role == nil {
+		return nil, status.Errorf(codes.NotFound, "role %s is not assigned to user", roleKey)
+	}
+
+	if err := s.keycloakClient.DeleteRealmRoleFromUser(ctx, userID, []gocloak.Role{*role}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke role: %v", err)
+	}
+
+	if kcUser.Attributes != nil {
+		if _, ok := (*kcUser.Attributes)[roleAssignmentKey(roleKey)]; ok {
+			delete(*kcUser.Attributes, roleAssignmentKey(roleKey))
+			if err := s.keycloakClient.UpdateUser(ctx, *kcUser); err != nil {
+				log.Printf("failed to clear role scope for user %s: %v", userID, err)
+			}
+		}
+	}
+
+	return &identityv1.RevokeRoleResponse{
+		Success: true,
+	}, nil
+}
+
 // GetGrant retrieves a grant by ID
 func (s *IdentityServer) GetGrant(ctx context.Context, req *identityv1.GetGrantRequest) (*identityv1.GetGrantResponse, error) {
 	// Grants are not directly stored in Keycloak - would need separate database
@@ -241,6 +546,234 @@ func (s *IdentityServer) HealthCheck(ctx context.Context, req *identityv1.Health
 	}, nil
 }
 
+const (
+	defaultUserPageSize   = 20
+	maxUserPageSize       = 200
+	maxBulkUserLookup     = 500
+	bulkLookupConcurrency = 8
+
+	// membershipPageSize is the batch size used when walking group or role
+	// members, which Keycloak cannot count or filter server-side
+	membershipPageSize = 500
+)
+
+// userFilter holds the ListUsers filters that apply to individual users
+type userFilter struct {
+	search         string
+	enabled        *bool
+	attributeKey   string
+	attributeValue string
+}
+
+// query returns the Keycloak attribute query ("key:value") for the filter
+func (f userFilter) query() *string {
+	if f.attributeKey == "" {
+		return nil
+	}
+	return gocloak.StringP(f.attributeKey + ":" + f.attributeValue)
+}
+
+// matches applies the filter to a user in memory
+func (f userFilter) matches(u *gocloak.User) bool {
+	if f.enabled != nil && (u.Enabled == nil || *u.Enabled != *f.enabled) {
+		return false
+	}
+	if f.search != "" {
+		needle := strings.ToLower(f.search)
+		found := false
+		for _, field := range []*string{u.Username, u.Email, u.FirstName, u.LastName} {
+			if field != nil && strings.Contains(strings.ToLower(*field), needle) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.attributeKey != "" {
+		if u.Attributes == nil {
+			return false
+		}
+		values, ok := (*u.Attributes)[f.attributeKey]
+		if !ok {
+			return false
+		}
+		if f.attributeValue != "" && !slices.Contains(values, f.attributeValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// enabledFilter derives the enabled filter from the explicit flag or status
+func enabledFilter(req *identityv1.ListUsersRequest) *bool {
+	if req.Enabled != nil {
+		return req.Enabled
+	}
+	switch req.Status {
+	case identityv1.UserStatus_USER_STATUS_ACTIVE:
+		return gocloak.BoolP(true)
+	case identityv1.UserStatus_USER_STATUS_DISABLED, identityv1.UserStatus_USER_STATUS_INACTIVE:
+		return gocloak.BoolP(false)
+	}
+	return nil
+}
+
+// listUsersByQuery pages through users using Keycloak's own filtering and
+// count endpoint
+func (s *IdentityServer) listUsersByQuery(ctx context.Context, f userFilter, first, limit int) ([]*gocloak.User, int, error) {
+	params := gocloak.GetUsersParams{
+		Enabled: f.enabled,
+		Q:       f.query(),
+	}
+	if f.search != "" {
+		params.Search = gocloak.StringP(f.search)
+	}
+
+	total, err := s.keycloakClient.GetUserCount(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	params.First = gocloak.IntP(first)
+	params.Max = gocloak.IntP(limit)
+	users, err := s.keycloakClient.GetUsers(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+// listUsersByMembership lists members of an org unit and/or holders of a
+// role. Keycloak offers neither counts nor filters on these endpoints, so the
+// members are collected and filtered here before paging.
+func (s *IdentityServer) listUsersByMembership(ctx context.Context, orgUnitID, roleKey string, f userFilter, first, limit int) ([]*gocloak.User, int, error) {
+	var candidates []*gocloak.User
+	var err error
+	if orgUnitID != "" {
+		candidates, err = s.collectUsers(func(first, max int) ([]*gocloak.User, error) {
+			return s.keycloakClient.GetGroupMembers(ctx, orgUnitID, gocloak.GetGroupsParams{
+				First: gocloak.IntP(first),
+				Max:   gocloak.IntP(max),
+			})
+		})
+	} else {
+		candidates, err = s.collectUsers(func(first, max int) ([]*gocloak.User, error) {
+			return s.keycloakClient.GetUsersByRoleName(ctx, roleKey, gocloak.GetUsersByRoleParams{
+				First: gocloak.IntP(first),
+				Max:   gocloak.IntP(max),
+			})
+		})
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var roleHolders map[string]bool
+	if orgUnitID != "" && roleKey != "" {
+		holders, err := s.collectUsers(func(first, max int) ([]*gocloak.User, error) {
+			return s.keycloakClient.GetUsersByRoleName(ctx, roleKey, gocloak.GetUsersByRoleParams{
+				First: gocloak.IntP(first),
+				Max:   gocloak.IntP(max),
+			})
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		roleHolders = make(map[string]bool, len(holders))
+		for _, u := range holders {
+			roleHolders[getStringValue(u.ID)] = true
+		}
+	}
+
+	matched := make([]*gocloak.User, 0, len(candidates))
+	for _, u := range candidates {
+		if roleHolders != nil && !roleHolders[getStringValue(u.ID)] {
+			continue
+		}
+		if f.matches(u) {
+			matched = append(matched, u)
+		}
+	}
+
+	total := len(matched)
+	if first >= total {
+		return []*gocloak.User{}, total, nil
+	}
+	end := min(first+limit, total)
+	return matched[first:end], total, nil
+}
+
+// collectUsers drains a paged Keycloak user endpoint
+func (s *IdentityServer) collectUsers(page func(first, max int) ([]*gocloak.User, error)) ([]*gocloak.User, error) {
+	var all []*gocloak.User
+	for first := 0; ; first += membershipPageSize {
+		users, err := page(first, membershipPageSize)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, users...)
+		if len(users) < membershipPageSize {
+			return all, nil
+		}
+	}
+}
+
+func uniqueNonEmpty(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out
+}
+
+// resolveRoles looks up realm roles by key, failing with InvalidArgument
+// if any are unknown
+func (s *IdentityServer) resolveRoles(ctx context.Context, keys []string) ([]gocloak.Role, error) {
+	roles := make([]gocloak.Role, 0, len(keys))
+	for _, key := range keys {
+		role, err := s.keycloakClient.GetRoleByName(ctx, key)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown role %q: %v", key, err)
+		}
+		roles = append(roles, *role)
+	}
+	return roles, nil
+}
+
+// sendInvitationEmail triggers Keycloak's execute-actions email
+func (s *IdentityServer) sendInvitationEmail(ctx context.Context, userID, redirectURI string, actions []string) error {
+	if redirectURI == "" {
+		redirectURI = s.invitation.RedirectURI
+	}
+	lifespan := int(s.invitation.Lifespan.Seconds())
+	params := gocloak.ExecuteActionsEmail{
+		UserID:   gocloak.StringP(userID),
+		Lifespan: &lifespan,
+		Actions:  &actions,
+	}
+	if s.invitation.ClientID != "" {
+		params.ClientID = gocloak.StringP(s.invitation.ClientID)
+		if redirectURI != "" {
+			params.RedirectURI = gocloak.StringP(redirectURI)
+		}
+	}
+	return s.keycloakClient.ExecuteActionsEmail(ctx, params)
+}
+
+// rollbackInvite deletes a partially provisioned user
+func (s *IdentityServer) rollbackInvite(ctx context.Context, userID string) {
+	if err := s.keycloakClient.DeleteUser(ctx, userID); err != nil {
+		log.Printf("failed to roll back invited user %s: %v", userID, err)
+	}
+}
+
 // Helper functions to convert Keycloak types to proto types
 
 func convertKeycloakUserToProto(kcUser *gocloak.User) *identityv1.User {
@@ -251,27 +784,19 @@ func convertKeycloakUserToProto(kcUser *gocloak.User) *identityv1.User {
 		Status:   identityv1.UserStatus_USER_STATUS_ACTIVE,
 	}
 
-	if kcUser.Enabled != nil && !*kcUser.Enabled {
-		user.Status = identityv1.UserStatus_USER_STATUS_DISABLED
-	}
-
 	// Extract person data from attributes
 	if kcUser.Attributes != nil {
 		attrs := *kcUser.Attributes
-		person := &identityv1.Person{
-			Id: user.Id,
-		}
-		if legalName, ok := attrs["legalName"]; ok && len(legalName) > 0 {
-			person.LegalName = legalName[0]
-			user.FullName = legalName[0]
-		}
-		if preferredName, ok := attrs["preferredName"]; ok && len(preferredName) > 0 {
-			person.PreferredName = preferredName[0]
-		}
-		if dob, ok := attrs["dateOfBirth"]; ok && len(dob) > 0 {
-			person.DateOfBirth = dob[0]
+		user.Person = attributesToPerson(user.Id, attrs)
+		user.FullName = user.Person.LegalName
+		user.Phone = firstAttr(attrs, attrPhone)
+		if firstAttr(attrs, attrInviteStatus) != "" && kcUser.EmailVerified != nil && !*kcUser.EmailVerified {
+			user.Status = identityv1.UserStatus_USER_STATUS_PENDING_VERIFICATION
 		}
-		user.Person = person
+	}
+
+	if kcUser.Enabled != nil && !*kcUser.Enabled {
+		user.Status = identityv1.UserStatus_USER_STATUS_DISABLED
 	}
 
 	if kcUser.CreatedTimestamp != nil {
@@ -310,10 +835,7 @@ func convertKeycloakRoleToProto(kcRole *gocloak.Role) *identityv1.Role {
 		role.Description = *kcRole.Description
 	}
 
-	// Constraints would need to be stored in role attributes
-	role.Constraints = &identityv1.RoleConstraints{
-		RequiresMfa: false,
-	}
+	role.Constraints = roleConstraints(kcRole)
 
 	return role
 }
@@ -0,0 +1,127 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Nerzal/gocloak/v13"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	identityv1 "git.ninjainfosys.com/ePalika/proto/gen/identity/v1"
+)
+
+// auditCategorySoDViolation tags the log line written for a refused role
+// grant. The identity service keeps no audit trail of its own, so the service
+// log is the only record of the refusal.
+const auditCategorySoDViolation = "SOD_VIOLATION"
+
+// roleConstraints reads RoleConstraints from Keycloak role attributes
+func roleConstraints(kcRole *gocloak.Role) *identityv1.RoleConstraints {
+	constraints := &identityv1.RoleConstraints{}
+	if kcRole.Attributes == nil {
+		return constraints
+	}
+	attrs := *kcRole.Attributes
+
+	constraints.RequiresMfa = firstAttr(attrs, attrRoleRequiresMFA) == "true"
+	constraints.SodConflicts = append(constraints.SodConflicts, attrs[attrRoleSoDConflicts]...)
+	for _, t := range attrs[attrRoleScopeTypes] {
+		if v, ok := identityv1.OrgUnitType_value[t]; ok {
+			constraints.ScopeTypes = append(constraints.ScopeTypes, identityv1.OrgUnitType(v))
+		}
+	}
+	return constraints
+}
+
+// sodConflict describes two roles that must not be held by the same user
+type sodConflict struct {
+	role        string
+	conflicting string
+}
+
+func (c sodConflict) String() string {
+	return fmt.Sprintf("%s conflicts with %s", c.role, c.conflicting)
+}
+
+// findSoDConflicts returns the conflicts between the roles being granted and
+// each other or the roles the user already holds. Conflicts are symmetric: a
+// rule declared on either role applies.
+func findSoDConflicts(held, granted []gocloak.Role) []sodConflict {
+	conflictsOf := func(r gocloak.Role) []string {
+		if r.Attributes == nil {
+			return nil
+		}
+		return (*r.Attributes)[attrRoleSoDConflicts]
+	}
+	declares := func(a, b gocloak.Role) bool {
+		for _, key := range conflictsOf(a) {
+			if key == getStringValue(b.Name) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var found []sodConflict
+	seen := make(map[string]bool)
+	check := func(a, b gocloak.Role) {
+		nameA, nameB := getStringValue(a.Name), getStringValue(b.Name)
+		if nameA == nameB || !(declares(a, b) || declares(b, a)) {
+			return
+		}
+		pair := []string{nameA, nameB}
+		sort.Strings(pair)
+		if key := strings.Join(pair, "|"); !seen[key] {
+			seen[key] = true
+			found = append(found, sodConflict{role: nameA, conflicting: nameB})
+		}
+	}
+
+	for i, g := range granted {
+		for _, h := range held {
+			check(g, h)
+		}
+		for _, other := range granted[i+1:] {
+			check(g, other)
+		}
+	}
+	return found
+}
+
+// enforceSoD refuses a role grant that would give a user conflicting roles.
+// Refusals are logged, tagged with the SOD_VIOLATION category.
+func (s *IdentityServer) enforceSoD(ctx context.Context, userID string, granted []gocloak.Role) error {
+	var held []gocloak.Role
+	if userID != "" {
+		assigned, err := s.keycloakClient.GetUserRealmRoles(ctx, userID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get user roles: %v", err)
+		}
+		// Role mappings are returned without attributes; load each role in full
+		keys := make([]string, 0, len(assigned))
+		for _, r := range assigned {
+			keys = append(keys, getStringValue(r.Name))
+		}
+		if held, err = s.resolveRoles(ctx, keys); err != nil {
+			return err
+		}
+	}
+
+	conflicts := findSoDConflicts(held, granted)
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, len(conflicts))
+	for i, c := range conflicts {
+		descriptions[i] = c.String()
+	}
+	log.Printf("audit category=%s actor=%s user=%s refused role grant: %s",
+		auditCategorySoDViolation, actorFromContext(ctx), userID, strings.Join(descriptions, "; "))
+
+	return status.Errorf(codes.FailedPrecondition, "segregation of duties violation: %s", strings.Join(descriptions, "; "))
+}
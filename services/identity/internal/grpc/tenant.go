@@ -0,0 +1,246 @@
+package grpc
+
+import (
+	"context"
+	"log"
+
+	"github.com/Nerzal/gocloak/v13"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	identityv1 "git.ninjainfosys.com/ePalika/proto/gen/identity/v1"
+)
+
+// baseRoles are the realm roles every tenant starts with. sodConflicts are
+// stored on the role and enforced when roles are granted.
+var baseRoles = []struct {
+	name         string
+	description  string
+	sodConflicts []string
+}{
+	{"darta_clerk", "Create and manage incoming correspondence drafts", []string{"darta_reviewer"}},
+	{"darta_reviewer", "Review and route Darta records", []string{"darta_clerk"}},
+	{"darta_registrar", "Finalize, archive, and close Darta records", nil},
+	{"chalani_dispatcher", "Draft and dispatch outgoing correspondence", []string{"chalani_approver"}},
+	{"chalani_approver", "Approve Chalani letters and manage queues", []string{"chalani_dispatcher"}},
+	{"numbering_officer", "Allocate Darta/Chalani number ranges", nil},
+	{"identity_admin", "Administer identity and grants", nil},
+	{"auditor", "Read and verify the tenant's audit trail", nil},
+}
+
+// baseOrgUnits are the groups (org units) every tenant starts with
+var baseOrgUnits = []struct {
+	name    string
+	orgType identityv1.OrgUnitType
+}{
+	{"Chief Administrative Office", identityv1.OrgUnitType_ORG_UNIT_TYPE_ADMINISTRATION},
+	{"Darta Chalani Section", identityv1.OrgUnitType_ORG_UNIT_TYPE_GENERAL_SERVICE},
+	{"Information Technology Section", identityv1.OrgUnitType_ORG_UNIT_TYPE_INFORMATION_TECHNOLOGY},
+}
+
+// OnboardTenant provisions a Keycloak realm for a new palika with its base
+// roles, org units, API client and claim mappers. It is idempotent: running
+// it against an existing realm reconciles anything missing.
+func (s *IdentityServer) OnboardTenant(ctx context.Context, req *identityv1.OnboardTenantRequest) (*identityv1.OnboardTenantResponse, error) {
+	if err := s.requirePlatformAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.Input == nil {
+		return nil, status.Error(codes.InvalidArgument, "input is required")
+	}
+	input := req.Input
+	if !tenantSlugPattern.MatchString(input.Slug) {
+		return nil, status.Error(codes.InvalidArgument, "slug must be lowercase letters, digits, '_' or '-'")
+	}
+	if input.Slug == "master" || input.Slug == s.keycloakClient.AdminRealm() || input.Slug == s.keycloakClient.DefaultRealm() {
+		return nil, status.Errorf(codes.InvalidArgument, "realm %s cannot be a tenant", input.Slug)
+	}
+	displayName := input.DisplayName
+	if displayName == "" {
+		displayName = input.Slug
+	}
+	locale := input.DefaultLocale
+	if locale == "" {
+		locale = "en"
+	}
+	realm := input.Slug
+
+	exists, err := s.keycloakClient.RealmExists(ctx, realm)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to look up realm: %v", err)
+	}
+	if !exists {
+		err := s.keycloakClient.CreateRealm(ctx, gocloak.RealmRepresentation{
+			Realm:                       gocloak.StringP(realm),
+			DisplayName:                 gocloak.StringP(displayName),
+			Enabled:                     gocloak.BoolP(true),
+			InternationalizationEnabled: gocloak.BoolP(true),
+			DefaultLocale:               gocloak.StringP(locale),
+			RegistrationAllowed:         gocloak.BoolP(false),
+			LoginWithEmailAllowed:       gocloak.BoolP(true),
+			DuplicateEmailsAllowed:      gocloak.BoolP(false),
+			ResetPasswordAllowed:        gocloak.BoolP(true),
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create realm: %v", err)
+		}
+	}
+
+	tenant := &identityv1.Tenant{
+		Slug:        input.Slug,
+		DisplayName: displayName,
+		Realm:       realm,
+		ClientId:    s.tenant.ClientID,
+		Enabled:     true,
+	}
+
+	for _, r := range baseRoles {
+		role := gocloak.Role{
+			Name:        gocloak.StringP(r.name),
+			Description: gocloak.StringP(r.description),
+		}
+		if len(r.sodConflicts) > 0 {
+			role.Attributes = &map[string][]string{attrRoleSoDConflicts: r.sodConflicts}
+		}
+		_, err := s.keycloakClient.EnsureRealmRole(ctx, realm, role)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to ensure role %s: %v", r.name, err)
+		}
+		tenant.Roles = append(tenant.Roles, r.name)
+	}
+
+	for _, ou := range baseOrgUnits {
+		attrs := map[string][]string{"type": {ou.orgType.String()}}
+		id, err := s.keycloakClient.EnsureGroup(ctx, realm, gocloak.Group{
+			Name:       gocloak.StringP(ou.name),
+			Attributes: &attrs,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to ensure org unit %s: %v", ou.name, err)
+		}
+		tenant.OrgUnits = append(tenant.OrgUnits, &identityv1.OrgUnit{
+			Id:   id,
+			Name: ou.name,
+			Type: ou.orgType,
+		})
+	}
+
+	clientID, secret, err := s.keycloakClient.EnsureClient(ctx, realm, tenantAPIClient(s.tenant.ClientID, input.RedirectUri, input.WebOrigin))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to ensure API client: %v", err)
+	}
+	tenant.ClientSecret = secret
+
+	scopeID, err := s.keycloakClient.EnsureClientScope(ctx, realm, gocloak.ClientScope{
+		Name:        gocloak.StringP(s.tenant.ScopeName),
+		Description: gocloak.StringP("ePalika default JWT claims (user_id, user_name, tenant, roles)"),
+		Protocol:    gocloak.StringP("openid-connect"),
+	}, tenantClaimMappers())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to ensure client scope: %v", err)
+	}
+	if err := s.keycloakClient.EnsureDefaultClientScope(ctx, realm, clientID, scopeID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to attach client scope: %v", err)
+	}
+
+	log.Printf("tenant %s onboarded by %s (created=%t)", realm, actorFromContext(ctx), !exists)
+
+	return &identityv1.OnboardTenantResponse{
+		Tenant:  tenant,
+		Created: !exists,
+	}, nil
+}
+
+// OffboardTenant deletes a tenant's realm. OpenFGA tuples and service data
+// are not touched and must be cleaned up separately.
+func (s *IdentityServer) OffboardTenant(ctx context.Context, req *identityv1.OffboardTenantRequest) (*identityv1.OffboardTenantResponse, error) {
+	if err := s.requirePlatformAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if !tenantSlugPattern.MatchString(req.Slug) {
+		return nil, status.Error(codes.InvalidArgument, "invalid tenant slug")
+	}
+	if req.ConfirmSlug != req.Slug {
+		return nil, status.Error(codes.InvalidArgument, "confirm_slug must match slug")
+	}
+	if req.Slug == "master" || req.Slug == s.keycloakClient.AdminRealm() || req.Slug == s.keycloakClient.DefaultRealm() {
+		return nil, status.Errorf(codes.FailedPrecondition, "realm %s cannot be offboarded", req.Slug)
+	}
+
+	exists, err := s.keycloakClient.RealmExists(ctx, req.Slug)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to look up realm: %v", err)
+	}
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "tenant %s not found", req.Slug)
+	}
+
+	if err := s.keycloakClient.DeleteRealm(ctx, req.Slug); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete realm: %v", err)
+	}
+
+	log.Printf("tenant %s offboarded by %s", req.Slug, actorFromContext(ctx))
+
+	return &identityv1.OffboardTenantResponse{
+		Success: true,
+	}, nil
+}
+
+// tenantAPIClient describes the confidential OAuth client created per tenant
+func tenantAPIClient(clientID, redirectURI, webOrigin string) gocloak.Client {
+	if redirectURI == "" {
+		redirectURI = "http://localhost:8000/*"
+	}
+	if webOrigin == "" {
+		webOrigin = "http://localhost:8000"
+	}
+	return gocloak.Client{
+		ClientID:                  gocloak.StringP(clientID),
+		Name:                      gocloak.StringP("ePalika API Client"),
+		Description:               gocloak.StringP("Confidential client for ePalika GraphQL/API access"),
+		Protocol:                  gocloak.StringP("openid-connect"),
+		PublicClient:              gocloak.BoolP(false),
+		StandardFlowEnabled:       gocloak.BoolP(true),
+		ImplicitFlowEnabled:       gocloak.BoolP(false),
+		DirectAccessGrantsEnabled: gocloak.BoolP(true),
+		ServiceAccountsEnabled:    gocloak.BoolP(true),
+		RedirectURIs:              &[]string{redirectURI},
+		WebOrigins:                &[]string{webOrigin},
+		DefaultClientScopes:       &[]string{"web-origins", "profile", "roles", "email"},
+		OptionalClientScopes:      &[]string{"address", "phone"},
+		Attributes:                &map[string]string{"access.token.lifespan": "3600"},
+	}
+}
+
+// tenantClaimMappers adds the user_id, user_name, tenant and roles claims
+// that Oathkeeper and the services rely on
+func tenantClaimMappers() []gocloak.ProtocolMappers {
+	mapper := func(name, mapperType, attribute string, multivalued bool) gocloak.ProtocolMappers {
+		cfg := &gocloak.ProtocolMappersConfig{
+			ClaimName:          gocloak.StringP(name),
+			JSONTypeLabel:      gocloak.StringP("String"),
+			AccessTokenClaim:   gocloak.StringP("true"),
+			IDTokenClaim:       gocloak.StringP("true"),
+			UserinfoTokenClaim: gocloak.StringP("true"),
+		}
+		if attribute != "" {
+			cfg.UserAttribute = gocloak.StringP(attribute)
+		}
+		if multivalued {
+			cfg.Multivalued = gocloak.StringP("true")
+		}
+		return gocloak.ProtocolMappers{
+			Name:                  gocloak.StringP(name),
+			Protocol:              gocloak.StringP("openid-connect"),
+			ProtocolMapper:        gocloak.StringP(mapperType),
+			ProtocolMappersConfig: cfg,
+		}
+	}
+
+	return []gocloak.ProtocolMappers{
+		mapper("user_id", "oidc-usermodel-property-mapper", "id", false),
+		mapper("user_name", "oidc-usermodel-property-mapper", "username", false),
+		mapper("tenant", "oidc-usermodel-attribute-mapper", attrTenant, true),
+		mapper("roles", "oidc-usermodel-realm-role-mapper", "", true),
+	}
+}
@@ -3,17 +3,29 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/Nerzal/gocloak/v13"
 )
 
-// Client wraps Keycloak API client
+// tokenRefreshMargin is how long before expiry a cached token is renewed
+const tokenRefreshMargin = 30 * time.Second
+
+// Client wraps Keycloak API client. A single client serves every tenant
+// realm; the realm for a call is taken from the context (see WithRealm).
 type Client struct {
-	gocloak     *gocloak.GoCloak
-	realm       string
-	clientID    string
-	clientSecret string
-	adminToken  string
+	gocloak      *gocloak.GoCloak
+	defaultRealm string
+	adminRealm   string
+
+	// credentials used to log in to specific realms; realms without an entry
+	// are administered with the admin realm's token
+	realmCredentials map[string]Credentials
+	adminCredentials Credentials
+
+	mu      sync.Mutex
+	sources map[string]*tokenSource
 }
 
 // Config holds Keycloak configuration
@@ -22,45 +34,169 @@ type Config struct {
 	Realm        string
 	ClientID     string
 	ClientSecret string
+
+	// AdminRealm and AdminCredentials are used for cross-realm operations
+	// such as tenant onboarding and for realms without their own credentials
+	AdminRealm       string
+	AdminCredentials Credentials
+}
+
+// Credentials identify the service to a Keycloak realm. Client credentials
+// are used when ClientSecret is set, otherwise the username and password.
+type Credentials struct {
+	ClientID     string
+	ClientSecret string
+	Username     string
+	Password     string
 }
 
 // NewClient creates a new Keycloak client
 func NewClient(cfg Config) *Client {
 	client := gocloak.NewClient(cfg.URL)
 
-	return &Client{
-		gocloak:      client,
-		realm:        cfg.Realm,
-		clientID:     cfg.ClientID,
-		clientSecret: cfg.ClientSecret,
+	adminRealm := cfg.AdminRealm
+	if adminRealm == "" {
+		adminRealm = "master"
 	}
+
+	c := &Client{
+		gocloak:          client,
+		defaultRealm:     cfg.Realm,
+		adminRealm:       adminRealm,
+		realmCredentials: make(map[string]Credentials),
+		adminCredentials: cfg.AdminCredentials,
+		sources:          make(map[string]*tokenSource),
+	}
+	if cfg.ClientSecret != "" {
+		c.realmCredentials[cfg.Realm] = Credentials{ClientID: cfg.ClientID, ClientSecret: cfg.ClientSecret}
+	}
+	return c
+}
+
+// DefaultRealm returns the realm used when the context names none
+func (c *Client) DefaultRealm() string {
+	return c.defaultRealm
+}
+
+// AdminRealm returns the realm used for cross-realm operations
+func (c *Client) AdminRealm() string {
+	return c.adminRealm
 }
 
-// Login authenticates with Keycloak and stores admin token
+// Login authenticates with the default realm, failing fast on bad
+// configuration. Tokens are otherwise obtained and refreshed on demand.
 func (c *Client) Login(ctx context.Context) error {
-	// For admin-cli client (no secret), use admin credentials
-	if c.clientSecret == "" || c.clientSecret == "admin" {
-		token, err := c.gocloak.LoginAdmin(ctx, "admin", "admin", c.realm)
-		if err != nil {
-			return fmt.Errorf("failed to login to keycloak: %w", err)
-		}
-		c.adminToken = token.AccessToken
-		return nil
+	_, err := c.sourceFor(c.defaultRealm).Token(ctx)
+	return err
+}
+
+// SetRealmCredentials registers credentials for logging in to a realm
+func (c *Client) SetRealmCredentials(realm string, creds Credentials) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.realmCredentials[realm] = creds
+	delete(c.sources, realm)
+}
+
+// ForgetRealm drops cached credentials and tokens for a realm
+func (c *Client) ForgetRealm(realm string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if realm != c.defaultRealm {
+		delete(c.realmCredentials, realm)
 	}
+	delete(c.sources, realm)
+}
 
-	// For service clients with secrets
-	token, err := c.gocloak.LoginClient(ctx, c.clientID, c.clientSecret, c.realm)
+// session returns the realm for the call and a valid token for it
+func (c *Client) session(ctx context.Context) (token, realm string, err error) {
+	realm = RealmFromContext(ctx)
+	if realm == "" {
+		realm = c.defaultRealm
+	}
+	token, err = c.sourceFor(realm).Token(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to login to keycloak: %w", err)
+		return "", "", err
 	}
+	return token, realm, nil
+}
 
-	c.adminToken = token.AccessToken
-	return nil
+// adminSession returns a token from the admin realm
+func (c *Client) adminSession(ctx context.Context) (string, error) {
+	return c.sourceFor(c.adminRealm).Token(ctx)
+}
+
+// sourceFor returns the token source used to administer realm
+func (c *Client) sourceFor(realm string) *tokenSource {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if src, ok := c.sources[realm]; ok {
+		return src
+	}
+
+	var src *tokenSource
+	if creds, ok := c.realmCredentials[realm]; ok {
+		src = &tokenSource{gocloak: c.gocloak, realm: realm, creds: creds}
+	} else if realm == c.adminRealm {
+		src = &tokenSource{gocloak: c.gocloak, realm: realm, creds: c.adminCredentials}
+	} else if admin, ok := c.sources[c.adminRealm]; ok {
+		src = admin
+	} else {
+		src = &tokenSource{gocloak: c.gocloak, realm: c.adminRealm, creds: c.adminCredentials}
+		c.sources[c.adminRealm] = src
+	}
+	c.sources[realm] = src
+	return src
+}
+
+// tokenSource caches an access token for one realm and renews it shortly
+// before it expires
+type tokenSource struct {
+	gocloak *gocloak.GoCloak
+	realm   string
+	creds   Credentials
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// Token returns a valid access token, logging in again when needed
+func (s *tokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Until(s.expiresAt) > tokenRefreshMargin {
+		return s.token, nil
+	}
+
+	jwt, err := s.login(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to login to keycloak realm %s: %w", s.realm, err)
+	}
+	s.token = jwt.AccessToken
+	s.expiresAt = time.Now().Add(time.Duration(jwt.ExpiresIn) * time.Second)
+	return s.token, nil
+}
+
+func (s *tokenSource) login(ctx context.Context) (*gocloak.JWT, error) {
+	switch {
+	case s.creds.ClientSecret != "":
+		return s.gocloak.LoginClient(ctx, s.creds.ClientID, s.creds.ClientSecret, s.realm)
+	case s.creds.Username != "":
+		return s.gocloak.LoginAdmin(ctx, s.creds.Username, s.creds.Password, s.realm)
+	}
+	return nil, fmt.Errorf("no credentials configured")
 }
 
 // GetUser retrieves a user by ID from Keycloak
 func (c *Client) GetUser(ctx context.Context, userID string) (*gocloak.User, error) {
-	user, err := c.gocloak.GetUserByID(ctx, c.adminToken, c.realm, userID)
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := c.gocloak.GetUserByID(ctx, token, realm, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
@@ -69,7 +205,11 @@ func (c *Client) GetUser(ctx context.Context, userID string) (*gocloak.User, err
 
 // GetUsers retrieves users with optional filters
 func (c *Client) GetUsers(ctx context.Context, params gocloak.GetUsersParams) ([]*gocloak.User, error) {
-	users, err := c.gocloak.GetUsers(ctx, c.adminToken, c.realm, params)
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+	users, err := c.gocloak.GetUsers(ctx, token, realm, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get users: %w", err)
 	}
@@ -78,7 +218,11 @@ func (c *Client) GetUsers(ctx context.Context, params gocloak.GetUsersParams) ([
 
 // CreateUser creates a new user in Keycloak
 func (c *Client) CreateUser(ctx context.Context, user gocloak.User) (string, error) {
-	userID, err := c.gocloak.CreateUser(ctx, c.adminToken, c.realm, user)
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return "", err
+	}
+	userID, err := c.gocloak.CreateUser(ctx, token, realm, user)
 	if err != nil {
 		return "", fmt.Errorf("failed to create user: %w", err)
 	}
@@ -87,7 +231,11 @@ func (c *Client) CreateUser(ctx context.Context, user gocloak.User) (string, err
 
 // UpdateUser updates an existing user
 func (c *Client) UpdateUser(ctx context.Context, user gocloak.User) error {
-	err := c.gocloak.UpdateUser(ctx, c.adminToken, c.realm, user)
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return err
+	}
+	err = c.gocloak.UpdateUser(ctx, token, realm, user)
 	if err != nil {
 		return fmt.Errorf("failed to update user: %w", err)
 	}
@@ -96,7 +244,11 @@ func (c *Client) UpdateUser(ctx context.Context, user gocloak.User) error {
 
 // GetUserGroups retrieves groups for a user
 func (c *Client) GetUserGroups(ctx context.Context, userID string) ([]*gocloak.Group, error) {
-	groups, err := c.gocloak.GetUserGroups(ctx, c.adminToken, c.realm, userID, gocloak.GetGroupsParams{})
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := c.gocloak.GetUserGroups(ctx, token, realm, userID, gocloak.GetGroupsParams{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user groups: %w", err)
 	}
@@ -105,7 +257,11 @@ func (c *Client) GetUserGroups(ctx context.Context, userID string) ([]*gocloak.G
 
 // GetRoles retrieves all realm roles
 func (c *Client) GetRoles(ctx context.Context) ([]*gocloak.Role, error) {
-	roles, err := c.gocloak.GetRealmRoles(ctx, c.adminToken, c.realm, gocloak.GetRoleParams{})
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := c.gocloak.GetRealmRoles(ctx, token, realm, gocloak.GetRoleParams{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get roles: %w", err)
 	}
@@ -114,7 +270,11 @@ func (c *Client) GetRoles(ctx context.Context) ([]*gocloak.Role, error) {
 
 // GetRoleByName retrieves a role by name
 func (c *Client) GetRoleByName(ctx context.Context, roleName string) (*gocloak.Role, error) {
-	role, err := c.gocloak.GetRealmRole(ctx, c.adminToken, c.realm, roleName)
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+	role, err := c.gocloak.GetRealmRole(ctx, token, realm, roleName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get role: %w", err)
 	}
@@ -123,7 +283,11 @@ func (c *Client) GetRoleByName(ctx context.Context, roleName string) (*gocloak.R
 
 // AddRealmRoleToUser adds a realm role to a user
 func (c *Client) AddRealmRoleToUser(ctx context.Context, userID string, roles []gocloak.Role) error {
-	err := c.gocloak.AddRealmRoleToUser(ctx, c.adminToken, c.realm, userID, roles)
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return err
+	}
+	err = c.gocloak.AddRealmRoleToUser(ctx, token, realm, userID, roles)
 	if err != nil {
 		return fmt.Errorf("failed to add role to user: %w", err)
 	}
@@ -132,7 +296,11 @@ func (c *Client) AddRealmRoleToUser(ctx context.Context, userID string, roles []
 
 // GetGroup retrieves a group by ID
 func (c *Client) GetGroup(ctx context.Context, groupID string) (*gocloak.Group, error) {
-	group, err := c.gocloak.GetGroup(ctx, c.adminToken, c.realm, groupID)
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+	group, err := c.gocloak.GetGroup(ctx, token, realm, groupID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get group: %w", err)
 	}
@@ -141,9 +309,131 @@ func (c *Client) GetGroup(ctx context.Context, groupID string) (*gocloak.Group,
 
 // GetGroups retrieves all groups
 func (c *Client) GetGroups(ctx context.Context, params gocloak.GetGroupsParams) ([]*gocloak.Group, error) {
-	groups, err := c.gocloak.GetGroups(ctx, c.adminToken, c.realm, params)
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := c.gocloak.GetGroups(ctx, token, realm, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get groups: %w", err)
 	}
 	return groups, nil
 }
+
+// DeleteUser deletes a user from Keycloak
+func (c *Client) DeleteUser(ctx context.Context, userID string) error {
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return err
+	}
+	err = c.gocloak.DeleteUser(ctx, token, realm, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	return nil
+}
+
+// GetUserRealmRoles retrieves the realm roles directly assigned to a user
+func (c *Client) GetUserRealmRoles(ctx context.Context, userID string) ([]*gocloak.Role, error) {
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := c.gocloak.GetRealmRolesByUserID(ctx, token, realm, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user roles: %w", err)
+	}
+	return roles, nil
+}
+
+// DeleteRealmRoleFromUser removes a realm role from a user
+func (c *Client) DeleteRealmRoleFromUser(ctx context.Context, userID string, roles []gocloak.Role) error {
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return err
+	}
+	err = c.gocloak.DeleteRealmRoleFromUser(ctx, token, realm, userID, roles)
+	if err != nil {
+		return fmt.Errorf("failed to remove role from user: %w", err)
+	}
+	return nil
+}
+
+// AddUserToGroup adds a user to a group
+func (c *Client) AddUserToGroup(ctx context.Context, userID, groupID string) error {
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return err
+	}
+	err = c.gocloak.AddUserToGroup(ctx, token, realm, userID, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to add user to group: %w", err)
+	}
+	return nil
+}
+
+// ExecuteActionsEmail sends the user an email with the given required actions
+// (e.g. VERIFY_EMAIL, UPDATE_PASSWORD)
+func (c *Client) ExecuteActionsEmail(ctx context.Context, params gocloak.ExecuteActionsEmail) error {
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return err
+	}
+	err = c.gocloak.ExecuteActionsEmail(ctx, token, realm, params)
+	if err != nil {
+		return fmt.Errorf("failed to send actions email: %w", err)
+	}
+	return nil
+}
+
+// LogoutAllSessions terminates all sessions of a user
+func (c *Client) LogoutAllSessions(ctx context.Context, userID string) error {
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return err
+	}
+	err = c.gocloak.LogoutAllSessions(ctx, token, realm, userID)
+	if err != nil {
+		return fmt.Errorf("failed to logout user sessions: %w", err)
+	}
+	return nil
+}
+
+// GetUserCount returns the number of users matching the given filters
+func (c *Client) GetUserCount(ctx context.Context, params gocloak.GetUsersParams) (int, error) {
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := c.gocloak.GetUserCount(ctx, token, realm, params)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count users: %w", err)
+	}
+	return count, nil
+}
+
+// GetGroupMembers retrieves the members of a group
+func (c *Client) GetGroupMembers(ctx context.Context, groupID string, params gocloak.GetGroupsParams) ([]*gocloak.User, error) {
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+	users, err := c.gocloak.GetGroupMembers(ctx, token, realm, groupID, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group members: %w", err)
+	}
+	return users, nil
+}
+
+// GetUsersByRoleName retrieves users that hold a realm role
+func (c *Client) GetUsersByRoleName(ctx context.Context, roleName string, params gocloak.GetUsersByRoleParams) ([]*gocloak.User, error) {
+	token, realm, err := c.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+	users, err := c.gocloak.GetUsersByRoleName(ctx, token, realm, roleName, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users by role: %w", err)
+	}
+	return users, nil
+}
@@ -0,0 +1,16 @@
+package keycloak
+
+import "context"
+
+type realmKey struct{}
+
+// WithRealm returns a context whose Keycloak calls target realm
+func WithRealm(ctx context.Context, realm string) context.Context {
+	return context.WithValue(ctx, realmKey{}, realm)
+}
+
+// RealmFromContext returns the realm set by WithRealm, or ""
+func RealmFromContext(ctx context.Context) string {
+	realm, _ := ctx.Value(realmKey{}).(string)
+	return realm
+}
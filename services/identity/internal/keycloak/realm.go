@@ -0,0 +1,205 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Nerzal/gocloak/v13"
+)
+
+// Realm administration. These calls always use the admin realm's token and
+// take the target realm explicitly, since they run before or after the
+// tenant realm is usable.
+
+// IsNotFound reports whether err is a Keycloak 404
+func IsNotFound(err error) bool {
+	var apiErr *gocloak.APIError
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound
+}
+
+// RealmExists reports whether a realm exists
+func (c *Client) RealmExists(ctx context.Context, realm string) (bool, error) {
+	token, err := c.adminSession(ctx)
+	if err != nil {
+		return false, err
+	}
+	_, err = c.gocloak.GetRealm(ctx, token, realm)
+	if IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get realm: %w", err)
+	}
+	return true, nil
+}
+
+// CreateRealm creates a realm
+func (c *Client) CreateRealm(ctx context.Context, realm gocloak.RealmRepresentation) error {
+	token, err := c.adminSession(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := c.gocloak.CreateRealm(ctx, token, realm); err != nil {
+		return fmt.Errorf("failed to create realm: %w", err)
+	}
+	return nil
+}
+
+// DeleteRealm deletes a realm and forgets its tokens
+func (c *Client) DeleteRealm(ctx context.Context, realm string) error {
+	token, err := c.adminSession(ctx)
+	if err != nil {
+		return err
+	}
+	if err := c.gocloak.DeleteRealm(ctx, token, realm); err != nil {
+		return fmt.Errorf("failed to delete realm: %w", err)
+	}
+	c.ForgetRealm(realm)
+	return nil
+}
+
+// EnsureRealmRole creates a realm role unless it already exists. It reports
+// whether the role was created.
+func (c *Client) EnsureRealmRole(ctx context.Context, realm string, role gocloak.Role) (bool, error) {
+	token, err := c.adminSession(ctx)
+	if err != nil {
+		return false, err
+	}
+	_, err = c.gocloak.GetRealmRole(ctx, token, realm, gocloak.PString(role.Name))
+	if err == nil {
+		return false, nil
+	}
+	if !IsNotFound(err) {
+		return false, fmt.Errorf("failed to get role: %w", err)
+	}
+	if _, err := c.gocloak.CreateRealmRole(ctx, token, realm, role); err != nil {
+		return false, fmt.Errorf("failed to create role: %w", err)
+	}
+	return true, nil
+}
+
+// EnsureGroup creates a top-level group unless one with the same name exists
+// and returns its ID
+func (c *Client) EnsureGroup(ctx context.Context, realm string, group gocloak.Group) (string, error) {
+	token, err := c.adminSession(ctx)
+	if err != nil {
+		return "", err
+	}
+	groups, err := c.gocloak.GetGroups(ctx, token, realm, gocloak.GetGroupsParams{
+		Search: group.Name,
+		Exact:  gocloak.BoolP(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get groups: %w", err)
+	}
+	for _, g := range groups {
+		if gocloak.PString(g.Name) == gocloak.PString(group.Name) {
+			return gocloak.PString(g.ID), nil
+		}
+	}
+	id, err := c.gocloak.CreateGroup(ctx, token, realm, group)
+	if err != nil {
+		return "", fmt.Errorf("failed to create group: %w", err)
+	}
+	return id, nil
+}
+
+// EnsureClient creates a confidential client unless one with the same
+// client ID exists, and returns its internal ID and secret
+func (c *Client) EnsureClient(ctx context.Context, realm string, client gocloak.Client) (id, secret string, err error) {
+	token, err := c.adminSession(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	clients, err := c.gocloak.GetClients(ctx, token, realm, gocloak.GetClientsParams{
+		ClientID: client.ClientID,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get clients: %w", err)
+	}
+	if len(clients) > 0 {
+		id = gocloak.PString(clients[0].ID)
+	} else {
+		id, err = c.gocloak.CreateClient(ctx, token, realm, client)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to create client: %w", err)
+		}
+	}
+
+	cred, err := c.gocloak.GetClientSecret(ctx, token, realm, id)
+	if err == nil && gocloak.PString(cred.Value) != "" {
+		return id, gocloak.PString(cred.Value), nil
+	}
+	cred, err = c.gocloak.RegenerateClientSecret(ctx, token, realm, id)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get client secret: %w", err)
+	}
+	return id, gocloak.PString(cred.Value), nil
+}
+
+// EnsureClientScope creates a client scope with the given protocol mappers
+// unless it exists, adds any missing mappers, and returns the scope ID
+func (c *Client) EnsureClientScope(ctx context.Context, realm string, scope gocloak.ClientScope, mappers []gocloak.ProtocolMappers) (string, error) {
+	token, err := c.adminSession(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	scopes, err := c.gocloak.GetClientScopes(ctx, token, realm)
+	if err != nil {
+		return "", fmt.Errorf("failed to get client scopes: %w", err)
+	}
+	var scopeID string
+	existing := map[string]bool{}
+	for _, s := range scopes {
+		if gocloak.PString(s.Name) != gocloak.PString(scope.Name) {
+			continue
+		}
+		scopeID = gocloak.PString(s.ID)
+		if s.ProtocolMappers != nil {
+			for _, m := range *s.ProtocolMappers {
+				existing[gocloak.PString(m.Name)] = true
+			}
+		}
+		break
+	}
+	if scopeID == "" {
+		scopeID, err = c.gocloak.CreateClientScope(ctx, token, realm, scope)
+		if err != nil {
+			return "", fmt.Errorf("failed to create client scope: %w", err)
+		}
+	}
+
+	for _, m := range mappers {
+		if existing[gocloak.PString(m.Name)] {
+			continue
+		}
+		if _, err := c.gocloak.CreateClientScopeProtocolMapper(ctx, token, realm, scopeID, m); err != nil {
+			return "", fmt.Errorf("failed to create protocol mapper %s: %w", gocloak.PString(m.Name), err)
+		}
+	}
+	return scopeID, nil
+}
+
+// EnsureDefaultClientScope attaches a client scope to a client as default
+func (c *Client) EnsureDefaultClientScope(ctx context.Context, realm, clientID, scopeID string) error {
+	token, err := c.adminSession(ctx)
+	if err != nil {
+		return err
+	}
+	scopes, err := c.gocloak.GetClientsDefaultScopes(ctx, token, realm, clientID)
+	if err != nil {
+		return fmt.Errorf("failed to get default client scopes: %w", err)
+	}
+	for _, s := range scopes {
+		if gocloak.PString(s.ID) == scopeID {
+			return nil
+		}
+	}
+	if err := c.gocloak.AddDefaultScopeToClient(ctx, token, realm, clientID, scopeID); err != nil {
+		return fmt.Errorf("failed to attach client scope: %w", err)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ replace git.ninjainfosys.com/ePalika/proto => ../../proto
 
 require (
 	git.ninjainfosys.com/ePalika/proto v0.0.0-00010101000000-000000000000
+	github.com/google/uuid v1.6.0
 	google.golang.org/grpc v1.75.1
 	google.golang.org/protobuf v1.36.10
 )
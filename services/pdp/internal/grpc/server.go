@@ -34,11 +34,21 @@ func (s *Server) HealthCheck(ctx context.Context, _ *pdpv1.HealthCheckRequest) (
 
 // CheckAuthorization evaluates an authorization decision for the provided input.
 func (s *Server) CheckAuthorization(ctx context.Context, req *pdpv1.CheckAuthorizationRequest) (*pdpv1.CheckAuthorizationResponse, error) {
+	contextual := make([]service.TupleKey, 0, len(req.GetContextualTuples()))
+	for _, t := range req.GetContextualTuples() {
+		contextual = append(contextual, service.TupleKey{
+			User:     t.GetUser(),
+			Relation: t.GetRelation(),
+			Object:   t.GetObject(),
+		})
+	}
+
 	result, err := s.svc.CheckAuthorization(ctx, service.AuthorizationRequest{
-		User:     req.GetUser(),
-		Relation: req.GetRelation(),
-		Object:   req.GetObject(),
-		Context:  req.GetContext(),
+		User:             req.GetUser(),
+		Relation:         req.GetRelation(),
+		Object:           req.GetObject(),
+		Context:          req.GetContext(),
+		ContextualTuples: contextual,
 	})
 	if err != nil {
 		if errors.Is(err, service.ErrInvalidInput) {
@@ -48,8 +58,9 @@ func (s *Server) CheckAuthorization(ctx context.Context, req *pdpv1.CheckAuthori
 	}
 
 	return &pdpv1.CheckAuthorizationResponse{
-		Allowed: result.Allowed,
-		Message: result.Message,
-		Reason:  result.Reason,
+		Allowed:    result.Allowed,
+		Message:    result.Message,
+		Reason:     result.Reason,
+		DecisionId: result.DecisionID,
 	}, nil
 }
@@ -6,10 +6,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log"
 	"net/http"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+
 	"git.ninjainfosys.com/ePalika/services/pdp/internal/config"
 )
 
@@ -25,13 +28,23 @@ type AuthorizationRequest struct {
 	Relation string
 	Object   string
 	Context  map[string]string
+	// ContextualTuples are assumed to exist for this check only
+	ContextualTuples []TupleKey
+}
+
+// TupleKey identifies a relationship tuple.
+type TupleKey struct {
+	User     string
+	Relation string
+	Object   string
 }
 
 // AuthorizationResult contains the outcome of an authorization check.
 type AuthorizationResult struct {
-	Allowed bool
-	Message string
-	Reason  string
+	Allowed    bool
+	Message    string
+	Reason     string
+	DecisionID string
 }
 
 // HealthStatus represents the service health snapshot.
@@ -86,8 +99,20 @@ func (s *Service) CheckAuthorization(ctx context.Context, input AuthorizationReq
 
 	normalizedRelation := mapActionToRelation(relation)
 
+	result, err := s.decide(ctx, user, normalizedRelation, object, input)
+	if err != nil {
+		return nil, err
+	}
+
+	result.DecisionID = uuid.NewString()
+	log.Printf("decision %s: %s %s %s allowed=%t reason=%s", result.DecisionID, user, normalizedRelation, object, result.Allowed, result.Reason)
+	return result, nil
+}
+
+// decide asks OPA, when configured, and then FGA
+func (s *Service) decide(ctx context.Context, user, relation, object string, input AuthorizationRequest) (*AuthorizationResult, error) {
 	if s.cfg.OPA.DecideURL != "" {
-		allowed, err := s.askOPA(ctx, user, normalizedRelation, object, input.Context)
+		allowed, err := s.askOPA(ctx, user, relation, object, input.Context)
 		if err != nil {
 			return nil, fmt.Errorf("opa decision: %w", err)
 		}
@@ -100,12 +125,7 @@ func (s *Service) CheckAuthorization(ctx context.Context, input AuthorizationReq
 		}
 	}
 
-	result, err := s.askFGA(ctx, user, normalizedRelation, object, input.Context)
-	if err != nil {
-		return nil, err
-	}
-
-	return result, nil
+	return s.askFGA(ctx, user, relation, object, input.Context, input.ContextualTuples)
 }
 
 func (s *Service) askOPA(ctx context.Context, user, relation, object string, ctxMap map[string]string) (bool, error) {
@@ -151,12 +171,23 @@ func (s *Service) askOPA(ctx context.Context, user, relation, object string, ctx
 	return out.Result, nil
 }
 
-func (s *Service) askFGA(ctx context.Context, user, relation, object string, ctxMap map[string]string) (*AuthorizationResult, error) {
+func (s *Service) askFGA(ctx context.Context, user, relation, object string, ctxMap map[string]string, contextual []TupleKey) (*AuthorizationResult, error) {
 	payload := fgaCheckRequest{AuthorizationModelID: s.cfg.FGA.ModelID}
 	payload.TupleKey.User = user
 	payload.TupleKey.Relation = relation
 	payload.TupleKey.Object = object
 
+	if len(contextual) > 0 {
+		payload.ContextualTuples = &fgaContextualTuples{}
+		for _, t := range contextual {
+			payload.ContextualTuples.TupleKeys = append(payload.ContextualTuples.TupleKeys, fgaTupleKey{
+				User:     strings.TrimSpace(t.User),
+				Relation: strings.TrimSpace(t.Relation),
+				Object:   strings.TrimSpace(t.Object),
+			})
+		}
+	}
+
 	if len(ctxMap) > 0 {
 		payload.Context = make(map[string]any, len(ctxMap))
 		for k, v := range ctxMap {
@@ -234,9 +265,14 @@ func mapActionToRelation(action string) string {
 }
 
 type fgaCheckRequest struct {
-	AuthorizationModelID string         `json:"authorization_model_id,omitempty"`
-	TupleKey             fgaTupleKey    `json:"tuple_key"`
-	Context              map[string]any `json:"context,omitempty"`
+	AuthorizationModelID string               `json:"authorization_model_id,omitempty"`
+	TupleKey             fgaTupleKey          `json:"tuple_key"`
+	ContextualTuples     *fgaContextualTuples `json:"contextual_tuples,omitempty"`
+	Context              map[string]any       `json:"context,omitempty"`
+}
+
+type fgaContextualTuples struct {
+	TupleKeys []fgaTupleKey `json:"tuple_keys"`
 }
 
 type fgaTupleKey struct {